@@ -18,6 +18,7 @@ type HandlerOptions struct {
 	FeeCollectorName string
 	AdmissionData    interface{}
 	SwingsetKeeper   SwingsetKeeper
+	VtransferKeeper  VtransferKeeper
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -36,6 +37,12 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.SwingsetKeeper == nil {
 		return nil, sdkioerrors.Wrap(sdkerrors.ErrLogic, "swingset keeper is required for AnteHandler")
 	}
+	if opts.IBCKeeper == nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrLogic, "IBC keeper is required for AnteHandler")
+	}
+	if opts.VtransferKeeper == nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrLogic, "vtransfer keeper is required for AnteHandler")
+	}
 
 	var sigGasConsumer = opts.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -50,6 +57,10 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
 		NewInboundDecorator(opts.SwingsetKeeper),
+		NewInboundLaneDecorator(opts.SwingsetKeeper, opts.IBCKeeper.PortKeeper, opts.VtransferKeeper),
+		NewWalletRateLimitDecorator(opts.SwingsetKeeper),
+		NewInboundDedupDecorator(opts.SwingsetKeeper),
+		NewOracleFeeDecorator(opts.SwingsetKeeper),
 		ante.NewDeductFeeDecoratorWithName(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, nil, opts.FeeCollectorName),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper),
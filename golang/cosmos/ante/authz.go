@@ -0,0 +1,30 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// unwrapAuthzMsgs returns msgs with the messages executed by each authz
+// MsgExec in place of the MsgExec, so that the swingset messages a grantee
+// submits on behalf of a granter (such as under a WalletActionAuthorization)
+// are subject to the same admission checks as if the granter had submitted
+// them.  A MsgExec whose messages cannot be unpacked is kept as is, for its
+// ValidateBasic to refuse.
+func unwrapAuthzMsgs(msgs []sdk.Msg) []sdk.Msg {
+	unwrapped := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			unwrapped = append(unwrapped, msg)
+			continue
+		}
+		inner, err := exec.GetMessages()
+		if err != nil {
+			unwrapped = append(unwrapped, msg)
+			continue
+		}
+		unwrapped = append(unwrapped, unwrapAuthzMsgs(inner)...)
+	}
+	return unwrapped
+}
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	"reflect"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestUnwrapAuthzMsgs(t *testing.T) {
+	grantee := sdk.AccAddress([]byte("grantee"))
+	action := &swingtypes.MsgWalletAction{Owner: sdk.AccAddress([]byte("owner")), Action: "{}"}
+	send := &banktypes.MsgSend{}
+	inner := authz.NewMsgExec(grantee, []sdk.Msg{action})
+	outer := authz.NewMsgExec(grantee, []sdk.Msg{&inner, send})
+
+	got := unwrapAuthzMsgs([]sdk.Msg{send, &outer})
+	want := []sdk.Msg{send, action, send}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -1,9 +1,12 @@
 package ante
 
 import (
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
@@ -23,4 +26,20 @@ type FeegrantKeeper interface {
 type SwingsetKeeper interface {
 	InboundQueueLength(ctx sdk.Context) (int32, error)
 	GetState(ctx sdk.Context) swingtypes.State
+	IsInboundBackpressured(ctx sdk.Context) bool
+	IsDeliverInboundReplay(ctx sdk.Context, peer sdk.AccAddress, nums []uint64, ack uint64) bool
+	ConsumeWalletSpendActionToken(ctx sdk.Context, addr sdk.AccAddress) (bool, error)
+	IsOracleOperator(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetOraclePushFee(ctx sdk.Context) sdk.Coins
+	IsInboundLaneFull(ctx sdk.Context, lane string) (bool, error)
+}
+
+// PortKeeper defines the expected IBC port keeper.
+type PortKeeper interface {
+	LookupModuleByPort(ctx sdk.Context, portID string) (string, *capabilitytypes.Capability, error)
+}
+
+// VtransferKeeper defines the expected vtransfer keeper.
+type VtransferKeeper interface {
+	IsPacketTargeted(ctx sdk.Context, packet ibcexported.PacketI, role agoric.AddressRole) bool
 }
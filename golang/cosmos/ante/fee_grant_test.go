@@ -0,0 +1,123 @@
+package ante_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/cosmos/ibc-go/v6/testing/simapp"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/ante"
+	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		// Our reply must be truthy or else we don't make it past AG_COSMOS_INIT.
+		return "true", nil
+	}
+	agoricApp := app.NewAgoricApp(controller, vm.NewAgdServer(), log.TestingLogger(), dbm.NewMemDB(), nil,
+		true, map[int64]bool{}, app.DefaultNodeHome, simapp.FlagPeriodValue, app.MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	return agoricApp, app.NewDefaultGenesisState()
+}
+
+// TestAdmissionFeeGrant runs the fee deduction and admission of smart wallet
+// actions executed through authz with the fees of the grantee paid by a fee
+// granter, as in the ante handler of the app, with the keepers of the app.
+func TestAdmissionFeeGrant(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	agoricApp := chain.App.(*app.GaiaApp)
+	ctx := chain.GetContext()
+
+	// Debit each admission fee immediately, in whole uist.
+	params := agoricApp.SwingSetKeeper.GetParams(ctx)
+	for i, entry := range params.BeansPerUnit {
+		if entry.Key == swingtypes.BeansPerMinFeeDebit {
+			params.BeansPerUnit[i].Beans = swingtypes.DefaultBeansPerFeeUnit.Quo(sdkmath.NewUint(1_000_000))
+		}
+	}
+	agoricApp.SwingSetKeeper.SetParams(ctx, params)
+
+	granter := chain.SenderAccount.GetAddress()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	_, _, owner := testdata.KeyTestPubAddr()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uist", 1_000_000))
+	if err := agoricApp.BankKeeper.MintCoins(ctx, ibctransfertypes.ModuleName, funds); err != nil {
+		t.Fatal(err)
+	}
+	if err := agoricApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, ibctransfertypes.ModuleName, granter, funds); err != nil {
+		t.Fatal(err)
+	}
+	walletPath := swingsetkeeper.StoragePathCustom + "." + swingsetkeeper.WalletStoragePathSegment + "." + owner.String()
+	agoricApp.VstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(walletPath, "{}"))
+
+	// The granter sponsors the grantee, which executes the wallet actions of
+	// the owner, up to two transaction fees and one admission fee of 11040uist.
+	err := agoricApp.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uist", 20_000)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	anteHandler := sdk.ChainAnteDecorators(
+		authante.NewDeductFeeDecorator(agoricApp.AccountKeeper, agoricApp.BankKeeper, agoricApp.FeeGrantKeeper, nil),
+		ante.NewAdmissionDecorator(agoricApp.SwingSetKeeper),
+	)
+	runTx := func() error {
+		exec := authz.NewMsgExec(grantee, []sdk.Msg{swingtypes.NewMsgWalletAction(owner, "{}")})
+		builder := agoricApp.GetTxConfig().NewTxBuilder()
+		if err := builder.SetMsgs(&exec); err != nil {
+			t.Fatal(err)
+		}
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uist", 1000)))
+		builder.SetFeeGranter(granter)
+		builder.SetGasLimit(200_000)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		_, err := anteHandler(cacheCtx, builder.GetTx(), false)
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+
+	if err := runTx(); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if got, want := agoricApp.BankKeeper.GetBalance(ctx, granter, "uist"), sdk.NewInt64Coin("uist", 1_000_000-1000-11040); !got.IsEqual(want) {
+		t.Errorf("got granter balance %s, want %s", got, want)
+	}
+
+	// The allowance covers the fee of the second transaction, but not its
+	// admission, so neither is charged.
+	if err := runTx(); err == nil {
+		t.Error("got no error once the allowance is exhausted")
+	}
+	if got, want := agoricApp.BankKeeper.GetBalance(ctx, granter, "uist"), sdk.NewInt64Coin("uist", 1_000_000-1000-11040); !got.IsEqual(want) {
+		t.Errorf("got granter balance %s, want %s", got, want)
+	}
+	allowance, err := agoricApp.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := allowance.(*feegrant.BasicAllowance).SpendLimit, sdk.NewCoins(sdk.NewInt64Coin("uist", 20_000-1000-11040)); !got.IsEqual(want) {
+		t.Errorf("got spend limit %s, want %s", got, want)
+	}
+}
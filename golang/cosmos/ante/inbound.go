@@ -32,6 +32,11 @@ the number of messages allowed for mempool admission as if its max
 queue length was lower (e.g. 50%). This is the QueueInboundMempool
 entry in the Swingset state QueueAllowed field. At DeliverTx time
 the QueueInbound entry gives the number of allowed messages.
+
+Independently of the queue size, SwingSet reports at the end of each block
+the headroom left by its run policy. While that headroom is below the
+min_run_policy_headroom param, the kernel is considered saturated and no
+inbound messages are allowed, applying backpressure until it catches up.
 */
 
 const (
@@ -55,7 +60,7 @@ func NewInboundDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
 // Lazily consults the Swingset state to avoid overhead when dealing
 // with pure Cosmos-level Txs.
 func (ia inboundAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	msgs := tx.GetMsgs()
+	msgs := unwrapAuthzMsgs(tx.GetMsgs())
 	inboundsAllowed := int32(-1)
 	for _, msg := range msgs {
 		inbounds := inboundMessages(msg)
@@ -114,6 +119,9 @@ func (ia inboundAnte) allowedInbound(ctx sdk.Context) (int32, error) {
 		// if number of allowed entries not given, fail closed
 		return 0, nil
 	}
+	if ia.sk.IsInboundBackpressured(ctx) {
+		return 0, nil
+	}
 	actions, err := ia.sk.InboundQueueLength(ctx)
 	if err != nil {
 		return 0, err
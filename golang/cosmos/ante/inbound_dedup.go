@@ -0,0 +1,57 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+/*
+This AnteDecorator rejects MsgDeliverInbound submissions that replay mailbox
+messages SwingSet has already received, such as when several relayers submit
+the same outbox of an ag-solo. x/swingset remembers the (peer, message number)
+pairs it delivered for the number of blocks given by the swingset
+inbound_dedup_window_blocks parameter, and a submission carrying only such
+messages and no newer ack is rejected, during CheckTx so that it never enters
+the mempool, and during DeliverTx so that it never reaches the kernel.
+*/
+
+// inboundDedupAnte is an sdk.AnteDecorator which rejects replayed mailbox
+// deliveries.
+type inboundDedupAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewInboundDedupDecorator returns an AnteDecorator which rejects replayed
+// mailbox deliveries.
+func NewInboundDedupDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return inboundDedupAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (da inboundDedupAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		deliverInbound, ok := msg.(*swingtypes.MsgDeliverInbound)
+		if !ok {
+			continue
+		}
+		if da.sk.IsDeliverInboundReplay(ctx, deliverInbound.Submitter, deliverInbound.Nums, deliverInbound.Ack) {
+			defer func() {
+				telemetry.IncrCounterWithLabels(
+					[]string{"tx", "ante", "inbound_replayed"},
+					1,
+					[]metrics.Label{
+						telemetry.NewLabel("msg", sdk.MsgTypeURL(msg)),
+					},
+				)
+			}()
+			return ctx, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "mailbox messages from %s were already delivered", deliverInbound.Submitter)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
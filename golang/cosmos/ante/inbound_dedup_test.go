@@ -0,0 +1,52 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestInboundDedupAnteHandle(t *testing.T) {
+	peer1 := sdk.AccAddress([]byte("peer1"))
+	peer2 := sdk.AccAddress([]byte("peer2"))
+	deliver1 := &swingtypes.MsgDeliverInbound{Submitter: peer1, Nums: []uint64{1}, Messages: []string{"hello"}}
+	deliver2 := &swingtypes.MsgDeliverInbound{Submitter: peer2, Nums: []uint64{1}, Messages: []string{"hello"}}
+
+	for _, tt := range []struct {
+		name          string
+		tx            sdk.Tx
+		replayedPeers map[string]bool
+		wantErr       bool
+	}{
+		{
+			name: "fresh",
+			tx:   makeTestTx(deliver1, deliver2),
+		},
+		{
+			name:          "ignore-other-msgs",
+			tx:            makeTestTx(&banktypes.MsgSend{}, deliver2),
+			replayedPeers: map[string]bool{peer1.String(): true},
+		},
+		{
+			name:          "replayed",
+			tx:            makeTestTx(deliver2, deliver1),
+			replayedPeers: map[string]bool{peer1.String(): true},
+			wantErr:       true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(true)
+			mock := mockSwingsetKeeper{replayedPeers: tt.replayedPeers}
+			decorator := NewInboundDedupDecorator(mock)
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, nilAnteHandler)
+			if tt.wantErr && err == nil {
+				t.Errorf("want error, got none")
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("want no error, got %s", err.Error())
+			}
+		})
+	}
+}
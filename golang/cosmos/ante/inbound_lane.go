@@ -0,0 +1,127 @@
+package ante
+
+import (
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+/*
+This AnteDecorator enforces the size limits of the inbound lanes configured by
+the swingset inbound_lanes parameter. x/swingset sorts the actions bound for
+SwingSet into lanes by their source, and a Tx is rejected (during both CheckTx
+and DeliverTx) while the lane of any of its messages holds its max_size of
+actions, so that a flood of one kind of message, such as wallet spam, cannot
+fill the inbound queue at the expense of the others. IBC relay messages are
+held back while the ibc lane is full only if their packets are bound for
+SwingSet, either over a port bound by x/vibc or as transfers of accounts
+watched by x/vtransfer, leaving the relayers to retry.
+
+The lane of a message must agree with that which x/swingset assigns to its
+action; see actionInboundLane in golang/cosmos/x/swingset/keeper/inbound_lanes.go.
+*/
+
+// inboundLaneAnte is an sdk.AnteDecorator which enforces the size limits of
+// the inbound lanes.
+type inboundLaneAnte struct {
+	sk SwingsetKeeper
+	pk PortKeeper
+	vk VtransferKeeper
+}
+
+// NewInboundLaneDecorator returns an AnteDecorator which enforces the size
+// limits of the inbound lanes.
+func NewInboundLaneDecorator(sk SwingsetKeeper, pk PortKeeper, vk VtransferKeeper) sdk.AnteDecorator {
+	return inboundLaneAnte{sk: sk, pk: pk, vk: vk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (la inboundLaneAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range unwrapAuthzMsgs(tx.GetMsgs()) {
+		lane, err := la.inboundLane(ctx, msg)
+		if err != nil {
+			return ctx, err
+		}
+		if lane == "" {
+			continue
+		}
+		full, err := la.sk.IsInboundLaneFull(ctx, lane)
+		if err != nil {
+			return ctx, err
+		}
+		if full {
+			defer func() {
+				telemetry.IncrCounterWithLabels(
+					[]string{"tx", "ante", "inbound_lane_full"},
+					1,
+					[]metrics.Label{
+						telemetry.NewLabel("msg", sdk.MsgTypeURL(msg)),
+						telemetry.NewLabel("lane", lane),
+					},
+				)
+			}()
+			return ctx, ErrInboundQueueFull
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// inboundLane returns the name of the lane of the action that msg delivers to
+// SwingSet, or "" if it belongs to none.
+func (la inboundLaneAnte) inboundLane(ctx sdk.Context, msg sdk.Msg) (string, error) {
+	switch m := msg.(type) {
+	case *swingtypes.MsgOraclePush:
+		return swingtypes.InboundLaneOracle, nil
+	case *channeltypes.MsgRecvPacket:
+		return la.packetLane(ctx, m.Packet, m.Packet.DestinationPort, agoric.RoleReceiver), nil
+	case *channeltypes.MsgAcknowledgement:
+		return la.packetLane(ctx, m.Packet, m.Packet.SourcePort, agoric.RoleSender), nil
+	case *channeltypes.MsgTimeout:
+		return la.packetLane(ctx, m.Packet, m.Packet.SourcePort, agoric.RoleSender), nil
+	case *channeltypes.MsgTimeoutOnClose:
+		return la.packetLane(ctx, m.Packet, m.Packet.SourcePort, agoric.RoleSender), nil
+	}
+	camsg, ok := msg.(vm.ControllerAdmissionMsg)
+	if !ok {
+		return "", nil
+	}
+	isHighPriority, err := camsg.IsHighPriority(ctx, la.sk)
+	if err != nil {
+		return "", err
+	}
+	if isHighPriority {
+		return swingtypes.InboundLaneGovernance, nil
+	}
+	switch msg.(type) {
+	case *swingtypes.MsgWalletAction, *swingtypes.MsgWalletSpendAction, *swingtypes.MsgProvision:
+		return swingtypes.InboundLaneWallet, nil
+	}
+	return "", nil
+}
+
+// packetLane returns the ibc lane if the event of packet on our port is
+// delivered to SwingSet, or "" if it is not.  role is that of the account of a
+// transfer packet whose watching by x/vtransfer delivers the event.
+func (la inboundLaneAnte) packetLane(ctx sdk.Context, packet channeltypes.Packet, port string, role agoric.AddressRole) string {
+	module, _, err := la.pk.LookupModuleByPort(ctx, port)
+	if err != nil {
+		return ""
+	}
+	switch module {
+	case vibctypes.ModuleName:
+		return swingtypes.InboundLaneIBC
+	case transfertypes.ModuleName:
+		if la.vk.IsPacketTargeted(ctx, packet, role) {
+			return swingtypes.InboundLaneIBC
+		}
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+type mockPortKeeper map[string]string
+
+func (pk mockPortKeeper) LookupModuleByPort(ctx sdk.Context, portID string) (string, *capabilitytypes.Capability, error) {
+	module, ok := pk[portID]
+	if !ok {
+		return "", nil, fmt.Errorf("port %s not bound", portID)
+	}
+	return module, &capabilitytypes.Capability{}, nil
+}
+
+type mockVtransferKeeper map[string]bool
+
+func (vk mockVtransferKeeper) IsPacketTargeted(ctx sdk.Context, packet ibcexported.PacketI, role agoric.AddressRole) bool {
+	return vk[string(packet.GetData())+" "+string(role)]
+}
+
+func TestInboundLaneAnteHandle(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	spend := &swingtypes.MsgWalletSpendAction{Owner: owner, SpendAction: "{}"}
+	push := &swingtypes.MsgOraclePush{Submitter: owner, Feed: "ATOM-USD", UnitPrice: "1"}
+	recv := &channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{DestinationPort: "icacontroller-1"}}
+	watched := channeltypes.Packet{SourcePort: "transfer", DestinationPort: "transfer", Data: []byte("watched")}
+	unwatched := channeltypes.Packet{SourcePort: "transfer", DestinationPort: "transfer", Data: []byte("unwatched")}
+	portKeeper := mockPortKeeper{"icacontroller-1": vibctypes.ModuleName, "transfer": transfertypes.ModuleName, "icahost": "icahost"}
+	vtransferKeeper := mockVtransferKeeper{"watched Receiver": true, "watched Sender": true}
+	exec := authz.NewMsgExec(sdk.AccAddress([]byte("grantee")), []sdk.Msg{spend})
+
+	for _, tt := range []struct {
+		name                string
+		tx                  sdk.Tx
+		fullLanes           map[string]bool
+		isHighPriorityOwner bool
+		wantErr             bool
+	}{
+		{
+			name: "no-full-lanes",
+			tx:   makeTestTx(spend, push, recv),
+		},
+		{
+			name:      "other-msgs",
+			tx:        makeTestTx(&banktypes.MsgSend{}, push),
+			fullLanes: map[string]bool{swingtypes.InboundLaneWallet: true, swingtypes.InboundLaneIBC: true},
+		},
+		{
+			name:      "full-wallet",
+			tx:        makeTestTx(push, spend),
+			fullLanes: map[string]bool{swingtypes.InboundLaneWallet: true},
+			wantErr:   true,
+		},
+		{
+			name:      "full-wallet-authz",
+			tx:        makeTestTx(&exec),
+			fullLanes: map[string]bool{swingtypes.InboundLaneWallet: true},
+			wantErr:   true,
+		},
+		{
+			name:                "priority-spend",
+			tx:                  makeTestTx(spend),
+			fullLanes:           map[string]bool{swingtypes.InboundLaneWallet: true},
+			isHighPriorityOwner: true,
+		},
+		{
+			name:      "full-oracle",
+			tx:        makeTestTx(push),
+			fullLanes: map[string]bool{swingtypes.InboundLaneOracle: true},
+			wantErr:   true,
+		},
+		{
+			name:      "full-ibc",
+			tx:        makeTestTx(recv),
+			fullLanes: map[string]bool{swingtypes.InboundLaneIBC: true},
+			wantErr:   true,
+		},
+		{
+			name:      "full-ibc-watched-transfer",
+			tx:        makeTestTx(&channeltypes.MsgRecvPacket{Packet: watched}),
+			fullLanes: map[string]bool{swingtypes.InboundLaneIBC: true},
+			wantErr:   true,
+		},
+		{
+			name:      "full-ibc-watched-ack",
+			tx:        makeTestTx(&channeltypes.MsgAcknowledgement{Packet: watched}),
+			fullLanes: map[string]bool{swingtypes.InboundLaneIBC: true},
+			wantErr:   true,
+		},
+		{
+			name:      "full-ibc-vibc-timeout",
+			tx:        makeTestTx(&channeltypes.MsgTimeout{Packet: channeltypes.Packet{SourcePort: "icacontroller-1"}}),
+			fullLanes: map[string]bool{swingtypes.InboundLaneIBC: true},
+			wantErr:   true,
+		},
+		{
+			name: "full-ibc-other-packets",
+			tx: makeTestTx(
+				&channeltypes.MsgRecvPacket{Packet: unwatched},
+				&channeltypes.MsgTimeout{Packet: unwatched},
+				&channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{DestinationPort: "icahost"}},
+				&channeltypes.MsgAcknowledgement{Packet: channeltypes.Packet{SourcePort: "unbound"}},
+			),
+			fullLanes: map[string]bool{swingtypes.InboundLaneIBC: true},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(true)
+			mock := mockSwingsetKeeper{fullLanes: tt.fullLanes, isHighPriorityOwner: tt.isHighPriorityOwner}
+			decorator := NewInboundLaneDecorator(mock, portKeeper, vtransferKeeper)
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, nilAnteHandler)
+			if tt.wantErr && err == nil {
+				t.Errorf("want error, got none")
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("want no error, got %s", err.Error())
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ func TestInboundAnteHandle(t *testing.T) {
 		mempoolLimit          int32
 		errMsg                string
 		isHighPriorityOwner   bool
+		backpressured         bool
 	}{
 		{
 			name: "empty-empty",
@@ -56,6 +57,22 @@ func TestInboundAnteHandle(t *testing.T) {
 			inboundQueueLength: 10,
 			errMsg:             ErrInboundQueueFull.Error(),
 		},
+		{
+			name:               "backpressured",
+			tx:                 makeTestTx(&swingtypes.MsgInstallBundle{}),
+			inboundLimit:       10,
+			inboundQueueLength: 8,
+			backpressured:      true,
+			errMsg:             ErrInboundQueueFull.Error(),
+		},
+		{
+			name:                "backpressured-high-priority",
+			tx:                  makeTestTx(&swingtypes.MsgWalletSpendAction{}),
+			inboundLimit:        10,
+			inboundQueueLength:  8,
+			backpressured:       true,
+			isHighPriorityOwner: true,
+		},
 		{
 			name:                  "state-lookup-error",
 			tx:                    makeTestTx(&swingtypes.MsgWalletAction{}, &swingtypes.MsgWalletSpendAction{}),
@@ -140,6 +157,7 @@ func TestInboundAnteHandle(t *testing.T) {
 				mempoolLimit:          tt.mempoolLimit,
 				emptyQueueAllowed:     emptyQueueAllowed,
 				isHighPriorityOwner:   tt.isHighPriorityOwner,
+				backpressured:         tt.backpressured,
 			}
 			decorator := NewInboundDecorator(mock)
 			newCtx, err := decorator.AnteHandle(ctx, tt.tx, tt.simulate, nilAnteHandler)
@@ -186,6 +204,12 @@ type mockSwingsetKeeper struct {
 	mempoolLimit          int32
 	emptyQueueAllowed     bool
 	isHighPriorityOwner   bool
+	backpressured         bool
+	walletTokens          map[string]int
+	replayedPeers         map[string]bool
+	oracleOperators       map[string]bool
+	oraclePushFee         sdk.Coins
+	fullLanes             map[string]bool
 }
 
 var _ SwingsetKeeper = mockSwingsetKeeper{}
@@ -207,7 +231,38 @@ func (msk mockSwingsetKeeper) GetState(ctx sdk.Context) swingtypes.State {
 	}
 }
 
-func (msk mockSwingsetKeeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+func (msk mockSwingsetKeeper) IsInboundBackpressured(ctx sdk.Context) bool {
+	return msk.backpressured
+}
+
+func (msk mockSwingsetKeeper) IsDeliverInboundReplay(ctx sdk.Context, peer sdk.AccAddress, nums []uint64, ack uint64) bool {
+	return msk.replayedPeers[peer.String()]
+}
+
+func (msk mockSwingsetKeeper) ConsumeWalletSpendActionToken(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+	if msk.walletTokens == nil {
+		return true, nil
+	}
+	if msk.walletTokens[addr.String()] <= 0 {
+		return false, nil
+	}
+	msk.walletTokens[addr.String()]--
+	return true, nil
+}
+
+func (msk mockSwingsetKeeper) IsOracleOperator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return msk.oracleOperators[addr.String()]
+}
+
+func (msk mockSwingsetKeeper) GetOraclePushFee(ctx sdk.Context) sdk.Coins {
+	return msk.oraclePushFee
+}
+
+func (msk mockSwingsetKeeper) IsInboundLaneFull(ctx sdk.Context, lane string) (bool, error) {
+	return msk.fullLanes[lane], nil
+}
+
+func (msk mockSwingsetKeeper) IsPrioritySender(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
 	return msk.isHighPriorityOwner, nil
 }
 
@@ -226,3 +281,7 @@ func (msk mockSwingsetKeeper) GetSmartWalletState(ctx sdk.Context, addr sdk.AccA
 func (msk mockSwingsetKeeper) ChargeForSmartWallet(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, addr sdk.AccAddress) error {
 	return fmt.Errorf("not implemented")
 }
+
+func (msk mockSwingsetKeeper) ChargeGrantedBeans(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, granter, grantee sdk.AccAddress, beans sdkmath.Uint, msgs []sdk.Msg) error {
+	return fmt.Errorf("not implemented")
+}
@@ -0,0 +1,69 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+/*
+This AnteDecorator applies the fixed fee of oracle price updates. A Tx whose
+messages are all MsgOraclePush must be submitted by oracle operators listed in
+the swingset oracle_operators parameter, and must pay at least the
+oracle_push_fee parameter. In exchange, the validator's minimum gas prices are
+waived during CheckTx, so that the cost of a price update does not depend on
+its gas or on which validator's mempool admits it. While the oracle_push_fee
+is empty, nothing is waived, so that pushes are never free. The subsequent
+DeductFeeDecorator collects the fee as usual.
+
+Txs mixing MsgOraclePush with other messages are left to the usual fee rules.
+*/
+
+// oracleFeeAnte is an sdk.AnteDecorator which applies the fixed fee of oracle
+// price updates.
+type oracleFeeAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewOracleFeeDecorator returns an AnteDecorator which applies the fixed fee
+// of oracle price updates.
+func NewOracleFeeDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return oracleFeeAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (oa oracleFeeAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	for _, msg := range msgs {
+		if _, ok := msg.(*swingtypes.MsgOraclePush); !ok {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	for _, msg := range msgs {
+		push := msg.(*swingtypes.MsgOraclePush)
+		if !oa.sk.IsOracleOperator(ctx, push.Submitter) {
+			return ctx, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an oracle operator", push.Submitter)
+		}
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkioerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	requiredFee := oa.sk.GetOraclePushFee(ctx)
+	if !feeTx.GetFee().IsAllGTE(requiredFee) {
+		return ctx, sdkioerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient oracle push fee; got: %s required: %s", feeTx.GetFee(), requiredFee)
+	}
+
+	if ctx.IsCheckTx() && !requiredFee.IsZero() {
+		ctx = ctx.WithMinGasPrices(sdk.DecCoins{})
+	}
+	return next(ctx, tx, simulate)
+}
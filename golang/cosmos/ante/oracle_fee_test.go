@@ -0,0 +1,100 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func makeTestFeeTx(fee sdk.Coins, msgs ...proto.Message) sdk.Tx {
+	feeTx := makeTestTx(msgs...).(*tx.Tx)
+	feeTx.AuthInfo = &tx.AuthInfo{Fee: &tx.Fee{Amount: fee, GasLimit: 200000}}
+	return feeTx
+}
+
+func TestOracleFeeAnteHandle(t *testing.T) {
+	oracle := sdk.AccAddress([]byte("oracle"))
+	other := sdk.AccAddress([]byte("other"))
+	push := &swingtypes.MsgOraclePush{Submitter: oracle, Feed: "ATOM-USD", UnitPrice: "12010000"}
+	otherPush := &swingtypes.MsgOraclePush{Submitter: other, Feed: "ATOM-USD", UnitPrice: "12010000"}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000))
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ubld", sdk.NewDecWithPrec(1, 2)))
+
+	for _, tt := range []struct {
+		name          string
+		tx            sdk.Tx
+		noPushFee     bool
+		wantErr       bool
+		wantMinPrices bool
+	}{
+		{
+			name:          "other-msgs",
+			tx:            makeTestFeeTx(nil, &banktypes.MsgSend{}),
+			wantMinPrices: true,
+		},
+		{
+			name:          "mixed-msgs",
+			tx:            makeTestFeeTx(nil, push, &banktypes.MsgSend{}),
+			wantMinPrices: true,
+		},
+		{
+			name: "fixed-fee",
+			tx:   makeTestFeeTx(fee, push),
+		},
+		{
+			name: "excess-fee",
+			tx:   makeTestFeeTx(fee.Add(fee...), push, push),
+		},
+		{
+			name:    "insufficient-fee",
+			tx:      makeTestFeeTx(sdk.NewCoins(sdk.NewInt64Coin("ubld", 999)), push),
+			wantErr: true,
+		},
+		{
+			name:    "wrong-denom",
+			tx:      makeTestFeeTx(sdk.NewCoins(sdk.NewInt64Coin("uist", 1000)), push),
+			wantErr: true,
+		},
+		{
+			name:          "no-push-fee",
+			tx:            makeTestFeeTx(fee, push),
+			noPushFee:     true,
+			wantMinPrices: true,
+		},
+		{
+			name:    "not-operator",
+			tx:      makeTestFeeTx(fee, push, otherPush),
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+			mock := mockSwingsetKeeper{
+				oracleOperators: map[string]bool{oracle.String(): true},
+				oraclePushFee:   fee,
+			}
+			if tt.noPushFee {
+				mock.oraclePushFee = sdk.Coins{}
+			}
+			decorator := NewOracleFeeDecorator(mock)
+			newCtx, err := decorator.AnteHandle(ctx, tt.tx, false, nilAnteHandler)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("want error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %s", err.Error())
+			}
+			if gotMinPrices := !newCtx.MinGasPrices().IsZero(); gotMinPrices != tt.wantMinPrices {
+				t.Errorf("got min gas prices %s, want them kept: %v", newCtx.MinGasPrices(), tt.wantMinPrices)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 )
 
 // TODO: We don't have a more appropriate error type for this.
@@ -25,15 +26,22 @@ func NewAdmissionDecorator(data interface{}) AdmissionDecorator {
 	return AdmissionDecorator{data: data}
 }
 
-// AnteHandle calls CheckAdmissibility for all messages that implement the
+// AnteHandle calls CheckAdmissibility for all messages, including those
+// executed by an authz MsgExec, that implement the
 // vm.ControllerAdmissionMsg interface.  If it returns an error, refuse the
-// entire transaction.
+// entire transaction.  If the transaction has a fee granter, the admission
+// fees of its smart wallet messages are charged to the granter as allowed by
+// its x/feegrant allowance to the fee payer, which also pays the transaction
+// fee.
 func (ad AdmissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	msgs := tx.GetMsgs()
+	msgs := unwrapAuthzMsgs(tx.GetMsgs())
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.FeeGranter() != nil {
+		ctx = swingtypes.WithFeeGrant(ctx, feeTx.FeeGranter(), feeTx.FeePayer(), tx.GetMsgs())
+	}
 	errors := make([]error, 0, len(msgs))
 
 	// Ask the controller if we are rejecting messages.
-	for _, msg := range tx.GetMsgs() {
+	for _, msg := range msgs {
 		if camsg, ok := msg.(vm.ControllerAdmissionMsg); ok {
 			if err := camsg.CheckAdmissibility(ctx, ad.data); err != nil {
 				// Only let admission errors interrupt the transaction if we're not
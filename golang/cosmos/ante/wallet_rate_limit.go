@@ -0,0 +1,67 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+/*
+This AnteDecorator limits how often a single smart wallet may submit
+MsgWalletSpendAction, so that one address cannot monopolize the Swingset
+action queue. Each owner address has a token bucket, configured by the
+swingset wallet_spend_action_rate_limit parameter, from which every
+MsgWalletSpendAction takes one token. Like the inbound queue limit, the check
+runs during both CheckTx and DeliverTx, and high-priority senders are exempt.
+*/
+
+// walletRateLimitAnte is an sdk.AnteDecorator which enforces the per-address
+// wallet spend action rate limit.
+type walletRateLimitAnte struct {
+	sk SwingsetKeeper
+}
+
+// NewWalletRateLimitDecorator returns an AnteDecorator which honors the
+// per-address wallet spend action rate limit.
+func NewWalletRateLimitDecorator(sk SwingsetKeeper) sdk.AnteDecorator {
+	return walletRateLimitAnte{sk: sk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (wa walletRateLimitAnte) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range unwrapAuthzMsgs(tx.GetMsgs()) {
+		spendAction, ok := msg.(*swingtypes.MsgWalletSpendAction)
+		if !ok {
+			continue
+		}
+		isHighPriority, err := spendAction.IsHighPriority(ctx, wa.sk)
+		if err != nil {
+			return ctx, err
+		}
+		if isHighPriority {
+			continue
+		}
+		allowed, err := wa.sk.ConsumeWalletSpendActionToken(ctx, spendAction.Owner)
+		if err != nil {
+			return ctx, err
+		}
+		if !allowed {
+			defer func() {
+				telemetry.IncrCounterWithLabels(
+					[]string{"tx", "ante", "wallet_rate_limited"},
+					1,
+					[]metrics.Label{
+						telemetry.NewLabel("msg", sdk.MsgTypeURL(msg)),
+					},
+				)
+			}()
+			return ctx, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "wallet spend action rate limit exceeded for %s", spendAction.Owner)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
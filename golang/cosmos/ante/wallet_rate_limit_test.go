@@ -0,0 +1,85 @@
+package ante
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	swingtypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestWalletRateLimitAnteHandle(t *testing.T) {
+	owner1 := sdk.AccAddress([]byte("owner1"))
+	owner2 := sdk.AccAddress([]byte("owner2"))
+	spend1 := &swingtypes.MsgWalletSpendAction{Owner: owner1}
+	spend2 := &swingtypes.MsgWalletSpendAction{Owner: owner2}
+
+	for _, tt := range []struct {
+		name                string
+		tx                  sdk.Tx
+		walletTokens        map[string]int
+		isHighPriorityOwner bool
+		wantErr             bool
+		wantTokens          map[string]int
+	}{
+		{
+			name: "disabled",
+			tx:   makeTestTx(spend1, spend1),
+		},
+		{
+			name:         "ignore-other-msgs",
+			tx:           makeTestTx(&banktypes.MsgSend{}, &swingtypes.MsgWalletAction{Owner: owner1}),
+			walletTokens: map[string]int{},
+			wantTokens:   map[string]int{},
+		},
+		{
+			name:         "has-token",
+			tx:           makeTestTx(spend1),
+			walletTokens: map[string]int{owner1.String(): 2},
+			wantTokens:   map[string]int{owner1.String(): 1},
+		},
+		{
+			name:         "no-token",
+			tx:           makeTestTx(spend1),
+			walletTokens: map[string]int{owner1.String(): 0, owner2.String(): 5},
+			wantErr:      true,
+		},
+		{
+			name:         "per-message",
+			tx:           makeTestTx(spend1, spend2, spend1),
+			walletTokens: map[string]int{owner1.String(): 1, owner2.String(): 1},
+			wantErr:      true,
+		},
+		{
+			name:                "priority-bypass",
+			tx:                  makeTestTx(spend1),
+			walletTokens:        map[string]int{owner1.String(): 0},
+			isHighPriorityOwner: true,
+			wantTokens:          map[string]int{owner1.String(): 0},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background())
+			mock := mockSwingsetKeeper{
+				walletTokens:        tt.walletTokens,
+				isHighPriorityOwner: tt.isHighPriorityOwner,
+			}
+			decorator := NewWalletRateLimitDecorator(mock)
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, nilAnteHandler)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("want error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %s", err.Error())
+			}
+			if !reflect.DeepEqual(tt.walletTokens, tt.wantTokens) {
+				t.Errorf("want tokens %v, got %v", tt.wantTokens, tt.walletTokens)
+			}
+		})
+	}
+}
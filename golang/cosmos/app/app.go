@@ -8,8 +8,10 @@ import (
 	stdlog "log"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime/debug"
+	"syscall"
 	"time"
 
 	sdkioerrors "cosmossdk.io/errors"
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -44,6 +47,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -86,6 +92,9 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v6/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	ibctransfer "github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -99,6 +108,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
@@ -124,6 +134,7 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer"
 	vtransferkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/keeper"
+	vtransfertypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 	testtypes "github.com/cosmos/ibc-go/v6/testing/types"
 
 	// Import the packet forward middleware
@@ -137,6 +148,10 @@ import (
 
 const appName = "agoric"
 
+// replayProgressLogInterval is the least time between the logs of the progress
+// of a single vat transcript replay.
+const replayProgressLogInterval = 10 * time.Second
+
 const (
 	// FlagSwingStoreExportDir defines the config flag used to specify where a
 	// genesis swing-store export is expected. For start from genesis, the default
@@ -152,6 +167,15 @@ const (
 	//
 	// 2- `debug` mode will export all the available store
 	FlagSwingStoreExportMode = "swing-store-export-mode"
+	// FlagVtransferForwardingMode chooses whether the VM intercepts ICS-20
+	// packets before ("intercept-before-forwarding", the default) or after
+	// ("intercept-after-forwarding") packet-forward-middleware has had a chance
+	// to forward them.  It determines the composition of the transfer
+	// middleware stack, so it must be the same on every node.
+	FlagVtransferForwardingMode = "vtransfer-forwarding-mode"
+	// FlagModulesToExport restricts the genesis export to the named modules,
+	// exporting all of them if empty.
+	FlagModulesToExport = "modules-to-export"
 )
 
 var (
@@ -179,6 +203,7 @@ var (
 			swingsetclient.CoreEvalProposalHandler,
 		}),
 		params.AppModuleBasic{},
+		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
@@ -188,6 +213,7 @@ var (
 		ibctransfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
 		packetforward.AppModuleBasic{},
 		swingset.AppModuleBasic{},
 		vstorage.AppModuleBasic{},
@@ -198,18 +224,20 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		icatypes.ModuleName:            nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		vbank.ModuleName:               {authtypes.Minter, authtypes.Burner},
-		vbanktypes.ReservePoolName:     nil,
-		vbanktypes.ProvisionPoolName:   nil,
-		vbanktypes.GiveawayPoolName:    nil,
+		authtypes.FeeCollectorName:          nil,
+		distrtypes.ModuleName:               nil,
+		icatypes.ModuleName:                 nil,
+		ibcfeetypes.ModuleName:              nil,
+		minttypes.ModuleName:                {authtypes.Minter},
+		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                 {authtypes.Burner},
+		ibctransfertypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		vbank.ModuleName:                    {authtypes.Minter, authtypes.Burner},
+		vbanktypes.ReservePoolName:          nil,
+		vbanktypes.ProvisionPoolName:        nil,
+		vbanktypes.GiveawayPoolName:         nil,
+		swingsettypes.BundleStoragePoolName: nil,
 	}
 )
 
@@ -230,12 +258,34 @@ type GaiaApp struct { // nolint: golint
 
 	controllerInited bool
 	bootstrapNeeded  bool
-	swingsetPort     int
-	vbankPort        int
-	vibcPort         int
-	vstoragePort     int
-	vlocalchainPort  int
-	vtransferPort    int
+
+	// bridgeJournal, if non-nil, records the messages crossing the bridge to
+	// the VM.
+	bridgeJournal *vm.Journal
+	// bridgeRecorder, if non-nil, records the messages crossing the bridge to
+	// the VM for replay.
+	bridgeRecorder *vm.Recorder
+	// vmHealth, if non-nil, pings the VM once the controller is inited.
+	vmHealth *vm.HealthMonitor
+	// blockTracer, if non-nil, traces the messages crossing the bridge.
+	blockTracer *vm.BlockTracer
+	// bridgeMetrics measures and logs the messages crossing the bridge.
+	bridgeMetrics *vm.BridgeMetrics
+	// logConfigReloader applies the logging options reread from
+	// logConfigPath, if any, on SIGHUP.
+	logConfigReloader *vm.LogConfigReloader
+	logConfigPath     string
+	// bridgeHashChain digests the bridge messages of each block, if enabled
+	// by the swingset bridge_message_hash_chain param.
+	bridgeHashChain *vm.HashChain
+
+	swingsetPort    int
+	entropyPort     int
+	vbankPort       int
+	vibcPort        int
+	vstoragePort    int
+	vlocalchainPort int
+	vtransferPort   int
 
 	upgradeDetails *upgradeDetails
 
@@ -256,6 +306,7 @@ type GaiaApp struct { // nolint: golint
 	StakingKeeper    stakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
 	MintKeeper       mintkeeper.Keeper
+	CrisisKeeper     crisiskeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
 	GovKeeper        govkeeper.Keeper
 	UpgradeKeeper    upgradekeeper.Keeper
@@ -266,6 +317,7 @@ type GaiaApp struct { // nolint: golint
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	IBCFeeKeeper        ibcfeekeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
 	AuthzKeeper         authzkeeper.Keeper
 
@@ -352,6 +404,7 @@ func NewAgoricApp(
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, packetforwardtypes.StoreKey,
 		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey, icahosttypes.StoreKey,
+		ibcfeetypes.StoreKey,
 		swingset.StoreKey, vstorage.StoreKey, vibc.StoreKey,
 		vlocalchain.StoreKey, vtransfer.StoreKey, vbank.StoreKey,
 	)
@@ -371,6 +424,47 @@ func NewAgoricApp(
 		memKeys:           memKeys,
 	}
 
+	// Pings and logging changes bypass the bridge journal, since they are
+	// outside of consensus.
+	app.vmHealth = newVMHealthMonitor(appOpts, sendToController)
+	if homePath != "" {
+		app.logConfigPath = filepath.Join(homePath, "config", "app.toml")
+	}
+
+	// Trace bridge messages innermost, so that the trace context given to the
+	// VM is neither journaled nor digested.
+	blockTracer := newBlockTracer(logger)
+	if blockTracer != nil {
+		sendToController = blockTracer.WrapSender(sendToController)
+		agdServer.SetBlockTracer(blockTracer)
+		app.blockTracer = blockTracer
+	}
+
+	// Measure bridge messages within our own journaling, so that their
+	// latencies exclude it.
+	app.bridgeMetrics = newBridgeMetrics(logger, appOpts)
+	app.logConfigReloader = vm.NewLogConfigReloader(app.bridgeMetrics, sendToController)
+	sendToController = app.bridgeMetrics.WrapSender(sendToController)
+	agdServer.SetBridgeMetrics(app.bridgeMetrics)
+
+	if journal := openBridgeJournal(logger, appOpts); journal != nil {
+		sendToController = journal.WrapSender(sendToController)
+		agdServer.SetJournal(journal)
+		app.bridgeJournal = journal
+	}
+
+	if recorder := openBridgeRecorder(logger, appOpts); recorder != nil {
+		sendToController = recorder.WrapSender(sendToController)
+		agdServer.SetRecorder(recorder)
+		app.bridgeRecorder = recorder
+	}
+
+	// Only the messages sent by callToController (below) or received during
+	// them belong in a block's hash chain.
+	app.bridgeHashChain = vm.NewHashChain()
+	agdServer.SetHashChain(app.bridgeHashChain)
+	sendToControllerForBlock := app.bridgeHashChain.WrapSender(sendToController)
+
 	app.ParamsKeeper = initParamsKeeper(
 		appCodec,
 		legacyAmino,
@@ -451,6 +545,12 @@ func NewAgoricApp(
 		&stakingKeeper,
 		app.GetSubspace(slashingtypes.ModuleName),
 	)
+	app.CrisisKeeper = crisiskeeper.NewKeeper(
+		app.GetSubspace(crisistypes.ModuleName),
+		invCheckPeriod,
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+	)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
 		keys[upgradetypes.StoreKey],
@@ -481,7 +581,7 @@ func NewAgoricApp(
 		app.CheckControllerInited(true)
 		// We use SwingSet-level metering to charge the user for the call.
 		defer app.AgdServer.SetControllerContext(ctx)()
-		return sendToController(sdk.WrapSDKContext(ctx), true, jsonRequest)
+		return sendToControllerForBlock(sdk.WrapSDKContext(ctx), true, jsonRequest)
 	}
 
 	setBootstrapNeeded := func() {
@@ -489,8 +589,12 @@ func NewAgoricApp(
 	}
 
 	app.VstorageKeeper = vstorage.NewKeeper(
-		keys[vstorage.StoreKey],
-	)
+		keys[vstorage.StoreKey], app.GetSubspace(vstorage.ModuleName),
+	).WithMaxQueryGas(vstorageMaxQueryGas(appOpts)).
+		WithStreamCellHistory(openStreamCellHistory(homePath, appOpts))
+	if proofQuerier, ok := app.CommitMultiStore().(storetypes.Queryable); ok {
+		app.VstorageKeeper = app.VstorageKeeper.WithProofQuerier(proofQuerier)
+	}
 	app.vstoragePort = app.AgdServer.MustRegisterPortHandler("vstorage", vstorage.NewStorageHandler(app.VstorageKeeper))
 
 	// The SwingSetKeeper is the Keeper from the SwingSet module
@@ -498,9 +602,14 @@ func NewAgoricApp(
 		appCodec, keys[swingset.StoreKey], app.GetSubspace(swingset.ModuleName),
 		app.AccountKeeper, app.BankKeeper,
 		app.VstorageKeeper, vbanktypes.ReservePoolName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		callToController,
-	)
+	).WithVMHealth(app.vmHealth).WithBridgeHashChain(app.bridgeHashChain).
+		WithKernelPanicMarkerDir(KernelPanicMarkerDir(homePath)).WithBlockTracer(blockTracer).
+		WithReplayTracker(swingsetkeeper.NewReplayTracker(logger.With("module", "x/swingset"), replayProgressLogInterval)).
+		WithFeegrantKeeper(app.FeeGrantKeeper)
 	app.swingsetPort = app.AgdServer.MustRegisterPortHandler("swingset", swingset.NewPortHandler(app.SwingSetKeeper))
+	app.entropyPort = app.AgdServer.MustRegisterPortHandler("entropy", swingset.NewEntropyPortHandler(app.SwingSetKeeper))
 
 	app.SwingStoreExportsHandler = *swingsetkeeper.NewSwingStoreExportsHandler(
 		app.Logger(),
@@ -530,24 +639,42 @@ func NewAgoricApp(
 		bApp,
 		&app.SwingStoreExportsHandler,
 		getSwingStoreExportDataShadowCopyReader,
+	).WithExportWorkers(swingStoreExportWorkers(appOpts))
+
+	// The ICS-29 fee keeper escrows the relayer incentives of packets on
+	// fee-enabled channels, which are only those of vIBC.
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, keys[ibcfeetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
 	)
 
 	app.VibcKeeper = vibc.NewKeeper(
 		appCodec,
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-	).WithScope(keys[vibc.StoreKey], scopedVibcKeeper, app.SwingSetKeeper.PushAction)
+		app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ClientKeeper,
+	).WithScope(keys[vibc.StoreKey], scopedVibcKeeper, app.SwingSetKeeper.PushAction).
+		WithFeeKeeper(app.IBCFeeKeeper)
 
 	vibcModule := vibc.NewAppModule(app.VibcKeeper, app.BankKeeper)
 	vibcIBCModule := vibc.NewIBCModule(app.VibcKeeper)
 	app.vibcPort = app.AgdServer.MustRegisterPortHandler("vibc", vibc.NewReceiver(app.VibcKeeper))
 
+	vtransferForwardingMode, err := vtransfertypes.ParseForwardingMode(cast.ToString(appOpts.Get(FlagVtransferForwardingMode)))
+	if err != nil {
+		panic(err)
+	}
 	app.VtransferKeeper = vtransferkeeper.NewKeeper(
 		appCodec,
 		keys[vtransfer.StoreKey],
 		app.VibcKeeper,
 		scopedTransferKeeper,
 		app.SwingSetKeeper.PushAction,
-	)
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		vtransferForwardingMode,
+	).WithMemoValidator(vtransfertypes.NewMemoValidator(vtransfertypes.DefaultMemoSizeLimit)).
+		WithDepositProvisioner(app.SwingSetKeeper)
 
 	vtransferModule := vtransfer.NewAppModule(app.VtransferKeeper)
 	app.vtransferPort = app.AgdServer.MustRegisterPortHandler("vtransfer",
@@ -556,7 +683,7 @@ func NewAgoricApp(
 
 	app.VbankKeeper = vbank.NewKeeper(
 		appCodec, keys[vbank.StoreKey], app.GetSubspace(vbank.ModuleName),
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName,
 		app.SwingSetKeeper.PushAction,
 	)
 	vbankModule := vbank.NewAppModule(app.VbankKeeper)
@@ -626,7 +753,9 @@ func NewAgoricApp(
 		scopedICAHostKeeper,
 		app.MsgServiceRouter(),
 	)
-	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	// Packets for interchain accounts controlled by vats are also delivered to
+	// them through vIBC.
+	icaHostIBCModule := vibc.NewICAHostModule(icahost.NewIBCModule(app.ICAHostKeeper), app.VibcKeeper)
 	icaModule := ica.NewAppModule(nil, &app.ICAHostKeeper)
 
 	ics20TransferModule := ibctransfer.NewAppModule(app.TransferKeeper)
@@ -637,21 +766,31 @@ func NewAgoricApp(
 	// Add an IBC route for the ICA Host.
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 
-	// Add an IBC route for vIBC.
-	ibcRouter.AddRoute(vibc.ModuleName, vibcIBCModule)
+	// Add an IBC route for vIBC, which also answers the requests of remote
+	// chains for attestations of vstorage data, wrapped by the ICS-29 fee
+	// middleware so that vats may incentivize relayers.
+	ibcRouter.AddRoute(vibc.ModuleName, ibcfee.NewIBCMiddleware(
+		vibc.NewVstorageAttestationModule(vibcIBCModule, app.VibcKeeper, app.VstorageKeeper),
+		app.IBCFeeKeeper,
+	))
 
 	// Add an IBC route for ICS-20 fungible token transfers, wrapping base
-	// Cosmos functionality with middleware (from the inside out, Cosmos
-	// packet-forwarding and then our own "vtransfer").
+	// Cosmos functionality with middleware (Cosmos packet-forwarding and our
+	// own "vtransfer", in the order given by FlagVtransferForwardingMode).
 	var ics20TransferIBCModule ibcporttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
-	ics20TransferIBCModule = packetforward.NewIBCMiddleware(
+	ics20TransferIBCModule = vtransfer.NewIBCMiddlewareWithForwarding(
 		ics20TransferIBCModule,
-		app.PacketForwardKeeper,
-		0, // retries on timeout
-		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp, // forward timeout
-		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,  // refund timeout
+		app.VtransferKeeper,
+		func(ibcModule ibcporttypes.IBCModule) ibcporttypes.IBCModule {
+			return packetforward.NewIBCMiddleware(
+				ibcModule,
+				app.PacketForwardKeeper,
+				0, // retries on timeout
+				packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp, // forward timeout
+				packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,  // refund timeout
+			)
+		},
 	)
-	ics20TransferIBCModule = vtransfer.NewIBCMiddleware(ics20TransferIBCModule, app.VtransferKeeper)
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ics20TransferIBCModule)
 
 	// Seal the router
@@ -683,6 +822,15 @@ func NewAgoricApp(
 
 	swingStoreExportDir := cast.ToString(appOpts.Get(FlagSwingStoreExportDir))
 	swingStoreExportMode := cast.ToString(appOpts.Get(FlagSwingStoreExportMode))
+	// The genesis file is configured relative to the home directory, as by
+	// Tendermint's genesis_file.
+	genesisFile := filepath.Join("config", "genesis.json")
+	if file := cast.ToString(appOpts.Get("genesis_file")); file != "" {
+		genesisFile = file
+	}
+	if !filepath.IsAbs(genesisFile) {
+		genesisFile = filepath.Join(homePath, genesisFile)
+	}
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -699,6 +847,7 @@ func NewAgoricApp(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
+		crisis.NewAppModule(&app.CrisisKeeper, cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -710,6 +859,7 @@ func NewAgoricApp(
 		params.NewAppModule(app.ParamsKeeper),
 		ics20TransferModule,
 		icaModule,
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		packetforward.NewAppModule(app.PacketForwardKeeper),
 		vstorage.NewAppModule(app.VstorageKeeper),
 		swingset.NewAppModule(
@@ -719,6 +869,8 @@ func NewAgoricApp(
 			app.ensureControllerInited,
 			swingStoreExportDir,
 			swingStoreExportMode,
+			genesisFile,
+			swingsetGenesisOverrides(appOpts),
 		),
 		vibcModule,
 		vbankModule,
@@ -743,12 +895,14 @@ func NewAgoricApp(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
+		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -764,6 +918,7 @@ func NewAgoricApp(
 	)
 	app.mm.SetOrderEndBlockers(
 		// Cosmos-SDK modules appear roughly in the order used by simapp and gaiad.
+		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		// vibc is an Agoric-specific IBC app, so group it here with other IBC apps.
@@ -772,6 +927,7 @@ func NewAgoricApp(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		feegrant.ModuleName,
 		authz.ModuleName,
@@ -813,10 +969,12 @@ func NewAgoricApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
+		crisistypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		evidencetypes.ModuleName,
 		feegrant.ModuleName,
 		authz.ModuleName,
@@ -834,6 +992,7 @@ func NewAgoricApp(
 	app.mm.SetOrderInitGenesis(moduleOrderForGenesisAndUpgrade...)
 	app.mm.SetOrderMigrations(moduleOrderForGenesisAndUpgrade...)
 
+	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -858,6 +1017,9 @@ func NewAgoricApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		ics20TransferModule,
+		swingset.NewAppModuleSimulation(app.SwingSetKeeper, app.AccountKeeper, app.BankKeeper),
+		vstorage.NewAppModuleSimulation(app.VstorageKeeper, app.AccountKeeper, app.BankKeeper),
+		vbank.NewAppModuleSimulation(app.VbankKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -880,6 +1042,7 @@ func NewAgoricApp(
 			AdmissionData:    app.SwingSetKeeper,
 			FeeCollectorName: vbanktypes.ReservePoolName,
 			SwingsetKeeper:   app.SwingSetKeeper,
+			VtransferKeeper:  app.VtransferKeeper,
 		},
 	)
 	if err != nil {
@@ -891,31 +1054,7 @@ func NewAgoricApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	for _, name := range upgradeNamesOfThisVersion {
-		app.UpgradeKeeper.SetUpgradeHandler(
-			name,
-			unreleasedUpgradeHandler(app, name),
-		)
-	}
-
-	// At this point we don't have a way to read from the store, so we have to
-	// rely on data saved by the x/upgrade module in the previous software.
-	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
-	if err != nil {
-		panic(err)
-	}
-	// Store migrations can only run once, so we use a notion of "primary upgrade
-	// name" to trigger them. Testnets may end up upgrading from one rc to
-	// another, which shouldn't re-run store upgrades.
-	if isPrimaryUpgradeName(upgradeInfo.Name) && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added:   []string{},
-			Deleted: []string{},
-		}
-
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
-	}
+	registerUpgradeHandlers(app)
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -959,6 +1098,11 @@ type upgradeDetails struct {
 	CoreProposals *vm.CoreProposals `json:"coreProposals,omitempty"`
 }
 
+type bridgeJournalCheckpoint struct {
+	Height int64  `json:"height"`
+	Seq    uint64 `json:"seq"`
+}
+
 type cosmosInitAction struct {
 	vm.ActionHeader `actionType:"AG_COSMOS_INIT"`
 	ChainID         string                   `json:"chainID"`
@@ -967,8 +1111,12 @@ type cosmosInitAction struct {
 	ResolvedConfig  *swingset.SwingsetConfig `json:"resolvedConfig"`
 	SupplyCoins     sdk.Coins                `json:"supplyCoins"`
 	UpgradeDetails  *upgradeDetails          `json:"upgradeDetails,omitempty"`
+	// BridgeJournal is the last checkpoint of the bridge journal, if any, for
+	// the VM to verify against the sequence number it saved with that block.
+	BridgeJournal *bridgeJournalCheckpoint `json:"bridgeJournal,omitempty"`
 	// CAVEAT: Every property ending in "Port" is saved in chain-main.js/portNums
 	// with a key consisting of this name with the "Port" stripped.
+	EntropyPort     int `json:"entropyPort"`
 	StoragePort     int `json:"storagePort"`
 	SwingsetPort    int `json:"swingsetPort"`
 	VbankPort       int `json:"vbankPort"`
@@ -980,6 +1128,19 @@ type cosmosInitAction struct {
 // Name returns the name of the App
 func (app *GaiaApp) Name() string { return app.BaseApp.Name() }
 
+// Close shuts down the tracing of bridge messages, if any, exporting the spans
+// yet to be exported.  The start command calls it on exit.
+func (app *GaiaApp) Close() error {
+	if app.blockTracer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := app.blockTracer.Shutdown(ctx); err != nil {
+			app.Logger().Error("failed to shut down bridge tracing", "err", err)
+		}
+	}
+	return app.BaseApp.Close()
+}
+
 // CheckControllerInited exits if the controller initialization state does not match `expected`.
 func (app *GaiaApp) CheckControllerInited(expected bool) {
 	if app.controllerInited != expected {
@@ -1010,6 +1171,7 @@ func (app *GaiaApp) initController(ctx sdk.Context, bootstrap bool) {
 		SupplyCoins:    sdk.NewCoins(app.BankKeeper.GetSupply(ctx, "uist")),
 		UpgradeDetails: app.upgradeDetails,
 		// See CAVEAT in cosmosInitAction.
+		EntropyPort:     app.entropyPort,
 		StoragePort:     app.vstoragePort,
 		SwingsetPort:    app.swingsetPort,
 		VbankPort:       app.vbankPort,
@@ -1017,6 +1179,11 @@ func (app *GaiaApp) initController(ctx sdk.Context, bootstrap bool) {
 		VlocalchainPort: app.vlocalchainPort,
 		VtransferPort:   app.vtransferPort,
 	}
+	if app.bridgeJournal != nil {
+		if height, seq, ok := app.bridgeJournal.LastCheckpoint(); ok {
+			action.BridgeJournal = &bridgeJournalCheckpoint{Height: height, Seq: seq}
+		}
+	}
 	// This uses `BlockingSend` as a friendly wrapper for `sendToController`
 	//
 	// CAVEAT: we are restarting after an in-consensus halt or just because this
@@ -1037,6 +1204,205 @@ func (app *GaiaApp) initController(ctx sdk.Context, bootstrap bool) {
 	if !res {
 		panic(fmt.Errorf("controller negative init response"))
 	}
+
+	if app.vmHealth != nil {
+		app.vmHealth.Start()
+	}
+	app.reloadLogConfigOnSIGHUP()
+}
+
+// newVMHealthMonitor returns a monitor which pings the VM through
+// sendToController as configured by the swingset configuration, or nil if the
+// VM is not to be pinged.
+func newVMHealthMonitor(appOpts servertypes.AppOptions, sendToController vm.Sender) *vm.HealthMonitor {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if swingsetConfig == nil || swingsetConfig.VmHealthCheckInterval == 0 {
+		return nil
+	}
+	return vm.NewHealthMonitor(
+		sendToController,
+		swingsetConfig.VmHealthCheckInterval,
+		swingsetConfig.VmHealthCheckTimeout,
+	)
+}
+
+// vstorageMaxQueryGas returns the gas limit of each vstorage gRPC query, as
+// configured by the vstorage configuration.
+func vstorageMaxQueryGas(appOpts servertypes.AppOptions) uint64 {
+	vstorageConfig, err := vstorage.VstorageConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if vstorageConfig == nil {
+		return vstorage.DefaultMaxQueryGas
+	}
+	return vstorageConfig.MaxQueryGas
+}
+
+// openStreamCellHistory opens the node-local database of past stream cells in
+// the data directory, if the vstorage configuration retains any.
+func openStreamCellHistory(homePath string, appOpts servertypes.AppOptions) *vstorage.StreamCellHistory {
+	vstorageConfig, err := vstorage.VstorageConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if vstorageConfig == nil || vstorageConfig.StreamCellHistoryDepth == 0 || homePath == "" {
+		return nil
+	}
+	historyDB, err := dbm.NewDB(
+		vstorage.StreamCellHistoryDBName,
+		server.GetAppDBBackend(appOpts),
+		filepath.Join(homePath, "data"),
+	)
+	if err != nil {
+		panic(sdkioerrors.Wrap(err, "cannot open stream cell history"))
+	}
+	return vstorage.NewStreamCellHistory(historyDB, vstorageConfig.StreamCellHistoryDepth)
+}
+
+// newBridgeMetrics returns a BridgeMetrics which logs the bridge messages
+// slower than the swingset configuration's bridge-slow-call-threshold, or all
+// of them if so configured.
+func newBridgeMetrics(logger log.Logger, appOpts servertypes.AppOptions) *vm.BridgeMetrics {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	bridgeMetrics := vm.NewBridgeMetrics(logger.With("module", "bridge"), 0)
+	if swingsetConfig != nil {
+		bridgeMetrics.SetLogging(swingsetConfig.BridgeSlowCallThreshold, swingsetConfig.BridgeLogMessages)
+	}
+	return bridgeMetrics
+}
+
+// reloadLogConfigOnSIGHUP arranges for the logging options of the swingset
+// configuration to be reread from app.toml whenever the process receives
+// SIGHUP, so that they can be changed without the long kernel replay of a
+// restart.  They are applied at the start of the next block, so as not to
+// message the VM concurrently with the block.  It does nothing for an app
+// without a home.
+func (app *GaiaApp) reloadLogConfigOnSIGHUP() {
+	if app.logConfigPath == "" {
+		return
+	}
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	go func() {
+		for range hangups {
+			config, err := readLogConfig(app.logConfigPath)
+			if err != nil {
+				app.Logger().Error("failed to reload logging configuration", "file", app.logConfigPath, "err", err)
+				continue
+			}
+			app.logConfigReloader.Request(config)
+		}
+	}()
+}
+
+// readLogConfig returns the logging options of the swingset configuration in
+// the app.toml at path.
+func readLogConfig(path string) (vm.LogConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return vm.LogConfig{}, err
+	}
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(v)
+	if err != nil {
+		return vm.LogConfig{}, err
+	}
+	return vm.LogConfig{
+		SlowCallThreshold: swingsetConfig.BridgeSlowCallThreshold,
+		LogMessages:       swingsetConfig.BridgeLogMessages,
+		SlogExcludeTypes:  swingsetConfig.SlogExcludeTypes,
+	}, nil
+}
+
+// KernelPanicMarkerDir returns the directory in which a node whose SwingSet
+// kernel panicked records a swingsetkeeper.KernelPanicMarker, or "" if the
+// node has no home.
+func KernelPanicMarkerDir(homePath string) string {
+	if homePath == "" {
+		return ""
+	}
+	return filepath.Join(homePath, "data")
+}
+
+// swingStoreExportWorkers returns the number of swing-store export artifacts
+// to encode concurrently for state-sync snapshots, as configured by the
+// swingset configuration.
+func swingStoreExportWorkers(appOpts servertypes.AppOptions) int {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if swingsetConfig == nil {
+		return 1
+	}
+	return swingsetConfig.ExportWorkers
+}
+
+// swingsetGenesisOverrides returns the path of the file of kernel and vstorage
+// overrides to apply at genesis, as configured by the swingset configuration, if any.
+func swingsetGenesisOverrides(appOpts servertypes.AppOptions) string {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if swingsetConfig == nil {
+		return ""
+	}
+	return swingsetConfig.GenesisOverrides
+}
+
+// openBridgeJournal opens the bridge journal named by the swingset
+// configuration, if any, reporting any messages that were left unanswered when
+// the node last stopped.
+func openBridgeJournal(logger log.Logger, appOpts servertypes.AppOptions) *vm.Journal {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if swingsetConfig == nil || swingsetConfig.BridgeJournal == "" {
+		return nil
+	}
+	journal, divergence, err := vm.OpenJournal(swingsetConfig.BridgeJournal)
+	if err != nil {
+		panic(sdkioerrors.Wrap(err, "cannot open bridge journal"))
+	}
+	if divergence != nil {
+		logger.Error("bridge to the VM diverged before restart",
+			"journal", swingsetConfig.BridgeJournal,
+			"err", divergence,
+		)
+		for _, entry := range divergence.Unanswered {
+			logger.Error("unanswered bridge message", "kind", entry.Kind, "seq", entry.Seq, "port", entry.Port, "data", entry.Data)
+		}
+	}
+	return journal
+}
+
+// openBridgeRecorder opens the recorder of bridge traffic for replay named by
+// the swingset configuration, if any.
+// Since recording must not affect consensus, a recorder which cannot be
+// opened is logged and skipped.
+func openBridgeRecorder(logger log.Logger, appOpts servertypes.AppOptions) *vm.Recorder {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if swingsetConfig == nil || swingsetConfig.RecordDir == "" {
+		return nil
+	}
+	recorder, err := vm.OpenRecorder(swingsetConfig.RecordDir, swingsetConfig.RecordRetainBlocks, logger)
+	if err != nil {
+		logger.Error("cannot open bridge recorder", "dir", swingsetConfig.RecordDir, "err", err)
+		return nil
+	}
+	return recorder
 }
 
 // ensureControllerInited inits the controller if needed. It's used by the
@@ -1057,6 +1423,14 @@ func (app *GaiaApp) ensureControllerInited(ctx sdk.Context) {
 
 // BeginBlocker application updates every begin block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.bridgeRecorder != nil {
+		app.bridgeRecorder.StartBlock(ctx.BlockHeight())
+	}
+	if applied, err := app.logConfigReloader.Apply(ctx.Context()); err != nil {
+		app.Logger().Error("failed to reload logging configuration", "file", app.logConfigPath, "err", err)
+	} else if applied {
+		app.Logger().Info("reloaded logging configuration", "file", app.logConfigPath)
+	}
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -1104,7 +1478,11 @@ func (app *GaiaApp) Commit() abci.ResponseCommit {
 	}
 
 	// Frontrun the BaseApp's Commit method
-	err = swingset.CommitBlock(app.SwingSetKeeper)
+	var bridgeJournalSeq uint64
+	if app.bridgeJournal != nil {
+		bridgeJournalSeq = app.bridgeJournal.Commit()
+	}
+	err = swingset.CommitBlock(app.SwingSetKeeper, bridgeJournalSeq)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -1116,6 +1494,18 @@ func (app *GaiaApp) Commit() abci.ResponseCommit {
 		panic(err.Error())
 	}
 
+	// Between blocks, the bridge is free for pinging the VM.
+	if app.vmHealth != nil {
+		app.vmHealth.MaybePing()
+	}
+
+	// Every message of the block has now been answered.
+	if app.bridgeJournal != nil {
+		if err := app.bridgeJournal.Checkpoint(app.LastBlockHeight()); err != nil {
+			app.Logger().Error("failed to checkpoint bridge journal", "err", err)
+		}
+	}
+
 	if snapshotHeight > 0 {
 		err = app.SwingSetSnapshotter.InitiateSnapshot(snapshotHeight)
 
@@ -1278,6 +1668,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(minttypes.ModuleName)
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
+	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypesv1.ParamKeyTable())
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
@@ -1285,6 +1676,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(swingset.ModuleName)
 	paramsKeeper.Subspace(vbank.ModuleName)
+	paramsKeeper.Subspace(vstorage.ModuleName)
 
 	return paramsKeeper
 }
@@ -1,7 +1,9 @@
 package gaia
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"log"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -17,6 +19,15 @@ import (
 // file.
 func (app *GaiaApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
+) (servertypes.ExportedApp, error) {
+	return app.ExportAppStateAndValidatorsForModules(forZeroHeight, jailAllowedAddrs, nil)
+}
+
+// ExportAppStateAndValidatorsForModules is like ExportAppStateAndValidators,
+// but only exports the genesis state of the named modules, or of all modules
+// if modulesToExport is empty.
+func (app *GaiaApp) ExportAppStateAndValidatorsForModules(
+	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
@@ -29,8 +40,7 @@ func (app *GaiaApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
+	appState, err := app.exportGenesisForModules(ctx, modulesToExport)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
@@ -44,6 +54,47 @@ func (app *GaiaApp) ExportAppStateAndValidators(
 	}, err
 }
 
+// exportGenesisForModules writes the app state as a JSON object of the
+// modules' genesis states.  Each module's state is appended as soon as it is
+// exported, so that at most one module's state is held in memory beside the
+// result.
+func (app *GaiaApp) exportGenesisForModules(ctx sdk.Context, modulesToExport []string) (json.RawMessage, error) {
+	selected := make(map[string]bool, len(modulesToExport))
+	for _, name := range modulesToExport {
+		if _, ok := app.mm.Modules[name]; !ok {
+			return nil, fmt.Errorf("unknown module %q to export", name)
+		}
+		selected[name] = true
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	// Export in OrderExportGenesis, since later modules may depend on the state
+	// left behind by earlier ones.
+	for _, name := range app.mm.OrderExportGenesis {
+		if len(selected) > 0 && !selected[name] {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		state := app.mm.Modules[name].ExportGenesis(ctx, app.appCodec)
+		if err := json.Compact(&buf, state); err != nil {
+			return nil, fmt.Errorf("cannot encode %s genesis: %w", name, err)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 // prepForZeroHeightGenesis prepares for a fresh start at zero height.
 //
 // NOTE: Zero height genesis is a temporary feature which will be deprecated
@@ -0,0 +1,34 @@
+package gaia
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReadLogConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.toml")
+	appToml := `
+[swingset]
+bridge-slow-call-threshold = "2s"
+bridge-log-messages = true
+slog-exclude-types = ["syscall", "syscall-result"]
+`
+	if err := os.WriteFile(path, []byte(appToml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config, err := readLogConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.SlowCallThreshold != 2*time.Second || !config.LogMessages ||
+		!reflect.DeepEqual(config.SlogExcludeTypes, []string{"syscall", "syscall-result"}) {
+		t.Errorf("got config %+v", config)
+	}
+
+	if _, err := readLogConfig(filepath.Join(t.TempDir(), "missing.toml")); err == nil {
+		t.Error("read the config of a missing file")
+	}
+}
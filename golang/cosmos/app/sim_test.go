@@ -3,15 +3,19 @@ package gaia_test
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 
 	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/helpers"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -25,6 +29,17 @@ func init() {
 	simapp.GetSimulatorFlags()
 }
 
+// newSimApp returns an app whose VM is the mock VM, which acknowledges every
+// message without running SwingSet, and which asserts the invariants every
+// invCheckPeriod blocks.
+func newSimApp(logger log.Logger, db dbm.DB, invCheckPeriod uint) *gaia.GaiaApp {
+	return gaia.NewAgoricApp(
+		vm.NewTransportSender(vm.NewMockTransport()), vm.NewAgdServer(),
+		logger, db, nil, true, map[int64]bool{}, gaia.DefaultNodeHome, invCheckPeriod,
+		gaia.MakeEncodingConfig(), simapp.EmptyAppOptions{}, interBlockCacheOpt(),
+	)
+}
+
 // Profile with:
 // /usr/local/go/bin/go test -benchmem -run=^$ github.com/cosmos/cosmos-sdk/GaiaApp -bench ^BenchmarkFullAppSimulation$ -Commit=true -cpuprofile cpu.out
 func BenchmarkFullAppSimulation(b *testing.B) {
@@ -41,7 +56,7 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 		}
 	}()
 
-	app := gaia.NewGaiaApp(logger, db, nil, true, map[int64]bool{}, gaia.DefaultNodeHome, simapp.FlagPeriodValue, gaia.MakeEncodingConfig(), simapp.EmptyAppOptions{}, interBlockCacheOpt())
+	app := newSimApp(logger, db, simapp.FlagPeriodValue)
 
 	// Run randomized simulation:w
 	_, simParams, simErr := simulation.SimulateFromSeed(
@@ -76,6 +91,55 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// TestAppSimulationWithMockVM runs a short simulation whether or not the
+// simulator is enabled, asserting the invariants every block and checking that
+// the wallet actions it generates reach the action queue of the mock VM.
+func TestAppSimulationWithMockVM(t *testing.T) {
+	config := simapp.NewConfigFromFlags()
+	config.ChainID = helpers.SimAppChainID
+	config.InitialBlockHeight = 1
+	config.NumBlocks = 10
+	config.BlockSize = 20
+	config.Seed = 42
+	config.Commit = true
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+
+	app := newSimApp(log.NewNopLogger(), dbm.NewMemDB(), 1)
+	routes := map[string]bool{}
+	for _, route := range app.CrisisKeeper.Routes() {
+		routes[route.FullRoute()] = true
+	}
+	require.True(t, routes["vbank/module-account-purses"], "vbank invariants are not registered")
+	_, _, err := simulation.SimulateFromSeed(
+		t,
+		io.Discard,
+		app.BaseApp,
+		simapp.AppStateFn(app.AppCodec(), app.SimulationManager()),
+		simulation2.RandomAccounts,
+		simapp.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{ChainID: config.ChainID})
+	walletActions := 0
+	for _, entry := range app.VstorageKeeper.ExportStorageFromPrefix(ctx, keeper.StoragePathActionQueue) {
+		var record struct {
+			Action struct {
+				Type string `json:"type"`
+			} `json:"action"`
+		}
+		if json.Unmarshal([]byte(entry.Value), &record) == nil && record.Action.Type == "WALLET_ACTION" {
+			walletActions++
+		}
+	}
+	require.Positive(t, walletActions, "no wallet actions reached the mock VM")
+}
+
 // // TODO: Make another test for the fuzzer itself, which just has noOp txs
 // // and doesn't depend on the application.
 func TestAppStateDeterminism(t *testing.T) {
@@ -106,7 +170,7 @@ func TestAppStateDeterminism(t *testing.T) {
 			}
 
 			db := dbm.NewMemDB()
-			app := gaia.NewGaiaApp(logger, db, nil, true, map[int64]bool{}, gaia.DefaultNodeHome, simapp.FlagPeriodValue, gaia.MakeEncodingConfig(), simapp.EmptyAppOptions{}, interBlockCacheOpt())
+			app := newSimApp(logger, db, simapp.FlagPeriodValue)
 
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
@@ -0,0 +1,54 @@
+package gaia
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// tracingServiceName is the OpenTelemetry service name of agd's spans, which
+// the kernel's spans (of service "agd-cosmos") descend from.
+const tracingServiceName = "agd"
+
+// tracingShutdownTimeout bounds how long agd waits on exit to export the spans
+// it has yet to export.
+const tracingShutdownTimeout = 5 * time.Second
+
+// newBlockTracer returns a BlockTracer exporting its spans over OTLP/HTTP, or
+// nil if no exporter endpoint is configured.  As for the kernel's slog
+// traces, the endpoint is set by OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or
+// OTEL_EXPORTER_OTLP_ENDPOINT, and the other OTEL_* environment variables
+// are honored.
+func newBlockTracer(logger log.Logger) *vm.BlockTracer {
+	if os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" {
+		return nil
+	}
+	ctx := context.Background()
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		logger.Error("failed to create OTLP trace exporter", "err", err)
+		return nil
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithAttributes(semconv.ServiceName(tracingServiceName)),
+	)
+	if err != nil {
+		logger.Error("failed to describe OTLP trace resource", "err", err)
+		res = resource.Default()
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	logger.Info("exporting bridge traces over OTLP")
+	return vm.NewBlockTracer(provider)
+}
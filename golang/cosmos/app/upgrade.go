@@ -4,76 +4,203 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"slices"
 	"strings"
 	"text/template"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 )
 
-var upgradeNamesOfThisVersion = []string{
-	"UNRELEASED_BASIC", // no-frills
-	"UNRELEASED_A3P_INTEGRATION",
-	"UNRELEASED_main",
-	"UNRELEASED_devnet",
-	"UNRELEASED_emerynet",
-	"UNRELEASED_REAPPLY",
+// upgradePlan declares an upgrade plan name handled by this software version.
+type upgradePlan struct {
+	Name string
+	// Primary plans apply the store upgrades of this version, so the first plan
+	// of this version applied to a chain must be a primary one. It is expected
+	// that only primary plan names are used for non testing chains.
+	Primary bool
+	// Variant selects the chain-specific arguments of upgradeSteps, if any.
+	Variant string
+	// BindVstorageAttestationPort binds the port on which remote chains request
+	// attestations of vstorage data over IBC, as genesis does for new chains.
+	BindVstorageAttestationPort bool
 }
 
-// isUpgradeNameOfThisVersion returns whether the provided plan name is a
-// known upgrade name of this software version
-func isUpgradeNameOfThisVersion(name string) bool {
-	return slices.Contains(upgradeNamesOfThisVersion, name)
+// upgradeStep declares a core proposal step, such as a core-eval bundle or
+// vat upgrades, to enqueue for SwingSet in the block of an upgrade.
+type upgradeStep struct {
+	// Name identifies the step in the swingset store once it has been enqueued,
+	// so that a later upgrade of this version does not enqueue it again.
+	Name string
+	// Build returns the step for an upgrade plan variant, or nil if the
+	// variant does not need it.
+	Build func(variant string) (vm.CoreProposalStep, error)
 }
 
-// validUpgradeName is an identity function that asserts the provided name
-// is an upgrade name of this software version. It can be used as a sort of
-// dynamic enum check.
-func validUpgradeName(name string) string {
-	if !isUpgradeNameOfThisVersion(name) {
-		panic(fmt.Errorf("invalid upgrade name: %s", name))
+// upgradeStepsVersion qualifies the names of this version's upgradeSteps in
+// the swingset store. It must change with each release, like the plan names.
+const upgradeStepsVersion = "UNRELEASED"
+
+// This version ships the vstorage attestation port, which every plan binds,
+// since binding a bound port does nothing.
+var upgradePlansOfThisVersion = []upgradePlan{
+	{Name: "UNRELEASED_BASIC", Primary: true, BindVstorageAttestationPort: true}, // no-frills
+	{Name: "UNRELEASED_A3P_INTEGRATION", Primary: true, Variant: "A3P_INTEGRATION", BindVstorageAttestationPort: true},
+	{Name: "UNRELEASED_main", Primary: true, Variant: "MAINNET", BindVstorageAttestationPort: true},
+	{Name: "UNRELEASED_devnet", Primary: true, Variant: "DEVNET", BindVstorageAttestationPort: true},
+	{Name: "UNRELEASED_emerynet", Primary: true, Variant: "EMERYNET", BindVstorageAttestationPort: true},
+	{Name: "UNRELEASED_REAPPLY", BindVstorageAttestationPort: true},
+}
+
+// upgradeStepsOfThisVersion run sequentially, each constructed from one or
+// more modules executing in parallel within the step. They are enqueued by
+// the first upgrade of this version, and by any later upgrade of this version
+// for the steps that were added since.
+var upgradeStepsOfThisVersion = []upgradeStep{
+	{
+		// Upgrade Zoe (no new ZCF needed).
+		Name:  "upgrade-zoe",
+		Build: coreProposalModules("@agoric/builders/scripts/vats/upgrade-zoe.js"),
+	},
+	{
+		Name:  "revive-kread",
+		Build: coreProposalModules("@agoric/builders/scripts/vats/revive-kread.js"),
+	},
+	{
+		// Upgrade to include a cleanup from https://github.com/Agoric/agoric-sdk/pull/10319
+		Name:  "wallet-factory2-upgrade",
+		Build: coreProposalModules("@agoric/builders/scripts/smart-wallet/build-wallet-factory2-upgrade.js"),
+	},
+
+	// CoreProposals for Upgrade 19. These should not be introduced
+	// before upgrade 18 is done because they would be run in n:upgrade-next
+	//
+	// {
+	// 	Name:  "upgrade-mintHolder",
+	// 	Build: variantCoreProposal("@agoric/builders/scripts/vats/upgrade-mintHolder.js", "defaultProposalBuilder"),
+	// },
+	// {
+	// 	Name:  "replace-feeDistributor",
+	// 	Build: coreProposalModules("@agoric/builders/scripts/inter-protocol/replace-feeDistributor.js"),
+	// },
+	// {
+	// 	Name: "upgrade-vats",
+	// 	Build: coreProposalModules(
+	// 		"@agoric/builders/scripts/vats/upgrade-paRegistry.js",
+	// 		"@agoric/builders/scripts/vats/upgrade-provisionPool.js",
+	// 		"@agoric/builders/scripts/vats/upgrade-bank.js",
+	// 		"@agoric/builders/scripts/vats/upgrade-agoricNames.js",
+	// 		"@agoric/builders/scripts/vats/upgrade-asset-reserve.js",
+	// 		"@agoric/builders/scripts/vats/upgrade-psm.js",
+	// 	),
+	// },
+}
+
+// storeUpgradesOfThisVersion are applied by the first primary upgrade of this
+// version.
+var storeUpgradesOfThisVersion = storetypes.StoreUpgrades{
+	Added:   []string{ibcfeetypes.StoreKey},
+	Deleted: []string{},
+}
+
+// getUpgradePlan returns the declaration of an upgrade plan name of this
+// software version, and whether there is one.
+func getUpgradePlan(name string) (upgradePlan, bool) {
+	for _, plan := range upgradePlansOfThisVersion {
+		if plan.Name == name {
+			return plan, true
+		}
 	}
-	return name
+	return upgradePlan{}, false
 }
 
-// isPrimaryUpgradeName returns wether the provided plan name is considered a
+// isPrimaryUpgradeName returns whether the provided plan name is considered a
 // primary for the purpose of applying store migrations for the first upgrade
 // of this version.
-// It is expected that only primary plan names are used for non testing chains.
 func isPrimaryUpgradeName(name string) bool {
 	if name == "" {
 		// An empty upgrade name can happen if there are no upgrade in progress
 		return false
 	}
-	switch name {
-	case validUpgradeName("UNRELEASED_BASIC"),
-		validUpgradeName("UNRELEASED_A3P_INTEGRATION"),
-		validUpgradeName("UNRELEASED_main"),
-		validUpgradeName("UNRELEASED_devnet"),
-		validUpgradeName("UNRELEASED_emerynet"):
-		return true
-	case validUpgradeName("UNRELEASED_REAPPLY"):
-		return false
-	default:
-		panic(fmt.Errorf("unexpected upgrade name %s", validUpgradeName(name)))
+	plan, ok := getUpgradePlan(name)
+	if !ok {
+		panic(fmt.Errorf("unexpected upgrade name %s", name))
 	}
+	return plan.Primary
 }
 
 // isFirstTimeUpgradeOfThisVersion looks up in the upgrade store whether no
 // upgrade plan name of this version have previously been applied.
 func isFirstTimeUpgradeOfThisVersion(app *GaiaApp, ctx sdk.Context) bool {
-	for _, name := range upgradeNamesOfThisVersion {
-		if app.UpgradeKeeper.GetDoneHeight(ctx, name) != 0 {
+	for _, plan := range upgradePlansOfThisVersion {
+		if app.UpgradeKeeper.GetDoneHeight(ctx, plan.Name) != 0 {
 			return false
 		}
 	}
 	return true
 }
 
+// registerUpgradeHandlers sets the upgrade handler of each upgrade plan of
+// this version, and the store loader applying this version's store upgrades
+// if the node is starting at the height of a primary upgrade.
+func registerUpgradeHandlers(app *GaiaApp) {
+	stepNames := map[string]bool{}
+	for _, step := range upgradeStepsOfThisVersion {
+		if stepNames[step.Name] {
+			panic(fmt.Errorf("duplicate upgrade step name %s", step.Name))
+		}
+		stepNames[step.Name] = true
+	}
+
+	for _, plan := range upgradePlansOfThisVersion {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			plan.Name,
+			upgradeHandlerOfThisVersion(app, plan),
+		)
+	}
+
+	// At this point we don't have a way to read from the store, so we have to
+	// rely on data saved by the x/upgrade module in the previous software.
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+	// Store migrations can only run once, so we use a notion of "primary upgrade
+	// name" to trigger them. Testnets may end up upgrading from one rc to
+	// another, which shouldn't re-run store upgrades.
+	if isPrimaryUpgradeName(upgradeInfo.Name) && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgradesOfThisVersion))
+	}
+}
+
+// coreProposalModules returns an upgradeStep builder of a step running the
+// given core proposal builder modules in parallel, for every variant.
+func coreProposalModules(modules ...vm.Jsonable) func(string) (vm.CoreProposalStep, error) {
+	return func(string) (vm.CoreProposalStep, error) {
+		return vm.CoreProposalStepForModules(modules...), nil
+	}
+}
+
+// variantCoreProposal returns an upgradeStep builder of a step calling the
+// entrypoint of a core proposal builder module with the upgrade plan variant,
+// for plans which have one.
+func variantCoreProposal(module string, entrypoint string) func(string) (vm.CoreProposalStep, error) {
+	return func(variant string) (vm.CoreProposalStep, error) {
+		if variant == "" {
+			return nil, nil
+		}
+		return buildProposalStepWithArgs(module, entrypoint, map[string]any{
+			"variant": variant,
+		})
+	}
+}
+
 func buildProposalStepWithArgs(moduleName string, entrypoint string, extra any) (vm.CoreProposalStep, error) {
 	t := template.Must(template.New("").Parse(`{
   "module": "{{.moduleName}}",
@@ -117,108 +244,52 @@ func buildProposalStepWithArgs(moduleName string, entrypoint string, extra any)
 	return vm.CoreProposalStepForModules(proposal), nil
 }
 
-func getVariantFromUpgradeName(upgradeName string) string {
-	switch upgradeName {
-	case "UNRELEASED_A3P_INTEGRATION":
-		return "A3P_INTEGRATION"
-	case "UNRELEASED_main":
-		return "MAINNET"
-	case "UNRELEASED_devnet":
-		return "DEVNET"
-	case "UNRELEASED_emerynet":
-		return "EMERYNET"
-		// Noupgrade for this version.
-	case "UNRELEASED_BASIC":
-		return ""
-	default:
-		return ""
-	}
+// upgradeStepRecorder records the upgrade steps enqueued on this chain, as
+// does the swingset keeper.
+type upgradeStepRecorder interface {
+	GetUpgradeStepDoneHeight(ctx sdk.Context, name string) int64
+	SetUpgradeStepDone(ctx sdk.Context, name string)
 }
 
-// func upgradeMintHolderCoreProposal(upgradeName string) (vm.CoreProposalStep, error) {
-// 	variant := getVariantFromUpgradeName(upgradeName)
-
-// 	if variant == "" {
-// 		return nil, nil
-// 	}
-
-// 	return buildProposalStepWithArgs(
-// 		"@agoric/builders/scripts/vats/upgrade-mintHolder.js",
-// 		"defaultProposalBuilder",
-// 		map[string]any{
-// 			"variant": variant,
-// 		},
-// 	)
-// }
+// enqueueUpgradeSteps returns the core proposal steps of this version which
+// have not yet been enqueued on this chain, recording them as enqueued.
+func enqueueUpgradeSteps(ctx sdk.Context, recorder upgradeStepRecorder, steps []upgradeStep, plan upgradePlan) ([]vm.CoreProposalStep, error) {
+	coreProposalSteps := []vm.CoreProposalStep{}
+	for _, step := range steps {
+		name := upgradeStepsVersion + "/" + step.Name
+		if recorder.GetUpgradeStepDoneHeight(ctx, name) != 0 {
+			continue
+		}
+		coreProposalStep, err := step.Build(plan.Variant)
+		if err != nil {
+			return nil, fmt.Errorf("cannot build upgrade step %s: %w", step.Name, err)
+		}
+		if coreProposalStep == nil {
+			continue
+		}
+		coreProposalSteps = append(coreProposalSteps, coreProposalStep)
+		recorder.SetUpgradeStepDone(ctx, name)
+	}
+	return coreProposalSteps, nil
+}
 
-// unreleasedUpgradeHandler performs standard upgrade actions plus custom actions for the unreleased upgrade.
-func unreleasedUpgradeHandler(app *GaiaApp, targetUpgrade string) func(sdk.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
+// upgradeHandlerOfThisVersion performs standard upgrade actions plus the
+// upgradeSteps of this version.
+func upgradeHandlerOfThisVersion(app *GaiaApp, targetUpgrade upgradePlan) func(sdk.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVm module.VersionMap) (module.VersionMap, error) {
 		app.CheckControllerInited(false)
 
-		CoreProposalSteps := []vm.CoreProposalStep{}
-
-		// These CoreProposalSteps are not idempotent and should only be executed
-		// as part of the first upgrade using this handler on any given chain.
-		if isFirstTimeUpgradeOfThisVersion(app, ctx) {
-			// The storeUpgrades defined in app.go only execute for the primary upgrade name
-			// If we got here and this first upgrade of this version does not use the
-			// primary upgrade name, stores have not been initialized correctly.
-			if !isPrimaryUpgradeName(plan.Name) {
-				return module.VersionMap{}, fmt.Errorf("cannot run %s as first upgrade", plan.Name)
-			}
-
-			// Each CoreProposalStep runs sequentially, and can be constructed from
-			// one or more modules executing in parallel within the step.
-			CoreProposalSteps = append(CoreProposalSteps,
-				vm.CoreProposalStepForModules(
-					// Upgrade Zoe (no new ZCF needed).
-					"@agoric/builders/scripts/vats/upgrade-zoe.js",
-				),
-				// Revive KREAd characters
-				vm.CoreProposalStepForModules(
-					"@agoric/builders/scripts/vats/revive-kread.js",
-				),
-				vm.CoreProposalStepForModules(
-					// Upgrade to include a cleanup from https://github.com/Agoric/agoric-sdk/pull/10319
-					"@agoric/builders/scripts/smart-wallet/build-wallet-factory2-upgrade.js",
-				),
-			)
-
-			// CoreProposals for Upgrade 19. These should not be introduced
-			// before upgrade 18 is done because they would be run in n:upgrade-next
-			//
-			// upgradeMintHolderStep, err := upgradeMintHolderCoreProposal(targetUpgrade)
-			// if err != nil {
-			// 	return nil, err
-			// } else if upgradeMintHolderStep != nil {
-			// 	CoreProposalSteps = append(CoreProposalSteps, upgradeMintHolderStep)
-			// }
-			//
-			// CoreProposalSteps = append(CoreProposalSteps,
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/inter-protocol/replace-feeDistributor.js",
-			// 	),
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/vats/upgrade-paRegistry.js",
-			// 	),
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/vats/upgrade-provisionPool.js",
-			// 	),
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/vats/upgrade-bank.js",
-			// 	),
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/vats/upgrade-agoricNames.js",
-			// 	),
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/vats/upgrade-asset-reserve.js",
-			// 	),
-			// 	vm.CoreProposalStepForModules(
-			// 		"@agoric/builders/scripts/vats/upgrade-psm.js",
-			// 	),
-			// )
+		// The storeUpgrades defined above only execute for the primary upgrade
+		// names. If we got here and this first upgrade of this version does not
+		// use a primary upgrade name, stores have not been initialized correctly.
+		if isFirstTimeUpgradeOfThisVersion(app, ctx) && !targetUpgrade.Primary {
+			return module.VersionMap{}, fmt.Errorf("cannot run %s as first upgrade", plan.Name)
+		}
 
+		// Steps that have run before are not idempotent, and are skipped.
+		CoreProposalSteps, err := enqueueUpgradeSteps(ctx, app.SwingSetKeeper, upgradeStepsOfThisVersion, targetUpgrade)
+		if err != nil {
+			return module.VersionMap{}, err
 		}
 
 		app.upgradeDetails = &upgradeDetails{
@@ -230,6 +301,16 @@ func unreleasedUpgradeHandler(app *GaiaApp, targetUpgrade string) func(sdk.Conte
 			CoreProposals: vm.CoreProposalsFromSteps(CoreProposalSteps...),
 		}
 
+		// A chain started by an earlier version has no crisis module, whose
+		// InitGenesis would assert every invariant in the upgrade block and halt
+		// the chain if any were broken. Skip it, instead setting the fee for
+		// MsgVerifyInvariant in the bond denom rather than its default "stake".
+		if _, ok := fromVm[crisistypes.ModuleName]; !ok {
+			fromVm[crisistypes.ModuleName] = app.mm.Modules[crisistypes.ModuleName].ConsensusVersion()
+			constantFee := crisistypes.DefaultGenesisState().ConstantFee
+			app.CrisisKeeper.SetConstantFee(ctx, sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), constantFee.Amount))
+		}
+
 		// Always run module migrations
 		mvm, err := app.mm.RunMigrations(ctx, app.configurator, fromVm)
 		if err != nil {
@@ -242,6 +323,12 @@ func unreleasedUpgradeHandler(app *GaiaApp, targetUpgrade string) func(sdk.Conte
 			return mvm, err
 		}
 
+		if targetUpgrade.BindVstorageAttestationPort {
+			if err := app.VibcKeeper.BindVstorageAttestationPort(ctx); err != nil {
+				return mvm, err
+			}
+		}
+
 		return mvm, nil
 	}
 }
@@ -0,0 +1,273 @@
+package gaia
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+type fakeUpgradeStepRecorder map[string]int64
+
+func (r fakeUpgradeStepRecorder) GetUpgradeStepDoneHeight(ctx sdk.Context, name string) int64 {
+	return r[name]
+}
+
+func (r fakeUpgradeStepRecorder) SetUpgradeStepDone(ctx sdk.Context, name string) {
+	r[name] = ctx.BlockHeight()
+}
+
+func TestEnqueueUpgradeSteps(t *testing.T) {
+	steps := []upgradeStep{
+		{Name: "always", Build: coreProposalModules("@agoric/builders/scripts/always.js")},
+		{Name: "variant", Build: variantCoreProposal("@agoric/builders/scripts/variant.js", "defaultProposalBuilder")},
+	}
+	recorder := fakeUpgradeStepRecorder{}
+
+	// A plan without a variant enqueues only the steps needed by every plan.
+	ctx := sdk.Context{}.WithBlockHeight(10)
+	got, err := enqueueUpgradeSteps(ctx, recorder, steps, upgradePlan{Name: "BASIC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []vm.CoreProposalStep{vm.CoreProposalStepForModules("@agoric/builders/scripts/always.js")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got steps %v, want %v", got, want)
+	}
+	wantRecorded := fakeUpgradeStepRecorder{upgradeStepsVersion + "/always": 10}
+	if !reflect.DeepEqual(recorder, wantRecorded) {
+		t.Errorf("got recorded steps %v, want %v", recorder, wantRecorded)
+	}
+
+	// A later plan of this version skips the steps already enqueued.
+	ctx = ctx.WithBlockHeight(20)
+	got, err = enqueueUpgradeSteps(ctx, recorder, steps, upgradePlan{Name: "REAPPLY", Variant: "MAINNET"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d steps %v, want only the variant step", len(got), got)
+	}
+	wantRecorded[upgradeStepsVersion+"/variant"] = 20
+	if !reflect.DeepEqual(recorder, wantRecorded) {
+		t.Errorf("got recorded steps %v, want %v", recorder, wantRecorded)
+	}
+
+	// Nothing is left to enqueue.
+	got, err = enqueueUpgradeSteps(ctx, recorder, steps, upgradePlan{Name: "REAPPLY", Variant: "MAINNET"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("got steps %v after all were enqueued, want none", got)
+	}
+}
+
+func TestEnqueueUpgradeStepsBuildError(t *testing.T) {
+	steps := []upgradeStep{
+		{Name: "broken", Build: func(string) (vm.CoreProposalStep, error) { return nil, fmt.Errorf("cannot build") }},
+	}
+	recorder := fakeUpgradeStepRecorder{}
+	if _, err := enqueueUpgradeSteps(sdk.Context{}, recorder, steps, upgradePlan{}); err == nil {
+		t.Error("got no error for a step that cannot be built")
+	}
+	if len(recorder) != 0 {
+		t.Errorf("got recorded steps %v, want none", recorder)
+	}
+}
+
+func TestUpgradeRegistryOfThisVersion(t *testing.T) {
+	planNames := map[string]bool{}
+	hasPrimary := false
+	for _, plan := range upgradePlansOfThisVersion {
+		if planNames[plan.Name] {
+			t.Errorf("duplicate upgrade plan %s", plan.Name)
+		}
+		planNames[plan.Name] = true
+		hasPrimary = hasPrimary || plan.Primary
+		if got, ok := getUpgradePlan(plan.Name); !ok || !reflect.DeepEqual(got, plan) {
+			t.Errorf("getUpgradePlan(%q) = %v, %t", plan.Name, got, ok)
+		}
+	}
+	if !hasPrimary {
+		t.Error("no primary upgrade plan")
+	}
+
+	stepNames := map[string]bool{}
+	for _, step := range upgradeStepsOfThisVersion {
+		if stepNames[step.Name] {
+			t.Errorf("duplicate upgrade step %s", step.Name)
+		}
+		stepNames[step.Name] = true
+		for _, plan := range upgradePlansOfThisVersion {
+			if _, err := step.Build(plan.Variant); err != nil {
+				t.Errorf("cannot build upgrade step %s for plan %s: %v", step.Name, plan.Name, err)
+			}
+		}
+	}
+}
+
+func TestUpgradeBindsVstorageAttestationPort(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+			return "true", nil
+		}
+		app := NewAgoricApp(controller, vm.NewAgdServer(), log.TestingLogger(), dbm.NewMemDB(), nil,
+			true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+		return app, NewDefaultGenesisState()
+	}
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*GaiaApp)
+
+	// Every plan of this version binds the port, since the first upgrade of
+	// a chain to this version may be any primary plan.
+	for _, plan := range upgradePlansOfThisVersion {
+		if !plan.BindVstorageAttestationPort {
+			t.Errorf("upgrade plan %s does not bind the attestation port", plan.Name)
+		}
+	}
+	plan, _ := getUpgradePlan("UNRELEASED_BASIC")
+	ctx := chain.GetContext()
+
+	// Unbind the port that genesis bound, as on a chain started by an earlier
+	// version.
+	portPath := host.PortPath(vibctypes.VstorageAttestationPortID)
+	portCap, ok := app.ScopedVibcKeeper.GetCapability(ctx, portPath)
+	if !ok {
+		t.Fatal("genesis did not bind the attestation port")
+	}
+	if err := app.ScopedVibcKeeper.ReleaseCapability(ctx, portCap); err != nil {
+		t.Fatal(err)
+	}
+	if err := app.ScopedIBCKeeper.ReleaseCapability(ctx, portCap); err != nil {
+		t.Fatal(err)
+	}
+	if app.IBCKeeper.PortKeeper.IsBound(ctx, vibctypes.VstorageAttestationPortID) {
+		t.Fatal("attestation port still bound")
+	}
+
+	app.controllerInited = false
+	handler := upgradeHandlerOfThisVersion(app, plan)
+	if _, err := handler(ctx, upgradetypes.Plan{Name: plan.Name}, app.mm.GetVersionMap()); err != nil {
+		t.Fatal(err)
+	}
+	if !app.IBCKeeper.PortKeeper.IsBound(ctx, vibctypes.VstorageAttestationPortID) {
+		t.Error("upgrade did not bind the attestation port")
+	}
+	if _, ok := app.ScopedVibcKeeper.GetCapability(ctx, portPath); !ok {
+		t.Error("vibc does not own the attestation port")
+	}
+}
+
+func TestUpgradeAddsCrisisWithoutAssertingInvariants(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+			return "true", nil
+		}
+		app := NewAgoricApp(controller, vm.NewAgdServer(), log.TestingLogger(), dbm.NewMemDB(), nil,
+			true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+		return app, NewDefaultGenesisState()
+	}
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*GaiaApp)
+	plan, _ := getUpgradePlan("UNRELEASED_BASIC")
+	ctx := chain.GetContext()
+
+	// Upgrade a chain started by an earlier version, without crisis, whose
+	// state breaks an invariant.
+	app.CrisisKeeper.SetConstantFee(ctx, sdk.NewInt64Coin("stake", 1000))
+	app.CrisisKeeper.RegisterRoute("test", "broken", func(sdk.Context) (string, bool) {
+		return "broken", true
+	})
+	fromVm := app.mm.GetVersionMap()
+	delete(fromVm, crisistypes.ModuleName)
+
+	app.controllerInited = false
+	handler := upgradeHandlerOfThisVersion(app, plan)
+	toVm, err := handler(ctx, upgradetypes.Plan{Name: plan.Name}, fromVm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := toVm[crisistypes.ModuleName]; !ok {
+		t.Error("upgrade did not add crisis to the version map")
+	}
+	if fee, bondDenom := app.CrisisKeeper.GetConstantFee(ctx), app.StakingKeeper.BondDenom(ctx); fee.Denom != bondDenom {
+		t.Errorf("got constant fee %s, want one in %s", fee, bondDenom)
+	}
+}
+
+func TestUpgradeDefaultsMissingSwingsetParams(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+			return "true", nil
+		}
+		app := NewAgoricApp(controller, vm.NewAgdServer(), log.TestingLogger(), dbm.NewMemDB(), nil,
+			true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), simapp.EmptyAppOptions{})
+		return app, NewDefaultGenesisState()
+	}
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*GaiaApp)
+	plan, _ := getUpgradePlan("UNRELEASED_BASIC")
+	ctx := chain.GetContext()
+
+	// Upgrade a chain started by an earlier version, whose swingset params
+	// lack those added since, but whose existing params were set by
+	// governance.
+	params := app.SwingSetKeeper.GetParams(ctx)
+	params.BootstrapVatConfig = "@agoric/vm-config/decentral-governance-config.json"
+	app.SwingSetKeeper.SetParams(ctx, params)
+	paramStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	missing := [][]byte{
+		swingsettypes.ParamStoreKeyComputronPriceUist,
+		swingsettypes.ParamStoreKeyOraclePushFee,
+		swingsettypes.ParamStoreKeyOraclePushesPerBlock,
+		swingsettypes.ParamStoreKeyInboundLaneBatchSize,
+		swingsettypes.ParamStoreKeyAutoProvisionMinDeposit,
+	}
+	for _, key := range missing {
+		paramStore.Delete(append([]byte(swingsettypes.ModuleName+"/"), key...))
+	}
+	if app.GetSubspace(swingsettypes.ModuleName).Has(ctx, swingsettypes.ParamStoreKeyOraclePushesPerBlock) {
+		t.Fatal("oracle_pushes_per_block still set")
+	}
+	fromVm := app.mm.GetVersionMap()
+	fromVm[swingsettypes.ModuleName] = 2
+
+	app.controllerInited = false
+	handler := upgradeHandlerOfThisVersion(app, plan)
+	toVm, err := handler(ctx, upgradetypes.Plan{Name: plan.Name}, fromVm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if toVm[swingsettypes.ModuleName] != 3 {
+		t.Errorf("got swingset version %d, want 3", toVm[swingsettypes.ModuleName])
+	}
+
+	got := app.SwingSetKeeper.GetParams(ctx)
+	want := swingsettypes.DefaultParams()
+	want.BootstrapVatConfig = params.BootstrapVatConfig
+	if got.String() != want.String() {
+		t.Errorf("got params %v, want %v", got, want)
+	}
+	if !app.SwingSetKeeper.AdmitOraclePush(ctx) {
+		t.Error("oracle push refused after the upgrade")
+	}
+}
@@ -18,6 +18,8 @@ import (
 	daemoncmd "github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/jsonrpcconn"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
@@ -49,108 +51,120 @@ func makeShutdown(cmd *exec.Cmd, writer *os.File) func() error {
 	}
 }
 
-// main is the entry point of the agd daemon.  It determines whether to
-// initialize JSON-RPC communications with the separate `--split-vm` VM process,
-// or just to give up control entirely to another binary.
-func main() {
-	var vmClient *rpc.Client
-	var shutdown func() error
+// vmLauncher launches the VM for agd, keeping the Transport with which to
+// reach it.
+type vmLauncher struct {
+	transport vm.Transport
+	nodePort  int
+	// exitCode is that of agd if the VM subprocess exits.
+	exitCode int
+}
 
-	nodePort := 1
-	var sendToNode vm.Sender = func(ctx context.Context, needReply bool, jsonRequest string) (jsonReply string, err error) {
-		if vmClient == nil {
-			return "", errors.New("sendToVM called without VM client set up")
+// launch sets up the VM configured by appOpts: a mock, a SwingSet worker
+// reached over gRPC, a `--split-vm` subprocess, or else the JS executable to
+// which agd gives up control entirely.
+func (l *vmLauncher) launch(agdServer *vm.AgdServer, logger log.Logger, appOpts servertypes.AppOptions) error {
+	swingsetConfig, err := swingset.SwingsetConfigFromViper(appOpts)
+	if err != nil {
+		return err
+	}
+	if swingsetConfig != nil && swingsetConfig.MockVm {
+		logger.Info("agd running with a mock VM; SwingSet will not run")
+		l.transport = vm.NewMockTransport()
+		return nil
+	}
+	if swingsetConfig != nil && swingsetConfig.VmTransport == swingset.VmTransportGrpc {
+		// The SwingSet worker runs as a separate process, started on its own.
+		vmSocket, agdSocket := swingset.GrpcSocketPaths(cast.ToString(appOpts.Get(flags.FlagHome)))
+		if _, err := vm.ServeGRPC(agdServer, agdSocket); err != nil {
+			return err
 		}
+		logger.Info("agd connecting to SwingSet worker over gRPC", "vmSocket", vmSocket, "agdSocket", agdSocket)
+		l.transport, err = vm.NewGRPCTransport(vmSocket, l.nodePort)
+		return err
+	}
 
-		if jsonRequest == "shutdown" {
-			// We could ask nicely, but don't bother.
-			if shutdown != nil {
-				return "", shutdown()
-			}
-			return "", nil
-		}
+	args := []string{"ag-chain-cosmos", "--home", gaia.DefaultNodeHome}
+	args = append(args, os.Args[1:]...)
 
-		msg := vm.Message{
-			Port:       nodePort,
-			NeedsReply: needReply,
-			Data:       jsonRequest,
+	binary := cast.ToString(appOpts.Get(daemoncmd.FlagSplitVm))
+	if binary == "" {
+		binary, lookErr := FindCosmicSwingsetBinary()
+		if lookErr != nil {
+			return lookErr
 		}
-		var reply string
-		err = vmClient.Call(vm.ReceiveMessageMethod, msg, &reply)
-		return reply, err
+
+		// We completely delegate to our default app for running the actual chain.
+		logger.Info("agd delegating to JS executable", "binary", binary, "args", args)
+		return syscall.Exec(binary, args, os.Environ())
 	}
 
-	exitCode := 0
-	launchVM := func(agdServer *vm.AgdServer, logger log.Logger, appOpts servertypes.AppOptions) error {
-		args := []string{"ag-chain-cosmos", "--home", gaia.DefaultNodeHome}
-		args = append(args, os.Args[1:]...)
-
-		binary := cast.ToString(appOpts.Get(daemoncmd.FlagSplitVm))
-		if binary == "" {
-			binary, lookErr := FindCosmicSwingsetBinary()
-			if lookErr != nil {
-				return lookErr
-			}
-
-			// We completely delegate to our default app for running the actual chain.
-			logger.Info("agd delegating to JS executable", "binary", binary, "args", args)
-			return syscall.Exec(binary, args, os.Environ())
-		}
+	// Split the execution between us and the VM.
+	agdFromVm, vmToAgd, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	vmFromAgd, agdToVm, err := os.Pipe()
+	if err != nil {
+		return err
+	}
 
-		// Split the execution between us and the VM.
-		agdFromVm, vmToAgd, err := os.Pipe()
-		if err != nil {
-			return err
-		}
-		vmFromAgd, agdToVm, err := os.Pipe()
-		if err != nil {
-			return err
-		}
+	// Start the command running, then continue.
+	args[0] = binary
+	cmd := NewVMCommand(logger, binary, args, vmFromAgd, vmToAgd)
+	shutdown := makeShutdown(cmd, agdToVm)
 
-		// Start the command running, then continue.
-		args[0] = binary
-		cmd := NewVMCommand(logger, binary, args, vmFromAgd, vmToAgd)
-		shutdown = makeShutdown(cmd, agdToVm)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if vmFromAgd.Close() != nil {
+		return err
+	}
+	if vmToAgd.Close() != nil {
+		return err
+	}
 
-		if err := cmd.Start(); err != nil {
-			return err
-		}
-		if vmFromAgd.Close() != nil {
-			return err
-		}
-		if vmToAgd.Close() != nil {
-			return err
-		}
+	// Multiplex bidirectional JSON-RPC over the pipes.
+	agvmConn := jsonrpcconn.NewConn(agdFromVm, agdToVm)
+	clientConn, serverConn := jsonrpcconn.ClientServerConn(agvmConn)
 
-		// Multiplex bidirectional JSON-RPC over the pipes.
-		agvmConn := jsonrpcconn.NewConn(agdFromVm, agdToVm)
-		clientConn, serverConn := jsonrpcconn.ClientServerConn(agvmConn)
+	// Set up the VM server.
+	vmServer := rpc.NewServer()
+	if err := vmServer.RegisterName("agd", agdServer); err != nil {
+		return err
+	}
+	go vmServer.ServeCodec(jsonrpc.NewServerCodec(serverConn))
 
-		// Set up the VM server.
-		vmServer := rpc.NewServer()
-		if err := vmServer.RegisterName("agd", agdServer); err != nil {
-			return err
-		}
-		go vmServer.ServeCodec(jsonrpc.NewServerCodec(serverConn))
+	// Set up the VM client.
+	l.transport = vm.NewRPCTransport(jsonrpc.NewClient(clientConn), l.nodePort, shutdown)
 
-		// Set up the VM client.
-		vmClient = jsonrpc.NewClient(clientConn)
+	go func() {
+		// Premature exit from `agd start` should exit the process.
+		_ = cmd.Wait()
+		os.Exit(l.exitCode)
+	}()
 
-		go func() {
-			// Premature exit from `agd start` should exit the process.
-			_ = cmd.Wait()
-			os.Exit(exitCode)
-		}()
+	return nil
+}
 
-		return nil
+// main is the entry point of the agd daemon.  It determines whether to
+// initialize JSON-RPC communications with the separate `--split-vm` VM process,
+// or just to give up control entirely to another binary.
+func main() {
+	launcher := &vmLauncher{nodePort: 1}
+	var sendToNode vm.Sender = func(ctx context.Context, needReply bool, jsonRequest string) (jsonReply string, err error) {
+		if launcher.transport == nil {
+			return "", errors.New("sendToVM called without VM client set up")
+		}
+		return vm.NewTransportSender(launcher.transport)(ctx, needReply, jsonRequest)
 	}
 
-	daemoncmd.OnExportHook = launchVM
+	daemoncmd.OnExportHook = launcher.launch
 	daemoncmd.OnStartHook = func(agdServer *vm.AgdServer, logger log.Logger, appOpts servertypes.AppOptions) error {
 		// We tried running start, which should never exit, so exit with non-zero
 		// code if we do.
-		exitCode = 99
-		return launchVM(agdServer, logger, appOpts)
+		launcher.exitCode = 99
+		return launcher.launch(agdServer, logger, appOpts)
 	}
 
 	daemon.RunWithController(sendToNode)
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	daemoncmd "github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+)
+
+func TestLaunchMockVM(t *testing.T) {
+	home := t.TempDir()
+	// A VM subprocess would leave this marker behind.
+	marker := filepath.Join(home, "vm-started")
+	splitVm := filepath.Join(home, "split-vm")
+	if err := os.WriteFile(splitVm, []byte("#!/bin/sh\ntouch "+marker+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// The app.toml of the node predates the [swingset] section, which is set
+	// only by the flag.
+	var appToml bytes.Buffer
+	configTemplate := template.Must(template.New("").Parse(serverconfig.DefaultConfigTemplate))
+	if err := configTemplate.Execute(&appToml, serverconfig.DefaultConfig()); err != nil {
+		t.Fatal(err)
+	}
+	appOpts := viper.New()
+	appOpts.SetConfigType("toml")
+	if err := appOpts.MergeConfig(&appToml); err != nil {
+		t.Fatal(err)
+	}
+	appOpts.Set(flags.FlagHome, home)
+	appOpts.Set(daemoncmd.FlagSplitVm, splitVm)
+	appOpts.Set(swingset.FlagMockVm, true)
+
+	launcher := &vmLauncher{nodePort: 1}
+	if err := launcher.launch(vm.NewAgdServer(), log.NewNopLogger(), appOpts); err != nil {
+		t.Fatalf("cannot launch the mock VM: %v", err)
+	}
+	if launcher.transport != vm.NewMockTransport() {
+		t.Errorf("got transport %#v, want the mock", launcher.transport)
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Errorf("a VM subprocess was started: %v", err)
+	}
+}
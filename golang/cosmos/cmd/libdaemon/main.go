@@ -12,7 +12,6 @@ import "C"
 import (
 	"context"
 	"encoding/json"
-	"net/rpc"
 	"os"
 	"path/filepath"
 
@@ -38,25 +37,9 @@ var agdServer *vm.AgdServer
 // ConnectVMClientCodec creates an RPC client codec and a sender to the
 // in-process implementation of the VM.
 func ConnectVMClientCodec(ctx context.Context, nodePort int, sendFunc func(int, int, string)) (*vm.ClientCodec, vm.Sender) {
-	vmClientCodec = vm.NewClientCodec(ctx, sendFunc)
-	vmClient := rpc.NewClientWithCodec(vmClientCodec)
-
-	var sendToNode vm.Sender = func(ctx context.Context, needReply bool, jsonRequest string) (jsonReply string, err error) {
-		if jsonRequest == "shutdown" {
-			return "", vmClientCodec.Close()
-		}
-
-		msg := vm.Message{
-			Port:       nodePort,
-			NeedsReply: needReply,
-			Data:       jsonRequest,
-		}
-		var reply string
-		err = vmClient.Call(vm.ReceiveMessageMethod, msg, &reply)
-		return reply, err
-	}
-
-	return vmClientCodec, sendToNode
+	var transport vm.Transport
+	transport, vmClientCodec = vm.NewBridgeTransport(ctx, nodePort, sendFunc)
+	return vmClientCodec, vm.NewTransportSender(transport)
 }
 
 //export RunAgCosmosDaemon
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// replaySwingsetCommand returns the "replay-swingset" command, which re-drives
+// the VM with the bridge traffic captured in a swingset record-dir.
+func replaySwingsetCommand(ac appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-swingset <record-dir>",
+		Short: "Replay recorded bridge traffic to the SwingSet VM",
+		Long: `Replay to the SwingSet VM the bridge traffic recorded in the
+[swingset] record-dir of a node, to reproduce a consensus divergence offline.
+
+Each recorded downcall is sent to the VM in order, and each upcall the VM makes
+is answered with its recorded reply rather than by the Cosmos modules, so no
+chain state is needed. The VM must start from the kernel state at the start of
+the recording, such as a copy of the node's swing-store taken before it was
+started with record-dir, or at the start of the first block remaining in a
+recording pruned by record-retain-blocks. The recording of each earlier run of
+the node is in a "session-<n>" subdirectory of the record-dir.
+
+The replay stops at the first upcall or reply that differs from the recording,
+which is reported with its block height and sequence number.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			replay, err := vm.LoadReplay(args[0])
+			if err != nil {
+				return err
+			}
+			ac.agdServer.SetReplay(replay)
+
+			// Launch the VM, as for "start".
+			if OnStartHook != nil {
+				if err := OnStartHook(ac.agdServer, serverCtx.Logger, serverCtx.Viper); err != nil {
+					return err
+				}
+			}
+
+			replayed, err := replay.Run(cmd.Context(), ac.sender)
+			if err != nil {
+				cmd.Printf("Replayed %d of %d downcalls\n", replayed, replay.Downcalls())
+				return err
+			}
+			cmd.Printf("Replayed %d downcalls without divergence\n", replayed)
+			return nil
+		},
+	}
+
+	addAgoricVMFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+const (
+	// FlagAddressMap names an address to substitute for another.
+	FlagAddressMap = "map"
+	// FlagOverrides names a JSON file of genesis overrides to apply.
+	FlagOverrides = "overrides"
+)
+
+// parseAddressMap returns a replacer of the bech32 addresses of each
+// "old:new" mapping.
+func parseAddressMap(mappings []string) (*strings.Replacer, error) {
+	seen := map[string]bool{}
+	var oldnew []string
+	for _, mapping := range mappings {
+		parts := strings.Split(mapping, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("address mapping %q must be old:new", mapping)
+		}
+		for _, address := range parts {
+			if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+				return nil, fmt.Errorf("invalid address %q in mapping %q: %w", address, mapping, err)
+			}
+		}
+		if seen[parts[0]] {
+			return nil, fmt.Errorf("address %s is mapped more than once", parts[0])
+		}
+		seen[parts[0]] = true
+		oldnew = append(oldnew, parts[0], parts[1])
+	}
+	return strings.NewReplacer(oldnew...), nil
+}
+
+// rewriteSwingStoreCommand returns the "debug rewrite-swingstore" command,
+// which substitutes addresses in a genesis export for a forked chain and
+// applies genesis overrides to it.
+func rewriteSwingStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewrite-swingstore <export-dir> <output-dir> [--map <old>:<new>...] [--overrides <file>]",
+		Short: "Substitute addresses in and apply overrides to a genesis export for a forked chain",
+		Long: `Substitute bech32 addresses in a genesis export created by "agd export",
+such as to replace the foundation multisigs of mainnet with test keys when
+launching a forked testnet from its state.
+
+The output directory receives a copy of the export's genesis and swing-store
+in which each old address is replaced by its new one wherever it appears in the
+kernel data and transcripts of the swing-store, and in the vstorage and
+swingset module state of the genesis. The transcript hashes, the export data
+hash, and the manifest hash of the swing-store are recomputed to match. Other
+modules, such as the accounts and balances, are left unchanged.
+
+Export data embedded in the genesis is rewritten with the transcripts it
+describes, and moved to the output swing-store export.
+
+Bundles and heap snapshots are opaque and copied as is. A vat restarts by
+replaying its transcript on its current heap snapshot, so a vat whose current
+snapshot holds an old address would produce syscalls that no longer match its
+rewritten transcript, and fail to replay. The command refuses such an export:
+upgrade those vats on the original chain before exporting, so that their heaps
+are rebuilt, or leave their addresses unmapped. A bundle that holds an old
+address has the same problem, which the command cannot detect.
+
+The --overrides file then "fast forwards" the rewritten state, such as to reset
+oracle feeds or replace the chain IDs of peers. It is a JSON object such as
+
+  {
+    "kernel": {"<kernel kvStore key>": "<value>", "<kernel kvStore key>": null},
+    "vstorage": {"<vstorage path>": "<value>", "<vstorage path>": null}
+  }
+
+in which each kernel key is set to its value, or deleted by null, in the
+export data of the swing-store, and each vstorage path is set to its value, or
+removed with its descendants by null, in the vstorage module state. Since the
+overrides are part of the output genesis, every node launched from it starts
+from the same state.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			exportDir, outDir := args[0], args[1]
+
+			mappings, _ := cmd.Flags().GetStringArray(FlagAddressMap)
+			overridesPath, _ := cmd.Flags().GetString(FlagOverrides)
+			if len(mappings) == 0 && overridesPath == "" {
+				return fmt.Errorf("at least one --%s or an --%s file is required", FlagAddressMap, FlagOverrides)
+			}
+			replacer, err := parseAddressMap(mappings)
+			if err != nil {
+				return err
+			}
+			var overrides swingsetkeeper.GenesisOverrides
+			if overridesPath != "" {
+				overrides, err = swingsetkeeper.ReadGenesisOverrides(overridesPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			genesisPath := filepath.Join(outDir, ExportedGenesisFileName)
+			if _, err := os.Stat(genesisPath); err == nil {
+				return fmt.Errorf("%s already exists", genesisPath)
+			}
+			if err := os.MkdirAll(outDir, os.ModePerm); err != nil {
+				return err
+			}
+
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(exportDir, ExportedGenesisFileName))
+			if err != nil {
+				return err
+			}
+			// The embedded export data, if any, is rewritten along with the
+			// transcripts it describes, so it is taken as exported.
+			var exportedSwingsetGenState, swingsetGenState swingsettypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[swingsettypes.ModuleName], &exportedSwingsetGenState); err != nil {
+				return err
+			}
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(replacer.Replace(string(appState[swingsettypes.ModuleName]))), &swingsetGenState); err != nil {
+				return err
+			}
+			var embeddedExportData []*swingsettypes.SwingStoreExportDataEntry
+			if len(exportedSwingsetGenState.SwingStoreExportData) > 0 {
+				embeddedExportData = exportedSwingsetGenState.SwingStoreExportData
+			}
+			if rawVstorageGenState, ok := appState[vstoragetypes.ModuleName]; ok {
+				var vstorageGenState vstoragetypes.GenesisState
+				if err := clientCtx.Codec.UnmarshalJSON([]byte(replacer.Replace(string(rawVstorageGenState))), &vstorageGenState); err != nil {
+					return err
+				}
+				vstorageGenState.Data = swingsetkeeper.ApplyVstorageGenesisOverrides(vstorageGenState.Data, overrides.Vstorage)
+				appState[vstoragetypes.ModuleName], err = clientCtx.Codec.MarshalJSON(&vstorageGenState)
+				if err != nil {
+					return err
+				}
+			} else if len(overrides.Vstorage) > 0 {
+				return fmt.Errorf("genesis has no %s state to override", vstoragetypes.ModuleName)
+			}
+
+			hasSwingStore := swingsetGenState.SwingStoreExportDataHash != "" || len(embeddedExportData) > 0
+			if len(overrides.Kernel) > 0 && !hasSwingStore {
+				return fmt.Errorf("kernel overrides require a genesis with a swing-store export")
+			}
+			if hasSwingStore {
+				outSwingStoreDir := filepath.Join(outDir, ExportedSwingStoreDirectoryName)
+				if err := os.MkdirAll(outSwingStoreDir, os.ModePerm); err != nil {
+					return err
+				}
+				result, err := swingsetkeeper.RewriteSwingStoreExport(
+					filepath.Join(exportDir, ExportedSwingStoreDirectoryName),
+					outSwingStoreDir,
+					swingsetkeeper.SwingStoreExportRewriteOptions{
+						Replacer:        replacer,
+						KernelOverrides: overrides.Kernel,
+						ExportData:      embeddedExportData,
+					},
+				)
+				if err != nil {
+					return err
+				}
+				// Embedded export data is written to the swing-store export
+				// with the rest, and referenced by its hash.
+				swingsetGenState.SwingStoreExportData = nil
+				swingsetGenState.SwingStoreExportDataHash = result.ExportDataHash
+				if swingsetGenState.SwingStoreExport != nil {
+					manifestHash, _, err := swingsetkeeper.HashSwingStoreExportManifest(outSwingStoreDir)
+					if err != nil {
+						return err
+					}
+					swingsetGenState.SwingStoreExport.ManifestHash = manifestHash
+				}
+				cmd.Printf("Rewrote %d export data entries and %d transcripts of the swing-store, overrode %d export data entries, and copied %d other artifacts\n",
+					result.RewrittenEntries, result.RewrittenTranscripts, result.OverriddenEntries, result.CopiedArtifacts)
+			}
+
+			appState[swingsettypes.ModuleName], err = clientCtx.Codec.MarshalJSON(&swingsetGenState)
+			if err != nil {
+				return err
+			}
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return err
+			}
+			return genutil.ExportGenesisFile(genDoc, genesisPath)
+		},
+	}
+
+	cmd.Flags().StringArray(FlagAddressMap, nil, "An <old>:<new> pair of bech32 addresses to substitute, which may be repeated")
+	cmd.Flags().String(FlagOverrides, "", "The path of a JSON file of kernel and vstorage overrides to apply")
+	return cmd
+}
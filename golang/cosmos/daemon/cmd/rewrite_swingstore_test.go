@@ -0,0 +1,163 @@
+package cmd_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/daemon/cmd"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// writeTestExport writes to a new directory a genesis export whose swing-store
+// has the given current heap snapshot and a transcript, each mentioning
+// oldAddr, and returns the directory.
+func writeTestExport(t *testing.T, oldAddr, snapshot string, embedExportData bool) string {
+	exportDir := t.TempDir()
+	transcript := `{"d":["message","` + oldAddr + `"]}` + "\n"
+	artifacts := []swingsettypes.SwingStoreArtifact{
+		{Name: "snapshot.v1.2", Data: []byte(snapshot)},
+		{Name: "transcript.v1.2.3", Data: []byte(transcript)},
+	}
+	// The transcript span hash is recomputed by the rewrite.
+	exportData := []*swingsettypes.SwingStoreExportDataEntry{
+		{Key: "kv.v1.owner", Value: oldAddr},
+		{Key: "kv.v1.feed", Value: "stale"},
+		{Key: "snapshot.v1.2", Value: `{"vatID":"v1","snapPos":2,"hash":"` + fmt.Sprintf("%x", sha256.Sum256([]byte(snapshot))) + `","inUse":1}`},
+		{Key: "snapshot.v1.current", Value: "snapshot.v1.2"},
+		{Key: "transcript.v1.current", Value: `{"vatID":"v1","startPos":2,"endPos":3,"hash":"0000","isCurrent":1,"incarnation":0}`},
+	}
+
+	next := 0
+	provider := swingsetkeeper.SwingStoreExportProvider{
+		BlockHeight: 10,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			if embedExportData {
+				return nil, nil
+			}
+			return agoric.NewSwingStoreExportDataEntriesReader(exportData), nil
+		},
+		ReadNextArtifact: func() (swingsettypes.SwingStoreArtifact, error) {
+			if next == len(artifacts) {
+				return swingsettypes.SwingStoreArtifact{}, io.EOF
+			}
+			next++
+			return artifacts[next-1], nil
+		},
+	}
+	swingStoreDir := filepath.Join(exportDir, cmd.ExportedSwingStoreDirectoryName)
+	require.NoError(t, os.Mkdir(swingStoreDir, 0o755))
+	require.NoError(t, swingsetkeeper.WriteSwingStoreExportToDirectory(provider, swingStoreDir))
+	manifestHash, _, err := swingsetkeeper.HashSwingStoreExportManifest(swingStoreDir)
+	require.NoError(t, err)
+
+	swingsetGenState := &swingsettypes.GenesisState{Params: swingsettypes.DefaultParams()}
+	swingsetGenState.SwingStoreExport = &swingsettypes.SwingStoreExportReference{
+		Dir:          cmd.ExportedSwingStoreDirectoryName,
+		BlockHeight:  10,
+		ManifestHash: manifestHash,
+	}
+	if embedExportData {
+		swingsetGenState.SwingStoreExportData = exportData
+	} else {
+		swingsetGenState.SwingStoreExportDataHash = "sha256:0000"
+	}
+	vstorageGenState := &vstoragetypes.GenesisState{Params: vstoragetypes.DefaultParams()}
+	vstorageGenState.Data = []*vstoragetypes.DataEntry{
+		{Path: "published.owner", Value: oldAddr},
+		{Path: "published.priceFeed.ATOM-USD_price_feed", Value: "stale"},
+	}
+
+	cdc := app.MakeEncodingConfig().Marshaler
+	appState := map[string]json.RawMessage{
+		swingsettypes.ModuleName: cdc.MustMarshalJSON(swingsetGenState),
+		vstoragetypes.ModuleName: cdc.MustMarshalJSON(vstorageGenState),
+	}
+	rawAppState, err := json.Marshal(appState)
+	require.NoError(t, err)
+	genDoc := tmtypes.GenesisDoc{ChainID: "agoric-3", AppState: rawAppState}
+	require.NoError(t, genDoc.SaveAs(filepath.Join(exportDir, cmd.ExportedGenesisFileName)))
+	return exportDir
+}
+
+func runRewriteSwingStore(t *testing.T, args ...string) error {
+	rootCmd, _ := cmd.NewRootCmd(nil)
+	rootCmd.SetArgs(append([]string{"debug", "rewrite-swingstore"}, args...))
+	return svrcmd.Execute(rootCmd, "", t.TempDir())
+}
+
+func TestRewriteSwingStoreCmd(t *testing.T) {
+	oldAddr := sdk.AccAddress([]byte("old-address_________")).String()
+	newAddr := sdk.AccAddress([]byte("new-address_________")).String()
+	overridesPath := filepath.Join(t.TempDir(), "overrides.json")
+	require.NoError(t, os.WriteFile(overridesPath, []byte(`{
+		"kernel": {"v1.feed": null, "v1.peer": "cosmoshub-fork"},
+		"vstorage": {"published.priceFeed": null}
+	}`), 0o644))
+
+	for _, embedExportData := range []bool{false, true} {
+		exportDir := writeTestExport(t, oldAddr, "heap-bytes", embedExportData)
+		outDir := filepath.Join(t.TempDir(), "out")
+		require.NoError(t, runRewriteSwingStore(t, exportDir, outDir, "--map", oldAddr+":"+newAddr, "--overrides", overridesPath))
+
+		appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(outDir, cmd.ExportedGenesisFileName))
+		require.NoError(t, err)
+		cdc := app.MakeEncodingConfig().Marshaler
+		var swingsetGenState swingsettypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[swingsettypes.ModuleName], &swingsetGenState)
+		var vstorageGenState vstoragetypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[vstoragetypes.ModuleName], &vstorageGenState)
+		require.Empty(t, swingsetGenState.SwingStoreExportData)
+		require.Equal(t, []*vstoragetypes.DataEntry{{Path: "published.owner", Value: newAddr}}, vstorageGenState.Data)
+
+		// The output verifies as the swing-store export the genesis references.
+		swingStoreDir := filepath.Join(outDir, swingsetGenState.SwingStoreExport.Dir)
+		manifestHash, _, err := swingsetkeeper.HashSwingStoreExportManifest(swingStoreDir)
+		require.NoError(t, err)
+		require.Equal(t, swingsetGenState.SwingStoreExport.ManifestHash, manifestHash)
+		provider, err := swingsetkeeper.OpenSwingStoreExportDirectory(swingStoreDir)
+		require.NoError(t, err)
+		verification, err := swingsetkeeper.VerifySwingStoreExport(provider, nil)
+		require.NoError(t, err)
+		require.Equal(t, swingsetGenState.SwingStoreExportDataHash, verification.ExportDataHash)
+
+		bz, err := os.ReadFile(filepath.Join(swingStoreDir, "export-data.jsonl"))
+		require.NoError(t, err)
+		exportData := string(bz)
+		require.NotContains(t, exportData, oldAddr)
+		require.Contains(t, exportData, `["kv.v1.owner","`+newAddr+`"]`)
+		require.Contains(t, exportData, `["kv.v1.peer","cosmoshub-fork"]`)
+		require.NotContains(t, exportData, "kv.v1.feed")
+	}
+}
+
+func TestRewriteSwingStoreCmdRefusals(t *testing.T) {
+	oldAddr := sdk.AccAddress([]byte("old-address_________")).String()
+	newAddr := sdk.AccAddress([]byte("new-address_________")).String()
+	mapping := oldAddr + ":" + newAddr
+
+	exportDir := writeTestExport(t, oldAddr, "heap holding "+oldAddr, false)
+	err := runRewriteSwingStore(t, exportDir, filepath.Join(t.TempDir(), "out"), "--map", mapping)
+	require.ErrorContains(t, err, "snapshot.v1.2")
+
+	exportDir = writeTestExport(t, oldAddr, "heap-bytes", false)
+	err = runRewriteSwingStore(t, exportDir, filepath.Join(t.TempDir(), "out"))
+	require.ErrorContains(t, err, "--map")
+	err = runRewriteSwingStore(t, exportDir, filepath.Join(t.TempDir(), "out"), "--map", oldAddr)
+	require.ErrorContains(t, err, "old:new")
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
@@ -25,7 +26,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -38,7 +41,10 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	swingset "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+	swingsetcli "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/client/cli"
 	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage"
+	vtransfertypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 )
 
 var AppName = "agd"
@@ -51,6 +57,9 @@ type CustomAppConfig struct {
 	// Swingset must be named as expected by swingset.DefaultConfigTemplate
 	// and must use a mapstructure key matching swingset.ConfigPrefix.
 	Swingset swingset.SwingsetConfig `mapstructure:"swingset"`
+	// Vstorage must be named as expected by vstorage.DefaultConfigTemplate
+	// and must use a mapstructure key matching vstorage.ConfigPrefix.
+	Vstorage vstorage.VstorageConfig `mapstructure:"vstorage"`
 }
 
 type cobraRunE func(cmd *cobra.Command, args []string) error
@@ -136,12 +145,14 @@ func initAppConfig() (string, interface{}) {
 	customAppConfig := CustomAppConfig{
 		Config:   *srvCfg,
 		Swingset: swingset.DefaultSwingsetConfig,
+		Vstorage: vstorage.DefaultVstorageConfig,
 	}
 
 	// Config TOML.
 	customAppTemplate := strings.Join([]string{
 		serverconfig.DefaultConfigTemplate,
 		swingset.DefaultConfigTemplate,
+		vstorage.DefaultConfigTemplate,
 	}, "")
 
 	return customAppTemplate, customAppConfig
@@ -166,7 +177,8 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 		AddGenesisAccountCmd(encodingConfig.Marshaler, gaia.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCommand(),
+		replaySwingsetCommand(ac),
 		config.Cmd(),
 		pruning.Cmd(ac.newSnapshotsApp, gaia.DefaultNodeHome),
 		snapshot.Cmd(ac.newSnapshotsApp),
@@ -190,6 +202,9 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 				if _, err = swingset.SwingsetConfigFromViper(viper); err != nil {
 					return err
 				}
+				if _, err = vstorage.VstorageConfigFromViper(viper); err != nil {
+					return err
+				}
 				return nil
 			}
 			appendToPreRunE(command, preRunE)
@@ -197,6 +212,7 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 			addAgoricVMFlags(command)
 			extendCosmosExportCommand(command)
 		case "snapshots":
+			command.AddCommand(verifySwingStoreExportCommand())
 			for _, subCommand := range command.Commands() {
 				switch subCommand.Name() {
 				case "restore":
@@ -225,13 +241,18 @@ const (
 	// split-process Agoric VM.  The default is to use an embedded VM.
 	FlagSplitVm      = "split-vm"
 	EmbeddedVmEnvVar = "AGD_EMBEDDED_VM"
+	// FlagAckKernelPanic is the command-line flag with which an operator allows
+	// a node that halted for a SwingSet kernel panic to restart.
+	FlagAckKernelPanic = "ack-kernel-panic"
 )
 
 // hasVMController returns true if we have a VM (are running in split-vm mode,
-// or with an embedded VM).
+// with an embedded VM, with a mock VM, or with a SwingSet worker over gRPC).
 func hasVMController(serverCtx *server.Context) bool {
 	return serverCtx.Viper.GetString(FlagSplitVm) != "" ||
-		os.Getenv(EmbeddedVmEnvVar) != ""
+		os.Getenv(EmbeddedVmEnvVar) != "" ||
+		serverCtx.Viper.GetBool(swingset.FlagMockVm) ||
+		serverCtx.Viper.GetString(swingset.FlagVmTransport) == swingset.VmTransportGrpc
 }
 
 func addAgoricVMFlags(cmd *cobra.Command) {
@@ -244,6 +265,23 @@ func addAgoricVMFlags(cmd *cobra.Command) {
 
 func addStartFlags(startCmd *cobra.Command) {
 	addAgoricVMFlags(startCmd)
+	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Bool(
+		FlagAckKernelPanic,
+		false,
+		"Acknowledge that the node halted for a SwingSet kernel panic, and restart it",
+	)
+	startCmd.Flags().String(
+		gaia.FlagVtransferForwardingMode,
+		vtransfertypes.InterceptBeforeForwardingName,
+		fmt.Sprintf("Whether the VM intercepts ICS-20 packets before (%q) or after (%q) packet-forward-middleware forwards them; must be the same on every node",
+			vtransfertypes.InterceptBeforeForwardingName, vtransfertypes.InterceptAfterForwardingName),
+	)
+	startCmd.Flags().Bool(
+		swingset.FlagMockVm,
+		false,
+		"Replace the SwingSet VM with a stub acknowledging every message, for integration tests of the Cosmos modules without Node.js",
+	)
 }
 
 func queryCommand() *cobra.Command {
@@ -295,6 +333,11 @@ func txCommand() *cobra.Command {
 	)
 
 	gaia.ModuleBasics.AddTxCommands(cmd)
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == govtypes.ModuleName {
+			subCmd.AddCommand(swingsetcli.NewCmdSubmitCoreEval())
+		}
+	}
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -327,6 +370,11 @@ func (ac appCreator) newApp(
 
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 
+	err := checkKernelPanicMarker(logger, gaia.KernelPanicMarkerDir(homePath), cast.ToBool(appOpts.Get(FlagAckKernelPanic)))
+	if err != nil {
+		panic(err)
+	}
+
 	// Set a default value for FlagSwingStoreExportDir based on homePath
 	// in case we need to InitGenesis with swing-store data
 	viper, ok := appOpts.(*viper.Viper)
@@ -346,6 +394,32 @@ func (ac appCreator) newApp(
 	)
 }
 
+// checkKernelPanicMarker refuses to start a node that halted for a SwingSet
+// kernel panic unless the operator has acknowledged it, in which case the
+// marker is cleared.
+func checkKernelPanicMarker(logger log.Logger, dataDir string, acknowledged bool) error {
+	if dataDir == "" {
+		return nil
+	}
+	marker, err := swingsetkeeper.ReadKernelPanicMarker(dataDir)
+	if err != nil {
+		return err
+	}
+	if marker == nil {
+		return nil
+	}
+	if !acknowledged {
+		return fmt.Errorf(
+			"node halted at %s because SwingSet failed %s of block %d: %s; "+
+				"once the cause is addressed, restart with --%s",
+			marker.Time.Format(time.RFC3339), marker.Action, marker.BlockHeight, marker.Error, FlagAckKernelPanic,
+		)
+	}
+	logger.Info("restarting after acknowledged SwingSet kernel panic",
+		"height", marker.BlockHeight, "action", marker.Action, "err", marker.Error)
+	return swingsetkeeper.ClearKernelPanicMarker(dataDir)
+}
+
 func (ac appCreator) newSnapshotsApp(
 	logger log.Logger,
 	db dbm.DB,
@@ -415,6 +489,11 @@ func extendCosmosExportCommand(cmd *cobra.Command) {
 			strings.Join(keys, " | "),
 		),
 	)
+	cmd.Flags().StringSlice(
+		gaia.FlagModulesToExport,
+		nil,
+		"Comma-separated names of the modules whose genesis state to export (default: all modules)",
+	)
 
 	originalRunE := cmd.RunE
 
@@ -521,7 +600,18 @@ func (ac appCreator) appExport(
 		}
 	}
 
-	return gaiaApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	modulesToExport := cast.ToStringSlice(appOpts.Get(gaia.FlagModulesToExport))
+	return gaiaApp.ExportAppStateAndValidatorsForModules(forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// debugCommand returns the cosmos-sdk "debug" command, extended with the
+// swingset debugging commands.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(swingsetcli.GetDebugCmd())
+	cmd.AddCommand(swingsetcli.GetCmdCompareSlogs())
+	cmd.AddCommand(rewriteSwingStoreCommand())
+	return cmd
 }
 
 // replaceCosmosSnapshotExportCommand monkey-patches the "snapshots export" command
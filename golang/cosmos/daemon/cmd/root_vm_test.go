@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
+)
+
+func TestHasVMController(t *testing.T) {
+	t.Setenv(EmbeddedVmEnvVar, "")
+	serverCtx := server.NewDefaultContext()
+	if hasVMController(serverCtx) {
+		t.Error("got a VM controller without a VM")
+	}
+
+	// A mock VM is the controller, so that agd does not exec the JS
+	// executable to run one.
+	serverCtx.Viper.Set(swingset.FlagMockVm, true)
+	if !hasVMController(serverCtx) {
+		t.Error("got no VM controller with a mock VM")
+	}
+}
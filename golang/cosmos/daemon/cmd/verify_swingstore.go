@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	swingsetkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// FlagOffline skips the comparison against the chain state.
+const FlagOffline = "offline"
+
+// verifySwingStoreExportCommand returns the "snapshots verify-swingstore"
+// command, which checks a swing-store export directory before it is restored.
+func verifySwingStoreExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-swingstore <export-dir>",
+		Short: "Verify the artifacts of a swing-store export",
+		Long: `Verify the artifacts of a swing-store export directory, such as the
+swing-store directory of a genesis export.
+
+Each artifact's hash is recomputed and compared with the swing-store export
+data recorded in the swingset module's store at the export's block height,
+which must still be available in the node's application database. If the
+export includes its own export data, it must match the chain's.
+
+With --offline, the artifacts are only compared with the export's own export
+data, which shows the export to be internally consistent.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			provider, err := swingsetkeeper.OpenSwingStoreExportDirectory(args[0])
+			if err != nil {
+				return err
+			}
+
+			var chainExportData agoric.KVEntryReader
+			offline, _ := cmd.Flags().GetBool(FlagOffline)
+			if !offline {
+				if provider.BlockHeight == 0 {
+					return errors.New("export manifest has no block height to verify against, use --offline")
+				}
+				db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				chainExportData, err = openChainSwingStoreExportData(db, int64(provider.BlockHeight))
+				if err != nil {
+					return err
+				}
+			}
+
+			result, err := swingsetkeeper.VerifySwingStoreExport(provider, chainExportData)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Verified %d artifacts of swing-store export at height %d against export data %s\n",
+				result.VerifiedArtifacts, result.BlockHeight, result.ExportDataHash)
+			for _, name := range result.UnverifiedArtifacts {
+				cmd.Printf("Could not verify the hash of artifact %s\n", name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagOffline, false, "Only verify the export against its own export data")
+	return cmd
+}
+
+// openChainSwingStoreExportData returns a reader of the swing-store export
+// data committed in the application database at height.
+func openChainSwingStoreExportData(db dbm.DB, height int64) (agoric.KVEntryReader, error) {
+	key := storetypes.NewKVStoreKey(swingsettypes.StoreKey)
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	ms, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load state at height %d: %w", height, err)
+	}
+	swingStore := swingsetkeeper.NewSwingStoreFromModuleStore(ms.GetKVStore(key))
+	return agoric.NewKVIteratorReader(swingStore.Iterator(nil, nil)), nil
+}
@@ -21,6 +21,43 @@ type Action interface {
 	GetActionHeader() *ActionHeader
 }
 
+// blockTimeQuantumContextKey is the sdk.Context key of the block time quantum
+// set by WithBlockTimeQuantum.
+type blockTimeQuantumContextKey struct{}
+
+// WithBlockTimeQuantum returns a copy of ctx in which the block times of
+// action headers are rounded down to a multiple of quantumSeconds, as
+// SwingSet's timer sees them.
+func WithBlockTimeQuantum(ctx sdk.Context, quantumSeconds uint64) sdk.Context {
+	return ctx.WithValue(blockTimeQuantumContextKey{}, quantumSeconds)
+}
+
+// minBlockTimeContextKey is the sdk.Context key of the minimum block time set
+// by WithMinBlockTime.
+type minBlockTimeContextKey struct{}
+
+// WithMinBlockTime returns a copy of ctx in which the block times of action
+// headers are raised to at least seconds, once quantized, so that they do not
+// go below a time that SwingSet has already seen.
+func WithMinBlockTime(ctx sdk.Context, seconds int64) sdk.Context {
+	return ctx.WithValue(minBlockTimeContextKey{}, seconds)
+}
+
+// QuantizeUnixTime returns seconds rounded down to a multiple of
+// quantumSeconds, or seconds itself when quantumSeconds is at most 1.
+func QuantizeUnixTime(seconds int64, quantumSeconds uint64) int64 {
+	quantum := int64(quantumSeconds)
+	if quantum <= 1 {
+		return seconds
+	}
+	// Round toward negative infinity, even before the epoch.
+	remainder := seconds % quantum
+	if remainder < 0 {
+		remainder += quantum
+	}
+	return seconds - remainder
+}
+
 // ActionPusher enqueues data for later consumption by the controller.
 type ActionPusher func(ctx sdk.Context, action Action) error
 
@@ -30,7 +67,9 @@ type ActionHeader struct {
 	Type string `json:"type,omitempty"`
 	// BlockHeight defaults to sdk.Context.BlockHeight().
 	BlockHeight int64 `json:"blockHeight,omitempty"`
-	// BlockTime defaults to sdk.Context.BlockTime().Unix().
+	// BlockTime defaults to sdk.Context.BlockTime().Unix(), and is rounded down
+	// to the quantum of WithBlockTimeQuantum, if any, then raised to the
+	// minimum of WithMinBlockTime, if any.
 	BlockTime int64 `json:"blockTime,omitempty"`
 }
 
@@ -56,6 +95,18 @@ func SetActionHeaderFromContext(ctx sdk.Context, actionType string, ah *ActionHe
 			ah.BlockTime = blockTime.Unix()
 		}
 	}
+
+	// Quantize even a preset block time, such as that of a header populated
+	// before the quantum was known, so that no action reveals a finer one.
+	if ah.BlockTime == 0 || ctx.Context() == nil {
+		return
+	}
+	if quantum, ok := ctx.Value(blockTimeQuantumContextKey{}).(uint64); ok {
+		ah.BlockTime = QuantizeUnixTime(ah.BlockTime, quantum)
+	}
+	if minTime, ok := ctx.Value(minBlockTimeContextKey{}).(int64); ok && ah.BlockTime < minTime {
+		ah.BlockTime = minTime
+	}
 }
 
 // PopulateAction returns a clone of action in which empty/zero-valued fields
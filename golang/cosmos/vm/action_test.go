@@ -1,6 +1,7 @@
 package vm_test
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
@@ -121,6 +122,30 @@ func TestActionContext(t *testing.T) {
 			dataAction{Data: []byte("hello2"),
 				ActionHeader: &vm.ActionHeader{Type: "DATA_ACTION2", BlockHeight: 999, BlockTime: 2}},
 		},
+		{"data action quantized default BlockTime",
+			vm.WithBlockTimeQuantum(emptyCtx.WithContext(context.Background()).WithBlockHeight(998).WithBlockTime(time.Unix(1_000_059, 0)), 60),
+			dataAction{Data: []byte("hello3")},
+			dataAction{Data: []byte("hello3"),
+				ActionHeader: &vm.ActionHeader{Type: "DATA_ACTION", BlockHeight: 998, BlockTime: 1_000_020}},
+		},
+		{"data action quantized override BlockTime",
+			vm.WithBlockTimeQuantum(emptyCtx.WithContext(context.Background()).WithBlockHeight(998).WithBlockTime(time.Unix(1_000_059, 0)), 60),
+			dataAction{Data: []byte("hello3"),
+				ActionHeader: &vm.ActionHeader{BlockTime: 1_000_079}},
+			dataAction{Data: []byte("hello3"),
+				ActionHeader: &vm.ActionHeader{Type: "DATA_ACTION", BlockHeight: 998, BlockTime: 1_000_020}},
+		},
+		{"data action quantized BlockTime raised to the minimum",
+			vm.WithMinBlockTime(vm.WithBlockTimeQuantum(emptyCtx.WithContext(context.Background()).WithBlockHeight(998).WithBlockTime(time.Unix(1_000_059, 0)), 3600), 1_000_040),
+			dataAction{Data: []byte("hello3")},
+			dataAction{Data: []byte("hello3"),
+				ActionHeader: &vm.ActionHeader{Type: "DATA_ACTION", BlockHeight: 998, BlockTime: 1_000_040}},
+		},
+		{"trivial quantum of one",
+			vm.WithBlockTimeQuantum(emptyCtx.WithContext(context.Background()).WithBlockTime(time.Unix(1_000_059, 0)), 1),
+			&Trivial{Abc: 123},
+			&Trivial{Abc: 123, ActionHeader: vm.ActionHeader{BlockTime: 1_000_059}},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,105 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/types"
+)
+
+// Bundles and swing-store export data can far exceed gRPC's default 4 MiB
+// message limit, so the transport accepts messages of any size.
+var grpcMessageSizeOptions = []grpc.CallOption{
+	grpc.MaxCallRecvMsgSize(math.MaxInt32),
+	grpc.MaxCallSendMsgSize(math.MaxInt32),
+}
+
+// grpcTransport sends requests to a VM serving the agoric.vm.VM gRPC service.
+type grpcTransport struct {
+	conn     *grpc.ClientConn
+	client   types.VMClient
+	nodePort int
+}
+
+var _ Transport = grpcTransport{}
+
+// NewGRPCTransport returns a Transport sending requests to the VM's nodePort
+// through the agoric.vm.VM gRPC service at the Unix domain socket vmSocket.
+// Requests made while the VM is not listening wait until it is, so the VM may
+// be started after agd, or restarted.
+func NewGRPCTransport(vmSocket string, nodePort int) (Transport, error) {
+	conn, err := grpc.Dial(
+		"unix://"+vmSocket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(append(grpcMessageSizeOptions, grpc.WaitForReady(true))...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return grpcTransport{conn: conn, client: types.NewVMClient(conn), nodePort: nodePort}, nil
+}
+
+func (t grpcTransport) Send(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+	msg := &types.TransportMessage{
+		Port:       int32(t.nodePort),
+		NeedsReply: needReply,
+		Data:       jsonRequest,
+	}
+	reply, err := t.client.ReceiveMessage(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+	return reply.Data, nil
+}
+
+func (t grpcTransport) Close() error {
+	return t.conn.Close()
+}
+
+// grpcAgdServer serves the agoric.vm.Agd gRPC service with an AgdServer.
+type grpcAgdServer struct {
+	agdServer *AgdServer
+}
+
+var _ types.AgdServer = grpcAgdServer{}
+
+func (s grpcAgdServer) ReceiveMessage(ctx context.Context, req *types.TransportMessage) (*types.TransportReply, error) {
+	msg := &Message{
+		Port:       int(req.Port),
+		Data:       req.Data,
+		NeedsReply: req.NeedsReply,
+	}
+	var reply string
+	if err := s.agdServer.ReceiveMessage(msg, &reply); err != nil {
+		return nil, err
+	}
+	return &types.TransportReply{Data: reply}, nil
+}
+
+// ServeGRPC serves the agoric.vm.Agd gRPC service with agdServer at the Unix
+// domain socket agdSocket, replacing any socket left there by a previous run,
+// until the returned function is called.
+func ServeGRPC(agdServer *AgdServer, agdSocket string) (func(), error) {
+	if err := os.Remove(agdSocket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", agdSocket)
+	if err != nil {
+		return nil, err
+	}
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(math.MaxInt32),
+		grpc.MaxSendMsgSize(math.MaxInt32),
+	)
+	types.RegisterAgdServer(server, grpcAgdServer{agdServer: agdServer})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return server.Stop, nil
+}
@@ -0,0 +1,102 @@
+package vm_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm/types"
+)
+
+type failingPortHandler struct{}
+
+func (failingPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	return "", errors.New("failed")
+}
+
+// fakeVM serves the agoric.vm.VM service, answering each message on port 1
+// with the reply of an agd port to it, as SwingSet does when it reads chain
+// storage while handling a block.
+type fakeVM struct {
+	agd         types.AgdClient
+	echoPort    int
+	failingPort int
+}
+
+func (f fakeVM) ReceiveMessage(ctx context.Context, req *types.TransportMessage) (*types.TransportReply, error) {
+	if req.Port != 1 {
+		return nil, errors.New("invalid port")
+	}
+	port := f.echoPort
+	if req.Data == "fail" {
+		port = f.failingPort
+	}
+	reply, err := f.agd.ReceiveMessage(ctx, &types.TransportMessage{Port: int32(port), NeedsReply: true, Data: req.Data})
+	if err != nil {
+		return nil, err
+	}
+	if !req.NeedsReply {
+		return &types.TransportReply{}, nil
+	}
+	return reply, nil
+}
+
+func TestGRPCTransport(t *testing.T) {
+	dir := t.TempDir()
+	agdSocket := filepath.Join(dir, "agd.sock")
+	vmSocket := filepath.Join(dir, "agvm.sock")
+
+	agdServer := vm.NewAgdServer()
+	echoPort := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+	failingPort := agdServer.MustRegisterPortHandler("failing", failingPortHandler{})
+	stop, err := vm.ServeGRPC(agdServer, agdSocket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	// The transport may be set up before the VM listens.
+	transport, err := vm.NewGRPCTransport(vmSocket, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := vm.NewTransportSender(transport)
+
+	conn, err := grpc.Dial("unix://"+agdSocket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	listener, err := net.Listen("unix", vmSocket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vmServer := grpc.NewServer()
+	types.RegisterVMServer(vmServer, fakeVM{agd: types.NewAgdClient(conn), echoPort: echoPort, failingPort: failingPort})
+	go func() {
+		_ = vmServer.Serve(listener)
+	}()
+	defer vmServer.Stop()
+
+	ctx := context.Background()
+	reply, err := sender(ctx, true, `{"type":"END_BLOCK"}`)
+	if want := `echo {"type":"END_BLOCK"}`; err != nil || reply != want {
+		t.Errorf("got reply %q (%v), want %q", reply, err, want)
+	}
+	if reply, err := sender(ctx, false, `{"type":"COMMIT_BLOCK"}`); err != nil || reply != "" {
+		t.Errorf("got reply %q (%v) without needReply", reply, err)
+	}
+	if _, err := sender(ctx, true, "fail"); err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("got error %v, want the failure of agd's handler", err)
+	}
+	if _, err := sender(ctx, false, "shutdown"); err != nil {
+		t.Errorf("cannot shut down: %v", err)
+	}
+}
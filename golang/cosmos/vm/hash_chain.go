@@ -0,0 +1,108 @@
+package vm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+)
+
+// hashChainLink is the content of each element hashed into a HashChain.
+type hashChainLink struct {
+	Kind  string `json:"kind"`
+	Port  int    `json:"port,omitempty"`
+	Data  string `json:"data"`
+	Error string `json:"error,omitempty"`
+}
+
+// HashChain computes a running SHA-256 digest of the messages crossing the
+// bridge during a block, so that validators whose VMs diverge commit to
+// different state in that very block.  Each link hashes the previous digest
+// together with one request or reply, in the order they cross the bridge.
+// Messages are only recorded between Begin and End, and must therefore be
+// deterministic across validators.
+type HashChain struct {
+	mtx    sync.Mutex
+	active bool
+	digest []byte
+}
+
+// NewHashChain returns an inactive HashChain.
+func NewHashChain() *HashChain {
+	return &HashChain{}
+}
+
+// Begin starts recording messages, chaining from seed (such as the digest of
+// the previous block).
+func (c *HashChain) Begin(seed []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.active = true
+	c.digest = append([]byte{}, seed...)
+}
+
+// End stops recording messages, returning the resulting digest, or nil if the
+// chain was not begun.
+func (c *HashChain) End() []byte {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.active {
+		return nil
+	}
+	c.active = false
+	return c.digest
+}
+
+// IsActive returns whether messages are being recorded.
+func (c *HashChain) IsActive() bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.active
+}
+
+// record extends the chain with a link, if it is active.
+func (c *HashChain) record(kind string, port int, data string, err error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.active {
+		return
+	}
+	link := hashChainLink{Kind: kind, Port: port, Data: data}
+	if err != nil {
+		link.Error = err.Error()
+	}
+	bz, jerr := json.Marshal(link)
+	if jerr != nil {
+		// Cannot happen for a struct of strings and ints.
+		panic(jerr)
+	}
+	h := sha256.New()
+	h.Write(c.digest)
+	h.Write(bz)
+	c.digest = h.Sum(nil)
+}
+
+// WrapSender returns a Sender that records the downcalls made through sender,
+// and their replies.
+func (c *HashChain) WrapSender(sender Sender) Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		c.record(JournalDowncall, 0, jsonRequest, nil)
+		reply, err := sender(ctx, needReply, jsonRequest)
+		c.record(JournalReply, 0, reply, err)
+		return reply, err
+	}
+}
+
+// hashChainPortHandler records the upcalls to a port, and their replies.
+type hashChainPortHandler struct {
+	chain *HashChain
+	port  int
+	inner PortHandler
+}
+
+func (h hashChainPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	h.chain.record(JournalUpcall, h.port, str, nil)
+	reply, err := h.inner.Receive(ctx, str)
+	h.chain.record(JournalReply, h.port, reply, err)
+	return reply, err
+}
@@ -0,0 +1,60 @@
+package vm_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// runBlock sends a downcall through a chain seeded with seed, during which the
+// VM makes an upcall with upcallData, returning the block's digest.
+func runBlock(t *testing.T, seed []byte, upcallData string) []byte {
+	t.Helper()
+	chain := vm.NewHashChain()
+	agdServer := vm.NewAgdServer()
+	agdServer.SetHashChain(chain)
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+
+	vmSender := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		var reply string
+		err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: upcallData}, &reply)
+		return reply, err
+	}
+	sender := chain.WrapSender(vmSender)
+
+	// Messages before the block begins are not recorded.
+	if _, err := sender(context.Background(), true, `"init"`); err != nil {
+		t.Fatal(err)
+	}
+
+	chain.Begin(seed)
+	defer agdServer.SetControllerContext(sdk.Context{}.WithContext(context.Background()))()
+	if _, err := sender(context.Background(), true, `"block"`); err != nil {
+		t.Fatal(err)
+	}
+	return chain.End()
+}
+
+func TestHashChain(t *testing.T) {
+	first := runBlock(t, nil, `"up"`)
+	if len(first) != 32 {
+		t.Fatalf("got digest %x, want 32 bytes", first)
+	}
+	if again := runBlock(t, nil, `"up"`); !bytes.Equal(again, first) {
+		t.Errorf("identical blocks got digests %x and %x", first, again)
+	}
+	if diverged := runBlock(t, nil, `"down"`); bytes.Equal(diverged, first) {
+		t.Error("a different upcall did not change the digest")
+	}
+	if chained := runBlock(t, first, `"up"`); bytes.Equal(chained, first) {
+		t.Error("the seed did not change the digest")
+	}
+
+	if digest := vm.NewHashChain().End(); digest != nil {
+		t.Errorf("unbegun chain got digest %x", digest)
+	}
+}
@@ -0,0 +1,171 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// HealthCheckActionType is the type of the message with which the VM is
+// pinged, synchronized with the JS side in
+// packages/internal/src/action-types.js.  It is answered by chain-main.js
+// without touching kernel state, so pings may be sent between any two block
+// downcalls once the VM is initialized.
+const HealthCheckActionType = "HEALTH_CHECK"
+
+// VM health gauges, exported as swingset_vm_* metrics by the telemetry sink.
+var (
+	metricKeyVMHealthy     = []string{"swingset", "vm_healthy"}
+	metricKeyVMPingLatency = []string{"swingset", "vm_ping_latency_ms"}
+)
+
+type healthCheckAction struct {
+	Type string `json:"type"` // "HEALTH_CHECK"
+}
+
+// HealthStatus is the outcome of the most recent pings of the VM.
+type HealthStatus struct {
+	// Enabled is false if the VM is not being pinged.
+	Enabled bool
+	// Healthy is true if the last ping was answered within the timeout.
+	Healthy bool
+	// LastSuccess is the time at which a ping was last answered, or zero.
+	LastSuccess time.Time
+	// Latency is the time taken to answer the last answered ping.
+	Latency time.Duration
+	// ConsecutiveFailures is the number of pings since the last answered one.
+	ConsecutiveFailures uint64
+	// LastError describes the last failed ping, or is empty.
+	LastError string
+}
+
+// HealthMonitor pings the VM over the bridge between blocks, so that a wedged
+// kernel can be detected before the chain halts.  The bridge carries one
+// request at a time, so pings are sent only by Ping, which must be called from
+// the goroutine that makes the block downcalls; a timer meanwhile reports the
+// VM unhealthy if a ping goes unanswered for too long.
+type HealthMonitor struct {
+	sender   Sender
+	interval time.Duration
+	timeout  time.Duration
+
+	mtx     sync.Mutex
+	status  HealthStatus
+	started bool
+	// lastPing is the time at which the last ping was sent.
+	lastPing time.Time
+	// pending is the start of the ping that is awaiting an answer, or zero.
+	pending time.Time
+	// overdue is true if the pending ping has outlasted the timeout.
+	overdue bool
+}
+
+// NewHealthMonitor returns a HealthMonitor which pings the VM through sender
+// at most every interval, counting it unhealthy if a ping is unanswered after
+// timeout.  The monitor does nothing until it is started.
+func NewHealthMonitor(sender Sender, interval, timeout time.Duration) *HealthMonitor {
+	return &HealthMonitor{
+		sender:   sender,
+		interval: interval,
+		timeout:  timeout,
+	}
+}
+
+// Start enables the pinging of the VM, which must already be initialized.
+func (m *HealthMonitor) Start() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.started = true
+	m.status.Enabled = true
+}
+
+// Stop disables the pinging of the VM.
+func (m *HealthMonitor) Stop() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.started = false
+	m.status.Enabled = false
+}
+
+// Status returns the outcome of the most recent pings.
+func (m *HealthMonitor) Status() HealthStatus {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.status
+}
+
+// MaybePing pings the VM as Ping does if the monitor is started and the
+// interval has elapsed since the last ping.  It must be called from the
+// goroutine that makes the block downcalls, between them.
+func (m *HealthMonitor) MaybePing() {
+	m.mtx.Lock()
+	due := m.started && (m.lastPing.IsZero() || time.Since(m.lastPing) >= m.interval)
+	m.mtx.Unlock()
+	if due {
+		m.Ping()
+	}
+}
+
+// Ping pings the VM once and waits for its answer, reporting the VM unhealthy
+// in the meantime once the timeout has passed.
+func (m *HealthMonitor) Ping() HealthStatus {
+	start := time.Now()
+	m.mtx.Lock()
+	m.lastPing = start
+	m.pending = start
+	m.overdue = false
+	m.mtx.Unlock()
+
+	watchdog := time.AfterFunc(m.timeout, func() { m.recordOverdue(start) })
+	bz, err := json.Marshal(healthCheckAction{Type: HealthCheckActionType})
+	if err == nil {
+		_, err = m.sender(context.Background(), true, string(bz))
+	}
+	watchdog.Stop()
+	return m.record(start, err)
+}
+
+// recordOverdue reports the VM unhealthy for not yet answering the ping sent
+// at start.
+func (m *HealthMonitor) recordOverdue(start time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.pending != start {
+		return
+	}
+	m.overdue = true
+	m.status.Healthy = false
+	m.status.ConsecutiveFailures++
+	m.status.LastError = fmt.Sprintf("VM has not answered a ping for %s", m.timeout)
+	telemetry.SetGauge(0, metricKeyVMHealthy...)
+}
+
+func (m *HealthMonitor) record(start time.Time, err error) HealthStatus {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	overdue := m.overdue
+	m.pending = time.Time{}
+	m.overdue = false
+	if err != nil {
+		m.status.Healthy = false
+		if !overdue {
+			m.status.ConsecutiveFailures++
+		}
+		m.status.LastError = err.Error()
+		telemetry.SetGauge(0, metricKeyVMHealthy...)
+		return m.status
+	}
+	now := time.Now()
+	m.status.Healthy = true
+	m.status.LastSuccess = now
+	m.status.Latency = now.Sub(start)
+	m.status.ConsecutiveFailures = 0
+	m.status.LastError = ""
+	telemetry.SetGauge(1, metricKeyVMHealthy...)
+	telemetry.SetGauge(float32(m.status.Latency.Milliseconds()), metricKeyVMPingLatency...)
+	return m.status
+}
@@ -0,0 +1,83 @@
+package vm_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestHealthMonitor(t *testing.T) {
+	answers := make(chan error, 1)
+	var pings int32
+	sender := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		atomic.AddInt32(&pings, 1)
+		if jsonRequest != `{"type":"HEALTH_CHECK"}` {
+			return "", fmt.Errorf("unexpected ping %s", jsonRequest)
+		}
+		return "true", <-answers
+	}
+	monitor := vm.NewHealthMonitor(sender, time.Hour, 20*time.Millisecond)
+
+	if status := monitor.Status(); status.Enabled || status.Healthy {
+		t.Errorf("unstarted monitor reports %+v", status)
+	}
+	monitor.MaybePing()
+	if n := atomic.LoadInt32(&pings); n != 0 {
+		t.Errorf("unstarted monitor pinged %d times", n)
+	}
+
+	monitor.Start()
+	answers <- nil
+	monitor.MaybePing()
+	status := monitor.Status()
+	if !status.Enabled || !status.Healthy || status.LastSuccess.IsZero() || status.ConsecutiveFailures != 0 {
+		t.Errorf("unexpected status after answered ping %+v", status)
+	}
+
+	// The next ping is not due for an hour.
+	monitor.MaybePing()
+	if n := atomic.LoadInt32(&pings); n != 1 {
+		t.Errorf("got %d pings before the interval elapsed, want 1", n)
+	}
+
+	answers <- errors.New("boom")
+	status = monitor.Ping()
+	if status.Healthy || status.ConsecutiveFailures != 1 || status.LastError != "boom" {
+		t.Errorf("unexpected status after failed ping %+v", status)
+	}
+
+	// A wedged VM is reported unhealthy while the ping is unanswered.
+	done := make(chan vm.HealthStatus)
+	go func() { done <- monitor.Ping() }()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status = monitor.Status()
+		if status.ConsecutiveFailures == 2 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if status.Healthy || status.ConsecutiveFailures != 2 || !strings.Contains(status.LastError, "has not answered") {
+		t.Errorf("unexpected status while wedged %+v", status)
+	}
+	answers <- nil
+	status = <-done
+	if !status.Healthy || status.ConsecutiveFailures != 0 || status.LastError != "" {
+		t.Errorf("unexpected status after recovery %+v", status)
+	}
+
+	// A stopped monitor reports that it is disabled.
+	monitor.Stop()
+	if status := monitor.Status(); status.Enabled {
+		t.Errorf("stopped monitor reports %+v", status)
+	}
+	if n := atomic.LoadInt32(&pings); n != 3 {
+		t.Errorf("got %d pings, want 3", n)
+	}
+}
@@ -0,0 +1,274 @@
+package vm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	// JournalDowncall marks a request from Go to the VM.
+	JournalDowncall = "downcall"
+	// JournalUpcall marks a request from the VM to Go.
+	JournalUpcall = "upcall"
+	// JournalReply marks the reply to the request with the same sequence number.
+	JournalReply = "reply"
+	// JournalCheckpoint marks a point at which every prior request was answered.
+	JournalCheckpoint = "checkpoint"
+)
+
+// JournalEntry is a single line of a Journal.
+type JournalEntry struct {
+	Seq    uint64 `json:"seq"`
+	Kind   string `json:"kind"`
+	Port   int    `json:"port,omitempty"`
+	Data   string `json:"data,omitempty"`
+	Error  string `json:"error,omitempty"`
+	Height int64  `json:"height,omitempty"`
+	// Committed is, for a checkpoint, the sequence number that the VM was told
+	// it committed with the block at Height.
+	Committed uint64 `json:"committed,omitempty"`
+}
+
+// JournalDivergence describes how the bridge traffic recorded before a restart
+// failed to complete.
+type JournalDivergence struct {
+	// Unanswered are the requests for which no reply was recorded.
+	Unanswered []JournalEntry
+	// Gap is non-zero if a sequence number was skipped, and is then the first
+	// missing one.
+	Gap uint64
+}
+
+func (d *JournalDivergence) Error() string {
+	if d.Gap != 0 {
+		return fmt.Sprintf("bridge journal is missing sequence number %d", d.Gap)
+	}
+	first := d.Unanswered[0]
+	return fmt.Sprintf(
+		"bridge journal has %d unanswered request(s), first %s #%d on port %d",
+		len(d.Unanswered), first.Kind, first.Seq, first.Port,
+	)
+}
+
+// Journal is a write-ahead log of the messages crossing the bridge between Go
+// and the VM.  Each request is recorded with a sequence number before it is
+// delivered, and its reply is recorded once it is returned, so that after a
+// crash the requests which were in flight can be identified.  The journal is
+// truncated at each Checkpoint.
+type Journal struct {
+	mtx     sync.Mutex
+	file    *os.File
+	lastSeq uint64
+	// committed is the sequence number most recently returned by Commit.
+	committed uint64
+	// checkpoint is the last checkpoint read when the journal was opened.
+	checkpoint *JournalEntry
+}
+
+// OpenJournal opens the journal at path, creating it if necessary.  If the
+// journal records requests which were never answered, they are described by
+// the returned *JournalDivergence, and the journal is nonetheless ready for
+// use: the divergence is for the caller to report.
+func OpenJournal(path string) (*Journal, *JournalDivergence, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, nil, err
+	}
+	lastSeq, validSize, checkpoint, divergence, err := replayJournal(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	// Drop any torn final line so that it does not corrupt the next entry.
+	if err := file.Truncate(validSize); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	if _, err := file.Seek(validSize, io.SeekStart); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return &Journal{file: file, lastSeq: lastSeq, checkpoint: checkpoint}, divergence, nil
+}
+
+// replayJournal reads the entries of a journal, returning the last sequence
+// number it used, the size of its complete entries, its last checkpoint, and
+// any divergence.  A torn final line, as left by a crash during a write, is
+// ignored.
+func replayJournal(r io.Reader) (uint64, int64, *JournalEntry, *JournalDivergence, error) {
+	var lastSeq uint64
+	var checkpoint *JournalEntry
+	var gap uint64
+	var validSize int64
+	pending := map[uint64]JournalEntry{}
+	var order []uint64
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, 0, nil, nil, err
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return 0, 0, nil, nil, fmt.Errorf("corrupt bridge journal entry at offset %d: %w", validSize, err)
+		}
+		validSize += int64(len(line))
+
+		switch entry.Kind {
+		case JournalCheckpoint:
+			entry := entry
+			checkpoint = &entry
+			lastSeq = entry.Seq
+			pending = map[uint64]JournalEntry{}
+			order = nil
+		case JournalDowncall, JournalUpcall:
+			if entry.Seq != lastSeq+1 && gap == 0 {
+				gap = lastSeq + 1
+			}
+			lastSeq = entry.Seq
+			pending[entry.Seq] = entry
+			order = append(order, entry.Seq)
+		case JournalReply:
+			delete(pending, entry.Seq)
+		default:
+			return 0, 0, nil, nil, fmt.Errorf("unknown bridge journal entry kind %q", entry.Kind)
+		}
+	}
+
+	var unanswered []JournalEntry
+	for _, seq := range order {
+		if entry, ok := pending[seq]; ok {
+			unanswered = append(unanswered, entry)
+		}
+	}
+	if gap == 0 && len(unanswered) == 0 {
+		return lastSeq, validSize, checkpoint, nil, nil
+	}
+	return lastSeq, validSize, checkpoint, &JournalDivergence{Unanswered: unanswered, Gap: gap}, nil
+}
+
+func (j *Journal) write(entry JournalEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
+
+// begin records a request, returning its sequence number.
+func (j *Journal) begin(kind string, port int, data string) (uint64, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	seq := j.lastSeq + 1
+	if err := j.write(JournalEntry{Seq: seq, Kind: kind, Port: port, Data: data}); err != nil {
+		return 0, err
+	}
+	j.lastSeq = seq
+	return seq, nil
+}
+
+// end records the reply to the request with sequence number seq.
+func (j *Journal) end(seq uint64, reply string, replyErr error) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	entry := JournalEntry{Seq: seq, Kind: JournalReply, Data: reply}
+	if replyErr != nil {
+		entry.Error = replyErr.Error()
+	}
+	return j.write(entry)
+}
+
+// Commit returns the sequence number of the most recently journaled request,
+// for the VM to save with the block it is about to commit, and remembers it
+// for the next Checkpoint.
+func (j *Journal) Commit() uint64 {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.committed = j.lastSeq
+	return j.committed
+}
+
+// Checkpoint discards the journal entries so far, all of whose requests must
+// have been answered, leaving a record of the sequence number and block height
+// reached, and of the sequence number last given by Commit.
+func (j *Journal) Checkpoint(height int64) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	if err := j.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := j.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return j.write(JournalEntry{Seq: j.lastSeq, Kind: JournalCheckpoint, Height: height, Committed: j.committed})
+}
+
+// LastCheckpoint returns the block height and the committed sequence number
+// of the checkpoint that ended the journal when it was opened, with ok false
+// if there was none recording a commit.  The VM is expected to have saved the same sequence
+// number with the same block.
+func (j *Journal) LastCheckpoint() (height int64, committed uint64, ok bool) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	if j.checkpoint == nil || j.checkpoint.Committed == 0 {
+		return 0, 0, false
+	}
+	return j.checkpoint.Height, j.checkpoint.Committed, true
+}
+
+// LastSeq returns the sequence number of the most recently journaled request.
+func (j *Journal) LastSeq() uint64 {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	return j.lastSeq
+}
+
+// Close closes the underlying file.
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
+
+// WrapSender returns a Sender that journals the downcalls made through sender.
+func (j *Journal) WrapSender(sender Sender) Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		seq, err := j.begin(JournalDowncall, 0, jsonRequest)
+		if err != nil {
+			return "", err
+		}
+		reply, err := sender(ctx, needReply, jsonRequest)
+		if jerr := j.end(seq, reply, err); jerr != nil && err == nil {
+			err = jerr
+		}
+		return reply, err
+	}
+}
+
+// journaledPortHandler journals the upcalls to a port.
+type journaledPortHandler struct {
+	journal *Journal
+	port    int
+	inner   PortHandler
+}
+
+func (h journaledPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	seq, err := h.journal.begin(JournalUpcall, h.port, str)
+	if err != nil {
+		return "", err
+	}
+	reply, err := h.inner.Receive(ctx, str)
+	if jerr := h.journal.end(seq, reply, err); jerr != nil && err == nil {
+		err = jerr
+	}
+	return reply, err
+}
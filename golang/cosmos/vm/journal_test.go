@@ -0,0 +1,156 @@
+package vm_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+type echoPortHandler struct{}
+
+func (echoPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	return "echo " + str, nil
+}
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bridge-journal")
+
+	journal, divergence, err := vm.OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if divergence != nil {
+		t.Fatalf("unexpected divergence in new journal: %v", divergence)
+	}
+
+	agdServer := vm.NewAgdServer()
+	agdServer.SetJournal(journal)
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+
+	// A downcall that makes an upcall before replying.
+	upcaller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		var reply string
+		if err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: jsonRequest}, &reply); err != nil {
+			return "", err
+		}
+		return reply, nil
+	}
+	sender := journal.WrapSender(upcaller)
+	reply, err := sender(context.Background(), true, `"hello"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply != `echo "hello"` {
+		t.Errorf("unexpected reply %q", reply)
+	}
+	if journal.LastSeq() != 2 {
+		t.Errorf("got last seq %d, want 2", journal.LastSeq())
+	}
+
+	failing := journal.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "", errors.New("boom")
+	})
+	if _, err := failing(context.Background(), true, `"fail"`); err == nil {
+		t.Error("expected the sender's error")
+	}
+	if err := journal.Checkpoint(10); err != nil {
+		t.Fatal(err)
+	}
+
+	// Crash while waiting for the reply to a downcall.
+	crashing := journal.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		if err := journal.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return "", errors.New("crashed")
+	})
+	_, _ = crashing(context.Background(), true, `"lost"`)
+
+	// Also simulate a torn write.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(`{"seq":5,"ki`); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	journal, divergence, err = vm.OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	agdServer.SetJournal(journal)
+	if divergence == nil {
+		t.Fatal("expected divergence after crash")
+	}
+	if divergence.Gap != 0 {
+		t.Errorf("unexpected gap %d", divergence.Gap)
+	}
+	if len(divergence.Unanswered) != 1 {
+		t.Fatalf("got %d unanswered, want 1", len(divergence.Unanswered))
+	}
+	entry := divergence.Unanswered[0]
+	if entry.Seq != 4 || entry.Kind != vm.JournalDowncall || entry.Data != `"lost"` {
+		t.Errorf("unexpected unanswered entry %+v", entry)
+	}
+	if journal.LastSeq() != 4 {
+		t.Errorf("got last seq %d after reopening, want 4", journal.LastSeq())
+	}
+
+	// The torn write was discarded, so later entries can be replayed.
+	if err := journal.Checkpoint(11); err != nil {
+		t.Fatal(err)
+	}
+	sender = journal.WrapSender(upcaller)
+	if _, err := sender(context.Background(), true, `"again"`); err != nil {
+		t.Fatal(err)
+	}
+	journal.Close()
+	journal, divergence, err = vm.OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if divergence != nil {
+		t.Errorf("unexpected divergence %v", divergence)
+	}
+	if journal.LastSeq() != 6 {
+		t.Errorf("got last seq %d, want 6", journal.LastSeq())
+	}
+	if _, _, ok := journal.LastCheckpoint(); ok {
+		t.Error("unexpected checkpoint of a commit")
+	}
+
+	// A commit is recorded by the next checkpoint, for the VM to verify.
+	if seq := journal.Commit(); seq != 6 {
+		t.Errorf("got committed seq %d, want 6", seq)
+	}
+	agdServer.SetJournal(journal)
+	sender = journal.WrapSender(upcaller)
+	if _, err := sender(context.Background(), true, `"after commit"`); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Checkpoint(12); err != nil {
+		t.Fatal(err)
+	}
+	journal.Close()
+	journal, divergence, err = vm.OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if divergence != nil {
+		t.Errorf("unexpected divergence %v", divergence)
+	}
+	height, committed, ok := journal.LastCheckpoint()
+	if !ok || height != 12 || committed != 6 {
+		t.Errorf("got checkpoint (%d, %d, %t), want (12, 6, true)", height, committed, ok)
+	}
+	if journal.LastSeq() != 8 {
+		t.Errorf("got last seq %d, want 8", journal.LastSeq())
+	}
+	journal.Close()
+}
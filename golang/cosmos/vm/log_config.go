@@ -0,0 +1,83 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// LogConfigActionType is the type of the message with which the VM's logging
+// is reconfigured, synchronized with the JS side in
+// packages/internal/src/action-types.js.  Like a ping, it touches no kernel
+// state, so it may be sent at any time once the VM is initialized.
+const LogConfigActionType = "SET_LOG_CONFIG"
+
+type logConfigAction struct {
+	Type string `json:"type"` // "SET_LOG_CONFIG"
+	// SlogExcludeTypes are the types of the slog entries to leave out of the
+	// slog, replacing any previously configured.
+	SlogExcludeTypes []string `json:"slogExcludeTypes"`
+}
+
+// SendLogConfig configures the logging of the VM through sender, which must
+// not be part of consensus.
+func SendLogConfig(ctx context.Context, sender Sender, slogExcludeTypes []string) error {
+	if slogExcludeTypes == nil {
+		slogExcludeTypes = []string{}
+	}
+	bz, err := json.Marshal(logConfigAction{Type: LogConfigActionType, SlogExcludeTypes: slogExcludeTypes})
+	if err != nil {
+		return err
+	}
+	_, err = sender(ctx, true, string(bz))
+	return err
+}
+
+// LogConfig holds the logging options which can be changed while running.
+type LogConfig struct {
+	// SlowCallThreshold and LogMessages configure the BridgeMetrics.
+	SlowCallThreshold time.Duration
+	LogMessages       bool
+	// SlogExcludeTypes configures the VM's slog.
+	SlogExcludeTypes []string
+}
+
+// LogConfigReloader applies the logging options requested from any goroutine,
+// such as that of a signal handler, on the goroutine calling Apply, so that
+// they are sent to the VM between its other messages rather than concurrently
+// with them.
+type LogConfigReloader struct {
+	mtx           sync.Mutex
+	pending       *LogConfig
+	bridgeMetrics *BridgeMetrics
+	sender        Sender
+}
+
+// NewLogConfigReloader returns a LogConfigReloader configuring bridgeMetrics
+// and, through sender, the VM.
+func NewLogConfigReloader(bridgeMetrics *BridgeMetrics, sender Sender) *LogConfigReloader {
+	return &LogConfigReloader{bridgeMetrics: bridgeMetrics, sender: sender}
+}
+
+// Request arranges for config to be applied by the next Apply, replacing any
+// config not yet applied.
+func (r *LogConfigReloader) Request(config LogConfig) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.pending = &config
+}
+
+// Apply applies the config last requested, if any, returning whether there
+// was one.
+func (r *LogConfigReloader) Apply(ctx context.Context) (bool, error) {
+	r.mtx.Lock()
+	config := r.pending
+	r.pending = nil
+	r.mtx.Unlock()
+	if config == nil {
+		return false, nil
+	}
+	r.bridgeMetrics.SetLogging(config.SlowCallThreshold, config.LogMessages)
+	return true, SendLogConfig(ctx, r.sender, config.SlogExcludeTypes)
+}
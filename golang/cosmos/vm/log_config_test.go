@@ -0,0 +1,71 @@
+package vm_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestLogConfigReloader(t *testing.T) {
+	var logged bytes.Buffer
+	bridgeMetrics := vm.NewBridgeMetrics(log.NewTMLogger(log.NewSyncWriter(&logged)), 0)
+	var sent []string
+	reloader := vm.NewLogConfigReloader(bridgeMetrics, func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		sent = append(sent, jsonRequest)
+		return "true", nil
+	})
+
+	if applied, err := reloader.Apply(context.Background()); applied || err != nil {
+		t.Errorf("applied %t, %v without a request", applied, err)
+	}
+
+	// Requests from other goroutines reach the VM only when applied, the last
+	// replacing the others.
+	var wg sync.WaitGroup
+	for _, slogExcludeTypes := range [][]string{{"syscall"}, {"deliver"}} {
+		wg.Add(1)
+		go func(slogExcludeTypes []string) {
+			defer wg.Done()
+			reloader.Request(vm.LogConfig{SlogExcludeTypes: slogExcludeTypes})
+		}(slogExcludeTypes)
+		wg.Wait()
+	}
+	reloader.Request(vm.LogConfig{LogMessages: true, SlogExcludeTypes: []string{"syscall-result"}})
+	if len(sent) != 0 {
+		t.Fatalf("sent %v before applying", sent)
+	}
+	if applied, err := reloader.Apply(context.Background()); !applied || err != nil {
+		t.Fatalf("applied %t, %v", applied, err)
+	}
+	if len(sent) != 1 || sent[0] != `{"type":"SET_LOG_CONFIG","slogExcludeTypes":["syscall-result"]}` {
+		t.Errorf("sent %v, want the last config", sent)
+	}
+	if applied, _ := reloader.Apply(context.Background()); applied || len(sent) != 1 {
+		t.Errorf("applied the config again: %v", sent)
+	}
+
+	sender := bridgeMetrics.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "true", nil
+	})
+	if _, err := sender(context.Background(), true, `{"type":"BEGIN_BLOCK"}`); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logged.String(), "type=BEGIN_BLOCK") {
+		t.Errorf("got log %q, want the BEGIN_BLOCK call", logged.String())
+	}
+
+	// An absent filter clears the VM's.
+	reloader.Request(vm.LogConfig{})
+	if _, err := reloader.Apply(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if sent[1] != `{"type":"SET_LOG_CONFIG","slogExcludeTypes":[]}` {
+		t.Errorf("sent %s, want an empty filter", sent[1])
+	}
+}
@@ -0,0 +1,126 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Bridge latency histograms, exported as swingset_bridge_* metrics by the
+// telemetry sink.
+var (
+	metricKeyBridgeDowncall = []string{"swingset", "bridge_downcall"}
+	metricKeyBridgeUpcall   = []string{"swingset", "bridge_upcall"}
+)
+
+// unknownMessageType labels messages without a "type" or "method".
+const unknownMessageType = "unknown"
+
+// BridgeMetrics measures the latency of the messages crossing the bridge, by
+// port and message type, and logs those slower than a threshold, or all of
+// them.  What it logs may be changed while it is in use.
+type BridgeMetrics struct {
+	logger log.Logger
+
+	mtx               sync.Mutex
+	slowCallThreshold time.Duration
+	logMessages       bool
+}
+
+// NewBridgeMetrics returns a BridgeMetrics which logs to logger every message
+// taking longer than slowCallThreshold to answer, or none if it is zero.
+func NewBridgeMetrics(logger log.Logger, slowCallThreshold time.Duration) *BridgeMetrics {
+	return &BridgeMetrics{logger: logger, slowCallThreshold: slowCallThreshold}
+}
+
+// SetLogging changes the threshold above which messages are logged as slow,
+// and whether every message is logged regardless.
+func (m *BridgeMetrics) SetLogging(slowCallThreshold time.Duration, logMessages bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.slowCallThreshold = slowCallThreshold
+	m.logMessages = logMessages
+}
+
+// bridgeMessageType returns the "type" (for actions) or "method" (for storage
+// messages) of a JSON message, for use as a metric label.
+func bridgeMessageType(data string) string {
+	var msg struct {
+		Type   string `json:"type"`
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal([]byte(data), &msg); err != nil {
+		return unknownMessageType
+	}
+	switch {
+	case msg.Type != "":
+		return msg.Type
+	case msg.Method != "":
+		return msg.Method
+	default:
+		return unknownMessageType
+	}
+}
+
+// observe records the latency of a message sent at start.
+func (m *BridgeMetrics) observe(key []string, kind, port, msgType string, start time.Time, err error) {
+	labels := []metrics.Label{telemetry.NewLabel("type", msgType)}
+	if port != "" {
+		labels = append(labels, telemetry.NewLabel("port", port))
+	}
+	metrics.MeasureSinceWithLabels(key, start, labels)
+
+	elapsed := time.Since(start)
+	m.mtx.Lock()
+	slowCallThreshold, logMessages := m.slowCallThreshold, m.logMessages
+	m.mtx.Unlock()
+	slow := slowCallThreshold != 0 && elapsed > slowCallThreshold
+	if !slow && !logMessages {
+		return
+	}
+	keyvals := []interface{}{"kind", kind, "type", msgType, "duration", elapsed}
+	if slow {
+		keyvals = append(keyvals, "threshold", slowCallThreshold)
+	}
+	if port != "" {
+		keyvals = append(keyvals, "port", port)
+	}
+	if err != nil {
+		keyvals = append(keyvals, "err", err)
+	}
+	if slow {
+		m.logger.Info("slow bridge call", keyvals...)
+	} else {
+		m.logger.Info("bridge call", keyvals...)
+	}
+}
+
+// WrapSender returns a Sender which measures each downcall made through
+// sender.
+func (m *BridgeMetrics) WrapSender(sender Sender) Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		start := time.Now()
+		reply, err := sender(ctx, needReply, jsonRequest)
+		m.observe(metricKeyBridgeDowncall, JournalDowncall, "", bridgeMessageType(jsonRequest), start, err)
+		return reply, err
+	}
+}
+
+// metricsPortHandler measures the upcalls to a port.
+type metricsPortHandler struct {
+	metrics *BridgeMetrics
+	port    string
+	inner   PortHandler
+}
+
+func (h metricsPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	start := time.Now()
+	reply, err := h.inner.Receive(ctx, str)
+	h.metrics.observe(metricKeyBridgeUpcall, JournalUpcall, h.port, bridgeMessageType(str), start, err)
+	return reply, err
+}
@@ -0,0 +1,105 @@
+package vm_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestBridgeMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	if _, err := metrics.NewGlobal(conf, sink); err != nil {
+		t.Fatal(err)
+	}
+
+	var logged bytes.Buffer
+	bridgeMetrics := vm.NewBridgeMetrics(log.NewTMLogger(log.NewSyncWriter(&logged)), 20*time.Millisecond)
+	agdServer := vm.NewAgdServer()
+	agdServer.SetBridgeMetrics(bridgeMetrics)
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+
+	vmSender := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		if strings.Contains(jsonRequest, "END_BLOCK") {
+			time.Sleep(30 * time.Millisecond)
+		}
+		var reply string
+		err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: `{"method":"set"}`}, &reply)
+		return reply, err
+	}
+	sender := bridgeMetrics.WrapSender(vmSender)
+	for _, request := range []string{`{"type":"BEGIN_BLOCK"}`, `{"type":"END_BLOCK"}`, `"shutdown"`} {
+		if _, err := sender(context.Background(), true, request); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	intervals := sink.Data()
+	if len(intervals) == 0 {
+		t.Fatal("no metrics recorded")
+	}
+	samples := intervals[0].Samples
+	expected := map[string]int{
+		"swingset.bridge_downcall;type=BEGIN_BLOCK": 1,
+		"swingset.bridge_downcall;type=END_BLOCK":   1,
+		"swingset.bridge_downcall;type=unknown":     1,
+		"swingset.bridge_upcall;type=set;port=echo": 3,
+	}
+	for key, want := range expected {
+		got, ok := samples[key]
+		if !ok {
+			t.Errorf("missing sample %q; have %v", key, samples)
+			continue
+		}
+		if got.Count != want {
+			t.Errorf("sample %q: got count %d, want %d", key, got.Count, want)
+		}
+	}
+
+	// Only the call that exceeded the threshold is logged.
+	lines := strings.Split(strings.TrimSpace(logged.String()), "\n")
+	if len(lines) != 1 || !strings.Contains(lines[0], "slow bridge call") ||
+		!strings.Contains(lines[0], "type=END_BLOCK") {
+		t.Errorf("got log %q, want one slow END_BLOCK call", logged.String())
+	}
+}
+
+func TestBridgeMetricsSetLogging(t *testing.T) {
+	var logged bytes.Buffer
+	bridgeMetrics := vm.NewBridgeMetrics(log.NewTMLogger(log.NewSyncWriter(&logged)), 0)
+	sender := bridgeMetrics.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "true", nil
+	})
+	send := func() {
+		if _, err := sender(context.Background(), true, `{"type":"BEGIN_BLOCK"}`); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	send()
+	if logged.Len() != 0 {
+		t.Errorf("got log %q, want none", logged.String())
+	}
+
+	bridgeMetrics.SetLogging(0, true)
+	send()
+	if !strings.Contains(logged.String(), "bridge call") || !strings.Contains(logged.String(), "type=BEGIN_BLOCK") {
+		t.Errorf("got log %q, want the BEGIN_BLOCK call", logged.String())
+	}
+
+	logged.Reset()
+	bridgeMetrics.SetLogging(time.Hour, false)
+	send()
+	if logged.Len() != 0 {
+		t.Errorf("got log %q, want none", logged.String())
+	}
+}
@@ -0,0 +1,34 @@
+package vm_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestMockTransport(t *testing.T) {
+	sender := vm.NewTransportSender(vm.NewMockTransport())
+	ctx := context.Background()
+
+	for _, request := range []string{
+		`{"type":"AG_COSMOS_INIT","chainID":"agoriclocal"}`,
+		`{"type":"BEGIN_BLOCK","blockHeight":"1"}`,
+		`{"type":"END_BLOCK","blockHeight":"1"}`,
+		`{"type":"HEALTH_CHECK"}`,
+	} {
+		reply, err := sender(ctx, true, request)
+		if err != nil || reply != "true" {
+			t.Errorf("%s: got reply %q (%v), want %q", request, reply, err, "true")
+		}
+	}
+	if reply, err := sender(ctx, false, `{"type":"COMMIT_BLOCK"}`); err != nil || reply != "" {
+		t.Errorf("got reply %q (%v) without needReply", reply, err)
+	}
+	if _, err := sender(ctx, true, `{"type":"SWING_STORE_EXPORT","request":"initiate"}`); err == nil {
+		t.Errorf("exported a swing-store")
+	}
+	if _, err := sender(ctx, false, "shutdown"); err != nil {
+		t.Errorf("cannot shut down: %v", err)
+	}
+}
@@ -0,0 +1,308 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	recordFilePrefix = "block-"
+	recordFileSuffix = ".jsonl"
+
+	recordSessionPrefix = "session-"
+)
+
+// RecordEntry is a single line of a recording of the bridge traffic.  Requests
+// (downcalls and upcalls) are recorded before they are delivered, and replies
+// once they are returned, with the sequence number of their request.
+type RecordEntry struct {
+	Seq       uint64 `json:"seq"`
+	Kind      string `json:"kind"`
+	Port      int    `json:"port,omitempty"`
+	NeedReply bool   `json:"needReply,omitempty"`
+	Data      string `json:"data,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// recordFileName returns the name of the recording file of a block.
+func recordFileName(height int64) string {
+	return recordFilePrefix + strconv.FormatInt(height, 10) + recordFileSuffix
+}
+
+// recordFileHeight returns the block height of a recording file name, or false
+// if name is not that of a recording file.
+func recordFileHeight(name string) (int64, bool) {
+	if !strings.HasPrefix(name, recordFilePrefix) || !strings.HasSuffix(name, recordFileSuffix) {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, recordFilePrefix), recordFileSuffix), 10, 64)
+	if err != nil || height < 0 {
+		return 0, false
+	}
+	return height, true
+}
+
+// recordFileHeights returns the heights of the recording files in dir, in
+// increasing order.
+func recordFileHeights(dir string) ([]int64, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var heights []int64
+	for _, dirEntry := range dirEntries {
+		if height, ok := recordFileHeight(dirEntry.Name()); ok && !dirEntry.IsDir() {
+			heights = append(heights, height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// recordSession is a recording of an earlier run of the node, rotated into a
+// subdirectory of the record dir.
+type recordSession struct {
+	dir        string
+	lastHeight int64
+}
+
+// recordSessionName returns the name of the subdirectory of the n-th rotated
+// recording.
+func recordSessionName(n int) string {
+	return recordSessionPrefix + strconv.Itoa(n)
+}
+
+// Recorder records every message crossing the bridge between Go and the VM,
+// with its reply, in a directory holding one file per block, so that a Replay
+// can re-drive a VM with the same traffic.  Messages outside of any block
+// (such as those initializing the VM at genesis) are recorded with those of
+// the block at height 0.
+//
+// Recording is a debugging aid which must not affect consensus, so failures to
+// write the recording are logged rather than returned.
+type Recorder struct {
+	mtx          sync.Mutex
+	dir          string
+	retainBlocks int64
+	logger       log.Logger
+	file         *os.File
+	heights      []int64
+	sessions     []recordSession
+	lastSeq      uint64
+	failed       bool
+}
+
+// OpenRecorder returns a Recorder writing to dir, creating it if necessary.
+// Since a recording can only be replayed from its start, a recording already
+// in dir is first rotated into a "session-<n>" subdirectory.  If retainBlocks
+// is positive, only the recordings of that many most recent blocks are kept.
+func OpenRecorder(dir string, retainBlocks int64, logger log.Logger) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	r := &Recorder{dir: dir, retainBlocks: retainBlocks, logger: logger}
+
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	lastSession := 0
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if !dirEntry.IsDir() || !strings.HasPrefix(name, recordSessionPrefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(name, recordSessionPrefix))
+		if err != nil || n < 1 {
+			continue
+		}
+		session, err := loadRecordSession(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		r.sessions = append(r.sessions, session)
+		if n > lastSession {
+			lastSession = n
+		}
+	}
+
+	heights, err := recordFileHeights(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(heights) > 0 {
+		sessionDir := filepath.Join(dir, recordSessionName(lastSession+1))
+		if err := os.Mkdir(sessionDir, 0o755); err != nil {
+			return nil, err
+		}
+		for _, height := range heights {
+			name := recordFileName(height)
+			if err := os.Rename(filepath.Join(dir, name), filepath.Join(sessionDir, name)); err != nil {
+				return nil, err
+			}
+		}
+		r.sessions = append(r.sessions, recordSession{dir: sessionDir, lastHeight: heights[len(heights)-1]})
+		logger.Info("rotated the previous bridge recording", "dir", sessionDir, "firstHeight", heights[0], "lastHeight", heights[len(heights)-1])
+	}
+	return r, nil
+}
+
+// loadRecordSession returns the rotated recording in dir.
+func loadRecordSession(dir string) (recordSession, error) {
+	heights, err := recordFileHeights(dir)
+	if err != nil {
+		return recordSession{}, err
+	}
+	session := recordSession{dir: dir}
+	if len(heights) > 0 {
+		session.lastHeight = heights[len(heights)-1]
+	}
+	return session, nil
+}
+
+// fail logs err, once until the recording next succeeds.
+func (r *Recorder) fail(msg string, err error) {
+	if !r.failed {
+		r.logger.Error(msg, "dir", r.dir, "err", err)
+	}
+	r.failed = true
+}
+
+// openFile opens the recording file of height, which must be at least that of
+// any file opened before it.
+func (r *Recorder) openFile(height int64) error {
+	file, err := os.OpenFile(filepath.Join(r.dir, recordFileName(height)), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if r.file != nil {
+		r.file.Close()
+	}
+	r.file = file
+	if len(r.heights) == 0 || r.heights[len(r.heights)-1] != height {
+		r.heights = append(r.heights, height)
+	}
+	return nil
+}
+
+// prune removes the recordings of the blocks before those to be retained at
+// height.
+func (r *Recorder) prune(height int64) {
+	if r.retainBlocks <= 0 {
+		return
+	}
+	oldest := height - r.retainBlocks + 1
+	sessions := r.sessions[:0]
+	for _, session := range r.sessions {
+		if session.lastHeight >= oldest {
+			sessions = append(sessions, session)
+			continue
+		}
+		if err := os.RemoveAll(session.dir); err != nil {
+			r.fail("cannot prune bridge recording", err)
+			sessions = append(sessions, session)
+		}
+	}
+	r.sessions = sessions
+	for len(r.heights) > 0 && r.heights[0] < oldest {
+		err := os.Remove(filepath.Join(r.dir, recordFileName(r.heights[0])))
+		if err != nil && !os.IsNotExist(err) {
+			r.fail("cannot prune bridge recording", err)
+			return
+		}
+		r.heights = r.heights[1:]
+	}
+}
+
+// StartBlock records subsequent messages with those of the block at height,
+// pruning the recordings of blocks no longer retained.
+func (r *Recorder) StartBlock(height int64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if err := r.openFile(height); err != nil {
+		r.fail("cannot record bridge traffic", err)
+		return
+	}
+	r.failed = false
+	r.prune(height)
+}
+
+func (r *Recorder) write(entry RecordEntry) {
+	if r.file == nil {
+		if err := r.openFile(0); err != nil {
+			r.fail("cannot record bridge traffic", err)
+			return
+		}
+	}
+	bz, err := json.Marshal(entry)
+	if err == nil {
+		_, err = r.file.Write(append(bz, '\n'))
+	}
+	if err != nil {
+		r.fail("cannot record bridge traffic", err)
+	}
+}
+
+// begin records a request, returning its sequence number.
+func (r *Recorder) begin(kind string, port int, needReply bool, data string) uint64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.lastSeq++
+	r.write(RecordEntry{Seq: r.lastSeq, Kind: kind, Port: port, NeedReply: needReply, Data: data})
+	return r.lastSeq
+}
+
+// end records the reply to the request with sequence number seq.
+func (r *Recorder) end(seq uint64, reply string, replyErr error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	entry := RecordEntry{Seq: seq, Kind: JournalReply, Data: reply}
+	if replyErr != nil {
+		entry.Error = replyErr.Error()
+	}
+	r.write(entry)
+}
+
+// Close closes the current recording file.
+func (r *Recorder) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// WrapSender returns a Sender that records the downcalls made through sender.
+func (r *Recorder) WrapSender(sender Sender) Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		seq := r.begin(JournalDowncall, 0, needReply, jsonRequest)
+		reply, err := sender(ctx, needReply, jsonRequest)
+		r.end(seq, reply, err)
+		return reply, err
+	}
+}
+
+// recordedPortHandler records the upcalls to a port.
+type recordedPortHandler struct {
+	recorder *Recorder
+	port     int
+	inner    PortHandler
+}
+
+func (h recordedPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	seq := h.recorder.begin(JournalUpcall, h.port, true, str)
+	reply, err := h.inner.Receive(ctx, str)
+	h.recorder.end(seq, reply, err)
+	return reply, err
+}
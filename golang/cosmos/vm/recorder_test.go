@@ -0,0 +1,255 @@
+package vm_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// upcallingVM returns a Sender standing in for a VM which makes an upcall to
+// port of agdServer with each downcall and replies with the upcall's reply.
+func upcallingVM(agdServer *vm.AgdServer, port int, transform func(string) string) vm.Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		var reply string
+		if err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: transform(jsonRequest)}, &reply); err != nil {
+			return "", err
+		}
+		return reply, nil
+	}
+}
+
+func identity(str string) string { return str }
+
+// record records the downcalls of two blocks through an upcalling VM.
+func record(t *testing.T, dir string) int {
+	recorder, err := vm.OpenRecorder(dir, 0, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Close()
+
+	agdServer := vm.NewAgdServer()
+	agdServer.SetRecorder(recorder)
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+	sender := recorder.WrapSender(upcallingVM(agdServer, port, identity))
+
+	for _, request := range []string{`"init"`, `"one"`, `"two"`} {
+		if request == `"one"` {
+			recorder.StartBlock(1)
+		} else if request == `"two"` {
+			recorder.StartBlock(2)
+		}
+		if _, err := sender(context.Background(), true, request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return port
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	port := record(t, dir)
+
+	for _, name := range []string{"block-0.jsonl", "block-1.jsonl", "block-2.jsonl"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("missing recording file: %v", err)
+		}
+	}
+	replay, err := vm.LoadReplay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replay.Downcalls() != 3 {
+		t.Errorf("got %d downcalls, want 3", replay.Downcalls())
+	}
+	// The replaying server has no handlers: the recording answers instead.
+	agdServer := vm.NewAgdServer()
+	agdServer.SetReplay(replay)
+	replayed, err := replay.Run(context.Background(), upcallingVM(agdServer, port, identity))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed != 3 {
+		t.Errorf("replayed %d downcalls, want 3", replayed)
+	}
+}
+
+func TestReplayDivergence(t *testing.T) {
+	dir := t.TempDir()
+	port := record(t, dir)
+
+	replay, err := vm.LoadReplay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	agdServer := vm.NewAgdServer()
+	agdServer.SetReplay(replay)
+	diverging := func(str string) string {
+		if str == `"two"` {
+			return `"deux"`
+		}
+		return str
+	}
+	replayed, err := replay.Run(context.Background(), upcallingVM(agdServer, port, diverging))
+	var divergence *vm.ReplayDivergence
+	if !errors.As(err, &divergence) {
+		t.Fatalf("expected a divergence, got %v", err)
+	}
+	if replayed != 2 {
+		t.Errorf("replayed %d downcalls, want 2", replayed)
+	}
+	// The upcall of the third downcall #5 bears sequence number 6.
+	if divergence.Height != 2 || divergence.Kind != vm.JournalUpcall || divergence.Seq != 6 {
+		t.Errorf("unexpected divergence %+v", divergence)
+	}
+	if divergence.Actual != `port 1: "deux"` {
+		t.Errorf("unexpected replayed upcall %q", divergence.Actual)
+	}
+}
+
+func TestReplayTruncatedRecording(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := vm.OpenRecorder(dir, 0, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = recorder.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "", errors.New("boom")
+	})(context.Background(), true, `"fail"`)
+	if err == nil {
+		t.Fatal("expected the sender's error")
+	}
+	recorder.Close()
+
+	// Recorded errors are replayed as such.
+	replay, err := vm.LoadReplay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := replay.Run(context.Background(), func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "", errors.New("boom")
+	}); err != nil {
+		t.Errorf("unexpected replay error %v", err)
+	}
+
+	// Drop the reply, as if the node crashed awaiting it.
+	path := filepath.Join(dir, "block-0.jsonl")
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, bz[:bytes.IndexByte(bz, '\n')+1], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	replay, err = vm.LoadReplay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := replay.Run(context.Background(), func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "ok", nil
+	})
+	if err == nil || replayed != 1 {
+		t.Errorf("expected the end of the recording after 1 downcall, got %d, %v", replayed, err)
+	}
+}
+
+func TestRecorderRotation(t *testing.T) {
+	dir := t.TempDir()
+	record(t, dir)
+	port := record(t, dir)
+
+	// The first recording was rotated rather than recorded over.
+	if _, err := os.Stat(filepath.Join(dir, "session-1", "block-2.jsonl")); err != nil {
+		t.Errorf("missing rotated recording file: %v", err)
+	}
+	for _, recordDir := range []string{filepath.Join(dir, "session-1"), dir} {
+		replay, err := vm.LoadReplay(recordDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		agdServer := vm.NewAgdServer()
+		agdServer.SetReplay(replay)
+		if replayed, err := replay.Run(context.Background(), upcallingVM(agdServer, port, identity)); err != nil || replayed != 3 {
+			t.Errorf("replayed %d downcalls of %s, want 3: %v", replayed, recordDir, err)
+		}
+	}
+
+	recorder, err := vm.OpenRecorder(dir, 0, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.Close()
+	if _, err := os.Stat(filepath.Join(dir, "session-2", "block-0.jsonl")); err != nil {
+		t.Errorf("missing rotated recording file: %v", err)
+	}
+}
+
+func TestRecorderPruning(t *testing.T) {
+	dir := t.TempDir()
+	record(t, dir)
+
+	recorder, err := vm.OpenRecorder(dir, 2, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Close()
+	sender := recorder.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "ok", nil
+	})
+	for height := int64(1); height <= 5; height++ {
+		recorder.StartBlock(height)
+		if _, err := sender(context.Background(), true, `"block"`); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, dirEntry := range dirEntries {
+		names = append(names, dirEntry.Name())
+	}
+	if len(names) != 2 || names[0] != "block-4.jsonl" || names[1] != "block-5.jsonl" {
+		t.Errorf("got recording %v, want only blocks 4 and 5", names)
+	}
+	replay, err := vm.LoadReplay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replay.Downcalls() != 2 {
+		t.Errorf("got %d downcalls, want 2", replay.Downcalls())
+	}
+}
+
+func TestRecorderWriteFailure(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "record")
+	recorder, err := vm.OpenRecorder(dir, 0, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Close()
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dir, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The bridge is unaffected by the failure to record it.
+	recorder.StartBlock(1)
+	reply, err := recorder.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "ok", nil
+	})(context.Background(), true, `"request"`)
+	if err != nil || reply != "ok" {
+		t.Errorf("got reply %q, %v, want the VM's reply", reply, err)
+	}
+}
@@ -0,0 +1,262 @@
+package vm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ReplayDivergence describes the first point at which a replayed VM departed
+// from a recording.
+type ReplayDivergence struct {
+	// Height is the block of the divergent message.
+	Height int64
+	// Kind is JournalReply if the VM replied differently to a downcall, or
+	// JournalUpcall if it made a different upcall, or one too many or too few.
+	Kind string
+	// Seq is the recorded sequence number of the downcall or upcall.
+	Seq uint64
+	// Expected and Actual describe the recorded and replayed messages, and
+	// are empty where there was none.
+	Expected string
+	Actual   string
+}
+
+func (d *ReplayDivergence) Error() string {
+	return fmt.Sprintf(
+		"replay diverged at %s #%d of block %d: recorded %q, replayed %q",
+		d.Kind, d.Seq, d.Height, d.Expected, d.Actual,
+	)
+}
+
+// describeReply returns a description of a reply or its error.
+func describeReply(reply, errMsg string) string {
+	if errMsg != "" {
+		return "error: " + errMsg
+	}
+	return reply
+}
+
+// replayStep is a downcall to replay, with its recorded reply.
+type replayStep struct {
+	height   int64
+	downcall RecordEntry
+	reply    *RecordEntry
+	// upcalls is the number of upcalls to be answered before the reply.
+	upcalls int
+}
+
+// replayUpcall is an upcall to expect, with its recorded reply.
+type replayUpcall struct {
+	height  int64
+	request RecordEntry
+	reply   *RecordEntry
+}
+
+// Replay re-drives a VM with the bridge traffic captured by a Recorder: it
+// sends each recorded downcall and answers each upcall with the recorded
+// reply, checking that the VM makes the recorded upcalls in order and returns
+// the recorded replies.  Downcalls made (and upcalls received) while Go was
+// answering an upcall are not replayed, since the recorded reply to that
+// upcall stands in for them.
+type Replay struct {
+	mtx      sync.Mutex
+	steps    []replayStep
+	upcalls  []replayUpcall
+	step     int
+	answered int
+	err      error
+}
+
+// LoadReplay reads the recording in dir.
+func LoadReplay(dir string) (*Replay, error) {
+	heights, err := recordFileHeights(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(heights) == 0 {
+		return nil, fmt.Errorf("%s holds no recording", dir)
+	}
+
+	r := &Replay{}
+	// The steps and upcalls awaiting their replies, by sequence number.
+	stepOf := map[uint64]int{}
+	upcallOf := map[uint64]int{}
+	// The number of requests awaiting their replies.
+	depth := 0
+	for _, height := range heights {
+		entries, err := readRecordFile(filepath.Join(dir, recordFileName(height)))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			entry := entry
+			switch entry.Kind {
+			case JournalDowncall:
+				if depth == 0 {
+					stepOf[entry.Seq] = len(r.steps)
+					r.steps = append(r.steps, replayStep{height: height, downcall: entry})
+				}
+				depth++
+			case JournalUpcall:
+				// Only the upcalls made by the VM itself are replayed.
+				if depth <= 1 {
+					upcallOf[entry.Seq] = len(r.upcalls)
+					r.upcalls = append(r.upcalls, replayUpcall{height: height, request: entry})
+				}
+				depth++
+			case JournalReply:
+				if depth > 0 {
+					depth--
+				}
+				if i, ok := stepOf[entry.Seq]; ok {
+					r.steps[i].reply = &entry
+					r.steps[i].upcalls = len(r.upcalls)
+					delete(stepOf, entry.Seq)
+				} else if i, ok := upcallOf[entry.Seq]; ok {
+					r.upcalls[i].reply = &entry
+					delete(upcallOf, entry.Seq)
+				}
+			default:
+				return nil, fmt.Errorf("unknown recording entry kind %q in block %d", entry.Kind, height)
+			}
+		}
+	}
+	if len(r.steps) == 0 {
+		return nil, fmt.Errorf("%s records no downcalls", dir)
+	}
+	return r, nil
+}
+
+// readRecordFile returns the entries of a recording file.  A torn final line,
+// as left by a crash during a write, is ignored.
+func readRecordFile(path string) ([]RecordEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []RecordEntry
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+		var entry RecordEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("corrupt recording entry %d of %s: %w", len(entries)+1, path, err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// Downcalls returns the number of downcalls to replay.
+func (r *Replay) Downcalls() int {
+	return len(r.steps)
+}
+
+// Run sends the recorded downcalls through sender, which must deliver them
+// to a VM whose upcalls reach an AgdServer with SetReplay(r).  It returns the
+// number of downcalls replayed, and a *ReplayDivergence at the first
+// departure from the recording.
+func (r *Replay) Run(ctx context.Context, sender Sender) (int, error) {
+	for i, step := range r.steps {
+		r.mtx.Lock()
+		r.step = i
+		r.mtx.Unlock()
+
+		reply, err := sender(ctx, step.downcall.NeedReply, step.downcall.Data)
+
+		r.mtx.Lock()
+		runErr, answered := r.err, r.answered
+		r.mtx.Unlock()
+		if runErr != nil {
+			return i, runErr
+		}
+		if step.reply == nil {
+			return i + 1, fmt.Errorf("recording ends before the reply to downcall #%d of block %d", step.downcall.Seq, step.height)
+		}
+		errMsg := ""
+		if err != nil {
+			errMsg = err.Error()
+		}
+		if reply != step.reply.Data || errMsg != step.reply.Error {
+			return i, &ReplayDivergence{
+				Height:   step.height,
+				Kind:     JournalReply,
+				Seq:      step.downcall.Seq,
+				Expected: describeReply(step.reply.Data, step.reply.Error),
+				Actual:   describeReply(reply, errMsg),
+			}
+		}
+		if answered < step.upcalls {
+			upcall := r.upcalls[answered]
+			return i, &ReplayDivergence{
+				Height:   upcall.height,
+				Kind:     JournalUpcall,
+				Seq:      upcall.request.Seq,
+				Expected: upcall.request.Data,
+			}
+		}
+	}
+	return len(r.steps), nil
+}
+
+// receive answers an upcall to port from the recording.
+func (r *Replay) receive(port int, str string) (string, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.err != nil {
+		return "", r.err
+	}
+	step := r.steps[r.step]
+	if (step.reply != nil && r.answered >= step.upcalls) || r.answered >= len(r.upcalls) {
+		r.err = &ReplayDivergence{
+			Height: step.height,
+			Kind:   JournalUpcall,
+			Seq:    step.downcall.Seq,
+			Actual: str,
+		}
+		return "", r.err
+	}
+	upcall := r.upcalls[r.answered]
+	if upcall.request.Port != port || upcall.request.Data != str {
+		r.err = &ReplayDivergence{
+			Height:   upcall.height,
+			Kind:     JournalUpcall,
+			Seq:      upcall.request.Seq,
+			Expected: fmt.Sprintf("port %d: %s", upcall.request.Port, upcall.request.Data),
+			Actual:   fmt.Sprintf("port %d: %s", port, str),
+		}
+		return "", r.err
+	}
+	r.answered++
+	if upcall.reply == nil {
+		r.err = fmt.Errorf("recording ends before the reply to upcall #%d of block %d", upcall.request.Seq, upcall.height)
+		return "", r.err
+	}
+	if upcall.reply.Error != "" {
+		return upcall.reply.Data, errors.New(upcall.reply.Error)
+	}
+	return upcall.reply.Data, nil
+}
+
+// replayPortHandler answers the upcalls to a port from a Replay.
+type replayPortHandler struct {
+	replay *Replay
+	port   int
+}
+
+func (h replayPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	return h.replay.receive(h.port, str)
+}
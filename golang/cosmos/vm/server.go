@@ -12,7 +12,9 @@ import (
 // is mutable and the mutex must be held to read or write any field.
 type AgdServer struct {
 	currentCtx context.Context
-	mtx        sync.Mutex
+	// hasControllerCtx is true while currentCtx was set by the controller
+	hasControllerCtx bool
+	mtx              sync.Mutex
 	// zero is an out-of-bounds port number
 	lastPort int
 	// portToHandler[i] is nonzero iff portToName[i] is nonempty
@@ -20,6 +22,19 @@ type AgdServer struct {
 	// portToName[nameToPort[s]] == s && nameToPort[portToName[i]] == i for all i, s
 	portToName map[int]string
 	nameToPort map[string]int
+	// journal, if non-nil, records every message received
+	journal *Journal
+	// hashChain, if non-nil, records the messages received for a block
+	hashChain *HashChain
+	// bridgeMetrics, if non-nil, measures every message received
+	bridgeMetrics *BridgeMetrics
+	// blockTracer, if non-nil, traces every message received
+	blockTracer *BlockTracer
+	// recorder, if non-nil, records every message received for replay
+	recorder *Recorder
+	// replay, if non-nil, answers every message received instead of the
+	// handlers
+	replay *Replay
 }
 
 var wrappedEmptySDKContext = sdk.WrapSDKContext(
@@ -46,10 +61,12 @@ func (s *AgdServer) SetControllerContext(ctx sdk.Context) func() {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 	s.currentCtx = sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	s.hasControllerCtx = true
 	return func() {
 		s.mtx.Lock()
 		defer s.mtx.Unlock()
 		s.currentCtx = wrappedEmptySDKContext
+		s.hasControllerCtx = false
 	}
 }
 
@@ -59,10 +76,77 @@ func (s *AgdServer) getContextAndHandler(port int) (context.Context, PortHandler
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 	ctx := s.currentCtx
+	if s.replay != nil {
+		return ctx, replayPortHandler{replay: s.replay, port: port}
+	}
 	handler := s.portToHandler[port]
+	if handler != nil && s.bridgeMetrics != nil {
+		handler = metricsPortHandler{metrics: s.bridgeMetrics, port: s.portToName[port], inner: handler}
+	}
+	if handler != nil && s.blockTracer != nil {
+		handler = tracedPortHandler{tracer: s.blockTracer, port: s.portToName[port], inner: handler}
+	}
+	// Only messages received on behalf of a block are part of its hash chain.
+	if handler != nil && s.hashChain != nil && s.hasControllerCtx {
+		handler = hashChainPortHandler{chain: s.hashChain, port: port, inner: handler}
+	}
+	if handler != nil && s.journal != nil {
+		handler = journaledPortHandler{journal: s.journal, port: port, inner: handler}
+	}
+	if handler != nil && s.recorder != nil {
+		handler = recordedPortHandler{recorder: s.recorder, port: port, inner: handler}
+	}
 	return ctx, handler
 }
 
+// SetJournal arranges for every subsequently received message and its reply
+// to be recorded in journal.
+func (s *AgdServer) SetJournal(journal *Journal) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.journal = journal
+}
+
+// SetHashChain arranges for every subsequently received message and its reply
+// to be recorded in hashChain while the controller context is set.
+func (s *AgdServer) SetHashChain(hashChain *HashChain) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.hashChain = hashChain
+}
+
+// SetBridgeMetrics arranges for every subsequently received message to be
+// measured by bridgeMetrics.
+func (s *AgdServer) SetBridgeMetrics(bridgeMetrics *BridgeMetrics) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.bridgeMetrics = bridgeMetrics
+}
+
+// SetBlockTracer arranges for every subsequently received message to be traced
+// by blockTracer.
+func (s *AgdServer) SetBlockTracer(blockTracer *BlockTracer) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.blockTracer = blockTracer
+}
+
+// SetRecorder arranges for every subsequently received message and its reply
+// to be recorded by recorder.
+func (s *AgdServer) SetRecorder(recorder *Recorder) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.recorder = recorder
+}
+
+// SetReplay arranges for every subsequently received message, on any port, to
+// be answered by replay rather than the registered handlers.
+func (s *AgdServer) SetReplay(replay *Replay) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.replay = replay
+}
+
 // ReceiveMessage is the method the VM calls in order to have agd receive a
 // Message.
 func (s *AgdServer) ReceiveMessage(msg *Message, reply *string) error {
@@ -0,0 +1,207 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+
+// TraceContextProperty is the property of a downcall's JSON object holding
+// the W3C trace context of its span, from which the kernel's own spans
+// descend, per packages/cosmic-swingset/src/launch-chain.js.
+const TraceContextProperty = "traceContext"
+
+// BlockTracer traces the bridge traffic of each block with OpenTelemetry: a
+// span for the block, a child span for each downcall, and a child of that for
+// each upcall made while answering it.  Each downcall passes its span in the
+// context given to the wrapped sender, but upcalls arrive without one, so they
+// descend from the downcall in progress only when there is exactly one.  The
+// structure is mutable and the mutex must be held to read or write the
+// contexts.
+type BlockTracer struct {
+	provider   trace.TracerProvider
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	mtx sync.Mutex
+	// blockCtx carries blockSpan, and is nil between blocks
+	blockCtx  context.Context
+	blockSpan trace.Span
+	// callCtxs carry the spans of the downcalls in progress, by call number
+	callCtxs map[uint64]context.Context
+	lastCall uint64
+}
+
+// NewBlockTracer returns a BlockTracer whose spans are created by provider.
+func NewBlockTracer(provider trace.TracerProvider) *BlockTracer {
+	return &BlockTracer{
+		provider:   provider,
+		tracer:     provider.Tracer(tracerName),
+		propagator: propagation.TraceContext{},
+		callCtxs:   map[uint64]context.Context{},
+	}
+}
+
+// StartBlock starts the span of the block at height, ending that of any
+// unfinished block.
+func (bt *BlockTracer) StartBlock(height int64) {
+	bt.mtx.Lock()
+	defer bt.mtx.Unlock()
+	if bt.blockSpan != nil {
+		bt.blockSpan.End()
+	}
+	bt.blockCtx, bt.blockSpan = bt.tracer.Start(
+		context.Background(), "block",
+		trace.WithAttributes(attribute.Int64("blockHeight", height)),
+	)
+}
+
+// EndBlock ends the span of the current block, if any.
+func (bt *BlockTracer) EndBlock() {
+	bt.mtx.Lock()
+	defer bt.mtx.Unlock()
+	if bt.blockSpan != nil {
+		bt.blockSpan.End()
+	}
+	bt.blockCtx, bt.blockSpan = nil, nil
+}
+
+// Shutdown ends the span of the current block, if any, and shuts down the
+// provider, if it can be, flushing the spans it has yet to export.
+func (bt *BlockTracer) Shutdown(ctx context.Context) error {
+	bt.EndBlock()
+	if provider, ok := bt.provider.(interface{ Shutdown(context.Context) error }); ok {
+		return provider.Shutdown(ctx)
+	}
+	return nil
+}
+
+// blockParentLocked returns the context of the current block, if any.
+func (bt *BlockTracer) blockParentLocked() context.Context {
+	if bt.blockCtx != nil {
+		return bt.blockCtx
+	}
+	return context.Background()
+}
+
+// downcallParentLocked returns the context from which to start the span of a
+// downcall made with ctx.
+func (bt *BlockTracer) downcallParentLocked(ctx context.Context) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	return bt.blockParentLocked()
+}
+
+// upcallParentLocked returns the context from which to start the span of an
+// upcall.
+func (bt *BlockTracer) upcallParentLocked() context.Context {
+	if len(bt.callCtxs) == 1 {
+		for _, callCtx := range bt.callCtxs {
+			return callCtx
+		}
+	}
+	return bt.blockParentLocked()
+}
+
+// endSpan ends span, marking it failed if err is non-nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// injectTraceContext returns jsonRequest with the trace context of ctx added
+// as its TraceContextProperty, if it is a JSON object.
+func (bt *BlockTracer) injectTraceContext(ctx context.Context, jsonRequest string) string {
+	if !strings.HasPrefix(jsonRequest, "{") {
+		return jsonRequest
+	}
+	carrier := propagation.MapCarrier{}
+	bt.propagator.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return jsonRequest
+	}
+	bz, err := json.Marshal(carrier)
+	if err != nil {
+		return jsonRequest
+	}
+	rest := strings.TrimSpace(jsonRequest[1:])
+	if !strings.HasPrefix(rest, "}") {
+		rest = "," + rest
+	}
+	return `{"` + TraceContextProperty + `":` + string(bz) + rest
+}
+
+// downcallSpanName returns the name of a downcall's span, after the type of
+// its action if it has one.
+func downcallSpanName(jsonRequest string) string {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(jsonRequest), &header); err != nil || header.Type == "" {
+		return "bridge downcall"
+	}
+	return "bridge " + header.Type
+}
+
+// WrapSender returns a Sender that traces each downcall through sender,
+// passing its trace context to the VM.  Any sender journaling or digesting
+// messages must wrap it rather than the reverse, so that their messages
+// exclude the trace context, which differs between nodes.
+func (bt *BlockTracer) WrapSender(sender Sender) Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		bt.mtx.Lock()
+		callCtx, span := bt.tracer.Start(
+			bt.downcallParentLocked(ctx), downcallSpanName(jsonRequest),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attribute.Bool("needReply", needReply)),
+		)
+		bt.lastCall++
+		call := bt.lastCall
+		bt.callCtxs[call] = callCtx
+		bt.mtx.Unlock()
+
+		reply, err := sender(trace.ContextWithSpan(ctx, span), needReply, bt.injectTraceContext(callCtx, jsonRequest))
+
+		bt.mtx.Lock()
+		delete(bt.callCtxs, call)
+		bt.mtx.Unlock()
+		endSpan(span, err)
+		return reply, err
+	}
+}
+
+// tracedPortHandler traces the upcalls to a port.
+type tracedPortHandler struct {
+	tracer *BlockTracer
+	port   string
+	inner  PortHandler
+}
+
+var _ PortHandler = tracedPortHandler{}
+
+// Receive implements the vm.PortHandler method.
+func (h tracedPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	bt := h.tracer
+	bt.mtx.Lock()
+	_, span := bt.tracer.Start(
+		bt.upcallParentLocked(), "bridge upcall "+h.port,
+		trace.WithSpanKind(trace.SpanKindServer),
+	)
+	bt.mtx.Unlock()
+
+	reply, err := h.inner.Receive(ctx, str)
+	endSpan(span, err)
+	return reply, err
+}
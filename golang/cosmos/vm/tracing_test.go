@@ -0,0 +1,157 @@
+package vm_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestBlockTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	blockTracer := vm.NewBlockTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	agdServer := vm.NewAgdServer()
+	agdServer.SetBlockTracer(blockTracer)
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+
+	var received []string
+	sender := blockTracer.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		received = append(received, jsonRequest)
+		var reply string
+		err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: `"upcall"`}, &reply)
+		return reply, err
+	})
+
+	blockTracer.StartBlock(7)
+	for _, request := range []string{`{"type":"BEGIN_BLOCK","blockHeight":7}`, `{}`, `"bare"`} {
+		if _, err := sender(context.Background(), true, request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	blockTracer.EndBlock()
+
+	// Objects gain the trace context of their span.
+	var action struct {
+		Type         string            `json:"type"`
+		BlockHeight  int64             `json:"blockHeight"`
+		TraceContext map[string]string `json:"traceContext"`
+	}
+	if err := json.Unmarshal([]byte(received[0]), &action); err != nil {
+		t.Fatalf("invalid traced request %s: %v", received[0], err)
+	}
+	if action.Type != "BEGIN_BLOCK" || action.BlockHeight != 7 || action.TraceContext["traceparent"] == "" {
+		t.Errorf("unexpected traced request %s", received[0])
+	}
+	if !strings.HasPrefix(received[1], `{"traceContext":{`) || !strings.HasSuffix(received[1], `}}`) {
+		t.Errorf("unexpected traced empty request %s", received[1])
+	}
+	if received[2] != `"bare"` {
+		t.Errorf("got %s, want a non-object request unchanged", received[2])
+	}
+
+	spans := recorder.Ended()
+	byName := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range spans {
+		if byName[span.Name()] == nil {
+			byName[span.Name()] = span
+		}
+	}
+	block, downcall, upcall := byName["block"], byName["bridge BEGIN_BLOCK"], byName["bridge upcall echo"]
+	if block == nil || downcall == nil || upcall == nil {
+		t.Fatalf("missing spans among %d", len(spans))
+	}
+	if downcall.Parent().SpanID() != block.SpanContext().SpanID() {
+		t.Error("expected the downcall span to be a child of the block span")
+	}
+	if upcall.Parent().SpanID() != downcall.SpanContext().SpanID() {
+		t.Error("expected the upcall span to be a child of the downcall span")
+	}
+	if !strings.Contains(action.TraceContext["traceparent"], downcall.SpanContext().SpanID().String()) {
+		t.Errorf("got traceparent %s, want that of the downcall span", action.TraceContext["traceparent"])
+	}
+}
+
+func TestBlockTracerConcurrentDowncalls(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	blockTracer := vm.NewBlockTracer(provider)
+
+	agdServer := vm.NewAgdServer()
+	agdServer.SetBlockTracer(blockTracer)
+	port := agdServer.MustRegisterPortHandler("echo", echoPortHandler{})
+
+	// The first downcall is still in progress while the second is made
+	// concurrently, as by a health check.
+	firstStarted, secondDone := make(chan struct{}), make(chan struct{})
+	sender := blockTracer.WrapSender(func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		if strings.Contains(jsonRequest, `"type":"first"`) {
+			close(firstStarted)
+			<-secondDone
+		}
+		// Each call has its own span.
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			t.Errorf("no span in the context of %s", jsonRequest)
+		}
+		var reply string
+		err := agdServer.ReceiveMessage(&vm.Message{Port: port, Data: `"upcall"`}, &reply)
+		return reply, err
+	})
+
+	blockTracer.StartBlock(7)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := sender(context.Background(), true, `{"type":"first"}`); err != nil {
+			t.Error(err)
+		}
+	}()
+	<-firstStarted
+	if _, err := sender(context.Background(), true, `{"type":"second"}`); err != nil {
+		t.Fatal(err)
+	}
+	close(secondDone)
+	wg.Wait()
+
+	// Shutting down ends the block and the provider.
+	if err := blockTracer.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, span := provider.Tracer("test").Start(context.Background(), "after"); span.SpanContext().IsValid() {
+		t.Error("started a span after shutdown")
+	}
+
+	byName := map[string]sdktrace.ReadOnlySpan{}
+	var upcalls []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "bridge upcall echo" {
+			upcalls = append(upcalls, span)
+		}
+		byName[span.Name()] = span
+	}
+	block, first, second := byName["block"], byName["bridge first"], byName["bridge second"]
+	if block == nil || first == nil || second == nil || len(upcalls) != 2 {
+		t.Fatalf("missing spans among %d", len(recorder.Ended()))
+	}
+	for _, downcall := range []sdktrace.ReadOnlySpan{first, second} {
+		if downcall.Parent().SpanID() != block.SpanContext().SpanID() {
+			t.Errorf("expected %s to be a child of the block span", downcall.Name())
+		}
+	}
+	// The upcall made while both downcalls were in progress is not attributed
+	// to either, but the one made during the first alone is.
+	if upcalls[0].Parent().SpanID() != block.SpanContext().SpanID() {
+		t.Error("expected the ambiguous upcall span to be a child of the block span")
+	}
+	if upcalls[1].Parent().SpanID() != first.SpanContext().SpanID() {
+		t.Error("expected the last upcall span to be a child of the first downcall span")
+	}
+}
@@ -0,0 +1,68 @@
+package vm
+
+import (
+	"context"
+	"net/rpc"
+)
+
+// Transport carries requests from agd to the VM.  Requests from the VM to agd
+// are delivered by each implementation to an AgdServer.
+type Transport interface {
+	// Send delivers a request to the VM, returning its reply if needReply.
+	Send(ctx context.Context, needReply bool, jsonRequest string) (jsonReply string, err error)
+	// Close stops carrying requests.
+	Close() error
+}
+
+// NewTransportSender returns a Sender which sends through transport, closing
+// it when asked to "shutdown".
+func NewTransportSender(transport Transport) Sender {
+	return func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		if jsonRequest == "shutdown" {
+			return "", transport.Close()
+		}
+		return transport.Send(ctx, needReply, jsonRequest)
+	}
+}
+
+// rpcTransport sends requests with a net/rpc client, as used by the in-process
+// bridge.
+type rpcTransport struct {
+	client   *rpc.Client
+	nodePort int
+	close    func() error
+}
+
+var _ Transport = rpcTransport{}
+
+// NewRPCTransport returns a Transport sending requests to the VM's nodePort
+// through client, and calling close (if not nil) to shut it down.
+func NewRPCTransport(client *rpc.Client, nodePort int, close func() error) Transport {
+	return rpcTransport{client: client, nodePort: nodePort, close: close}
+}
+
+// NewBridgeTransport returns a Transport for the in-process bridge, which
+// calls send to deliver a message to the VM.  The VM's replies must be
+// delivered to the returned ClientCodec.
+func NewBridgeTransport(ctx context.Context, nodePort int, send func(int, int, string)) (Transport, *ClientCodec) {
+	codec := NewClientCodec(ctx, send)
+	return NewRPCTransport(rpc.NewClientWithCodec(codec), nodePort, codec.Close), codec
+}
+
+func (t rpcTransport) Send(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+	msg := Message{
+		Port:       t.nodePort,
+		NeedsReply: needReply,
+		Data:       jsonRequest,
+	}
+	var reply string
+	err := t.client.Call(ReceiveMessageMethod, msg, &reply)
+	return reply, err
+}
+
+func (t rpcTransport) Close() error {
+	if t.close == nil {
+		return nil
+	}
+	return t.close()
+}
@@ -26,6 +26,9 @@ type endBlockAction struct {
 
 type commitBlockAction struct {
 	*vm.ActionHeader `actionType:"COMMIT_BLOCK"`
+	// BridgeJournalSeq is the sequence number of the bridge journal reached by
+	// the block, for the VM to save with it, or zero if there is no journal.
+	BridgeJournalSeq uint64 `json:"bridgeJournalSeq,omitempty"`
 }
 
 type afterCommitBlockAction struct {
@@ -35,14 +38,23 @@ type afterCommitBlockAction struct {
 func BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, keeper Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	keeper.StartBlockTrace(ctx)
+	keeper.BeginBridgeMessageHashChain(ctx)
+	keeper.UpdateBlockEntropy(ctx)
+
+	params := keeper.GetParams(ctx)
+	blockTime := keeper.GetQuantizedBlockTime(ctx)
+	keeper.SetLastBlockTime(ctx, blockTime)
 	action := beginBlockAction{
-		ChainID: ctx.ChainID(),
-		Params:  keeper.GetParams(ctx),
+		ActionHeader: &vm.ActionHeader{BlockTime: blockTime},
+		ChainID:      ctx.ChainID(),
+		Params:       params,
 	}
 	_, err := keeper.BlockingSend(ctx, action)
 	// fmt.Fprintf(os.Stderr, "BEGIN_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
-		panic(err)
+		keeper.HaltForKernelPanic(ctx, "BEGIN_BLOCK", err)
+		return err
 	}
 
 	err = keeper.UpdateQueueAllowed(ctx)
@@ -56,19 +68,51 @@ var endBlockTime int64
 func EndBlock(ctx sdk.Context, req abci.RequestEndBlock, keeper Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	action := endBlockAction{}
-	_, err := keeper.BlockingSend(ctx, action)
+	// Forward the actions of the inbound lanes for SwingSet to process in this
+	// block.  Those left behind by a failure wait for the next.
+	if err := keeper.ForwardInboundLanes(ctx); err != nil {
+		keeper.Logger(ctx).Error("failed to forward inbound lanes", "error", err)
+	}
+
+	// SwingSet polls its timer with the END_BLOCK time.
+	timerTime := keeper.GetQuantizedBlockTime(ctx)
+	keeper.SetLastBlockTime(ctx, timerTime)
+	action := endBlockAction{
+		ActionHeader: &vm.ActionHeader{BlockTime: timerTime},
+	}
+	out, err := keeper.BlockingSend(ctx, action)
 
 	// fmt.Fprintf(os.Stderr, "END_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
 		// NOTE: A failed END_BLOCK means that the SwingSet state is inconsistent.
-		// Panic here, in the hopes that a replay from scratch will fix the problem.
-		panic(err)
+		// Halt here, in the hopes that a replay from scratch will fix the problem.
+		keeper.HaltForKernelPanic(ctx, "END_BLOCK", err)
+		return nil, err
 	}
 
+	// END_BLOCK is the last message of the block's hash chain.
+	keeper.EndBridgeMessageHashChain(ctx)
+
+	keeper.UpdateTimerStatus(ctx, timerTime, out)
+	keeper.UpdateVatMeters(ctx, out)
+	keeper.UpdatePolicyHeadroom(ctx, out)
+	keeper.UpdateBundleReferences(ctx, out)
+	keeper.BillVats(ctx, out)
+
 	// Save our EndBlock status.
 	endBlockHeight = ctx.BlockHeight()
-	endBlockTime = ctx.BlockTime().Unix()
+	endBlockTime = timerTime
+
+	err = keeper.UpdateBundleInstallations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.PruneExpiredBundleUploads(ctx)
+	keeper.PruneRateLimitBuckets(ctx)
+
+	keeper.PruneDeliveredInbound(ctx)
+	keeper.PruneExpiredAutoProvisions(ctx)
 
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -80,17 +124,18 @@ func getEndBlockContext() sdk.Context {
 		WithBlockTime(time.Unix(endBlockTime, 0))
 }
 
-func CommitBlock(keeper Keeper) error {
+func CommitBlock(keeper Keeper, bridgeJournalSeq uint64) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "commit_blocker")
 
-	action := commitBlockAction{}
-	_, err := keeper.BlockingSend(getEndBlockContext(), action)
+	action := commitBlockAction{BridgeJournalSeq: bridgeJournalSeq}
+	ctx := getEndBlockContext()
+	_, err := keeper.BlockingSend(ctx, action)
 
 	// fmt.Fprintf(os.Stderr, "COMMIT_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
 		// NOTE: A failed COMMIT_BLOCK means that the SwingSet state is inconsistent.
-		// Panic here, in the hopes that a replay from scratch will fix the problem.
-		panic(err)
+		// Halt here, in the hopes that a replay from scratch will fix the problem.
+		keeper.HaltForKernelPanic(ctx, "COMMIT_BLOCK", err)
 	}
 	return err
 }
@@ -99,12 +144,16 @@ func AfterCommitBlock(keeper Keeper) error {
 	// defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "commit_blocker")
 
 	action := afterCommitBlockAction{}
-	_, err := keeper.BlockingSend(getEndBlockContext(), action)
+	ctx := getEndBlockContext()
+	_, err := keeper.BlockingSend(ctx, action)
+	// AFTER_COMMIT_BLOCK is the last message of the block's trace.
+	keeper.EndBlockTrace()
 
 	// fmt.Fprintf(os.Stderr, "AFTER_COMMIT_BLOCK Returned from SwingSet: %s, %v\n", out, err)
 	if err != nil {
-		// Panic here, in the hopes that a replay from scratch will fix the problem.
-		panic(fmt.Errorf("AFTER_COMMIT_BLOCK failed: %s. Swingset is in an irrecoverable inconsistent state", err))
+		// Halt here, in the hopes that a replay from scratch will fix the problem.
+		err = fmt.Errorf("AFTER_COMMIT_BLOCK failed: %s. Swingset is in an irrecoverable inconsistent state", err)
+		keeper.HaltForKernelPanic(ctx, "AFTER_COMMIT_BLOCK", err)
 	}
 	return err
 }
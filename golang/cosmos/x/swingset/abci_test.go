@@ -0,0 +1,66 @@
+package swingset
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestBlockTimeMonotonicWhenQuantumRaised(t *testing.T) {
+	var sent []int64
+	k, ctx := makeTestControllerKeeper(t, func(ctx sdk.Context, str string) (string, error) {
+		var action vm.ActionHeader
+		if err := json.Unmarshal([]byte(str), &action); err != nil {
+			return "", err
+		}
+		if action.Type == "BEGIN_BLOCK" || action.Type == "END_BLOCK" {
+			sent = append(sent, action.BlockTime)
+		}
+		return "true", nil
+	})
+	setQuantum := func(ctx sdk.Context, quantum uint64) {
+		params := k.GetParams(ctx)
+		params.BlockTimeQuantumSeconds = quantum
+		k.SetParams(ctx, params)
+	}
+	runBlock := func(height int64, blockTime int64, midBlock func(ctx sdk.Context)) {
+		t.Helper()
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(time.Unix(blockTime, 0))
+		if err := BeginBlock(ctx, abci.RequestBeginBlock{}, k); err != nil {
+			t.Fatal(err)
+		}
+		if midBlock != nil {
+			midBlock(ctx)
+		}
+		if _, err := EndBlock(ctx, abci.RequestEndBlock{}, k); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runBlock(8, 1_000_059, nil)
+	// Governance raises the quantum in the middle of a block, after SwingSet
+	// has seen its unquantized time.
+	runBlock(9, 1_000_065, func(ctx sdk.Context) {
+		setQuantum(ctx, 3600)
+	})
+	// Time stands still until the quantized time catches up.
+	runBlock(10, 1_000_100, nil)
+	runBlock(11, 1_004_000, nil)
+
+	want := []int64{1_000_059, 1_000_059, 1_000_065, 1_000_065, 1_000_065, 1_000_065, 1_000_800, 1_000_800}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("got BEGIN_BLOCK and END_BLOCK times %v, want %v", sent, want)
+	}
+
+	// The last time survives a genesis export and import.
+	k2, ctx2 := roundTripGenesis(t, k, ctx)
+	if got := k2.GetQuantizedBlockTime(ctx2.WithBlockTime(time.Unix(1_004_000, 0))); got != 1_000_800 {
+		t.Errorf("got imported block time %d, want 1000800", got)
+	}
+}
@@ -0,0 +1,429 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/slogdiff"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// DecodedBlockAction is a message of a block which is delivered to SwingSet,
+// as reported by decode-block.
+type DecodedBlockAction struct {
+	// TxIndex is the index of the transaction within the block.
+	TxIndex int `json:"txIndex"`
+	// TxHash is the hash of the transaction.
+	TxHash string `json:"txHash"`
+	// MsgIndex is the index of the message within the transaction.
+	MsgIndex int `json:"msgIndex"`
+	// Queue is the inbound queue to which the resulting action was pushed.
+	Queue string `json:"queue"`
+	// Action describes the message as SwingSet sees it.
+	Action map[string]interface{} `json:"action"`
+	// Condition is what the delivery of the action depends upon besides the
+	// success of the transaction, if anything.
+	Condition string `json:"condition,omitempty"`
+}
+
+// DecodedBlock is the output of decode-block.
+type DecodedBlock struct {
+	Height int64 `json:"height"`
+	// FailedTxs is the number of transactions which failed (or have no result),
+	// and whose messages therefore never reached SwingSet.
+	FailedTxs int `json:"failedTxs"`
+	// Actions are listed in the order that SwingSet runs them, high-priority
+	// first, assuming that the queues were empty when the block began.
+	Actions []DecodedBlockAction `json:"actions"`
+	// Warning notes any limitation of the decoding.
+	Warning string `json:"warning,omitempty"`
+}
+
+// GetDebugCmd returns the swingset debugging commands, to be added beneath
+// "agd debug".
+func GetDebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Debugging commands for the swingset module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(GetCmdDecodeBlock())
+	return cmd
+}
+
+// GetCmdDecodeBlock decodes the messages of a block which are delivered to
+// SwingSet.
+func GetCmdDecodeBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-block <height>",
+		Short: "Decode the SwingSet inbound messages of a block",
+		Long: `Decode the messages of a committed block which are delivered to SwingSet
+(deliver-inbound, provisioning, bundle installation, smart wallet actions, and
+IBC packets), and print them in the order that SwingSet runs them.
+
+IBC packets are labeled with the event that their port's module delivers:
+"IBC_EVENT" for the ports bound by vibc and the interchain accounts host, and
+"VTRANSFER_IBC_EVENT" for ICS-20 transfers, which are delivered only for
+accounts watched by the VM.
+
+The high-priority queue is reconstructed from the priority senders before the
+block. Actions left queued by earlier blocks are not shown.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("invalid block height %q", args[0])
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			block, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+			results, err := node.BlockResults(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			decoded := DecodedBlock{Height: height}
+			var prioritySenders map[string]bool
+			if height > 1 {
+				priorCtx := clientCtx.WithHeight(height - 1)
+				res, err := types.NewQueryClient(priorCtx).PrioritySenders(cmd.Context(), &types.QueryPrioritySendersRequest{})
+				if err != nil {
+					decoded.Warning = fmt.Sprintf("cannot query priority senders at height %d, so all actions are shown as queued normally: %v", height-1, err)
+				} else {
+					prioritySenders = map[string]bool{}
+					for _, sender := range append(res.ParamSenders, res.StorageSenders...) {
+						prioritySenders[sender] = true
+					}
+				}
+			}
+
+			err = decodeBlockActions(&decoded, clientCtx.Codec, clientCtx.TxConfig.TxDecoder(), block.Block.Txs, results.TxsResults, prioritySenders)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(decoded, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCompareSlogs compares the slogs of two nodes to find the first delivery
+// at which their SwingSet kernels diverged.
+func GetCmdCompareSlogs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare-slogs <slog1> <slog2>",
+		Short: "Find the first divergent delivery between two SwingSet slogs",
+		Long: `Align the SwingSet log ("slog") files of two nodes by crank number, and
+compare their cranks in order: the kernel's view of each delivery, of each of
+its syscalls and their results, of the delivery result, and the crank hashes.
+The first difference is printed, with the canonical JSON of both versions.
+
+Only the cranks recorded by both slogs are compared. Replayed deliveries and
+timings are ignored, and a crank re-executed after a restart is compared as
+last executed. Slogs ending in ".gz" are decompressed.
+
+The command fails if the slogs diverge.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			left, err := slogdiff.ReadCranksFile(args[0])
+			if err != nil {
+				return err
+			}
+			right, err := slogdiff.ReadCranksFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			result := slogdiff.Compare(left, right)
+			if len(left) == 0 || len(right) == 0 || result.FirstCrank > result.LastCrank {
+				return fmt.Errorf("the slogs record no cranks in common")
+			}
+			cmd.Printf("Compared cranks %d to %d of both slogs\n", result.FirstCrank, result.LastCrank)
+			divergence := result.Divergence
+			if divergence == nil {
+				cmd.Printf("No divergence in %d cranks\n", result.Compared)
+				return nil
+			}
+
+			cmd.Printf("%d cranks matched before the first divergence\n", result.Compared)
+			cmd.Printf("Crank %d", divergence.CrankNum)
+			if divergence.VatID != "" {
+				cmd.Printf(" (vat %s delivery %d)", divergence.VatID, divergence.DeliveryNum)
+			}
+			cmd.Printf(" differs in its %s:\n", divergence.Part)
+			cmd.Printf("  %s: %s\n", args[0], divergence.Left)
+			cmd.Printf("  %s: %s\n", args[1], divergence.Right)
+			cmd.SilenceUsage = true
+			return fmt.Errorf("slogs diverge at crank %d", divergence.CrankNum)
+		},
+	}
+	return cmd
+}
+
+// decodeBlockActions appends to decoded the SwingSet actions of the txs which
+// succeeded according to results.  A tx without a result is counted as failed.
+func decodeBlockActions(
+	decoded *DecodedBlock,
+	cdc codec.Codec,
+	txDecoder sdk.TxDecoder,
+	txs tmtypes.Txs,
+	results []*abci.ResponseDeliverTx,
+	prioritySenders map[string]bool,
+) error {
+	var oracle, highPriority, normal []DecodedBlockAction
+	for txIndex, txBytes := range txs {
+		if txIndex >= len(results) || results[txIndex].Code != 0 {
+			decoded.FailedTxs++
+			continue
+		}
+		tx, err := txDecoder(txBytes)
+		if err != nil {
+			return fmt.Errorf("cannot decode tx %d: %w", txIndex, err)
+		}
+		for msgIndex, msg := range tx.GetMsgs() {
+			entry, ok := describeInboundMsg(cdc, msg, prioritySenders)
+			if !ok {
+				continue
+			}
+			entry.TxIndex = txIndex
+			entry.TxHash = fmt.Sprintf("%X", txBytes.Hash())
+			entry.MsgIndex = msgIndex
+			switch entry.Queue {
+			case keeper.StoragePathOracleQueue:
+				oracle = append(oracle, entry)
+			case keeper.StoragePathHighPriorityQueue:
+				highPriority = append(highPriority, entry)
+			default:
+				normal = append(normal, entry)
+			}
+		}
+	}
+	decoded.Actions = append(append(append([]DecodedBlockAction{}, oracle...), highPriority...), normal...)
+	return nil
+}
+
+// describeInboundMsg describes the action that msg delivers to SwingSet and
+// the inbound queue it enters, or returns false if it delivers none.
+func describeInboundMsg(cdc codec.Codec, msg sdk.Msg, prioritySenders map[string]bool) (DecodedBlockAction, bool) {
+	action, isHighPriority, condition := describeInboundAction(cdc, msg, prioritySenders)
+	entry := DecodedBlockAction{Action: action, Condition: condition}
+	switch {
+	case action == nil:
+		return DecodedBlockAction{}, false
+	case action["type"] == "ORACLE_PUSH":
+		entry.Queue = keeper.StoragePathOracleQueue
+	case isHighPriority:
+		entry.Queue = keeper.StoragePathHighPriorityQueue
+	default:
+		entry.Queue = keeper.StoragePathActionQueue
+	}
+	return entry, true
+}
+
+// describeInboundAction returns a description of the action that msg delivers
+// to SwingSet, or nil if it delivers none, whether it is high-priority, and
+// what else its delivery depends upon.
+func describeInboundAction(cdc codec.Codec, msg sdk.Msg, prioritySenders map[string]bool) (map[string]interface{}, bool, string) {
+	switch m := msg.(type) {
+	case *types.MsgDeliverInbound:
+		messages := make([][]interface{}, len(m.Messages))
+		for i, message := range m.Messages {
+			messages[i] = []interface{}{m.Nums[i], message}
+		}
+		return map[string]interface{}{
+			"type":     "DELIVER_INBOUND",
+			"peer":     m.Submitter.String(),
+			"messages": messages,
+			"ack":      m.Ack,
+		}, false, ""
+	case *types.MsgWalletAction:
+		return map[string]interface{}{
+			"type":   "WALLET_ACTION",
+			"owner":  m.Owner.String(),
+			"action": decodeJSONString(m.Action),
+		}, false, ""
+	case *types.MsgWalletSpendAction:
+		return map[string]interface{}{
+			"type":        "WALLET_SPEND_ACTION",
+			"owner":       m.Owner.String(),
+			"spendAction": decodeJSONString(m.SpendAction),
+		}, prioritySenders[m.Owner.String()], ""
+	case *types.MsgProvision:
+		return map[string]interface{}{
+			"type":       "PLEASE_PROVISION",
+			"nickname":   m.Nickname,
+			"address":    m.Address.String(),
+			"powerFlags": m.PowerFlags,
+			"submitter":  m.Submitter.String(),
+		}, false, ""
+	case *types.MsgInstallBundle:
+		return map[string]interface{}{
+			"type":             "INSTALL_BUNDLE",
+			"submitter":        m.Submitter.String(),
+			"bundleSize":       len(m.Bundle),
+			"compressedSize":   len(m.CompressedBundle),
+			"uncompressedSize": m.UncompressedSize,
+		}, false, ""
+	case *types.MsgOraclePush:
+		return map[string]interface{}{
+			"type":      "ORACLE_PUSH",
+			"owner":     m.Submitter.String(),
+			"feed":      m.Feed,
+			"unitPrice": m.UnitPrice,
+			"roundID":   m.RoundID,
+		}, false, ""
+	case *types.MsgInstallBundleChunk:
+		// Only the final chunk results in an INSTALL_BUNDLE action.
+		return map[string]interface{}{
+			"type":        "INSTALL_BUNDLE_CHUNK",
+			"submitter":   m.Submitter.String(),
+			"bundleHash":  m.BundleHash,
+			"chunkIndex":  m.ChunkIndex,
+			"totalChunks": m.TotalChunks,
+			"size":        len(m.Chunk),
+		}, false, ""
+	case *channeltypes.MsgRecvPacket:
+		action, condition := describePacket(cdc, packetReceived, m.Packet, nil)
+		return action, false, condition
+	case *channeltypes.MsgAcknowledgement:
+		action, condition := describePacket(cdc, packetAcknowledged, m.Packet, m.Acknowledgement)
+		return action, false, condition
+	case *channeltypes.MsgTimeout:
+		action, condition := describePacket(cdc, packetTimedOut, m.Packet, nil)
+		return action, false, condition
+	case *channeltypes.MsgTimeoutOnClose:
+		action, condition := describePacket(cdc, packetTimedOut, m.Packet, nil)
+		return action, false, condition
+	}
+	return nil, false, ""
+}
+
+// packetOutcome is what a relayed IBC message does to its packet.
+type packetOutcome int
+
+const (
+	packetReceived packetOutcome = iota
+	packetAcknowledged
+	packetTimedOut
+)
+
+// describePacket describes the IBC event that SwingSet sees for the outcome of
+// packet, as delivered by the module that routes the packet's port on this
+// chain, or returns nil if that module delivers none.  The condition, if any,
+// is what the delivery depends upon besides the success of the transaction.
+func describePacket(cdc codec.Codec, outcome packetOutcome, packet channeltypes.Packet, ack []byte) (map[string]interface{}, string) {
+	ourPort := packet.SourcePort
+	if outcome == packetReceived {
+		ourPort = packet.DestinationPort
+	}
+	action := map[string]interface{}{
+		"type":   "IBC_EVENT",
+		"packet": packet,
+		"data":   decodeJSONString(string(packet.Data)),
+	}
+	if ack != nil {
+		action["acknowledgement"] = decodeJSONString(string(ack))
+	}
+
+	switch ourPort {
+	case ibctransfertypes.PortID:
+		// vtransfer delivers the transfers of watched accounts, letting the VM
+		// write the acknowledgements of those it receives.
+		role := agoric.RoleSender
+		action["event"] = map[packetOutcome]string{
+			packetReceived:     "writeAcknowledgement",
+			packetAcknowledged: "acknowledgementPacket",
+			packetTimedOut:     "timeoutPacket",
+		}[outcome]
+		if outcome == packetReceived {
+			role = agoric.RoleReceiver
+		}
+		target, err := agoric.ExtractBaseAddressFromData(cdc, packet.Data, role, nil)
+		if err != nil {
+			return nil, ""
+		}
+		action["type"] = "VTRANSFER_IBC_EVENT"
+		action["target"] = target
+		return action, fmt.Sprintf("only if %s is watched by the VM", target)
+	case icatypes.HostPortID:
+		// The host only receives packets, delivering those of the accounts that
+		// vats control.
+		if outcome != packetReceived {
+			return nil, ""
+		}
+		action["event"] = "icaHostPacket"
+		return action, "only if the interchain account is controlled by a vat"
+	}
+
+	// Any other port is bound by vibc.
+	switch {
+	case outcome == packetReceived:
+		action["event"] = "receivePacket"
+	case isInterchainQuery(cdc, packet.Data):
+		action["event"] = "interchainQueryResult"
+		return action, "only if the query was sent with sendInterchainQuery"
+	case outcome == packetAcknowledged:
+		action["event"] = "acknowledgementPacket"
+	default:
+		action["event"] = "timeoutPacket"
+	}
+	return action, ""
+}
+
+// isInterchainQuery reports whether data is that of an interchain query
+// packet, whose outcome vibc delivers as an "interchainQueryResult" event.
+func isInterchainQuery(cdc codec.Codec, data []byte) bool {
+	var packetData vibctypes.InterchainQueryPacketData
+	if err := cdc.UnmarshalJSON(data, &packetData); err != nil {
+		return false
+	}
+	var query vibctypes.CosmosQuery
+	if err := query.Unmarshal(packetData.Data); err != nil {
+		return false
+	}
+	return len(query.Requests) > 0
+}
+
+// decodeJSONString returns the value encoded by str, or str itself if it is
+// not JSON.
+func decodeJSONString(str string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(str), &value); err != nil {
+		return str
+	}
+	return value
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+type fakeTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx fakeTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx fakeTx) ValidateBasic() error { return nil }
+
+func TestDecodeBlockActions(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	prioritySender := sdk.AccAddress([]byte("prioritySender"))
+	msgs := []sdk.Msg{
+		&types.MsgDeliverInbound{Messages: []string{"hello"}, Nums: []uint64{1}, Submitter: owner},
+		&types.MsgWalletAction{Owner: owner, Action: `{"failed":true}`},
+		&types.MsgWalletSpendAction{Owner: prioritySender, SpendAction: `{"spend":1}`},
+		&types.MsgWalletSpendAction{Owner: owner, SpendAction: `{"spend":2}`},
+		&types.MsgProvision{Nickname: "unresulted", Address: owner, Submitter: owner},
+	}
+	txs := make(tmtypes.Txs, len(msgs))
+	txMsgs := map[string]sdk.Msg{}
+	for i, msg := range msgs {
+		txs[i] = tmtypes.Tx(fmt.Sprintf("tx%d", i))
+		txMsgs[string(txs[i])] = msg
+	}
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		return fakeTx{msgs: []sdk.Msg{txMsgs[string(txBytes)]}}, nil
+	}
+	// The last tx has no result, as when the block results are incomplete.
+	results := []*abci.ResponseDeliverTx{{Code: 0}, {Code: 1}, {Code: 0}, {Code: 0}}
+
+	decoded := DecodedBlock{}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	err := decodeBlockActions(&decoded, cdc, txDecoder, txs, results, map[string]bool{prioritySender.String(): true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.FailedTxs != 2 {
+		t.Errorf("got %d failed txs, want 2", decoded.FailedTxs)
+	}
+
+	want := []struct {
+		txIndex    int
+		actionType string
+		queue      string
+	}{
+		{2, "WALLET_SPEND_ACTION", keeper.StoragePathHighPriorityQueue},
+		{0, "DELIVER_INBOUND", keeper.StoragePathActionQueue},
+		{3, "WALLET_SPEND_ACTION", keeper.StoragePathActionQueue},
+	}
+	if len(decoded.Actions) != len(want) {
+		t.Fatalf("got %d actions %v, want %d", len(decoded.Actions), decoded.Actions, len(want))
+	}
+	for i, w := range want {
+		got := decoded.Actions[i]
+		if got.TxIndex != w.txIndex || got.Action["type"] != w.actionType || got.Queue != w.queue {
+			t.Errorf("action %d: got tx %d %v in %q, want tx %d %s in %q",
+				i, got.TxIndex, got.Action["type"], got.Queue, w.txIndex, w.actionType, w.queue)
+		}
+		if want := fmt.Sprintf("%X", txs[w.txIndex].Hash()); got.TxHash != want {
+			t.Errorf("action %d: got tx hash %s, want %s", i, got.TxHash, want)
+		}
+	}
+}
+
+func TestDescribePacket(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	sender := sdk.AccAddress([]byte("sender")).String()
+	receiver := sdk.AccAddress([]byte("receiver")).String()
+	transferData := transfertypes.NewFungibleTokenPacketData("ubld", "100", sender, receiver, "").GetBytes()
+	icqData, err := vibctypes.EncodeInterchainQuery([]abci.RequestQuery{{Path: "/cosmos.bank.v1beta1.Query/Balance"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mkPacket := func(data []byte, sourcePort, destPort string) channeltypes.Packet {
+		return channeltypes.NewPacket(data, 1, sourcePort, "channel-0", destPort, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	for _, tt := range []struct {
+		name        string
+		outcome     packetOutcome
+		packet      channeltypes.Packet
+		actionType  string
+		event       string
+		target      string
+		conditional bool
+	}{
+		{
+			name: "vibc receive", outcome: packetReceived,
+			packet:     mkPacket([]byte(`{"hello":1}`), "remote", "custom"),
+			actionType: "IBC_EVENT", event: "receivePacket",
+		},
+		{
+			name: "vibc ack", outcome: packetAcknowledged,
+			packet:     mkPacket([]byte(`{"hello":1}`), "custom", "remote"),
+			actionType: "IBC_EVENT", event: "acknowledgementPacket",
+		},
+		{
+			name: "vibc timeout", outcome: packetTimedOut,
+			packet:     mkPacket([]byte(`{"hello":1}`), "custom", "remote"),
+			actionType: "IBC_EVENT", event: "timeoutPacket",
+		},
+		{
+			name: "interchain query ack", outcome: packetAcknowledged,
+			packet:     mkPacket(icqData, "icqcontroller-1", "icqhost"),
+			actionType: "IBC_EVENT", event: "interchainQueryResult", conditional: true,
+		},
+		{
+			name: "interchain query timeout", outcome: packetTimedOut,
+			packet:     mkPacket(icqData, "icqcontroller-1", "icqhost"),
+			actionType: "IBC_EVENT", event: "interchainQueryResult", conditional: true,
+		},
+		{
+			name: "ica host receive", outcome: packetReceived,
+			packet:     mkPacket([]byte(`{"type":"TYPE_EXECUTE_TX"}`), "icacontroller-1", "icahost"),
+			actionType: "IBC_EVENT", event: "icaHostPacket", conditional: true,
+		},
+		{
+			name: "ica host timeout", outcome: packetTimedOut,
+			packet: mkPacket([]byte(`{}`), "icahost", "icacontroller-1"),
+		},
+		{
+			name: "transfer receive", outcome: packetReceived,
+			packet:     mkPacket(transferData, "transfer", "transfer"),
+			actionType: "VTRANSFER_IBC_EVENT", event: "writeAcknowledgement", target: receiver, conditional: true,
+		},
+		{
+			name: "transfer ack", outcome: packetAcknowledged,
+			packet:     mkPacket(transferData, "transfer", "transfer"),
+			actionType: "VTRANSFER_IBC_EVENT", event: "acknowledgementPacket", target: sender, conditional: true,
+		},
+		{
+			name: "transfer timeout", outcome: packetTimedOut,
+			packet:     mkPacket(transferData, "transfer", "transfer"),
+			actionType: "VTRANSFER_IBC_EVENT", event: "timeoutPacket", target: sender, conditional: true,
+		},
+		{
+			name: "transfer of non-ICS-20 data", outcome: packetReceived,
+			packet: mkPacket([]byte(`not json`), "transfer", "transfer"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			action, condition := describePacket(cdc, tt.outcome, tt.packet, nil)
+			if tt.actionType == "" {
+				if action != nil {
+					t.Fatalf("got action %v, want none", action)
+				}
+				return
+			}
+			if action == nil {
+				t.Fatalf("got no action, want %s %s", tt.actionType, tt.event)
+			}
+			if action["type"] != tt.actionType || action["event"] != tt.event {
+				t.Errorf("got %v %v, want %s %s", action["type"], action["event"], tt.actionType, tt.event)
+			}
+			if target, _ := action["target"].(string); target != tt.target {
+				t.Errorf("got target %q, want %q", target, tt.target)
+			}
+			if (condition != "") != tt.conditional {
+				t.Errorf("got condition %q, want conditional %t", condition, tt.conditional)
+			}
+		})
+	}
+}
@@ -9,6 +9,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagLimit = "limit"
+
+	defaultActionQueueLimit = 100
+)
+
 func GetQueryCmd(storeKey string) *cobra.Command {
 	swingsetQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -19,8 +25,17 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 	}
 	swingsetQueryCmd.AddCommand(
 		GetCmdGetEgress(storeKey),
+		GetCmdEgresses(storeKey),
 		GetCmdQueryParams(storeKey),
+		GetCmdQueryProvisionFee(storeKey),
 		GetCmdMailbox(storeKey),
+		GetCmdVats(storeKey),
+		GetCmdBundleStatus(storeKey),
+		GetCmdActionQueue(storeKey),
+		GetCmdPrioritySenders(storeKey),
+		GetCmdTimer(storeKey),
+		GetCmdVatOwner(storeKey),
+		GetCmdWalletActionText(storeKey),
 	)
 
 	return swingsetQueryCmd
@@ -51,6 +66,38 @@ func GetCmdQueryParams(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryProvisionFee implements the query provision-fee command.
+func GetCmdQueryProvisionFee(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provision-fee",
+		Args:  cobra.NoArgs,
+		Short: "Query the fee for provisioning a smart wallet",
+		Long: `Query the fee for provisioning a smart wallet.
+
+The smart_wallet_provision_fee is charged when a wallet is provisioned on demand,
+and is derived from the smartWalletProvision entry of the beans_per_unit param
+and from the fee_unit_price param.  Explicit provisioning by MsgProvision is
+charged according to the power_flag_fees param instead.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(res.SmartWalletProvisionFee.String() + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdGetEgress(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "egress <account>",
@@ -83,12 +130,54 @@ func GetCmdGetEgress(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdEgresses lists the provisioned egresses
+func GetCmdEgresses(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "egresses",
+		Short: "list the provisioned egresses",
+		Long: `List the egresses provisioned for ag-solo peers, in order of peer address,
+with their nicknames, power flags, and the block heights at which they were
+provisioned.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Egresses(cmd.Context(), &types.QueryEgressesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "egresses")
+	return cmd
+}
+
 // GetCmdMailbox queries information about a mailbox
 func GetCmdMailbox(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "mailbox <peer>",
 		Short: "get mailbox for peer",
-		Args:  cobra.ExactArgs(1),
+		Long: `Get the outbound mailbox that the chain keeps for an ag-solo peer.
+
+The outbox messages not yet acknowledged by the peer are listed with their
+message numbers, along with the number of the latest message received from
+the peer, as well as the stored JSON value.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -115,3 +204,221 @@ func GetCmdMailbox(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdVats queries the status of the kernel's vats and queues
+func GetCmdVats(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vats",
+		Short: "get vat status and kernel queue depths",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Vats(cmd.Context(), &types.QueryVatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBundleStatus queries the installation progress of a bundle
+func GetCmdBundleStatus(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle-status <bundle-id>",
+		Short: "get whether a bundle is pending, installed, or rejected",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BundleStatus(cmd.Context(), &types.QueryBundleStatusRequest{
+				BundleHash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdActionQueue queries the actions waiting in the inbound queues
+func GetCmdActionQueue(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "action-queue",
+		Short: "get the actions waiting in the inbound queues",
+		Long: `Get the actions waiting in the inbound queues, in the order SwingSet will
+process them: first the price updates in the oracleQueue, then those in the
+highPriorityQueue, then those in the actionQueue, followed by those waiting in
+the inbound lanes to be forwarded to the actionQueue.  Each entry reports its
+queue, its inbound number (index) within that queue, its action type, and the
+account or peer that submitted it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ActionQueue(cmd.Context(), &types.QueryActionQueueRequest{
+				Limit: limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagLimit, defaultActionQueueLimit, "maximum number of entries to print")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPrioritySenders queries the senders admitted to the high-priority queue
+func GetCmdPrioritySenders(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "priority-senders",
+		Short: "get the senders whose messages are admitted to the high-priority queue",
+		Long: `Get the senders whose messages are admitted to the high-priority queue: those
+in the priority_senders param, which is set by governance, and those recorded
+by SwingSet under the highPrioritySenders vstorage path.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PrioritySenders(cmd.Context(), &types.QueryPrioritySendersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTimer queries the status of the SwingSet timer device
+func GetCmdTimer(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timer",
+		Short: "get the status of the SwingSet timer device",
+		Long: `Get the status of the SwingSet timer device as of the end of the latest block:
+the time last delivered to it, and the number of wakeups and repeaters pending
+in it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Timer(cmd.Context(), &types.QueryTimerRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdVatOwner queries the billing record of a vat
+func GetCmdVatOwner(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vat-owner <vatID>",
+		Short: "get the billing record of a vat",
+		Long: `Get the account billed for the computrons used by a vat, and the cost it
+has accrued but not paid.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VatOwner(cmd.Context(), &types.QueryVatOwnerRequest{VatId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWalletActionText queries the textual rendering of a wallet action
+func GetCmdWalletActionText(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet-action-text <owner> <action JSON>",
+		Short: "render a wallet action as text",
+		Long: `Render a smart wallet action, given as the CapData of a BridgeAction, as the
+lines of text that a signer can display in place of its CapData, such as the
+give and want of an offer.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			spend, err := cmd.Flags().GetBool(FlagAllowSpend)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.WalletActionText(cmd.Context(), &types.QueryWalletActionTextRequest{
+				Owner:       args[0],
+				Action:      args[1],
+				SpendAction: spend,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllowSpend, false, "Render the action as that of a WalletSpendAction")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -12,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
@@ -20,8 +24,12 @@ import (
 )
 
 const (
-	FlagAllowSpend = "allow-spend"
-	FlagCompress   = "compress"
+	FlagAllowSpend      = "allow-spend"
+	FlagCompress        = "compress"
+	FlagExpiration      = "expiration"
+	FlagMaxTxBundleSize = "max-tx-bundle-size"
+	FlagMethods         = "methods"
+	FlagOfferTypes      = "offer-types"
 )
 
 func GetTxCmd(storeKey string) *cobra.Command {
@@ -37,7 +45,10 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		GetCmdDeliver(),
 		GetCmdProvisionOne(),
 		GetCmdInstallBundle(),
+		GetCmdPruneBundles(),
+		GetCmdOraclePush(),
 		GetCmdWalletAction(),
+		GetCmdGrantWalletAction(),
 	)
 
 	return swingsetTxCmd
@@ -158,12 +169,95 @@ https://github.com/endojs/endo/tree/master/packages/bundle-source`,
 	return cmd
 }
 
+// GetCmdPruneBundles is the CLI command for sending a PruneBundles transaction
+func GetCmdPruneBundles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-bundles <bundle hash>...",
+		Short: "remove installed bundles that no vat was created from",
+		Long: `Remove installed bundles that no vat was created from, reclaiming their
+storage.  Each bundle must have been installed at least the swingset
+bundle_prune_min_age_blocks param ago.  Bundle hashes may carry the "b1-"
+bundle ID prefix.`,
+		Example: fmt.Sprintf(`$ %[1]s tx swingset prune-bundles b1-1234... --from mykey`, version.AppName),
+		Args:    cobra.RangeArgs(1, types.BundlePruneLimit),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundleHashes := make([]string, len(args))
+			for i, arg := range args {
+				bundleHashes[i] = strings.TrimPrefix(arg, types.BundleIDPrefix)
+			}
+
+			msg := types.NewMsgPruneBundles(bundleHashes, cctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdOraclePush is the CLI command for sending an OraclePush transaction
+func GetCmdOraclePush() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-push <feed> <unit price> [<round id>]",
+		Short: "push a price update as an oracle operator",
+		Long: `Push a price update for a feed as an oracle operator registered in the
+swingset oracle_operators param.  The unit price is a positive integer in the
+smallest unit of the feed's quote.  The round defaults to the feed's next one.
+A transaction of only oracle pushes must pay the swingset oracle_push_fee param
+rather than a fee according to the validator's minimum gas prices.`,
+		Example: fmt.Sprintf(`$ %[1]s tx swingset oracle-push ATOM-USD 12010000 --from myoracle`, version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var roundID uint64
+			if len(args) > 2 {
+				roundID, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid round id %q: %w", args[2], err)
+				}
+			}
+
+			msg := types.NewMsgOraclePush(args[0], args[1], roundID, cctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdProvision is the CLI command for sending a Provision transaction
 func GetCmdProvisionOne() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "provision-one <nickname> <address> [<power-flag>[,...]]",
 		Short: "provision a single address",
-		Args:  cobra.RangeArgs(2, 3),
+		Long: `Provision a single address, such as with the SMART_WALLET power flag.
+
+Unless the submitter holds a provisionpass, the power flags are charged
+according to the swingset power_flag_fees param.  Use --dry-run to simulate the
+transaction, or --generate-only to print it unsigned.`,
+		Example: fmt.Sprintf(`$ %[1]s tx swingset provision-one my-wallet agoric1... SMART_WALLET --from mykey
+$ %[1]s tx swingset provision-one my-wallet agoric1... SMART_WALLET --from mykey --dry-run`, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cctx, err := client.GetClientTxContext(cmd)
@@ -180,7 +274,10 @@ func GetCmdProvisionOne() *cobra.Command {
 
 			var powerFlags []string
 			if len(args) > 2 {
-				powerFlags = strings.Split(args[2], ",")
+				powerFlags, err = parsePowerFlags(args[2])
+				if err != nil {
+					return err
+				}
 			}
 
 			msg := types.NewMsgProvision(nickname, addr, powerFlags, cctx.GetFromAddress())
@@ -196,12 +293,33 @@ func GetCmdProvisionOne() *cobra.Command {
 	return cmd
 }
 
+// parsePowerFlags splits a comma-separated list of power flags, ignoring
+// surrounding whitespace.
+func parsePowerFlags(arg string) ([]string, error) {
+	powerFlags := strings.Split(arg, ",")
+	for i, powerFlag := range powerFlags {
+		powerFlag = strings.TrimSpace(powerFlag)
+		if powerFlag == "" {
+			return nil, fmt.Errorf("power flag %d of %q cannot be empty", i+1, arg)
+		}
+		powerFlags[i] = powerFlag
+	}
+	return powerFlags, nil
+}
+
 // GetCmdWalletAction is the CLI command for sending a WalletAction or WalletSpendAction transaction
 func GetCmdWalletAction() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "wallet-action <action JSON>",
 		Short: "perform a wallet action",
-		Args:  cobra.ExactArgs(1),
+		Long: `Perform a smart wallet action, given as the CapData of a BridgeAction.
+
+The message carries the rendering of the action as by the "query swingset
+wallet-action-text" command, which the chain checks, so that signers such as
+hardware wallets display it in the sign doc.  An action that cannot be rendered
+is sent without one.`,
+		Example: fmt.Sprintf(`$ %[1]s tx swingset wallet-action --allow-spend "$(cat offer.json)" --from agoric1...`, version.AppName),
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -215,11 +333,15 @@ func GetCmdWalletAction() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			signText, renderErr := types.RenderWalletAction(owner.String(), action, spend)
+			if renderErr != nil {
+				cmd.PrintErrf("wallet action has no sign text: %s\n", renderErr)
+			}
 			var msg sdk.Msg
 			if spend {
-				msg = types.NewMsgWalletSpendAction(owner, action)
+				msg = &types.MsgWalletSpendAction{Owner: owner, SpendAction: action, SignText: signText}
 			} else {
-				msg = types.NewMsgWalletAction(owner, action)
+				msg = &types.MsgWalletAction{Owner: owner, Action: action, SignText: signText}
 			}
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -234,6 +356,77 @@ func GetCmdWalletAction() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGrantWalletAction is the CLI command for granting a
+// WalletActionAuthorization.
+func GetCmdGrantWalletAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-wallet-action <grantee>",
+		Short: "authorize another account to perform wallet actions",
+		Long: `Grant the grantee an authz WalletActionAuthorization to submit wallet actions
+on behalf of the smart wallet of the granter, such as from a hot key for a cold
+owner.  The grantee submits them with "tx authz exec", and only those whose
+bridge actions pass the filters are executed.
+
+--methods limits the bridge action methods, such as executeOffer or
+tryExitOffer.  --offer-types limits the offers of executeOffer, each given as
+the source of the offer's invitationSpec optionally followed by ":" and its
+publicInvitationMaker or invitationMakerName.`,
+		Example: fmt.Sprintf(`$ %[1]s tx swingset grant-wallet-action agoric1... --methods executeOffer,tryExitOffer --offer-types contract:makeWantMintedInvitation --from cold
+$ %[1]s tx swingset grant-wallet-action agoric1... --allow-spend --offer-types purse --from cold`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			spend, err := cmd.Flags().GetBool(FlagAllowSpend)
+			if err != nil {
+				return err
+			}
+			methods, err := cmd.Flags().GetStringSlice(FlagMethods)
+			if err != nil {
+				return err
+			}
+			offerTypes, err := cmd.Flags().GetStringSlice(FlagOfferTypes)
+			if err != nil {
+				return err
+			}
+			authorization := types.NewWalletActionAuthorization(spend, methods, offerTypes)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != 0 {
+				expire := time.Unix(exp, 0)
+				expiration = &expire
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllowSpend, false, "Authorize wallet actions that spend assets (MsgWalletSpendAction)")
+	cmd.Flags().StringSlice(FlagMethods, nil, "Comma-separated bridge action methods to allow (default any)")
+	cmd.Flags().StringSlice(FlagOfferTypes, nil, "Comma-separated offer types to allow for executeOffer (default any)")
+	cmd.Flags().Int64(FlagExpiration, 0, "The Unix timestamp at which the grant expires (default none)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitCoreEvalProposal is the CLI command for submitting a "CoreEval"
 // governance proposal via `agd tx gov submit-proposal swingset-core-eval ...`.
 func NewCmdSubmitCoreEvalProposal() *cobra.Command {
@@ -268,26 +461,10 @@ Specify at least one pair of permit.json and code.js files`,
 			npairs := len(args) / 2
 			evals := make([]types.CoreEval, 0, npairs)
 			for j := 0; j < npairs; j++ {
-				permitFile := args[j*2]
-				permit, err := os.ReadFile(permitFile)
-				if err != nil {
-					return errors.Wrapf(err, "failed to read permit %s", permitFile)
-				}
-
-				codeFile := args[j*2+1]
-				code, err := os.ReadFile(codeFile)
+				ce, err := readCoreEval(args[j*2], args[j*2+1])
 				if err != nil {
-					return errors.Wrapf(err, "failed to read code %s", codeFile)
-				}
-
-				ce := types.CoreEval{
-					JsonPermits: string(permit),
-					JsCode:      string(code),
-				}
-				if err = ce.ValidateBasic(); err != nil {
-					return errors.Wrapf(err, "cannot validate permit=%s, code=%s", permitFile, codeFile)
+					return err
 				}
-
 				evals = append(evals, ce)
 			}
 
@@ -325,3 +502,175 @@ Specify at least one pair of permit.json and code.js files`,
 
 	return cmd
 }
+
+// readCoreEval reads and validates a CoreEval from a pair of permit and code
+// files.
+func readCoreEval(permitFile, codeFile string) (types.CoreEval, error) {
+	permit, err := os.ReadFile(permitFile)
+	if err != nil {
+		return types.CoreEval{}, errors.Wrapf(err, "failed to read permit %s", permitFile)
+	}
+
+	code, err := os.ReadFile(codeFile)
+	if err != nil {
+		return types.CoreEval{}, errors.Wrapf(err, "failed to read code %s", codeFile)
+	}
+
+	ce := types.CoreEval{
+		JsonPermits: string(permit),
+		JsCode:      string(code),
+	}
+	if err = ce.ValidateBasic(); err != nil {
+		return types.CoreEval{}, errors.Wrapf(err, "cannot validate permit=%s, code=%s", permitFile, codeFile)
+	}
+	return ce, nil
+}
+
+// NewCmdSubmitCoreEval is the CLI command for installing the bundles used by a
+// "CoreEval" governance proposal and submitting the proposal in one step, via
+// `agd tx gov submit-core-eval ...`.
+func NewCmdSubmitCoreEval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-core-eval <permit.json> <code.js> [<bundle.json>...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Install bundles and submit a proposal to evaluate code in the SwingSet core",
+		Long: `Install the given bundles and submit a SwingSet CoreEval proposal, along with
+an initial deposit, evaluating code.js with the permits of permit.json.
+
+Each bundle is installed by a MsgInstallBundle, and the proposal is submitted
+after all of them.  By default every message is sent in a single transaction.
+With --max-tx-bundle-size, the bundles are instead split across as many
+transactions as needed to keep the bundles of each one under that size, and the
+proposal is sent in a final transaction.  Each bundle must fit in one
+transaction on its own.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			//nolint:staticcheck // Agoric is still using the legacy proposal shape
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			//nolint:staticcheck // Agoric is still using the legacy proposal shape
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			compress, err := cmd.Flags().GetBool(FlagCompress)
+			if err != nil {
+				return err
+			}
+
+			maxTxBundleSize, err := cmd.Flags().GetInt(FlagMaxTxBundleSize)
+			if err != nil {
+				return err
+			}
+
+			ce, err := readCoreEval(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			bundleMsgs := make([]*types.MsgInstallBundle, 0, len(args)-2)
+			for _, bundleFile := range args[2:] {
+				bundle, err := os.ReadFile(bundleFile)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read bundle %s", bundleFile)
+				}
+				msg := types.NewMsgInstallBundle(string(bundle), from)
+				if compress {
+					if err = msg.Compress(); err != nil {
+						return err
+					}
+				}
+				if err = msg.ValidateBasic(); err != nil {
+					return errors.Wrapf(err, "cannot validate bundle %s", bundleFile)
+				}
+				if maxTxBundleSize > 0 && msg.Size() > maxTxBundleSize {
+					return fmt.Errorf("bundle %s is %d bytes, which exceeds --%s %d", bundleFile, msg.Size(), FlagMaxTxBundleSize, maxTxBundleSize)
+				}
+				bundleMsgs = append(bundleMsgs, msg)
+			}
+
+			content := types.NewCoreEvalProposal(title, description, []types.CoreEval{ce})
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			txMsgs := groupBundleMsgs(bundleMsgs, maxTxBundleSize)
+			if maxTxBundleSize > 0 || len(txMsgs) == 0 {
+				txMsgs = append(txMsgs, []sdk.Msg{proposalMsg})
+			} else {
+				txMsgs[0] = append(txMsgs[0], proposalMsg)
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if len(txMsgs) > 1 && !clientCtx.GenerateOnly && !clientCtx.Offline {
+				// Fix the starting sequence so that it can be advanced for each
+				// transaction, without waiting for the previous one to commit.
+				txf, err = txf.Prepare(clientCtx)
+				if err != nil {
+					return err
+				}
+			}
+			for _, msgs := range txMsgs {
+				if err = tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...); err != nil {
+					return err
+				}
+				if txf.Sequence() != 0 {
+					txf = txf.WithSequence(txf.Sequence() + 1)
+				}
+			}
+			return nil
+		},
+	}
+
+	//nolint:staticcheck // Agoric is still using the legacy proposal shape
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	//nolint:staticcheck // Agoric is still using the legacy proposal shape
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit for proposal")
+	cmd.Flags().Bool(FlagCompress, true, "Compress the bundles in transit")
+	cmd.Flags().Int(FlagMaxTxBundleSize, 0, "Split the bundles across transactions of at most this many bytes of bundles each (0 for a single transaction)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// groupBundleMsgs splits msgs into consecutive groups, each of total size at
+// most maxSize (or all in a single group if maxSize is not positive).
+func groupBundleMsgs(msgs []*types.MsgInstallBundle, maxSize int) [][]sdk.Msg {
+	groups := [][]sdk.Msg{}
+	groupSize := 0
+	for _, msg := range msgs {
+		size := msg.Size()
+		if len(groups) == 0 || (maxSize > 0 && groupSize+size > maxSize) {
+			groups = append(groups, []sdk.Msg{})
+			groupSize = 0
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], msg)
+		groupSize += size
+	}
+	return groups
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestParsePowerFlags(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		arg     string
+		want    []string
+		wantErr bool
+	}{
+		{name: "single", arg: "SMART_WALLET", want: []string{"SMART_WALLET"}},
+		{name: "several", arg: "SMART_WALLET,REMOTE_WALLET", want: []string{"SMART_WALLET", "REMOTE_WALLET"}},
+		{name: "whitespace", arg: " SMART_WALLET , REMOTE_WALLET ", want: []string{"SMART_WALLET", "REMOTE_WALLET"}},
+		{name: "empty", arg: "", wantErr: true},
+		{name: "blank entry", arg: "SMART_WALLET, ,REMOTE_WALLET", wantErr: true},
+		{name: "trailing comma", arg: "SMART_WALLET,", wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePowerFlags(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupBundleMsgs(t *testing.T) {
+	submitter := sdk.AccAddress([]byte("submitter"))
+	mkMsg := func(bundle string) *types.MsgInstallBundle {
+		return types.NewMsgInstallBundle(bundle, submitter)
+	}
+	small, medium, large := mkMsg("{}"), mkMsg(`{"a":"0123456789"}`), mkMsg(`{"a":"01234567890123456789"}`)
+	msgs := []*types.MsgInstallBundle{small, medium, large, small}
+
+	for _, tt := range []struct {
+		name    string
+		maxSize int
+		want    [][]sdk.Msg
+	}{
+		{name: "unlimited", maxSize: 0, want: [][]sdk.Msg{{small, medium, large, small}}},
+		{name: "negative", maxSize: -1, want: [][]sdk.Msg{{small, medium, large, small}}},
+		{name: "pairs", maxSize: small.Size() + medium.Size(), want: [][]sdk.Msg{{small, medium}, {large}, {small}}},
+		{name: "exact fit", maxSize: medium.Size() + large.Size(), want: [][]sdk.Msg{{small, medium}, {large, small}}},
+		{name: "oversized", maxSize: 1, want: [][]sdk.Msg{{small}, {medium}, {large}, {small}}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := groupBundleMsgs(msgs, tt.maxSize)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %d groups %v, want %d groups %v", len(got), got, len(tt.want), tt.want)
+			}
+		})
+	}
+
+	if got := groupBundleMsgs(nil, 10); len(got) != 0 {
+		t.Errorf("got %d groups for no bundles, want none", len(got))
+	}
+}
+
+func TestWalletActionCmd(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithTxConfig(txConfig).
+		WithChainID("agoric-test")
+	owner := sdk.AccAddress([]byte("owner"))
+
+	// generate returns the messages of the transaction generated for action,
+	// and what was printed before it.
+	generate := func(action string, extraArgs ...string) ([]sdk.Msg, string) {
+		args := append([]string{action, "--generate-only", "--from", owner.String()}, extraArgs...)
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, GetCmdWalletAction(), args)
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		tx, err := txConfig.TxJSONDecoder()([]byte(lines[len(lines)-1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx.GetMsgs(), strings.Join(lines[:len(lines)-1], "\n")
+	}
+
+	exit := `{"body":"#{\"method\":\"tryExitOffer\",\"offerId\":\"bid-1\"}","slots":[]}`
+	signText, err := types.RenderWalletAction(owner.String(), exit, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []sdk.Msg{&types.MsgWalletSpendAction{Owner: owner, SpendAction: exit, SignText: signText}}
+	if got, _ := generate(exit, "--allow-spend"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got msgs %v, want %v", got, want)
+	}
+
+	// An action that cannot be rendered is sent without sign text, even
+	// without --yes.
+	unrenderable := `{"method":"tryExitOffer"}`
+	got, printed := generate(unrenderable)
+	if msg, ok := got[0].(*types.MsgWalletAction); len(got) != 1 || !ok || msg.Action != unrenderable || len(msg.SignText) != 0 {
+		t.Errorf("got msgs %v, want a MsgWalletAction of %s without sign text", got, unrenderable)
+	}
+	if !strings.Contains(printed, "wallet action has no sign text") {
+		t.Errorf("got %q printed, want a warning", printed)
+	}
+}
@@ -1,8 +1,11 @@
 package swingset
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -12,21 +15,50 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/util"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
 )
 
 const (
 	ConfigPrefix                = "swingset"
 	FlagSlogfile                = ConfigPrefix + ".slogfile"
+	FlagSlogsocket              = ConfigPrefix + ".slogsocket"
 	FlagVatSnapshotArchiveDir   = ConfigPrefix + ".vat-snapshot-archive-dir"
 	FlagVatTranscriptArchiveDir = ConfigPrefix + ".vat-transcript-archive-dir"
+	FlagVatConfigOverride       = ConfigPrefix + ".vat-config-override"
+	FlagBridgeJournal           = ConfigPrefix + ".bridge-journal"
+	FlagBridgeSlowCallThreshold = ConfigPrefix + ".bridge-slow-call-threshold"
+	FlagBridgeLogMessages       = ConfigPrefix + ".bridge-log-messages"
+	FlagSlogExcludeTypes        = ConfigPrefix + ".slog-exclude-types"
+	FlagRecordDir               = ConfigPrefix + ".record-dir"
+	FlagRecordRetainBlocks      = ConfigPrefix + ".record-retain-blocks"
+	FlagMockVm                  = ConfigPrefix + ".mock-vm"
+	FlagVmTransport             = ConfigPrefix + ".vm_transport"
+	FlagGenesisOverrides        = ConfigPrefix + ".genesis_overrides"
 
+	SnapshotRetentionOptionArchival    = "archival"
 	SnapshotRetentionOptionDebug       = "debug"
 	SnapshotRetentionOptionOperational = "operational"
 
 	TranscriptRetentionOptionArchival    = "archival"
 	TranscriptRetentionOptionOperational = "operational"
+
+	VmTransportBridge = "bridge"
+	VmTransportGrpc   = "grpc"
+)
+
+// VmSocketFile and AgdSocketFile are the names of the Unix domain sockets in
+// the data directory of the application home at which the VM and agd
+// respectively serve gRPC with the "grpc" vm_transport.  The SwingSet worker
+// finds them in the same place.
+const (
+	VmSocketFile  = "agvm.sock"
+	AgdSocketFile = "agd.sock"
 )
 
+// DefaultVmHealthCheckTimeout is the vm-health-check-timeout used when none is
+// configured.
+const DefaultVmHealthCheckTimeout = 10 * time.Second
+
 var snapshotRetentionValues []string = []string{
 	SnapshotRetentionOptionDebug,
 	SnapshotRetentionOptionOperational,
@@ -54,15 +86,41 @@ const DefaultConfigTemplate = `
 # interpreted against the working directory.
 slogfile = "{{ .Swingset.SlogFile }}"
 
+# The size in megabytes at which the slog file is rotated. Zero disables
+# rotation, letting the file grow without bound.
+slogfile-max-size = {{ .Swingset.SlogFileMaxSize }}
+
+# The number of rotated slog files to retain. Zero retains all of them.
+slogfile-max-backups = {{ .Swingset.SlogFileMaxBackups }}
+
+# Whether rotated slog files should be compressed with gzip.
+slogfile-compress = {{ .Swingset.SlogFileCompress }}
+
+# The path of a Unix domain socket to which slog entries should be streamed as
+# newline-delimited JSON, in addition to any slogfile.
+# If relative, it is interpreted against the application home directory.
+# May be overridden by a SLOGSOCKET environment variable, which if relative is
+# interpreted against the working directory.
+slogsocket = "{{ .Swingset.SlogSocket }}"
+
 # The maximum number of vats that the SwingSet kernel will bring online. A lower number
 # requires less memory but may have a negative performance impact if vats need to
 # be frequently paged out to remain under this limit.
 max-vats-online = {{ .Swingset.MaxVatsOnline }}
 
+# The number of vat transcripts the SwingSet kernel replays concurrently when
+# bringing vats back online at startup, which can shorten a restart on a host
+# with many cores. Each vat replays exactly as it would alone, so the kernel
+# state and consensus are unaffected; only the interleaving of replay entries
+# in the slog and the order in which preloaded vats are later paged out vary.
+# Each worker holds a vat in memory while it replays. At most 1 replays them
+# one at a time.
+replay-workers = {{ .Swingset.ReplayWorkers }}
+
 # Retention of vat snapshots, with values analogous to those of export
 # 'artifactMode' (cf.
 # https://github.com/Agoric/agoric-sdk/blob/master/packages/swing-store/docs/data-export.md#optional--historical-data ).
-# * "debug": keep all snapshots
+# * "archival" (or "debug"): keep all snapshots
 # * "operational": keep only the last snapshot
 vat-snapshot-retention = "{{ .Swingset.VatSnapshotRetention }}"
 
@@ -81,6 +139,90 @@ vat-snapshot-archive-dir = "{{ .Swingset.VatSnapshotArchiveDir }}"
 
 # Archival of historical (i.e., closed) vat transcript spans to gzipped files.
 vat-transcript-archive-dir = "{{ .Swingset.VatTranscriptArchiveDir }}"
+
+# The path of a SwingSet vat config JSON file with which to bootstrap the
+# kernel, in place of the one named by the genesis "bootstrap_vat_config"
+# parameter, for devnets that need a different bootstrap without rebuilding
+# the JS packages. It matters only when the kernel is bootstrapped, at genesis.
+# The CHAIN_BOOTSTRAP_VAT_CONFIG environment variable takes precedence.
+# Empty uses the genesis parameter.
+# If relative, it is interpreted against the application home directory.
+vat-config-override = "{{ .Swingset.VatConfigOverride }}"
+
+# The path of a JSON file of overrides with which to "fast forward" a forked
+# chain launched from an exported genesis holding a swing-store export, such as
+# to reset oracle feeds or replace the chain IDs of peers. It is an object like
+#   {"kernel": {"<kvStore key>": "<value>"}, "vstorage": {"<path>": null}}
+# in which each kernel kvStore key restored from the swing-store export is set
+# to its new string value, or deleted by null, and each vstorage path is set to
+# its new string value, or removed with its descendants by null. It matters
+# only at genesis, and every node of the forked chain must use the same file.
+# Empty applies no overrides.
+# If relative, it is interpreted against the application home directory.
+genesis_overrides = "{{ .Swingset.GenesisOverrides }}"
+
+# The path of a journal in which every message crossing the bridge to the VM
+# is recorded until the block is committed, so that messages left unanswered
+# by a crash are reported on restart. Empty disables the journal.
+# If relative, it is interpreted against the application home directory.
+bridge-journal = "{{ .Swingset.BridgeJournal }}"
+
+# A directory in which to record every message crossing the bridge to the VM,
+# with its reply, in one file per block, so that "agd replay-swingset" can
+# re-drive a kernel restored to its state at the start of the recording, to
+# reproduce a divergence offline. On restart, a recording already in the
+# directory is moved into a "session-<n>" subdirectory, which can be replayed
+# on its own. Empty disables recording.
+# If relative, it is interpreted against the application home directory.
+record-dir = "{{ .Swingset.RecordDir }}"
+
+# The number of most recent blocks whose recordings to keep in record-dir,
+# removing older ones. A pruned recording replays from the kernel state at the
+# start of its first remaining block. Zero keeps every recording.
+record-retain-blocks = {{ .Swingset.RecordRetainBlocks }}
+
+# How long a message crossing the bridge may go unanswered before it is logged
+# as a slow call, with its port and message type, to help identify which
+# bridge interactions stall block production. Zero disables logging. Latencies
+# are always reported by the swingset_bridge_downcall and
+# swingset_bridge_upcall metrics.
+bridge-slow-call-threshold = "{{ .Swingset.BridgeSlowCallThreshold }}"
+
+# Whether to log every message crossing the bridge, with its port, message
+# type, and latency, rather than only the slow ones.
+bridge-log-messages = {{ .Swingset.BridgeLogMessages }}
+
+# The types of slog entries (such as "syscall" or "syscall-result") to leave
+# out of the slog, to reduce its volume.
+slog-exclude-types = [{{ range $i, $type := .Swingset.SlogExcludeTypes }}{{ if $i }}, {{ end }}"{{ $type }}"{{ end }}]
+
+# The above three logging options are reread from this file when the node
+# receives SIGHUP, and applied at the start of the next block, so that they can
+# be changed without a restart. The slog filter requires a VM which answers
+# SET_LOG_CONFIG messages.
+
+# How often to ping the VM over the bridge, so that a wedged kernel is
+# reported by the swingset Health query and the swingset_vm_healthy metric.
+# Pings are sent after a block is committed, so at most once per block.
+# Requires a VM which answers HEALTH_CHECK messages. Zero disables pinging.
+vm-health-check-interval = "{{ .Swingset.VmHealthCheckInterval }}"
+
+# How long a ping may go unanswered before the VM is reported unhealthy.
+vm-health-check-timeout = "{{ .Swingset.VmHealthCheckTimeout }}"
+
+# The number of swing-store export artifacts read and encoded concurrently
+# while creating a state-sync snapshot. At most 1 handles them one at a time.
+export-workers = {{ .Swingset.ExportWorkers }}
+
+# How agd carries messages to and from the SwingSet VM:
+# * "bridge": within the same process, or over pipes to a --split-vm
+#   subprocess
+# * "grpc": over gRPC on the Unix domain sockets data/agvm.sock (served by the
+#   VM) and data/agd.sock (served by agd) of the application home, to a
+#   SwingSet worker run as a separate process with
+#   "ag-chain-cosmos --home <home>", which reads this setting too. The worker
+#   may be started before or after agd; messages to it wait until it listens.
+vm_transport = "{{ .Swingset.VmTransport }}"
 `
 
 // SwingsetConfig defines configuration for the SwingSet VM.
@@ -94,14 +236,34 @@ type SwingsetConfig struct {
 	// If relative, it is interpreted against the application home directory
 	SlogFile string `mapstructure:"slogfile" json:"slogfile,omitempty"`
 
+	// SlogFileMaxSize is the size in megabytes at which the slog file is rotated.
+	// Zero disables rotation.
+	SlogFileMaxSize int `mapstructure:"slogfile-max-size" json:"slogfileMaxSize,omitempty"`
+
+	// SlogFileMaxBackups is the number of rotated slog files to retain.
+	// Zero retains all of them.
+	SlogFileMaxBackups int `mapstructure:"slogfile-max-backups" json:"slogfileMaxBackups,omitempty"`
+
+	// SlogFileCompress controls whether rotated slog files are gzipped.
+	SlogFileCompress bool `mapstructure:"slogfile-compress" json:"slogfileCompress,omitempty"`
+
+	// SlogSocket is the path of a Unix domain socket to which slog entries
+	// should be streamed as newline-delimited JSON.
+	// If relative, it is interpreted against the application home directory
+	SlogSocket string `mapstructure:"slogsocket" json:"slogsocket,omitempty"`
+
 	// MaxVatsOnline is the maximum number of vats that the SwingSet kernel will have online
 	// at any given time.
 	MaxVatsOnline int `mapstructure:"max-vats-online" json:"maxVatsOnline,omitempty"`
 
+	// ReplayWorkers is the number of vat transcripts that the SwingSet kernel
+	// replays concurrently when bringing vats online at startup.
+	ReplayWorkers int `mapstructure:"replay-workers" json:"replayWorkers,omitempty"`
+
 	// VatSnapshotRetention controls retention of vat snapshots,
 	// and has values analogous to those of export `artifactMode` (cf.
 	// ../../../../packages/swing-store/docs/data-export.md#optional--historical-data ).
-	// * "debug": keep all snapshots
+	// * "debug": keep all snapshots ("archival" is accepted as a synonym)
 	// * "operational": keep only the last snapshot
 	VatSnapshotRetention string `mapstructure:"vat-snapshot-retention" json:"vatSnapshotRetention,omitempty"`
 
@@ -121,13 +283,111 @@ type SwingsetConfig struct {
 	// VatTranscriptArchiveDir controls archival of historical (i.e., closed) vat
 	// transcript spans to gzipped files.
 	VatTranscriptArchiveDir string `mapstructure:"vat-transcript-archive-dir" json:"vatTranscriptArchiveDir,omitempty"`
+
+	// VatConfigOverride is the path of a vat config JSON file with which to
+	// bootstrap the kernel instead of the genesis "bootstrap_vat_config".
+	// If relative, it is interpreted against the application home directory
+	VatConfigOverride string `mapstructure:"vat-config-override" json:"vatConfigOverride,omitempty"`
+
+	// GenesisOverrides is the path of a JSON file of kernel and vstorage
+	// overrides to apply when launching a forked chain from an exported
+	// genesis, or empty for none.  It is not sent to the VM.
+	// If relative, it is interpreted against the application home directory
+	GenesisOverrides string `mapstructure:"genesis_overrides" json:"-"`
+
+	// BridgeJournal is the path of a journal of the messages crossing the
+	// bridge to the VM, or empty for none.  It is not sent to the VM.
+	// If relative, it is interpreted against the application home directory
+	BridgeJournal string `mapstructure:"bridge-journal" json:"-"`
+
+	// RecordDir is the directory in which to record the messages crossing the
+	// bridge to the VM for replay, or empty for none.  It is not sent to the VM.
+	// If relative, it is interpreted against the application home directory
+	RecordDir string `mapstructure:"record-dir" json:"-"`
+
+	// RecordRetainBlocks is the number of most recent blocks whose recordings
+	// are kept in RecordDir, or zero for all of them.  It is not sent to the VM.
+	RecordRetainBlocks int64 `mapstructure:"record-retain-blocks" json:"-"`
+
+	// BridgeSlowCallThreshold is how long a bridge message may go unanswered
+	// before it is logged, or zero for never.  It is not sent to the VM.
+	BridgeSlowCallThreshold time.Duration `mapstructure:"bridge-slow-call-threshold" json:"-"`
+
+	// BridgeLogMessages logs every bridge message, not only the slow ones.
+	// It is not sent to the VM.
+	BridgeLogMessages bool `mapstructure:"bridge-log-messages" json:"-"`
+
+	// SlogExcludeTypes are the types of the slog entries which the VM leaves
+	// out of its slog.
+	SlogExcludeTypes []string `mapstructure:"slog-exclude-types" json:"slogExcludeTypes,omitempty"`
+
+	// MockVm replaces the VM with a stub acknowledging every message, for
+	// integration tests of the Cosmos modules.  It is normally set only by the
+	// --swingset.mock-vm flag of "agd start", and is not sent to the VM.
+	MockVm bool `mapstructure:"mock-vm" json:"-"`
+
+	// VmHealthCheckInterval is the least time between pings of the VM, which
+	// are sent after committing a block, or zero for never.
+	// It is not sent to the VM.
+	VmHealthCheckInterval time.Duration `mapstructure:"vm-health-check-interval" json:"-"`
+
+	// VmHealthCheckTimeout is how long a ping may go unanswered before the VM
+	// is reported unhealthy.  It is not sent to the VM.
+	VmHealthCheckTimeout time.Duration `mapstructure:"vm-health-check-timeout" json:"-"`
+
+	// ExportWorkers is the number of swing-store export artifacts read and
+	// encoded concurrently while creating a state-sync snapshot.  It is not
+	// sent to the VM.
+	ExportWorkers int `mapstructure:"export-workers" json:"-"`
+
+	// VmTransport selects how agd carries messages to and from the VM:
+	// "bridge" within the same process or over pipes to a --split-vm
+	// subprocess, or "grpc" over Unix domain sockets to a SwingSet worker run
+	// as a separate process.  The worker reads it from app.toml itself, so it
+	// is not sent to the VM.
+	VmTransport string `mapstructure:"vm_transport" json:"-"`
 }
 
 var DefaultSwingsetConfig = SwingsetConfig{
 	SlogFile:               "",
 	MaxVatsOnline:          50,
+	ReplayWorkers:          1,
 	VatSnapshotRetention:   "operational",
 	VatTranscriptRetention: "default",
+	VmHealthCheckTimeout:   DefaultVmHealthCheckTimeout,
+	ExportWorkers:          1,
+	VmTransport:            VmTransportBridge,
+}
+
+// validateVatConfig returns an error unless the file at path holds a SwingSet
+// vat config: a JSON object whose "vats", if any, is an object naming its
+// "bootstrap" vat, if any.
+func validateVatConfig(path string) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var vatConfig struct {
+		Bootstrap *string                     `json:"bootstrap"`
+		Vats      map[string]*json.RawMessage `json:"vats"`
+	}
+	if err := json.Unmarshal(bz, &vatConfig); err != nil {
+		return fmt.Errorf("%s is not a vat config: %w", path, err)
+	}
+	if vatConfig.Bootstrap != nil && vatConfig.Vats != nil {
+		if _, ok := vatConfig.Vats[*vatConfig.Bootstrap]; !ok {
+			return fmt.Errorf("%s has no bootstrap vat %q", path, *vatConfig.Bootstrap)
+		}
+	}
+	return nil
+}
+
+// GrpcSocketPaths returns the paths of the Unix domain sockets at which the VM
+// and agd serve gRPC with the "grpc" vm_transport, given the application
+// home.
+func GrpcSocketPaths(home string) (vmSocket, agdSocket string) {
+	dataDir := filepath.Join(home, "data")
+	return filepath.Join(dataDir, VmSocketFile), filepath.Join(dataDir, AgdSocketFile)
 }
 
 func SwingsetConfigFromViper(resolvedConfig servertypes.AppOptions) (*SwingsetConfig, error) {
@@ -143,6 +403,7 @@ func SwingsetConfigFromViper(resolvedConfig servertypes.AppOptions) (*SwingsetCo
 		return nil, nil
 	}
 	v.MustBindEnv(FlagSlogfile, "SLOGFILE")
+	v.MustBindEnv(FlagSlogsocket, "SLOGSOCKET")
 	// See CustomAppConfig in ../../daemon/cmd/root.go.
 	type ExtendedConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
@@ -154,13 +415,26 @@ func SwingsetConfigFromViper(resolvedConfig servertypes.AppOptions) (*SwingsetCo
 	}
 	ssConfig := &extendedConfig.Swingset
 
+	if ssConfig.SlogFileMaxSize < 0 {
+		return nil, fmt.Errorf("value for slogfile-max-size must not be negative")
+	}
+	if ssConfig.SlogFileMaxBackups < 0 {
+		return nil, fmt.Errorf("value for slogfile-max-backups must not be negative")
+	}
+	if ssConfig.RecordRetainBlocks < 0 {
+		return nil, fmt.Errorf("value for record-retain-blocks must not be negative")
+	}
+
 	// Validate vat snapshot retention only if non-empty (because otherwise it
 	// it will be omitted, leaving the VM to apply its own defaults).
 	if ssConfig.VatSnapshotRetention != "" {
+		if ssConfig.VatSnapshotRetention == SnapshotRetentionOptionArchival {
+			ssConfig.VatSnapshotRetention = SnapshotRetentionOptionDebug
+		}
 		if util.IndexOf(snapshotRetentionValues, ssConfig.VatSnapshotRetention) == -1 {
 			err := fmt.Errorf(
 				"value for vat-snapshot-retention must be in %q",
-				snapshotRetentionValues,
+				append([]string{SnapshotRetentionOptionArchival}, snapshotRetentionValues...),
 			)
 			return nil, err
 		}
@@ -183,6 +457,39 @@ func SwingsetConfigFromViper(resolvedConfig servertypes.AppOptions) (*SwingsetCo
 		return nil, err
 	}
 
+	if ssConfig.ReplayWorkers < 0 {
+		return nil, fmt.Errorf("value for replay-workers must not be negative")
+	}
+	if ssConfig.MaxVatsOnline > 0 && ssConfig.ReplayWorkers > ssConfig.MaxVatsOnline {
+		return nil, fmt.Errorf("value for replay-workers must not exceed max-vats-online (%d)", ssConfig.MaxVatsOnline)
+	}
+
+	if ssConfig.ExportWorkers < 0 {
+		return nil, fmt.Errorf("value for export-workers must not be negative")
+	}
+
+	switch ssConfig.VmTransport {
+	case "":
+		ssConfig.VmTransport = VmTransportBridge
+	case VmTransportBridge, VmTransportGrpc:
+	default:
+		return nil, fmt.Errorf("value for vm_transport must be in %q", []string{VmTransportBridge, VmTransportGrpc})
+	}
+
+	if ssConfig.BridgeSlowCallThreshold < 0 {
+		return nil, fmt.Errorf("value for bridge-slow-call-threshold must not be negative")
+	}
+
+	if ssConfig.VmHealthCheckInterval < 0 {
+		return nil, fmt.Errorf("value for vm-health-check-interval must not be negative")
+	}
+	if ssConfig.VmHealthCheckTimeout < 0 {
+		return nil, fmt.Errorf("value for vm-health-check-timeout must not be negative")
+	}
+	if ssConfig.VmHealthCheckTimeout == 0 {
+		ssConfig.VmHealthCheckTimeout = DefaultVmHealthCheckTimeout
+	}
+
 	// Interpret relative paths from config files against the application home
 	// directory and from other sources (e.g. env vars) against the current
 	// working directory.
@@ -218,6 +525,12 @@ func SwingsetConfigFromViper(resolvedConfig servertypes.AppOptions) (*SwingsetCo
 	}
 	ssConfig.SlogFile = resolvedSlogFile
 
+	resolvedSlogSocket, err := resolvePath(ssConfig.SlogSocket, FlagSlogsocket)
+	if err != nil {
+		return nil, err
+	}
+	ssConfig.SlogSocket = resolvedSlogSocket
+
 	resolvedSnapshotDir, err := resolvePath(ssConfig.VatSnapshotArchiveDir, FlagVatSnapshotArchiveDir)
 	if err != nil {
 		return nil, err
@@ -230,5 +543,39 @@ func SwingsetConfigFromViper(resolvedConfig servertypes.AppOptions) (*SwingsetCo
 	}
 	ssConfig.VatTranscriptArchiveDir = resolvedTranscriptDir
 
+	resolvedVatConfigOverride, err := resolvePath(ssConfig.VatConfigOverride, FlagVatConfigOverride)
+	if err != nil {
+		return nil, err
+	}
+	ssConfig.VatConfigOverride = resolvedVatConfigOverride
+	if ssConfig.VatConfigOverride != "" {
+		if err := validateVatConfig(ssConfig.VatConfigOverride); err != nil {
+			return nil, fmt.Errorf("value for vat-config-override: %w", err)
+		}
+	}
+
+	resolvedGenesisOverrides, err := resolvePath(ssConfig.GenesisOverrides, FlagGenesisOverrides)
+	if err != nil {
+		return nil, err
+	}
+	ssConfig.GenesisOverrides = resolvedGenesisOverrides
+	if ssConfig.GenesisOverrides != "" {
+		if _, err := keeper.ReadGenesisOverrides(ssConfig.GenesisOverrides); err != nil {
+			return nil, fmt.Errorf("value for genesis_overrides: %w", err)
+		}
+	}
+
+	resolvedBridgeJournal, err := resolvePath(ssConfig.BridgeJournal, FlagBridgeJournal)
+	if err != nil {
+		return nil, err
+	}
+	ssConfig.BridgeJournal = resolvedBridgeJournal
+
+	resolvedRecordDir, err := resolvePath(ssConfig.RecordDir, FlagRecordDir)
+	if err != nil {
+		return nil, err
+	}
+	ssConfig.RecordDir = resolvedRecordDir
+
 	return ssConfig, nil
 }
@@ -0,0 +1,323 @@
+package swingset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestSwingsetConfigFromViperSlogRotation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		values  map[string]interface{}
+		want    SwingsetConfig
+		wantErr bool
+	}{
+		{
+			name:   "defaults",
+			values: map[string]interface{}{},
+			want:   SwingsetConfig{},
+		},
+		{
+			name: "rotation enabled",
+			values: map[string]interface{}{
+				"swingset.slogfile-max-size":    100,
+				"swingset.slogfile-max-backups": 5,
+				"swingset.slogfile-compress":    true,
+			},
+			want: SwingsetConfig{
+				SlogFileMaxSize:    100,
+				SlogFileMaxBackups: 5,
+				SlogFileCompress:   true,
+			},
+		},
+		{
+			name:    "negative max size",
+			values:  map[string]interface{}{"swingset.slogfile-max-size": -1},
+			wantErr: true,
+		},
+		{
+			name:    "negative max backups",
+			values:  map[string]interface{}{"swingset.slogfile-max-backups": -1},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			for key, value := range tc.values {
+				v.Set(key, value)
+			}
+			got, err := SwingsetConfigFromViper(v)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.SlogFileMaxSize != tc.want.SlogFileMaxSize ||
+				got.SlogFileMaxBackups != tc.want.SlogFileMaxBackups ||
+				got.SlogFileCompress != tc.want.SlogFileCompress {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSwingsetConfigFromViperSlogSocket(t *testing.T) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := viper.New()
+	v.Set(FlagSlogsocket, "/var/run/agd-slog.sock")
+	got, err := SwingsetConfigFromViper(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.SlogSocket != "/var/run/agd-slog.sock" {
+		t.Errorf("got slogsocket %q, want absolute path unchanged", got.SlogSocket)
+	}
+
+	t.Setenv("SLOGSOCKET", "slog.sock")
+	got, err = SwingsetConfigFromViper(viper.New())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := filepath.Join(cwd, "slog.sock"); got.SlogSocket != want {
+		t.Errorf("got slogsocket %q, want %q", got.SlogSocket, want)
+	}
+}
+
+func TestSwingsetConfigFromViperReplayWorkers(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         interface{}
+		maxVatsOnline interface{}
+		want          int
+		wantErr       bool
+	}{
+		{name: "unset", value: nil, want: 0},
+		{name: "one", value: 1, want: 1},
+		{name: "several", value: 8, maxVatsOnline: 50, want: 8},
+		{name: "all online vats", value: 50, maxVatsOnline: 50, want: 50},
+		{name: "more than online vats", value: 51, maxVatsOnline: 50, wantErr: true},
+		{name: "negative", value: -1, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			if tc.value != nil {
+				v.Set("swingset.replay-workers", tc.value)
+			}
+			if tc.maxVatsOnline != nil {
+				v.Set("swingset.max-vats-online", tc.maxVatsOnline)
+			}
+			got, err := SwingsetConfigFromViper(v)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ReplayWorkers != tc.want {
+				t.Errorf("got %d, want %d", got.ReplayWorkers, tc.want)
+			}
+		})
+	}
+}
+
+func TestSwingsetConfigFromViperVatConfigOverride(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	testCases := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "unset", path: ""},
+		{name: "valid", path: writeConfig("valid.json", `{"bootstrap":"bootstrap","vats":{"bootstrap":{"sourceSpec":"./boot.js"}}}`)},
+		{name: "includes", path: writeConfig("includes.json", `{"includes":["base.json"],"bootstrap":"bootstrap"}`)},
+		{name: "missing", path: filepath.Join(dir, "missing.json"), wantErr: true},
+		{name: "not json", path: writeConfig("bad.json", `bootstrap: true`), wantErr: true},
+		{name: "not an object", path: writeConfig("array.json", `["bootstrap"]`), wantErr: true},
+		{name: "unknown bootstrap", path: writeConfig("unknown.json", `{"bootstrap":"boot","vats":{"bootstrap":{}}}`), wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			if tc.path != "" {
+				v.Set(FlagVatConfigOverride, tc.path)
+			}
+			got, err := SwingsetConfigFromViper(v)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.VatConfigOverride != tc.path {
+				t.Errorf("got %q, want %q", got.VatConfigOverride, tc.path)
+			}
+		})
+	}
+}
+
+func TestSwingsetConfigFromViperGenesisOverrides(t *testing.T) {
+	dir := t.TempDir()
+	writeOverrides := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	testCases := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "unset", path: ""},
+		{name: "valid", path: writeOverrides("valid.json", `{"kernel":{"v9.vs.feed":null},"vstorage":{"published.priceFeed":null,"published.chain.peer":"cosmoshub-fork"}}`)},
+		{name: "missing", path: filepath.Join(dir, "missing.json"), wantErr: true},
+		{name: "not an object", path: writeOverrides("array.json", `["published"]`), wantErr: true},
+		{name: "not a string", path: writeOverrides("number.json", `{"vstorage":{"published.count":1}}`), wantErr: true},
+		{name: "invalid path", path: writeOverrides("path.json", `{"vstorage":{"published..priceFeed":null}}`), wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			if tc.path != "" {
+				v.Set(FlagGenesisOverrides, tc.path)
+			}
+			got, err := SwingsetConfigFromViper(v)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.GenesisOverrides != tc.path {
+				t.Errorf("got %q, want %q", got.GenesisOverrides, tc.path)
+			}
+		})
+	}
+}
+
+func TestSwingsetConfigFromViperRetention(t *testing.T) {
+	testCases := []struct {
+		name           string
+		values         map[string]interface{}
+		wantSnapshot   string
+		wantTranscript string
+		wantErr        bool
+	}{
+		{
+			name:           "unset",
+			values:         map[string]interface{}{},
+			wantTranscript: TranscriptRetentionOptionOperational,
+		},
+		{
+			name: "archival",
+			values: map[string]interface{}{
+				"swingset.vat-snapshot-retention":   "archival",
+				"swingset.vat-transcript-retention": "archival",
+			},
+			wantSnapshot:   SnapshotRetentionOptionDebug,
+			wantTranscript: TranscriptRetentionOptionArchival,
+		},
+		{
+			name:    "snapshot count",
+			values:  map[string]interface{}{"swingset.vat-snapshot-retention": "1"},
+			wantErr: true,
+		},
+		{
+			name:    "transcript count",
+			values:  map[string]interface{}{"swingset.vat-transcript-retention": 5},
+			wantErr: true,
+		},
+		{
+			name:    "unknown",
+			values:  map[string]interface{}{"swingset.vat-snapshot-retention": "forever"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			for key, value := range tc.values {
+				v.Set(key, value)
+			}
+			got, err := SwingsetConfigFromViper(v)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.VatSnapshotRetention != tc.wantSnapshot ||
+				got.VatTranscriptRetention != tc.wantTranscript {
+				t.Errorf("got %+v", got)
+			}
+		})
+	}
+}
+
+func TestSwingsetConfigFromViperVmTransport(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "unset", value: nil, want: VmTransportBridge},
+		{name: "bridge", value: "bridge", want: VmTransportBridge},
+		{name: "grpc", value: "grpc", want: VmTransportGrpc},
+		{name: "unknown", value: "carrier-pigeon", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			if tc.value != nil {
+				v.Set(FlagVmTransport, tc.value)
+			}
+			got, err := SwingsetConfigFromViper(v)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got config %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.VmTransport != tc.want {
+				t.Errorf("got %q, want %q", got.VmTransport, tc.want)
+			}
+		})
+	}
+}
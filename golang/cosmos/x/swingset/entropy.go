@@ -0,0 +1,66 @@
+package swingset
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+const GetBlockEntropy = "getBlockEntropy"
+
+// entropyPortHandler implements vm.PortHandler for the "entropy" bridge port,
+// through which SwingSet obtains the deterministic entropy of each block.
+type entropyPortHandler struct {
+	keeper Keeper
+}
+
+type entropyMessage struct {
+	Method string `json:"method"`
+}
+
+// blockEntropy is the reply to getBlockEntropy.
+type blockEntropy struct {
+	BlockHeight string `json:"blockHeight"`
+	// Entropy is the hex encoding of 32 bytes.
+	Entropy string `json:"entropy"`
+}
+
+// NewEntropyPortHandler returns a port handler offering the block entropy
+// maintained by a swingset Keeper.
+func NewEntropyPortHandler(k Keeper) vm.PortHandler {
+	return entropyPortHandler{keeper: k}
+}
+
+// Receive implements the vm.PortHandler method.
+func (ph entropyPortHandler) Receive(cctx context.Context, str string) (string, error) {
+	ctx := sdk.UnwrapSDKContext(cctx)
+	var msg entropyMessage
+	if err := json.Unmarshal([]byte(str), &msg); err != nil {
+		return "", err
+	}
+
+	switch msg.Method {
+	case GetBlockEntropy:
+		entropy, ok := ph.keeper.GetBlockEntropy(ctx)
+		if !ok {
+			return "", fmt.Errorf("block entropy is not available")
+		}
+		bz, err := json.Marshal(blockEntropy{
+			BlockHeight: strconv.FormatInt(ctx.BlockHeight(), 10),
+			Entropy:     hex.EncodeToString(entropy),
+		})
+		if err != nil {
+			return "", err
+		}
+		return string(bz), nil
+
+	default:
+		return "", fmt.Errorf("unrecognized entropy method %s", msg.Method)
+	}
+}
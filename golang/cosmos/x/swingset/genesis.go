@@ -3,11 +3,14 @@ package swingset
 import (
 	"bytes"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash"
 	"io"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -37,6 +40,155 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	seenBuckets := make(map[string]bool, len(data.WalletSpendActionRateLimitBuckets))
+	for _, record := range data.WalletSpendActionRateLimitBuckets {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid rate limit bucket address: %w", err)
+		}
+		if seenBuckets[record.Address] {
+			return fmt.Errorf("duplicate rate limit bucket for %s", record.Address)
+		}
+		seenBuckets[record.Address] = true
+	}
+	seenSteps := make(map[string]bool, len(data.UpgradeSteps))
+	for _, record := range data.UpgradeSteps {
+		if record.Name == "" {
+			return fmt.Errorf("upgrade step record has no name")
+		}
+		if seenSteps[record.Name] {
+			return fmt.Errorf("duplicate upgrade step record for %s", record.Name)
+		}
+		seenSteps[record.Name] = true
+		if record.DoneHeight <= 0 {
+			return fmt.Errorf("invalid done height %d of upgrade step %s", record.DoneHeight, record.Name)
+		}
+	}
+	seenDeposits := make(map[string]bool, len(data.BundleStorageDeposits))
+	for _, record := range data.BundleStorageDeposits {
+		if len(record.BundleHash) != 2*sha512.Size || strings.ToLower(record.BundleHash) != record.BundleHash {
+			return fmt.Errorf("invalid bundle storage deposit hash %q", record.BundleHash)
+		}
+		if seenDeposits[record.BundleHash] {
+			return fmt.Errorf("duplicate bundle storage deposit for %s", record.BundleHash)
+		}
+		seenDeposits[record.BundleHash] = true
+		if _, err := sdk.AccAddressFromBech32(record.Deposit.Submitter); err != nil {
+			return fmt.Errorf("invalid submitter of bundle storage deposit %s: %w", record.BundleHash, err)
+		}
+		if err := record.Deposit.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid bundle storage deposit %s: %w", record.BundleHash, err)
+		}
+	}
+	seenAutoProvisions := make(map[string]bool, len(data.AutoProvisions))
+	for _, record := range data.AutoProvisions {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid auto-provision address: %w", err)
+		}
+		if seenAutoProvisions[record.Address] {
+			return fmt.Errorf("duplicate auto-provision record for %s", record.Address)
+		}
+		seenAutoProvisions[record.Address] = true
+	}
+	if len(data.BlockEntropy) != 0 && len(data.BlockEntropy) != sha256.Size {
+		return fmt.Errorf("block entropy must be %d bytes, not %d", sha256.Size, len(data.BlockEntropy))
+	}
+	seenInstallations := make(map[string]bool, len(data.BundleInstallations))
+	for _, record := range data.BundleInstallations {
+		if len(record.BundleHash) != 2*sha512.Size || strings.ToLower(record.BundleHash) != record.BundleHash {
+			return fmt.Errorf("invalid bundle installation hash %q", record.BundleHash)
+		}
+		if seenInstallations[record.BundleHash] {
+			return fmt.Errorf("duplicate bundle installation for %s", record.BundleHash)
+		}
+		seenInstallations[record.BundleHash] = true
+		switch record.Installation.Status {
+		case types.BundleStatusPending, types.BundleStatusInstalled, types.BundleStatusRejected:
+		default:
+			return fmt.Errorf("invalid status %q of bundle installation %s", record.Installation.Status, record.BundleHash)
+		}
+	}
+	seenUploads := make(map[string]bool, len(data.BundleUploads))
+	for _, record := range data.BundleUploads {
+		if _, err := sdk.AccAddressFromBech32(record.Submitter); err != nil {
+			return fmt.Errorf("invalid submitter of bundle upload %s: %w", record.BundleHash, err)
+		}
+		if len(record.BundleHash) != 2*sha512.Size || strings.ToLower(record.BundleHash) != record.BundleHash {
+			return fmt.Errorf("invalid bundle upload hash %q", record.BundleHash)
+		}
+		uploadID := record.Submitter + "/" + record.BundleHash
+		if seenUploads[uploadID] {
+			return fmt.Errorf("duplicate bundle upload %s", uploadID)
+		}
+		seenUploads[uploadID] = true
+		if record.Upload.TotalChunks == 0 || record.Upload.TotalChunks > types.BundleChunksLimit {
+			return fmt.Errorf("invalid total chunks %d of bundle upload %s", record.Upload.TotalChunks, uploadID)
+		}
+		if uint64(len(record.Chunks)) != record.Upload.ReceivedChunks {
+			return fmt.Errorf("bundle upload %s has %d chunks, not the %d received", uploadID, len(record.Chunks), record.Upload.ReceivedChunks)
+		}
+		seenChunks := make(map[uint64]bool, len(record.Chunks))
+		for _, chunk := range record.Chunks {
+			if chunk.Index >= record.Upload.TotalChunks || seenChunks[chunk.Index] {
+				return fmt.Errorf("invalid chunk index %d of bundle upload %s", chunk.Index, uploadID)
+			}
+			seenChunks[chunk.Index] = true
+		}
+	}
+	seenPeers := make(map[string]bool, len(data.DeliveredInbound))
+	for _, record := range data.DeliveredInbound {
+		if _, err := sdk.AccAddressFromBech32(record.Peer); err != nil {
+			return fmt.Errorf("invalid delivered inbound peer: %w", err)
+		}
+		if seenPeers[record.Peer] {
+			return fmt.Errorf("duplicate delivered inbound record for %s", record.Peer)
+		}
+		seenPeers[record.Peer] = true
+		seenNums := make(map[uint64]bool, len(record.Messages))
+		for _, message := range record.Messages {
+			if seenNums[message.Num] {
+				return fmt.Errorf("duplicate delivered inbound message %d of %s", message.Num, record.Peer)
+			}
+			seenNums[message.Num] = true
+			if message.ExpiryHeight < 0 {
+				return fmt.Errorf("invalid expiry height %d of delivered inbound message %d of %s", message.ExpiryHeight, message.Num, record.Peer)
+			}
+		}
+	}
+	if len(data.BridgeMessageDigest) != 0 && len(data.BridgeMessageDigest) != sha256.Size {
+		return fmt.Errorf("bridge message digest must be %d bytes, not %d", sha256.Size, len(data.BridgeMessageDigest))
+	}
+	if data.PolicyHeadroom != "" {
+		if _, err := strconv.ParseUint(data.PolicyHeadroom, 10, 64); err != nil {
+			return fmt.Errorf("invalid policy headroom %q: %w", data.PolicyHeadroom, err)
+		}
+	}
+	seenVats := make(map[string]bool, len(data.VatOwners))
+	for _, record := range data.VatOwners {
+		if err := types.ValidateVatID(record.VatID); err != nil {
+			return err
+		}
+		if seenVats[record.VatID] {
+			return fmt.Errorf("duplicate vat owner for %s", record.VatID)
+		}
+		seenVats[record.VatID] = true
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid owner of vat %s: %w", record.VatID, err)
+		}
+		if !record.Unbilled.IsNil() && record.Unbilled.IsNegative() {
+			return fmt.Errorf("invalid unbilled cost %s of vat %s", record.Unbilled, record.VatID)
+		}
+	}
+	if export := data.SwingStoreExport; export != nil {
+		if data.SwingStoreExportDataHash == "" && len(data.SwingStoreExportData) == 0 {
+			return fmt.Errorf("swing-store export %s has neither export data nor its hash", export.Dir)
+		}
+		if !filepath.IsLocal(export.Dir) {
+			return fmt.Errorf("swing-store export dir %q must be a path within that of the genesis file", export.Dir)
+		}
+		if !strings.HasPrefix(export.ManifestHash, "sha256:") {
+			return fmt.Errorf("invalid swing-store export manifest hash %s", export.ManifestHash)
+		}
+	}
 	return nil
 }
 
@@ -48,19 +200,88 @@ func DefaultGenesisState() *types.GenesisState {
 	}
 }
 
+// ResolveSwingStoreExportDir returns the directory of the swing-store export
+// from which InitGenesis restores the kernel state of data: that which data
+// references relative to the directory of genesisFile, or else the configured
+// swingStoreExportDir.
+func ResolveSwingStoreExportDir(genesisFile, swingStoreExportDir string, data *types.GenesisState) string {
+	if export := data.SwingStoreExport; export != nil && genesisFile != "" {
+		return filepath.Join(filepath.Dir(genesisFile), export.Dir)
+	}
+	return swingStoreExportDir
+}
+
 // InitGenesis initializes the (Cosmos-side) SwingSet state from the GenesisState.
 // Returns whether the app should send a bootstrap action to the controller.
-func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, data *types.GenesisState) bool {
+//
+// A genesis holding a swing-store export may be "fast forwarded" into a forked
+// chain by overrides, which are applied to the kernel state restored from the
+// export and to the vstorage imported with it.  They are refused for a genesis
+// that bootstraps the kernel.
+func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStoreExportsHandler, swingStoreExportDir string, overrides keeper.GenesisOverrides, data *types.GenesisState) bool {
 	k.SetParams(ctx, data.GetParams())
 	k.SetState(ctx, data.GetState())
+	for _, record := range data.GetWalletSpendActionRateLimitBuckets() {
+		if err := k.SetRateLimitBucket(ctx, sdk.MustAccAddressFromBech32(record.Address), record.Bucket); err != nil {
+			panic(err)
+		}
+	}
+	for _, record := range data.GetBundleUploads() {
+		k.SetBundleUpload(ctx, record)
+	}
+	for _, record := range data.GetBundleInstallations() {
+		k.SetBundleInstallation(ctx, record)
+	}
+	k.SetBridgeMessageDigest(ctx, data.GetBridgeMessageDigest())
+	for _, record := range data.GetUpgradeSteps() {
+		k.SetUpgradeStep(ctx, record)
+	}
+	for _, record := range data.GetVatOwners() {
+		k.SetVatOwner(ctx, record)
+	}
+	if data.GetPolicyHeadroom() != "" {
+		headroom, err := strconv.ParseUint(data.GetPolicyHeadroom(), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPolicyHeadroom(ctx, headroom, true)
+	}
+	for _, record := range data.GetDeliveredInbound() {
+		k.SetDeliveredInbound(ctx, record)
+	}
+	for _, record := range data.GetBundleStorageDeposits() {
+		k.SetBundleStorageDeposit(ctx, record)
+	}
+	k.SetLatestBlockEntropy(ctx, data.GetBlockEntropy())
+	for _, record := range data.GetAutoProvisions() {
+		k.SetAutoProvision(ctx, record)
+	}
 
 	swingStoreExportData := data.GetSwingStoreExportData()
 	if len(swingStoreExportData) == 0 && data.SwingStoreExportDataHash == "" {
+		if !overrides.IsEmpty() {
+			panic("Swingset genesis overrides require a genesis with swing-store export data")
+		}
 		return true
 	} else if data.SwingStoreExportDataHash != "" && len(swingStoreExportData) > 0 {
 		panic("Swingset genesis state cannot have both export data and hash of export data")
 	}
 
+	k.ApplyVstorageOverrides(ctx, overrides.Vstorage)
+
+	if export := data.SwingStoreExport; export != nil {
+		manifestHash, manifestHeight, err := keeper.HashSwingStoreExportManifest(swingStoreExportDir)
+		if err != nil {
+			panic(fmt.Errorf("cannot read swing-store export %s of genesis (expected in %s): %w", export.Dir, swingStoreExportDir, err))
+		}
+		if manifestHash != export.ManifestHash {
+			panic(fmt.Errorf("swing-store export manifest hash %s in %s doesn't match genesis (%s)", manifestHash, swingStoreExportDir, export.ManifestHash))
+		}
+		if manifestHeight != 0 && manifestHeight != export.BlockHeight {
+			panic(fmt.Errorf("swing-store export manifest blockHeight %d in %s doesn't match genesis (%d)", manifestHeight, swingStoreExportDir, export.BlockHeight))
+		}
+	}
+
 	artifactProvider, err := keeper.OpenSwingStoreExportDirectory(swingStoreExportDir)
 	if err != nil {
 		panic(err)
@@ -73,8 +294,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 	var getExportDataReader func() (agoric.KVEntryReader, error)
 
 	if len(swingStoreExportData) > 0 {
-		for _, entry := range swingStoreExportData {
-			swingStore.Set([]byte(entry.Key), []byte(entry.Value))
+		reader := keeper.NewKVOverridingReader(agoric.NewSwingStoreExportDataEntriesReader(swingStoreExportData), overrides.Kernel)
+		for {
+			entry, err := reader.Read()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				panic(err)
+			}
+			swingStore.Set([]byte(entry.Key()), []byte(entry.StringValue()))
 		}
 		getExportDataReader = func() (agoric.KVEntryReader, error) {
 			exportDataIterator := swingStore.Iterator(nil, nil)
@@ -106,7 +334,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 			encoder := json.NewEncoder(hasher)
 			encoder.SetEscapeHTML(false)
 
-			return agoric.NewKVHookingReader(kvReader, func(entry agoric.KVEntry) error {
+			hashingReader := agoric.NewKVHookingReader(kvReader, func(entry agoric.KVEntry) error {
+				return encoder.Encode(entry)
+			}, func() error {
+				sum := hasher.Sum(nil)
+				if !bytes.Equal(sum, sha256Hash) {
+					return fmt.Errorf("swing-store data sha256sum didn't match. expected %x, got %x", sha256Hash, sum)
+				}
+				return nil
+			})
+
+			// The export data is checked as exported, and restored with the
+			// kernel overrides applied.
+			return agoric.NewKVHookingReader(keeper.NewKVOverridingReader(hashingReader, overrides.Kernel), func(entry agoric.KVEntry) error {
 				key := []byte(entry.Key())
 
 				if !entry.HasValue() {
@@ -115,12 +355,8 @@ func InitGenesis(ctx sdk.Context, k Keeper, swingStoreExportsHandler *SwingStore
 					swingStore.Set(key, []byte(entry.StringValue()))
 				}
 
-				return encoder.Encode(entry)
+				return nil
 			}, func() error {
-				sum := hasher.Sum(nil)
-				if !bytes.Equal(sum, sha256Hash) {
-					return fmt.Errorf("swing-store data sha256sum didn't match. expected %x, got %x", sha256Hash, sum)
-				}
 				return nil
 			}), nil
 		}
@@ -155,6 +391,20 @@ func ExportGenesis(
 		Params:               k.GetParams(ctx),
 		State:                k.GetState(ctx),
 		SwingStoreExportData: nil,
+
+		WalletSpendActionRateLimitBuckets: k.GetRateLimitBuckets(ctx),
+		BundleInstallations:               k.GetBundleInstallations(ctx),
+		BundleUploads:                     k.GetBundleUploads(ctx),
+		BridgeMessageDigest:               k.GetBridgeMessageDigest(ctx),
+		UpgradeSteps:                      k.GetUpgradeSteps(ctx),
+		VatOwners:                         k.GetVatOwners(ctx),
+		DeliveredInbound:                  k.GetDeliveredInbound(ctx),
+		BundleStorageDeposits:             k.GetBundleStorageDeposits(ctx),
+		BlockEntropy:                      k.GetLatestBlockEntropy(ctx),
+		AutoProvisions:                    k.GetAutoProvisions(ctx),
+	}
+	if headroom, found := k.GetPolicyHeadroom(ctx); found {
+		gs.PolicyHeadroom = strconv.FormatUint(headroom, 10)
 	}
 
 	// This will only be used in non skip mode
@@ -169,9 +419,11 @@ func ExportGenesis(
 	}
 
 	if swingStoreExportMode != SwingStoreExportModeSkip {
+		var exportHeight uint64
 		eventHandler := swingStoreGenesisEventHandler{
 			exportDir:      swingStoreExportDir,
 			snapshotHeight: snapshotHeight,
+			exportHeight:   &exportHeight,
 			swingStore:     k.GetSwingStore(ctx),
 			hasher:         hasher,
 			exportMode:     swingStoreExportMode,
@@ -194,6 +446,16 @@ func ExportGenesis(
 		if err != nil {
 			panic(err)
 		}
+
+		manifestHash, _, err := keeper.HashSwingStoreExportManifest(swingStoreExportDir)
+		if err != nil {
+			panic(err)
+		}
+		gs.SwingStoreExport = &types.SwingStoreExportReference{
+			Dir:          filepath.Base(swingStoreExportDir),
+			BlockHeight:  exportHeight,
+			ManifestHash: manifestHash,
+		}
 	}
 
 	gs.SwingStoreExportDataHash = fmt.Sprintf("sha256:%x", hasher.Sum(nil))
@@ -204,9 +466,12 @@ func ExportGenesis(
 type swingStoreGenesisEventHandler struct {
 	exportDir      string
 	snapshotHeight uint64
-	swingStore     sdk.KVStore
-	hasher         hash.Hash
-	exportMode     string
+	// exportHeight receives the block height of the retrieved export, which
+	// in debug mode is that of the latest rather than the requested.
+	exportHeight *uint64
+	swingStore   sdk.KVStore
+	hasher       hash.Hash
+	exportMode   string
 }
 
 func (eventHandler swingStoreGenesisEventHandler) OnExportStarted(height uint64, retrieveSwingStoreExport func() error) error {
@@ -218,6 +483,8 @@ func (eventHandler swingStoreGenesisEventHandler) OnExportRetrieved(provider kee
 		return fmt.Errorf("snapshot block height (%d) doesn't match requested height (%d)", provider.BlockHeight, eventHandler.snapshotHeight)
 	}
 
+	*eventHandler.exportHeight = provider.BlockHeight
+
 	artifactsEnded := false
 
 	artifactsProvider := keeper.SwingStoreExportProvider{
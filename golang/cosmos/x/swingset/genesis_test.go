@@ -1,7 +1,28 @@
 package swingset
 
 import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 )
 
 func TestDefaultGenesis(t *testing.T) {
@@ -10,3 +31,576 @@ func TestDefaultGenesis(t *testing.T) {
 		t.Errorf("DefaultGenesisState did not validate %v: %e", defaultGenesisState, err)
 	}
 }
+
+func TestValidateGenesisRateLimitBuckets(t *testing.T) {
+	addr := sdk.AccAddress([]byte("submitter")).String()
+	for _, tt := range []struct {
+		name    string
+		records []types.RateLimitBucketRecord
+		wantErr bool
+	}{
+		{"valid", []types.RateLimitBucketRecord{{Address: addr}}, false},
+		{"bad address", []types.RateLimitBucketRecord{{Address: "agoric1bad"}}, true},
+		{"duplicate", []types.RateLimitBucketRecord{{Address: addr}, {Address: addr}}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.WalletSpendActionRateLimitBuckets = tt.records
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisBundleInstallations(t *testing.T) {
+	hash := strings.Repeat("ab", 64)
+	record := func(bundleHash, status string) types.BundleInstallationRecord {
+		return types.BundleInstallationRecord{
+			BundleHash:   bundleHash,
+			Installation: types.BundleInstallation{Status: status, Height: 3},
+		}
+	}
+	for _, tt := range []struct {
+		name    string
+		records []types.BundleInstallationRecord
+		wantErr bool
+	}{
+		{"valid", []types.BundleInstallationRecord{record(hash, types.BundleStatusInstalled)}, false},
+		{"short hash", []types.BundleInstallationRecord{record("abc", types.BundleStatusInstalled)}, true},
+		{"duplicate", []types.BundleInstallationRecord{record(hash, types.BundleStatusPending), record(hash, types.BundleStatusInstalled)}, true},
+		{"invalid status", []types.BundleInstallationRecord{record(hash, "lost")}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.BundleInstallations = tt.records
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisBundleUploads(t *testing.T) {
+	hash := strings.Repeat("ab", 64)
+	submitter := sdk.AccAddress([]byte("submitter")).String()
+	record := func(submitter string, indexes ...uint64) types.BundleUploadRecord {
+		chunks := []types.BundleUploadChunk{}
+		for _, index := range indexes {
+			chunks = append(chunks, types.BundleUploadChunk{Index: index, Data: []byte("chunk")})
+		}
+		return types.BundleUploadRecord{
+			Submitter:  submitter,
+			BundleHash: hash,
+			Upload:     types.BundleUpload{TotalChunks: 2, ReceivedChunks: uint64(len(indexes)), ExpiryHeight: 10},
+			Chunks:     chunks,
+		}
+	}
+	mismatched := record(submitter, 0)
+	mismatched.Upload.ReceivedChunks = 2
+	for _, tt := range []struct {
+		name    string
+		records []types.BundleUploadRecord
+		wantErr bool
+	}{
+		{"valid", []types.BundleUploadRecord{record(submitter, 1)}, false},
+		{"invalid submitter", []types.BundleUploadRecord{record("agoric1bad", 1)}, true},
+		{"duplicate", []types.BundleUploadRecord{record(submitter, 0), record(submitter, 1)}, true},
+		{"chunk out of range", []types.BundleUploadRecord{record(submitter, 2)}, true},
+		{"duplicate chunk", []types.BundleUploadRecord{record(submitter, 1, 1)}, true},
+		{"mismatched received chunks", []types.BundleUploadRecord{mismatched}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.BundleUploads = tt.records
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisBridgeMessageDigest(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		digest  []byte
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"valid", make([]byte, 32), false},
+		{"short", make([]byte, 31), true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.BridgeMessageDigest = tt.digest
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func makeTestGenesisKeeper(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+	return makeTestControllerKeeper(t, nil)
+}
+
+// makeTestControllerKeeper returns a keeper sending its bridge messages to
+// callToController.
+func makeTestControllerKeeper(t *testing.T, callToController func(ctx sdk.Context, str string) (string, error)) (Keeper, sdk.Context) {
+	t.Helper()
+	swingsetStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range []storetypes.StoreKey{swingsetStoreKey, vstorageStoreKey, paramsStoreKey} {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsKeeper := func(name string) paramstypes.Subspace {
+		return paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, name)
+	}
+	vstorageKeeper := vstoragekeeper.NewKeeper(vstorageStoreKey, paramsKeeper(vstoragetypes.ModuleName))
+	k := keeper.NewKeeper(cdc, swingsetStoreKey, paramsKeeper(types.ModuleName), nil, nil, vstorageKeeper, "", "", callToController)
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 7}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	return k, ctx
+}
+
+// roundTripGenesis exports the genesis of k, without the kernel state, and
+// imports it into a fresh keeper.
+func roundTripGenesis(t *testing.T, k Keeper, ctx sdk.Context) (Keeper, sdk.Context) {
+	t.Helper()
+	gs := ExportGenesis(ctx, k, nil, "", SwingStoreExportModeSkip)
+	gs.SwingStoreExportDataHash = ""
+	if err := ValidateGenesis(gs); err != nil {
+		t.Fatal(err)
+	}
+	k2, ctx2 := makeTestGenesisKeeper(t)
+	InitGenesis(ctx2, k2, nil, "", keeper.GenesisOverrides{}, gs)
+	return k2, ctx2
+}
+
+func TestGenesisBundleInstallationsRoundTrip(t *testing.T) {
+	hash := strings.Repeat("ab", 64)
+	k, ctx := makeTestGenesisKeeper(t)
+	params := types.DefaultParams()
+	params.BundlePruneMinAgeBlocks = 3
+	k.SetParams(ctx, params)
+	k.SetBundleInstallation(ctx, types.BundleInstallationRecord{
+		BundleHash:   hash,
+		Installation: types.BundleInstallation{Status: types.BundleStatusInstalled, Height: 2},
+	})
+
+	k2, ctx2 := roundTripGenesis(t, k, ctx)
+	installation, found := k2.GetBundleInstallation(ctx2, hash)
+	if !found || installation.Status != types.BundleStatusInstalled || installation.Height != 2 {
+		t.Fatalf("got imported installation %v (found %t), want installed at height 2", installation, found)
+	}
+	// The imported bundle is old enough to be pruned.
+	if err := k2.PruneBundles(ctx2, []string{hash}); err != nil {
+		t.Errorf("cannot prune imported bundle: %v", err)
+	}
+}
+
+func TestGenesisBundleUploadsRoundTrip(t *testing.T) {
+	submitter := sdk.AccAddress([]byte("submitter"))
+	bundle := types.NewMsgInstallBundle(`{"moduleFormat":"endoZipBase64"}`, submitter)
+	if err := bundle.Compress(); err != nil {
+		t.Fatal(err)
+	}
+	compressed := bundle.CompressedBundle
+	hash := types.BundleHash(compressed)
+	split := len(compressed) / 2
+	k, ctx := makeTestGenesisKeeper(t)
+	if _, err := k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(hash, 1, 2, compressed[split:], bundle.UncompressedSize, submitter)); err != nil {
+		t.Fatal(err)
+	}
+
+	// The imported upload can be completed.
+	k2, ctx2 := roundTripGenesis(t, k, ctx)
+	cacheCtx, _ := ctx2.CacheContext()
+	got, err := k2.AddBundleChunk(cacheCtx, types.NewMsgInstallBundleChunk(hash, 0, 2, compressed[:split], bundle.UncompressedSize, submitter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || !bytes.Equal(got.CompressedBundle, compressed) {
+		t.Fatalf("got bundle %v, want the complete upload", got)
+	}
+
+	// And it expires as it would have on the exporting chain.
+	k2.PruneExpiredBundleUploads(ctx2.WithBlockHeight(ctx.BlockHeight() + keeper.BundleUploadExpiryBlocks))
+	if uploads := k2.GetBundleUploads(ctx2); len(uploads) != 0 {
+		t.Errorf("got uploads %v after expiry, want none", uploads)
+	}
+}
+
+func TestGenesisBridgeMessageDigestRoundTrip(t *testing.T) {
+	digest := bytes.Repeat([]byte{7}, 32)
+	k, ctx := makeTestGenesisKeeper(t)
+	params := types.DefaultParams()
+	params.BridgeMessageHashChain = true
+	k.SetParams(ctx, params)
+	k.SetBridgeMessageDigest(ctx, digest)
+
+	// The imported chain chains the next block's bridge messages from the
+	// exported digest.
+	k2, ctx2 := roundTripGenesis(t, k, ctx)
+	hashChain := vm.NewHashChain()
+	k2 = k2.WithBridgeHashChain(hashChain)
+	k2.BeginBridgeMessageHashChain(ctx2)
+	k2.EndBridgeMessageHashChain(ctx2)
+	if got := k2.GetBridgeMessageDigest(ctx2); !bytes.Equal(got, digest) {
+		t.Errorf("got digest %x, want %x", got, digest)
+	}
+}
+
+func TestValidateGenesisUpgradeSteps(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		records []types.UpgradeStepRecord
+		wantErr bool
+	}{
+		{"valid", []types.UpgradeStepRecord{{Name: "v1/step", DoneHeight: 1}, {Name: "v1/step2", DoneHeight: 1}}, false},
+		{"no name", []types.UpgradeStepRecord{{DoneHeight: 1}}, true},
+		{"duplicate", []types.UpgradeStepRecord{{Name: "v1/step", DoneHeight: 1}, {Name: "v1/step", DoneHeight: 2}}, true},
+		{"not done", []types.UpgradeStepRecord{{Name: "v1/step"}}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.UpgradeSteps = tt.records
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenesisPolicyHeadroomRoundTrip(t *testing.T) {
+	k, ctx := makeTestGenesisKeeper(t)
+	params := types.DefaultParams()
+	params.MinRunPolicyHeadroom = 100
+	k.SetParams(ctx, params)
+	k.UpdatePolicyHeadroom(ctx, `{"policyHeadroom":"0"}`)
+
+	k2, ctx2 := roundTripGenesis(t, k, ctx)
+	if headroom, found := k2.GetPolicyHeadroom(ctx2); !found || headroom != 0 {
+		t.Errorf("got imported headroom %d (found %t), want 0", headroom, found)
+	}
+	if !k2.IsInboundBackpressured(ctx2) {
+		t.Error("imported chain is not backpressured")
+	}
+
+	// Without a reported headroom, none is imported.
+	k.UpdatePolicyHeadroom(ctx, `{}`)
+	k3, ctx3 := roundTripGenesis(t, k, ctx)
+	if _, found := k3.GetPolicyHeadroom(ctx3); found {
+		t.Error("got imported headroom, want none")
+	}
+}
+
+func TestGenesisDeliveredInboundRoundTrip(t *testing.T) {
+	peer := sdk.AccAddress([]byte("peer"))
+	k, ctx := makeTestGenesisKeeper(t)
+	params := types.DefaultParams()
+	params.InboundDedupWindowBlocks = 3
+	k.SetParams(ctx, params)
+	k.RecordDeliverInbound(ctx, peer, []uint64{1, 2}, 5)
+
+	// The imported chain still rejects the replay.
+	k2, ctx2 := roundTripGenesis(t, k, ctx)
+	if !k2.IsDeliverInboundReplay(ctx2, peer, []uint64{1, 2}, 5) {
+		t.Error("imported chain does not remember the delivery")
+	}
+	if k2.IsDeliverInboundReplay(ctx2, peer, []uint64{1, 2}, 6) {
+		t.Error("imported chain rejects a newer ack")
+	}
+
+	// And forgets it when it would have expired on the exporting chain.
+	k2.PruneDeliveredInbound(ctx2.WithBlockHeight(ctx.BlockHeight() + 3))
+	if k2.IsDeliverInboundReplay(ctx2, peer, []uint64{1}, 5) {
+		t.Error("imported delivery not forgotten at expiry")
+	}
+}
+
+func TestValidateGenesisBundleStorageDeposits(t *testing.T) {
+	hash := strings.Repeat("ab", 64)
+	submitter := sdk.AccAddress([]byte("submitter")).String()
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("ubld", 100))
+	record := func(bundleHash, submitter string, coins sdk.Coins) types.BundleStorageDepositRecord {
+		return types.BundleStorageDepositRecord{
+			BundleHash: bundleHash,
+			Deposit:    types.BundleStorageDeposit{Submitter: submitter, Deposit: coins},
+		}
+	}
+	for _, tt := range []struct {
+		name    string
+		records []types.BundleStorageDepositRecord
+		wantErr bool
+	}{
+		{"valid", []types.BundleStorageDepositRecord{record(hash, submitter, deposit)}, false},
+		{"short hash", []types.BundleStorageDepositRecord{record("abc", submitter, deposit)}, true},
+		{"uppercase hash", []types.BundleStorageDepositRecord{record(strings.ToUpper(hash), submitter, deposit)}, true},
+		{"duplicate", []types.BundleStorageDepositRecord{record(hash, submitter, deposit), record(hash, submitter, deposit)}, true},
+		{"invalid submitter", []types.BundleStorageDepositRecord{record(hash, "agoric1bad", deposit)}, true},
+		{"invalid coins", []types.BundleStorageDepositRecord{record(hash, submitter, sdk.Coins{{Denom: "ubld", Amount: sdk.NewInt(-1)}})}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.BundleStorageDeposits = tt.records
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisAutoProvisions(t *testing.T) {
+	addr := sdk.AccAddress([]byte("receiver")).String()
+	for _, tt := range []struct {
+		name    string
+		records []types.AutoProvisionRecord
+		wantErr bool
+	}{
+		{"valid", []types.AutoProvisionRecord{{Address: addr, Height: 7}}, false},
+		{"duplicate", []types.AutoProvisionRecord{{Address: addr, Height: 7}, {Address: addr, Height: 8}}, true},
+		{"invalid address", []types.AutoProvisionRecord{{Address: "agoric1bad", Height: 7}}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.AutoProvisions = tt.records
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisBlockEntropy(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		entropy []byte
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"valid", make([]byte, 32), false},
+		{"short", make([]byte, 31), true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.BlockEntropy = tt.entropy
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisSwingStoreExport(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		hash    string
+		export  types.SwingStoreExportReference
+		wantErr bool
+	}{
+		{"valid", "sha256:00", types.SwingStoreExportReference{Dir: "swing-store", ManifestHash: "sha256:00"}, false},
+		{"without export data", "", types.SwingStoreExportReference{Dir: "swing-store", ManifestHash: "sha256:00"}, true},
+		{"absolute dir", "sha256:00", types.SwingStoreExportReference{Dir: "/tmp/swing-store", ManifestHash: "sha256:00"}, true},
+		{"empty dir", "sha256:00", types.SwingStoreExportReference{ManifestHash: "sha256:00"}, true},
+		{"escaping dir", "sha256:00", types.SwingStoreExportReference{Dir: "../swing-store", ManifestHash: "sha256:00"}, true},
+		{"bad manifest hash", "sha256:00", types.SwingStoreExportReference{Dir: "swing-store", ManifestHash: "00"}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.SwingStoreExportDataHash = tt.hash
+			gs.SwingStoreExport = &tt.export
+			err := ValidateGenesis(gs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// fakeSwingStore stands in for the JS swing-store of the exports handler,
+// exporting its artifacts at exportHeight and recording those restored.
+type fakeSwingStore struct {
+	t            *testing.T
+	exportHeight uint64
+	artifacts    map[string]string
+	restored     map[string]string
+}
+
+func (fake *fakeSwingStore) blockingSend(action vm.Jsonable, mustNotBeInited bool) (string, error) {
+	bz, err := json.Marshal(action)
+	if err != nil {
+		return "", err
+	}
+	var request struct {
+		Request string `json:"request"`
+		Args    []struct {
+			ExportDir string `json:"exportDir"`
+		} `json:"args"`
+	}
+	if err := json.Unmarshal(bz, &request); err != nil {
+		return "", err
+	}
+	switch request.Request {
+	case "retrieve":
+		exportDir := fake.t.TempDir()
+		manifest := map[string]interface{}{"blockHeight": fake.exportHeight, "data": "export-data.jsonl", "artifacts": [][2]string{}}
+		if err := os.WriteFile(filepath.Join(exportDir, "export-data.jsonl"), nil, 0o644); err != nil {
+			return "", err
+		}
+		for name, data := range fake.artifacts {
+			manifest["artifacts"] = append(manifest["artifacts"].([][2]string), [2]string{name, name})
+			if err := os.WriteFile(filepath.Join(exportDir, name), []byte(data), 0o644); err != nil {
+				return "", err
+			}
+		}
+		bz, err := json.Marshal(manifest)
+		if err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filepath.Join(exportDir, keeper.ExportManifestFilename), bz, 0o644); err != nil {
+			return "", err
+		}
+		bz, err = json.Marshal(exportDir)
+		return string(bz), err
+	case "restore":
+		provider, err := keeper.OpenSwingStoreExportDirectory(request.Args[0].ExportDir)
+		if err != nil {
+			return "", err
+		}
+		fake.restored = map[string]string{}
+		for {
+			artifact, err := provider.ReadNextArtifact()
+			if err != nil {
+				break
+			}
+			fake.restored[artifact.Name] = string(artifact.Data)
+		}
+	}
+	return "", nil
+}
+
+func TestGenesisSwingStoreExportRoundTrip(t *testing.T) {
+	artifacts := map[string]string{"transcript.v1.1": "transcript"}
+	k, ctx := makeTestGenesisKeeper(t)
+	k.GetSwingStore(ctx).Set([]byte("kv.key"), []byte("value"))
+	fake := &fakeSwingStore{t: t, exportHeight: uint64(ctx.BlockHeight()), artifacts: artifacts}
+	exportsHandler := keeper.NewSwingStoreExportsHandler(log.NewNopLogger(), fake.blockingSend)
+
+	// As by agd export, the swing-store export is written beside the genesis
+	// file.
+	genesisDir := t.TempDir()
+	genesisFile := filepath.Join(genesisDir, "genesis.json")
+	exportDir := filepath.Join(genesisDir, "swing-store")
+	if err := os.Mkdir(exportDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	gs := ExportGenesis(ctx, k, exportsHandler, exportDir, SwingStoreExportModeOperational)
+	if err := ValidateGenesis(gs); err != nil {
+		t.Fatal(err)
+	}
+	if export := gs.SwingStoreExport; export == nil || export.Dir != "swing-store" || export.BlockHeight != 7 {
+		t.Fatalf("got swing-store export reference %v", export)
+	}
+
+	// A node whose configured export dir is elsewhere restores from the
+	// export referenced by the genesis file.
+	k2, ctx2 := makeTestGenesisKeeper(t)
+	restoreDir := ResolveSwingStoreExportDir(genesisFile, filepath.Join(t.TempDir(), "configured"), gs)
+	if restoreDir != exportDir {
+		t.Errorf("got restore dir %s, want %s", restoreDir, exportDir)
+	}
+	fake.restored = nil
+	if bootstrapNeeded := InitGenesis(ctx2, k2, exportsHandler, restoreDir, keeper.GenesisOverrides{}, gs); bootstrapNeeded {
+		t.Error("bootstrap needed after restoring an export")
+	}
+	if got := string(k2.GetSwingStore(ctx2).Get([]byte("kv.key"))); got != "value" {
+		t.Errorf("got restored export data %q, want value", got)
+	}
+	if got := fake.restored["transcript.v1.1"]; got != "transcript" {
+		t.Errorf("got restored artifacts %v, want %v", fake.restored, artifacts)
+	}
+
+	// A forked chain is fast forwarded by the overrides, those of the kernel
+	// being applied to the export data as it is restored.
+	k4, ctx4 := makeTestGenesisKeeper(t)
+	reset := "reset"
+	overridden := "overridden"
+	InitGenesis(ctx4, k4, exportsHandler, restoreDir, keeper.GenesisOverrides{
+		Kernel:   map[string]*string{"key": &overridden, "added": &reset},
+		Vstorage: map[string]*string{"published.priceFeed": &reset},
+	}, gs)
+	swingStore := k4.GetSwingStore(ctx4)
+	if got := string(swingStore.Get([]byte("kv.key"))); got != "overridden" {
+		t.Errorf("got overridden export data %q, want overridden", got)
+	}
+	if got := string(swingStore.Get([]byte("kv.added"))); got != "reset" {
+		t.Errorf("got added export data %q, want reset", got)
+	}
+	if got := keeper.GetVstorageKeeper(t, k4).GetEntry(ctx4, "published.priceFeed").StringValue(); got != "reset" {
+		t.Errorf("got overridden vstorage %q, want reset", got)
+	}
+
+	// A genesis whose reference does not match the export is refused.
+	tampered := *gs
+	tampered.SwingStoreExport = &types.SwingStoreExportReference{
+		Dir:          gs.SwingStoreExport.Dir,
+		BlockHeight:  gs.SwingStoreExport.BlockHeight,
+		ManifestHash: "sha256:00",
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("restored an export whose manifest hash does not match")
+			}
+		}()
+		k3, ctx3 := makeTestGenesisKeeper(t)
+		InitGenesis(ctx3, k3, exportsHandler, restoreDir, keeper.GenesisOverrides{}, &tampered)
+	}()
+}
+
+func TestInitGenesisOverridesRequireExport(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("applied overrides to a genesis that bootstraps the kernel")
+		}
+	}()
+	k, ctx := makeTestGenesisKeeper(t)
+	reset := "reset"
+	InitGenesis(ctx, k, nil, "", keeper.GenesisOverrides{
+		Vstorage: map[string]*string{"published.priceFeed": &reset},
+	}, DefaultGenesisState())
+}
+
+func TestExportGenesisDebugHeight(t *testing.T) {
+	k, ctx := makeTestGenesisKeeper(t)
+	// In debug mode, the latest export is retrieved, whatever its height.
+	fake := &fakeSwingStore{t: t, exportHeight: 5, artifacts: map[string]string{}}
+	exportsHandler := keeper.NewSwingStoreExportsHandler(log.NewNopLogger(), fake.blockingSend)
+	exportDir := t.TempDir()
+	gs := ExportGenesis(ctx, k, exportsHandler, exportDir, SwingStoreExportModeDebug)
+	if export := gs.SwingStoreExport; export == nil || export.BlockHeight != 5 {
+		t.Fatalf("got swing-store export reference %v, want blockHeight 5", export)
+	}
+	if _, height, err := keeper.HashSwingStoreExportManifest(exportDir); err != nil || height != 5 {
+		t.Errorf("got manifest blockHeight %d (%v), want 5", height, err)
+	}
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	// DefaultActionQueueLimit is the number of entries returned by
+	// GetActionQueue when no limit is requested.
+	DefaultActionQueueLimit = 100
+
+	// MaxActionQueueLimit is the largest number of entries returned by
+	// GetActionQueue.
+	MaxActionQueueLimit = 1000
+)
+
+// actionSourceFields are the action fields which may name the account or peer
+// on whose behalf it was enqueued, in order of preference.
+var actionSourceFields = []string{"owner", "peer", "submitter", "address", "sender"}
+
+// queuedRecord is the subset of an InboundQueueRecord decoded for reporting.
+type queuedRecord struct {
+	Action  map[string]json.RawMessage `json:"action"`
+	Context types.ActionContext        `json:"context"`
+}
+
+// GetActionQueue reports up to limit of the actions waiting in the inbound
+// queues, oracle pushes then high-priority ones first, as SwingSet will
+// process them, followed by those waiting in the inbound lanes.
+func (k Keeper) GetActionQueue(ctx sdk.Context, limit uint64) (*types.QueryActionQueueResponse, error) {
+	if limit == 0 {
+		limit = DefaultActionQueueLimit
+	} else if limit > MaxActionQueueLimit {
+		limit = MaxActionQueueLimit
+	}
+
+	res := &types.QueryActionQueueResponse{
+		Entries: []types.ActionQueueEntry{},
+	}
+	queuePaths := []string{StoragePathOracleQueue, StoragePathHighPriorityQueue, StoragePathActionQueue}
+	for _, lane := range types.InboundLaneNames {
+		queuePaths = append(queuePaths, inboundLanePath(lane))
+	}
+	for _, queuePath := range queuePaths {
+		length, err := k.vstorageKeeper.GetQueueLength(ctx, queuePath)
+		if err != nil {
+			return nil, err
+		}
+		if !length.IsUint64() {
+			return nil, fmt.Errorf("%s length out of range: %s", queuePath, length)
+		}
+		switch queuePath {
+		case StoragePathOracleQueue:
+			res.OracleQueueLength = length.Uint64()
+		case StoragePathHighPriorityQueue:
+			res.HighPriorityQueueLength = length.Uint64()
+		case StoragePathActionQueue:
+			res.ActionQueueLength = length.Uint64()
+		default:
+			lane := strings.TrimPrefix(queuePath, StoragePathInboundLanes+".")
+			res.InboundLaneLengths = append(res.InboundLaneLengths, types.UintMapEntry{Key: lane, Value: sdkmath.NewUint(length.Uint64())})
+		}
+
+		head, err := k.vstorageKeeper.GetIntValue(ctx, queuePath+".head")
+		if err != nil {
+			return nil, err
+		}
+		for i := sdkmath.ZeroInt(); i.LT(length) && uint64(len(res.Entries)) < limit; i = i.AddRaw(1) {
+			index := head.Add(i)
+			entry, err := k.readActionQueueEntry(ctx, queuePath, index)
+			if err != nil {
+				return nil, err
+			}
+			res.Entries = append(res.Entries, entry)
+		}
+	}
+
+	return res, nil
+}
+
+// readActionQueueEntry decodes the queue record at index of queuePath.
+func (k Keeper) readActionQueueEntry(ctx sdk.Context, queuePath string, index sdkmath.Int) (types.ActionQueueEntry, error) {
+	entry := types.ActionQueueEntry{
+		Queue: queuePath,
+		Index: index.String(),
+	}
+	path := queuePath + "." + index.String()
+	kvEntry := k.vstorageKeeper.GetEntry(ctx, path)
+	if !kvEntry.HasValue() {
+		return entry, fmt.Errorf("missing queue entry %s", path)
+	}
+
+	var record queuedRecord
+	if err := json.Unmarshal([]byte(kvEntry.StringValue()), &record); err != nil {
+		return entry, fmt.Errorf("cannot decode queue entry %s: %w", path, err)
+	}
+	entry.BlockHeight = record.Context.BlockHeight
+	entry.TxHash = record.Context.TxHash
+	if raw, ok := record.Action["type"]; ok {
+		if err := json.Unmarshal(raw, &entry.Type); err != nil {
+			return entry, fmt.Errorf("cannot decode type of queue entry %s: %w", path, err)
+		}
+	}
+	for _, field := range actionSourceFields {
+		var source string
+		if raw, ok := record.Action[field]; ok && json.Unmarshal(raw, &source) == nil && source != "" {
+			entry.Source = source
+			break
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/cosmos/ibc-go/v6/testing/simapp"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
+	swingsettesting "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/testing"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		// Our reply must be truthy or else we don't make it past AG_COSMOS_INIT.
+		return "true", nil
+	}
+	agoricApp := app.NewAgoricApp(controller, vm.NewAgdServer(), log.TestingLogger(), dbm.NewMemDB(), nil,
+		true, map[int64]bool{}, app.DefaultNodeHome, simapp.FlagPeriodValue, app.MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	return agoricApp, app.NewDefaultGenesisState()
+}
+
+// TestWalletActionAuthorizationExec executes smart wallet actions of an owner
+// by an authz MsgExec of a grantee, through the msg service router of the app,
+// and checks which of them reach the action queue.
+func TestWalletActionAuthorizationExec(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	agoricApp := chain.App.(*app.GaiaApp)
+	ctx := chain.GetContext()
+
+	_, _, owner := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	_, _, stranger := testdata.KeyTestPubAddr()
+	walletPath := keeper.StoragePathCustom + "." + keeper.WalletStoragePathSegment + "." + owner.String()
+	agoricApp.VstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(walletPath, "{}"))
+	err := agoricApp.AuthzKeeper.SaveGrant(ctx, grantee, owner, types.NewWalletActionAuthorization(false, []string{"tryExitOffer"}, nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := swingsettesting.ResetActionQueue(t, ctx, agoricApp.SwingSetKeeper); err != nil {
+		t.Fatal(err)
+	}
+
+	// exec returns the actions queued by the execution of a wallet action of
+	// the owner by executor.
+	exec := func(executor sdk.AccAddress, action string) ([]string, error) {
+		msg := authz.NewMsgExec(executor, []sdk.Msg{types.NewMsgWalletAction(owner, action)})
+		cacheCtx, writeCache := ctx.CacheContext()
+		_, err := agoricApp.MsgServiceRouter().Handler(&msg)(cacheCtx, &msg)
+		if err != nil {
+			return nil, err
+		}
+		writeCache()
+		records, err := swingsettesting.GetActionQueueRecords(t, ctx, agoricApp.SwingSetKeeper)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := swingsettesting.ResetActionQueue(t, ctx, agoricApp.SwingSetKeeper); err != nil {
+			t.Fatal(err)
+		}
+		return records, nil
+	}
+
+	exit := `{"body":"#{\"method\":\"tryExitOffer\",\"offerId\":\"1\"}","slots":[]}`
+	records, err := exec(grantee, exit)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got records %v, want one", records)
+	}
+	var record struct {
+		Action struct {
+			Type   string `json:"type"`
+			Owner  string `json:"owner"`
+			Action string `json:"action"`
+		} `json:"action"`
+	}
+	if err := json.Unmarshal([]byte(records[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.Action.Type != "WALLET_ACTION" || record.Action.Owner != owner.String() || record.Action.Action != exit {
+		t.Errorf("got action %+v, want the WALLET_ACTION of %s", record.Action, owner)
+	}
+
+	// An offer disguised by a key that differs only by case from "method" is
+	// refused, as is any action of a grantee without a grant.
+	disguised := `{"body":"#{\"method\":\"executeOffer\",\"METHOD\":\"tryExitOffer\",\"offer\":{\"id\":\"2\",\"invitationSpec\":{\"source\":\"purse\"},\"proposal\":{}}}","slots":[]}`
+	if records, err := exec(grantee, disguised); err == nil {
+		t.Errorf("got records %v for a disguised offer, want error", records)
+	}
+	if records, err := exec(stranger, exit); err == nil {
+		t.Errorf("got records %v without a grant, want error", records)
+	}
+	records, err = swingsettesting.GetActionQueueRecords(t, ctx, agoricApp.SwingSetKeeper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Errorf("got records %v after refused actions, want none", records)
+	}
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	autoProvisionKeyPrefix       = "autoProvision."
+	autoProvisionExpiryKeyPrefix = "autoProvisionExpiry."
+
+	// AutoProvisionExpiryBlocks is the number of blocks after which the record
+	// of an automatic provisioning is forgotten, by when SwingSet has long
+	// since run it.  A later qualifying deposit to an address still without a
+	// smart wallet then enqueues its provisioning again.
+	AutoProvisionExpiryBlocks int64 = 1000
+)
+
+// autoProvisionExpiryKey orders the expiry index by height so that expired
+// records can be found with a bounded iteration.
+func autoProvisionExpiryKey(expiryHeight int64, addr sdk.AccAddress) []byte {
+	key := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(addr)), uint64(expiryHeight))
+	return append(key, addr...)
+}
+
+func (k Keeper) getAutoProvisionStore(ctx sdk.Context, keyPrefix string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(keyPrefix))
+}
+
+// SetAutoProvision stores the record of an automatic provisioning enqueued on
+// deposit, as imported from genesis, along with its expiry.
+func (k Keeper) SetAutoProvision(ctx sdk.Context, record types.AutoProvisionRecord) {
+	addr := sdk.MustAccAddressFromBech32(record.Address)
+	k.deleteAutoProvision(ctx, addr)
+	k.getAutoProvisionStore(ctx, autoProvisionKeyPrefix).Set(addr, sdk.Uint64ToBigEndian(uint64(record.Height)))
+	expiryStore := k.getAutoProvisionStore(ctx, autoProvisionExpiryKeyPrefix)
+	expiryStore.Set(autoProvisionExpiryKey(record.Height+AutoProvisionExpiryBlocks, addr), []byte{})
+}
+
+// hasAutoProvision reports whether an automatic provisioning of addr has
+// been enqueued on deposit.
+func (k Keeper) hasAutoProvision(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.getAutoProvisionStore(ctx, autoProvisionKeyPrefix).Has(addr)
+}
+
+// deleteAutoProvision forgets the automatic provisioning of addr, whose smart
+// wallet has been provisioned.
+func (k Keeper) deleteAutoProvision(ctx sdk.Context, addr sdk.AccAddress) {
+	store := k.getAutoProvisionStore(ctx, autoProvisionKeyPrefix)
+	bz := store.Get(addr)
+	if bz == nil {
+		return
+	}
+	expiryHeight := int64(sdk.BigEndianToUint64(bz)) + AutoProvisionExpiryBlocks
+	k.getAutoProvisionStore(ctx, autoProvisionExpiryKeyPrefix).Delete(autoProvisionExpiryKey(expiryHeight, addr))
+	store.Delete(addr)
+}
+
+// PruneExpiredAutoProvisions forgets the automatic provisionings enqueued
+// AutoProvisionExpiryBlocks ago or more.
+func (k Keeper) PruneExpiredAutoProvisions(ctx sdk.Context) {
+	store := k.getAutoProvisionStore(ctx, autoProvisionKeyPrefix)
+	expiryStore := k.getAutoProvisionStore(ctx, autoProvisionExpiryKeyPrefix)
+
+	end := autoProvisionExpiryKey(ctx.BlockHeight()+1, nil)
+	iter := expiryStore.Iterator(nil, end)
+	var expiredKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	for _, expiryKey := range expiredKeys {
+		store.Delete(expiryKey[8:])
+		expiryStore.Delete(expiryKey)
+	}
+}
+
+// GetAutoProvisions returns the records of the automatic provisionings
+// enqueued on deposit, for export.
+func (k Keeper) GetAutoProvisions(ctx sdk.Context) []types.AutoProvisionRecord {
+	iterator := k.getAutoProvisionStore(ctx, autoProvisionKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.AutoProvisionRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.AutoProvisionRecord{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Height:  int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	return records
+}
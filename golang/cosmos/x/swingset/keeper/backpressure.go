@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const policyHeadroomKey = "policyHeadroom"
+
+// GetPolicyHeadroom returns the run-policy headroom, in beans, that SwingSet
+// reported at the end of the latest block, and whether it reported one.
+func (k Keeper) GetPolicyHeadroom(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(policyHeadroomKey))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// UpdatePolicyHeadroom records the run-policy headroom of the current block,
+// given SwingSet's reply to END_BLOCK. A reply without a headroom (such as
+// from an older SwingSet, or for a block run without a compute limit) clears
+// it.
+func (k Keeper) UpdatePolicyHeadroom(ctx sdk.Context, reply string) {
+	store := ctx.KVStore(k.storeKey)
+	var parsed endBlockReply
+	if err := json.Unmarshal([]byte(reply), &parsed); err != nil || parsed.PolicyHeadroom == nil {
+		store.Delete([]byte(policyHeadroomKey))
+		return
+	}
+	headroom, err := strconv.ParseUint(*parsed.PolicyHeadroom, 10, 64)
+	if err != nil {
+		store.Delete([]byte(policyHeadroomKey))
+		return
+	}
+	store.Set([]byte(policyHeadroomKey), sdk.Uint64ToBigEndian(headroom))
+}
+
+// SetPolicyHeadroom stores the run-policy headroom of the latest block, as
+// imported from genesis, or clears it if found is false.
+func (k Keeper) SetPolicyHeadroom(ctx sdk.Context, headroom uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !found {
+		store.Delete([]byte(policyHeadroomKey))
+		return
+	}
+	store.Set([]byte(policyHeadroomKey), sdk.Uint64ToBigEndian(headroom))
+}
+
+// IsInboundBackpressured returns whether SwingSet was too busy in the latest
+// block, per the min_run_policy_headroom param, to accept new inbound actions.
+func (k Keeper) IsInboundBackpressured(ctx sdk.Context) bool {
+	threshold := k.GetParams(ctx).MinRunPolicyHeadroom
+	if threshold == 0 {
+		return false
+	}
+	headroom, found := k.GetPolicyHeadroom(ctx)
+	return found && headroom < threshold
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const blockEntropyKey = "blockEntropy"
+
+// nextBlockEntropy returns the entropy of a block, chaining the entropy of the
+// previous block with the hash and height of this one.  Since no VRF is
+// involved, the proposer of a block can influence its entropy by varying the
+// block's contents, so it is only suitable for tie-breaking.
+func nextBlockEntropy(prev, headerHash []byte, height int64) []byte {
+	hasher := sha256.New()
+	hasher.Write(prev)
+	hasher.Write(headerHash)
+	hasher.Write(sdk.Uint64ToBigEndian(uint64(height)))
+	return hasher.Sum(nil)
+}
+
+// UpdateBlockEntropy derives the entropy of the current block at its
+// beginning, unless the block_entropy_disabled param is set.
+func (k Keeper) UpdateBlockEntropy(ctx sdk.Context) {
+	if k.GetParams(ctx).BlockEntropyDisabled {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	prev := store.Get([]byte(blockEntropyKey))
+	store.Set([]byte(blockEntropyKey), nextBlockEntropy(prev, ctx.HeaderHash(), ctx.BlockHeight()))
+}
+
+// GetBlockEntropy returns the entropy of the current block, and whether it is
+// offered, which it is not while the block_entropy_disabled param is set.
+func (k Keeper) GetBlockEntropy(ctx sdk.Context) ([]byte, bool) {
+	if k.GetParams(ctx).BlockEntropyDisabled {
+		return nil, false
+	}
+	entropy := ctx.KVStore(k.storeKey).Get([]byte(blockEntropyKey))
+	return entropy, entropy != nil
+}
+
+// GetLatestBlockEntropy returns the entropy last derived, regardless of the
+// block_entropy_disabled param, for export in genesis.
+func (k Keeper) GetLatestBlockEntropy(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get([]byte(blockEntropyKey))
+}
+
+// SetLatestBlockEntropy stores the entropy from which that of the next block
+// is chained, as imported from genesis.
+func (k Keeper) SetLatestBlockEntropy(ctx sdk.Context, entropy []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if len(entropy) == 0 {
+		store.Delete([]byte(blockEntropyKey))
+		return
+	}
+	store.Set([]byte(blockEntropyKey), entropy)
+}
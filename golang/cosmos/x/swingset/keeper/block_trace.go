@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// WithBlockTracer returns a copy of the keeper that traces each block with
+// blockTracer.
+func (k Keeper) WithBlockTracer(blockTracer *vm.BlockTracer) Keeper {
+	k.blockTracer = blockTracer
+	return k
+}
+
+// StartBlockTrace starts the trace span of the block, which parents those of
+// its bridge messages.
+func (k Keeper) StartBlockTrace(ctx sdk.Context) {
+	if k.blockTracer != nil {
+		k.blockTracer.StartBlock(ctx.BlockHeight())
+	}
+}
+
+// EndBlockTrace ends the trace span of the block, once SwingSet has finished
+// with it.
+func (k Keeper) EndBlockTrace() {
+	if k.blockTracer != nil {
+		k.blockTracer.EndBlock()
+	}
+}
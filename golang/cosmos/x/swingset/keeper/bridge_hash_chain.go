@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+const bridgeMessageDigestKey = "bridgeMessageDigest"
+
+// WithBridgeHashChain returns a copy of the keeper that digests the bridge
+// messages recorded by hashChain, when the bridge_message_hash_chain param is
+// enabled.
+func (k Keeper) WithBridgeHashChain(hashChain *vm.HashChain) Keeper {
+	k.bridgeHashChain = hashChain
+	return k
+}
+
+// BeginBridgeMessageHashChain starts recording the bridge messages of the
+// block, chaining from the digest of the last block to record them.
+func (k Keeper) BeginBridgeMessageHashChain(ctx sdk.Context) {
+	if k.bridgeHashChain == nil || !k.GetParams(ctx).BridgeMessageHashChain {
+		return
+	}
+	k.bridgeHashChain.Begin(k.GetBridgeMessageDigest(ctx))
+}
+
+// EndBridgeMessageHashChain stops recording the bridge messages of the block,
+// storing their digest if they were being recorded.
+func (k Keeper) EndBridgeMessageHashChain(ctx sdk.Context) {
+	if k.bridgeHashChain == nil {
+		return
+	}
+	digest := k.bridgeHashChain.End()
+	if digest == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(bridgeMessageDigestKey), digest)
+}
+
+// GetBridgeMessageDigest returns the digest of the bridge messages of every
+// block recorded so far, or nil if none has been.
+func (k Keeper) GetBridgeMessageDigest(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get([]byte(bridgeMessageDigestKey))
+}
+
+// SetBridgeMessageDigest stores the digest from which that of the next block's
+// bridge messages is chained, as imported from genesis.
+func (k Keeper) SetBridgeMessageDigest(ctx sdk.Context, digest []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if len(digest) == 0 {
+		store.Delete([]byte(bridgeMessageDigestKey))
+		return
+	}
+	store.Set([]byte(bridgeMessageDigestKey), digest)
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	vatBundleKeyPrefix      = "vatBundle."
+	bundleRefCountKeyPrefix = "bundleRefCount."
+)
+
+type pruneBundlesAction struct {
+	*vm.ActionHeader `actionType:"PRUNE_BUNDLES"`
+	BundleIDs        []string `json:"bundleIDs"`
+}
+
+func (k Keeper) getVatBundleStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(vatBundleKeyPrefix))
+}
+
+func (k Keeper) getBundleRefCountStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(bundleRefCountKeyPrefix))
+}
+
+// GetVatBundle returns the endoZipBase64Sha512 hash of the bundle that vatID
+// was created (or last upgraded) from, and whether one is known.
+func (k Keeper) GetVatBundle(ctx sdk.Context, vatID string) (string, bool) {
+	bz := k.getVatBundleStore(ctx).Get([]byte(vatID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetBundleReferenceCount returns the number of vats created from the bundle
+// with the given endoZipBase64Sha512 hash.
+func (k Keeper) GetBundleReferenceCount(ctx sdk.Context, bundleHash string) uint64 {
+	bz := k.getBundleRefCountStore(ctx).Get([]byte(bundleHash))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) addBundleReference(ctx sdk.Context, bundleHash string, delta int) {
+	store := k.getBundleRefCountStore(ctx)
+	count := k.GetBundleReferenceCount(ctx, bundleHash)
+	switch {
+	case delta > 0:
+		count++
+	case count > 0:
+		count--
+	}
+	if count == 0 {
+		store.Delete([]byte(bundleHash))
+		return
+	}
+	store.Set([]byte(bundleHash), sdk.Uint64ToBigEndian(count))
+}
+
+// setVatBundle records the bundle that vatID is running, or its termination
+// if bundleHash is empty, adjusting the bundles' reference counts.
+func (k Keeper) setVatBundle(ctx sdk.Context, vatID, bundleHash string) {
+	oldHash, _ := k.GetVatBundle(ctx, vatID)
+	if oldHash == bundleHash {
+		return
+	}
+	if oldHash != "" {
+		k.addBundleReference(ctx, oldHash, -1)
+	}
+	store := k.getVatBundleStore(ctx)
+	if bundleHash == "" {
+		store.Delete([]byte(vatID))
+		return
+	}
+	store.Set([]byte(vatID), []byte(bundleHash))
+	k.addBundleReference(ctx, bundleHash, 1)
+}
+
+// UpdateBundleReferences records the bundles of the vats, given SwingSet's
+// reply to END_BLOCK, which maps the ID of each live vat to the ID of the
+// bundle it now runs.  Vats that are no longer reported are gone.  Only bundles
+// identified by their hash are counted.  Malformed reports are ignored, since
+// they must not halt the chain.
+func (k Keeper) UpdateBundleReferences(ctx sdk.Context, reply string) {
+	var parsed endBlockReply
+	if err := json.Unmarshal([]byte(reply), &parsed); err != nil || parsed.VatBundleIDs == nil {
+		return
+	}
+
+	bundleHashes := make(map[string]string, len(parsed.VatBundleIDs))
+	for vatID, bundleID := range parsed.VatBundleIDs {
+		if types.ValidateVatID(vatID) != nil {
+			continue
+		}
+		if bundleHash, ok := strings.CutPrefix(bundleID, types.BundleIDPrefix); ok && bundleHash != "" {
+			bundleHashes[vatID] = bundleHash
+		}
+	}
+
+	// Forget the vats that are gone, collecting them before deletion from the
+	// store being iterated.
+	gone := []string{}
+	iterator := k.getVatBundleStore(ctx).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if vatID := string(iterator.Key()); bundleHashes[vatID] == "" {
+			gone = append(gone, vatID)
+		}
+	}
+	iterator.Close()
+	for _, vatID := range gone {
+		k.setVatBundle(ctx, vatID, "")
+	}
+
+	// Iterate in a deterministic order.
+	vatIDs := make([]string, 0, len(bundleHashes))
+	for vatID := range bundleHashes {
+		vatIDs = append(vatIDs, vatID)
+	}
+	sort.Strings(vatIDs)
+	for _, vatID := range vatIDs {
+		k.setVatBundle(ctx, vatID, bundleHashes[vatID])
+	}
+}
+
+// PruneBundles asks SwingSet to remove the bundles with the given
+// endoZipBase64Sha512 hashes, each of which must have been installed at least
+// bundle_prune_min_age_blocks ago and not be running in any vat.  SwingSet
+// keeps any that it still needs, such as those of Zoe installations.
+func (k Keeper) PruneBundles(ctx sdk.Context, bundleHashes []string) error {
+	minAge := k.GetParams(ctx).BundlePruneMinAgeBlocks
+	if minAge == 0 {
+		return fmt.Errorf("bundle pruning is disabled")
+	}
+	bundleIDs := make([]string, len(bundleHashes))
+	for i, bundleHash := range bundleHashes {
+		installation, found := k.GetBundleInstallation(ctx, bundleHash)
+		if !found || installation.Status != types.BundleStatusInstalled {
+			return fmt.Errorf("bundle %s is not installed", bundleHash)
+		}
+		if age := ctx.BlockHeight() - installation.Height; age < int64(minAge) {
+			return fmt.Errorf("bundle %s was installed %d blocks ago, fewer than %d", bundleHash, age, minAge)
+		}
+		if refs := k.GetBundleReferenceCount(ctx, bundleHash); refs > 0 {
+			return fmt.Errorf("bundle %s is referenced by %d vats", bundleHash, refs)
+		}
+		bundleIDs[i] = types.BundleIDPrefix + bundleHash
+	}
+	return k.PushAction(ctx, pruneBundlesAction{BundleIDs: bundleIDs})
+}
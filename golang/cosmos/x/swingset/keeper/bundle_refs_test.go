@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func TestPruneBundles(t *testing.T) {
+	params := types.DefaultParams()
+	params.BundlePruneMinAgeBlocks = 0
+	k, ctx := makeTestParamsKeeper(t, params)
+	hashA, hashB, hashC := strings.Repeat("a", 128), strings.Repeat("b", 128), strings.Repeat("c", 128)
+	if err := k.PruneBundles(ctx, []string{hashA}); err == nil {
+		t.Error("pruned bundles while pruning is disabled")
+	}
+
+	params.BundlePruneMinAgeBlocks = 5
+	k.SetParams(ctx, params)
+	k.setBundleInstallation(ctx, hashA, types.BundleInstallation{Status: types.BundleStatusInstalled})
+	k.setBundleInstallation(ctx, hashB, types.BundleInstallation{Status: types.BundleStatusInstalled})
+	k.setBundleInstallation(ctx, hashC, types.BundleInstallation{Status: types.BundleStatusRejected})
+	k.UpdateBundleReferences(ctx, `{"vatBundleIDs":{"v1":"b1-`+hashB+`"}}`)
+	if err := k.PruneBundles(ctx, []string{hashA}); err == nil {
+		t.Error("pruned a bundle installed too recently")
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	for _, tt := range []struct {
+		name   string
+		hashes []string
+	}{
+		{"running bundle", []string{hashA, hashB}},
+		{"rejected bundle", []string{hashC}},
+		{"unknown bundle", []string{strings.Repeat("d", 128)}},
+	} {
+		if err := k.PruneBundles(ctx, tt.hashes); err == nil {
+			t.Errorf("pruned a %s", tt.name)
+		}
+	}
+	if err := k.PruneBundles(ctx, []string{hashA}); err != nil {
+		t.Fatal(err)
+	}
+	length, err := k.vstorageKeeper.GetQueueLength(ctx, StoragePathActionQueue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if length.Int64() != 1 {
+		t.Errorf("got %d queued actions, want 1", length.Int64())
+	}
+
+	// Once its vat is gone, a bundle may be pruned.
+	k.UpdateBundleReferences(ctx, `{"vatBundleIDs":{}}`)
+	if err := k.PruneBundles(ctx, []string{hashB}); err != nil {
+		t.Error(err)
+	}
+}
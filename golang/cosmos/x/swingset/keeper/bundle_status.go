@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+)
+
+const bundleStatusKeyPrefix = "bundleStatus."
+
+// installationResult is the record that `installBundle` in
+// packages/cosmic-swingset/src/launch-chain.js publishes to StoragePathBundles
+// for each INSTALL_BUNDLE action.
+type installationResult struct {
+	EndoZipBase64Sha512 string `json:"endoZipBase64Sha512"`
+	Installed           bool   `json:"installed"`
+	// A smallcaps-encoded Error, or null.
+	Error *struct {
+		Message string `json:"#error"`
+	} `json:"error"`
+}
+
+func (k Keeper) getBundleStatusStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(bundleStatusKeyPrefix))
+}
+
+// GetBundleInstallation returns the installation progress of the bundle with
+// the given endoZipBase64Sha512 hash, and whether any is known.
+func (k Keeper) GetBundleInstallation(ctx sdk.Context, bundleHash string) (types.BundleInstallation, bool) {
+	var installation types.BundleInstallation
+	bz := k.getBundleStatusStore(ctx).Get([]byte(bundleHash))
+	if bz == nil {
+		return installation, false
+	}
+	k.cdc.MustUnmarshal(bz, &installation)
+	return installation, true
+}
+
+// GetBundleInstallations returns the installation progress of every bundle,
+// as exported in genesis.
+func (k Keeper) GetBundleInstallations(ctx sdk.Context) []types.BundleInstallationRecord {
+	iterator := k.getBundleStatusStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.BundleInstallationRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.BundleInstallationRecord{BundleHash: string(iterator.Key())}
+		k.cdc.MustUnmarshal(iterator.Value(), &record.Installation)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetBundleInstallation stores the installation progress of a bundle, as
+// imported from genesis.  Its height is kept, so that the age of an installed
+// bundle carries over.
+func (k Keeper) SetBundleInstallation(ctx sdk.Context, record types.BundleInstallationRecord) {
+	bz := k.cdc.MustMarshal(&record.Installation)
+	k.getBundleStatusStore(ctx).Set([]byte(record.BundleHash), bz)
+}
+
+func (k Keeper) setBundleInstallation(ctx sdk.Context, bundleHash string, installation types.BundleInstallation) {
+	installation.Height = ctx.BlockHeight()
+	k.SetBundleInstallation(ctx, types.BundleInstallationRecord{BundleHash: bundleHash, Installation: installation})
+}
+
+// setBundlePending marks a bundle which has just been queued for installation
+// as pending.  Bundles whose hash cannot be determined are ignored, since
+// SwingSet will drop them without reporting a result.
+func (k Keeper) setBundlePending(ctx sdk.Context, bundleJson string) {
+	bundleHash := parseBundleHash(bundleJson)
+	if bundleHash == "" {
+		return
+	}
+	k.setBundleInstallation(ctx, bundleHash, types.BundleInstallation{
+		Status: types.BundleStatusPending,
+	})
+}
+
+// parseBundleHash returns the endoZipBase64Sha512 hash of a JSON bundle, or
+// the empty string if it has none.
+func parseBundleHash(bundleJson string) string {
+	return parseBundleStrings(bundleJson, "endoZipBase64Sha512")["endoZipBase64Sha512"]
+}
+
+// parseBundleStrings returns the string values of the wantKeys of the
+// top-level object of a JSON bundle, or none if it is malformed.  Only the
+// keys of the top-level object are decoded, so that the (typically huge)
+// values of the others are merely skipped over.  As with JSON.parse, keys
+// match exactly and the last of duplicate keys wins.
+func parseBundleStrings(bundleJson string, wantKeys ...string) map[string]string {
+	values := map[string]string{}
+	depth := 0
+	for i := 0; i < len(bundleJson); i++ {
+		switch bundleJson[i] {
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		case '"':
+			end := endOfJSONString(bundleJson, i)
+			if end < 0 {
+				return map[string]string{}
+			}
+			if depth == 1 {
+				for _, wantKey := range wantKeys {
+					if value, ok := parseStringEntry(bundleJson, i, end, wantKey); ok {
+						values[wantKey] = value
+					}
+				}
+			}
+			i = end - 1
+		}
+	}
+	return values
+}
+
+// endOfJSONString returns the index just past the JSON string starting at
+// start, or -1 if it is unterminated.
+func endOfJSONString(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// parseStringEntry decodes the string value following the object key
+// s[start:end], reporting false if s[start:end] is not the key wantKey or its
+// value is not a string.
+func parseStringEntry(s string, start, end int, wantKey string) (string, bool) {
+	rest := strings.TrimLeft(s[end:], " \t\r\n")
+	if !strings.HasPrefix(rest, ":") {
+		return "", false
+	}
+	var key, value string
+	if err := json.Unmarshal([]byte(s[start:end]), &key); err != nil || key != wantKey {
+		return "", false
+	}
+	rest = strings.TrimLeft(rest[1:], " \t\r\n")
+	if !strings.HasPrefix(rest, `"`) {
+		return "", false
+	}
+	valueEnd := endOfJSONString(rest, 0)
+	if valueEnd < 0 {
+		return "", false
+	}
+	if err := json.Unmarshal([]byte(rest[:valueEnd]), &value); err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// parseInstallationResult decodes a smallcaps CapData value published to
+// StoragePathBundles.
+func parseInstallationResult(capDataJson string) (installationResult, error) {
+	var result installationResult
+	var capData struct {
+		Body string `json:"body"`
+	}
+	if err := json.Unmarshal([]byte(capDataJson), &capData); err != nil {
+		return result, err
+	}
+	body, ok := strings.CutPrefix(capData.Body, "#")
+	if !ok {
+		return result, fmt.Errorf("installation result body %q is not smallcaps", capData.Body)
+	}
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// UpdateBundleInstallations records the installation results that SwingSet
+// published during the current block.  Results that cannot be parsed are
+// logged and skipped, since they must not halt the chain.
+func (k Keeper) UpdateBundleInstallations(ctx sdk.Context) error {
+	entry := k.vstorageKeeper.GetEntry(ctx, StoragePathBundles)
+	if !entry.HasValue() {
+		return nil
+	}
+	var cell vstoragekeeper.StreamCell
+	if err := json.Unmarshal([]byte(entry.StringValue()), &cell); err != nil {
+		k.Logger(ctx).Error("invalid bundle installation results", "path", StoragePathBundles, "err", err)
+		return nil
+	}
+	if cell.BlockHeight != strconv.FormatInt(ctx.BlockHeight(), 10) {
+		return nil
+	}
+
+	results := make([]installationResult, 0, len(cell.Values))
+	installed := map[string]bool{}
+	for _, value := range cell.Values {
+		result, err := parseInstallationResult(value)
+		if err != nil {
+			k.Logger(ctx).Error("invalid bundle installation result", "value", value, "err", err)
+			continue
+		}
+		if result.EndoZipBase64Sha512 == "" {
+			continue
+		}
+		results = append(results, result)
+		if result.Installed {
+			installed[result.EndoZipBase64Sha512] = true
+		}
+	}
+
+	for _, result := range results {
+		bundleHash := result.EndoZipBase64Sha512
+		installation := types.BundleInstallation{Status: types.BundleStatusInstalled}
+		if !result.Installed {
+			// A rejected bundle may merely claim the hash of one that is
+			// installed, which keeps its status and deposit.
+			if installed[bundleHash] || k.hasSwingStoreBundle(ctx, bundleHash) {
+				continue
+			}
+			installation.Status = types.BundleStatusRejected
+			if result.Error != nil {
+				installation.Error = result.Error.Message
+			}
+			k.refundBundleStorageDeposit(ctx, bundleHash)
+		}
+		k.setBundleInstallation(ctx, bundleHash, installation)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const bundleDepositKeyPrefix = "bundleDeposit."
+
+// swingStoreBundleKeyPrefix is the prefix of the swing-store export data keys
+// of the bundles installed by hash, per bundleArtifactName in
+// packages/swing-store/src/bundleStore.js.
+const swingStoreBundleKeyPrefix = "bundle." + types.BundleIDPrefix
+
+func (k Keeper) getBundleDepositStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(bundleDepositKeyPrefix))
+}
+
+// GetBundleStorageDeposit returns the refundable storage fee paid for the
+// bundle with the given endoZipBase64Sha512 hash, and whether any is held.
+func (k Keeper) GetBundleStorageDeposit(ctx sdk.Context, bundleHash string) (types.BundleStorageDeposit, bool) {
+	var deposit types.BundleStorageDeposit
+	bz := k.getBundleDepositStore(ctx).Get([]byte(bundleHash))
+	if bz == nil {
+		return deposit, false
+	}
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// GetBundleStorageDeposits returns the bundle storage deposits held, for
+// export in genesis.
+func (k Keeper) GetBundleStorageDeposits(ctx sdk.Context) []types.BundleStorageDepositRecord {
+	iterator := k.getBundleDepositStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.BundleStorageDepositRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.BundleStorageDepositRecord{BundleHash: string(iterator.Key())}
+		k.cdc.MustUnmarshal(iterator.Value(), &record.Deposit)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetBundleStorageDeposit stores a bundle storage deposit, as imported from
+// genesis.
+func (k Keeper) SetBundleStorageDeposit(ctx sdk.Context, record types.BundleStorageDepositRecord) {
+	bz := k.cdc.MustMarshal(&record.Deposit)
+	k.getBundleDepositStore(ctx).Set([]byte(record.BundleHash), bz)
+}
+
+// hasSwingStoreBundle returns whether the bundle with the given
+// endoZipBase64Sha512 hash is in the swing-store export data, meaning that
+// SwingSet holds it.
+func (k Keeper) hasSwingStoreBundle(ctx sdk.Context, bundleHash string) bool {
+	return k.GetSwingStore(ctx).Has([]byte(swingStoreBundleKeyPrefix + bundleHash))
+}
+
+// verifyBundleHash returns the endoZipBase64Sha512 hash of a JSON bundle, or
+// the empty string if it has none, and whether it is the hash of the bundle's
+// endoZipBase64 content, as SwingSet requires of the bundles it installs.
+func verifyBundleHash(bundleJson string) (string, bool) {
+	entries := parseBundleStrings(bundleJson, "moduleFormat", "endoZipBase64", "endoZipBase64Sha512")
+	bundleHash := entries["endoZipBase64Sha512"]
+	if bundleHash == "" || entries["moduleFormat"] != "endoZipBase64" {
+		return bundleHash, false
+	}
+	zip, err := base64.StdEncoding.DecodeString(entries["endoZipBase64"])
+	if err != nil {
+		return bundleHash, false
+	}
+	sum := sha512.Sum512(zip)
+	return bundleHash, hex.EncodeToString(sum[:]) == bundleHash
+}
+
+// ChargeBundleStorage charges submitter the storage fee of an uncompressed
+// JSON bundle, holding the refundable part as a deposit until the bundle is
+// rejected or garbage-collected.  Nothing is charged for a bundle without a
+// hash, which SwingSet drops, nor for one whose content is already stored or
+// paid for.  A bundle whose hash does not match its content, which SwingSet
+// rejects, is charged the whole fee without a deposit, since its hash may be
+// that of another bundle.
+func (k Keeper) ChargeBundleStorage(ctx sdk.Context, submitter sdk.AccAddress, bundleJson string) error {
+	bundleHash, verified := verifyBundleHash(bundleJson)
+	if bundleHash == "" {
+		return nil
+	}
+	deposit, fee := k.GetParams(ctx).BundleStorageFee(uint64(len(bundleJson)))
+	if !verified {
+		fee = fee.Add(deposit...)
+		if fee.IsZero() {
+			return nil
+		}
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fee)
+	}
+	if _, found := k.GetBundleStorageDeposit(ctx, bundleHash); found || k.hasSwingStoreBundle(ctx, bundleHash) {
+		return nil
+	}
+
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fee); err != nil {
+			return err
+		}
+	}
+	if deposit.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, types.BundleStoragePoolName, deposit); err != nil {
+		return err
+	}
+	k.SetBundleStorageDeposit(ctx, types.BundleStorageDepositRecord{
+		BundleHash: bundleHash,
+		Deposit: types.BundleStorageDeposit{
+			Submitter: submitter.String(),
+			Deposit:   deposit,
+		},
+	})
+	return nil
+}
+
+// RefundBundleStorageDeposit returns the deposit held for the bundle with the
+// given endoZipBase64Sha512 hash, if any, to its submitter, unless SwingSet
+// still holds the bundle.
+func (k Keeper) RefundBundleStorageDeposit(ctx sdk.Context, bundleHash string) error {
+	deposit, found := k.GetBundleStorageDeposit(ctx, bundleHash)
+	if !found || k.hasSwingStoreBundle(ctx, bundleHash) {
+		return nil
+	}
+	submitter, err := sdk.AccAddressFromBech32(deposit.Submitter)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.BundleStoragePoolName, submitter, deposit.Deposit); err != nil {
+		return err
+	}
+	k.getBundleDepositStore(ctx).Delete([]byte(bundleHash))
+	return nil
+}
+
+// refundBundleStorageDeposit refunds the deposit held for a bundle, logging
+// rather than returning any error, which must not halt the chain.
+func (k Keeper) refundBundleStorageDeposit(ctx sdk.Context, bundleHash string) {
+	if err := k.RefundBundleStorageDeposit(ctx, bundleHash); err != nil {
+		k.Logger(ctx).Error("cannot refund bundle storage deposit", "bundleHash", bundleHash, "err", err)
+	}
+}
+
+// NoteSwingStoreExportDataDeletion forgets the installation of a bundle whose
+// swing-store export data entry has been deleted, meaning SwingSet
+// garbage-collected the bundle, and refunds its storage deposit.
+func (k Keeper) NoteSwingStoreExportDataDeletion(ctx sdk.Context, key string) {
+	bundleHash, ok := strings.CutPrefix(key, swingStoreBundleKeyPrefix)
+	if !ok {
+		return
+	}
+	k.getBundleStatusStore(ctx).Delete([]byte(bundleHash))
+	k.refundBundleStorageDeposit(ctx, bundleHash)
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+)
+
+type mockBankKeeper struct {
+	bankkeeper.Keeper
+	sent      []string
+	refundErr error
+}
+
+func (mb *mockBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	mb.sent = append(mb.sent, fmt.Sprintf("%s %s %s", senderAddr, recipientModule, amt))
+	return nil
+}
+
+func (mb *mockBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if mb.refundErr != nil {
+		return mb.refundErr
+	}
+	mb.sent = append(mb.sent, fmt.Sprintf("%s %s %s", senderModule, recipientAddr, amt))
+	return nil
+}
+
+// makeTestBundle returns a JSON bundle of the given zip content, and its
+// endoZipBase64Sha512 hash.
+func makeTestBundle(t *testing.T, zip string) (string, string) {
+	t.Helper()
+	sum := sha512.Sum512([]byte(zip))
+	bundleHash := hex.EncodeToString(sum[:])
+	bz, err := json.Marshal(map[string]string{
+		"moduleFormat":        "endoZipBase64",
+		"endoZipBase64":       base64.StdEncoding.EncodeToString([]byte(zip)),
+		"endoZipBase64Sha512": bundleHash,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bz), bundleHash
+}
+
+func Test_verifyBundleHash(t *testing.T) {
+	bundle, bundleHash := makeTestBundle(t, "zip")
+	if got, verified := verifyBundleHash(bundle); got != bundleHash || !verified {
+		t.Errorf("got %q, %v for a valid bundle", got, verified)
+	}
+
+	_, otherHash := makeTestBundle(t, "other zip")
+	for _, tt := range []struct {
+		name     string
+		bundle   string
+		wantHash string
+	}{
+		{"hash of other content", `{"moduleFormat":"endoZipBase64","endoZipBase64":"emlw","endoZipBase64Sha512":"` + otherHash + `"}`, otherHash},
+		{"other module format", `{"moduleFormat":"nestedEvaluate","endoZipBase64":"emlw","endoZipBase64Sha512":"` + bundleHash + `"}`, bundleHash},
+		{"invalid base64", `{"moduleFormat":"endoZipBase64","endoZipBase64":"!","endoZipBase64Sha512":"` + bundleHash + `"}`, bundleHash},
+		{"nested content", `{"moduleFormat":"endoZipBase64","x":{"endoZipBase64":"emlw"},"endoZipBase64Sha512":"` + bundleHash + `"}`, bundleHash},
+		{"no hash", `{"moduleFormat":"endoZipBase64","endoZipBase64":"emlw"}`, ""},
+	} {
+		if got, verified := verifyBundleHash(tt.bundle); got != tt.wantHash || verified {
+			t.Errorf("%s: got %q, %v, want %q, false", tt.name, got, verified, tt.wantHash)
+		}
+	}
+}
+
+func TestChargeAndRefundBundleStorage(t *testing.T) {
+	params := types.DefaultParams()
+	params.BundleStorageFeePerByte = sdk.NewDecCoins(sdk.NewInt64DecCoin("ubld", 10))
+	params.BundleStorageRefundFraction = sdk.NewDecWithPrec(8, 1)
+	k, ctx := makeTestParamsKeeper(t, params)
+	bank := &mockBankKeeper{}
+	k.bankKeeper = bank
+	k.feeCollectorName = "feeCollector"
+	submitter := sdk.AccAddress([]byte("submitter"))
+
+	bundle, bundleHash := makeTestBundle(t, "zip")
+	deposit, fee := params.BundleStorageFee(uint64(len(bundle)))
+	if err := k.ChargeBundleStorage(ctx, submitter, bundle); err != nil {
+		t.Fatal(err)
+	}
+	wantSent := []string{
+		fmt.Sprintf("%s feeCollector %s", submitter, fee),
+		fmt.Sprintf("%s %s %s", submitter, types.BundleStoragePoolName, deposit),
+	}
+	if !reflect.DeepEqual(bank.sent, wantSent) {
+		t.Errorf("got sent %q, want %q", bank.sent, wantSent)
+	}
+	wantDeposit := types.BundleStorageDeposit{Submitter: submitter.String(), Deposit: deposit}
+	if got, found := k.GetBundleStorageDeposit(ctx, bundleHash); !found || !reflect.DeepEqual(got, wantDeposit) {
+		t.Errorf("got deposit %v, %v, want %v", got, found, wantDeposit)
+	}
+
+	// A bundle already paid for is not charged again.
+	bank.sent = nil
+	if err := k.ChargeBundleStorage(ctx, submitter, bundle); err != nil {
+		t.Fatal(err)
+	}
+	if len(bank.sent) != 0 {
+		t.Errorf("charged %q for a bundle already paid for", bank.sent)
+	}
+
+	// A bundle claiming the same hash pays the whole fee but holds no
+	// deposit.
+	bogus := `{"moduleFormat":"endoZipBase64","endoZipBase64":"Ym9ndXM=","endoZipBase64Sha512":"` + bundleHash + `"}`
+	bogusDeposit, bogusFee := params.BundleStorageFee(uint64(len(bogus)))
+	otherSubmitter := sdk.AccAddress([]byte("other"))
+	if err := k.ChargeBundleStorage(ctx, otherSubmitter, bogus); err != nil {
+		t.Fatal(err)
+	}
+	wantSent = []string{fmt.Sprintf("%s feeCollector %s", otherSubmitter, bogusFee.Add(bogusDeposit...))}
+	if !reflect.DeepEqual(bank.sent, wantSent) {
+		t.Errorf("got sent %q for a bogus bundle, want %q", bank.sent, wantSent)
+	}
+	if got, _ := k.GetBundleStorageDeposit(ctx, bundleHash); !reflect.DeepEqual(got, wantDeposit) {
+		t.Errorf("got deposit %v after a bogus bundle, want %v", got, wantDeposit)
+	}
+
+	// While SwingSet holds the bundle, the rejection of another claiming its
+	// hash refunds nothing.
+	bank.sent = nil
+	swingStore := k.GetSwingStore(ctx)
+	swingStore.Set([]byte(swingStoreBundleKeyPrefix+bundleHash), []byte(bundleHash))
+	k.setBundleInstallation(ctx, bundleHash, types.BundleInstallation{Status: types.BundleStatusInstalled})
+	cell := vstoragekeeper.StreamCell{
+		BlockHeight: fmt.Sprint(ctx.BlockHeight()),
+		Values: []string{
+			`{"body":"#{\"endoZipBase64Sha512\":\"` + bundleHash + `\",\"error\":{\"#error\":\"bad hash\"},\"installed\":false}","slots":[]}`,
+		},
+	}
+	bz, err := json.Marshal(cell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	GetVstorageKeeper(t, k).SetStorage(ctx, agoric.NewKVEntry(StoragePathBundles, string(bz)))
+	if err := k.UpdateBundleInstallations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(bank.sent) != 0 {
+		t.Errorf("refunded %q for a bundle SwingSet holds", bank.sent)
+	}
+	if installation, _ := k.GetBundleInstallation(ctx, bundleHash); installation.Status != types.BundleStatusInstalled {
+		t.Errorf("got status %v after a bogus rejection, want installed", installation.Status)
+	}
+	if err := k.RefundBundleStorageDeposit(ctx, bundleHash); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := k.GetBundleStorageDeposit(ctx, bundleHash); !found || len(bank.sent) != 0 {
+		t.Errorf("refunded %q for a bundle SwingSet holds", bank.sent)
+	}
+
+	// Once SwingSet deletes the bundle, its deposit is refunded.
+	swingStore.Delete([]byte(swingStoreBundleKeyPrefix + bundleHash))
+	k.NoteSwingStoreExportDataDeletion(ctx, swingStoreBundleKeyPrefix+bundleHash)
+	wantSent = []string{fmt.Sprintf("%s %s %s", types.BundleStoragePoolName, submitter, deposit)}
+	if !reflect.DeepEqual(bank.sent, wantSent) {
+		t.Errorf("got sent %q after deletion, want %q", bank.sent, wantSent)
+	}
+	if _, found := k.GetBundleStorageDeposit(ctx, bundleHash); found {
+		t.Error("deposit held after its refund")
+	}
+	if _, found := k.GetBundleInstallation(ctx, bundleHash); found {
+		t.Error("installation of a deleted bundle remembered")
+	}
+
+	// A refund that fails is logged rather than failing the upcall.
+	other, otherHash := makeTestBundle(t, "other zip")
+	if err := k.ChargeBundleStorage(ctx, submitter, other); err != nil {
+		t.Fatal(err)
+	}
+	bank.refundErr = errors.New("insufficient funds")
+	k.NoteSwingStoreExportDataDeletion(ctx, swingStoreBundleKeyPrefix+otherHash)
+	if _, found := k.GetBundleStorageDeposit(ctx, otherHash); !found {
+		t.Error("deposit forgotten after a failed refund")
+	}
+	if err := k.RefundBundleStorageDeposit(ctx, bundleHash); err != nil {
+		t.Errorf("got error %v refunding a bundle without a deposit", err)
+	}
+}
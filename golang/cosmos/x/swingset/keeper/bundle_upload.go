@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdkioerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	bundleUploadKeyPrefix       = "bundleUpload."
+	bundleUploadChunkKeyPrefix  = "bundleUploadChunk."
+	bundleUploadExpiryKeyPrefix = "bundleUploadExpiry."
+
+	// BundleUploadExpiryBlocks is the number of blocks after its first chunk
+	// within which a chunked bundle upload must be completed.
+	BundleUploadExpiryBlocks int64 = 1000
+
+	// MaxBundleUploadsPerSubmitter is the number of chunked bundle uploads that
+	// a submitter may have in progress at once.
+	MaxBundleUploadsPerSubmitter = 4
+)
+
+// bundleUploadKey identifies the upload of bundleHash by submitter.  Uploads
+// are scoped by submitter so that nobody can interfere with another's upload.
+// The hash has a fixed length, so the key is unambiguous.
+func bundleUploadKey(submitter sdk.AccAddress, bundleHash string) []byte {
+	return append(address.MustLengthPrefix(submitter), bundleHash...)
+}
+
+func bundleUploadChunkKey(uploadKey []byte, chunkIndex uint64) []byte {
+	key := make([]byte, len(uploadKey), len(uploadKey)+8)
+	copy(key, uploadKey)
+	return binary.BigEndian.AppendUint64(key, chunkIndex)
+}
+
+// bundleUploadExpiryKey orders the expiry index by height so that expired
+// uploads can be found with a bounded iteration.
+func bundleUploadExpiryKey(expiryHeight int64, uploadKey []byte) []byte {
+	key := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(uploadKey)), uint64(expiryHeight))
+	return append(key, uploadKey...)
+}
+
+func (k Keeper) getBundleUploadStore(ctx sdk.Context, keyPrefix string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(keyPrefix))
+}
+
+// AddBundleChunk records a chunk of a bundle upload, returning a nil bundle
+// while chunks remain outstanding.  Once every chunk has arrived, the upload
+// state is removed and the reassembled bundle is returned, or an error if it
+// does not match the declared hash.  Resubmitting a chunk replaces its
+// previous contents, so a bad chunk can be corrected before expiry.
+func (k Keeper) AddBundleChunk(ctx sdk.Context, msg *types.MsgInstallBundleChunk) (*types.MsgInstallBundle, error) {
+	uploadStore := k.getBundleUploadStore(ctx, bundleUploadKeyPrefix)
+	chunkStore := k.getBundleUploadStore(ctx, bundleUploadChunkKeyPrefix)
+	uploadKey := bundleUploadKey(msg.Submitter, msg.BundleHash)
+
+	upload := types.BundleUpload{
+		TotalChunks:      msg.TotalChunks,
+		UncompressedSize: msg.UncompressedSize,
+		ExpiryHeight:     ctx.BlockHeight() + BundleUploadExpiryBlocks,
+	}
+	if bz := uploadStore.Get(uploadKey); bz != nil {
+		if err := k.cdc.Unmarshal(bz, &upload); err != nil {
+			return nil, err
+		}
+		if upload.TotalChunks != msg.TotalChunks || upload.UncompressedSize != msg.UncompressedSize {
+			return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Chunk does not match the bundle upload in progress")
+		}
+	} else {
+		if k.countBundleUploads(ctx, msg.Submitter) >= MaxBundleUploadsPerSubmitter {
+			return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Submitter already has %d bundle uploads in progress", MaxBundleUploadsPerSubmitter)
+		}
+		expiryStore := k.getBundleUploadStore(ctx, bundleUploadExpiryKeyPrefix)
+		expiryStore.Set(bundleUploadExpiryKey(upload.ExpiryHeight, uploadKey), []byte{})
+	}
+
+	chunkKey := bundleUploadChunkKey(uploadKey, msg.ChunkIndex)
+	if previous := chunkStore.Get(chunkKey); previous != nil {
+		upload.ReceivedSize -= int64(len(previous))
+	} else {
+		upload.ReceivedChunks++
+	}
+	upload.ReceivedSize += int64(len(msg.Chunk))
+	if upload.ReceivedSize >= types.BundleUploadSizeLimit {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bundle upload size out of range")
+	}
+	chunkStore.Set(chunkKey, msg.Chunk)
+
+	if upload.ReceivedChunks < upload.TotalChunks {
+		bz, err := k.cdc.Marshal(&upload)
+		if err != nil {
+			return nil, err
+		}
+		uploadStore.Set(uploadKey, bz)
+		return nil, nil
+	}
+
+	var compressed bytes.Buffer
+	compressed.Grow(int(upload.ReceivedSize))
+	for i := uint64(0); i < upload.TotalChunks; i++ {
+		compressed.Write(chunkStore.Get(bundleUploadChunkKey(uploadKey, i)))
+	}
+	if types.BundleHash(compressed.Bytes()) != msg.BundleHash {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reassembled bundle does not match the bundle hash")
+	}
+	k.deleteBundleUpload(ctx, uploadKey, upload)
+	return &types.MsgInstallBundle{
+		Submitter:        msg.Submitter,
+		CompressedBundle: compressed.Bytes(),
+		UncompressedSize: upload.UncompressedSize,
+	}, nil
+}
+
+// countBundleUploads returns the number of bundle uploads that submitter has
+// in progress, up to MaxBundleUploadsPerSubmitter.
+func (k Keeper) countBundleUploads(ctx sdk.Context, submitter sdk.AccAddress) int {
+	uploadStore := k.getBundleUploadStore(ctx, bundleUploadKeyPrefix)
+	iter := sdk.KVStorePrefixIterator(uploadStore, address.MustLengthPrefix(submitter))
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid() && count < MaxBundleUploadsPerSubmitter; iter.Next() {
+		count++
+	}
+	return count
+}
+
+func (k Keeper) deleteBundleUpload(ctx sdk.Context, uploadKey []byte, upload types.BundleUpload) {
+	chunkStore := k.getBundleUploadStore(ctx, bundleUploadChunkKeyPrefix)
+	for i := uint64(0); i < upload.TotalChunks; i++ {
+		chunkStore.Delete(bundleUploadChunkKey(uploadKey, i))
+	}
+	k.getBundleUploadStore(ctx, bundleUploadKeyPrefix).Delete(uploadKey)
+	k.getBundleUploadStore(ctx, bundleUploadExpiryKeyPrefix).Delete(bundleUploadExpiryKey(upload.ExpiryHeight, uploadKey))
+}
+
+// PruneExpiredBundleUploads discards the state of every incomplete bundle
+// upload whose expiry height has been reached.  Uploads that cannot be decoded
+// are logged and dropped from the expiry index, since they must not halt the
+// chain.
+func (k Keeper) PruneExpiredBundleUploads(ctx sdk.Context) {
+	uploadStore := k.getBundleUploadStore(ctx, bundleUploadKeyPrefix)
+	expiryStore := k.getBundleUploadStore(ctx, bundleUploadExpiryKeyPrefix)
+
+	end := bundleUploadExpiryKey(ctx.BlockHeight()+1, nil)
+	iter := expiryStore.Iterator(nil, end)
+	var expiryKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expiryKeys = append(expiryKeys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	for _, expiryKey := range expiryKeys {
+		uploadKey := expiryKey[8:]
+		var upload types.BundleUpload
+		if err := k.cdc.Unmarshal(uploadStore.Get(uploadKey), &upload); err != nil {
+			k.Logger(ctx).Error("invalid bundle upload", "key", uploadKey, "err", err)
+			expiryStore.Delete(expiryKey)
+			continue
+		}
+		k.deleteBundleUpload(ctx, uploadKey, upload)
+	}
+}
+
+// GetBundleUploads returns every chunked bundle upload in progress, as
+// exported in genesis.
+func (k Keeper) GetBundleUploads(ctx sdk.Context) []types.BundleUploadRecord {
+	uploadStore := k.getBundleUploadStore(ctx, bundleUploadKeyPrefix)
+	chunkStore := k.getBundleUploadStore(ctx, bundleUploadChunkKeyPrefix)
+	iterator := uploadStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.BundleUploadRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		uploadKey := iterator.Key()
+		submitterLen := int(uploadKey[0])
+		record := types.BundleUploadRecord{
+			Submitter:  sdk.AccAddress(uploadKey[1 : 1+submitterLen]).String(),
+			BundleHash: string(uploadKey[1+submitterLen:]),
+			Chunks:     []types.BundleUploadChunk{},
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &record.Upload)
+
+		chunkIter := sdk.KVStorePrefixIterator(chunkStore, uploadKey)
+		for ; chunkIter.Valid(); chunkIter.Next() {
+			record.Chunks = append(record.Chunks, types.BundleUploadChunk{
+				Index: binary.BigEndian.Uint64(chunkIter.Key()[len(uploadKey):]),
+				Data:  chunkIter.Value(),
+			})
+		}
+		chunkIter.Close()
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetBundleUpload stores a chunked bundle upload in progress, as imported from
+// genesis, along with its expiry.
+func (k Keeper) SetBundleUpload(ctx sdk.Context, record types.BundleUploadRecord) {
+	uploadKey := bundleUploadKey(sdk.MustAccAddressFromBech32(record.Submitter), record.BundleHash)
+	k.getBundleUploadStore(ctx, bundleUploadKeyPrefix).Set(uploadKey, k.cdc.MustMarshal(&record.Upload))
+	k.getBundleUploadStore(ctx, bundleUploadExpiryKeyPrefix).Set(bundleUploadExpiryKey(record.Upload.ExpiryHeight, uploadKey), []byte{})
+	chunkStore := k.getBundleUploadStore(ctx, bundleUploadChunkKeyPrefix)
+	for _, chunk := range record.Chunks {
+		chunkStore.Set(bundleUploadChunkKey(uploadKey, chunk.Index), chunk.Data)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sync"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
@@ -69,6 +70,9 @@ type ExtensionSnapshotter struct {
 	getSwingStoreExportDataShadowCopyReader func(height int64) agoric.KVEntryReader
 	logger                                  log.Logger
 	activeSnapshot                          *snapshotDetails
+	// exportWorkers is the number of artifacts read and encoded concurrently
+	// when writing a snapshot. At most 1 reads them serially.
+	exportWorkers int
 }
 
 // NewExtensionSnapshotter creates a new swingset ExtensionSnapshotter
@@ -87,6 +91,13 @@ func NewExtensionSnapshotter(
 	}
 }
 
+// WithExportWorkers sets the number of artifacts read and encoded concurrently
+// when writing a snapshot, if the SwingStore export supports it.
+func (snapshotter *ExtensionSnapshotter) WithExportWorkers(workers int) *ExtensionSnapshotter {
+	snapshotter.exportWorkers = workers
+	return snapshotter
+}
+
 // SnapshotName returns the name of the snapshotter, it should be unique in the manager.
 // Implements ExtensionSnapshotter
 func (snapshotter *ExtensionSnapshotter) SnapshotName() string {
@@ -227,18 +238,25 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 		return nil
 	}
 
-	for {
-		artifact, err := provider.ReadNextArtifact()
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return err
-		}
-
-		err = writeArtifactToPayload(artifact)
+	if snapshotter.exportWorkers > 1 && provider.NextArtifactLoader != nil {
+		err := writeArtifactPayloadsConcurrently(provider.NextArtifactLoader, snapshotter.exportWorkers, snapshotDetails.payloadWriter)
 		if err != nil {
 			return err
 		}
+	} else {
+		for {
+			artifact, err := provider.ReadNextArtifact()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+
+			err = writeArtifactToPayload(artifact)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	exportDataReader, err := provider.GetExportDataReader()
@@ -270,6 +288,73 @@ func (snapshotter *ExtensionSnapshotter) OnExportRetrieved(provider SwingStoreEx
 	return nil
 }
 
+// writeArtifactPayloadsConcurrently loads and encodes up to workers artifacts
+// at a time, while writing their payloads in export order with writePayload.
+// It returns once no more artifacts are being loaded.
+func writeArtifactPayloadsConcurrently(
+	nextArtifactLoader func() (SwingStoreArtifactLoader, error),
+	workers int,
+	writePayload func(payload []byte) error,
+) error {
+	type encodedArtifact struct {
+		payload []byte
+		err     error
+	}
+
+	// Each pending channel is fed by the worker encoding that artifact, which
+	// only starts once the channel is queued. The queue's capacity, along with
+	// the artifact being written, thus bounds the artifacts held in memory.
+	pending := make(chan chan encodedArtifact, workers-1)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(pending)
+		for {
+			load, err := nextArtifactLoader()
+			if err == io.EOF {
+				return
+			}
+			encoded := make(chan encodedArtifact, 1)
+			select {
+			case pending <- encoded:
+			case <-stop:
+				return
+			}
+			if err != nil {
+				encoded <- encodedArtifact{err: err}
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				artifact, err := load()
+				var payload []byte
+				if err == nil {
+					payload, err = artifact.Marshal()
+				}
+				encoded <- encodedArtifact{payload, err}
+			}()
+		}
+	}()
+
+	defer wg.Wait()
+	defer close(stop)
+
+	for encoded := range pending {
+		result := <-encoded
+		if result.err != nil {
+			return result.err
+		}
+		if err := writePayload(result.payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RestoreExtension restores an extension state snapshot,
 // the payload reader returns io.EOF when it reaches the extension boundaries.
 // Implements ExtensionSnapshotter
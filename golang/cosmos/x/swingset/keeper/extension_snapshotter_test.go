@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"testing"
+	"time"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -102,3 +106,65 @@ func TestExtensionSnapshotterSecondCommit(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWriteArtifactPayloadsConcurrently(t *testing.T) {
+	const count = 20
+	newLoaders := func(failAt int) func() (SwingStoreArtifactLoader, error) {
+		next := 0
+		return func() (SwingStoreArtifactLoader, error) {
+			if next == count {
+				return nil, io.EOF
+			}
+			i := next
+			next++
+			return func() (types.SwingStoreArtifact, error) {
+				// Load later artifacts faster, to scramble the completion order.
+				time.Sleep(time.Duration(count-i) * time.Millisecond)
+				if i == failAt {
+					return types.SwingStoreArtifact{}, fmt.Errorf("cannot load artifact %d", i)
+				}
+				return types.SwingStoreArtifact{Name: fmt.Sprintf("artifact-%d", i)}, nil
+			}, nil
+		}
+	}
+
+	var names []string
+	err := writeArtifactPayloadsConcurrently(newLoaders(-1), 4, func(payload []byte) error {
+		var artifact types.SwingStoreArtifact
+		if err := artifact.Unmarshal(payload); err != nil {
+			return err
+		}
+		names = append(names, artifact.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != count {
+		t.Fatalf("got %d payloads, want %d", len(names), count)
+	}
+	for i, name := range names {
+		if want := fmt.Sprintf("artifact-%d", i); name != want {
+			t.Errorf("got payload %d named %q, want %q", i, name, want)
+		}
+	}
+
+	written := 0
+	err = writeArtifactPayloadsConcurrently(newLoaders(7), 4, func(payload []byte) error {
+		written++
+		return nil
+	})
+	if err == nil || err.Error() != "cannot load artifact 7" {
+		t.Errorf("got error %v, want load failure", err)
+	}
+	if written != 7 {
+		t.Errorf("got %d payloads written before the failure, want 7", written)
+	}
+
+	err = writeArtifactPayloadsConcurrently(newLoaders(-1), 4, func(payload []byte) error {
+		return errors.New("cannot write")
+	})
+	if err == nil || err.Error() != "cannot write" {
+		t.Errorf("got error %v, want write failure", err)
+	}
+}
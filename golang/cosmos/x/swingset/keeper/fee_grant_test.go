@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// mockFeegrantKeeper applies the allowances of x/feegrant without its store.
+type mockFeegrantKeeper struct {
+	allowances map[string]feegrant.FeeAllowanceI
+}
+
+func (mf mockFeegrantKeeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	key := granter.String() + "/" + grantee.String()
+	allowance, ok := mf.allowances[key]
+	if !ok {
+		return sdkioerrors.Wrap(sdkerrors.ErrNotFound, "fee-grant not found")
+	}
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		delete(mf.allowances, key)
+	}
+	return err
+}
+
+func TestChargeGrantedBeans(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+	bank := &mockBankKeeper{}
+	k.bankKeeper = bank
+	k.feeCollectorName = "feeCollector"
+	granter := sdk.AccAddress([]byte("granter"))
+	owner := sdk.AccAddress([]byte("owner"))
+	msgs := []sdk.Msg{types.NewMsgWalletSpendAction(owner, "{}")}
+	beansPerUnit := map[string]sdkmath.Uint{
+		types.BeansPerFeeUnit:     sdk.NewUint(1_000_000_000_000),
+		types.BeansPerMinFeeDebit: sdk.NewUint(200_000_000_000),
+	}
+	// Each step of the offer costs 0.2 IST, of which the allowance covers two.
+	stepBeans := sdk.NewUint(200_000_000_000)
+
+	err := k.ChargeGrantedBeans(ctx, beansPerUnit, granter, owner, stepBeans, msgs)
+	if err == nil {
+		t.Fatalf("got no error without a feegrant keeper")
+	}
+
+	k = k.WithFeegrantKeeper(mockFeegrantKeeper{allowances: map[string]feegrant.FeeAllowanceI{
+		granter.String() + "/" + owner.String(): &feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uist", 500_000)),
+		},
+	}})
+
+	for step := 1; step <= 2; step++ {
+		if err := k.ChargeGrantedBeans(ctx, beansPerUnit, granter, owner, stepBeans, msgs); err != nil {
+			t.Fatalf("step %d: got error = %v", step, err)
+		}
+	}
+	// Beans that accrue without a debit need no more than what is left.
+	if err := k.ChargeGrantedBeans(ctx, beansPerUnit, granter, owner, sdk.NewUint(100_000_000_000), msgs); err != nil {
+		t.Fatalf("got error = %v for beans owing", err)
+	}
+	// The debit of the next step exhausts the allowance mid-offer.
+	if err := k.ChargeGrantedBeans(ctx, beansPerUnit, granter, owner, stepBeans, msgs); err == nil {
+		t.Fatalf("got no error for an exhausted allowance")
+	}
+
+	want := []string{
+		fmt.Sprintf("%s feeCollector 200000uist", granter),
+		fmt.Sprintf("%s feeCollector 200000uist", granter),
+	}
+	if fmt.Sprint(bank.sent) != fmt.Sprint(want) {
+		t.Errorf("got sends %v, want %v", bank.sent, want)
+	}
+	if got := k.GetBeansOwing(ctx, granter); !got.Equal(sdk.NewUint(100_000_000_000)) {
+		t.Errorf("got granter owing %s, want 100000000000", got)
+	}
+	if got := k.GetBeansOwing(ctx, owner); !got.IsZero() {
+		t.Errorf("got owner owing %s, want 0", got)
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,7 +27,8 @@ func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*type
 	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{
-		Params: params,
+		Params:                  params,
+		SmartWalletProvisionFee: k.GetSmartWalletProvisionFee(ctx),
 	}, nil
 }
 
@@ -46,6 +48,24 @@ func (k Querier) Egress(c context.Context, req *types.QueryEgressRequest) (*type
 	}, nil
 }
 
+// Egresses lists the provisioned egresses.
+func (k Querier) Egresses(c context.Context, req *types.QueryEgressesRequest) (*types.QueryEgressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	egresses, pageRes, err := k.GetEgressesPage(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEgressesResponse{
+		Egresses:   egresses,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Querier) Mailbox(c context.Context, req *types.QueryMailboxRequest) (*types.QueryMailboxResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -57,7 +77,172 @@ func (k Querier) Mailbox(c context.Context, req *types.QueryMailboxRequest) (*ty
 		return nil, status.Error(codes.NotFound, "mailbox not found")
 	}
 
+	messages, ack, err := types.DecodeMailbox(value)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &types.QueryMailboxResponse{
-		Value: value,
+		Value:    value,
+		Messages: messages,
+		Ack:      ack,
+	}, nil
+}
+
+func (k Querier) Vats(c context.Context, req *types.QueryVatsRequest) (*types.QueryVatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.GetVatsStatus(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (k Querier) BundleStatus(c context.Context, req *types.QueryBundleStatusRequest) (*types.QueryBundleStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bundleHash := strings.TrimPrefix(req.BundleHash, types.BundleIDPrefix)
+	if bundleHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty bundle hash")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	installation, found := k.GetBundleInstallation(ctx, bundleHash)
+	if !found {
+		return nil, status.Error(codes.NotFound, "bundle not found")
+	}
+
+	return &types.QueryBundleStatusResponse{
+		Installation:   installation,
+		ReferenceCount: k.GetBundleReferenceCount(ctx, bundleHash),
+	}, nil
+}
+
+func (k Querier) Health(c context.Context, req *types.QueryHealthRequest) (*types.QueryHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	health := k.GetVMHealth()
+	res := &types.QueryHealthResponse{
+		Enabled:             health.Enabled,
+		Healthy:             health.Healthy,
+		LatencyMs:           health.Latency.Milliseconds(),
+		ConsecutiveFailures: health.ConsecutiveFailures,
+		LastError:           health.LastError,
+	}
+	if !health.LastSuccess.IsZero() {
+		res.LastSuccessUnixMs = health.LastSuccess.UnixMilli()
+	}
+
+	return res, nil
+}
+
+func (k Querier) ReplayStatus(c context.Context, req *types.QueryReplayStatusRequest) (*types.QueryReplayStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	replay := k.GetReplayStatus()
+	res := &types.QueryReplayStatusResponse{
+		Replaying:          replay.Replaying,
+		VatID:              replay.Progress.VatID,
+		ReplayedDeliveries: replay.Progress.Replayed,
+		TotalDeliveries:    replay.Progress.Total,
+		VatsReplayed:       replay.VatsReplayed,
+	}
+	if !replay.Started.IsZero() {
+		res.StartedUnixMs = replay.Started.UnixMilli()
+	}
+	if !replay.LastProgress.IsZero() {
+		res.LastProgressUnixMs = replay.LastProgress.UnixMilli()
+	}
+	res.Vats = make([]types.VatReplayStatus, len(replay.Vats))
+	for i, vat := range replay.Vats {
+		res.Vats[i] = types.VatReplayStatus{
+			VatID:              vat.Progress.VatID,
+			ReplayedDeliveries: vat.Progress.Replayed,
+			TotalDeliveries:    vat.Progress.Total,
+			StartedUnixMs:      vat.Started.UnixMilli(),
+			LastProgressUnixMs: vat.LastProgress.UnixMilli(),
+		}
+	}
+
+	return res, nil
+}
+
+func (k Querier) ActionQueue(c context.Context, req *types.QueryActionQueueRequest) (*types.QueryActionQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.GetActionQueue(ctx, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (k Querier) PrioritySenders(c context.Context, req *types.QueryPrioritySendersRequest) (*types.QueryPrioritySendersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPrioritySendersResponse{
+		ParamSenders:   k.GetParams(ctx).PrioritySenders,
+		StorageSenders: k.GetStoragePrioritySenders(ctx),
+	}, nil
+}
+
+func (k Querier) Timer(c context.Context, req *types.QueryTimerRequest) (*types.QueryTimerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTimerResponse{
+		Timer: k.GetTimerStatus(ctx),
+	}, nil
+}
+
+func (k Querier) VatOwner(c context.Context, req *types.QueryVatOwnerRequest) (*types.QueryVatOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetVatOwner(ctx, req.VatId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "vat owner not found")
+	}
+	return &types.QueryVatOwnerResponse{
+		VatOwner: record,
+	}, nil
+}
+
+// WalletActionText renders a smart wallet action for display by a signer.
+func (k Querier) WalletActionText(c context.Context, req *types.QueryWalletActionTextRequest) (*types.QueryWalletActionTextResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lines, err := types.RenderWalletAction(req.Owner, req.Action, req.SpendAction)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryWalletActionTextResponse{
+		Lines: lines,
 	}, nil
 }
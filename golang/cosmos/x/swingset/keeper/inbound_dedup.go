@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	deliveredInboundKeyPrefix       = "deliveredInbound."
+	deliveredInboundExpiryKeyPrefix = "deliveredInboundExpiry."
+	deliveredInboundAckKeyPrefix    = "deliveredInboundAck."
+)
+
+// deliveredInboundKey identifies the mailbox message num delivered by peer.
+// The peer is length-prefixed, so the key is unambiguous.
+func deliveredInboundKey(peer sdk.AccAddress, num uint64) []byte {
+	return binary.BigEndian.AppendUint64(address.MustLengthPrefix(peer), num)
+}
+
+// deliveredInboundExpiryKey orders the expiry index by height so that expired
+// deliveries can be found with a bounded iteration.
+func deliveredInboundExpiryKey(expiryHeight int64, deliveredKey []byte) []byte {
+	key := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(deliveredKey)), uint64(expiryHeight))
+	return append(key, deliveredKey...)
+}
+
+func (k Keeper) getDeliveredInboundStore(ctx sdk.Context, keyPrefix string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(keyPrefix))
+}
+
+// IsDeliverInboundReplay returns whether a MsgDeliverInbound from peer with
+// the mailbox message nums and ack would tell SwingSet nothing new, because
+// every message was delivered within the inbound_dedup_window_blocks param
+// and the ack is no newer than the last one delivered. It is always false
+// when the window is zero.
+func (k Keeper) IsDeliverInboundReplay(ctx sdk.Context, peer sdk.AccAddress, nums []uint64, ack uint64) bool {
+	if k.GetParams(ctx).InboundDedupWindowBlocks == 0 {
+		return false
+	}
+	return k.isRememberedDelivery(ctx, peer, nums, ack)
+}
+
+// isRememberedDelivery returns whether every one of the mailbox message nums
+// from peer is remembered, and ack is no newer than the last one remembered.
+func (k Keeper) isRememberedDelivery(ctx sdk.Context, peer sdk.AccAddress, nums []uint64, ack uint64) bool {
+	bz := k.getDeliveredInboundStore(ctx, deliveredInboundAckKeyPrefix).Get(address.MustLengthPrefix(peer))
+	if bz == nil || ack > binary.BigEndian.Uint64(bz) {
+		return false
+	}
+	store := k.getDeliveredInboundStore(ctx, deliveredInboundKeyPrefix)
+	for _, num := range nums {
+		if !store.Has(deliveredInboundKey(peer, num)) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecordDeliverInbound remembers the mailbox message nums and ack delivered by
+// peer for the inbound_dedup_window_blocks param, if it is nonzero.
+func (k Keeper) RecordDeliverInbound(ctx sdk.Context, peer sdk.AccAddress, nums []uint64, ack uint64) {
+	window := k.GetParams(ctx).InboundDedupWindowBlocks
+	if window == 0 {
+		return
+	}
+	k.rememberDelivery(ctx, peer, nums, ack, window)
+}
+
+// rememberDelivery remembers the mailbox message nums and ack delivered by
+// peer for window blocks.
+func (k Keeper) rememberDelivery(ctx sdk.Context, peer sdk.AccAddress, nums []uint64, ack uint64, window uint64) {
+	store := k.getDeliveredInboundStore(ctx, deliveredInboundKeyPrefix)
+	expiryStore := k.getDeliveredInboundStore(ctx, deliveredInboundExpiryKeyPrefix)
+	expiryHeight := ctx.BlockHeight() + int64(window)
+
+	for _, num := range nums {
+		deliveredKey := deliveredInboundKey(peer, num)
+		if bz := store.Get(deliveredKey); bz != nil {
+			expiryStore.Delete(deliveredInboundExpiryKey(int64(binary.BigEndian.Uint64(bz)), deliveredKey))
+		}
+		store.Set(deliveredKey, sdk.Uint64ToBigEndian(uint64(expiryHeight)))
+		expiryStore.Set(deliveredInboundExpiryKey(expiryHeight, deliveredKey), []byte{})
+	}
+
+	ackStore := k.getDeliveredInboundStore(ctx, deliveredInboundAckKeyPrefix)
+	peerKey := address.MustLengthPrefix(peer)
+	if bz := ackStore.Get(peerKey); bz == nil || ack > binary.BigEndian.Uint64(bz) {
+		ackStore.Set(peerKey, sdk.Uint64ToBigEndian(ack))
+	}
+}
+
+// PruneDeliveredInbound forgets the mailbox messages whose deduplication
+// window has passed.
+func (k Keeper) PruneDeliveredInbound(ctx sdk.Context) {
+	store := k.getDeliveredInboundStore(ctx, deliveredInboundKeyPrefix)
+	expiryStore := k.getDeliveredInboundStore(ctx, deliveredInboundExpiryKeyPrefix)
+
+	end := deliveredInboundExpiryKey(ctx.BlockHeight()+1, nil)
+	iter := expiryStore.Iterator(nil, end)
+	var expiredKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	for _, expiryKey := range expiredKeys {
+		store.Delete(expiryKey[8:])
+		expiryStore.Delete(expiryKey)
+	}
+}
+
+// GetDeliveredInbound returns the deliveries remembered from every peer, as
+// exported in genesis.
+func (k Keeper) GetDeliveredInbound(ctx sdk.Context) []types.DeliveredInboundRecord {
+	store := k.getDeliveredInboundStore(ctx, deliveredInboundKeyPrefix)
+	ackIter := k.getDeliveredInboundStore(ctx, deliveredInboundAckKeyPrefix).Iterator(nil, nil)
+	defer ackIter.Close()
+
+	records := []types.DeliveredInboundRecord{}
+	for ; ackIter.Valid(); ackIter.Next() {
+		peerKey := ackIter.Key()
+		record := types.DeliveredInboundRecord{
+			Peer:     sdk.AccAddress(peerKey[1:]).String(),
+			Ack:      binary.BigEndian.Uint64(ackIter.Value()),
+			Messages: []types.DeliveredInboundMessage{},
+		}
+		iter := sdk.KVStorePrefixIterator(store, peerKey)
+		for ; iter.Valid(); iter.Next() {
+			record.Messages = append(record.Messages, types.DeliveredInboundMessage{
+				Num:          binary.BigEndian.Uint64(iter.Key()[len(peerKey):]),
+				ExpiryHeight: int64(binary.BigEndian.Uint64(iter.Value())),
+			})
+		}
+		iter.Close()
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetDeliveredInbound stores the deliveries remembered from a peer, as
+// imported from genesis, along with their expiry.
+func (k Keeper) SetDeliveredInbound(ctx sdk.Context, record types.DeliveredInboundRecord) {
+	peer := sdk.MustAccAddressFromBech32(record.Peer)
+	store := k.getDeliveredInboundStore(ctx, deliveredInboundKeyPrefix)
+	expiryStore := k.getDeliveredInboundStore(ctx, deliveredInboundExpiryKeyPrefix)
+	for _, message := range record.Messages {
+		deliveredKey := deliveredInboundKey(peer, message.Num)
+		store.Set(deliveredKey, sdk.Uint64ToBigEndian(uint64(message.ExpiryHeight)))
+		expiryStore.Set(deliveredInboundExpiryKey(message.ExpiryHeight, deliveredKey), []byte{})
+	}
+	ackStore := k.getDeliveredInboundStore(ctx, deliveredInboundAckKeyPrefix)
+	ackStore.Set(address.MustLengthPrefix(peer), sdk.Uint64ToBigEndian(record.Ack))
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// The inbound lanes are queues kept by x/swingset alone, under
+// StoragePathInboundLanes, in the format of the inbound queues.  While a lane
+// is configured by the inbound_lanes param, the actions bound for it wait
+// there rather than in the actionQueue, highPriorityQueue, or oracleQueue, and
+// ForwardInboundLanes moves them back to the queue they were bound for at the
+// end of each block in weighted round-robin order, so that a flood of actions
+// in one lane (such as from wallet spam) delays those of the others by at most
+// the lane's share, while SwingSet keeps running the oracleQueue and
+// highPriorityQueue ahead of the actionQueue.
+
+// inboundLanePath returns the vstorage path of the queue of the named lane.
+func inboundLanePath(lane string) string {
+	return StoragePathInboundLanes + "." + lane
+}
+
+// actionInboundLane returns the name of the lane of an action of actionType
+// bound for inboundQueuePath, or "" if it belongs to none.
+func actionInboundLane(inboundQueuePath, actionType string) string {
+	switch inboundQueuePath {
+	case StoragePathHighPriorityQueue:
+		return types.InboundLaneGovernance
+	case StoragePathOracleQueue:
+		return types.InboundLaneOracle
+	}
+	switch actionType {
+	case "WALLET_ACTION", "WALLET_SPEND_ACTION", "PLEASE_PROVISION":
+		return types.InboundLaneWallet
+	case "IBC_EVENT", "VTRANSFER_IBC_EVENT":
+		return types.InboundLaneIBC
+	}
+	return ""
+}
+
+// routeInboundLane returns the queue to which an action of actionType bound
+// for inboundQueuePath must be pushed: that of its lane if configured, else
+// inboundQueuePath itself.
+func (k Keeper) routeInboundLane(ctx sdk.Context, inboundQueuePath, actionType string) string {
+	lane := actionInboundLane(inboundQueuePath, actionType)
+	if lane == "" {
+		return inboundQueuePath
+	}
+	if _, ok := k.GetParams(ctx).GetInboundLane(lane); !ok {
+		return inboundQueuePath
+	}
+	return inboundLanePath(lane)
+}
+
+// inboundLaneQueuePath returns the vstorage path of the SwingSet queue to
+// which the actions of the named lane are forwarded.
+func inboundLaneQueuePath(lane string) string {
+	switch lane {
+	case types.InboundLaneGovernance:
+		return StoragePathHighPriorityQueue
+	case types.InboundLaneOracle:
+		return StoragePathOracleQueue
+	}
+	return StoragePathActionQueue
+}
+
+// getInboundLaneLength returns the number of actions waiting in the named lane.
+func (k Keeper) getInboundLaneLength(ctx sdk.Context, lane string) (uint64, error) {
+	path := inboundLanePath(lane)
+	length, err := k.vstorageKeeper.GetQueueLength(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	if !length.IsUint64() {
+		return 0, fmt.Errorf("%s length out of range: %s", path, length)
+	}
+	return length.Uint64(), nil
+}
+
+// IsInboundLaneFull reports whether the named lane holds as many actions as
+// its max_size allows, so that no more may be admitted to it.
+func (k Keeper) IsInboundLaneFull(ctx sdk.Context, lane string) (bool, error) {
+	config, ok := k.GetParams(ctx).GetInboundLane(lane)
+	if !ok || config.MaxSize <= 0 {
+		return false, nil
+	}
+	length, err := k.getInboundLaneLength(ctx, lane)
+	if err != nil {
+		return false, err
+	}
+	return length >= uint64(config.MaxSize), nil
+}
+
+// shiftInboundLane moves the action at the head of the named lane to the
+// tail of the SwingSet queue of the lane.
+func (k Keeper) shiftInboundLane(ctx sdk.Context, lane string) error {
+	path := inboundLanePath(lane)
+	head, err := k.vstorageKeeper.GetIntValue(ctx, path+".head")
+	if err != nil {
+		return err
+	}
+	entryPath := path + "." + head.String()
+	entry := k.vstorageKeeper.GetEntry(ctx, entryPath)
+	if !entry.HasValue() {
+		return fmt.Errorf("missing queue entry %s", entryPath)
+	}
+	if err := k.vstorageKeeper.PushQueueItem(ctx, inboundLaneQueuePath(lane), entry.StringValue()); err != nil {
+		return err
+	}
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue(entryPath))
+	k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(path+".head", head.AddRaw(1).String()))
+	return nil
+}
+
+// ForwardInboundLanes moves the actions waiting in the inbound lanes to their
+// SwingSet queues, taking in turn up to the weight of each configured lane
+// until the lanes are empty or inbound_lane_batch_size actions have been
+// forwarded in this block.  Lanes no longer configured are drained with a
+// weight of 1.
+func (k Keeper) ForwardInboundLanes(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	lanes := append([]types.InboundLane{}, params.InboundLanes...)
+	for _, name := range types.InboundLaneNames {
+		if _, ok := params.GetInboundLane(name); !ok {
+			lanes = append(lanes, types.InboundLane{Name: name, Weight: 1})
+		}
+	}
+	remaining := make([]uint64, len(lanes))
+	var total uint64
+	for i, lane := range lanes {
+		length, err := k.getInboundLaneLength(ctx, lane.Name)
+		if err != nil {
+			return err
+		}
+		remaining[i] = length
+		total += length
+	}
+
+	budget := uint64(math.MaxUint64)
+	if params.InboundLaneBatchSize > 0 {
+		budget = params.InboundLaneBatchSize
+	}
+
+	for budget > 0 && total > 0 {
+		for i, lane := range lanes {
+			for n := uint32(0); n < lane.Weight && remaining[i] > 0 && budget > 0; n++ {
+				if err := k.shiftInboundLane(ctx, lane.Name); err != nil {
+					return err
+				}
+				remaining[i]--
+				total--
+				budget--
+			}
+		}
+	}
+	return nil
+}
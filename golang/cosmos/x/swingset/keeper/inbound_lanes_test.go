@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// queuedOwners returns the owners of the actions waiting in queuePath.
+func queuedOwners(t *testing.T, k Keeper, ctx sdk.Context, queuePath string) []string {
+	head, err := k.vstorageKeeper.GetIntValue(ctx, queuePath+".head")
+	if err != nil {
+		t.Fatal(err)
+	}
+	length, err := k.vstorageKeeper.GetQueueLength(ctx, queuePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owners := []string{}
+	for i := int64(0); i < length.Int64(); i++ {
+		entry := k.vstorageKeeper.GetEntry(ctx, queuePath+"."+head.AddRaw(i).String())
+		var record struct {
+			Action struct {
+				Owner string `json:"owner"`
+			} `json:"action"`
+		}
+		if err := json.Unmarshal([]byte(entry.StringValue()), &record); err != nil {
+			t.Fatal(err)
+		}
+		owners = append(owners, record.Action.Owner)
+	}
+	return owners
+}
+
+func TestForwardInboundLanes(t *testing.T) {
+	params := types.DefaultParams()
+	params.InboundLanes = []types.InboundLane{
+		{Name: types.InboundLaneOracle, Weight: 2},
+		{Name: types.InboundLaneWallet, Weight: 1, MaxSize: 3},
+		{Name: types.InboundLaneGovernance, Weight: 1},
+	}
+	params.InboundLaneBatchSize = 5
+	k, ctx := makeTestParamsKeeper(t, params)
+
+	for _, owner := range []string{"w0", "w1", "w2"} {
+		if err := k.PushAction(ctx, walletSpendAction{Owner: owner, SpendAction: "{}"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, owner := range []string{"o0", "o1", "o2"} {
+		if err := k.PushOracleAction(ctx, oraclePushAction{Owner: owner, Feed: "ATOM-USD", UnitPrice: "1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := k.PushHighPriorityAction(ctx, walletSpendAction{Owner: "g0", SpendAction: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+	// Actions without a lane enter the actionQueue directly.
+	if err := k.PushAction(ctx, deliverInboundAction{Peer: "agoric1bob"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if full, err := k.IsInboundLaneFull(ctx, types.InboundLaneWallet); err != nil || !full {
+		t.Errorf("got wallet lane full %v (%v), want true", full, err)
+	}
+	if full, err := k.IsInboundLaneFull(ctx, types.InboundLaneOracle); err != nil || full {
+		t.Errorf("got oracle lane full %v (%v), want false", full, err)
+	}
+	if length, err := k.InboundQueueLength(ctx); err != nil || length != 8 {
+		t.Errorf("got inbound queue length %d (%v), want 8", length, err)
+	}
+
+	// Each lane is forwarded to the queue it was bound for, which keeps its
+	// priority.
+	if err := k.ForwardInboundLanes(ctx); err != nil {
+		t.Fatal(err)
+	}
+	wantQueues := map[string][]string{
+		StoragePathOracleQueue:       {"o0", "o1", "o2"},
+		StoragePathHighPriorityQueue: {"g0"},
+		StoragePathActionQueue:       {"", "w0"},
+	}
+	for path, want := range wantQueues {
+		if got := queuedOwners(t, k, ctx, path); !reflect.DeepEqual(got, want) {
+			t.Errorf("got %s %q, want %q", path, got, want)
+		}
+	}
+	if got := queuedOwners(t, k, ctx, inboundLanePath(types.InboundLaneWallet)); !reflect.DeepEqual(got, []string{"w1", "w2"}) {
+		t.Errorf("got wallet lane %q, want [w1 w2]", got)
+	}
+
+	// Actions outside the lanes do not count against the batch.
+	for i := 0; i < 5; i++ {
+		if err := k.PushAction(ctx, deliverInboundAction{Peer: "agoric1bob"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := k.ForwardInboundLanes(ctx); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"", "w0", "", "", "", "", "", "w1", "w2"}
+	if got := queuedOwners(t, k, ctx, StoragePathActionQueue); !reflect.DeepEqual(got, want) {
+		t.Errorf("got actionQueue %q, want %q", got, want)
+	}
+
+	// Lanes no longer configured are drained.
+	if err := k.PushAction(ctx, walletSpendAction{Owner: "w3", SpendAction: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+	params.InboundLanes = nil
+	params.InboundLaneBatchSize = 0
+	k.SetParams(ctx, params)
+	if err := k.ForwardInboundLanes(ctx); err != nil {
+		t.Fatal(err)
+	}
+	want = append(want, "w3")
+	if got := queuedOwners(t, k, ctx, StoragePathActionQueue); !reflect.DeepEqual(got, want) {
+		t.Errorf("got actionQueue %q, want %q", got, want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	stdlog "log"
 	"math"
 
+	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -16,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -31,6 +34,8 @@ import (
 const (
 	StoragePathActionQueue         = "actionQueue"
 	StoragePathHighPriorityQueue   = "highPriorityQueue"
+	StoragePathOracleQueue         = "oracleQueue"
+	StoragePathInboundLanes        = "inboundLanes"
 	StoragePathHighPrioritySenders = "highPrioritySenders"
 	StoragePathBeansOwing          = "beansOwing"
 	StoragePathEgress              = "egress"
@@ -38,6 +43,7 @@ const (
 	StoragePathCustom              = "published"
 	StoragePathBundles             = "bundles"
 	StoragePathSwingStore          = "swingStore"
+	StoragePathSwingset            = "swingset"
 )
 
 const (
@@ -49,6 +55,9 @@ const (
 const (
 	stateKey            = "state"
 	swingStoreKeyPrefix = "swingStore."
+	// oraclePushCountKey holds the height of the block of the last admitted
+	// MsgOraclePush and the number admitted in it.
+	oraclePushCountKey = "oraclePushCount"
 )
 
 // Keeper maintains the link to data vstorage and exposes getter/setter methods for the various parts of the state machine
@@ -62,8 +71,32 @@ type Keeper struct {
 	vstorageKeeper   vstoragekeeper.Keeper
 	feeCollectorName string
 
+	// feegrantKeeper, if non-nil, lets fee granters pay the admission fees of
+	// the smart wallet messages of their grantees
+	feegrantKeeper types.FeegrantKeeper
+
+	// authority is the address allowed to register vat owners, normally the
+	// governance module account
+	authority string
+
 	// CallToController dispatches a message to the controlling process
 	callToController func(ctx sdk.Context, str string) (string, error)
+
+	// vmHealth, if non-nil, reports the result of pinging the VM
+	vmHealth *vm.HealthMonitor
+
+	// replayTracker, if non-nil, follows the kernel's transcript replays
+	replayTracker *ReplayTracker
+
+	// bridgeHashChain, if non-nil, digests the bridge messages of each block
+	bridgeHashChain *vm.HashChain
+
+	// blockTracer, if non-nil, traces the bridge messages of each block
+	blockTracer *vm.BlockTracer
+
+	// kernelPanicMarkerDir, if non-empty, is where HaltForKernelPanic records
+	// its KernelPanicMarker
+	kernelPanicMarkerDir string
 }
 
 var _ types.SwingSetKeeper = &Keeper{}
@@ -74,6 +107,7 @@ func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper bankkeeper.Keeper,
 	vstorageKeeper vstoragekeeper.Keeper, feeCollectorName string,
+	authority string,
 	callToController func(ctx sdk.Context, str string) (string, error),
 ) Keeper {
 
@@ -90,11 +124,73 @@ func NewKeeper(
 		bankKeeper:       bankKeeper,
 		vstorageKeeper:   vstorageKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 		callToController: callToController,
 	}
 }
 
-func populateAction(ctx sdk.Context, action vm.Action) (vm.Action, error) {
+// GetAuthority returns the address allowed to register vat owners.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// WithVMHealth returns a copy of the keeper that reports the health of the VM
+// as monitored by vmHealth.
+func (k Keeper) WithVMHealth(vmHealth *vm.HealthMonitor) Keeper {
+	k.vmHealth = vmHealth
+	return k
+}
+
+// WithFeegrantKeeper returns a copy of the keeper that charges the admission
+// fees of smart wallet messages to the fee granter of their transaction, as
+// allowed by fk.
+func (k Keeper) WithFeegrantKeeper(fk types.FeegrantKeeper) Keeper {
+	k.feegrantKeeper = fk
+	return k
+}
+
+// GetVMHealth returns the result of the most recent pings of the VM, which are
+// not part of consensus state.
+func (k Keeper) GetVMHealth() vm.HealthStatus {
+	if k.vmHealth == nil {
+		return vm.HealthStatus{}
+	}
+	return k.vmHealth.Status()
+}
+
+// WithReplayTracker returns a copy of the keeper that records the kernel's
+// transcript replay progress in replayTracker.
+func (k Keeper) WithReplayTracker(replayTracker *ReplayTracker) Keeper {
+	k.replayTracker = replayTracker
+	return k
+}
+
+// ReportReplayProgress records the progress of a transcript replay reported by
+// the kernel.
+func (k Keeper) ReportReplayProgress(progress ReplayProgress) {
+	if k.replayTracker != nil {
+		k.replayTracker.Report(progress)
+	}
+}
+
+// GetReplayStatus returns the state of the kernel's transcript replays, which
+// is not part of consensus state.
+func (k Keeper) GetReplayStatus() ReplayStatus {
+	if k.replayTracker == nil {
+		return ReplayStatus{}
+	}
+	return k.replayTracker.Status()
+}
+
+// populateAction populates the defaults of action, with the block time of its
+// header quantized by the block_time_quantum_seconds param, but no earlier
+// than the block time last delivered to SwingSet.  The storeless contexts of
+// COMMIT_BLOCK and AFTER_COMMIT_BLOCK already carry the END_BLOCK time.
+func (k Keeper) populateAction(ctx sdk.Context, action vm.Action) (vm.Action, error) {
+	if ctx.MultiStore() != nil {
+		ctx = vm.WithBlockTimeQuantum(ctx, k.GetParams(ctx).BlockTimeQuantumSeconds)
+		ctx = vm.WithMinBlockTime(ctx, k.GetState(ctx).LastBlockTime)
+	}
 	action = vm.PopulateAction(ctx, action)
 	ah := action.GetActionHeader()
 	if len(ah.Type) == 0 {
@@ -113,10 +209,11 @@ func populateAction(ctx sdk.Context, action vm.Action) (vm.Action, error) {
 // The inbound queue's format is documented by `makeChainQueue` in
 // `packages/cosmic-swingset/src/helpers/make-queue.js`.
 func (k Keeper) pushAction(ctx sdk.Context, inboundQueuePath string, action vm.Action) error {
-	action, err := populateAction(ctx, action)
+	action, err := k.populateAction(ctx, action)
 	if err != nil {
 		return err
 	}
+	inboundQueuePath = k.routeInboundLane(ctx, inboundQueuePath, action.GetActionHeader().Type)
 	txHash, txHashOk := ctx.Context().Value(baseapp.TxHashContextKey).(string)
 	if !txHashOk {
 		txHash = "unknown"
@@ -151,11 +248,66 @@ func (k Keeper) PushHighPriorityAction(ctx sdk.Context, action vm.Action) error
 	return k.pushAction(ctx, StoragePathHighPriorityQueue, action)
 }
 
-func (k Keeper) IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
-	path := StoragePathHighPrioritySenders + "." + addr.String()
+// PushOracleAction appends an action to the controller's oracleQueue, which
+// SwingSet processes ahead of the highPriorityQueue.
+func (k Keeper) PushOracleAction(ctx sdk.Context, action vm.Action) error {
+	return k.pushAction(ctx, StoragePathOracleQueue, action)
+}
+
+// IsOracleOperator reports whether addr is in the oracle_operators param, and
+// may therefore submit MsgOraclePush.
+func (k Keeper) IsOracleOperator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	address := addr.String()
+	for _, operator := range k.GetParams(ctx).OracleOperators {
+		if operator == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetOraclePushFee returns the fixed fee of a transaction of oracle pushes.
+func (k Keeper) GetOraclePushFee(ctx sdk.Context) sdk.Coins {
+	return k.GetParams(ctx).OraclePushFee
+}
+
+// AdmitOraclePush counts a MsgOraclePush against the oracle_pushes_per_block
+// param, and reports whether it is admitted in the current block.
+func (k Keeper) AdmitOraclePush(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	height := uint64(ctx.BlockHeight())
+	var count uint64
+	if bz := store.Get([]byte(oraclePushCountKey)); len(bz) == 16 && sdk.BigEndianToUint64(bz[:8]) == height {
+		count = sdk.BigEndianToUint64(bz[8:])
+	}
+	if count >= k.GetParams(ctx).OraclePushesPerBlock {
+		return false
+	}
+	bz := append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(count+1)...)
+	store.Set([]byte(oraclePushCountKey), bz)
+	return true
+}
+
+// IsPrioritySender reports whether the messages of addr are admitted to the
+// highPriorityQueue, because it is either in the priority_senders param or
+// recorded by SwingSet under the highPrioritySenders vstorage path.
+func (k Keeper) IsPrioritySender(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+	address := addr.String()
+	for _, sender := range k.GetParams(ctx).PrioritySenders {
+		if sender == address {
+			return true, nil
+		}
+	}
+	path := StoragePathHighPrioritySenders + "." + address
 	return k.vstorageKeeper.HasEntry(ctx, path), nil
 }
 
+// GetStoragePrioritySenders returns the priority senders recorded by SwingSet
+// under the highPrioritySenders vstorage path.
+func (k Keeper) GetStoragePrioritySenders(ctx sdk.Context) []string {
+	return k.vstorageKeeper.GetChildren(ctx, StoragePathHighPrioritySenders).Children
+}
+
 // GetSmartWalletState returns the provision state of the smart wallet for the account address
 func (k Keeper) GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) types.SmartWalletState {
 	// walletStoragePath is path of `walletStorageNode` constructed in
@@ -173,6 +325,12 @@ func (k Keeper) GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) types.
 func (k Keeper) InboundQueueLength(ctx sdk.Context) (int32, error) {
 	size := sdk.NewInt(0)
 
+	oracleQueueLength, err := k.vstorageKeeper.GetQueueLength(ctx, StoragePathOracleQueue)
+	if err != nil {
+		return 0, err
+	}
+	size = size.Add(oracleQueueLength)
+
 	highPriorityQueueLength, err := k.vstorageKeeper.GetQueueLength(ctx, StoragePathHighPriorityQueue)
 	if err != nil {
 		return 0, err
@@ -185,6 +343,14 @@ func (k Keeper) InboundQueueLength(ctx sdk.Context) (int32, error) {
 	}
 	size = size.Add(actionQueueLength)
 
+	for _, lane := range types.InboundLaneNames {
+		laneLength, err := k.vstorageKeeper.GetQueueLength(ctx, inboundLanePath(lane))
+		if err != nil {
+			return 0, err
+		}
+		size = size.Add(laneLength)
+	}
+
 	if !size.IsInt64() {
 		return 0, fmt.Errorf("inbound queue size too big: %s", size)
 	}
@@ -234,7 +400,7 @@ func (k Keeper) UpdateQueueAllowed(ctx sdk.Context) error {
 // by SwingSet to perform block lifecycle events (BEGIN_BLOCK, END_BLOCK,
 // COMMIT_BLOCK).
 func (k Keeper) BlockingSend(ctx sdk.Context, action vm.Action) (string, error) {
-	action, err := populateAction(ctx, action)
+	action, err := k.populateAction(ctx, action)
 	if err != nil {
 		return "", err
 	}
@@ -245,6 +411,29 @@ func (k Keeper) BlockingSend(ctx sdk.Context, action vm.Action) (string, error)
 	return k.callToController(ctx, string(bz))
 }
 
+// GetQuantizedBlockTime returns the Unix time of the current block as
+// delivered to SwingSet, rounded down to the block_time_quantum_seconds
+// parameter, but no earlier than the time last delivered, since SwingSet's
+// timer requires time to be monotonic even when the quantum is raised.
+func (k Keeper) GetQuantizedBlockTime(ctx sdk.Context) int64 {
+	blockTime := k.GetParams(ctx).QuantizeBlockTime(ctx.BlockTime())
+	if last := k.GetState(ctx).LastBlockTime; blockTime < last {
+		return last
+	}
+	return blockTime
+}
+
+// SetLastBlockTime records blockTime as the block time last delivered to
+// SwingSet.
+func (k Keeper) SetLastBlockTime(ctx sdk.Context, blockTime int64) {
+	state := k.GetState(ctx)
+	if state.LastBlockTime == blockTime {
+		return
+	}
+	state.LastBlockTime = blockTime
+	k.SetState(ctx, state)
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	// Note the use of "IfExists"...
 	// migration fills in missing data with defaults,
@@ -313,6 +502,63 @@ func (k Keeper) ChargeBeans(
 	addr sdk.AccAddress,
 	beans sdkmath.Uint,
 ) error {
+	feeCoins, remainderOwing := k.debitBeans(ctx, beansPerUnit, addr, beans)
+	if !feeCoins.IsZero() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, k.feeCollectorName, feeCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Record the new owing value, whether we have debited immediately or not
+	// (i.e. there is more owing than before, but not enough to debit).
+	k.SetBeansOwing(ctx, addr, remainderOwing)
+	return nil
+}
+
+// ChargeGrantedBeans charges granter the given number of beans on behalf of
+// grantee, as allowed by the x/feegrant allowance of granter to grantee for
+// msgs.  The beans accrue to the beansOwing of granter, and each debit is
+// deducted from the allowance, so that once it is exhausted the charge fails
+// and nothing is debited from either account.
+func (k Keeper) ChargeGrantedBeans(
+	ctx sdk.Context,
+	beansPerUnit map[string]sdkmath.Uint,
+	granter, grantee sdk.AccAddress,
+	beans sdkmath.Uint,
+	msgs []sdk.Msg,
+) error {
+	if k.feegrantKeeper == nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	feeCoins, remainderOwing := k.debitBeans(ctx, beansPerUnit, granter, beans)
+
+	// Use the allowance even when nothing is debited, so that an expired grant
+	// or one that does not cover msgs is refused.
+	err := k.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, feeCoins, msgs)
+	if err != nil {
+		return sdkioerrors.Wrapf(err, "%s does not allow %s to pay admission fees", granter, grantee)
+	}
+	if !feeCoins.IsZero() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, granter, k.feeCollectorName, feeCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetBeansOwing(ctx, granter, remainderOwing)
+	return nil
+}
+
+// debitBeans adds beans to the beansOwing of addr, returning the fee to debit
+// immediately and the beans that remain owing.
+func (k Keeper) debitBeans(
+	ctx sdk.Context,
+	beansPerUnit map[string]sdkmath.Uint,
+	addr sdk.AccAddress,
+	beans sdkmath.Uint,
+) (sdk.Coins, sdkmath.Uint) {
 	wasOwing := k.GetBeansOwing(ctx, addr)
 	nowOwing := wasOwing.Add(beans)
 
@@ -322,26 +568,32 @@ func (k Keeper) ChargeBeans(
 	remainderOwing := nowOwing.Mod(beansPerMinFeeDebit)
 	beansToDebit := nowOwing.Sub(remainderOwing)
 
-	// Convert the debit to coins.
-	beansPerFeeUnitDec := sdk.NewDecFromBigInt(beansPerUnit[types.BeansPerFeeUnit].BigInt())
-	beansToDebitDec := sdk.NewDecFromBigInt(beansToDebit.BigInt())
-	feeUnitPrice := k.GetParams(ctx).FeeUnitPrice
-	feeDecCoins := sdk.NewDecCoinsFromCoins(feeUnitPrice...).MulDec(beansToDebitDec).QuoDec(beansPerFeeUnitDec)
-
 	// Charge the account immediately if they owe more than BeansPerMinFeeDebit.
 	// NOTE: We assume that BeansPerMinFeeDebit is a multiple of BeansPerFeeUnit.
+	feeCoins := beansToFee(beansPerUnit, k.GetParams(ctx).FeeUnitPrice, beansToDebit)
+	return feeCoins, remainderOwing
+}
+
+// beansToFee converts beans to coins at the given fee unit price, truncating
+// any fractional amounts.
+func beansToFee(beansPerUnit map[string]sdkmath.Uint, feeUnitPrice sdk.Coins, beans sdkmath.Uint) sdk.Coins {
+	beansPerFeeUnitDec := sdk.NewDecFromBigInt(beansPerUnit[types.BeansPerFeeUnit].BigInt())
+	beansDec := sdk.NewDecFromBigInt(beans.BigInt())
+	feeDecCoins := sdk.NewDecCoinsFromCoins(feeUnitPrice...).MulDec(beansDec).QuoDec(beansPerFeeUnitDec)
 	feeCoins, _ := feeDecCoins.TruncateDecimal()
-	if !feeCoins.IsZero() {
-		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, k.feeCollectorName, feeCoins)
-		if err != nil {
-			return err
-		}
-	}
+	return feeCoins
+}
 
-	// Record the new owing value, whether we have debited immediately or not
-	// (i.e. there is more owing than before, but not enough to debit).
-	k.SetBeansOwing(ctx, addr, remainderOwing)
-	return nil
+// GetSmartWalletProvisionFee returns the fee that ChargeForSmartWallet charges
+// for provisioning a smart wallet.
+func (k Keeper) GetSmartWalletProvisionFee(ctx sdk.Context) sdk.Coins {
+	beansPerUnit := k.GetBeansPerUnit(ctx)
+	beans, hasBeans := beansPerUnit[types.BeansPerSmartWalletProvision]
+	beansPerFeeUnit, hasFeeUnit := beansPerUnit[types.BeansPerFeeUnit]
+	if !hasBeans || !hasFeeUnit || beansPerFeeUnit.IsZero() {
+		return sdk.NewCoins()
+	}
+	return beansToFee(beansPerUnit, k.GetParams(ctx).FeeUnitPrice, beans)
 }
 
 // ChargeForSmartWallet charges the fee for provisioning a smart wallet.
@@ -422,6 +674,57 @@ func (k Keeper) ChargeForProvisioning(ctx sdk.Context, submitter sdk.AccAddress,
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fees)
 }
 
+// ProvisionOnDeposit enqueues the automatic provisioning of a smart wallet for
+// addr, at the expense of the provision pool, if it has none yet and amt
+// reaches the auto_provision_min_deposit param.  It reports whether it did.
+// As with the auto-provisioning of wallet actions, addr is charged the smart
+// wallet provisioning fee, so that deposits bounced through fresh addresses
+// cannot drain the provision pool.  The provisioning is enqueued only once,
+// and is not retried on later deposits until AutoProvisionExpiryBlocks have
+// passed; should it fail, the wallet can still be provisioned by a wallet
+// action or a MsgProvision.
+func (k Keeper) ProvisionOnDeposit(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (bool, error) {
+	minDeposit := k.GetParams(ctx).AutoProvisionMinDeposit
+	qualifies := false
+	for _, coin := range amt {
+		if threshold := minDeposit.AmountOf(coin.Denom); threshold.IsPositive() && coin.Amount.GTE(threshold) {
+			qualifies = true
+			break
+		}
+	}
+	if !qualifies {
+		return false, nil
+	}
+	if k.GetSmartWalletState(ctx, addr) == types.SmartWalletStateProvisioned {
+		k.deleteAutoProvision(ctx, addr)
+		return false, nil
+	}
+	if k.hasAutoProvision(ctx, addr) {
+		return false, nil
+	}
+
+	// Charge and enqueue together, so that neither outlives a failure of the
+	// other.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ChargeForSmartWallet(cacheCtx, k.GetBeansPerUnit(cacheCtx), addr); err != nil {
+		return false, err
+	}
+	action := provisionAction{
+		MsgProvision: &types.MsgProvision{
+			Address:    addr,
+			Submitter:  addr,
+			PowerFlags: []string{types.PowerFlagSmartWallet},
+		},
+		AutoProvision: true,
+	}
+	if err := k.PushAction(cacheCtx, action); err != nil {
+		return false, err
+	}
+	k.SetAutoProvision(cacheCtx, types.AutoProvisionRecord{Address: addr.String(), Height: ctx.BlockHeight()})
+	writeCache()
+	return true, nil
+}
+
 // GetEgress gets the entire egress struct for a peer
 func (k Keeper) GetEgress(ctx sdk.Context, addr sdk.AccAddress) types.Egress {
 	path := StoragePathEgress + "." + addr.String()
@@ -467,6 +770,48 @@ func (k Keeper) SetEgress(ctx sdk.Context, egress *types.Egress) error {
 	return nil
 }
 
+// GetEgressesPage returns one page of the provisioned egresses, in order of
+// peer address.
+func (k Keeper) GetEgressesPage(ctx sdk.Context, pageReq *query.PageRequest) ([]types.Egress, *query.PageResponse, error) {
+	children, pageRes, err := k.vstorageKeeper.GetChildrenPage(ctx, StoragePathEgress, pageReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	egresses := make([]types.Egress, 0, len(children.Children))
+	for _, child := range children.Children {
+		entry := k.vstorageKeeper.GetEntry(ctx, StoragePathEgress+"."+child)
+		if !entry.HasValue() {
+			continue
+		}
+		var egress types.Egress
+		if err := json.Unmarshal([]byte(entry.StringValue()), &egress); err != nil {
+			return nil, nil, err
+		}
+		egresses = append(egresses, egress)
+	}
+	return egresses, pageRes, nil
+}
+
+type revokeEgressAction struct {
+	*vm.ActionHeader `actionType:"REVOKE_EGRESS"`
+	Peer             string `json:"peer"`
+}
+
+// RevokeEgress removes the egress and the mailbox of peer, and tells the
+// controller to stop transmitting to it, returning an error if it has no
+// egress. The account of peer is left alone.
+func (k Keeper) RevokeEgress(ctx sdk.Context, peer sdk.AccAddress) error {
+	path := StoragePathEgress + "." + peer.String()
+	if !k.vstorageKeeper.GetEntry(ctx, path).HasValue() {
+		return sdkioerrors.Wrapf(sdkerrors.ErrNotFound, "no egress for %s", peer)
+	}
+	// FIXME: We should use just SetStorageAndNotify here, but solo needs legacy for now.
+	k.vstorageKeeper.LegacySetStorageAndNotify(ctx, agoric.NewKVEntryWithNoValue(path))
+	mailboxPath := StoragePathMailbox + "." + peer.String()
+	k.vstorageKeeper.LegacySetStorageAndNotify(ctx, agoric.NewKVEntryWithNoValue(mailboxPath))
+	return k.PushAction(ctx, revokeEgressAction{Peer: peer.String()})
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -486,7 +831,12 @@ func (k Keeper) SetMailbox(ctx sdk.Context, peer string, mailbox string) {
 }
 
 func (k Keeper) GetSwingStore(ctx sdk.Context) sdk.KVStore {
-	store := ctx.KVStore(k.storeKey)
+	return NewSwingStoreFromModuleStore(ctx.KVStore(k.storeKey))
+}
+
+// NewSwingStoreFromModuleStore returns the swing-store "export data" within the
+// swingset module's KVStore, for readers of the store outside of the app.
+func NewSwingStoreFromModuleStore(store sdk.KVStore) sdk.KVStore {
 	return prefix.NewStore(store, []byte(swingStoreKeyPrefix))
 }
 
@@ -1,16 +1,30 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	prefixstore "github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -28,6 +42,20 @@ var (
 	utilAddr   = sdk.AccAddress([]byte("addr"))
 )
 
+// mountTestVstorageKeeper mounts the stores of a vstorage keeper in ms, which
+// the caller then loads.
+func mountTestVstorageKeeper(ms store.CommitMultiStore, db dbm.DB) vstoragekeeper.Keeper {
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey("vstorage_params")
+	paramsTStoreKey := storetypes.NewTransientStoreKey("transient_vstorage_params")
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(),
+		paramsStoreKey, paramsTStoreKey, vstoragetypes.ModuleName)
+	return vstoragekeeper.NewKeeper(vstorageStoreKey, paramSpace)
+}
+
 func Test_calculateFees(t *testing.T) {
 	type args struct {
 		balances        sdk.Coins
@@ -212,6 +240,32 @@ func makeTestStore() sdk.KVStore {
 	return prefixStore
 }
 
+func makeTestParamsKeeper(t *testing.T, params types.Params) (Keeper, sdk.Context) {
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	vstorageKeeper := mountTestVstorageKeeper(ms, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	k := Keeper{
+		storeKey:       swingsetStoreKey,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		vstorageKeeper: vstorageKeeper,
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 7}, false, log.NewNopLogger())
+	k.SetParams(ctx, params)
+	return k, ctx
+}
+
 func TestSwingStore(t *testing.T) {
 	store := makeTestStore()
 
@@ -264,3 +318,1204 @@ func TestSwingStore(t *testing.T) {
 		t.Errorf("got export %q, want %q", gotEntries, expectedEntries)
 	}
 }
+
+func TestReadVatsStatus(t *testing.T) {
+	store := makeTestStore()
+
+	got, err := readVatsStatus(store)
+	if err != nil {
+		t.Fatalf("unexpected error on empty store: %v", err)
+	}
+	if len(got.Vats) != 0 || got.RunQueueLength != 0 || got.AcceptanceQueueLength != 0 {
+		t.Errorf("got %+v, want empty status", got)
+	}
+
+	entries := map[string]string{
+		"kv.vat.names":          `["bootstrap","comms"]`,
+		"kv.vat.name.bootstrap": "v1",
+		"kv.vat.name.comms":     "v2",
+		"kv.vat.dynamicIDs":     `["v9"]`,
+		"kv.v9.options":         `{"name":"zoe","workerOptions":{"type":"xsnap"}}`,
+		"kv.runQueue":           "[3,7]",
+		"kv.acceptanceQueue":    "[10,10]",
+		"transcript.v1.current": `{"vatID":"v1","startPos":0,"endPos":5,"incarnation":0,"isCurrent":1}`,
+		"transcript.v9.current": `{"vatID":"v9","startPos":40,"endPos":52,"incarnation":2,"isCurrent":1}`,
+		"snapshot.v9.40":        `{"vatID":"v9","snapPos":40,"hash":"abc","inUse":1}`,
+		"snapshot.v9.current":   "snapshot.v9.40",
+	}
+	for key, value := range entries {
+		store.Set([]byte(key), []byte(value))
+	}
+
+	got, err = readVatsStatus(store)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedVats := []types.VatStatus{
+		{VatID: "v1", Name: "bootstrap"},
+		{VatID: "v2", Name: "comms"},
+		{VatID: "v9", Name: "zoe", Incarnation: 2, SnapshotPos: 40},
+	}
+	if !reflect.DeepEqual(got.Vats, expectedVats) {
+		t.Errorf("got vats %+v, want %+v", got.Vats, expectedVats)
+	}
+	if got.RunQueueLength != 4 {
+		t.Errorf("got run queue length %d, want 4", got.RunQueueLength)
+	}
+	if got.AcceptanceQueueLength != 0 {
+		t.Errorf("got acceptance queue length %d, want 0", got.AcceptanceQueueLength)
+	}
+
+	store.Set([]byte("kv.runQueue"), []byte("[7,3]"))
+	if _, err := readVatsStatus(store); err == nil {
+		t.Errorf("expected error for inverted run queue bounds")
+	}
+}
+
+func TestRefillBucket(t *testing.T) {
+	bucket := func(tokens uint64, height int64) types.RateLimitBucket {
+		return types.RateLimitBucket{Tokens: tokens, LastRefillHeight: height}
+	}
+	for _, tt := range []struct {
+		name   string
+		bucket types.RateLimitBucket
+		height int64
+		want   types.RateLimitBucket
+	}{
+		{name: "same-block", bucket: bucket(0, 10), height: 10, want: bucket(0, 10)},
+		{name: "partial-token", bucket: bucket(0, 10), height: 12, want: bucket(0, 10)},
+		{name: "one-token", bucket: bucket(0, 10), height: 13, want: bucket(1, 13)},
+		{name: "keep-remainder", bucket: bucket(1, 10), height: 17, want: bucket(3, 16)},
+		{name: "fill-to-capacity", bucket: bucket(1, 10), height: 100, want: bucket(5, 100)},
+		{name: "exactly-full", bucket: bucket(2, 10), height: 19, want: bucket(5, 19)},
+		{name: "lowered-capacity", bucket: bucket(8, 10), height: 11, want: bucket(5, 11)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := refillBucket(tt.bucket, tt.height, 5, 3)
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPruneRateLimitBuckets(t *testing.T) {
+	params := types.DefaultParams()
+	params.WalletSpendActionRateLimit = []types.UintMapEntry{
+		{Key: types.RateLimitCapacity, Value: sdk.NewUint(2)},
+		{Key: types.RateLimitBlocksPerToken, Value: sdk.NewUint(3)},
+	}
+	k, ctx := makeTestParamsKeeper(t, params)
+
+	for i := 0; i < 2; i++ {
+		if ok, err := k.ConsumeWalletSpendActionToken(ctx, submitAddr); err != nil || !ok {
+			t.Fatalf("token %d got %v, %v; want true, nil", i, ok, err)
+		}
+	}
+	if ok, err := k.ConsumeWalletSpendActionToken(ctx, submitAddr); err != nil || ok {
+		t.Fatalf("exhausted bucket got %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := k.ConsumeWalletSpendActionToken(ctx.WithBlockHeight(ctx.BlockHeight()+5), utilAddr); err != nil || !ok {
+		t.Fatalf("other address got %v, %v; want true, nil", ok, err)
+	}
+
+	records := k.GetRateLimitBuckets(ctx)
+	if len(records) != 2 {
+		t.Fatalf("got %d buckets, want 2", len(records))
+	}
+	fullHeight := ctx.BlockHeight() + 6
+	for _, record := range records {
+		if record.Address == submitAddr.String() && record.Bucket.FullHeight != fullHeight {
+			t.Errorf("got full height %d, want %d", record.Bucket.FullHeight, fullHeight)
+		}
+	}
+
+	k.PruneRateLimitBuckets(ctx.WithBlockHeight(fullHeight - 1))
+	if got := k.GetRateLimitBuckets(ctx); len(got) != 2 {
+		t.Fatalf("got %d buckets before full height, want 2", len(got))
+	}
+	k.PruneRateLimitBuckets(ctx.WithBlockHeight(fullHeight))
+	got := k.GetRateLimitBuckets(ctx)
+	if len(got) != 1 || got[0].Address != utilAddr.String() {
+		t.Fatalf("got buckets %+v, want only %s", got, utilAddr)
+	}
+
+	// Reusing a bucket moves its expiry.
+	later := ctx.WithBlockHeight(fullHeight + 10)
+	if ok, err := k.ConsumeWalletSpendActionToken(later, utilAddr); err != nil || !ok {
+		t.Fatalf("refilled bucket got %v, %v; want true, nil", ok, err)
+	}
+	k.PruneRateLimitBuckets(later)
+	got = k.GetRateLimitBuckets(ctx)
+	if len(got) != 1 || got[0].Bucket.FullHeight != later.BlockHeight()+3 {
+		t.Fatalf("got buckets %+v, want one full at %d", got, later.BlockHeight()+3)
+	}
+
+	// An imported bucket is pruned like any other.
+	k2, ctx2 := makeTestParamsKeeper(t, params)
+	for _, record := range got {
+		if err := k2.SetRateLimitBucket(ctx2, sdk.MustAccAddressFromBech32(record.Address), record.Bucket); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if exported := k2.GetRateLimitBuckets(ctx2); !reflect.DeepEqual(exported, got) {
+		t.Errorf("got reimported buckets %+v, want %+v", exported, got)
+	}
+	k2.PruneRateLimitBuckets(ctx2.WithBlockHeight(later.BlockHeight() + 3))
+	if iter := ctx2.KVStore(swingsetStoreKey).Iterator(nil, nil); iter.Valid() {
+		t.Errorf("got leftover rate limit state %q", iter.Key())
+	}
+}
+
+func Test_beansToFee(t *testing.T) {
+	beansPerUnit := map[string]sdk.Uint{
+		types.BeansPerFeeUnit: sdk.NewUint(1000),
+	}
+	feeUnitPrice := cns(a(3), b(10))
+	for _, tt := range []struct {
+		name  string
+		beans sdk.Uint
+		want  sdk.Coins
+	}{
+		{name: "zero", beans: sdk.NewUint(0), want: cns()},
+		{name: "one fee unit", beans: sdk.NewUint(1000), want: cns(a(3), b(10))},
+		{name: "truncated", beans: sdk.NewUint(500), want: cns(a(1), b(5))},
+		{name: "too small", beans: sdk.NewUint(50), want: cns()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := beansToFee(beansPerUnit, feeUnitPrice, tt.beans)
+			if !got.IsEqual(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func makeTestBundleUploadKeeper() (Keeper, sdk.Context) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(swingsetStoreKey, storetypes.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		panic(err)
+	}
+	k := Keeper{
+		storeKey: swingsetStoreKey,
+		cdc:      codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	return k, ctx
+}
+
+func TestAddBundleChunk(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	bundle := types.NewMsgInstallBundle(`{"moduleFormat":"endoZipBase64"}`, submitAddr)
+	if err := bundle.Compress(); err != nil {
+		t.Fatal(err)
+	}
+	compressed := bundle.CompressedBundle
+	hash := types.BundleHash(compressed)
+	split := len(compressed) / 2
+	chunks := [][]byte{compressed[:split], compressed[split:]}
+	mkChunk := func(i uint64, chunk []byte) *types.MsgInstallBundleChunk {
+		return types.NewMsgInstallBundleChunk(hash, i, 2, chunk, bundle.UncompressedSize, submitAddr)
+	}
+
+	got, err := k.AddBundleChunk(ctx, mkChunk(1, []byte("garbage")))
+	if err != nil || got != nil {
+		t.Fatalf("first chunk got %v, %v; want nil, nil", got, err)
+	}
+	_, err = k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(hash, 0, 3, chunks[0], bundle.UncompressedSize, submitAddr))
+	if err == nil {
+		t.Errorf("wanted error for mismatched total chunks")
+	}
+	// A failed transaction discards its state changes.
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.AddBundleChunk(cacheCtx, mkChunk(0, chunks[0]))
+	if err == nil {
+		t.Fatalf("wanted error for mismatched hash")
+	}
+
+	// Correcting the bad chunk allows the upload to complete.
+	got, err = k.AddBundleChunk(ctx, mkChunk(1, chunks[1]))
+	if err != nil || got != nil {
+		t.Fatalf("resubmitted chunk got %v, %v; want nil, nil", got, err)
+	}
+	got, err = k.AddBundleChunk(ctx, mkChunk(0, chunks[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil {
+		t.Fatalf("got nil bundle, want a complete upload")
+	}
+	if !bytes.Equal(got.CompressedBundle, compressed) || got.UncompressedSize != bundle.UncompressedSize {
+		t.Errorf("got bundle %+v, want %+v", got, bundle)
+	}
+	if iter := ctx.KVStore(swingsetStoreKey).Iterator(nil, nil); iter.Valid() {
+		t.Errorf("got leftover upload state %q", iter.Key())
+	}
+}
+
+func TestPruneExpiredBundleUploads(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	hash := types.BundleHash([]byte("compressed"))
+	_, err := k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), 100, submitAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiryHeight := ctx.BlockHeight() + BundleUploadExpiryBlocks
+	k.PruneExpiredBundleUploads(ctx.WithBlockHeight(expiryHeight - 1))
+	if iter := ctx.KVStore(swingsetStoreKey).Iterator(nil, nil); !iter.Valid() {
+		t.Fatalf("upload state pruned before expiry")
+	}
+	k.PruneExpiredBundleUploads(ctx.WithBlockHeight(expiryHeight))
+	if iter := ctx.KVStore(swingsetStoreKey).Iterator(nil, nil); iter.Valid() {
+		t.Errorf("got leftover upload state %q", iter.Key())
+	}
+}
+
+func TestPruneInvalidBundleUpload(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	hash := types.BundleHash([]byte("compressed"))
+	_, err := k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), 100, submitAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	uploadKey := bundleUploadKey(submitAddr, hash)
+	k.getBundleUploadStore(ctx, bundleUploadKeyPrefix).Set(uploadKey, []byte("garbage"))
+
+	// The undecodable upload is skipped without halting the chain, and only
+	// once.
+	expiryHeight := ctx.BlockHeight() + BundleUploadExpiryBlocks
+	k.PruneExpiredBundleUploads(ctx.WithBlockHeight(expiryHeight))
+	if iter := k.getBundleUploadStore(ctx, bundleUploadExpiryKeyPrefix).Iterator(nil, nil); iter.Valid() {
+		t.Errorf("got leftover expiry %q", iter.Key())
+	}
+}
+
+func TestMaxBundleUploadsPerSubmitter(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	for i := 0; i < MaxBundleUploadsPerSubmitter; i++ {
+		hash := types.BundleHash([]byte(fmt.Sprintf("compressed%d", i)))
+		if _, err := k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), 100, submitAddr)); err != nil {
+			t.Fatalf("upload %d got error = %v", i, err)
+		}
+	}
+
+	extraHash := types.BundleHash([]byte("extra"))
+	extra := types.NewMsgInstallBundleChunk(extraHash, 0, 2, []byte("comp"), 100, submitAddr)
+	if _, err := k.AddBundleChunk(ctx, extra); err == nil {
+		t.Errorf("wanted error for too many uploads in progress")
+	}
+
+	// Uploads in progress may continue, and other submitters are unaffected.
+	firstHash := types.BundleHash([]byte("compressed0"))
+	if _, err := k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(firstHash, 1, 2, []byte("ressed0"), 100, submitAddr)); err != nil {
+		t.Errorf("continuing upload got error = %v", err)
+	}
+	if _, err := k.AddBundleChunk(ctx, types.NewMsgInstallBundleChunk(extraHash, 0, 2, []byte("comp"), 100, utilAddr)); err != nil {
+		t.Errorf("other submitter got error = %v", err)
+	}
+
+	// Expired uploads no longer count.
+	k.PruneExpiredBundleUploads(ctx.WithBlockHeight(ctx.BlockHeight() + BundleUploadExpiryBlocks))
+	if _, err := k.AddBundleChunk(ctx, extra); err != nil {
+		t.Errorf("upload after expiry got error = %v", err)
+	}
+}
+
+func TestUpgradeStepDone(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	if got := k.GetUpgradeStepDoneHeight(ctx, "v1/step"); got != 0 {
+		t.Errorf("got done height %d before the step was done, want 0", got)
+	}
+	k.SetUpgradeStepDone(ctx, "v1/step")
+	if got := k.GetUpgradeStepDoneHeight(ctx, "v1/step"); got != ctx.BlockHeight() {
+		t.Errorf("got done height %d, want %d", got, ctx.BlockHeight())
+	}
+	if got := k.GetUpgradeStepDoneHeight(ctx, "v1/step2"); got != 0 {
+		t.Errorf("got done height %d for another step, want 0", got)
+	}
+}
+
+func TestUpgradeStepRecords(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	if got := k.GetUpgradeSteps(ctx); len(got) != 0 {
+		t.Errorf("got upgrade steps %v before any was done, want none", got)
+	}
+	k.SetUpgradeStepDone(ctx, "v2/step")
+	k.SetUpgradeStep(ctx, types.UpgradeStepRecord{Name: "v1/step", DoneHeight: 3})
+
+	want := []types.UpgradeStepRecord{
+		{Name: "v1/step", DoneHeight: 3},
+		{Name: "v2/step", DoneHeight: ctx.BlockHeight()},
+	}
+	if got := k.GetUpgradeSteps(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("got upgrade steps %v, want %v", got, want)
+	}
+	if got := k.GetUpgradeStepDoneHeight(ctx, "v1/step"); got != 3 {
+		t.Errorf("got done height %d of an imported step, want 3", got)
+	}
+}
+
+func Test_parseInstallationResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    installationResult
+		wantErr bool
+	}{
+		{
+			name:  "installed",
+			value: `{"body":"#{\"endoZipBase64Sha512\":\"abc\",\"error\":null,\"installed\":true}","slots":[]}`,
+			want:  installationResult{EndoZipBase64Sha512: "abc", Installed: true},
+		},
+		{
+			name:  "rejected",
+			value: `{"body":"#{\"endoZipBase64Sha512\":\"abc\",\"error\":{\"#error\":\"bad bundle\",\"name\":\"Error\"},\"installed\":false}","slots":[]}`,
+			want: installationResult{
+				EndoZipBase64Sha512: "abc",
+				Error: &struct {
+					Message string `json:"#error"`
+				}{Message: "bad bundle"},
+			},
+		},
+		{
+			name:    "legacy capdata",
+			value:   `{"body":"{\"installed\":true}","slots":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "not capdata",
+			value:   `"installed"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseInstallationResult(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInstallationResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseInstallationResult() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseBundleHash(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		bundle string
+		want   string
+	}{
+		{name: "hash last", bundle: `{"moduleFormat":"endoZipBase64","endoZipBase64":"UEsDBA==","endoZipBase64Sha512":"abc"}`, want: "abc"},
+		{name: "hash first", bundle: `{ "endoZipBase64Sha512" : "abc", "endoZipBase64": "UEsDBA==" }`, want: "abc"},
+		{name: "escaped key", bundle: `{"endoZipBase64Sha\u003512":"abc"}`, want: "abc"},
+		{name: "duplicate key", bundle: `{"endoZipBase64Sha512":"abc","endoZipBase64Sha512":"def"}`, want: "def"},
+		{name: "nested", bundle: `{"meta":{"endoZipBase64Sha512":"abc"},"list":["endoZipBase64Sha512"]}`},
+		{name: "key in value", bundle: `{"note":"\"endoZipBase64Sha512\":\"abc\"","other":"endoZipBase64Sha512"}`},
+		{name: "case variant", bundle: `{"ENDOZIPBASE64SHA512":"abc"}`},
+		{name: "not a string", bundle: `{"endoZipBase64Sha512":42}`},
+		{name: "unterminated", bundle: `{"endoZipBase64Sha512":"abc`},
+		{name: "not json", bundle: `endoZipBase64Sha512`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseBundleHash(tt.bundle); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateBundleInstallations(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+
+	cell := vstoragekeeper.StreamCell{
+		BlockHeight: fmt.Sprint(ctx.BlockHeight()),
+		Values: []string{
+			`{"body":"{\"installed\":true}","slots":[]}`,
+			`{"body":"#{\"endoZipBase64Sha512\":\"abc\",\"error\":null,\"installed\":true}","slots":[]}`,
+		},
+	}
+	bz, err := json.Marshal(cell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	GetVstorageKeeper(t, k).SetStorage(ctx, agoric.NewKVEntry(StoragePathBundles, string(bz)))
+
+	if err := k.UpdateBundleInstallations(ctx); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	installation, found := k.GetBundleInstallation(ctx, "abc")
+	if !found || installation.Status != types.BundleStatusInstalled {
+		t.Errorf("got installation %+v, %t after a bad result; want installed", installation, found)
+	}
+
+	GetVstorageKeeper(t, k).SetStorage(ctx, agoric.NewKVEntry(StoragePathBundles, "not a stream cell"))
+	if err := k.UpdateBundleInstallations(ctx); err != nil {
+		t.Errorf("got error = %v for a bad stream cell", err)
+	}
+}
+
+func TestGetActionQueue(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+
+	if err := k.PushAction(ctx, walletSpendAction{Owner: "agoric1alice", SpendAction: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.PushAction(ctx, deliverInboundAction{Peer: "agoric1bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.PushHighPriorityAction(ctx, walletAction{Owner: "agoric1carol", Action: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.PushOracleAction(ctx, oraclePushAction{Owner: "agoric1dave", Feed: "ATOM-USD", UnitPrice: "12010000"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := k.GetActionQueue(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.OracleQueueLength != 1 || res.HighPriorityQueueLength != 1 || res.ActionQueueLength != 2 {
+		t.Errorf("got queue lengths %d, %d, and %d, want 1, 1, and 2", res.OracleQueueLength, res.HighPriorityQueueLength, res.ActionQueueLength)
+	}
+	want := []types.ActionQueueEntry{
+		{Queue: StoragePathOracleQueue, Index: "0", Type: "ORACLE_PUSH", Source: "agoric1dave", BlockHeight: 7, TxHash: "unknown"},
+		{Queue: StoragePathHighPriorityQueue, Index: "0", Type: "WALLET_ACTION", Source: "agoric1carol", BlockHeight: 7, TxHash: "unknown"},
+		{Queue: StoragePathActionQueue, Index: "0", Type: "WALLET_SPEND_ACTION", Source: "agoric1alice", BlockHeight: 7, TxHash: "unknown"},
+		{Queue: StoragePathActionQueue, Index: "1", Type: "DELIVER_INBOUND", Source: "agoric1bob", BlockHeight: 7, TxHash: "unknown"},
+	}
+	if !reflect.DeepEqual(res.Entries, want) {
+		t.Errorf("got entries %+v, want %+v", res.Entries, want)
+	}
+
+	res, err = k.GetActionQueue(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.Entries, want[:2]) {
+		t.Errorf("got limited entries %+v, want %+v", res.Entries, want[:2])
+	}
+}
+
+func TestPushActionQuantizesBlockTime(t *testing.T) {
+	params := types.DefaultParams()
+	params.BlockTimeQuantumSeconds = 60
+	k, ctx := makeTestParamsKeeper(t, params)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_059, 0))
+
+	// Both a defaulted and a preset block time are quantized.
+	if err := k.PushAction(ctx, walletSpendAction{Owner: "agoric1alice", SpendAction: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+	preset := walletSpendAction{ActionHeader: &vm.ActionHeader{BlockTime: 1_000_079}, Owner: "agoric1bob", SpendAction: "{}"}
+	if err := k.PushAction(ctx, preset); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, index := range []string{"0", "1"} {
+		path := StoragePathActionQueue + "." + index
+		var record queuedRecord
+		if err := json.Unmarshal([]byte(k.vstorageKeeper.GetEntry(ctx, path).StringValue()), &record); err != nil {
+			t.Fatal(err)
+		}
+		var blockTime int64
+		if err := json.Unmarshal(record.Action["blockTime"], &blockTime); err != nil {
+			t.Fatal(err)
+		}
+		if blockTime != 1_000_020 {
+			t.Errorf("got %s blockTime %d, want 1000020", path, blockTime)
+		}
+	}
+}
+
+func TestPushActionBlockTimeNotBeforeLastDelivered(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+	ctx = ctx.WithBlockTime(time.Unix(1_000_065, 0))
+	k.SetLastBlockTime(ctx, k.GetQuantizedBlockTime(ctx))
+
+	// Raising the quantum mid-block must not turn back the time of actions.
+	params := k.GetParams(ctx)
+	params.BlockTimeQuantumSeconds = 3600
+	k.SetParams(ctx, params)
+	if got := k.GetQuantizedBlockTime(ctx); got != 1_000_065 {
+		t.Errorf("got quantized block time %d, want 1000065", got)
+	}
+	if err := k.PushAction(ctx, walletSpendAction{Owner: "agoric1alice", SpendAction: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+
+	path := StoragePathActionQueue + ".0"
+	var record queuedRecord
+	if err := json.Unmarshal([]byte(k.vstorageKeeper.GetEntry(ctx, path).StringValue()), &record); err != nil {
+		t.Fatal(err)
+	}
+	var blockTime int64
+	if err := json.Unmarshal(record.Action["blockTime"], &blockTime); err != nil {
+		t.Fatal(err)
+	}
+	if blockTime != 1_000_065 {
+		t.Errorf("got %s blockTime %d, want 1000065", path, blockTime)
+	}
+
+	// Time resumes once the quantized time passes the last one delivered.
+	if got := k.GetQuantizedBlockTime(ctx.WithBlockTime(time.Unix(1_004_000, 0))); got != 1_000_800 {
+		t.Errorf("got later quantized block time %d, want 1000800", got)
+	}
+}
+
+func TestUpdateTimerStatus(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	if got := k.GetTimerStatus(ctx); got != (types.TimerStatus{}) {
+		t.Errorf("got initial status %+v, want empty", got)
+	}
+
+	k.UpdateTimerStatus(ctx, 1_700_000_000, `{"timer":{"pendingWakeups":3,"repeaters":1,"nextWakeup":1700000060}}`)
+	want := types.TimerStatus{
+		LastTimestamp:  1_700_000_000,
+		BlockHeight:    10,
+		PendingWakeups: 3,
+		Repeaters:      1,
+		NextWakeup:     1_700_000_060,
+		Reported:       true,
+	}
+	if got := k.GetTimerStatus(ctx); got != want {
+		t.Errorf("got status %+v, want %+v", got, want)
+	}
+
+	// A reply without stats records only the time.
+	for _, reply := range []string{"null", "{}", "", "not JSON"} {
+		k.UpdateTimerStatus(ctx, 1_700_000_006, reply)
+		want := types.TimerStatus{LastTimestamp: 1_700_000_006, BlockHeight: 10}
+		if got := k.GetTimerStatus(ctx); got != want {
+			t.Errorf("reply %q got status %+v, want %+v", reply, got, want)
+		}
+	}
+}
+
+func TestRememberDelivery(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+	peer := sdk.AccAddress([]byte("peer"))
+	other := sdk.AccAddress([]byte("other"))
+
+	if k.isRememberedDelivery(ctx, peer, []uint64{}, 0) {
+		t.Errorf("remembered a delivery before any")
+	}
+	k.rememberDelivery(ctx, peer, []uint64{1, 2}, 5, 3)
+	ctx = ctx.WithBlockHeight(11)
+	k.rememberDelivery(ctx, peer, []uint64{2, 3}, 4, 3)
+
+	for _, tt := range []struct {
+		peer   sdk.AccAddress
+		nums   []uint64
+		ack    uint64
+		replay bool
+	}{
+		{peer, []uint64{1, 2, 3}, 5, true},
+		{peer, []uint64{}, 4, true},
+		{peer, []uint64{2}, 6, false},
+		{peer, []uint64{3, 4}, 0, false},
+		{other, []uint64{1}, 0, false},
+	} {
+		if got := k.isRememberedDelivery(ctx, tt.peer, tt.nums, tt.ack); got != tt.replay {
+			t.Errorf("delivery of %v with ack %d got replay %t, want %t", tt.nums, tt.ack, got, tt.replay)
+		}
+	}
+
+	// Message 1 expires at height 13, and messages 2 and 3 at height 14.
+	ctx = ctx.WithBlockHeight(13)
+	k.PruneDeliveredInbound(ctx)
+	if k.isRememberedDelivery(ctx, peer, []uint64{1}, 0) {
+		t.Errorf("remembered message 1 after its window")
+	}
+	if !k.isRememberedDelivery(ctx, peer, []uint64{2, 3}, 0) {
+		t.Errorf("forgot messages 2 and 3 before their window")
+	}
+}
+
+func TestUpdatePolicyHeadroom(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+
+	if _, found := k.GetPolicyHeadroom(ctx); found {
+		t.Errorf("got initial headroom")
+	}
+	k.UpdatePolicyHeadroom(ctx, `{"policyHeadroom":"12345"}`)
+	if got, found := k.GetPolicyHeadroom(ctx); !found || got != 12345 {
+		t.Errorf("got headroom %d (%t), want 12345", got, found)
+	}
+
+	// A reply without a valid headroom clears it.
+	for _, reply := range []string{"null", "{}", `{"policyHeadroom":"-1"}`, "", "not JSON"} {
+		k.UpdatePolicyHeadroom(ctx, `{"policyHeadroom":"1"}`)
+		k.UpdatePolicyHeadroom(ctx, reply)
+		if got, found := k.GetPolicyHeadroom(ctx); found {
+			t.Errorf("reply %q got headroom %d, want none", reply, got)
+		}
+	}
+}
+
+func TestUpdateVatMeters(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	vstorageKeeper := mountTestVstorageKeeper(ms, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	k := Keeper{vstorageKeeper: vstorageKeeper}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 7}, false, log.NewNopLogger())
+
+	k.UpdateVatMeters(ctx, `{"vatComputrons":{"v1":"100","v2":"5"}}`)
+	ctx = ctx.WithBlockHeight(8)
+	k.UpdateVatMeters(ctx, `{"vatComputrons":{"v1":"20","v3":"bogus"}}`)
+	// Replies without usage are ignored.
+	for _, reply := range []string{"null", "{}", "", "not JSON"} {
+		k.UpdateVatMeters(ctx, reply)
+	}
+
+	want := map[string]string{
+		"v1": `{"blockHeight":8,"computrons":"20","totalComputrons":"120"}`,
+		"v2": `{"blockHeight":7,"computrons":"5","totalComputrons":"5"}`,
+	}
+	for vatID, value := range want {
+		entry := GetVstorageKeeper(t, k).GetEntry(ctx, VatMeterPath(vatID))
+		if got := entry.StringValue(); got != value {
+			t.Errorf("vat %s got meter %s, want %s", vatID, got, value)
+		}
+	}
+	if GetVstorageKeeper(t, k).HasEntry(ctx, VatMeterPath("v3")) {
+		t.Errorf("got meter for vat with invalid computrons")
+	}
+}
+
+func Test_billVat(t *testing.T) {
+	price := sdk.MustNewDecFromStr("0.0001")
+	record := types.VatOwner{VatID: "v1", Owner: "agoric1owner"}
+
+	// Costs below a whole uist accrue.
+	charge, record := billVat(record, price, sdk.NewUint(15_000), sdk.NewInt(10))
+	if !charge.Equal(sdk.NewInt(1)) || record.Suspended {
+		t.Errorf("got charge %s, suspended %t; want 1uist, not suspended", charge, record.Suspended)
+	}
+	if want := sdk.MustNewDecFromStr("0.5"); !record.Unbilled.Equal(want) {
+		t.Errorf("got record %+v, want unbilled %s", record, want)
+	}
+
+	// An owner that cannot pay is charged its balance, and owes the rest,
+	// suspending the vat.
+	charge, record = billVat(record, price, sdk.NewUint(100_000), sdk.NewInt(4))
+	if !charge.Equal(sdk.NewInt(4)) || !record.Suspended {
+		t.Errorf("got charge %s, suspended %t; want 4uist, suspended", charge, record.Suspended)
+	}
+	if want := sdk.MustNewDecFromStr("6.5"); !record.Unbilled.Equal(want) {
+		t.Errorf("got record %+v, want unbilled %s", record, want)
+	}
+
+	// The debt is charged once the owner can pay, resuming the vat.
+	charge, record = billVat(record, price, sdk.NewUint(0), sdk.NewInt(100))
+	if !charge.Equal(sdk.NewInt(6)) || record.Suspended {
+		t.Errorf("got charge %s, suspended %t; want 6uist, not suspended", charge, record.Suspended)
+	}
+	if want := sdk.MustNewDecFromStr("0.5"); !record.Unbilled.Equal(want) {
+		t.Errorf("got record %+v, want unbilled %s", record, want)
+	}
+}
+
+// billingBankKeeper holds the spendable balances of accounts, refusing to
+// charge those in failing.
+type billingBankKeeper struct {
+	bankkeeper.Keeper
+	balances map[string]sdk.Coins
+	failing  map[string]bool
+	sent     []string
+}
+
+func (b *billingBankKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *billingBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if b.failing[senderAddr.String()] {
+		return fmt.Errorf("cannot send from %s", senderAddr)
+	}
+	b.sent = append(b.sent, fmt.Sprintf("%s %s %s", senderAddr, recipientModule, amt))
+	return nil
+}
+
+// queuedActionTypes returns the types of the actions in the
+// highPriorityQueue.
+func queuedActionTypes(t *testing.T, k Keeper, ctx sdk.Context) []string {
+	res, err := k.GetActionQueue(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actionTypes := []string{}
+	for _, entry := range res.Entries {
+		if entry.Queue == StoragePathHighPriorityQueue {
+			actionTypes = append(actionTypes, entry.Type)
+		}
+	}
+	return actionTypes
+}
+
+func TestBillVats(t *testing.T) {
+	params := types.DefaultParams()
+	params.ComputronPriceUist = sdk.MustNewDecFromStr("0.01")
+	k, ctx := makeTestParamsKeeper(t, params)
+	alice := sdk.AccAddress([]byte("alice")).String()
+	bob := sdk.AccAddress([]byte("bob")).String()
+	carol := sdk.AccAddress([]byte("carol")).String()
+	bank := &billingBankKeeper{
+		balances: map[string]sdk.Coins{
+			alice: sdk.NewCoins(sdk.NewInt64Coin("uist", 100)),
+			bob:   sdk.NewCoins(sdk.NewInt64Coin("uist", 100), sdk.NewInt64Coin("ubld", 100)),
+			carol: sdk.NewCoins(sdk.NewInt64Coin("uist", 1), sdk.NewInt64Coin("ubld", 100)),
+		},
+		failing: map[string]bool{alice: true},
+	}
+	k.bankKeeper = bank
+	k.feeCollectorName = "feeCollector"
+	for vatID, owner := range map[string]string{"v1": alice, "v2": bob, "v3": "agoric1bad", "v5": carol} {
+		if err := k.RegisterVatOwner(ctx, vatID, owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A vat that cannot be billed changes nothing, and does not stop the
+	// billing of the others.  Only uist pays for computrons, so an owner
+	// without enough of it has its vat suspended.
+	k.BillVats(ctx, `{"vatComputrons":{"v1":"500","v2":"250","v3":"100","v4":"100","v5":"300"}}`)
+	if want := []string{bob + " feeCollector 2uist", carol + " feeCollector 1uist"}; !reflect.DeepEqual(bank.sent, want) {
+		t.Errorf("got charges %v, want %v", bank.sent, want)
+	}
+	if record, _ := k.GetVatOwner(ctx, "v1"); !record.Unbilled.IsZero() || record.Suspended {
+		t.Errorf("got record %+v for a vat whose charge failed, want it unchanged", record)
+	}
+	if record, _ := k.GetVatOwner(ctx, "v2"); !record.Unbilled.Equal(sdk.MustNewDecFromStr("0.5")) || record.Suspended {
+		t.Errorf("got record %+v, want 0.5uist unbilled", record)
+	}
+	if record, _ := k.GetVatOwner(ctx, "v5"); !record.Unbilled.Equal(sdk.NewDec(2)) || !record.Suspended {
+		t.Errorf("got record %+v, want 2uist unbilled and suspended", record)
+	}
+	if got := queuedActionTypes(t, k, ctx); !reflect.DeepEqual(got, []string{"SUSPEND_VAT"}) {
+		t.Errorf("got actions %q, want a SUSPEND_VAT", got)
+	}
+
+	// A suspended vat runs no more, but its owner is charged again each
+	// block, and the vat is resumed once its debt is paid.
+	bank.sent = nil
+	bank.balances[carol] = sdk.NewCoins(sdk.NewInt64Coin("ubld", 100))
+	k.BillVats(ctx, `{"vatComputrons":{}}`)
+	if len(bank.sent) != 0 {
+		t.Errorf("got charges %v from an owner without uist", bank.sent)
+	}
+	bank.balances[carol] = sdk.NewCoins(sdk.NewInt64Coin("uist", 10))
+	k.BillVats(ctx, `{"vatComputrons":{}}`)
+	if want := []string{carol + " feeCollector 2uist"}; !reflect.DeepEqual(bank.sent, want) {
+		t.Errorf("got charges %v, want %v", bank.sent, want)
+	}
+	if record, _ := k.GetVatOwner(ctx, "v5"); !record.Unbilled.IsZero() || record.Suspended {
+		t.Errorf("got record %+v, want paid and resumed", record)
+	}
+	if got := queuedActionTypes(t, k, ctx); !reflect.DeepEqual(got, []string{"SUSPEND_VAT", "RESUME_VAT"}) {
+		t.Errorf("got actions %q, want SUSPEND_VAT and RESUME_VAT", got)
+	}
+
+	// Nothing accrues without a price.
+	bank.sent = nil
+	k.SetParams(ctx, types.DefaultParams())
+	k.BillVats(ctx, `{"vatComputrons":{"v2":"250"}}`)
+	if len(bank.sent) != 0 {
+		t.Errorf("got charges %v without a price", bank.sent)
+	}
+}
+
+func TestRegisterVatOwner(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+
+	if err := k.RegisterVatOwner(ctx, "v2", "agoric1alice"); err != nil {
+		t.Fatal(err)
+	}
+	unbilled := sdk.MustNewDecFromStr("6.5")
+	k.SetVatOwner(ctx, types.VatOwner{VatID: "v1", Owner: "agoric1bob", Unbilled: unbilled, Suspended: true})
+	if got := k.GetVatOwners(ctx); len(got) != 2 || got[0].VatID != "v1" || got[1].VatID != "v2" {
+		t.Errorf("got vat owners %+v, want v1 and v2", got)
+	}
+
+	// Reregistering keeps the debt and the suspension, without telling
+	// SwingSet.
+	if err := k.RegisterVatOwner(ctx, "v1", "agoric1carol"); err != nil {
+		t.Fatal(err)
+	}
+	got, found := k.GetVatOwner(ctx, "v1")
+	if !found || got.Owner != "agoric1carol" || !got.Unbilled.Equal(unbilled) || !got.Suspended {
+		t.Errorf("got vat owner %+v, want suspended carol owing %s", got, unbilled)
+	}
+	if got := queuedActionTypes(t, k, ctx); len(got) != 0 {
+		t.Errorf("got actions %q, want none", got)
+	}
+
+	// Unregistering resumes a suspended vat.
+	if err := k.RegisterVatOwner(ctx, "v2", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.RegisterVatOwner(ctx, "v1", ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := k.GetVatOwners(ctx); len(got) != 0 {
+		t.Errorf("got vat owners %+v of unregistered vats", got)
+	}
+	if got := queuedActionTypes(t, k, ctx); !reflect.DeepEqual(got, []string{"RESUME_VAT"}) {
+		t.Errorf("got actions %q, want a RESUME_VAT", got)
+	}
+}
+
+func TestRevokeEgress(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+
+	peers := []sdk.AccAddress{sdk.AccAddress([]byte("peer1")), sdk.AccAddress([]byte("peer2"))}
+	for i, peer := range peers {
+		egress := types.Egress{Nickname: fmt.Sprintf("solo%d", i), Peer: peer, PowerFlags: []string{"agoric.vattp"}, CreatedHeight: int64(i + 1)}
+		bz, err := json.Marshal(&egress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(StoragePathEgress+"."+peer.String(), string(bz)))
+		k.SetMailbox(ctx, peer.String(), `{"outbox":[],"ack":0}`)
+	}
+
+	egresses, _, err := k.GetEgressesPage(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(egresses) != 2 || egresses[0].CreatedHeight+egresses[1].CreatedHeight != 3 {
+		t.Errorf("got egresses %+v, want both", egresses)
+	}
+
+	if err := k.RevokeEgress(ctx, peers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.RevokeEgress(ctx, peers[0]); err == nil {
+		t.Errorf("revoked a missing egress")
+	}
+	// The controller is told once to stop transmitting to the peer.
+	queued, err := k.vstorageKeeper.GetQueueLength(ctx, StoragePathActionQueue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if queued.Int64() != 1 {
+		t.Fatalf("got %s queued actions, want 1", queued)
+	}
+	var record struct {
+		Action struct {
+			Type string `json:"type"`
+			Peer string `json:"peer"`
+		} `json:"action"`
+	}
+	if err := json.Unmarshal([]byte(k.vstorageKeeper.GetEntry(ctx, StoragePathActionQueue+".0").StringValue()), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.Action.Type != "REVOKE_EGRESS" || record.Action.Peer != peers[0].String() {
+		t.Errorf("got action %+v, want REVOKE_EGRESS of %s", record.Action, peers[0])
+	}
+	if k.GetMailbox(ctx, peers[0].String()) != "" {
+		t.Errorf("kept the mailbox of a revoked egress")
+	}
+	egresses, _, err = k.GetEgressesPage(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(egresses) != 1 || !egresses[0].Peer.Equals(peers[1]) {
+		t.Errorf("got egresses %+v, want only %s", egresses, peers[1])
+	}
+}
+
+func TestUpdateBundleReferences(t *testing.T) {
+	k, ctx := makeTestBundleUploadKeeper()
+	hashA, hashB := strings.Repeat("a", 128), strings.Repeat("b", 128)
+
+	k.UpdateBundleReferences(ctx, `{"vatBundleIDs":{"v1":"b1-`+hashA+`","v2":"b1-`+hashA+`","v3":"b0-legacy","bogus":"b1-`+hashB+`"}}`)
+	if got := k.GetBundleReferenceCount(ctx, hashA); got != 2 {
+		t.Errorf("got %d references to A, want 2", got)
+	}
+	if got := k.GetBundleReferenceCount(ctx, hashB); got != 0 {
+		t.Errorf("got %d references to B from an invalid vat ID, want 0", got)
+	}
+	if _, found := k.GetVatBundle(ctx, "v3"); found {
+		t.Error("unexpected bundle recorded for a vat not created from a hashed bundle")
+	}
+
+	// A reply without the vats' bundles changes nothing.
+	k.UpdateBundleReferences(ctx, `{}`)
+	if got := k.GetBundleReferenceCount(ctx, hashA); got != 2 {
+		t.Errorf("got %d references to A after a reply without vats, want 2", got)
+	}
+
+	// Upgrades move references, and vats no longer reported drop them.
+	k.UpdateBundleReferences(ctx, `{"vatBundleIDs":{"v1":"b1-`+hashB+`"}}`)
+	if got := k.GetBundleReferenceCount(ctx, hashA); got != 0 {
+		t.Errorf("got %d references to A, want 0", got)
+	}
+	if got := k.GetBundleReferenceCount(ctx, hashB); got != 1 {
+		t.Errorf("got %d references to B, want 1", got)
+	}
+	if bundleHash, found := k.GetVatBundle(ctx, "v1"); !found || bundleHash != hashB {
+		t.Errorf("got v1 bundle %q, want B", bundleHash)
+	}
+
+	// Repeated reports are idempotent.
+	k.UpdateBundleReferences(ctx, `{"vatBundleIDs":{"v1":"b1-`+hashB+`"}}`)
+	if got := k.GetBundleReferenceCount(ctx, hashB); got != 1 {
+		t.Errorf("got %d references to B after a repeated report, want 1", got)
+	}
+	if _, found := k.GetVatBundle(ctx, "v2"); found {
+		t.Error("unexpected bundle recorded for a vat no longer reported")
+	}
+
+	// A node without the vats' earlier bundles, such as one restored from a
+	// state-sync snapshot, converges on the same counts.
+	k.UpdateBundleReferences(ctx, `{"vatBundleIDs":{}}`)
+	if got := k.GetBundleReferenceCount(ctx, hashB); got != 0 {
+		t.Errorf("got %d references to B without live vats, want 0", got)
+	}
+
+	// A deleted bundle is forgotten.
+	k.setBundleInstallation(ctx, hashA, types.BundleInstallation{Status: types.BundleStatusInstalled})
+	k.NoteSwingStoreExportDataDeletion(ctx, "bundle.b1-"+hashA)
+	if _, found := k.GetBundleInstallation(ctx, hashA); found {
+		t.Error("unexpected installation of a deleted bundle")
+	}
+}
+
+func Test_nextBlockEntropy(t *testing.T) {
+	first := nextBlockEntropy(nil, []byte("header1"), 1)
+	if len(first) != 32 {
+		t.Fatalf("got %d bytes of entropy, want 32", len(first))
+	}
+	if !bytes.Equal(first, nextBlockEntropy(nil, []byte("header1"), 1)) {
+		t.Error("entropy is not deterministic")
+	}
+	second := nextBlockEntropy(first, []byte("header2"), 2)
+	for _, other := range [][]byte{
+		nextBlockEntropy(nil, []byte("header2"), 2),
+		nextBlockEntropy(first, []byte("header2"), 3),
+		nextBlockEntropy(first, []byte("header3"), 2),
+	} {
+		if bytes.Equal(second, other) {
+			t.Errorf("entropy %x does not depend on each input", other)
+		}
+	}
+}
+
+func TestBlockEntropy(t *testing.T) {
+	params := types.DefaultParams()
+	k, ctx := makeTestParamsKeeper(t, params)
+	if _, ok := k.GetBlockEntropy(ctx); ok {
+		t.Error("got entropy before any block")
+	}
+
+	ctx = ctx.WithHeaderHash([]byte("header1"))
+	k.UpdateBlockEntropy(ctx)
+	first, ok := k.GetBlockEntropy(ctx)
+	if !ok || !bytes.Equal(first, nextBlockEntropy(nil, []byte("header1"), ctx.BlockHeight())) {
+		t.Fatalf("got entropy %x, %v for the first block", first, ok)
+	}
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithHeaderHash([]byte("header2"))
+	k.UpdateBlockEntropy(ctx)
+	second, _ := k.GetBlockEntropy(ctx)
+	if !bytes.Equal(second, nextBlockEntropy(first, []byte("header2"), ctx.BlockHeight())) {
+		t.Errorf("got entropy %x not chained from %x", second, first)
+	}
+
+	// A node importing the latest entropy from genesis chains the same.
+	k2, ctx2 := makeTestParamsKeeper(t, params)
+	k2.SetLatestBlockEntropy(ctx2, k.GetLatestBlockEntropy(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithHeaderHash([]byte("header3"))
+	ctx2 = ctx2.WithBlockHeight(ctx.BlockHeight()).WithHeaderHash([]byte("header3"))
+	k.UpdateBlockEntropy(ctx)
+	k2.UpdateBlockEntropy(ctx2)
+	third, _ := k.GetBlockEntropy(ctx)
+	if imported, _ := k2.GetBlockEntropy(ctx2); !bytes.Equal(imported, third) {
+		t.Errorf("got entropy %x after import, want %x", imported, third)
+	}
+
+	// While disabled, entropy is neither offered nor advanced.
+	params.BlockEntropyDisabled = true
+	k.SetParams(ctx, params)
+	k.UpdateBlockEntropy(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	if _, ok := k.GetBlockEntropy(ctx); ok {
+		t.Error("got entropy while disabled")
+	}
+	if !bytes.Equal(k.GetLatestBlockEntropy(ctx), third) {
+		t.Error("entropy advanced while disabled")
+	}
+}
+
+func TestAdmitOraclePush(t *testing.T) {
+	params := types.DefaultParams()
+	params.OraclePushesPerBlock = 2
+	k, ctx := makeTestParamsKeeper(t, params)
+	for i, want := range []bool{true, true, false, false} {
+		if got := k.AdmitOraclePush(ctx); got != want {
+			t.Errorf("push %d: got admitted %v, want %v", i, got, want)
+		}
+	}
+
+	// The count starts over in each block.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	if !k.AdmitOraclePush(ctx) {
+		t.Error("first push of the next block not admitted")
+	}
+
+	// A chain that has not set the param admits none.
+	params.OraclePushesPerBlock = 0
+	k.SetParams(ctx, params)
+	if k.AdmitOraclePush(ctx) {
+		t.Error("push admitted with no oracle_pushes_per_block")
+	}
+}
+
+func TestProvisionOnDeposit(t *testing.T) {
+	params := types.DefaultParams()
+	params.AutoProvisionMinDeposit = sdk.NewCoins(sdk.NewInt64Coin("uist", 1000))
+	k, ctx := makeTestParamsKeeper(t, params)
+	unpaidAddr := sdk.AccAddress([]byte("unpaid"))
+	bank := &billingBankKeeper{failing: map[string]bool{unpaidAddr.String(): true}}
+	k.bankKeeper = bank
+	k.feeCollectorName = "feeCollector"
+	fee := submitAddr.String() + " feeCollector 1000000uist"
+
+	for _, tt := range []struct {
+		name       string
+		addr       sdk.AccAddress
+		amt        sdk.Coins
+		wantAct    bool
+		wantErr    bool
+		wantCharge []string
+	}{
+		{name: "tooSmall", addr: submitAddr, amt: sdk.NewCoins(sdk.NewInt64Coin("uist", 999))},
+		{name: "otherDenom", addr: submitAddr, amt: sdk.NewCoins(sdk.NewInt64Coin("ubld", 5000))},
+		{name: "unpaid", addr: unpaidAddr, amt: sdk.NewCoins(sdk.NewInt64Coin("uist", 1000)), wantErr: true},
+		{name: "enough", addr: submitAddr, amt: sdk.NewCoins(sdk.NewInt64Coin("uist", 1000)), wantAct: true, wantCharge: []string{fee}},
+		// The provisioning is pending, so it is neither charged nor enqueued again.
+		{name: "again", addr: submitAddr, amt: sdk.NewCoins(sdk.NewInt64Coin("uist", 1000)), wantCharge: []string{fee}},
+		{name: "provisioned", addr: utilAddr, amt: sdk.NewCoins(sdk.NewInt64Coin("uist", 5000)), wantCharge: []string{fee}},
+	} {
+		if tt.name == "provisioned" {
+			path := StoragePathCustom + "." + WalletStoragePathSegment + "." + utilAddr.String()
+			GetVstorageKeeper(t, k).SetStorage(ctx, agoric.NewKVEntry(path, "{}"))
+		}
+		before, err := k.GetActionQueue(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		acted, err := k.ProvisionOnDeposit(ctx, tt.addr, tt.amt)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: got error = %v, want error %t", tt.name, err, tt.wantErr)
+		}
+		if acted != tt.wantAct {
+			t.Errorf("%s: got provisioned %v, want %v", tt.name, acted, tt.wantAct)
+		}
+		if !reflect.DeepEqual(bank.sent, tt.wantCharge) {
+			t.Errorf("%s: got charges %v, want %v", tt.name, bank.sent, tt.wantCharge)
+		}
+		after, err := k.GetActionQueue(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantLength := before.ActionQueueLength
+		if tt.wantAct {
+			wantLength++
+			if got := after.Entries[len(after.Entries)-1].Type; got != "PLEASE_PROVISION" {
+				t.Errorf("%s: got action %s, want PLEASE_PROVISION", tt.name, got)
+			}
+		}
+		if after.ActionQueueLength != wantLength {
+			t.Errorf("%s: got queue length %d, want %d", tt.name, after.ActionQueueLength, wantLength)
+		}
+	}
+
+	// Only the pending provisioning is recorded, and is exported.
+	want := []types.AutoProvisionRecord{{Address: submitAddr.String(), Height: ctx.BlockHeight()}}
+	if got := k.GetAutoProvisions(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("got auto-provisions %v, want %v", got, want)
+	}
+
+	// An expired record is forgotten, even if the wallet was not provisioned.
+	expiryHeight := ctx.BlockHeight() + AutoProvisionExpiryBlocks
+	k.PruneExpiredAutoProvisions(ctx.WithBlockHeight(expiryHeight - 1))
+	if got := k.GetAutoProvisions(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("got auto-provisions %v before expiry, want %v", got, want)
+	}
+	k.PruneExpiredAutoProvisions(ctx.WithBlockHeight(expiryHeight))
+	if got := k.GetAutoProvisions(ctx); len(got) != 0 {
+		t.Errorf("got auto-provisions %v after expiry, want none", got)
+	}
+	if acted, err := k.ProvisionOnDeposit(ctx, submitAddr, sdk.NewCoins(sdk.NewInt64Coin("uist", 1000))); err != nil || !acted {
+		t.Errorf("got provisioned %v, error %v after expiry", acted, err)
+	}
+
+	// Once the wallet is provisioned, its record is forgotten, along with its
+	// expiry.
+	path := StoragePathCustom + "." + WalletStoragePathSegment + "." + submitAddr.String()
+	GetVstorageKeeper(t, k).SetStorage(ctx, agoric.NewKVEntry(path, "{}"))
+	if acted, err := k.ProvisionOnDeposit(ctx, submitAddr, sdk.NewCoins(sdk.NewInt64Coin("uist", 1000))); err != nil || acted {
+		t.Errorf("got provisioned %v, error %v for a provisioned wallet", acted, err)
+	}
+	if got := k.GetAutoProvisions(ctx); len(got) != 0 {
+		t.Errorf("got auto-provisions %v after provisioning, want none", got)
+	}
+	iter := k.getAutoProvisionStore(ctx, autoProvisionExpiryKeyPrefix).Iterator(nil, nil)
+	if iter.Valid() {
+		t.Errorf("got auto-provision expiry %x after provisioning, want none", iter.Key())
+	}
+	iter.Close()
+
+	k.SetParams(ctx, types.DefaultParams())
+	acted, err := k.ProvisionOnDeposit(ctx, unpaidAddr, sdk.NewCoins(sdk.NewInt64Coin("uist", 5000)))
+	if err != nil || acted {
+		t.Errorf("got provisioned %v, error %v with no minimum deposit", acted, err)
+	}
+}
+
+func TestApplyVstorageOverrides(t *testing.T) {
+	k, ctx := makeTestParamsKeeper(t, types.DefaultParams())
+	vstorageKeeper := GetVstorageKeeper(t, k)
+	for path, value := range map[string]string{
+		"published.priceFeed.ATOM-USD_price_feed":       "old",
+		"published.priceFeed.ATOM-USD_price_feed.round": "3",
+		"published.chain.peer":                          "cosmoshub-4",
+		"published.kept":                                "kept",
+	} {
+		vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(path, value))
+	}
+
+	reset := "reset"
+	fork := "cosmoshub-fork"
+	k.ApplyVstorageOverrides(ctx, map[string]*string{
+		"published.priceFeed":                     nil,
+		"published.priceFeed.ATOM-USD_price_feed": &reset,
+		"published.chain.peer":                    &fork,
+	})
+
+	for path, want := range map[string]string{
+		"published.priceFeed.ATOM-USD_price_feed":       "reset",
+		"published.priceFeed.ATOM-USD_price_feed.round": "",
+		"published.chain.peer":                          "cosmoshub-fork",
+		"published.kept":                                "kept",
+	} {
+		if got := vstorageKeeper.GetEntry(ctx, path).StringValue(); got != want {
+			t.Errorf("got %s = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KernelPanicMarkerFile is the name of the file, within a node's data
+// directory, which records that SwingSet reported an unrecoverable error.
+const KernelPanicMarkerFile = "swingset-kernel-panic.json"
+
+// KernelPanicExitCode is the exit status of a node halted by
+// HaltForKernelPanic.
+const KernelPanicExitCode = 3
+
+// exitForKernelPanic is replaced by tests.
+var exitForKernelPanic = os.Exit
+
+// KernelPanicMarker describes the failure that halted a node.
+type KernelPanicMarker struct {
+	// BlockHeight is the height of the block that could not be completed.
+	BlockHeight int64 `json:"blockHeight"`
+	// Action is the type of the action that SwingSet failed to process.
+	Action string `json:"action"`
+	Error  string `json:"error"`
+	// Time is when the node halted, which is not consensus time.
+	Time time.Time `json:"time"`
+}
+
+// ReadKernelPanicMarker returns the marker in dataDir, or nil if there is
+// none.
+func ReadKernelPanicMarker(dataDir string) (*KernelPanicMarker, error) {
+	bz, err := os.ReadFile(filepath.Join(dataDir, KernelPanicMarkerFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var marker KernelPanicMarker
+	if err := json.Unmarshal(bz, &marker); err != nil {
+		return nil, fmt.Errorf("invalid kernel panic marker: %w", err)
+	}
+	return &marker, nil
+}
+
+// WriteKernelPanicMarker durably replaces the marker in dataDir.
+func WriteKernelPanicMarker(dataDir string, marker KernelPanicMarker) error {
+	bz, err := json.MarshalIndent(marker, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dataDir, KernelPanicMarkerFile+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(bz, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dataDir, KernelPanicMarkerFile))
+}
+
+// ClearKernelPanicMarker removes any marker in dataDir.
+func ClearKernelPanicMarker(dataDir string) error {
+	err := os.Remove(filepath.Join(dataDir, KernelPanicMarkerFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// WithKernelPanicMarkerDir returns a copy of the keeper which records a
+// KernelPanicMarker in dataDir when halting for a kernel panic.
+func (k Keeper) WithKernelPanicMarkerDir(dataDir string) Keeper {
+	k.kernelPanicMarkerDir = dataDir
+	return k
+}
+
+// HaltForKernelPanic stops the node because SwingSet failed to process a
+// block action, which leaves its state inconsistent with the chain's. Rather
+// than panicking, which would leave consensus hung or the next attempt at the
+// block diverging, the node records a KernelPanicMarker and exits, and then
+// refuses to restart until an operator acknowledges the marker. Without a
+// marker directory, it panics with err.
+func (k Keeper) HaltForKernelPanic(ctx sdk.Context, action string, err error) {
+	if k.kernelPanicMarkerDir == "" {
+		panic(err)
+	}
+
+	marker := KernelPanicMarker{
+		BlockHeight: ctx.BlockHeight(),
+		Action:      action,
+		Error:       err.Error(),
+		Time:        time.Now().UTC(),
+	}
+	fmt.Fprintf(os.Stderr, "SwingSet kernel panicked during %s of block %d, halting: %s\n",
+		action, marker.BlockHeight, marker.Error)
+	if writeErr := WriteKernelPanicMarker(k.kernelPanicMarkerDir, marker); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "cannot record kernel panic marker: %s\n", writeErr)
+	} else {
+		fmt.Fprintf(os.Stderr, "Recorded %s; the node will not restart until it is acknowledged.\n",
+			filepath.Join(k.kernelPanicMarkerDir, KernelPanicMarkerFile))
+	}
+	exitForKernelPanic(KernelPanicExitCode)
+}
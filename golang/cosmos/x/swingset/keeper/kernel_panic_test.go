@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHaltForKernelPanic(t *testing.T) {
+	dir := t.TempDir()
+	exitCode := -1
+	defer func(exit func(int)) { exitForKernelPanic = exit }(exitForKernelPanic)
+	exitForKernelPanic = func(code int) { exitCode = code }
+
+	if marker, err := ReadKernelPanicMarker(dir); err != nil || marker != nil {
+		t.Fatalf("got marker %+v, %v before halting; want nil, nil", marker, err)
+	}
+
+	k := Keeper{}.WithKernelPanicMarkerDir(dir)
+	ctx := sdk.Context{}.WithBlockHeight(42)
+	k.HaltForKernelPanic(ctx, "END_BLOCK", errors.New("kernel panic: critical vat v7 failed"))
+	if exitCode != KernelPanicExitCode {
+		t.Errorf("got exit code %d, want %d", exitCode, KernelPanicExitCode)
+	}
+
+	marker, err := ReadKernelPanicMarker(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if marker == nil || marker.BlockHeight != 42 || marker.Action != "END_BLOCK" ||
+		marker.Error != "kernel panic: critical vat v7 failed" || marker.Time.IsZero() {
+		t.Errorf("got marker %+v", marker)
+	}
+
+	if err := ClearKernelPanicMarker(dir); err != nil {
+		t.Fatal(err)
+	}
+	if marker, err := ReadKernelPanicMarker(dir); err != nil || marker != nil {
+		t.Errorf("got marker %+v, %v after clearing; want nil, nil", marker, err)
+	}
+	if err := ClearKernelPanicMarker(dir); err != nil {
+		t.Errorf("got error clearing a missing marker: %v", err)
+	}
+
+	// Without a marker directory, the failure panics as before.
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic without a marker directory")
+		}
+	}()
+	Keeper{}.HaltForKernelPanic(ctx, "END_BLOCK", errors.New("boom"))
+}
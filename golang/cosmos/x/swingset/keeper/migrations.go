@@ -20,8 +20,17 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return m.MigrateParams(ctx)
 }
 
+// Migrate2to3 migrates from version 2 to 3, defaulting the params added since
+// version 2, such as oracle_pushes_per_block and computron_price_uist.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.setDefaultParamsIfMissing(ctx)
+	return nil
+}
+
 // MigrateParams migrates params by setting new params to their default value
 func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	// SetParams would validate the zero values of the missing params.
+	m.setDefaultParamsIfMissing(ctx)
 	params := m.keeper.GetParams(ctx)
 	newParams, err := types.UpdateParams(params)
 	if err != nil {
@@ -30,3 +39,15 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.SetParams(ctx, newParams)
 	return nil
 }
+
+// setDefaultParamsIfMissing sets each param to its default value, unless it is
+// already present.
+func (m Migrator) setDefaultParamsIfMissing(ctx sdk.Context) {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if m.keeper.paramSpace.Has(ctx, pair.Key) {
+			continue
+		}
+		m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+	}
+}
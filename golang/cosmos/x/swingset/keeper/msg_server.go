@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -59,6 +62,7 @@ func (keeper msgServer) DeliverInbound(goCtx context.Context, msg *types.MsgDeli
 	if err != nil {
 		return nil, err
 	}
+	keeper.RecordDeliverInbound(ctx, msg.Submitter, msg.Nums, msg.Ack)
 	return &types.MsgDeliverInboundResponse{}, nil
 }
 
@@ -66,6 +70,9 @@ type walletAction struct {
 	*vm.ActionHeader `actionType:"WALLET_ACTION"`
 	Owner            string `json:"owner"`
 	Action           string `json:"action"`
+	// FeeGranter, if non-empty, is the account that paid the fees of the
+	// action under an x/feegrant allowance to the fee payer.
+	FeeGranter string `json:"feeGranter,omitempty"`
 }
 
 func (keeper msgServer) WalletAction(goCtx context.Context, msg *types.MsgWalletAction) (*types.MsgWalletActionResponse, error) {
@@ -77,8 +84,9 @@ func (keeper msgServer) WalletAction(goCtx context.Context, msg *types.MsgWallet
 	}
 
 	action := walletAction{
-		Owner:  msg.Owner.String(),
-		Action: msg.Action,
+		Owner:      msg.Owner.String(),
+		Action:     msg.Action,
+		FeeGranter: feeGranterOf(ctx, msg.Owner),
 	}
 	// fmt.Fprintf(os.Stderr, "Context is %+v\n", ctx)
 
@@ -94,6 +102,9 @@ type walletSpendAction struct {
 	*vm.ActionHeader `actionType:"WALLET_SPEND_ACTION"`
 	Owner            string `json:"owner"`
 	SpendAction      string `json:"spendAction"`
+	// FeeGranter, if non-empty, is the account that paid the fees of the
+	// action under an x/feegrant allowance to the fee payer.
+	FeeGranter string `json:"feeGranter,omitempty"`
 }
 
 func (keeper msgServer) WalletSpendAction(goCtx context.Context, msg *types.MsgWalletSpendAction) (*types.MsgWalletSpendActionResponse, error) {
@@ -107,6 +118,7 @@ func (keeper msgServer) WalletSpendAction(goCtx context.Context, msg *types.MsgW
 	action := walletSpendAction{
 		Owner:       msg.Owner.String(),
 		SpendAction: msg.SpendAction,
+		FeeGranter:  feeGranterOf(ctx, msg.Owner),
 	}
 	// fmt.Fprintf(os.Stderr, "Context is %+v\n", ctx)
 	err = keeper.routeAction(ctx, msg, action)
@@ -116,6 +128,15 @@ func (keeper msgServer) WalletSpendAction(goCtx context.Context, msg *types.MsgW
 	return &types.MsgWalletSpendActionResponse{}, nil
 }
 
+// feeGranterOf returns the fee granter that sponsors the smart wallet
+// messages of owner in this transaction, or "" if none.
+func feeGranterOf(ctx sdk.Context, owner sdk.AccAddress) string {
+	if grant, ok := types.GetFeeGrant(ctx); ok && !grant.Granter.Equals(owner) {
+		return grant.Granter.String()
+	}
+	return ""
+}
+
 type provisionAction struct {
 	*vm.ActionHeader `actionType:"PLEASE_PROVISION"`
 	*types.MsgProvision
@@ -169,6 +190,11 @@ func (keeper msgServer) Provision(goCtx context.Context, msg *types.MsgProvision
 
 	// Create the account, if it doesn't already exist.
 	egress := types.NewEgress(msg.Nickname, msg.Address, msg.PowerFlags)
+	egress.CreatedHeight = ctx.BlockHeight()
+	if previous := keeper.GetEgress(ctx, msg.Address); previous.Peer != nil {
+		// Reprovisioning keeps the original height.
+		egress.CreatedHeight = previous.CreatedHeight
+	}
 	err = keeper.SetEgress(ctx, egress)
 	if err != nil {
 		return nil, err
@@ -188,12 +214,15 @@ type installBundleAction struct {
 	*types.MsgInstallBundle
 }
 
-func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInstallBundle) (*types.MsgInstallBundleResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
+// installBundle queues a bundle for installation and marks it pending.
+func (keeper msgServer) installBundle(ctx sdk.Context, msg *types.MsgInstallBundle) error {
 	err := msg.Uncompress()
 	if err != nil {
-		return nil, err
+		return err
+	}
+	err = keeper.ChargeBundleStorage(ctx, msg.Submitter, msg.Bundle)
+	if err != nil {
+		return err
 	}
 	action := installBundleAction{
 		MsgInstallBundle: msg,
@@ -201,9 +230,113 @@ func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInsta
 
 	err = keeper.routeAction(ctx, msg, action)
 	// fmt.Fprintln(os.Stderr, "Returned from SwingSet", out, err)
+	if err != nil {
+		return err
+	}
+
+	keeper.setBundlePending(ctx, msg.Bundle)
+	return nil
+}
+
+func (keeper msgServer) InstallBundle(goCtx context.Context, msg *types.MsgInstallBundle) (*types.MsgInstallBundleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.installBundle(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgInstallBundleResponse{}, nil
 }
+
+func (keeper msgServer) InstallBundleChunk(goCtx context.Context, msg *types.MsgInstallBundleChunk) (*types.MsgInstallBundleChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bundle, err := keeper.AddBundleChunk(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if bundle == nil {
+		return &types.MsgInstallBundleChunkResponse{Installed: false}, nil
+	}
+
+	err = bundle.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	err = keeper.installBundle(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstallBundleChunkResponse{Installed: true}, nil
+}
+
+func (keeper msgServer) PruneBundles(goCtx context.Context, msg *types.MsgPruneBundles) (*types.MsgPruneBundlesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := keeper.Keeper.PruneBundles(ctx, msg.BundleHashes)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgPruneBundlesResponse{}, nil
+}
+
+type oraclePushAction struct {
+	*vm.ActionHeader `actionType:"ORACLE_PUSH"`
+	Owner            string `json:"owner"`
+	Feed             string `json:"feed"`
+	UnitPrice        string `json:"unitPrice"`
+	RoundID          uint64 `json:"roundID"`
+}
+
+func (keeper msgServer) OraclePush(goCtx context.Context, msg *types.MsgOraclePush) (*types.MsgOraclePushResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !keeper.IsOracleOperator(ctx, msg.Submitter) {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an oracle operator", msg.Submitter)
+	}
+	if !keeper.AdmitOraclePush(ctx) {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "oracle pushes exceed %d in this block", keeper.GetParams(ctx).OraclePushesPerBlock)
+	}
+
+	action := oraclePushAction{
+		Owner:     msg.Submitter.String(),
+		Feed:      msg.Feed,
+		UnitPrice: msg.UnitPrice,
+		RoundID:   msg.RoundID,
+	}
+	err := keeper.PushOracleAction(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOraclePushResponse{}, nil
+}
+
+func (keeper msgServer) RegisterVatOwner(goCtx context.Context, msg *types.MsgRegisterVatOwner) (*types.MsgRegisterVatOwnerResponse, error) {
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := keeper.Keeper.RegisterVatOwner(ctx, msg.VatID, msg.Owner); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterVatOwnerResponse{}, nil
+}
+
+func (keeper msgServer) RevokeEgress(goCtx context.Context, msg *types.MsgRevokeEgress) (*types.MsgRevokeEgressResponse, error) {
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	peer, err := sdk.AccAddressFromBech32(msg.Peer)
+	if err != nil {
+		return nil, err
+	}
+	if err := keeper.Keeper.RevokeEgress(ctx, peer); err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeEgressResponse{}, nil
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const (
+	walletSpendActionRateLimitKeyPrefix = "walletSpendActionRateLimit."
+
+	// The buckets keyed by their full height and then address, so that they can
+	// be pruned once full.
+	walletSpendActionRateLimitExpiryKeyPrefix = "walletSpendActionRateLimitExpiry."
+)
+
+func (k Keeper) getRateLimitStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(walletSpendActionRateLimitKeyPrefix))
+}
+
+func (k Keeper) getRateLimitExpiryStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(walletSpendActionRateLimitExpiryKeyPrefix))
+}
+
+func rateLimitExpiryKey(fullHeight int64, addr sdk.AccAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(fullHeight)), addr...)
+}
+
+// ConsumeWalletSpendActionToken takes a token from the wallet spend action rate
+// limit bucket of addr, returning false (and leaving the bucket untouched) if
+// none is available. It always succeeds when the rate limit is disabled.
+func (k Keeper) ConsumeWalletSpendActionToken(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+	capacity, blocksPerToken, enabled := types.GetRateLimit(k.GetParams(ctx).WalletSpendActionRateLimit)
+	if !enabled {
+		return true, nil
+	}
+
+	store := k.getRateLimitStore(ctx)
+	bucket := types.RateLimitBucket{Tokens: capacity, LastRefillHeight: ctx.BlockHeight()}
+	if bz := store.Get(addr); bz != nil {
+		// Unmarshal into an empty bucket, since a zero tokens field is omitted
+		// from the encoding.
+		var stored types.RateLimitBucket
+		if err := k.cdc.Unmarshal(bz, &stored); err != nil {
+			return false, err
+		}
+		bucket = refillBucket(stored, ctx.BlockHeight(), capacity, blocksPerToken)
+	}
+
+	if bucket.Tokens == 0 {
+		return false, nil
+	}
+	bucket.Tokens--
+	bucket.FullHeight = bucket.LastRefillHeight + int64((capacity-bucket.Tokens)*blocksPerToken)
+
+	if err := k.SetRateLimitBucket(ctx, addr, bucket); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetRateLimitBucket stores the wallet spend action rate limit bucket of addr,
+// to be pruned at its full height.
+func (k Keeper) SetRateLimitBucket(ctx sdk.Context, addr sdk.AccAddress, bucket types.RateLimitBucket) error {
+	store := k.getRateLimitStore(ctx)
+	expiryStore := k.getRateLimitExpiryStore(ctx)
+	if bz := store.Get(addr); bz != nil {
+		var old types.RateLimitBucket
+		if err := k.cdc.Unmarshal(bz, &old); err != nil {
+			return err
+		}
+		expiryStore.Delete(rateLimitExpiryKey(old.FullHeight, addr))
+	}
+
+	bz, err := k.cdc.Marshal(&bucket)
+	if err != nil {
+		return err
+	}
+	store.Set(addr, bz)
+	expiryStore.Set(rateLimitExpiryKey(bucket.FullHeight, addr), []byte{})
+	return nil
+}
+
+// GetRateLimitBuckets returns the wallet spend action rate limit buckets not
+// yet pruned, for export.
+func (k Keeper) GetRateLimitBuckets(ctx sdk.Context) []types.RateLimitBucketRecord {
+	iterator := k.getRateLimitStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.RateLimitBucketRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RateLimitBucketRecord{Address: sdk.AccAddress(iterator.Key()).String()}
+		k.cdc.MustUnmarshal(iterator.Value(), &record.Bucket)
+		records = append(records, record)
+	}
+	return records
+}
+
+// PruneRateLimitBuckets deletes the wallet spend action rate limit buckets that
+// have refilled to capacity by the current block, since they are then
+// indistinguishable from a new bucket.
+func (k Keeper) PruneRateLimitBuckets(ctx sdk.Context) {
+	store := k.getRateLimitStore(ctx)
+	expiryStore := k.getRateLimitExpiryStore(ctx)
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) + 1)
+	iterator := expiryStore.Iterator(nil, end)
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key[8:])
+		expiryStore.Delete(key)
+	}
+}
+
+// refillBucket credits bucket with a token for every blocksPerToken blocks
+// elapsed since its last refill, up to capacity.
+func refillBucket(bucket types.RateLimitBucket, height int64, capacity, blocksPerToken uint64) types.RateLimitBucket {
+	if bucket.Tokens >= capacity {
+		// The capacity may have been lowered since the bucket was last used.
+		return types.RateLimitBucket{Tokens: capacity, LastRefillHeight: height}
+	}
+	if height <= bucket.LastRefillHeight {
+		return bucket
+	}
+
+	earned := uint64(height-bucket.LastRefillHeight) / blocksPerToken
+	if earned >= capacity-bucket.Tokens {
+		return types.RateLimitBucket{Tokens: capacity, LastRefillHeight: height}
+	}
+
+	// Keep the remainder of a partially earned token for the next refill.
+	bucket.Tokens += earned
+	bucket.LastRefillHeight += int64(earned * blocksPerToken)
+	return bucket
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// ReplayProgress is the payload of a reportReplayProgress message, sent by
+// packages/cosmic-swingset/src/chain-main.js as the launching kernel replays
+// the transcript of a vat to bring it back into memory.  Only a VM with a slog
+// sender reports its replays, and only those before its first block.
+type ReplayProgress struct {
+	// VatID is the vat whose transcript is being replayed.
+	VatID string `json:"vatID"`
+	// Replayed is the number of deliveries replayed so far.
+	Replayed uint64 `json:"replayed"`
+	// Total is the number of deliveries in the transcript.
+	Total uint64 `json:"total"`
+	// Done is true once the transcript has been replayed.
+	Done bool `json:"done"`
+}
+
+// VatReplay is the state of the transcript replay of one vat.
+type VatReplay struct {
+	// Progress is the most recent progress reported.
+	Progress ReplayProgress
+	// Started is the time at which the replay began.
+	Started time.Time
+	// LastProgress is the time at which progress was last reported.
+	LastProgress time.Time
+}
+
+// ReplayStatus is the state of the kernel's transcript replays, which are not
+// part of consensus state.  Several vats may replay at once.
+type ReplayStatus struct {
+	// Replaying is true while any vat transcript is being replayed.
+	Replaying bool
+	// Progress is the most recent progress reported, of any vat.
+	Progress ReplayProgress
+	// Vats are the replays in progress, by vat ID.
+	Vats []VatReplay
+	// VatsReplayed is the number of transcripts replayed to completion.
+	VatsReplayed uint64
+	// Started is the time at which the earliest replay in progress began, or
+	// the last replay if none is in progress, or zero.
+	Started time.Time
+	// LastProgress is the time at which progress was last reported, or zero.
+	LastProgress time.Time
+}
+
+// ReplayTracker follows the progress of the kernel's transcript replays, which
+// can keep a restarting node busy for a long time before its first block, and
+// logs that of each vat at most once per interval.
+type ReplayTracker struct {
+	logger   log.Logger
+	interval time.Duration
+
+	mtx          sync.Mutex
+	replays      map[string]*trackedReplay
+	progress     ReplayProgress
+	vatsReplayed uint64
+	lastStarted  time.Time
+	lastProgress time.Time
+}
+
+// trackedReplay is a replay in progress.
+type trackedReplay struct {
+	VatReplay
+	lastLog time.Time
+}
+
+// NewReplayTracker returns a ReplayTracker which logs to logger.
+func NewReplayTracker(logger log.Logger, interval time.Duration) *ReplayTracker {
+	return &ReplayTracker{logger: logger, interval: interval, replays: map[string]*trackedReplay{}}
+}
+
+// Report records the progress of a replay.
+func (t *ReplayTracker) Report(progress ReplayProgress) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	now := time.Now()
+
+	replay, replaying := t.replays[progress.VatID]
+	if !replaying {
+		replay = &trackedReplay{VatReplay: VatReplay{Started: now}, lastLog: now}
+		t.replays[progress.VatID] = replay
+		t.lastStarted = now
+		t.logger.Info("replaying vat transcript", "vatID", progress.VatID, "deliveries", progress.Total)
+	}
+	replay.Progress = progress
+	replay.LastProgress = now
+	t.progress = progress
+	t.lastProgress = now
+
+	switch {
+	case progress.Done:
+		delete(t.replays, progress.VatID)
+		t.vatsReplayed++
+		t.logger.Info("replayed vat transcript",
+			"vatID", progress.VatID,
+			"deliveries", progress.Replayed,
+			"duration", now.Sub(replay.Started).String(),
+			"vatsReplayed", t.vatsReplayed,
+		)
+	case replaying && now.Sub(replay.lastLog) >= t.interval:
+		replay.lastLog = now
+		t.logger.Info("replaying vat transcript",
+			"vatID", progress.VatID,
+			"replayed", progress.Replayed,
+			"deliveries", progress.Total,
+		)
+	}
+}
+
+// Status returns the state of the replays.
+func (t *ReplayTracker) Status() ReplayStatus {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	status := ReplayStatus{
+		Replaying:    len(t.replays) > 0,
+		Progress:     t.progress,
+		VatsReplayed: t.vatsReplayed,
+		Started:      t.lastStarted,
+		LastProgress: t.lastProgress,
+	}
+	for _, replay := range t.replays {
+		status.Vats = append(status.Vats, replay.VatReplay)
+		if replay.Started.Before(status.Started) {
+			status.Started = replay.Started
+		}
+	}
+	sort.Slice(status.Vats, func(i, j int) bool {
+		return status.Vats[i].Progress.VatID < status.Vats[j].Progress.VatID
+	})
+	return status
+}
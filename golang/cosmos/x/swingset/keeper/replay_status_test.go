@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestReplayTracker(t *testing.T) {
+	k := Keeper{}
+	// Without a tracker, progress is ignored.
+	k.ReportReplayProgress(ReplayProgress{VatID: "v1", Total: 10})
+	if status := k.GetReplayStatus(); status.Replaying {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	k = k.WithReplayTracker(NewReplayTracker(log.NewNopLogger(), 0))
+	k.ReportReplayProgress(ReplayProgress{VatID: "v1", Total: 10})
+	k.ReportReplayProgress(ReplayProgress{VatID: "v1", Replayed: 4, Total: 10})
+	status := k.GetReplayStatus()
+	if !status.Replaying || status.Progress.Replayed != 4 || status.VatsReplayed != 0 {
+		t.Errorf("unexpected status %+v", status)
+	}
+	started := status.Started
+	if started.IsZero() || status.LastProgress.Before(started) {
+		t.Errorf("unexpected times %+v", status)
+	}
+
+	k.ReportReplayProgress(ReplayProgress{VatID: "v1", Replayed: 10, Total: 10, Done: true})
+	status = k.GetReplayStatus()
+	if status.Replaying || status.VatsReplayed != 1 || status.Progress.Replayed != 10 {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	// A replay of another vat starts anew.
+	k.ReportReplayProgress(ReplayProgress{VatID: "v2", Total: 3})
+	status = k.GetReplayStatus()
+	if !status.Replaying || status.Progress.VatID != "v2" || status.VatsReplayed != 1 || status.Started.Before(started) {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestReplayTrackerParallel(t *testing.T) {
+	tracker := NewReplayTracker(log.NewNopLogger(), 0)
+	tracker.Report(ReplayProgress{VatID: "v1", Total: 10})
+	tracker.Report(ReplayProgress{VatID: "v2", Total: 5})
+	tracker.Report(ReplayProgress{VatID: "v1", Replayed: 3, Total: 10})
+	status := tracker.Status()
+	if !status.Replaying || len(status.Vats) != 2 || status.Progress.VatID != "v1" {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	v1, v2 := status.Vats[0], status.Vats[1]
+	if v1.Progress.VatID != "v1" || v1.Progress.Replayed != 3 || v2.Progress.VatID != "v2" || v2.Progress.Total != 5 {
+		t.Errorf("unexpected vats %+v", status.Vats)
+	}
+	// Progress of one vat does not restart the replay of the other.
+	if v2.Started.Before(v1.Started) || !status.Started.Equal(v1.Started) {
+		t.Errorf("unexpected start times %+v", status)
+	}
+
+	tracker.Report(ReplayProgress{VatID: "v2", Replayed: 5, Total: 5, Done: true})
+	status = tracker.Status()
+	if !status.Replaying || len(status.Vats) != 1 || status.Vats[0].Progress.VatID != "v1" ||
+		!status.Vats[0].Started.Equal(v1.Started) || status.VatsReplayed != 1 {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	tracker.Report(ReplayProgress{VatID: "v1", Replayed: 10, Total: 10, Done: true})
+	status = tracker.Status()
+	if status.Replaying || len(status.Vats) != 0 || status.VatsReplayed != 2 {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
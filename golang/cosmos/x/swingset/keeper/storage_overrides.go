@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// GenesisOverrides are changes with which to "fast forward" the state of an
+// exported genesis when a forked chain is launched from it, such as to reset
+// oracle feeds or replace the chain IDs of peers.  InitGenesis applies them to
+// the kernel state restored from the swing-store export as well as to
+// vstorage, so that the two agree.  The "debug rewrite-swingstore" command
+// may instead apply them offline to the genesis export itself.
+type GenesisOverrides struct {
+	// Kernel maps the keys of the kernel's kvStore (those of the "kv." entries
+	// of the swing-store export data, without the prefix) to the values with
+	// which to replace theirs, or to nil to delete them.
+	Kernel map[string]*string `json:"kernel,omitempty"`
+	// Vstorage maps vstorage paths to the values with which to replace theirs,
+	// or to nil to remove them along with their descendants.
+	Vstorage map[string]*string `json:"vstorage,omitempty"`
+}
+
+// ReadGenesisOverrides reads the GenesisOverrides from the JSON object in the
+// file at path, such as
+//
+//	{
+//	  "kernel": {"some.kernel.key": "value", "another.kernel.key": null},
+//	  "vstorage": {"published.priceFeed.ATOM-USD_price_feed": null}
+//	}
+func ReadGenesisOverrides(path string) (GenesisOverrides, error) {
+	var overrides GenesisOverrides
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return overrides, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&overrides); err != nil {
+		return overrides, fmt.Errorf("%s is not a JSON object of genesis overrides: %w", path, err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return overrides, fmt.Errorf("%s has data after its genesis overrides", path)
+	}
+	for key := range overrides.Kernel {
+		if key == "" {
+			return overrides, fmt.Errorf("%s: empty kernel key", path)
+		}
+	}
+	for storagePath := range overrides.Vstorage {
+		if err := vstoragetypes.ValidatePath(storagePath); err != nil {
+			return overrides, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return overrides, nil
+}
+
+// IsEmpty returns whether the overrides change nothing.
+func (o GenesisOverrides) IsEmpty() bool {
+	return len(o.Kernel) == 0 && len(o.Vstorage) == 0
+}
+
+// ApplyVstorageOverrides applies overrides to vstorage, first removing the
+// paths overridden by nil and then setting the others, each in path order so
+// that the result is the same on every node.
+func (k Keeper) ApplyVstorageOverrides(ctx sdk.Context, overrides map[string]*string) {
+	paths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if overrides[path] == nil {
+			k.vstorageKeeper.RemoveEntriesWithPrefix(ctx, path)
+		}
+	}
+	for _, path := range paths {
+		if value := overrides[path]; value != nil {
+			k.vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry(path, *value))
+		}
+	}
+}
+
+// ApplyVstorageGenesisOverrides returns the vstorage genesis data with overrides
+// applied: the paths overridden by nil are removed along with their
+// descendants, and the others are set, those not already present being
+// appended in path order.
+func ApplyVstorageGenesisOverrides(data []*vstoragetypes.DataEntry, overrides map[string]*string) []*vstoragetypes.DataEntry {
+	removed := func(path string) bool {
+		for prefix, value := range overrides {
+			if value == nil && (path == prefix || strings.HasPrefix(path, prefix+".")) {
+				return true
+			}
+		}
+		return false
+	}
+
+	applied := map[string]bool{}
+	result := make([]*vstoragetypes.DataEntry, 0, len(data)+len(overrides))
+	for _, entry := range data {
+		if value, ok := overrides[entry.Path]; ok && value != nil {
+			entry = &vstoragetypes.DataEntry{Path: entry.Path, Value: *value}
+			applied[entry.Path] = true
+		} else if removed(entry.Path) {
+			continue
+		}
+		result = append(result, entry)
+	}
+
+	paths := make([]string, 0, len(overrides))
+	for path, value := range overrides {
+		if value != nil && !applied[path] {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		result = append(result, &vstoragetypes.DataEntry{Path: path, Value: *overrides[path]})
+	}
+	return result
+}
+
+// kvOverridingReader is a KVEntryReader yielding the swing-store export data
+// entries of another with the kernel overrides applied, followed by the
+// entries of the overridden keys that it lacks.
+type kvOverridingReader struct {
+	reader    agoric.KVEntryReader
+	overrides map[string]*string
+	applied   map[string]bool
+	// added is nil until the entries of reader are exhausted.
+	added []agoric.KVEntry
+	// onOverride is called for each entry replaced, deleted, or added.
+	onOverride func()
+}
+
+// NewKVOverridingReader returns a KVEntryReader yielding the swing-store
+// export data entries of reader with the kernel overrides applied.
+func NewKVOverridingReader(reader agoric.KVEntryReader, overrides map[string]*string) agoric.KVEntryReader {
+	return newKVOverridingReader(reader, overrides, func() {})
+}
+
+// newKVOverridingReader returns a kvOverridingReader of reader.
+func newKVOverridingReader(reader agoric.KVEntryReader, overrides map[string]*string, onOverride func()) *kvOverridingReader {
+	return &kvOverridingReader{
+		reader:     reader,
+		overrides:  overrides,
+		applied:    map[string]bool{},
+		onOverride: onOverride,
+	}
+}
+
+// Read implements KVEntryReader.
+func (or *kvOverridingReader) Read() (agoric.KVEntry, error) {
+	if or.added != nil {
+		return or.readAdded()
+	}
+	for {
+		entry, err := or.reader.Read()
+		if err == io.EOF {
+			return or.readAdded()
+		} else if err != nil {
+			return entry, err
+		}
+		if !strings.HasPrefix(entry.Key(), swingStoreKVPrefix) {
+			return entry, nil
+		}
+		kernelKey := strings.TrimPrefix(entry.Key(), swingStoreKVPrefix)
+		value, ok := or.overrides[kernelKey]
+		if !ok {
+			return entry, nil
+		}
+		or.applied[kernelKey] = true
+		or.onOverride()
+		if value != nil {
+			return agoric.NewKVEntry(entry.Key(), *value), nil
+		}
+	}
+}
+
+// readAdded yields the entries of the overridden keys that weren't read.
+func (or *kvOverridingReader) readAdded() (agoric.KVEntry, error) {
+	if or.added == nil {
+		keys := make([]string, 0, len(or.overrides))
+		for key, value := range or.overrides {
+			if value != nil && !or.applied[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		or.added = make([]agoric.KVEntry, 0, len(keys))
+		for _, key := range keys {
+			or.added = append(or.added, agoric.NewKVEntry(swingStoreKVPrefix+key, *or.overrides[key]))
+		}
+	}
+	if len(or.added) == 0 {
+		return agoric.KVEntry{}, io.EOF
+	}
+	entry := or.added[0]
+	or.added = or.added[1:]
+	or.onOverride()
+	return entry, nil
+}
+
+// Close implements KVEntryReader.
+func (or *kvOverridingReader) Close() error {
+	return or.reader.Close()
+}
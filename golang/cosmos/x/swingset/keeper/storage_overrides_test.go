@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+func TestReadGenesisOverrides(t *testing.T) {
+	dir := t.TempDir()
+	testCases := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "valid", content: `{"kernel":{"v1.vs.feed":null,"v2.vs.peer":"cosmoshub-fork"},"vstorage":{"published.priceFeed":null}}`},
+		{name: "empty", content: `{}`},
+		{name: "not an object", content: `["published"]`, wantErr: true},
+		{name: "unknown section", content: `{"published.priceFeed":null}`, wantErr: true},
+		{name: "not a string", content: `{"vstorage":{"published.count":1}}`, wantErr: true},
+		{name: "invalid path", content: `{"vstorage":{"published..priceFeed":null}}`, wantErr: true},
+		{name: "empty kernel key", content: `{"kernel":{"":"value"}}`, wantErr: true},
+		{name: "trailing data", content: `{} {}`, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(tc.name, " ", "-")+".json")
+			if err := os.WriteFile(path, []byte(tc.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := ReadGenesisOverrides(path)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("got error %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+	if _, err := ReadGenesisOverrides(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestKVOverridingReader(t *testing.T) {
+	fork := "cosmoshub-fork"
+	baz := "baz"
+	reader := NewKVOverridingReader(agoric.NewSwingStoreExportDataEntriesReader([]*types.SwingStoreExportDataEntry{
+		{Key: "kv.foo", Value: "bar"},
+		{Key: "kv.v9.vs.feed", Value: "old"},
+		{Key: "transcript.v1.current", Value: "{}"},
+	}), map[string]*string{
+		"foo":        &baz,
+		"v9.vs.feed": nil,
+		"v9.vs.peer": &fork,
+		"missing":    nil,
+	})
+	var got []agoric.KVEntry
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, entry)
+	}
+	want := []agoric.KVEntry{
+		agoric.NewKVEntry("kv.foo", "baz"),
+		agoric.NewKVEntry("transcript.v1.current", "{}"),
+		agoric.NewKVEntry("kv.v9.vs.peer", "cosmoshub-fork"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestApplyVstorageGenesisOverrides(t *testing.T) {
+	reset := "reset"
+	fork := "cosmoshub-fork"
+	added := "added"
+	got := ApplyVstorageGenesisOverrides([]*vstoragetypes.DataEntry{
+		{Path: "published.chain.peer", Value: "cosmoshub-4"},
+		{Path: "published.kept", Value: "kept"},
+		{Path: "published.priceFeed.ATOM-USD_price_feed", Value: "old"},
+		{Path: "published.priceFeed.ATOM-USD_price_feed.round", Value: "3"},
+		{Path: "published.priceFeedLike", Value: "kept"},
+	}, map[string]*string{
+		"published.priceFeed":                     nil,
+		"published.priceFeed.ATOM-USD_price_feed": &reset,
+		"published.chain.peer":                    &fork,
+		"published.added":                         &added,
+	})
+	want := []*vstoragetypes.DataEntry{
+		{Path: "published.chain.peer", Value: "cosmoshub-fork"},
+		{Path: "published.kept", Value: "kept"},
+		{Path: "published.priceFeed.ATOM-USD_price_feed", Value: "reset"},
+		{Path: "published.priceFeedLike", Value: "kept"},
+		{Path: "published.added", Value: "added"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRewriteSwingStoreExportKernelOverrides(t *testing.T) {
+	exportData := append(testExportData(), agoric.NewKVEntry("kv.v9.vs.feed", "old"))
+	exportDir, outDir := t.TempDir(), t.TempDir()
+	if err := WriteSwingStoreExportToDirectory(newTestExportProvider(exportData, testArtifacts()), exportDir); err != nil {
+		t.Fatal(err)
+	}
+
+	fork := "cosmoshub-fork"
+	baz := "baz"
+	result, err := RewriteSwingStoreExport(exportDir, outDir, SwingStoreExportRewriteOptions{
+		Replacer: strings.NewReplacer(),
+		KernelOverrides: map[string]*string{
+			"foo":        &baz,
+			"v9.vs.feed": nil,
+			"v9.vs.peer": &fork,
+			"missing":    nil,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.OverriddenEntries != 3 || result.RewrittenEntries != 0 {
+		t.Errorf("got %+v, want 3 entries overridden and none rewritten", result)
+	}
+
+	provider, err := OpenSwingStoreExportDirectory(outDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verification, err := VerifySwingStoreExport(provider, nil)
+	if err != nil {
+		t.Fatalf("overridden export doesn't verify: %v", err)
+	}
+	if verification.ExportDataHash != result.ExportDataHash {
+		t.Errorf("got export data hash %s, want %s", result.ExportDataHash, verification.ExportDataHash)
+	}
+
+	reader, err := provider.GetExportDataReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	kernel := map[string]string{}
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(entry.Key(), swingStoreKVPrefix) {
+			kernel[entry.Key()] = entry.StringValue()
+		}
+	}
+	if want := map[string]string{"kv.foo": "baz", "kv.v9.vs.peer": "cosmoshub-fork"}; !reflect.DeepEqual(kernel, want) {
+		t.Errorf("got kernel entries %v, want %v", kernel, want)
+	}
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// SwingStoreExportRewrite is the outcome of RewriteSwingStoreExport.
+type SwingStoreExportRewrite struct {
+	// ExportDataHash is the "sha256:<hex>" hash of the rewritten export data,
+	// in the format of the swingset genesis SwingStoreExportDataHash.
+	ExportDataHash string
+	// RewrittenEntries is the number of export data entries that changed,
+	// other than the metadata of rewritten transcript spans.
+	RewrittenEntries int
+	// OverriddenEntries is the number of export data entries replaced, deleted,
+	// or added by the kernel overrides.
+	OverriddenEntries int
+	// RewrittenTranscripts is the number of transcript artifacts that changed.
+	RewrittenTranscripts int
+	// CopiedArtifacts is the number of bundle and snapshot artifacts, which
+	// are opaque and copied unchanged.
+	CopiedArtifacts int
+}
+
+// SwingStoreExportRewriteOptions are the changes RewriteSwingStoreExport
+// makes to a swing-store export.
+type SwingStoreExportRewriteOptions struct {
+	// Replacer substitutes strings, such as bech32 addresses, in the kernel
+	// data and transcripts.
+	Replacer *strings.Replacer
+	// KernelOverrides then change the kernel data, as described by the Kernel
+	// field of GenesisOverrides.
+	KernelOverrides map[string]*string
+	// ExportData, if not nil, is the export data embedded in the genesis
+	// instead of the export data of the export directory.
+	ExportData []*types.SwingStoreExportDataEntry
+}
+
+// kvRewritingReader is a KVEntryReader yielding the entries of another,
+// transformed by a function.
+type kvRewritingReader struct {
+	reader  agoric.KVEntryReader
+	rewrite func(entry agoric.KVEntry) agoric.KVEntry
+}
+
+// Read implements KVEntryReader.
+func (rr kvRewritingReader) Read() (agoric.KVEntry, error) {
+	entry, err := rr.reader.Read()
+	if err != nil {
+		return entry, err
+	}
+	return rr.rewrite(entry), nil
+}
+
+// Close implements KVEntryReader.
+func (rr kvRewritingReader) Close() error {
+	return rr.reader.Close()
+}
+
+// RewriteSwingStoreExport writes to outDir a copy of the swing-store export in
+// exportDir in which every string replaced by options.Replacer (such as a
+// bech32 address) is substituted in the kernel data of the export data, and in
+// the items of the transcript artifacts, whose span hashes are recomputed in
+// the export data to match. The kernel data is then changed by
+// options.KernelOverrides. The export data of the copy is that of the export,
+// or options.ExportData if not nil.
+//
+// Bundle and heap snapshot artifacts are opaque and copied unchanged. Since a
+// vat is reloaded from its current heap snapshot by replaying its transcript
+// since, a vat whose current snapshot holds a replaced string would produce
+// syscalls that don't match its rewritten transcript, so such an export is
+// refused.
+func RewriteSwingStoreExport(exportDir, outDir string, options SwingStoreExportRewriteOptions) (result SwingStoreExportRewrite, err error) {
+	replacer := options.Replacer
+	provider, err := OpenSwingStoreExportDirectory(exportDir)
+	if err != nil {
+		return result, err
+	}
+	getExportDataReader := provider.GetExportDataReader
+	if options.ExportData != nil {
+		getExportDataReader = func() (agoric.KVEntryReader, error) {
+			return agoric.NewSwingStoreExportDataEntriesReader(options.ExportData), nil
+		}
+	}
+
+	// The span hashes must be recomputed before the export data is written,
+	// which precedes the artifacts, so the transcript artifacts are read twice,
+	// as are the current snapshots, which must be checked before anything is
+	// written.
+	currentSnapshots, err := readCurrentSnapshotNames(getExportDataReader)
+	if err != nil {
+		return result, err
+	}
+	spanHashes := map[string]string{}
+	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+	if err != nil {
+		return result, err
+	}
+	var manifest exportManifest
+	if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+		return result, err
+	}
+	var refusedSnapshots []string
+	for _, artifactEntry := range manifest.Artifacts {
+		name, fileName := artifactEntry[0], artifactEntry[1]
+		switch {
+		case strings.HasPrefix(name, transcriptArtifactPrefix):
+			parts := strings.Split(name, ".")
+			if len(parts) != 4 {
+				return result, fmt.Errorf("invalid transcript artifact name %s", name)
+			}
+			data, err := os.ReadFile(filepath.Join(exportDir, fileName))
+			if err != nil {
+				return result, err
+			}
+			if rewritten := replacer.Replace(string(data)); rewritten != string(data) {
+				spanHashes[parts[1]+"."+parts[2]], _ = hashTranscriptSpan([]byte(rewritten))
+			}
+		case currentSnapshots[name]:
+			data, err := os.ReadFile(filepath.Join(exportDir, fileName))
+			if err != nil {
+				return result, err
+			}
+			if replacer.Replace(string(data)) != string(data) {
+				refusedSnapshots = append(refusedSnapshots, name)
+			}
+		}
+	}
+	if len(refusedSnapshots) > 0 {
+		return result, fmt.Errorf(
+			"current heap snapshots %s hold replaced strings, so their vats would fail to replay their rewritten transcripts; upgrade those vats before exporting, or leave their strings unreplaced",
+			strings.Join(refusedSnapshots, ", "),
+		)
+	}
+	hasher, writeHash := NewSwingStoreExportDataHasher()
+	rewriteEntry := func(entry agoric.KVEntry) agoric.KVEntry {
+		key := entry.Key()
+		switch {
+		case strings.HasPrefix(key, transcriptArtifactPrefix):
+			var metadata transcriptSpanMetadata
+			if !entry.HasValue() || json.Unmarshal([]byte(entry.StringValue()), &metadata) != nil {
+				break
+			}
+			hash, ok := spanHashes[fmt.Sprintf("%s.%d", metadata.VatID, metadata.StartPos)]
+			if !ok {
+				break
+			}
+			value := strings.Replace(entry.StringValue(), `"hash":"`+metadata.Hash+`"`, `"hash":"`+hash+`"`, 1)
+			entry = agoric.NewKVEntry(key, value)
+		case strings.HasPrefix(key, bundleArtifactPrefix), strings.HasPrefix(key, snapshotArtifactPrefix):
+			// Describes an artifact which is copied unchanged.
+		default:
+			rewritten := agoric.NewKVEntryWithNoValue(replacer.Replace(key))
+			if entry.HasValue() {
+				rewritten = agoric.NewKVEntry(replacer.Replace(key), replacer.Replace(entry.StringValue()))
+			}
+			if rewritten.Key() != key || rewritten.StringValue() != entry.StringValue() {
+				result.RewrittenEntries++
+			}
+			entry = rewritten
+		}
+		return entry
+	}
+
+	rewritten := SwingStoreExportProvider{
+		BlockHeight: provider.BlockHeight,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			reader, err := getExportDataReader()
+			if reader == nil || err != nil {
+				return reader, err
+			}
+			return agoric.NewKVHookingReader(
+				newKVOverridingReader(
+					kvRewritingReader{reader, rewriteEntry},
+					options.KernelOverrides,
+					func() { result.OverriddenEntries++ },
+				),
+				writeHash,
+				func() error { return nil },
+			), nil
+		},
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			artifact, err := provider.ReadNextArtifact()
+			if err != nil {
+				return artifact, err
+			}
+			if !strings.HasPrefix(artifact.Name, transcriptArtifactPrefix) {
+				result.CopiedArtifacts++
+				return artifact, nil
+			}
+			data := replacer.Replace(string(artifact.Data))
+			if data != string(artifact.Data) {
+				result.RewrittenTranscripts++
+			}
+			artifact.Data = []byte(data)
+			return artifact, nil
+		},
+	}
+
+	if err := WriteSwingStoreExportToDirectory(rewritten, outDir); err != nil {
+		return result, err
+	}
+	result.ExportDataHash = fmt.Sprintf("sha256:%x", hasher.Sum(nil))
+	return result, nil
+}
+
+// readCurrentSnapshotNames returns the set of artifact names of the current
+// heap snapshots, as named by the "snapshot.<vatID>.current" entries of the
+// export data.
+func readCurrentSnapshotNames(getExportDataReader func() (agoric.KVEntryReader, error)) (map[string]bool, error) {
+	names := map[string]bool{}
+	reader, err := getExportDataReader()
+	if reader == nil || err != nil {
+		return names, err
+	}
+	defer reader.Close()
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return names, nil
+		} else if err != nil {
+			return names, err
+		}
+		if strings.HasPrefix(entry.Key(), snapshotArtifactPrefix) && strings.HasSuffix(entry.Key(), ".current") && entry.HasValue() {
+			names[entry.StringValue()] = true
+		}
+	}
+}
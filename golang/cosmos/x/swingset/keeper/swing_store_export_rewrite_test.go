@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+func TestRewriteSwingStoreExport(t *testing.T) {
+	exportData := append(testExportData(), agoric.NewKVEntry("kv.v9.vs.owner.agoric1old", `"agoric1old"`))
+	artifacts := testArtifacts()
+	artifacts[3] = types.SwingStoreArtifact{Name: "transcript.v1.3.5", Data: []byte("{\"from\":\"agoric1old\"}\n{\"d\":2}\n")}
+	spanHash, _ := hashTranscriptSpan(artifacts[3].Data)
+	exportData[5] = agoric.NewKVEntry("transcript.v1.current", `{"vatID":"v1","startPos":3,"endPos":5,"hash":"`+spanHash+`","isCurrent":1,"incarnation":0}`)
+
+	exportDir, outDir := t.TempDir(), t.TempDir()
+	if err := WriteSwingStoreExportToDirectory(newTestExportProvider(exportData, artifacts), exportDir); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := RewriteSwingStoreExport(exportDir, outDir, SwingStoreExportRewriteOptions{
+		Replacer: strings.NewReplacer("agoric1old", "agoric1new"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RewrittenEntries != 1 || result.RewrittenTranscripts != 1 || result.CopiedArtifacts != 3 {
+		t.Errorf("got %+v, want 1 entry and 1 transcript rewritten, and 3 artifacts copied", result)
+	}
+
+	provider, err := OpenSwingStoreExportDirectory(outDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verification, err := VerifySwingStoreExport(provider, nil)
+	if err != nil {
+		t.Fatalf("rewritten export doesn't verify: %v", err)
+	}
+	if verification.ExportDataHash != result.ExportDataHash {
+		t.Errorf("got export data hash %s, want %s", result.ExportDataHash, verification.ExportDataHash)
+	}
+
+	bz, err := os.ReadFile(filepath.Join(outDir, exportDataFilename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bz), "agoric1old") || !strings.Contains(string(bz), `["kv.v9.vs.owner.agoric1new","\"agoric1new\""]`) {
+		t.Errorf("unexpected rewritten export data %s", bz)
+	}
+}
+
+func TestRewriteSwingStoreExportEmbeddedExportData(t *testing.T) {
+	artifacts := testArtifacts()
+	artifacts[3] = types.SwingStoreArtifact{Name: "transcript.v1.3.5", Data: []byte("{\"from\":\"agoric1old\"}\n{\"d\":2}\n")}
+	spanHash, _ := hashTranscriptSpan(artifacts[3].Data)
+	var embedded []*types.SwingStoreExportDataEntry
+	for _, entry := range testExportData() {
+		if entry.Key() == "transcript.v1.current" {
+			entry = agoric.NewKVEntry(entry.Key(), `{"vatID":"v1","startPos":3,"endPos":5,"hash":"`+spanHash+`","isCurrent":1,"incarnation":0}`)
+		}
+		embedded = append(embedded, &types.SwingStoreExportDataEntry{Key: entry.Key(), Value: entry.StringValue()})
+	}
+	embedded = append(embedded, &types.SwingStoreExportDataEntry{Key: "kv.v9.vs.owner", Value: "agoric1old"})
+
+	exportDir, outDir := t.TempDir(), t.TempDir()
+	if err := WriteSwingStoreExportToDirectory(newTestExportProvider(nil, artifacts), exportDir); err != nil {
+		t.Fatal(err)
+	}
+	result, err := RewriteSwingStoreExport(exportDir, outDir, SwingStoreExportRewriteOptions{
+		Replacer:   strings.NewReplacer("agoric1old", "agoric1new"),
+		ExportData: embedded,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RewrittenEntries != 1 || result.RewrittenTranscripts != 1 {
+		t.Errorf("got %+v, want 1 entry and 1 transcript rewritten", result)
+	}
+
+	provider, err := OpenSwingStoreExportDirectory(outDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verification, err := VerifySwingStoreExport(provider, nil)
+	if err != nil {
+		t.Fatalf("rewritten export doesn't verify: %v", err)
+	}
+	if verification.ExportDataHash != result.ExportDataHash {
+		t.Errorf("got export data hash %s, want %s", result.ExportDataHash, verification.ExportDataHash)
+	}
+	bz, err := os.ReadFile(filepath.Join(outDir, exportDataFilename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(bz), `["kv.v9.vs.owner","agoric1new"]`) {
+		t.Errorf("unexpected rewritten export data %s", bz)
+	}
+}
+
+func TestRewriteSwingStoreExportRefusesSnapshots(t *testing.T) {
+	artifacts := testArtifacts()
+	artifacts[2] = types.SwingStoreArtifact{Name: "snapshot.v1.2", Data: []byte("heap of agoric1old")}
+	exportDir := t.TempDir()
+	if err := WriteSwingStoreExportToDirectory(newTestExportProvider(testExportData(), artifacts), exportDir); err != nil {
+		t.Fatal(err)
+	}
+	_, err := RewriteSwingStoreExport(exportDir, t.TempDir(), SwingStoreExportRewriteOptions{
+		Replacer: strings.NewReplacer("agoric1old", "agoric1new"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "snapshot.v1.2") {
+		t.Errorf("got error %v, want the current snapshot refused", err)
+	}
+
+	// A snapshot which is no longer current is never replayed from.
+	exportData := testExportData()
+	exportData[4] = agoric.NewKVEntry("snapshot.v1.current", "snapshot.v1.9")
+	exportDir = t.TempDir()
+	if err := WriteSwingStoreExportToDirectory(newTestExportProvider(exportData, artifacts), exportDir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RewriteSwingStoreExport(exportDir, t.TempDir(), SwingStoreExportRewriteOptions{
+		Replacer: strings.NewReplacer("agoric1old", "agoric1new"),
+	}); err != nil {
+		t.Errorf("unexpected error for a snapshot no longer current: %v", err)
+	}
+}
@@ -0,0 +1,296 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+)
+
+// The artifact and export data formats below are defined by the JS
+// swing-store, and must be synchronized with packages/swing-store/src:
+//   - A bundle artifact `bundle.${bundleID}` has the export data entry
+//     `bundle.${bundleID}`. A "b1-" bundleID is the sha512 of the artifact.
+//   - A snapshot artifact `snapshot.${vatID}.${snapPos}` has the export data
+//     entry of the same name, whose hash is the sha256 of the artifact.
+//   - A transcript artifact `transcript.${vatID}.${startPos}.${endPos}` has a
+//     span export data entry `transcript.${vatID}.${startPos}` (or
+//     `transcript.${vatID}.current`), whose hash chains the sha256 of each of
+//     the artifact's lines.
+//   - A vat's `snapshot.${vatID}.current` export data entry names its current
+//     snapshot artifact.
+const (
+	bundleArtifactPrefix     = "bundle."
+	snapshotArtifactPrefix   = "snapshot."
+	transcriptArtifactPrefix = "transcript."
+
+	transcriptSpanInitialSeed = "start of transcript span"
+)
+
+// snapshotMetadata is the export data value of a snapshot.
+type snapshotMetadata struct {
+	Hash string `json:"hash"`
+}
+
+// transcriptSpanMetadata is the export data value of a transcript span.
+type transcriptSpanMetadata struct {
+	VatID    string `json:"vatID"`
+	StartPos uint64 `json:"startPos"`
+	EndPos   uint64 `json:"endPos"`
+	Hash     string `json:"hash"`
+}
+
+// SwingStoreExportVerification is the outcome of VerifySwingStoreExport.
+type SwingStoreExportVerification struct {
+	// BlockHeight is the block height of the verified export.
+	BlockHeight uint64
+	// ExportDataHash is the "sha256:<hex>" hash of the export data against which
+	// the artifacts were verified, in the format of the swingset genesis
+	// SwingStoreExportDataHash.
+	ExportDataHash string
+	// VerifiedArtifacts is the number of artifacts whose hash matched.
+	VerifiedArtifacts int
+	// UnverifiedArtifacts are the names of the artifacts whose hash cannot be
+	// recomputed outside of the JS swing-store (such as "b0-" bundles).
+	UnverifiedArtifacts []string
+}
+
+// exportDataIndex holds the export data entries needed to verify artifacts.
+type exportDataIndex struct {
+	bundles   map[string]string
+	snapshots map[string]snapshotMetadata
+	// spans is keyed by "${vatID}.${startPos}".
+	spans map[string]transcriptSpanMetadata
+}
+
+// NewSwingStoreExportDataHasher returns a hash.Hash and a function writing a
+// KVEntry to it, such that the sum of the entries of some "export data"
+// matches the swingset genesis SwingStoreExportDataHash.
+func NewSwingStoreExportDataHasher() (hash.Hash, func(agoric.KVEntry) error) {
+	hasher := sha256.New()
+	encoder := json.NewEncoder(hasher)
+	encoder.SetEscapeHTML(false)
+	return hasher, func(entry agoric.KVEntry) error {
+		return encoder.Encode(entry)
+	}
+}
+
+// indexExportData consumes reader, returning the artifact metadata it contains
+// and the hash of all its entries.
+func indexExportData(reader agoric.KVEntryReader) (exportDataIndex, string, error) {
+	index := exportDataIndex{
+		bundles:   map[string]string{},
+		snapshots: map[string]snapshotMetadata{},
+		spans:     map[string]transcriptSpanMetadata{},
+	}
+	hasher, write := NewSwingStoreExportDataHasher()
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return index, "", err
+		}
+		if err := write(entry); err != nil {
+			return index, "", err
+		}
+		if !entry.HasValue() {
+			continue
+		}
+		key, value := entry.Key(), entry.StringValue()
+		switch {
+		case strings.HasPrefix(key, bundleArtifactPrefix):
+			index.bundles[key] = value
+		case strings.HasPrefix(key, snapshotArtifactPrefix):
+			if strings.HasSuffix(key, ".current") {
+				// Names the current snapshot artifact rather than describing it.
+				continue
+			}
+			var metadata snapshotMetadata
+			if err := json.Unmarshal([]byte(value), &metadata); err != nil {
+				return index, "", fmt.Errorf("invalid export data %s: %w", key, err)
+			}
+			index.snapshots[key] = metadata
+		case strings.HasPrefix(key, transcriptArtifactPrefix):
+			var metadata transcriptSpanMetadata
+			if err := json.Unmarshal([]byte(value), &metadata); err != nil {
+				return index, "", fmt.Errorf("invalid export data %s: %w", key, err)
+			}
+			index.spans[fmt.Sprintf("%s.%d", metadata.VatID, metadata.StartPos)] = metadata
+		}
+	}
+	return index, fmt.Sprintf("sha256:%x", hasher.Sum(nil)), nil
+}
+
+// hashExportData consumes reader, returning the hash of its entries.
+func hashExportData(reader agoric.KVEntryReader) (string, error) {
+	hasher, write := NewSwingStoreExportDataHasher()
+	for {
+		entry, err := reader.Read()
+		if err == io.EOF {
+			return fmt.Sprintf("sha256:%x", hasher.Sum(nil)), nil
+		} else if err != nil {
+			return "", err
+		}
+		if err := write(entry); err != nil {
+			return "", err
+		}
+	}
+}
+
+// VerifySwingStoreExport recomputes the hash of each artifact of provider and
+// checks it against the artifact metadata in trustedExportData, such as the
+// swing-store export data recorded on chain at the export's block height. If
+// the provider has export data of its own, it must match trustedExportData.
+// If trustedExportData is nil, the artifacts are checked against the
+// provider's own export data, which only shows the export to be consistent.
+// The readers are consumed whether or not the export verifies.
+func VerifySwingStoreExport(provider SwingStoreExportProvider, trustedExportData agoric.KVEntryReader) (result SwingStoreExportVerification, err error) {
+	result.BlockHeight = provider.BlockHeight
+
+	exportData, err := provider.GetExportDataReader()
+	if err != nil {
+		return result, err
+	}
+	if exportData != nil {
+		defer exportData.Close()
+	}
+
+	if trustedExportData == nil {
+		if exportData == nil {
+			return result, fmt.Errorf("swing-store export has no export data to verify against")
+		}
+		trustedExportData, exportData = exportData, nil
+	} else {
+		defer trustedExportData.Close()
+	}
+
+	index, trustedHash, err := indexExportData(trustedExportData)
+	if err != nil {
+		return result, err
+	}
+	result.ExportDataHash = trustedHash
+
+	if exportData != nil {
+		exportHash, err := hashExportData(exportData)
+		if err != nil {
+			return result, err
+		}
+		if exportHash != trustedHash {
+			return result, fmt.Errorf("swing-store export data hash %s doesn't match %s", exportHash, trustedHash)
+		}
+	}
+
+	for {
+		artifact, err := provider.ReadNextArtifact()
+		if err == io.EOF {
+			return result, nil
+		} else if err != nil {
+			return result, err
+		}
+
+		verified, err := index.verifyArtifact(artifact.Name, artifact.Data)
+		if err != nil {
+			return result, err
+		}
+		if verified {
+			result.VerifiedArtifacts++
+		} else {
+			result.UnverifiedArtifacts = append(result.UnverifiedArtifacts, artifact.Name)
+		}
+	}
+}
+
+// verifyArtifact checks the artifact data against its metadata, returning
+// false if the artifact's hash cannot be recomputed.
+func (index exportDataIndex) verifyArtifact(name string, data []byte) (bool, error) {
+	switch {
+	case strings.HasPrefix(name, bundleArtifactPrefix):
+		bundleID, ok := index.bundles[name]
+		if !ok {
+			return false, fmt.Errorf("no export data for bundle artifact %s", name)
+		}
+		if !strings.HasPrefix(bundleID, "b1-") {
+			return false, nil
+		}
+		sum := sha512.Sum512(data)
+		if got := hex.EncodeToString(sum[:]); got != bundleID[len("b1-"):] {
+			return false, fmt.Errorf("bundle artifact %s has hash %s", name, got)
+		}
+		return true, nil
+
+	case strings.HasPrefix(name, snapshotArtifactPrefix):
+		metadata, ok := index.snapshots[name]
+		if !ok {
+			return false, fmt.Errorf("no export data for snapshot artifact %s", name)
+		}
+		sum := sha256.Sum256(data)
+		if got := hex.EncodeToString(sum[:]); got != metadata.Hash {
+			return false, fmt.Errorf("snapshot artifact %s has hash %s, export data says %s", name, got, metadata.Hash)
+		}
+		return true, nil
+
+	case strings.HasPrefix(name, transcriptArtifactPrefix):
+		parts := strings.Split(name, ".")
+		if len(parts) != 4 {
+			return false, fmt.Errorf("invalid transcript artifact name %s", name)
+		}
+		endPos, err := strconv.ParseUint(parts[3], 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid transcript artifact name %s: %w", name, err)
+		}
+		metadata, ok := index.spans[parts[1]+"."+parts[2]]
+		if !ok {
+			return false, fmt.Errorf("no export data for transcript artifact %s", name)
+		}
+		if metadata.EndPos != endPos {
+			return false, fmt.Errorf("transcript artifact %s ends at %d, export data says %d", name, endPos, metadata.EndPos)
+		}
+		got, count := hashTranscriptSpan(data)
+		if count != metadata.EndPos-metadata.StartPos {
+			return false, fmt.Errorf("transcript artifact %s has %d items, export data says %d", name, count, metadata.EndPos-metadata.StartPos)
+		}
+		if got != metadata.Hash {
+			return false, fmt.Errorf("transcript artifact %s has hash %s, export data says %s", name, got, metadata.Hash)
+		}
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("unknown artifact type %s", name)
+	}
+}
+
+// hashTranscriptSpan returns the span hash of the newline-terminated items of
+// a transcript artifact, and the number of items.
+func hashTranscriptSpan(data []byte) (string, uint64) {
+	hexSha256 := func(parts ...[]byte) string {
+		h := sha256.New()
+		for _, part := range parts {
+			h.Write(part)
+		}
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	spanHash := hexSha256([]byte(transcriptSpanInitialSeed))
+	count := uint64(0)
+	for len(data) > 0 {
+		line := data
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			data = nil
+		}
+		item := bytes.TrimRight(line, " \t\r")
+		spanHash = hexSha256([]byte(spanHash), []byte(hexSha256(item)))
+		count++
+	}
+	return spanHash, count
+}
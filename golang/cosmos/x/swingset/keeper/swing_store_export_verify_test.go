@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// Hashes computed by the JS swing-store algorithms over the artifacts below.
+const (
+	testBundleID       = "b1-3bf65b9ff48d5208c97338c8824a43749726bf12a1f68ff554cb7b5e19c08be8f1a08dfe225871682579339eedfaf4718da420206f074fde4c7459fc880bb6a2"
+	testSnapshotHash   = "7783d47a378f6c3ca8d1b29aa1b688ff6b14d26bd3bf8bae14785138db1eff0c"
+	testTranscriptHash = "371e82a66d6a784d224d7ac974ea35d17c3f351ae2d138bfcaaba75806c43096"
+)
+
+func testExportData() []agoric.KVEntry {
+	return []agoric.KVEntry{
+		agoric.NewKVEntry("bundle."+testBundleID, testBundleID),
+		agoric.NewKVEntry("bundle.b0-old", "b0-old"),
+		agoric.NewKVEntry("kv.foo", "bar"),
+		agoric.NewKVEntry("snapshot.v1.2", `{"vatID":"v1","snapPos":2,"hash":"`+testSnapshotHash+`","inUse":1}`),
+		agoric.NewKVEntry("snapshot.v1.current", "snapshot.v1.2"),
+		agoric.NewKVEntry("transcript.v1.current", `{"vatID":"v1","startPos":3,"endPos":5,"hash":"`+testTranscriptHash+`","isCurrent":1,"incarnation":0}`),
+	}
+}
+
+func testArtifacts() []types.SwingStoreArtifact {
+	return []types.SwingStoreArtifact{
+		{Name: "bundle." + testBundleID, Data: []byte("zip-bytes")},
+		{Name: "bundle.b0-old", Data: []byte("{}")},
+		{Name: "snapshot.v1.2", Data: []byte("snapshot-bytes")},
+		{Name: "transcript.v1.3.5", Data: []byte("{\"d\":1}\n{\"d\":2}\n")},
+	}
+}
+
+func newTestExportProvider(exportData []agoric.KVEntry, artifacts []types.SwingStoreArtifact) SwingStoreExportProvider {
+	next := 0
+	return SwingStoreExportProvider{
+		BlockHeight: 10,
+		GetExportDataReader: func() (agoric.KVEntryReader, error) {
+			if exportData == nil {
+				return nil, nil
+			}
+			return newTestKVEntryReader(exportData), nil
+		},
+		ReadNextArtifact: func() (types.SwingStoreArtifact, error) {
+			if next == len(artifacts) {
+				return types.SwingStoreArtifact{}, io.EOF
+			}
+			next++
+			return artifacts[next-1], nil
+		},
+	}
+}
+
+type testKVEntryReader struct {
+	entries []agoric.KVEntry
+}
+
+func newTestKVEntryReader(entries []agoric.KVEntry) agoric.KVEntryReader {
+	return &testKVEntryReader{entries}
+}
+
+func (r *testKVEntryReader) Read() (agoric.KVEntry, error) {
+	if len(r.entries) == 0 {
+		return agoric.KVEntry{}, io.EOF
+	}
+	entry := r.entries[0]
+	r.entries = r.entries[1:]
+	return entry, nil
+}
+
+func (r *testKVEntryReader) Close() error {
+	return nil
+}
+
+func TestVerifySwingStoreExport(t *testing.T) {
+	dir := t.TempDir()
+	err := WriteSwingStoreExportToDirectory(newTestExportProvider(testExportData(), testArtifacts()), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := OpenSwingStoreExportDirectory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := VerifySwingStoreExport(provider, newTestKVEntryReader(testExportData()))
+	if err != nil {
+		t.Fatalf("cannot verify export: %v", err)
+	}
+	if result.BlockHeight != 10 || result.VerifiedArtifacts != 3 ||
+		len(result.UnverifiedArtifacts) != 1 || result.UnverifiedArtifacts[0] != "bundle.b0-old" ||
+		!strings.HasPrefix(result.ExportDataHash, "sha256:") {
+		t.Errorf("unexpected verification %+v", result)
+	}
+
+	// Verifying against the export's own export data gives the same hash.
+	offline, err := VerifySwingStoreExport(newTestExportProvider(testExportData(), testArtifacts()), nil)
+	if err != nil {
+		t.Fatalf("cannot verify export offline: %v", err)
+	}
+	if offline.ExportDataHash != result.ExportDataHash {
+		t.Errorf("got offline hash %s, want %s", offline.ExportDataHash, result.ExportDataHash)
+	}
+
+	for _, tc := range []struct {
+		label      string
+		provider   SwingStoreExportProvider
+		chainData  []agoric.KVEntry
+		errPattern string
+	}{
+		{
+			label:      "no export data",
+			provider:   newTestExportProvider(nil, testArtifacts()),
+			errPattern: "no export data",
+		},
+		{
+			label:      "mismatched export data",
+			provider:   newTestExportProvider(testExportData(), testArtifacts()),
+			chainData:  append(testExportData(), agoric.NewKVEntry("kv.extra", "")),
+			errPattern: "doesn't match",
+		},
+		{
+			label: "corrupt snapshot",
+			provider: newTestExportProvider(nil, []types.SwingStoreArtifact{
+				{Name: "snapshot.v1.2", Data: []byte("snapshot-bytez")},
+			}),
+			chainData:  testExportData(),
+			errPattern: "snapshot artifact snapshot.v1.2 has hash",
+		},
+		{
+			label: "truncated transcript",
+			provider: newTestExportProvider(nil, []types.SwingStoreArtifact{
+				{Name: "transcript.v1.3.5", Data: []byte("{\"d\":1}\n")},
+			}),
+			chainData:  testExportData(),
+			errPattern: "has 1 items",
+		},
+		{
+			label: "corrupt bundle",
+			provider: newTestExportProvider(nil, []types.SwingStoreArtifact{
+				{Name: "bundle." + testBundleID, Data: []byte("zap-bytes")},
+			}),
+			chainData:  testExportData(),
+			errPattern: "bundle artifact",
+		},
+		{
+			label: "unknown artifact",
+			provider: newTestExportProvider(nil, []types.SwingStoreArtifact{
+				{Name: "snapshot.v9.1", Data: []byte("")},
+			}),
+			chainData:  testExportData(),
+			errPattern: "no export data for snapshot artifact",
+		},
+	} {
+		t.Run(tc.label, func(t *testing.T) {
+			var chainData agoric.KVEntryReader
+			if tc.chainData != nil {
+				chainData = newTestKVEntryReader(tc.chainData)
+			}
+			_, err := VerifySwingStoreExport(tc.provider, chainData)
+			if err == nil || !strings.Contains(err.Error(), tc.errPattern) {
+				t.Errorf("got error %v, want %q", err, tc.errPattern)
+			}
+		})
+	}
+}
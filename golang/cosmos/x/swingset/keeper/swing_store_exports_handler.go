@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -407,8 +408,17 @@ type SwingStoreExportProvider struct {
 	// ReadNextArtifact is a function to return the next unread artifact in the SwingStore export.
 	// It errors with io.EOF upon reaching the end of the list of available artifacts.
 	ReadNextArtifact func() (types.SwingStoreArtifact, error)
+	// NextArtifactLoader optionally returns a loader of the next unread artifact,
+	// without reading it. Unlike ReadNextArtifact, loaders may be called
+	// concurrently. It errors with io.EOF upon reaching the end of the list of
+	// available artifacts. A provider must be read either with ReadNextArtifact
+	// or with NextArtifactLoader, not both.
+	NextArtifactLoader func() (SwingStoreArtifactLoader, error)
 }
 
+// SwingStoreArtifactLoader reads an artifact of a SwingStore export.
+type SwingStoreArtifactLoader func() (types.SwingStoreArtifact, error)
+
 // SwingStoreExportEventHandler is used to handle events that occur while generating
 // a swing-store export. It is provided to SwingStoreExportsHandler.InitiateExport.
 type SwingStoreExportEventHandler interface {
@@ -691,11 +701,11 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 
 	nextArtifact := 0
 
-	readNextArtifact := func() (artifact types.SwingStoreArtifact, err error) {
+	nextArtifactLoader := func() (SwingStoreArtifactLoader, error) {
 		if nextArtifact == len(manifest.Artifacts) {
-			return artifact, io.EOF
+			return nil, io.EOF
 		} else if nextArtifact > len(manifest.Artifacts) {
-			return artifact, fmt.Errorf("exceeded expected artifact count: %d > %d", nextArtifact, len(manifest.Artifacts))
+			return nil, fmt.Errorf("exceeded expected artifact count: %d > %d", nextArtifact, len(manifest.Artifacts))
 		}
 
 		artifactEntry := manifest.Artifacts[nextArtifact]
@@ -704,15 +714,45 @@ func OpenSwingStoreExportDirectory(exportDir string) (SwingStoreExportProvider,
 		artifactName := artifactEntry[0]
 		fileName := artifactEntry[1]
 		if artifactName == UntrustedExportDataArtifactName {
-			return artifact, fmt.Errorf("unexpected export artifact name %s", artifactName)
+			return nil, fmt.Errorf("unexpected export artifact name %s", artifactName)
 		}
-		artifact.Name = artifactName
-		artifact.Data, err = os.ReadFile(filepath.Join(exportDir, fileName))
+		return func() (artifact types.SwingStoreArtifact, err error) {
+			artifact.Name = artifactName
+			artifact.Data, err = os.ReadFile(filepath.Join(exportDir, fileName))
+			return artifact, err
+		}, nil
+	}
 
-		return artifact, err
+	readNextArtifact := func() (types.SwingStoreArtifact, error) {
+		load, err := nextArtifactLoader()
+		if err != nil {
+			return types.SwingStoreArtifact{}, err
+		}
+		return load()
 	}
 
-	return SwingStoreExportProvider{BlockHeight: manifest.BlockHeight, GetExportDataReader: getExportDataReader, ReadNextArtifact: readNextArtifact}, nil
+	return SwingStoreExportProvider{
+		BlockHeight:         manifest.BlockHeight,
+		GetExportDataReader: getExportDataReader,
+		ReadNextArtifact:    readNextArtifact,
+		NextArtifactLoader:  nextArtifactLoader,
+	}, nil
+}
+
+// HashSwingStoreExportManifest returns the "sha256:<hex>" digest of the
+// export manifest in the provided directory, by which an exported genesis
+// refers to the swing-store export saved beside it, and the block height of
+// the manifest, which is 0 if it has none.
+func HashSwingStoreExportManifest(exportDir string) (string, uint64, error) {
+	rawManifest, err := os.ReadFile(filepath.Join(exportDir, ExportManifestFilename))
+	if err != nil {
+		return "", 0, err
+	}
+	var manifest exportManifest
+	if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+		return "", 0, err
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(rawManifest)), manifest.BlockHeight, nil
 }
 
 // RestoreExport restores the JS swing-store using previously exported data and artifacts.
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const timerStatusKey = "timer"
+
+// endBlockReply is the reply of SwingSet to END_BLOCK, per `launch-chain.js`
+// in packages/cosmic-swingset.
+type endBlockReply struct {
+	Timer *struct {
+		PendingWakeups uint64 `json:"pendingWakeups"`
+		Repeaters      uint64 `json:"repeaters"`
+		NextWakeup     int64  `json:"nextWakeup"`
+	} `json:"timer"`
+	// VatComputrons maps the ID of each vat that ran in the block to the
+	// computrons it used, as a decimal string.
+	VatComputrons map[string]string `json:"vatComputrons"`
+	// PolicyHeadroom is the beans left of the block compute limit when the
+	// run policy stopped, as a decimal string, or absent if the block was run
+	// without a limit.
+	PolicyHeadroom *string `json:"policyHeadroom"`
+	// VatBundleIDs maps the ID of each live vat created from a bundle ID to
+	// the ID of the bundle it now runs.
+	VatBundleIDs map[string]string `json:"vatBundleIDs"`
+}
+
+// GetTimerStatus returns the timer device status recorded at the end of the
+// latest block, which is empty before the first.
+func (k Keeper) GetTimerStatus(ctx sdk.Context) types.TimerStatus {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(timerStatusKey))
+	status := types.TimerStatus{}
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &status)
+	}
+	return status
+}
+
+// UpdateTimerStatus records the timer device status of the current block,
+// given the time delivered to the timer by END_BLOCK and SwingSet's reply to
+// it. A reply without timer stats (such as from an older SwingSet) records
+// only the time.
+func (k Keeper) UpdateTimerStatus(ctx sdk.Context, timestamp int64, reply string) {
+	status := types.TimerStatus{
+		LastTimestamp: timestamp,
+		BlockHeight:   ctx.BlockHeight(),
+	}
+	var parsed endBlockReply
+	if err := json.Unmarshal([]byte(reply), &parsed); err == nil && parsed.Timer != nil {
+		status.PendingWakeups = parsed.Timer.PendingWakeups
+		status.Repeaters = parsed.Timer.Repeaters
+		status.NextWakeup = parsed.Timer.NextWakeup
+		status.Reported = true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(timerStatusKey), k.cdc.MustMarshal(&status))
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const upgradeStepKeyPrefix = "upgradeStep."
+
+func (k Keeper) getUpgradeStepStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(upgradeStepKeyPrefix))
+}
+
+// GetUpgradeStepDoneHeight returns the height at which the named upgrade step
+// was enqueued for SwingSet, or 0 if it has not been.
+func (k Keeper) GetUpgradeStepDoneHeight(ctx sdk.Context, name string) int64 {
+	bz := k.getUpgradeStepStore(ctx).Get([]byte(name))
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetUpgradeStepDone records that the named upgrade step has been enqueued for
+// SwingSet at the current height, so that later upgrades don't enqueue it
+// again.
+func (k Keeper) SetUpgradeStepDone(ctx sdk.Context, name string) {
+	k.SetUpgradeStep(ctx, types.UpgradeStepRecord{Name: name, DoneHeight: ctx.BlockHeight()})
+}
+
+// SetUpgradeStep stores the record of an enqueued upgrade step, as imported
+// from genesis.
+func (k Keeper) SetUpgradeStep(ctx sdk.Context, record types.UpgradeStepRecord) {
+	k.getUpgradeStepStore(ctx).Set([]byte(record.Name), sdk.Uint64ToBigEndian(uint64(record.DoneHeight)))
+}
+
+// GetUpgradeSteps returns the records of the enqueued upgrade steps, for
+// export.
+func (k Keeper) GetUpgradeSteps(ctx sdk.Context) []types.UpgradeStepRecord {
+	iterator := k.getUpgradeStepStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.UpgradeStepRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.UpgradeStepRecord{
+			Name:       string(iterator.Key()),
+			DoneHeight: int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	return records
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+const vatOwnerKeyPrefix = "vatOwner."
+
+// billingDenom is the denomination in which vat owners are billed.
+const billingDenom = "uist"
+
+// suspendVatAction asks SwingSet to hold the deliveries to a vat whose owner
+// cannot pay for it.
+type suspendVatAction struct {
+	*vm.ActionHeader `actionType:"SUSPEND_VAT"`
+	VatID            string `json:"vatID"`
+}
+
+// resumeVatAction asks SwingSet to resume the deliveries to a suspended vat.
+type resumeVatAction struct {
+	*vm.ActionHeader `actionType:"RESUME_VAT"`
+	VatID            string `json:"vatID"`
+}
+
+func (k Keeper) getVatOwnerStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, []byte(vatOwnerKeyPrefix))
+}
+
+// GetVatOwner returns the billing record of vatID, and whether it has one.
+func (k Keeper) GetVatOwner(ctx sdk.Context, vatID string) (types.VatOwner, bool) {
+	bz := k.getVatOwnerStore(ctx).Get([]byte(vatID))
+	if bz == nil {
+		return types.VatOwner{}, false
+	}
+	record := types.VatOwner{}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetVatOwner stores the billing record of a vat.
+func (k Keeper) SetVatOwner(ctx sdk.Context, record types.VatOwner) {
+	k.getVatOwnerStore(ctx).Set([]byte(record.VatID), k.cdc.MustMarshal(&record))
+}
+
+// DeleteVatOwner removes the billing record of vatID.
+func (k Keeper) DeleteVatOwner(ctx sdk.Context, vatID string) {
+	k.getVatOwnerStore(ctx).Delete([]byte(vatID))
+}
+
+// GetVatOwners returns the billing records of all the registered vats, in
+// order of vat ID.
+func (k Keeper) GetVatOwners(ctx sdk.Context) []types.VatOwner {
+	iterator := k.getVatOwnerStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.VatOwner{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.VatOwner{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// RegisterVatOwner makes owner the account billed for vatID, keeping any cost
+// the vat has accrued but not paid, or removes the registration if owner is
+// empty, resuming the vat if it was suspended.
+func (k Keeper) RegisterVatOwner(ctx sdk.Context, vatID, owner string) error {
+	record, found := k.GetVatOwner(ctx, vatID)
+	if owner == "" {
+		if found && record.Suspended {
+			if err := k.PushHighPriorityAction(ctx, resumeVatAction{VatID: vatID}); err != nil {
+				return err
+			}
+		}
+		k.DeleteVatOwner(ctx, vatID)
+		return nil
+	}
+	if record.Unbilled.IsNil() {
+		record.Unbilled = sdk.ZeroDec()
+	}
+	record.VatID = vatID
+	record.Owner = owner
+	k.SetVatOwner(ctx, record)
+	return nil
+}
+
+// billVat returns the uist to charge the owner of a vat for its use of
+// computrons at price, given the spendable uist of the owner, and the updated
+// billing record.  Costs too small to charge accrue in the record, as does
+// whatever the owner cannot pay, to be charged once it can.  The record is
+// suspended while the owner owes a whole uist it cannot pay.
+func billVat(record types.VatOwner, price sdk.Dec, computrons sdkmath.Uint, spendable sdkmath.Int) (sdkmath.Int, types.VatOwner) {
+	due := price.MulInt(sdkmath.NewIntFromBigInt(computrons.BigInt()))
+	if !record.Unbilled.IsNil() {
+		due = due.Add(record.Unbilled)
+	}
+	charge := due.TruncateInt()
+	record.Suspended = charge.GT(spendable)
+	if record.Suspended {
+		charge = spendable
+	}
+	record.Unbilled = due.Sub(sdk.NewDecFromInt(charge))
+	return charge, record
+}
+
+// BillVats charges the owners of the registered vats for the computrons used
+// by their vats in the current block, given SwingSet's reply to END_BLOCK,
+// and retries the charges of the suspended vats.  A vat is suspended when its
+// owner cannot pay what it owes, and resumed once the owner has paid.  Nothing
+// accrues while the computron_price_uist parameter is zero.  A vat that
+// cannot be billed is logged and skipped, since billing must not halt the
+// chain.
+func (k Keeper) BillVats(ctx sdk.Context, reply string) {
+	var parsed endBlockReply
+	if err := json.Unmarshal([]byte(reply), &parsed); err != nil {
+		return
+	}
+	price := k.GetParams(ctx).GetComputronPriceUist()
+
+	// GetVatOwners iterates in a deterministic order.
+	for _, record := range k.GetVatOwners(ctx) {
+		computronsStr, ran := parsed.VatComputrons[record.VatID]
+		if !ran && !record.Suspended {
+			continue
+		}
+		if !ran {
+			computronsStr = "0"
+		}
+		if err := k.billVatComputrons(ctx, record, price, computronsStr); err != nil {
+			ctx.Logger().Error("cannot bill vat for computrons", "vatID", record.VatID, "err", err)
+		}
+	}
+}
+
+// billVatComputrons charges the owner of a vat for the computrons it used,
+// suspending or resuming the vat as its owner's ability to pay changes, and
+// changing nothing if the charge fails.
+func (k Keeper) billVatComputrons(ctx sdk.Context, record types.VatOwner, price sdk.Dec, computronsStr string) error {
+	computrons, err := sdkmath.ParseUint(computronsStr)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	wasSuspended := record.Suspended
+	spendable := k.bankKeeper.SpendableCoins(ctx, owner).AmountOf(billingDenom)
+	charge, record := billVat(record, price, computrons, spendable)
+	cacheCtx, writeCache := ctx.CacheContext()
+	if charge.IsPositive() {
+		amt := sdk.NewCoins(sdk.NewCoin(billingDenom, charge))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, owner, k.feeCollectorName, amt); err != nil {
+			return err
+		}
+	}
+	switch {
+	case record.Suspended && !wasSuspended:
+		err = k.PushHighPriorityAction(cacheCtx, suspendVatAction{VatID: record.VatID})
+	case !record.Suspended && wasSuspended:
+		err = k.PushHighPriorityAction(cacheCtx, resumeVatAction{VatID: record.VatID})
+	}
+	if err != nil {
+		return err
+	}
+	k.SetVatOwner(cacheCtx, record)
+	writeCache()
+	return nil
+}
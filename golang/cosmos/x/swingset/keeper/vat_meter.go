@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"encoding/json"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// VatMeter is the computron usage of a vat, as stored at its vat meter path.
+type VatMeter struct {
+	// BlockHeight is the height of the latest block in which the vat ran.
+	BlockHeight int64 `json:"blockHeight"`
+	// Computrons is the usage of the vat in that block.
+	Computrons sdkmath.Uint `json:"computrons"`
+	// TotalComputrons is the usage of the vat over all the recorded blocks.
+	TotalComputrons sdkmath.Uint `json:"totalComputrons"`
+}
+
+// VatMeterPath returns the vstorage path of the computron usage of vatID.
+func VatMeterPath(vatID string) string {
+	return StoragePathSwingset + ".vats." + vatID + ".meter"
+}
+
+// GetVatMeter returns the computron usage recorded for vatID, which is zero
+// before the vat first runs.
+func (k Keeper) GetVatMeter(ctx sdk.Context, vatID string) VatMeter {
+	meter := VatMeter{Computrons: sdkmath.ZeroUint(), TotalComputrons: sdkmath.ZeroUint()}
+	entry := k.vstorageKeeper.GetEntry(ctx, VatMeterPath(vatID))
+	if !entry.HasValue() {
+		return meter
+	}
+	if err := json.Unmarshal([]byte(entry.StringValue()), &meter); err != nil {
+		panic(err)
+	}
+	return meter
+}
+
+// UpdateVatMeters records the computrons used by each vat in the current
+// block, given SwingSet's reply to END_BLOCK. Vats that did not run keep
+// their previous records. Malformed usage is ignored, since it must not halt
+// the chain.
+func (k Keeper) UpdateVatMeters(ctx sdk.Context, reply string) {
+	var parsed endBlockReply
+	if err := json.Unmarshal([]byte(reply), &parsed); err != nil {
+		return
+	}
+
+	// Iterate in a deterministic order.
+	vatIDs := make([]string, 0, len(parsed.VatComputrons))
+	for vatID := range parsed.VatComputrons {
+		vatIDs = append(vatIDs, vatID)
+	}
+	sort.Strings(vatIDs)
+
+	for _, vatID := range vatIDs {
+		computrons, err := sdkmath.ParseUint(parsed.VatComputrons[vatID])
+		if err != nil {
+			ctx.Logger().Error("invalid vat computrons", "vatID", vatID, "err", err)
+			continue
+		}
+		path := VatMeterPath(vatID)
+		if err := vstoragetypes.ValidatePath(path); err != nil {
+			ctx.Logger().Error("invalid vat meter path", "vatID", vatID, "err", err)
+			continue
+		}
+		meter := k.GetVatMeter(ctx, vatID)
+		meter.BlockHeight = ctx.BlockHeight()
+		meter.Computrons = computrons
+		meter.TotalComputrons = meter.TotalComputrons.Add(computrons)
+		bz, err := json.Marshal(meter)
+		if err != nil {
+			panic(err)
+		}
+		k.vstorageKeeper.SetStorageAndNotify(ctx, agoric.NewKVEntry(path, string(bz)))
+	}
+}
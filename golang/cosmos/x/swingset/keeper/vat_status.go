@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// The swing-store export data keys consulted for vat status. These mirror the
+// formats written by packages/swing-store (kvStore, snapStore, and
+// transcriptStore) and the kernel state layout documented in
+// packages/SwingSet/src/kernel/state/kernelKeeper.js.
+const (
+	swingStoreKVPrefix           = "kv."
+	swingStoreVatNamesKey        = swingStoreKVPrefix + "vat.names"
+	swingStoreVatNamePrefix      = swingStoreKVPrefix + "vat.name."
+	swingStoreDynamicIDsKey      = swingStoreKVPrefix + "vat.dynamicIDs"
+	swingStoreRunQueueKey        = swingStoreKVPrefix + "runQueue"
+	swingStoreAcceptanceQueueKey = swingStoreKVPrefix + "acceptanceQueue"
+	swingStoreSnapshotPrefix     = "snapshot."
+	swingStoreTranscriptPrefix   = "transcript."
+	swingStoreCurrentSuffix      = ".current"
+)
+
+// GetVatsStatus reports the vats known to the kernel, along with the current
+// depths of the kernel and inbound queues.
+func (k Keeper) GetVatsStatus(ctx sdk.Context) (*types.QueryVatsResponse, error) {
+	res, err := readVatsStatus(k.GetSwingStore(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	inboundQueueLength, err := k.InboundQueueLength(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res.InboundQueueLength = uint64(inboundQueueLength)
+
+	return res, nil
+}
+
+// readVatsStatus extracts vat and kernel queue status from swing-store export
+// data. Missing entries are treated as empty, so that a chain which has not
+// yet booted the kernel reports no vats rather than an error.
+func readVatsStatus(swingStore sdk.KVStore) (*types.QueryVatsResponse, error) {
+	res := &types.QueryVatsResponse{
+		Vats: []types.VatStatus{},
+	}
+
+	var names []string
+	if err := readSwingStoreJSON(swingStore, swingStoreVatNamesKey, &names); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		vatID := swingStore.Get([]byte(swingStoreVatNamePrefix + name))
+		if vatID == nil {
+			continue
+		}
+		status, err := readVatStatus(swingStore, string(vatID))
+		if err != nil {
+			return nil, err
+		}
+		status.Name = name
+		res.Vats = append(res.Vats, status)
+	}
+
+	var dynamicIDs []string
+	if err := readSwingStoreJSON(swingStore, swingStoreDynamicIDsKey, &dynamicIDs); err != nil {
+		return nil, err
+	}
+	for _, vatID := range dynamicIDs {
+		status, err := readVatStatus(swingStore, vatID)
+		if err != nil {
+			return nil, err
+		}
+		var options struct {
+			Name string `json:"name"`
+		}
+		if err := readSwingStoreJSON(swingStore, swingStoreKVPrefix+vatID+".options", &options); err != nil {
+			return nil, err
+		}
+		status.Name = options.Name
+		res.Vats = append(res.Vats, status)
+	}
+
+	var err error
+	res.RunQueueLength, err = readSwingStoreQueueLength(swingStore, swingStoreRunQueueKey)
+	if err != nil {
+		return nil, err
+	}
+	res.AcceptanceQueueLength, err = readSwingStoreQueueLength(swingStore, swingStoreAcceptanceQueueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func readVatStatus(swingStore sdk.KVStore, vatID string) (types.VatStatus, error) {
+	status := types.VatStatus{VatID: vatID}
+
+	var transcript struct {
+		Incarnation uint64 `json:"incarnation"`
+	}
+	transcriptKey := swingStoreTranscriptPrefix + vatID + swingStoreCurrentSuffix
+	if err := readSwingStoreJSON(swingStore, transcriptKey, &transcript); err != nil {
+		return status, err
+	}
+	status.Incarnation = transcript.Incarnation
+
+	// The current snapshot entry names the metadata entry of the snapshot in use.
+	current := swingStore.Get([]byte(swingStoreSnapshotPrefix + vatID + swingStoreCurrentSuffix))
+	if current != nil {
+		var snapshot struct {
+			SnapPos uint64 `json:"snapPos"`
+		}
+		if err := readSwingStoreJSON(swingStore, string(current), &snapshot); err != nil {
+			return status, err
+		}
+		status.SnapshotPos = snapshot.SnapPos
+	}
+
+	return status, nil
+}
+
+// readSwingStoreQueueLength reads a kernel queue stored as JSON([head, tail]).
+func readSwingStoreQueueLength(swingStore sdk.KVStore, key string) (uint64, error) {
+	var bounds []uint64
+	if err := readSwingStoreJSON(swingStore, key, &bounds); err != nil {
+		return 0, err
+	}
+	if bounds == nil {
+		return 0, nil
+	}
+	if len(bounds) != 2 || bounds[1] < bounds[0] {
+		return 0, fmt.Errorf("invalid swing-store queue %q: %v", key, bounds)
+	}
+	return bounds[1] - bounds[0], nil
+}
+
+// readSwingStoreJSON unmarshals the value at key into target, leaving target
+// untouched if there is no such entry.
+func readSwingStoreJSON(swingStore sdk.KVStore, key string, target interface{}) error {
+	bz := swingStore.Get([]byte(key))
+	if bz == nil {
+		return nil
+	}
+	if err := json.Unmarshal(bz, target); err != nil {
+		return fmt.Errorf("cannot parse swing-store entry %q: %w", key, err)
+	}
+	return nil
+}
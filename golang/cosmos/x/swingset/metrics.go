@@ -0,0 +1,55 @@
+package swingset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// Kernel stats gauges, exported as swingset_* metrics by the telemetry sink.
+var (
+	metricKeyCrankCount      = []string{types.ModuleName, "crank_count"}
+	metricKeyBlockComputrons = []string{types.ModuleName, "block_computrons"}
+	metricKeyVatHeapSize     = []string{types.ModuleName, "vat_heap_size"}
+)
+
+// kernelStats is the payload of a reportKernelStats message, sent by
+// packages/cosmic-swingset/src/launch-chain.js at the end of each block.
+type kernelStats struct {
+	// CrankCount is the kernel's total number of cranks to date.
+	CrankCount uint64 `json:"crankCount"`
+	// BlockComputrons is the number of computrons consumed during the block.
+	BlockComputrons uint64 `json:"blockComputrons"`
+	// VatHeapSizes maps vatID to the heap size (in xsnap heap slots) reported
+	// by that vat's most recent delivery.
+	VatHeapSizes map[string]uint64 `json:"vatHeapSizes"`
+}
+
+// emit records the stats as telemetry gauges.
+func (stats kernelStats) emit() {
+	telemetry.SetGauge(float32(stats.CrankCount), metricKeyCrankCount...)
+	telemetry.SetGauge(float32(stats.BlockComputrons), metricKeyBlockComputrons...)
+	for vatID, heapSize := range stats.VatHeapSizes {
+		telemetry.SetGaugeWithLabels(
+			metricKeyVatHeapSize,
+			float32(heapSize),
+			[]metrics.Label{telemetry.NewLabel("vat_id", vatID)},
+		)
+	}
+}
+
+func (ph portHandler) handleReportKernelStats(args []json.RawMessage) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected 1 argument to %s, got %d", ReportKernelStats, len(args))
+	}
+	var stats kernelStats
+	if err := json.Unmarshal(args[0], &stats); err != nil {
+		return "", err
+	}
+	stats.emit()
+	return "true", nil
+}
@@ -0,0 +1,56 @@
+package swingset
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+)
+
+func TestReportKernelStats(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	if _, err := metrics.NewGlobal(conf, sink); err != nil {
+		t.Fatal(err)
+	}
+
+	ph := portHandler{}
+	args := []json.RawMessage{
+		json.RawMessage(`{"crankCount":42,"blockComputrons":123456,"vatHeapSizes":{"v1":1000,"v7":2000}}`),
+	}
+	if _, err := ph.handleReportKernelStats(args); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	intervals := sink.Data()
+	if len(intervals) == 0 {
+		t.Fatal("no metrics recorded")
+	}
+	gauges := intervals[0].Gauges
+	expected := map[string]float32{
+		"swingset.crank_count":             42,
+		"swingset.block_computrons":        123456,
+		"swingset.vat_heap_size;vat_id=v1": 1000,
+		"swingset.vat_heap_size;vat_id=v7": 2000,
+	}
+	for key, want := range expected {
+		got, ok := gauges[key]
+		if !ok {
+			t.Errorf("missing gauge %q; have %v", key, gauges)
+			continue
+		}
+		if got.Value != want {
+			t.Errorf("gauge %q: got %v, want %v", key, got.Value, want)
+		}
+	}
+
+	if _, err := ph.handleReportKernelStats(nil); err == nil {
+		t.Error("expected error for missing argument")
+	}
+	if _, err := ph.handleReportKernelStats([]json.RawMessage{json.RawMessage(`"bogus"`)}); err == nil {
+		t.Error("expected error for malformed stats")
+	}
+}
@@ -81,6 +81,8 @@ type AppModule struct {
 	ensureControllerInited   func(sdk.Context)
 	swingStoreExportDir      string
 	swingStoreExportMode     string
+	genesisFile              string
+	genesisOverrides         string
 }
 
 // NewAppModule creates a new AppModule Object
@@ -91,6 +93,8 @@ func NewAppModule(
 	ensureControllerInited func(sdk.Context),
 	swingStoreExportDir string,
 	swingStoreExportMode string,
+	genesisFile string,
+	genesisOverrides string,
 ) AppModule {
 	am := AppModule{
 		AppModuleBasic:           AppModuleBasic{},
@@ -100,6 +104,8 @@ func NewAppModule(
 		ensureControllerInited:   ensureControllerInited,
 		swingStoreExportDir:      swingStoreExportDir,
 		swingStoreExportMode:     swingStoreExportMode,
+		genesisFile:              genesisFile,
+		genesisOverrides:         genesisOverrides,
 	}
 	return am
 }
@@ -137,9 +143,13 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	am.ensureControllerInited(ctx)
@@ -173,7 +183,16 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	am.checkSwingStoreExportSetup()
-	bootstrapNeeded := InitGenesis(ctx, am.keeper, am.swingStoreExportsHandler, am.swingStoreExportDir, &genesisState)
+	var overrides keeper.GenesisOverrides
+	if am.genesisOverrides != "" {
+		var err error
+		overrides, err = keeper.ReadGenesisOverrides(am.genesisOverrides)
+		if err != nil {
+			panic(err)
+		}
+	}
+	swingStoreExportDir := ResolveSwingStoreExportDir(am.genesisFile, am.swingStoreExportDir, &genesisState)
+	bootstrapNeeded := InitGenesis(ctx, am.keeper, am.swingStoreExportsHandler, swingStoreExportDir, overrides, &genesisState)
 	if bootstrapNeeded {
 		am.setBootstrapNeeded()
 	}
@@ -0,0 +1,123 @@
+package swingset
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgWalletAction = "op_weight_msg_wallet_action" //nolint:gosec
+
+	DefaultWeightMsgWalletAction = 100
+)
+
+var TypeMsgWalletAction = sdk.MsgTypeURL(&types.MsgWalletAction{})
+
+var _ module.AppModuleSimulation = AppModuleSimulation{}
+
+// AppModuleSimulation implements module.AppModuleSimulation for x/swingset,
+// generating randomized smart wallet actions. It needs the account and bank
+// keepers to sign and pay for the transactions it generates.
+type AppModuleSimulation struct {
+	keeper        Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+}
+
+// NewAppModuleSimulation returns the simulation of x/swingset for the
+// simulation manager.
+func NewAppModuleSimulation(k Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) AppModuleSimulation {
+	return AppModuleSimulation{keeper: k, accountKeeper: ak, bankKeeper: bk}
+}
+
+// GenerateGenesisState creates a randomized GenesisState of x/swingset.
+func (AppModuleSimulation) GenerateGenesisState(simState *module.SimulationState) {
+	genesis := DefaultGenesisState()
+	genesis.Params.BlockTimeQuantumSeconds = uint64(simState.Rand.Intn(60))
+	genesis.Params.InboundDedupWindowBlocks = uint64(simState.Rand.Intn(100))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// ProposalContents returns no governance proposal contents.
+func (AppModuleSimulation) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized param changes for the simulator.
+func (AppModuleSimulation) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyBlockTimeQuantumSeconds),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf(`"%d"`, r.Intn(60))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyInboundDedupWindowBlocks),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf(`"%d"`, r.Intn(100))
+			},
+		),
+	}
+}
+
+// RegisterStoreDecoder registers no decoders, since the x/swingset store
+// holds opaque swing-store data.
+func (AppModuleSimulation) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the x/swingset operations with their weights.
+func (am AppModuleSimulation) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	var weightMsgWalletAction int
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgWalletAction, &weightMsgWalletAction, nil,
+		func(_ *rand.Rand) {
+			weightMsgWalletAction = DefaultWeightMsgWalletAction
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgWalletAction,
+			SimulateMsgWalletAction(am.keeper, am.accountKeeper, am.bankKeeper),
+		),
+	}
+}
+
+// SimulateMsgWalletAction generates a MsgWalletAction with a random action
+// from an account whose smart wallet is provisioned, so that the message is
+// not charged for provisioning.
+func SimulateMsgWalletAction(k Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		if k.GetSmartWalletState(ctx, owner.Address) != types.SmartWalletStateProvisioned {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgWalletAction, "smart wallet not provisioned"), nil, nil
+		}
+
+		action := fmt.Sprintf(`{"method":"tryExitOffer","offerId":"sim-%d"}`, r.Int63())
+		msg := types.NewMsgWalletAction(owner.Address, action)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Msg:           msg,
+			MsgType:       TypeMsgWalletAction,
+			Context:       ctx,
+			SimAccount:    owner,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
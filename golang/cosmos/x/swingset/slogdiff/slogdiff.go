@@ -0,0 +1,319 @@
+// Package slogdiff compares the SwingSet logs ("slogs") of two nodes crank by
+// crank, to find the first delivery at which their kernels diverged.
+package slogdiff
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Syscall is a syscall made by a delivery, and its result.
+type Syscall struct {
+	Request json.RawMessage `json:"ksc,omitempty"`
+	Result  json.RawMessage `json:"ksr,omitempty"`
+}
+
+// Crank is what a slog records of a kernel crank.  Only the kernel's view of a
+// delivery is kept, since the vat's view follows from it, and timings are
+// dropped, since they are expected to differ between nodes.
+type Crank struct {
+	CrankNum     uint64          `json:"crankNum"`
+	CrankType    string          `json:"crankType,omitempty"`
+	VatID        string          `json:"vatID,omitempty"`
+	DeliveryNum  uint64          `json:"deliveryNum,omitempty"`
+	Delivery     json.RawMessage `json:"kd,omitempty"`
+	Syscalls     []Syscall       `json:"syscalls,omitempty"`
+	Result       json.RawMessage `json:"dr,omitempty"`
+	CrankHash    string          `json:"crankhash,omitempty"`
+	ActivityHash string          `json:"activityhash,omitempty"`
+}
+
+// slogEntry holds the fields of the slog entries of interest.
+type slogEntry struct {
+	Type           string          `json:"type"`
+	CrankNum       *uint64         `json:"crankNum"`
+	CrankType      string          `json:"crankType"`
+	VatID          string          `json:"vatID"`
+	DeliveryNum    uint64          `json:"deliveryNum"`
+	SyscallNum     *int            `json:"syscallNum"`
+	Replay         bool            `json:"replay"`
+	KernelDelivery json.RawMessage `json:"kd"`
+	KernelSyscall  json.RawMessage `json:"ksc"`
+	KernelResult   json.RawMessage `json:"ksr"`
+	DeliveryResult json.RawMessage `json:"dr"`
+	CrankHash      string          `json:"crankhash"`
+	ActivityHash   string          `json:"activityhash"`
+}
+
+// crankLog accumulates the cranks of a slog.
+type crankLog map[uint64]*Crank
+
+// crank returns the crank numbered crankNum, starting a new execution of it if
+// restart is true.
+func (cl crankLog) crank(crankNum uint64, restart bool) *Crank {
+	crank := cl[crankNum]
+	if crank == nil || restart {
+		crank = &Crank{CrankNum: crankNum}
+		cl[crankNum] = crank
+	}
+	return crank
+}
+
+// add records a slog entry.
+func (cl crankLog) add(entry slogEntry) error {
+	if entry.CrankNum == nil || entry.Replay {
+		return nil
+	}
+	crankNum := *entry.CrankNum
+	switch entry.Type {
+	case "crank-start":
+		cl.crank(crankNum, true).CrankType = entry.CrankType
+	case "deliver":
+		crank := cl.crank(crankNum, false)
+		crank.VatID = entry.VatID
+		crank.DeliveryNum = entry.DeliveryNum
+		crank.Delivery = entry.KernelDelivery
+	case "syscall", "syscall-result":
+		crank := cl.crank(crankNum, false)
+		i := len(crank.Syscalls)
+		if entry.SyscallNum != nil {
+			i = *entry.SyscallNum
+		} else if entry.Type == "syscall-result" && i > 0 {
+			i--
+		}
+		if i < 0 {
+			return fmt.Errorf("invalid syscallNum %d", i)
+		}
+		for len(crank.Syscalls) <= i {
+			crank.Syscalls = append(crank.Syscalls, Syscall{})
+		}
+		if entry.Type == "syscall" {
+			crank.Syscalls[i].Request = entry.KernelSyscall
+		} else {
+			crank.Syscalls[i].Result = entry.KernelResult
+		}
+	case "deliver-result":
+		cl.crank(crankNum, false).Result = entry.DeliveryResult
+	case "crank-finish":
+		crank := cl.crank(crankNum, false)
+		crank.CrankHash = entry.CrankHash
+		crank.ActivityHash = entry.ActivityHash
+	}
+	return nil
+}
+
+// ReadCranks returns the cranks recorded by a slog, in increasing order of
+// crank number.  Deliveries replayed to bring a vat back into memory are
+// ignored, and when a crank is recorded more than once (as when a node
+// restarts a block), its last execution is kept.
+func ReadCranks(r io.Reader) ([]*Crank, error) {
+	cranks := crankLog{}
+	reader := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		atEOF := err == io.EOF
+		if len(bytes.TrimSpace(line)) > 0 {
+			var entry slogEntry
+			if err := json.Unmarshal(line, &entry); err != nil {
+				// A torn final line, as left by a crash, is ignored.
+				if atEOF {
+					break
+				}
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			if err := cranks.add(entry); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+		}
+		if atEOF {
+			break
+		}
+	}
+
+	sorted := make([]*Crank, 0, len(cranks))
+	for _, crank := range cranks {
+		sorted = append(sorted, crank)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].CrankNum < sorted[j].CrankNum })
+	return sorted, nil
+}
+
+// ReadCranksFile returns the cranks recorded by the slog file at path, which
+// is decompressed if its name ends in ".gz", as do rotated slogs.
+func ReadCranksFile(path string) ([]*Crank, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var r io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	cranks, err := ReadCranks(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cranks, nil
+}
+
+// Divergence describes the first difference between two slogs.
+type Divergence struct {
+	CrankNum uint64
+	// VatID and DeliveryNum identify the delivery of the crank in the first
+	// slog, if it has one.
+	VatID       string
+	DeliveryNum uint64
+	// Part names what differs, such as "delivery", "syscall 3 result", or
+	// "crank hash".
+	Part string
+	// Left and Right are the canonical JSON of the differing part in each
+	// slog, or empty where it is missing.
+	Left  string
+	Right string
+}
+
+// Result is the outcome of a comparison.
+type Result struct {
+	// FirstCrank and LastCrank bound the cranks recorded by both slogs, which
+	// are the only ones compared.
+	FirstCrank uint64
+	LastCrank  uint64
+	// Compared is the number of cranks found identical before any divergence.
+	Compared int
+	// Divergence is the first difference, or nil if there is none.
+	Divergence *Divergence
+}
+
+// canonical returns the JSON of raw with sorted keys and no insignificant
+// whitespace, or an empty string if raw is empty.
+func canonical(raw json.RawMessage) string {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return ""
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(raw)
+	}
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return string(raw)
+	}
+	return string(bz)
+}
+
+// compareCranks returns the first difference between two executions of a
+// crank, or nil.
+func compareCranks(left, right *Crank) *Divergence {
+	divergence := func(part, l, r string) *Divergence {
+		return &Divergence{
+			CrankNum:    left.CrankNum,
+			VatID:       left.VatID,
+			DeliveryNum: left.DeliveryNum,
+			Part:        part,
+			Left:        l,
+			Right:       r,
+		}
+	}
+	if left.CrankType != right.CrankType {
+		return divergence("crank type", left.CrankType, right.CrankType)
+	}
+	if left.VatID != right.VatID || left.DeliveryNum != right.DeliveryNum {
+		return divergence("delivery target",
+			fmt.Sprintf("vat %s delivery %d", left.VatID, left.DeliveryNum),
+			fmt.Sprintf("vat %s delivery %d", right.VatID, right.DeliveryNum))
+	}
+	if l, r := canonical(left.Delivery), canonical(right.Delivery); l != r {
+		return divergence("delivery", l, r)
+	}
+	for i := 0; i < len(left.Syscalls) || i < len(right.Syscalls); i++ {
+		var l, r Syscall
+		if i < len(left.Syscalls) {
+			l = left.Syscalls[i]
+		}
+		if i < len(right.Syscalls) {
+			r = right.Syscalls[i]
+		}
+		if lc, rc := canonical(l.Request), canonical(r.Request); lc != rc {
+			return divergence(fmt.Sprintf("syscall %d", i), lc, rc)
+		}
+		if lc, rc := canonical(l.Result), canonical(r.Result); lc != rc {
+			return divergence(fmt.Sprintf("syscall %d result", i), lc, rc)
+		}
+	}
+	if l, r := canonical(left.Result), canonical(right.Result); l != r {
+		return divergence("delivery result", l, r)
+	}
+	if left.CrankHash != "" && right.CrankHash != "" && left.CrankHash != right.CrankHash {
+		return divergence("crank hash", left.CrankHash, right.CrankHash)
+	}
+	if left.ActivityHash != "" && right.ActivityHash != "" && left.ActivityHash != right.ActivityHash {
+		return divergence("activity hash", left.ActivityHash, right.ActivityHash)
+	}
+	return nil
+}
+
+// Compare aligns two slogs' cranks by crank number and returns the first
+// divergence among the cranks which both record.  A crank missing from one
+// slog within that range is a divergence.
+func Compare(left, right []*Crank) Result {
+	var result Result
+	if len(left) == 0 || len(right) == 0 {
+		return result
+	}
+	result.FirstCrank = left[0].CrankNum
+	if right[0].CrankNum > result.FirstCrank {
+		result.FirstCrank = right[0].CrankNum
+	}
+	result.LastCrank = left[len(left)-1].CrankNum
+	if right[len(right)-1].CrankNum < result.LastCrank {
+		result.LastCrank = right[len(right)-1].CrankNum
+	}
+
+	i, j := 0, 0
+	for i < len(left) && left[i].CrankNum < result.FirstCrank {
+		i++
+	}
+	for j < len(right) && right[j].CrankNum < result.FirstCrank {
+		j++
+	}
+	for i < len(left) && j < len(right) {
+		l, r := left[i], right[j]
+		if l.CrankNum > result.LastCrank && r.CrankNum > result.LastCrank {
+			break
+		}
+		switch {
+		case l.CrankNum < r.CrankNum:
+			result.Divergence = &Divergence{CrankNum: l.CrankNum, VatID: l.VatID, DeliveryNum: l.DeliveryNum, Part: "crank", Left: "present", Right: "missing"}
+			return result
+		case l.CrankNum > r.CrankNum:
+			result.Divergence = &Divergence{CrankNum: r.CrankNum, VatID: r.VatID, DeliveryNum: r.DeliveryNum, Part: "crank", Left: "missing", Right: "present"}
+			return result
+		}
+		if divergence := compareCranks(l, r); divergence != nil {
+			result.Divergence = divergence
+			return result
+		}
+		result.Compared++
+		i++
+		j++
+	}
+	return result
+}
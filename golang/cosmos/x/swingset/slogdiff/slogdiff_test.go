@@ -0,0 +1,105 @@
+package slogdiff
+
+import (
+	"strings"
+	"testing"
+)
+
+const baseSlog = `{"type":"kernel-init-start","time":1}
+{"type":"crank-start","crankNum":1,"crankType":"routing","time":2}
+{"type":"crank-finish","crankNum":1,"crankhash":"aa","activityhash":"a1","time":3}
+{"type":"crank-start","crankNum":2,"crankType":"delivery","time":4}
+{"type":"deliver","crankNum":2,"vatID":"v1","deliveryNum":7,"kd":["message","ko1",{"methargs":{"body":"#[]","slots":[]}}],"vd":["ignored"],"time":5}
+{"type":"syscall","crankNum":2,"vatID":"v1","deliveryNum":7,"syscallNum":0,"ksc":["vatstoreGet","v1","key"],"time":6}
+{"type":"syscall-result","crankNum":2,"vatID":"v1","deliveryNum":7,"syscallNum":0,"ksr":["ok","value"],"time":7}
+{"type":"deliver-result","crankNum":2,"vatID":"v1","deliveryNum":7,"dr":["ok",null,{"compute":100}],"time":8}
+{"type":"crank-finish","crankNum":2,"crankhash":"bb","activityhash":"b1","time":9}
+`
+
+func mustRead(t *testing.T, slog string) []*Crank {
+	t.Helper()
+	cranks, err := ReadCranks(strings.NewReader(slog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cranks
+}
+
+func TestReadCranks(t *testing.T) {
+	// Replays and torn final lines are ignored, and restarted cranks replaced.
+	slog := baseSlog +
+		`{"type":"deliver","crankNum":2,"vatID":"v1","deliveryNum":7,"replay":true,"kd":["other"]}` + "\n" +
+		`{"type":"crank-start","crankNum":3,"crankType":"delivery"}` + "\n" +
+		`{"type":"deliver","crankNum":3,"vatID":"v2","deliveryNum":1,"kd":["first"]}` + "\n" +
+		`{"type":"crank-start","crankNum":3,"crankType":"delivery"}` + "\n" +
+		`{"type":"deliver","crankNum":3,"vatID":"v2","deliveryNum":1,"kd":["second"]}` + "\n" +
+		`{"type":"syscall","crankNum":3,`
+	cranks := mustRead(t, slog)
+	if len(cranks) != 3 {
+		t.Fatalf("got %d cranks, want 3", len(cranks))
+	}
+	delivery := cranks[1]
+	if delivery.VatID != "v1" || delivery.DeliveryNum != 7 || len(delivery.Syscalls) != 1 || delivery.CrankHash != "bb" {
+		t.Errorf("unexpected crank %+v", delivery)
+	}
+	if got := canonical(cranks[2].Delivery); got != `["second"]` {
+		t.Errorf("got restarted delivery %s, want the last execution", got)
+	}
+
+	if _, err := ReadCranks(strings.NewReader("not json\n{}\n")); err == nil {
+		t.Error("expected an error for a corrupt line")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base := mustRead(t, baseSlog)
+
+	// Timings, key order, and vat views do not matter.
+	equivalent := strings.NewReplacer(
+		`"time":5`, `"time":50`,
+		`{"compute":100}`, `{ "compute": 100 }`,
+		`["ignored"]`, `["different"]`,
+	).Replace(baseSlog)
+	result := Compare(base, mustRead(t, equivalent))
+	if result.Divergence != nil {
+		t.Fatalf("unexpected divergence %+v", result.Divergence)
+	}
+	if result.FirstCrank != 1 || result.LastCrank != 2 || result.Compared != 2 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		part     string
+		compared int
+	}{
+		{"syscall", `"ksc":["vatstoreGet","v1","key"]`, `"ksc":["vatstoreGet","v1","other"]`, "syscall 0", 1},
+		{"syscall result", `"ksr":["ok","value"]`, `"ksr":["ok","other"]`, "syscall 0 result", 1},
+		{"delivery result", `{"compute":100}`, `{"compute":101}`, "delivery result", 1},
+		{"crank hash", `"crankhash":"aa"`, `"crankhash":"ab"`, "crank hash", 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diverged := mustRead(t, strings.Replace(baseSlog, tc.old, tc.new, 1))
+			result := Compare(base, diverged)
+			if result.Divergence == nil {
+				t.Fatal("expected a divergence")
+			}
+			if result.Divergence.Part != tc.part || result.Compared != tc.compared {
+				t.Errorf("got divergence in %q after %d cranks, want %q after %d", result.Divergence.Part, result.Compared, tc.part, tc.compared)
+			}
+		})
+	}
+
+	// Only cranks recorded by both slogs are compared, but a gap is a divergence.
+	result = Compare(base, base[1:])
+	if result.Divergence != nil || result.FirstCrank != 2 || result.Compared != 1 {
+		t.Errorf("unexpected result %+v", result)
+	}
+	result = Compare(append([]*Crank{}, base[0], &Crank{CrankNum: 3}), append([]*Crank{}, base[0], base[1], &Crank{CrankNum: 3}))
+	if result.Divergence == nil || result.Divergence.CrankNum != 2 || result.Divergence.Left != "missing" {
+		t.Errorf("expected crank 2 to be missing from the first slog, got %+v", result.Divergence)
+	}
+}
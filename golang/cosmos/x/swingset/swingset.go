@@ -8,6 +8,7 @@ import (
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,6 +25,8 @@ type swingsetMessage struct {
 
 const (
 	SwingStoreUpdateExportData = "swingStoreUpdateExportData"
+	ReportKernelStats          = "reportKernelStats"
+	ReportReplayProgress       = "reportReplayProgress"
 )
 
 // NewPortHandler returns a port handler for a swingset Keeper.
@@ -46,6 +49,12 @@ func (ph portHandler) Receive(cctx context.Context, str string) (string, error)
 	case SwingStoreUpdateExportData:
 		return ph.handleSwingStoreUpdateExportData(ctx, msg.Args)
 
+	case ReportKernelStats:
+		return ph.handleReportKernelStats(msg.Args)
+
+	case ReportReplayProgress:
+		return ph.handleReportReplayProgress(msg.Args)
+
 	default:
 		return "", fmt.Errorf("unrecognized swingset method %s", msg.Method)
 	}
@@ -66,8 +75,21 @@ func (ph portHandler) handleSwingStoreUpdateExportData(ctx sdk.Context, entries
 		key := []byte(entry.Key())
 		if !entry.HasValue() {
 			store.Delete(key)
+			ph.keeper.NoteSwingStoreExportDataDeletion(ctx, entry.Key())
 		} else {
 			store.Set(key, []byte(entry.StringValue()))
 		}
 	}
 }
+
+func (ph portHandler) handleReportReplayProgress(args []json.RawMessage) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected 1 argument to %s, got %d", ReportReplayProgress, len(args))
+	}
+	var progress keeper.ReplayProgress
+	if err := json.Unmarshal(args[0], &progress); err != nil {
+		return "", err
+	}
+	ph.keeper.ReportReplayProgress(progress)
+	return "true", nil
+}
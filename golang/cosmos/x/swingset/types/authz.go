@@ -0,0 +1,215 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &WalletActionAuthorization{}
+
+// BridgeActionExecuteOffer is the method of the smart wallet bridge actions
+// that make offers, to which the offer type filters apply.
+const BridgeActionExecuteOffer = "executeOffer"
+
+// NewWalletActionAuthorization creates a new WalletActionAuthorization.
+func NewWalletActionAuthorization(spendAction bool, allowedMethods, allowedOfferTypes []string) *WalletActionAuthorization {
+	return &WalletActionAuthorization{
+		SpendAction:       spendAction,
+		AllowedMethods:    allowedMethods,
+		AllowedOfferTypes: allowedOfferTypes,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a WalletActionAuthorization) MsgTypeURL() string {
+	if a.SpendAction {
+		return sdk.MsgTypeURL(&MsgWalletSpendAction{})
+	}
+	return sdk.MsgTypeURL(&MsgWalletAction{})
+}
+
+// Accept implements Authorization.Accept.  The x/authz keeper calls it before
+// dispatching the message to the msg server, which forwards it to the kernel.
+func (a WalletActionAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var action string
+	switch msg := msg.(type) {
+	case *MsgWalletAction:
+		if a.SpendAction {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+		}
+		action = msg.Action
+	case *MsgWalletSpendAction:
+		if !a.SpendAction {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+		}
+		action = msg.SpendAction
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	bridgeAction, err := parseBridgeAction(action)
+	if err != nil {
+		return authz.AcceptResponse{}, sdkioerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	if len(a.AllowedMethods) > 0 && !containsString(a.AllowedMethods, bridgeAction.Method) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("method %q is not allowed", bridgeAction.Method)
+	}
+	if bridgeAction.Method == BridgeActionExecuteOffer && len(a.AllowedOfferTypes) > 0 {
+		offerType, maker := bridgeAction.offerType()
+		if !containsString(a.AllowedOfferTypes, offerType) &&
+			(maker == "" || !containsString(a.AllowedOfferTypes, offerType+":"+maker)) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("offer type %q is not allowed", offerType+":"+maker)
+		}
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a WalletActionAuthorization) ValidateBasic() error {
+	for _, method := range a.AllowedMethods {
+		if method == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowed methods cannot be empty")
+		}
+	}
+	for _, offerType := range a.AllowedOfferTypes {
+		if source, _, _ := strings.Cut(offerType, ":"); source == "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowed offer type %q must have a source", offerType)
+		}
+	}
+	if len(a.AllowedOfferTypes) > 0 && len(a.AllowedMethods) > 0 && !containsString(a.AllowedMethods, BridgeActionExecuteOffer) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("allowed offer types require the %s method", BridgeActionExecuteOffer)
+	}
+	return nil
+}
+
+// bridgeAction is the part of a smart wallet bridge action that a
+// WalletActionAuthorization examines.
+type bridgeAction struct {
+	Method string
+	Offer  struct {
+		InvitationSpec struct {
+			Source                string
+			PublicInvitationMaker string
+			InvitationMakerName   string
+		}
+	}
+}
+
+// offerType returns the source of the offer's invitationSpec and the name of
+// its invitation maker, if any.
+func (ba bridgeAction) offerType() (string, string) {
+	spec := ba.Offer.InvitationSpec
+	if spec.PublicInvitationMaker != "" {
+		return spec.Source, spec.PublicInvitationMaker
+	}
+	return spec.Source, spec.InvitationMakerName
+}
+
+// jsonObject is a JSON object decoded by the exact keys of its members.
+// Unlike the fields of a struct, which encoding/json matches case-
+// insensitively and to the last of several matching keys, its members are
+// those that the smart wallet reads, so that a key such as "METHOD" cannot
+// hide the "method" of an action from the authorization.
+type jsonObject map[string]json.RawMessage
+
+// parseJSONObject decodes the JSON object bz, of which a JSON null is one
+// without members.
+func parseJSONObject(bz []byte) (jsonObject, error) {
+	var obj jsonObject
+	if err := json.Unmarshal(bz, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// object returns the member object of obj at key, or an empty one if it has
+// none.
+func (obj jsonObject) object(key string) (jsonObject, error) {
+	raw, ok := obj[key]
+	if !ok {
+		return jsonObject{}, nil
+	}
+	member, err := parseJSONObject(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return member, nil
+}
+
+// string returns the member string of obj at key, or "" if it has none.
+func (obj jsonObject) string(key string) (string, error) {
+	raw, ok := obj[key]
+	if !ok || string(raw) == "null" {
+		return "", nil
+	}
+	var member string
+	if err := json.Unmarshal(raw, &member); err != nil {
+		return "", fmt.Errorf("%s: %w", key, err)
+	}
+	return member, nil
+}
+
+// parseBridgeAction decodes the bridge action of a smart wallet message from
+// its marshalled CapData, whose body is in either the smallcaps encoding
+// ("#"-prefixed, with "!"-escaped strings) or the legacy JSON encoding.
+func parseBridgeAction(action string) (bridgeAction, error) {
+	var ba bridgeAction
+	capData, err := parseJSONObject([]byte(action))
+	if err != nil {
+		return ba, sdkerrors.ErrJSONUnmarshal.Wrap("wallet action must be marshalled CapData")
+	}
+	capDataBody, err := capData.string("body")
+	if err != nil || capDataBody == "" {
+		return ba, sdkerrors.ErrJSONUnmarshal.Wrap("wallet action must be marshalled CapData")
+	}
+	body, smallcaps := strings.CutPrefix(capDataBody, "#")
+
+	invalid := func(err error) (bridgeAction, error) {
+		return ba, sdkerrors.ErrJSONUnmarshal.Wrapf("invalid wallet bridge action: %s", err)
+	}
+	obj, err := parseJSONObject([]byte(body))
+	if err != nil {
+		return invalid(err)
+	}
+	offer, err := obj.object("offer")
+	if err != nil {
+		return invalid(err)
+	}
+	spec, err := offer.object("invitationSpec")
+	if err != nil {
+		return invalid(err)
+	}
+	for _, member := range []struct {
+		obj jsonObject
+		key string
+		s   *string
+	}{
+		{obj, "method", &ba.Method},
+		{spec, "source", &ba.Offer.InvitationSpec.Source},
+		{spec, "publicInvitationMaker", &ba.Offer.InvitationSpec.PublicInvitationMaker},
+		{spec, "invitationMakerName", &ba.Offer.InvitationSpec.InvitationMakerName},
+	} {
+		if *member.s, err = member.obj.string(member.key); err != nil {
+			return invalid(err)
+		}
+		if smallcaps {
+			*member.s = strings.TrimPrefix(*member.s, "!")
+		}
+	}
+	return ba, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// capData marshals a bridge action body as the CapData of a wallet action.
+func capData(t *testing.T, body string) string {
+	bz, err := json.Marshal(map[string]interface{}{"body": body, "slots": []string{"board0123"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bz)
+}
+
+func TestWalletActionAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	mintOffer := capData(t, `#{"method":"executeOffer","offer":{"id":"1","invitationSpec":{"source":"contract","instance":"$0.Alleged: instance","publicInvitationMaker":"makeWantMintedInvitation"},"proposal":{}}}`)
+	escapedOffer := capData(t, `#{"method":"executeOffer","offer":{"id":"2","invitationSpec":{"source":"continuing","previousOffer":"1","invitationMakerName":"!AdjustBalances"},"proposal":{}}}`)
+	purseOffer := capData(t, `{"method":"executeOffer","offer":{"id":"3","invitationSpec":{"source":"purse","description":"bid"},"proposal":{}}}`)
+	exit := capData(t, `#{"method":"tryExitOffer","offerId":"1"}`)
+	// The smart wallet reads the exact keys, so those that differ only by
+	// case are not what it executes.
+	caseMethod := capData(t, `#{"method":"executeOffer","METHOD":"tryExitOffer","offer":{"id":"4","invitationSpec":{"source":"contract","publicInvitationMaker":"makeWantMintedInvitation"},"proposal":{}}}`)
+	caseMaker := capData(t, `#{"method":"executeOffer","offer":{"id":"5","invitationSpec":{"source":"contract","publicInvitationMaker":"makeGiveMintedInvitation","PublicInvitationMaker":"makeWantMintedInvitation"},"proposal":{}}}`)
+	caseBody := `{"body":"#{\"method\":\"executeOffer\"}","BODY":"#{\"method\":\"tryExitOffer\"}","slots":[]}`
+
+	for _, tt := range []struct {
+		name   string
+		auth   *WalletActionAuthorization
+		msg    sdk.Msg
+		accept bool
+	}{
+		{name: "any", auth: NewWalletActionAuthorization(false, nil, nil), msg: NewMsgWalletAction(addr, exit), accept: true},
+		{name: "spendMismatch", auth: NewWalletActionAuthorization(false, nil, nil), msg: NewMsgWalletSpendAction(addr, exit)},
+		{name: "spend", auth: NewWalletActionAuthorization(true, nil, nil), msg: NewMsgWalletSpendAction(addr, purseOffer), accept: true},
+		{name: "method", auth: NewWalletActionAuthorization(false, []string{"tryExitOffer"}, nil), msg: NewMsgWalletAction(addr, exit), accept: true},
+		{name: "methodRefused", auth: NewWalletActionAuthorization(false, []string{"tryExitOffer"}, nil), msg: NewMsgWalletAction(addr, mintOffer)},
+		{name: "maker", auth: NewWalletActionAuthorization(false, nil, []string{"contract:makeWantMintedInvitation"}), msg: NewMsgWalletAction(addr, mintOffer), accept: true},
+		{name: "makerRefused", auth: NewWalletActionAuthorization(false, nil, []string{"contract:makeGiveMintedInvitation"}), msg: NewMsgWalletAction(addr, mintOffer)},
+		{name: "source", auth: NewWalletActionAuthorization(true, nil, []string{"purse"}), msg: NewMsgWalletSpendAction(addr, purseOffer), accept: true},
+		{name: "escaped", auth: NewWalletActionAuthorization(false, nil, []string{"continuing:AdjustBalances"}), msg: NewMsgWalletAction(addr, escapedOffer), accept: true},
+		{name: "offerTypesSkipOtherMethods", auth: NewWalletActionAuthorization(false, nil, []string{"purse"}), msg: NewMsgWalletAction(addr, exit), accept: true},
+		{name: "caseMethodRefused", auth: NewWalletActionAuthorization(false, []string{"tryExitOffer"}, nil), msg: NewMsgWalletAction(addr, caseMethod)},
+		{name: "caseMakerRefused", auth: NewWalletActionAuthorization(false, nil, []string{"contract:makeWantMintedInvitation"}), msg: NewMsgWalletAction(addr, caseMaker)},
+		{name: "caseBodyRefused", auth: NewWalletActionAuthorization(false, []string{"tryExitOffer"}, nil), msg: NewMsgWalletAction(addr, caseBody)},
+		{name: "nonStringMethod", auth: NewWalletActionAuthorization(false, nil, nil), msg: NewMsgWalletAction(addr, capData(t, `#{"method":["tryExitOffer"]}`))},
+		{name: "notCapData", auth: NewWalletActionAuthorization(false, nil, nil), msg: NewMsgWalletAction(addr, `{"method":"executeOffer"}`)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.auth.Accept(ctx, tt.msg)
+			if tt.accept {
+				if err != nil || !res.Accept {
+					t.Errorf("got %+v, error %v, want accepted", res, err)
+				}
+				if res.Delete || res.Updated != nil {
+					t.Errorf("got %+v, want unchanged", res)
+				}
+			} else if err == nil {
+				t.Errorf("got %+v, want error", res)
+			}
+		})
+	}
+
+	if got := NewWalletActionAuthorization(true, nil, nil).MsgTypeURL(); got != sdk.MsgTypeURL(&MsgWalletSpendAction{}) {
+		t.Errorf("got spend type URL %s", got)
+	}
+	if got := NewWalletActionAuthorization(false, nil, nil).MsgTypeURL(); got != sdk.MsgTypeURL(&MsgWalletAction{}) {
+		t.Errorf("got type URL %s", got)
+	}
+}
+
+func TestWalletActionAuthorization_ValidateBasic(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		auth      *WalletActionAuthorization
+		shouldErr bool
+	}{
+		{name: "any", auth: NewWalletActionAuthorization(false, nil, nil)},
+		{name: "filters", auth: NewWalletActionAuthorization(false, []string{"executeOffer"}, []string{"purse", "contract:makeWantMintedInvitation"})},
+		{name: "emptyMethod", auth: NewWalletActionAuthorization(false, []string{""}, nil), shouldErr: true},
+		{name: "noSource", auth: NewWalletActionAuthorization(false, nil, []string{":makeWantMintedInvitation"}), shouldErr: true},
+		{name: "offerTypesWithoutExecuteOffer", auth: NewWalletActionAuthorization(false, []string{"tryExitOffer"}, []string{"purse"}), shouldErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.auth.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -33,6 +34,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgProvision{}, ModuleName+"/Provision", nil)
 	cdc.RegisterConcrete(&MsgWalletAction{}, ModuleName+"/WalletAction", nil)
 	cdc.RegisterConcrete(&MsgWalletSpendAction{}, ModuleName+"/WalletSpendAction", nil)
+	cdc.RegisterConcrete(&MsgRegisterVatOwner{}, ModuleName+"/RegisterVatOwner", nil)
+	cdc.RegisterConcrete(&MsgRevokeEgress{}, ModuleName+"/RevokeEgress", nil)
+	cdc.RegisterConcrete(&MsgPruneBundles{}, ModuleName+"/PruneBundles", nil)
+	cdc.RegisterConcrete(&MsgOraclePush{}, ModuleName+"/OraclePush", nil)
+	cdc.RegisterConcrete(&WalletActionAuthorization{}, ModuleName+"/WalletActionAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
@@ -42,10 +48,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgProvision{},
 		&MsgWalletAction{},
 		&MsgWalletSpendAction{},
+		&MsgRegisterVatOwner{},
+		&MsgRevokeEgress{},
+		&MsgPruneBundles{},
+		&MsgOraclePush{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CoreEvalProposal{},
 	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&WalletActionAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -39,6 +39,16 @@ const (
 	VatCleanupKv          = "kv"
 	VatCleanupSnapshots   = "snapshots"
 	VatCleanupTranscripts = "transcripts"
+
+	// Wallet spend action rate limit keys.
+	RateLimitCapacity       = "capacity"
+	RateLimitBlocksPerToken = "blocks_per_token"
+
+	// Inbound lane names.
+	InboundLaneGovernance = "governance"
+	InboundLaneOracle     = "oracle"
+	InboundLaneWallet     = "wallet"
+	InboundLaneIBC        = "ibc"
 )
 
 var (
@@ -92,6 +102,60 @@ var (
 		// UintMapEntry{VatCleanupSnapshots, DefaultVatCleanupSnapshots},
 		// UintMapEntry{VatCleanupTranscripts, DefaultVatCleanupTranscripts},
 	}
+
+	// The wallet spend action rate limit is disabled unless set by governance.
+	DefaultWalletSpendActionRateLimit = []UintMapEntry{}
+
+	// The bridge message hash chain is disabled unless enabled by governance.
+	DefaultBridgeMessageHashChain = false
+
+	// Only the senders recorded by SwingSet have priority unless governance
+	// adds more.
+	DefaultPrioritySenders = []string{}
+
+	// Block times are delivered with full-second precision unless governance
+	// coarsens them.
+	DefaultBlockTimeQuantumSeconds uint64 = 0
+
+	// Vat owners are not billed for computrons unless governance sets a
+	// price.
+	DefaultComputronPriceUist = sdk.ZeroDec()
+
+	// The inbound queue stays open however busy SwingSet is unless governance
+	// sets a headroom threshold.
+	DefaultMinRunPolicyHeadroom uint64 = 0
+
+	// Replayed MsgDeliverInbound submissions reach SwingSet (which ignores
+	// them) unless governance sets a deduplication window.
+	DefaultInboundDedupWindowBlocks uint64 = 0
+
+	// Bundle storage is free unless governance sets a price, and no part of
+	// the fee is refundable unless governance sets a fraction.
+	DefaultBundleStorageFeePerByte     = sdk.DecCoins{}
+	DefaultBundleStorageRefundFraction = sdk.ZeroDec()
+
+	// Installed bundles are kept forever unless governance sets a minimum age
+	// after which unused ones may be pruned.
+	DefaultBundlePruneMinAgeBlocks uint64 = 0
+
+	// Block entropy is offered to SwingSet unless governance withholds it.
+	DefaultBlockEntropyDisabled = false
+
+	// No account may submit MsgOraclePush unless governance registers oracle
+	// operators, whose transactions of pushes then pay a fixed 0.01 IST, of
+	// which up to 10 are admitted in each block.
+	DefaultOracleOperators             = []string{}
+	DefaultOraclePushFee               = sdk.NewCoins(sdk.NewInt64Coin("uist", 10_000))
+	DefaultOraclePushesPerBlock uint64 = 10
+
+	// Inbound actions enter the SwingSet queues directly unless governance
+	// configures lanes for them.
+	DefaultInboundLanes                = []InboundLane{}
+	DefaultInboundLaneBatchSize uint64 = 0
+
+	// Smart wallets are provisioned only on request unless governance sets a
+	// minimum deposit that provisions them automatically.
+	DefaultAutoProvisionMinDeposit = sdk.Coins{}
 )
 
 // move DefaultBeansPerUnit to a function to allow for boot overriding of the Default params
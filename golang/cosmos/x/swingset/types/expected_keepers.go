@@ -24,7 +24,15 @@ type AccountKeeper interface {
 type SwingSetKeeper interface {
 	GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint
 	ChargeBeans(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, addr sdk.AccAddress, beans sdkmath.Uint) error
-	IsHighPriorityAddress(ctx sdk.Context, addr sdk.AccAddress) (bool, error)
+	IsPrioritySender(ctx sdk.Context, addr sdk.AccAddress) (bool, error)
+	IsOracleOperator(ctx sdk.Context, addr sdk.AccAddress) bool
 	GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) SmartWalletState
 	ChargeForSmartWallet(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, addr sdk.AccAddress) error
+	ChargeGrantedBeans(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, granter, grantee sdk.AccAddress, beans sdkmath.Uint, msgs []sdk.Msg) error
+}
+
+// FeegrantKeeper defines the expected x/feegrant keeper, whose allowances let
+// a fee granter pay the admission fees of smart wallet messages.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type feeGrantContextKey struct{}
+
+// FeeGrant is the fee granter of a transaction, which pays the admission fees
+// of its smart wallet messages as allowed by x/feegrant, the fee payer of the
+// transaction to which it granted the allowance, and the messages of the
+// transaction to which the allowance applies.  As in the x/auth
+// DeductFeeDecorator, the allowance is that of the fee payer, not of the
+// owners of the messages, which may differ under an authz MsgExec.
+type FeeGrant struct {
+	Granter sdk.AccAddress
+	Grantee sdk.AccAddress
+	Msgs    []sdk.Msg
+}
+
+// WithFeeGrant returns a copy of ctx in which the admission fees of smart
+// wallet messages are charged to granter rather than their owners, under its
+// allowance to grantee.
+func WithFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Context {
+	return ctx.WithValue(feeGrantContextKey{}, FeeGrant{Granter: granter, Grantee: grantee, Msgs: msgs})
+}
+
+// GetFeeGrant returns the FeeGrant of ctx, if any.
+func GetFeeGrant(ctx sdk.Context) (FeeGrant, bool) {
+	grant, ok := ctx.Value(feeGrantContextKey{}).(FeeGrant)
+	return grant, ok
+}
@@ -29,6 +29,35 @@ type GenesisState struct {
 	State                    State                        `protobuf:"bytes,3,opt,name=state,proto3" json:"state"`
 	SwingStoreExportData     []*SwingStoreExportDataEntry `protobuf:"bytes,4,rep,name=swing_store_export_data,json=swingStoreExportData,proto3" json:"swingStoreExportData"`
 	SwingStoreExportDataHash string                       `protobuf:"bytes,5,opt,name=swing_store_export_data_hash,json=swingStoreExportDataHash,proto3" json:"swingStoreExportDataHash"`
+	// The MsgWalletSpendAction rate limit buckets not yet refilled to capacity.
+	WalletSpendActionRateLimitBuckets []RateLimitBucketRecord `protobuf:"bytes,8,rep,name=wallet_spend_action_rate_limit_buckets,json=walletSpendActionRateLimitBuckets,proto3" json:"walletSpendActionRateLimitBuckets" yaml:"walletSpendActionRateLimitBuckets"`
+	// The upgrade steps already enqueued, which later upgrades must skip.
+	UpgradeSteps []UpgradeStepRecord `protobuf:"bytes,9,rep,name=upgrade_steps,json=upgradeSteps,proto3" json:"upgradeSteps" yaml:"upgradeSteps"`
+	// The refundable storage deposits held for installed bundles.
+	BundleStorageDeposits []BundleStorageDepositRecord `protobuf:"bytes,10,rep,name=bundle_storage_deposits,json=bundleStorageDeposits,proto3" json:"bundleStorageDeposits" yaml:"bundleStorageDeposits"`
+	// The entropy of the latest block, from which that of the next is
+	// chained.  Empty if none has been derived.
+	BlockEntropy []byte `protobuf:"bytes,11,opt,name=block_entropy,json=blockEntropy,proto3" json:"blockEntropy,omitempty" yaml:"blockEntropy"`
+	// The installation progress of the bundles submitted for installation.
+	BundleInstallations []BundleInstallationRecord `protobuf:"bytes,13,rep,name=bundle_installations,json=bundleInstallations,proto3" json:"bundleInstallations" yaml:"bundleInstallations"`
+	// The chunked bundle uploads in progress, which expire as they would have
+	// on the exporting chain.
+	BundleUploads []BundleUploadRecord `protobuf:"bytes,14,rep,name=bundle_uploads,json=bundleUploads,proto3" json:"bundleUploads" yaml:"bundleUploads"`
+	// The MsgDeliverInbound deliveries remembered to reject their replays.
+	DeliveredInbound []DeliveredInboundRecord `protobuf:"bytes,15,rep,name=delivered_inbound,json=deliveredInbound,proto3" json:"deliveredInbound" yaml:"deliveredInbound"`
+	// The digest of the bridge messages of every block recorded so far, from
+	// which that of the next is chained.  Empty if none has been recorded.
+	BridgeMessageDigest []byte     `protobuf:"bytes,17,opt,name=bridge_message_digest,json=bridgeMessageDigest,proto3" json:"bridgeMessageDigest,omitempty" yaml:"bridgeMessageDigest"`
+	VatOwners           []VatOwner `protobuf:"bytes,6,rep,name=vat_owners,json=vatOwners,proto3" json:"vatOwners" yaml:"vatOwners"`
+	// The swing-store export holding the kernel state of this genesis, which
+	// must be restored from it on InitGenesis.  Absent if the kernel state was
+	// not exported.
+	SwingStoreExport *SwingStoreExportReference `protobuf:"bytes,7,opt,name=swing_store_export,json=swingStoreExport,proto3" json:"swingStoreExport,omitempty" yaml:"swingStoreExport"`
+	// The run-policy headroom in beans that SwingSet reported at the end of
+	// the latest block, as a decimal string.  Empty if it reported none.
+	PolicyHeadroom string `protobuf:"bytes,16,opt,name=policy_headroom,json=policyHeadroom,proto3" json:"policyHeadroom,omitempty" yaml:"policyHeadroom"`
+	// The automatic provisionings enqueued on deposit.
+	AutoProvisions []AutoProvisionRecord `protobuf:"bytes,12,rep,name=auto_provisions,json=autoProvisions,proto3" json:"autoProvisions" yaml:"autoProvisions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +121,156 @@ func (m *GenesisState) GetSwingStoreExportDataHash() string {
 	return ""
 }
 
+func (m *GenesisState) GetWalletSpendActionRateLimitBuckets() []RateLimitBucketRecord {
+	if m != nil {
+		return m.WalletSpendActionRateLimitBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUpgradeSteps() []UpgradeStepRecord {
+	if m != nil {
+		return m.UpgradeSteps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBundleStorageDeposits() []BundleStorageDepositRecord {
+	if m != nil {
+		return m.BundleStorageDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockEntropy() []byte {
+	if m != nil {
+		return m.BlockEntropy
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBundleInstallations() []BundleInstallationRecord {
+	if m != nil {
+		return m.BundleInstallations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBundleUploads() []BundleUploadRecord {
+	if m != nil {
+		return m.BundleUploads
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeliveredInbound() []DeliveredInboundRecord {
+	if m != nil {
+		return m.DeliveredInbound
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeMessageDigest() []byte {
+	if m != nil {
+		return m.BridgeMessageDigest
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVatOwners() []VatOwner {
+	if m != nil {
+		return m.VatOwners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwingStoreExport() *SwingStoreExportReference {
+	if m != nil {
+		return m.SwingStoreExport
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPolicyHeadroom() string {
+	if m != nil {
+		return m.PolicyHeadroom
+	}
+	return ""
+}
+
+func (m *GenesisState) GetAutoProvisions() []AutoProvisionRecord {
+	if m != nil {
+		return m.AutoProvisions
+	}
+	return nil
+}
+
+// A reference from an exported genesis to the swing-store export directory
+// written beside it, whose artifacts are not embedded in the genesis file.
+type SwingStoreExportReference struct {
+	// The path of the export directory, relative to that of the genesis file.
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// The block height at which the kernel state was exported.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The "sha256:<hex>" digest of the export manifest, which names the
+	// artifacts of the export.
+	ManifestHash string `protobuf:"bytes,3,opt,name=manifest_hash,json=manifestHash,proto3" json:"manifestHash" yaml:"manifestHash"`
+}
+
+func (m *SwingStoreExportReference) Reset()         { *m = SwingStoreExportReference{} }
+func (m *SwingStoreExportReference) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreExportReference) ProtoMessage()    {}
+func (*SwingStoreExportReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49b057311de9d296, []int{1}
+}
+func (m *SwingStoreExportReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreExportReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreExportReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwingStoreExportReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreExportReference.Merge(m, src)
+}
+func (m *SwingStoreExportReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreExportReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreExportReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwingStoreExportReference proto.InternalMessageInfo
+
+func (m *SwingStoreExportReference) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *SwingStoreExportReference) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SwingStoreExportReference) GetManifestHash() string {
+	if m != nil {
+		return m.ManifestHash
+	}
+	return ""
+}
+
 // A SwingStore "export data" entry.
 type SwingStoreExportDataEntry struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -102,7 +281,7 @@ func (m *SwingStoreExportDataEntry) Reset()         { *m = SwingStoreExportDataE
 func (m *SwingStoreExportDataEntry) String() string { return proto.CompactTextString(m) }
 func (*SwingStoreExportDataEntry) ProtoMessage()    {}
 func (*SwingStoreExportDataEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49b057311de9d296, []int{1}
+	return fileDescriptor_49b057311de9d296, []int{2}
 }
 func (m *SwingStoreExportDataEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,36 +326,78 @@ func (m *SwingStoreExportDataEntry) GetValue() string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.swingset.GenesisState")
+	proto.RegisterType((*SwingStoreExportReference)(nil), "agoric.swingset.SwingStoreExportReference")
 	proto.RegisterType((*SwingStoreExportDataEntry)(nil), "agoric.swingset.SwingStoreExportDataEntry")
 }
 
 func init() { proto.RegisterFile("agoric/swingset/genesis.proto", fileDescriptor_49b057311de9d296) }
 
 var fileDescriptor_49b057311de9d296 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x93, 0xfe, 0x41, 0xa7, 0x82, 0x12, 0x8a, 0x8d, 0xa5, 0x26, 0xa5, 0xab, 0x22, 0x98,
-	0x40, 0xc5, 0x8d, 0xae, 0x8c, 0x16, 0x5d, 0x4a, 0x8a, 0x1b, 0x11, 0xc2, 0xb4, 0x1d, 0x26, 0xa1,
-	0x6d, 0x26, 0xe4, 0x4e, 0xb5, 0xc5, 0x97, 0xf0, 0x11, 0x7c, 0x9c, 0x2e, 0xbb, 0x14, 0x17, 0x45,
-	0xda, 0x8d, 0xf4, 0x29, 0x24, 0x33, 0x2d, 0x42, 0x7f, 0x76, 0x27, 0xf9, 0xce, 0x39, 0x37, 0xdc,
-	0x1b, 0x74, 0x8a, 0x29, 0x8b, 0x83, 0x8e, 0x0d, 0x6f, 0x41, 0x48, 0x81, 0x70, 0x9b, 0x92, 0x90,
-	0x40, 0x00, 0x56, 0x14, 0x33, 0xce, 0xb4, 0x43, 0x89, 0xad, 0x35, 0x2e, 0x17, 0x29, 0xa3, 0x4c,
-	0x30, 0x3b, 0x51, 0xd2, 0x56, 0x36, 0x36, 0x5b, 0xd6, 0x42, 0xf2, 0xda, 0x77, 0x0a, 0x1d, 0xdc,
-	0xcb, 0xe2, 0x16, 0xc7, 0x9c, 0x68, 0x97, 0x28, 0x17, 0xe1, 0x18, 0x0f, 0x40, 0x4f, 0x55, 0xd5,
-	0x7a, 0xa1, 0x51, 0xb2, 0x36, 0x06, 0x59, 0x8f, 0x02, 0x3b, 0x99, 0xc9, 0xcc, 0x54, 0xdc, 0x95,
-	0x59, 0x6b, 0xa0, 0x2c, 0x24, 0x79, 0x3d, 0x2d, 0x52, 0xc7, 0x5b, 0x29, 0xd1, 0xbe, 0x0a, 0x49,
-	0xab, 0xf6, 0x8e, 0x4a, 0x02, 0x7b, 0xc0, 0x59, 0x4c, 0x3c, 0x32, 0x8a, 0x58, 0xcc, 0xbd, 0x2e,
-	0xe6, 0x58, 0xcf, 0x54, 0xd3, 0xf5, 0x42, 0xe3, 0x6c, 0xbb, 0x25, 0x11, 0xad, 0xc4, 0xde, 0x14,
-	0xee, 0x3b, 0xcc, 0x71, 0x33, 0xe4, 0xf1, 0xd8, 0xd1, 0x97, 0x33, 0xb3, 0x08, 0x3b, 0xb0, 0xbb,
-	0xf3, 0xad, 0xf6, 0x82, 0x2a, 0x7b, 0x86, 0x7b, 0x3e, 0x06, 0x5f, 0xcf, 0x56, 0xd5, 0x7a, 0xde,
-	0xa9, 0x2c, 0x67, 0xa6, 0xbe, 0x2b, 0xff, 0x80, 0xc1, 0x77, 0xf7, 0x92, 0xab, 0xcc, 0xef, 0xa7,
-	0xa9, 0xd4, 0x6e, 0xd1, 0xc9, 0xde, 0x0f, 0xd6, 0x8e, 0x50, 0xba, 0x47, 0xc6, 0xba, 0x9a, 0xcc,
-	0x71, 0x13, 0xa9, 0x15, 0x51, 0xf6, 0x15, 0xf7, 0x87, 0x44, 0x6c, 0x3e, 0xef, 0xca, 0x07, 0xe7,
-	0x69, 0x32, 0x37, 0xd4, 0xe9, 0xdc, 0x50, 0x7f, 0xe6, 0x86, 0xfa, 0xb1, 0x30, 0x94, 0xe9, 0xc2,
-	0x50, 0xbe, 0x16, 0x86, 0xf2, 0x7c, 0x4d, 0x03, 0xee, 0x0f, 0xdb, 0x56, 0x87, 0x0d, 0xec, 0x1b,
-	0x79, 0x66, 0xb9, 0xaf, 0x73, 0xe8, 0xf6, 0x6c, 0xca, 0xfa, 0x38, 0xa4, 0x76, 0x87, 0xc1, 0x80,
-	0x81, 0x3d, 0xfa, 0xff, 0x03, 0xf8, 0x38, 0x22, 0xd0, 0xce, 0x89, 0xfb, 0x5f, 0xfc, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x95, 0x41, 0xea, 0x67, 0x02, 0x00, 0x00,
+	// 1018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xc7, 0xe3, 0x5f, 0x5e, 0x7e, 0x64, 0xf2, 0xda, 0x69, 0xd2, 0xb8, 0x51, 0xba, 0x0e, 0x86,
+	0xb6, 0x81, 0xd2, 0x5d, 0x91, 0xaa, 0x87, 0x16, 0x21, 0x14, 0x93, 0x88, 0x54, 0x2a, 0xa2, 0x9a,
+	0x55, 0x7a, 0x40, 0x08, 0x6b, 0xbc, 0x9e, 0x7a, 0xad, 0xb5, 0x3d, 0x96, 0x67, 0xbc, 0xe9, 0x0a,
+	0x89, 0x3b, 0x07, 0x24, 0x24, 0x0e, 0x5c, 0x11, 0xff, 0x0b, 0x52, 0x8f, 0x39, 0x72, 0xb2, 0x50,
+	0x72, 0x41, 0x7b, 0xcc, 0x5f, 0x80, 0xe6, 0x65, 0xbb, 0xf6, 0x7a, 0x57, 0xe5, 0xe6, 0x7d, 0xbe,
+	0x9f, 0x99, 0xf9, 0x7e, 0x1f, 0x7b, 0x1f, 0x1b, 0xdc, 0xc1, 0x01, 0xcd, 0xc2, 0x4e, 0x8b, 0x9d,
+	0x87, 0x49, 0xc0, 0x08, 0x6f, 0x05, 0x24, 0x21, 0x2c, 0x64, 0xcd, 0x34, 0xa3, 0x9c, 0xc2, 0x0d,
+	0x25, 0x37, 0x47, 0xf2, 0xee, 0x56, 0x40, 0x03, 0x2a, 0xb5, 0x96, 0xb8, 0x52, 0xd8, 0x6e, 0x63,
+	0x72, 0x97, 0xd1, 0x85, 0xd2, 0xed, 0x9f, 0x37, 0xc0, 0xea, 0x57, 0x6a, 0xe3, 0x36, 0xc7, 0x9c,
+	0xc0, 0xc7, 0x60, 0x29, 0xc5, 0x19, 0x8e, 0x99, 0xf9, 0xbf, 0x7d, 0xe3, 0x60, 0xe5, 0x70, 0xa7,
+	0x39, 0x71, 0x50, 0xf3, 0x85, 0x94, 0x9d, 0x85, 0x37, 0x85, 0x35, 0x87, 0x34, 0x0c, 0x0f, 0xc1,
+	0x22, 0x13, 0xeb, 0xcd, 0x79, 0xb9, 0xea, 0x56, 0x6d, 0x95, 0xdc, 0x5d, 0x2f, 0x52, 0x28, 0xfc,
+	0x01, 0xec, 0x48, 0xd9, 0x65, 0x9c, 0x66, 0xc4, 0x25, 0xaf, 0x53, 0x9a, 0x71, 0xd7, 0xc7, 0x1c,
+	0x9b, 0x0b, 0xfb, 0xf3, 0x07, 0x2b, 0x87, 0x1f, 0xd7, 0x77, 0x11, 0x17, 0x6d, 0x81, 0x9f, 0x48,
+	0xfa, 0x18, 0x73, 0x7c, 0x92, 0xf0, 0x6c, 0xe0, 0x98, 0xc3, 0xc2, 0xda, 0x62, 0x53, 0x64, 0x34,
+	0xb5, 0x0a, 0xbf, 0x03, 0x7b, 0x33, 0x0e, 0x77, 0xbb, 0x98, 0x75, 0xcd, 0xc5, 0x7d, 0xe3, 0x60,
+	0xd9, 0xd9, 0x1b, 0x16, 0x96, 0x39, 0x6d, 0xfd, 0x29, 0x66, 0x5d, 0x34, 0x53, 0x81, 0x17, 0x06,
+	0xb8, 0x77, 0x8e, 0xa3, 0x88, 0x70, 0x97, 0xa5, 0x24, 0xf1, 0x5d, 0xdc, 0xe1, 0x21, 0x4d, 0xdc,
+	0x0c, 0x73, 0xe2, 0x46, 0x61, 0x1c, 0x72, 0xd7, 0xcb, 0x3b, 0x3d, 0xc2, 0x99, 0xf9, 0x9e, 0x8c,
+	0x7a, 0xaf, 0x16, 0x15, 0x61, 0x4e, 0x9e, 0x0b, 0xd2, 0x91, 0x20, 0x22, 0x1d, 0x9a, 0xf9, 0xce,
+	0x99, 0x68, 0xe0, 0xb0, 0xb0, 0xde, 0x57, 0xbb, 0xb7, 0xc5, 0xe6, 0x47, 0x72, 0xef, 0x09, 0x9e,
+	0x5d, 0x17, 0xd6, 0xc1, 0x00, 0xc7, 0xd1, 0x53, 0xfb, 0x9d, 0xa8, 0x8d, 0xde, 0xbd, 0x1d, 0xe4,
+	0x60, 0x2d, 0x4f, 0x83, 0x0c, 0xfb, 0xc4, 0x65, 0x9c, 0xa4, 0xcc, 0x5c, 0x96, 0xc6, 0xed, 0x9a,
+	0xf1, 0x33, 0x45, 0xb5, 0x39, 0x49, 0xb5, 0xe9, 0x07, 0xda, 0xf4, 0x6a, 0x3e, 0x96, 0x84, 0xbf,
+	0x9b, 0xca, 0x5f, 0xb9, 0x6a, 0xa3, 0x0a, 0x04, 0xff, 0x30, 0xc0, 0x8e, 0x97, 0x27, 0x7e, 0x44,
+	0xe4, 0x8d, 0xc2, 0x01, 0x71, 0x7d, 0x92, 0x52, 0x16, 0x72, 0x66, 0x02, 0x69, 0xe0, 0x41, 0xcd,
+	0x80, 0x23, 0xf9, 0xb6, 0xc2, 0x8f, 0x15, 0xad, 0x9d, 0x7c, 0xae, 0x9d, 0x6c, 0x7b, 0x53, 0x18,
+	0x61, 0x69, 0x4f, 0x59, 0x9a, 0x2a, 0xdb, 0x68, 0xfa, 0x32, 0xf8, 0x12, 0xac, 0x79, 0x11, 0xed,
+	0xf4, 0x5c, 0x92, 0xf0, 0x8c, 0xa6, 0x03, 0x73, 0x65, 0xdf, 0x38, 0x58, 0x75, 0x3e, 0x1d, 0x16,
+	0xd6, 0x2d, 0x29, 0x9c, 0xa8, 0xfa, 0x27, 0x34, 0x0e, 0x39, 0x89, 0x53, 0x3e, 0x18, 0x87, 0x2f,
+	0xeb, 0x36, 0x5a, 0x2d, 0xff, 0x84, 0xbf, 0x19, 0x60, 0x4b, 0x87, 0x0f, 0x13, 0xc6, 0x71, 0x14,
+	0x61, 0x71, 0x6b, 0x98, 0xb9, 0x26, 0x93, 0x7f, 0x34, 0x23, 0xf9, 0xb3, 0x12, 0xab, 0x73, 0x3f,
+	0xd1, 0xb9, 0x6f, 0x7a, 0x35, 0x42, 0xa4, 0xde, 0x2d, 0xa7, 0xae, 0x88, 0x36, 0x9a, 0xb6, 0x04,
+	0x0e, 0xc0, 0xba, 0x36, 0x96, 0xa7, 0x11, 0xc5, 0x3e, 0x33, 0xd7, 0xa5, 0xa5, 0x0f, 0x66, 0x58,
+	0x3a, 0x93, 0x94, 0x36, 0xf3, 0x50, 0x9b, 0x59, 0xf3, 0x4a, 0x9a, 0xb0, 0xb1, 0x55, 0xb6, 0xa1,
+	0xcb, 0x36, 0xaa, 0x62, 0xf0, 0x27, 0x03, 0xdc, 0xf0, 0x49, 0x14, 0xf6, 0x49, 0x46, 0x7c, 0x37,
+	0x4c, 0x3c, 0x9a, 0x27, 0xbe, 0xb9, 0x21, 0x8f, 0xbf, 0x5f, 0x3b, 0xfe, 0x78, 0x44, 0x3e, 0x53,
+	0xa0, 0xb6, 0xf0, 0x48, 0x5b, 0xd8, 0xf4, 0x27, 0xf4, 0xeb, 0xc2, 0xda, 0x51, 0x2e, 0x26, 0x15,
+	0x1b, 0xd5, 0x60, 0xc8, 0xc0, 0xb6, 0x97, 0x85, 0x7e, 0x40, 0xdc, 0x98, 0x30, 0x26, 0x1f, 0xce,
+	0x30, 0x20, 0x8c, 0x9b, 0x37, 0xe4, 0x03, 0xf0, 0xc5, 0xb0, 0xb0, 0xee, 0x28, 0xe0, 0x6b, 0xa5,
+	0x1f, 0x4b, 0xb9, 0xf2, 0x1c, 0x8c, 0x7a, 0x5f, 0xc7, 0x44, 0xef, 0xeb, 0x55, 0xe8, 0x02, 0xd0,
+	0xc7, 0xdc, 0xa5, 0xe7, 0x09, 0xc9, 0x98, 0xb9, 0x24, 0x83, 0xdf, 0xae, 0x05, 0x7f, 0x89, 0xf9,
+	0x37, 0x82, 0x70, 0xee, 0xea, 0xa8, 0xcb, 0x7d, 0x5d, 0x11, 0x9d, 0xde, 0x54, 0x87, 0xbe, 0x2d,
+	0xd9, 0x68, 0x2c, 0xc3, 0x5f, 0x0d, 0x00, 0xeb, 0xb3, 0xd1, 0xfc, 0xff, 0xbe, 0xf1, 0x9f, 0x66,
+	0x32, 0x22, 0xaf, 0x48, 0x46, 0x92, 0x0e, 0x71, 0x9e, 0x0c, 0x0b, 0x6b, 0x77, 0x72, 0x46, 0x56,
+	0xc2, 0xeb, 0x5e, 0x4f, 0x32, 0x36, 0xda, 0x9c, 0x2c, 0xc1, 0xef, 0xc1, 0x46, 0x4a, 0xa3, 0xb0,
+	0x33, 0x70, 0xbb, 0x04, 0xfb, 0x19, 0xa5, 0xb1, 0xb9, 0x29, 0x67, 0xf4, 0x63, 0x31, 0xa3, 0x95,
+	0x74, 0xaa, 0x95, 0xca, 0x19, 0xdb, 0xea, 0x8c, 0x2a, 0x61, 0xa3, 0xf5, 0x6a, 0x01, 0xfe, 0x08,
+	0x36, 0x70, 0xce, 0xa9, 0x9b, 0x66, 0xb4, 0x1f, 0x32, 0xf9, 0x37, 0x5b, 0x95, 0xbd, 0xfd, 0xb0,
+	0x96, 0xf8, 0x28, 0xe7, 0xf4, 0xc5, 0x08, 0xd3, 0x4f, 0x54, 0x4b, 0xb7, 0x79, 0x1d, 0x97, 0x45,
+	0x36, 0x3e, 0xbf, 0x5a, 0xb7, 0xd1, 0x04, 0xf8, 0x74, 0xe1, 0x9f, 0xdf, 0xad, 0x39, 0xfb, 0x4f,
+	0x03, 0xdc, 0x9e, 0xd9, 0x50, 0xb8, 0x09, 0xe6, 0xfd, 0x30, 0x33, 0x0d, 0x91, 0x1b, 0x89, 0x4b,
+	0x78, 0x0a, 0xd4, 0xc8, 0x70, 0xbb, 0x24, 0x0c, 0xba, 0x5c, 0xbe, 0xb4, 0x17, 0x9c, 0xbb, 0xc3,
+	0xc2, 0x5a, 0x91, 0xf5, 0x53, 0x59, 0xbe, 0x2e, 0x2c, 0x58, 0x1a, 0x37, 0xaa, 0x68, 0xa3, 0x32,
+	0x02, 0x9f, 0x83, 0xb5, 0x18, 0x27, 0xe1, 0x2b, 0xc2, 0xb8, 0x7a, 0x03, 0xce, 0xcb, 0xee, 0xde,
+	0x17, 0x73, 0x7b, 0x24, 0x88, 0x77, 0xdb, 0x78, 0x74, 0x95, 0xab, 0x36, 0xaa, 0x40, 0xf6, 0x97,
+	0xf5, 0x18, 0x6f, 0xdf, 0xd5, 0x22, 0x46, 0x8f, 0x0c, 0x46, 0x31, 0x7a, 0x64, 0x00, 0xb7, 0xc0,
+	0x62, 0x1f, 0x47, 0x39, 0x91, 0xfe, 0x97, 0x91, 0xfa, 0xe1, 0x9c, 0xbd, 0xb9, 0x6c, 0x18, 0x17,
+	0x97, 0x0d, 0xe3, 0xef, 0xcb, 0x86, 0xf1, 0xcb, 0x55, 0x63, 0xee, 0xe2, 0xaa, 0x31, 0xf7, 0xd7,
+	0x55, 0x63, 0xee, 0xdb, 0xcf, 0x82, 0x90, 0x77, 0x73, 0xaf, 0xd9, 0xa1, 0x71, 0xeb, 0x48, 0x7d,
+	0xe1, 0xa8, 0x9b, 0xf4, 0x90, 0xf9, 0xbd, 0x56, 0x40, 0x23, 0x9c, 0x04, 0xad, 0x0e, 0x65, 0x31,
+	0x65, 0xad, 0xd7, 0xe3, 0x8f, 0x1f, 0x3e, 0x48, 0x09, 0xf3, 0x96, 0xe4, 0xa7, 0xcf, 0xa3, 0x7f,
+	0x07, 0x00, 0x9f, 0xc4, 0xe9, 0xb2, 0x62, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +420,155 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BridgeMessageDigest) > 0 {
+		i -= len(m.BridgeMessageDigest)
+		copy(dAtA[i:], m.BridgeMessageDigest)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeMessageDigest)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PolicyHeadroom) > 0 {
+		i -= len(m.PolicyHeadroom)
+		copy(dAtA[i:], m.PolicyHeadroom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PolicyHeadroom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DeliveredInbound) > 0 {
+		for iNdEx := len(m.DeliveredInbound) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliveredInbound[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.BundleUploads) > 0 {
+		for iNdEx := len(m.BundleUploads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleUploads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.BundleInstallations) > 0 {
+		for iNdEx := len(m.BundleInstallations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleInstallations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AutoProvisions) > 0 {
+		for iNdEx := len(m.AutoProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.BlockEntropy) > 0 {
+		i -= len(m.BlockEntropy)
+		copy(dAtA[i:], m.BlockEntropy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockEntropy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BundleStorageDeposits) > 0 {
+		for iNdEx := len(m.BundleStorageDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleStorageDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.UpgradeSteps) > 0 {
+		for iNdEx := len(m.UpgradeSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.WalletSpendActionRateLimitBuckets) > 0 {
+		for iNdEx := len(m.WalletSpendActionRateLimitBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletSpendActionRateLimitBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SwingStoreExport != nil {
+		{
+			size, err := m.SwingStoreExport.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VatOwners) > 0 {
+		for iNdEx := len(m.VatOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VatOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SwingStoreExportDataHash) > 0 {
 		i -= len(m.SwingStoreExportDataHash)
 		copy(dAtA[i:], m.SwingStoreExportDataHash)
@@ -243,6 +613,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwingStoreExportReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreExportReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreExportReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManifestHash) > 0 {
+		i -= len(m.ManifestHash)
+		copy(dAtA[i:], m.ManifestHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ManifestHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SwingStoreExportDataEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -311,36 +723,120 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	return n
-}
-
-func (m *SwingStoreExportDataEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.VatOwners) > 0 {
+		for _, e := range m.VatOwners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
+	if m.SwingStoreExport != nil {
+		l = m.SwingStoreExport.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = len(m.Value)
+	if len(m.WalletSpendActionRateLimitBuckets) > 0 {
+		for _, e := range m.WalletSpendActionRateLimitBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UpgradeSteps) > 0 {
+		for _, e := range m.UpgradeSteps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BundleStorageDeposits) > 0 {
+		for _, e := range m.BundleStorageDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.BlockEntropy)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+	if len(m.AutoProvisions) > 0 {
+		for _, e := range m.AutoProvisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BundleInstallations) > 0 {
+		for _, e := range m.BundleInstallations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BundleUploads) > 0 {
+		for _, e := range m.BundleUploads {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeliveredInbound) > 0 {
+		for _, e := range m.DeliveredInbound {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PolicyHeadroom)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeMessageDigest)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SwingStoreExportReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	l = len(m.ManifestHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SwingStoreExportDataEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -498,6 +994,547 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.SwingStoreExportDataHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VatOwners = append(m.VatOwners, VatOwner{})
+			if err := m.VatOwners[len(m.VatOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwingStoreExport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwingStoreExport == nil {
+				m.SwingStoreExport = &SwingStoreExportReference{}
+			}
+			if err := m.SwingStoreExport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletSpendActionRateLimitBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletSpendActionRateLimitBuckets = append(m.WalletSpendActionRateLimitBuckets, RateLimitBucketRecord{})
+			if err := m.WalletSpendActionRateLimitBuckets[len(m.WalletSpendActionRateLimitBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeSteps = append(m.UpgradeSteps, UpgradeStepRecord{})
+			if err := m.UpgradeSteps[len(m.UpgradeSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleStorageDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleStorageDeposits = append(m.BundleStorageDeposits, BundleStorageDepositRecord{})
+			if err := m.BundleStorageDeposits[len(m.BundleStorageDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEntropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockEntropy = append(m.BlockEntropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockEntropy == nil {
+				m.BlockEntropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoProvisions = append(m.AutoProvisions, AutoProvisionRecord{})
+			if err := m.AutoProvisions[len(m.AutoProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleInstallations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleInstallations = append(m.BundleInstallations, BundleInstallationRecord{})
+			if err := m.BundleInstallations[len(m.BundleInstallations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleUploads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleUploads = append(m.BundleUploads, BundleUploadRecord{})
+			if err := m.BundleUploads[len(m.BundleUploads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredInbound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveredInbound = append(m.DeliveredInbound, DeliveredInboundRecord{})
+			if err := m.DeliveredInbound[len(m.DeliveredInbound)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyHeadroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyHeadroom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeMessageDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeMessageDigest = append(m.BridgeMessageDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.BridgeMessageDigest == nil {
+				m.BridgeMessageDigest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwingStoreExportReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwingStoreExportReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwingStoreExportReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
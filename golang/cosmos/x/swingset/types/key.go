@@ -6,4 +6,8 @@ const (
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
+
+	// BundleStoragePoolName is the module account holding the refundable
+	// deposits of bundle storage fees.
+	BundleStoragePoolName = "swingset/bundle_storage"
 )
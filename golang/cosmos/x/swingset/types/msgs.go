@@ -3,6 +3,9 @@ package types
 import (
 	"bytes"
 	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"strings"
@@ -22,14 +25,22 @@ var (
 	_ sdk.Msg = &MsgDeliverInbound{}
 	_ sdk.Msg = &MsgProvision{}
 	_ sdk.Msg = &MsgInstallBundle{}
+	_ sdk.Msg = &MsgInstallBundleChunk{}
 	_ sdk.Msg = &MsgWalletAction{}
 	_ sdk.Msg = &MsgWalletSpendAction{}
+	_ sdk.Msg = &MsgRegisterVatOwner{}
+	_ sdk.Msg = &MsgRevokeEgress{}
+	_ sdk.Msg = &MsgPruneBundles{}
+	_ sdk.Msg = &MsgOraclePush{}
 
 	_ vm.ControllerAdmissionMsg = &MsgDeliverInbound{}
 	_ vm.ControllerAdmissionMsg = &MsgInstallBundle{}
+	_ vm.ControllerAdmissionMsg = &MsgInstallBundleChunk{}
 	_ vm.ControllerAdmissionMsg = &MsgProvision{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletAction{}
 	_ vm.ControllerAdmissionMsg = &MsgWalletSpendAction{}
+	_ vm.ControllerAdmissionMsg = &MsgPruneBundles{}
+	_ vm.ControllerAdmissionMsg = &MsgOraclePush{}
 )
 
 // Contextual information about the message source of an action on an inbound queue.
@@ -55,6 +66,24 @@ const (
 	// bundleUncompressedSizeLimit is the (exclusive) limit on uncompressed bundle size.
 	// We must ensure there is an exclusive int64 limit in order to detect an underflow.
 	bundleUncompressedSizeLimit int64 = 10 * 1024 * 1024 // 10MB
+
+	// BundleChunksLimit is the (inclusive) limit on the number of chunks in a
+	// bundle uploaded by MsgInstallBundleChunk.
+	BundleChunksLimit uint64 = 1024
+	// BundleUploadSizeLimit is the (exclusive) limit on the total size of the
+	// chunks of a bundle uploaded by MsgInstallBundleChunk.
+	BundleUploadSizeLimit int64 = bundleUncompressedSizeLimit
+
+	// BundlePruneLimit is the (inclusive) limit on the number of bundles
+	// removed by a single MsgPruneBundles.
+	BundlePruneLimit = 100
+
+	// OracleFeedLengthLimit is the (inclusive) limit on the length of the
+	// feed name of a MsgOraclePush.
+	OracleFeedLengthLimit = 64
+	// OracleUnitPriceLengthLimit is the (inclusive) limit on the number of
+	// digits of the unit price of a MsgOraclePush.
+	OracleUnitPriceLengthLimit = 78
 )
 
 // Charge an account address for the beans associated with given messages and storage.
@@ -67,6 +96,28 @@ func chargeAdmission(
 	msgs []string,
 	storageLen uint64,
 ) error {
+	return keeper.ChargeBeans(ctx, beansPerUnit, addr, admissionBeans(beansPerUnit, msgs, storageLen))
+}
+
+// chargeWalletBeans charges the owner of a smart wallet message the given
+// beans, or the fee granter of the transaction on the owner's behalf if any,
+// so that a dapp may sponsor the smart wallet actions of its users.  The
+// granter is charged under its allowance to the fee payer of the transaction.
+func chargeWalletBeans(
+	ctx sdk.Context,
+	keeper SwingSetKeeper,
+	beansPerUnit map[string]sdkmath.Uint,
+	owner sdk.AccAddress,
+	beans sdkmath.Uint,
+) error {
+	if grant, ok := GetFeeGrant(ctx); ok && !grant.Granter.Equals(owner) {
+		return keeper.ChargeGrantedBeans(ctx, beansPerUnit, grant.Granter, grant.Grantee, beans, grant.Msgs)
+	}
+	return keeper.ChargeBeans(ctx, beansPerUnit, owner, beans)
+}
+
+// admissionBeans returns the beans associated with given messages and storage.
+func admissionBeans(beansPerUnit map[string]sdkmath.Uint, msgs []string, storageLen uint64) sdkmath.Uint {
 	// A flat charge for each transaction.
 	beans := beansPerUnit[BeansPerInboundTx]
 	// A charge for each message in the transaction.
@@ -78,7 +129,7 @@ func chargeAdmission(
 	// A charge for persistent storage.
 	beans = beans.Add(beansPerUnit[BeansPerStorageByte].MulUint64(storageLen))
 
-	return keeper.ChargeBeans(ctx, beansPerUnit, addr, beans)
+	return beans
 }
 
 // checkSmartWalletProvisioned verifies if a smart wallet message (MsgWalletAction
@@ -109,6 +160,9 @@ func checkSmartWalletProvisioned(
 		// This is a separate charge from the smart wallet action which triggered the check
 		// TODO: Currently this call does not mark the smart wallet provisioning as
 		// pending, resulting in multiple provisioning charges for the owner.
+		if _, ok := GetFeeGrant(ctx); ok {
+			return chargeWalletBeans(ctx, keeper, beansPerUnit, addr, beansPerUnit[BeansPerSmartWalletProvision])
+		}
 		return keeper.ChargeForSmartWallet(ctx, beansPerUnit, addr)
 	}
 }
@@ -209,7 +263,7 @@ func (msg MsgWalletAction) CheckAdmissibility(ctx sdk.Context, data interface{})
 		return err
 	}
 
-	return chargeAdmission(ctx, keeper, beansPerUnit, msg.Owner, []string{msg.Action}, 0)
+	return chargeWalletBeans(ctx, keeper, beansPerUnit, msg.Owner, admissionBeans(beansPerUnit, []string{msg.Action}, 0))
 }
 
 // GetInboundMsgCount implements InboundMsgCarrier.
@@ -259,7 +313,7 @@ func (msg MsgWalletAction) ValidateBasic() error {
 	if !json.Valid([]byte(msg.Action)) {
 		return sdkioerrors.Wrap(sdkerrors.ErrJSONUnmarshal, "Wallet action must be valid JSON")
 	}
-	return nil
+	return validateSignText(&msg)
 }
 
 func NewMsgWalletSpendAction(owner sdk.AccAddress, spendAction string) *MsgWalletSpendAction {
@@ -282,7 +336,7 @@ func (msg MsgWalletSpendAction) CheckAdmissibility(ctx sdk.Context, data interfa
 		return err
 	}
 
-	return chargeAdmission(ctx, keeper, beansPerUnit, msg.Owner, []string{msg.SpendAction}, 0)
+	return chargeWalletBeans(ctx, keeper, beansPerUnit, msg.Owner, admissionBeans(beansPerUnit, []string{msg.SpendAction}, 0))
 }
 
 // GetInboundMsgCount implements InboundMsgCarrier.
@@ -297,7 +351,7 @@ func (msg MsgWalletSpendAction) IsHighPriority(ctx sdk.Context, data interface{}
 		return false, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
 	}
 
-	return keeper.IsHighPriorityAddress(ctx, msg.Owner)
+	return keeper.IsPrioritySender(ctx, msg.Owner)
 }
 
 func (msg MsgWalletSpendAction) GetSigners() []sdk.AccAddress {
@@ -315,7 +369,7 @@ func (msg MsgWalletSpendAction) ValidateBasic() error {
 	if !json.Valid([]byte(msg.SpendAction)) {
 		return sdkioerrors.Wrap(sdkerrors.ErrJSONUnmarshal, "Wallet spend action must be valid JSON")
 	}
-	return nil
+	return validateSignText(&msg)
 }
 
 func NewMsgProvision(nickname string, addr sdk.AccAddress, powerFlags []string, submitter sdk.AccAddress) *MsgProvision {
@@ -471,8 +525,17 @@ func (msg *MsgInstallBundle) Compress() error {
 	return nil
 }
 
+// isZlibHeader reports whether header begins with a zlib (RFC 1950) stream
+// header using the deflate method.
+func isZlibHeader(header []byte) bool {
+	if len(header) < 2 {
+		return false
+	}
+	return header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0
+}
+
 // Uncompress ensures that a validated bundle is uncompressed,
-// gzip-uncompressing it if necessary.
+// gzip- or zlib-uncompressing it if necessary.
 // Returns an error (and ends uncompression early) if the uncompressed
 // size does not match the expected uncompressed size.
 // The successor of the uncompressed size must not overflow.
@@ -481,7 +544,13 @@ func (msg *MsgInstallBundle) Uncompress() error {
 		return nil
 	}
 	bytesReader := bytes.NewReader(msg.CompressedBundle)
-	ungzipReader, err := gzip.NewReader(bytesReader)
+	var uncompressReader io.Reader
+	var err error
+	if isZlibHeader(msg.CompressedBundle) {
+		uncompressReader, err = zlib.NewReader(bytesReader)
+	} else {
+		uncompressReader, err = gzip.NewReader(bytesReader)
+	}
 	if err != nil {
 		return err
 	}
@@ -489,7 +558,7 @@ func (msg *MsgInstallBundle) Uncompress() error {
 	// Computation doesn't overflow because of ValidateBasic check.
 	// Setting the limit over the expected size is needed to detect
 	// expansion beyond expectations.
-	limitedReader := io.LimitedReader{R: ungzipReader, N: msg.UncompressedSize + 1}
+	limitedReader := io.LimitedReader{R: uncompressReader, N: msg.UncompressedSize + 1}
 	var buf bytes.Buffer
 	n, err := io.Copy(&buf, &limitedReader)
 	if err != nil {
@@ -503,3 +572,318 @@ func (msg *MsgInstallBundle) Uncompress() error {
 	msg.UncompressedSize = 0
 	return nil
 }
+
+func NewMsgInstallBundleChunk(
+	bundleHash string,
+	chunkIndex, totalChunks uint64,
+	chunk []byte,
+	uncompressedSize int64,
+	submitter sdk.AccAddress,
+) *MsgInstallBundleChunk {
+	return &MsgInstallBundleChunk{
+		Submitter:        submitter,
+		BundleHash:       bundleHash,
+		ChunkIndex:       chunkIndex,
+		TotalChunks:      totalChunks,
+		Chunk:            chunk,
+		UncompressedSize: uncompressedSize,
+	}
+}
+
+// BundleHash returns the hash expected in the BundleHash field of a
+// MsgInstallBundleChunk uploading compressedBundle.
+func BundleHash(compressedBundle []byte) string {
+	sum := sha512.Sum512(compressedBundle)
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// Every chunk is charged its share of the storage for the uncompressed bundle,
+// so that a complete upload costs at least the storage of MsgInstallBundle,
+// and also for storing its own bytes until the upload completes or expires,
+// so that neither resubmitted nor abandoned chunks are free.
+func (msg MsgInstallBundleChunk) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	storageLen := bundleChunkStorageShare(msg.UncompressedSize, msg.TotalChunks) + uint64(len(msg.Chunk))
+	beansPerUnit := keeper.GetBeansPerUnit(ctx)
+	return chargeAdmission(ctx, keeper, beansPerUnit, msg.Submitter, []string{""}, storageLen)
+}
+
+// bundleChunkStorageShare returns the share of uncompressedSize charged to each
+// of totalChunks chunks, rounded up so that the shares cover it.
+func bundleChunkStorageShare(uncompressedSize int64, totalChunks uint64) uint64 {
+	if uncompressedSize <= 0 || totalChunks == 0 {
+		return 0
+	}
+	return (uint64(uncompressedSize) + totalChunks - 1) / totalChunks
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+// Any chunk may be the one completing the upload and installing the bundle.
+func (msg MsgInstallBundleChunk) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgInstallBundleChunk) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgInstallBundleChunk) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgInstallBundleChunk) Type() string { return "installBundleChunk" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgInstallBundleChunk) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if len(msg.BundleHash) != 2*sha512.Size || strings.ToLower(msg.BundleHash) != msg.BundleHash {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle hash must be a lowercase hex SHA-512 hash")
+	}
+	if _, err := hex.DecodeString(msg.BundleHash); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle hash must be a lowercase hex SHA-512 hash")
+	}
+	if msg.TotalChunks == 0 || msg.TotalChunks > BundleChunksLimit {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Total chunks must be between 1 and %d", BundleChunksLimit)
+	}
+	if msg.ChunkIndex >= msg.TotalChunks {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk index out of range")
+	}
+	if len(msg.Chunk) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Chunk cannot be empty")
+	}
+	if !(msg.UncompressedSize > 0) {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size must be positive")
+	}
+	if msg.UncompressedSize >= bundleUncompressedSizeLimit {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Uncompressed size out of range")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgInstallBundleChunk) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func NewMsgRegisterVatOwner(authority, vatID, owner string) *MsgRegisterVatOwner {
+	return &MsgRegisterVatOwner{
+		Authority: authority,
+		VatID:     vatID,
+		Owner:     owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterVatOwner) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterVatOwner) Type() string { return "registerVatOwner" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterVatOwner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address: %s", err)
+	}
+	if err := ValidateVatID(msg.VatID); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address: %s", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterVatOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterVatOwner) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgRevokeEgress(authority, peer string) *MsgRevokeEgress {
+	return &MsgRevokeEgress{
+		Authority: authority,
+		Peer:      peer,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeEgress) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeEgress) Type() string { return "revokeEgress" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeEgress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Peer); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid peer address: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeEgress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeEgress) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgPruneBundles(bundleHashes []string, submitter sdk.AccAddress) *MsgPruneBundles {
+	return &MsgPruneBundles{
+		Submitter:    submitter,
+		BundleHashes: bundleHashes,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgPruneBundles) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	beansPerUnit := keeper.GetBeansPerUnit(ctx)
+	return chargeAdmission(ctx, keeper, beansPerUnit, msg.Submitter, msg.BundleHashes, 0)
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgPruneBundles) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+func (msg MsgPruneBundles) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	return false, nil
+}
+
+// Route should return the name of the module
+func (msg MsgPruneBundles) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgPruneBundles) Type() string { return "pruneBundles" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgPruneBundles) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if len(msg.BundleHashes) == 0 || len(msg.BundleHashes) > BundlePruneLimit {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Bundle hashes must number between 1 and %d", BundlePruneLimit)
+	}
+	seen := make(map[string]bool, len(msg.BundleHashes))
+	for _, bundleHash := range msg.BundleHashes {
+		if len(bundleHash) != 2*sha512.Size || strings.ToLower(bundleHash) != bundleHash {
+			return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle hash must be a lowercase hex SHA-512 hash")
+		}
+		if _, err := hex.DecodeString(bundleHash); err != nil {
+			return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Bundle hash must be a lowercase hex SHA-512 hash")
+		}
+		if seen[bundleHash] {
+			return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Duplicate bundle hash %s", bundleHash)
+		}
+		seen[bundleHash] = true
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgPruneBundles) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPruneBundles) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func NewMsgOraclePush(feed, unitPrice string, roundID uint64, submitter sdk.AccAddress) *MsgOraclePush {
+	return &MsgOraclePush{
+		Submitter: submitter,
+		Feed:      feed,
+		UnitPrice: unitPrice,
+		RoundID:   roundID,
+	}
+}
+
+// CheckAdmissibility implements the vm.ControllerAdmissionMsg interface.
+// Oracle pushes are paid for by the fixed oracle_push_fee rather than beans,
+// so only the submitter's registration is checked.
+func (msg MsgOraclePush) CheckAdmissibility(ctx sdk.Context, data interface{}) error {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	if !keeper.IsOracleOperator(ctx, msg.Submitter) {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an oracle operator", msg.Submitter)
+	}
+	return nil
+}
+
+// GetInboundMsgCount implements InboundMsgCarrier.
+func (msg MsgOraclePush) GetInboundMsgCount() int32 {
+	return 1
+}
+
+// IsHighPriority implements the vm.ControllerAdmissionMsg interface.
+// Pushes from oracle operators are exempt from the inbound queue size limits,
+// like high-priority messages.
+func (msg MsgOraclePush) IsHighPriority(ctx sdk.Context, data interface{}) (bool, error) {
+	keeper, ok := data.(SwingSetKeeper)
+	if !ok {
+		return false, sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "data must be a SwingSetKeeper, not a %T", data)
+	}
+	return keeper.IsOracleOperator(ctx, msg.Submitter), nil
+}
+
+// Route should return the name of the module
+func (msg MsgOraclePush) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgOraclePush) Type() string { return "oraclePush" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgOraclePush) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if len(msg.Feed) == 0 || len(msg.Feed) > OracleFeedLengthLimit {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Feed must be between 1 and %d bytes", OracleFeedLengthLimit)
+	}
+	if len(msg.UnitPrice) > OracleUnitPriceLengthLimit {
+		return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unit price must have at most %d digits", OracleUnitPriceLengthLimit)
+	}
+	price, err := sdkmath.ParseUint(msg.UnitPrice)
+	if err != nil || price.IsZero() || price.String() != msg.UnitPrice {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, "Unit price must be a positive integer without leading zeros")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgOraclePush) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgOraclePush) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
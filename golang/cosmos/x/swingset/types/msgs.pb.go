@@ -142,6 +142,12 @@ type MsgWalletAction struct {
 	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner" yaml:"owner"`
 	// The action to perform, as JSON-stringified marshalled data.
 	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// The lines of the textual rendering of the action, as by the
+	// WalletActionText query, for signers such as hardware wallets to display
+	// in the sign doc in place of the action.  If given, they must equal the
+	// rendering of the action by the chain, so that what the signer displays
+	// is what the action does.
+	SignText []string `protobuf:"bytes,3,rep,name=sign_text,json=signText,proto3" json:"sign_text,omitempty" yaml:"sign_text"`
 }
 
 func (m *MsgWalletAction) Reset()         { *m = MsgWalletAction{} }
@@ -191,6 +197,13 @@ func (m *MsgWalletAction) GetAction() string {
 	return ""
 }
 
+func (m *MsgWalletAction) GetSignText() []string {
+	if m != nil {
+		return m.SignText
+	}
+	return nil
+}
+
 // MsgWalletActionResponse is an empty reply.
 type MsgWalletActionResponse struct {
 }
@@ -235,6 +248,12 @@ type MsgWalletSpendAction struct {
 	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner" yaml:"owner"`
 	// The action to perform, as JSON-stringified marshalled data.
 	SpendAction string `protobuf:"bytes,2,opt,name=spend_action,json=spendAction,proto3" json:"spend_action,omitempty"`
+	// The lines of the textual rendering of the action, as by the
+	// WalletActionText query, for signers such as hardware wallets to display
+	// in the sign doc in place of the action.  If given, they must equal the
+	// rendering of the action by the chain, so that what the signer displays
+	// is what the action does.
+	SignText []string `protobuf:"bytes,3,rep,name=sign_text,json=signText,proto3" json:"sign_text,omitempty" yaml:"sign_text"`
 }
 
 func (m *MsgWalletSpendAction) Reset()         { *m = MsgWalletSpendAction{} }
@@ -284,6 +303,13 @@ func (m *MsgWalletSpendAction) GetSpendAction() string {
 	return ""
 }
 
+func (m *MsgWalletSpendAction) GetSignText() []string {
+	if m != nil {
+		return m.SignText
+	}
+	return nil
+}
+
 // MsgWalletSpendActionResponse is an empty reply.
 type MsgWalletSpendActionResponse struct {
 }
@@ -432,7 +458,8 @@ type MsgInstallBundle struct {
 	Bundle    string                                        `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle" yaml:"bundle"`
 	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
 	// Either bundle or compressed_bundle will be set.
-	// Default compression algorithm is gzip.
+	// Default compression algorithm is gzip, but a zlib stream is also
+	// accepted.
 	CompressedBundle []byte `protobuf:"bytes,3,opt,name=compressed_bundle,json=compressedBundle,proto3" json:"compressedBundle" yaml:"compressedBundle"`
 	// Size in bytes of uncompression of compressed_bundle.
 	UncompressedSize int64 `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
@@ -537,839 +564,3267 @@ func (m *MsgInstallBundleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInstallBundleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
-	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
-	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
-	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
-	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
-	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
-	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
-	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
-	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
-	proto.RegisterType((*MsgInstallBundleResponse)(nil), "agoric.swingset.MsgInstallBundleResponse")
+// MsgInstallBundleChunk carries one chunk of a compressed bundle to be
+// reassembled and installed on SwingSet.  The concatenation of all chunks in
+// index order must be the gzip- or zlib-compressed bundle.
+type MsgInstallBundleChunk struct {
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// Lowercase hex SHA-512 hash of the complete compressed bundle.
+	BundleHash string `protobuf:"bytes,2,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	// Zero-based position of this chunk.
+	ChunkIndex uint64 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunkIndex" yaml:"chunkIndex"`
+	// Number of chunks making up the compressed bundle.
+	TotalChunks uint64 `protobuf:"varint,4,opt,name=total_chunks,json=totalChunks,proto3" json:"totalChunks" yaml:"totalChunks"`
+	Chunk       []byte `protobuf:"bytes,5,opt,name=chunk,proto3" json:"chunk" yaml:"chunk"`
+	// Size in bytes of uncompression of the complete compressed bundle.
+	UncompressedSize int64 `protobuf:"varint,6,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressedSize"`
 }
 
-func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
-
-var fileDescriptor_788baa062b181a57 = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe3, 0x50, 0x36, 0xaf, 0xd9, 0x6d, 0x63, 0x95, 0xad, 0xd7, 0x0b, 0x99, 0xac, 0xa5,
-	0x15, 0x01, 0xd4, 0x44, 0xb0, 0xb7, 0xed, 0x29, 0x16, 0x42, 0x5a, 0xa4, 0xa0, 0xc5, 0x2b, 0x84,
-	0x54, 0x81, 0x5a, 0xc7, 0x19, 0x5c, 0x2b, 0xb6, 0xc7, 0xf2, 0x38, 0x2d, 0xed, 0x8d, 0xff, 0x00,
-	0xfe, 0x01, 0x04, 0xff, 0x0d, 0xc7, 0x1e, 0x11, 0x87, 0x51, 0x95, 0x5e, 0x90, 0x8f, 0x3e, 0x72,
-	0x42, 0x9e, 0xf1, 0x8f, 0xfc, 0x82, 0xa2, 0x1e, 0xca, 0x29, 0x79, 0xdf, 0xf7, 0xbd, 0xf7, 0xbe,
-	0x99, 0x79, 0x63, 0x1b, 0x34, 0xcb, 0x21, 0x91, 0x6b, 0x0f, 0xe8, 0xb9, 0x1b, 0x38, 0x14, 0xc7,
-	0x03, 0x9f, 0x3a, 0xb4, 0x1f, 0x46, 0x24, 0x26, 0xca, 0x8e, 0xe0, 0xfa, 0x05, 0xa7, 0xed, 0x39,
-	0xc4, 0x21, 0x9c, 0x1b, 0x64, 0xff, 0x84, 0x4c, 0xff, 0xb9, 0x0e, 0xed, 0x11, 0x75, 0x3e, 0xc5,
-	0x9e, 0x7b, 0x86, 0xa3, 0x57, 0xc1, 0x98, 0xcc, 0x82, 0x89, 0x72, 0x08, 0x0f, 0x7c, 0x4c, 0xa9,
-	0xe5, 0x60, 0xaa, 0x4a, 0x5d, 0xb9, 0xd7, 0x34, 0x50, 0xc2, 0x50, 0x89, 0xa5, 0x0c, 0xed, 0x5c,
-	0x58, 0xbe, 0xf7, 0x52, 0x2f, 0x10, 0xdd, 0x2c, 0x49, 0xe5, 0x23, 0x68, 0x04, 0x33, 0x9f, 0xaa,
-	0xf5, 0xae, 0xdc, 0x6b, 0x18, 0xfb, 0x09, 0x43, 0x3c, 0x4e, 0x19, 0xda, 0x16, 0x49, 0x59, 0xa4,
-	0x9b, 0x1c, 0x54, 0xde, 0x07, 0xd9, 0xb2, 0xa7, 0xaa, 0xdc, 0x95, 0x7a, 0x0d, 0xe3, 0x9d, 0x84,
-	0xa1, 0x2c, 0x4c, 0x19, 0x02, 0x21, 0xb5, 0xec, 0xa9, 0x6e, 0x66, 0x90, 0x12, 0x42, 0x93, 0xce,
-	0xc6, 0xbe, 0x1b, 0xc7, 0x38, 0x52, 0x1b, 0x5d, 0xa9, 0xd7, 0x32, 0xcc, 0x84, 0xa1, 0x0a, 0x4c,
-	0x19, 0xda, 0x15, 0x49, 0x25, 0xa4, 0xff, 0xc5, 0xd0, 0x81, 0xe3, 0xc6, 0xa7, 0xb3, 0x71, 0xdf,
-	0x26, 0xfe, 0xc0, 0x26, 0xd4, 0x27, 0x34, 0xff, 0x39, 0xa0, 0x93, 0xe9, 0x20, 0xbe, 0x08, 0x31,
-	0xed, 0x0f, 0x6d, 0x7b, 0x38, 0x99, 0x44, 0x98, 0x52, 0xb3, 0xaa, 0xf7, 0xb2, 0xf1, 0xe7, 0x2f,
-	0xa8, 0xa6, 0x3f, 0x85, 0x27, 0x6b, 0xfb, 0x63, 0x62, 0x1a, 0x92, 0x80, 0x62, 0xfd, 0x27, 0x09,
-	0x76, 0x46, 0xd4, 0xf9, 0xda, 0xf2, 0x3c, 0x1c, 0x0f, 0xed, 0xd8, 0x25, 0x81, 0x72, 0x02, 0x6f,
-	0x91, 0xf3, 0x00, 0x47, 0xaa, 0xc4, 0x4d, 0x7e, 0x9e, 0x30, 0x24, 0x80, 0x94, 0xa1, 0x96, 0x30,
-	0xc8, 0xc3, 0x3b, 0x98, 0x13, 0x75, 0x94, 0xc7, 0xb0, 0x65, 0xf1, 0x5e, 0x6a, 0xbd, 0x2b, 0xf5,
-	0x9a, 0x66, 0x1e, 0xe5, 0x86, 0x9f, 0xc0, 0xfe, 0x8a, 0xa5, 0xd2, 0xee, 0xaf, 0x12, 0xec, 0x95,
-	0xdc, 0x9b, 0x10, 0x07, 0x93, 0x7b, 0xf3, 0xfc, 0x0c, 0x5a, 0x34, 0x6b, 0x78, 0xbc, 0xe4, 0x7c,
-	0x9b, 0x56, 0x26, 0x72, 0xfb, 0x1d, 0x78, 0x77, 0x93, 0xc5, 0x72, 0x0d, 0x3f, 0xc8, 0xd0, 0x1a,
-	0x51, 0xe7, 0x75, 0x44, 0xce, 0x5c, 0x9a, 0x79, 0x3f, 0x84, 0x07, 0x81, 0x6b, 0x4f, 0x03, 0xcb,
-	0xc7, 0xdc, 0x7e, 0x3e, 0xab, 0x05, 0x56, 0xcd, 0x6a, 0x81, 0xe8, 0x66, 0x49, 0x2a, 0xa7, 0xf0,
-	0xb6, 0x25, 0x8c, 0x72, 0x47, 0x2d, 0xe3, 0x8b, 0x84, 0xa1, 0x02, 0x4a, 0x19, 0x7a, 0x94, 0x8f,
-	0xa1, 0x00, 0xee, 0xb0, 0xfc, 0xa2, 0x96, 0x62, 0xc2, 0x76, 0x48, 0xce, 0x71, 0x74, 0xfc, 0x9d,
-	0x67, 0x39, 0x54, 0x95, 0xf9, 0xad, 0xfa, 0x78, 0xce, 0x10, 0xbc, 0xce, 0xe0, 0xcf, 0x32, 0x34,
-	0x61, 0x08, 0xc2, 0x32, 0x4a, 0x19, 0x6a, 0x8b, 0xf6, 0x15, 0xa6, 0x9b, 0x0b, 0x82, 0xff, 0xed,
-	0x4e, 0x3c, 0xe6, 0x63, 0x54, 0x1e, 0x41, 0x79, 0x36, 0x7f, 0xd4, 0x61, 0x77, 0x44, 0x9d, 0x57,
-	0x01, 0x8d, 0x2d, 0xcf, 0x33, 0x66, 0xc1, 0xc4, 0xc3, 0xca, 0x0b, 0xd8, 0x1a, 0xf3, 0x7f, 0xf9,
-	0xe9, 0x3c, 0x4d, 0x18, 0xca, 0x91, 0x94, 0xa1, 0x87, 0xc2, 0x9e, 0x88, 0x75, 0x33, 0x27, 0x96,
-	0x57, 0x56, 0xbf, 0x87, 0x95, 0x29, 0xdf, 0x40, 0xdb, 0x26, 0x7e, 0x98, 0xc1, 0x78, 0x72, 0x9c,
-	0x3b, 0x96, 0x79, 0xe7, 0x41, 0xc2, 0xd0, 0x6e, 0x45, 0x1a, 0x85, 0xf7, 0x7d, 0x61, 0x60, 0x95,
-	0xd1, 0xcd, 0x35, 0xb1, 0x32, 0x84, 0xf6, 0x2c, 0x58, 0xa8, 0x4f, 0xdd, 0x4b, 0xcc, 0x4f, 0x4c,
-	0x36, 0xf6, 0xb2, 0xea, 0x8b, 0xe4, 0x1b, 0xf7, 0x12, 0x9b, 0x6b, 0x88, 0xae, 0x81, 0xba, 0xba,
-	0xb7, 0xc5, 0xc6, 0x7f, 0x72, 0x2d, 0x83, 0x3c, 0xa2, 0x8e, 0xf2, 0x2d, 0x3c, 0x5c, 0xde, 0xfc,
-	0x67, 0xfd, 0x95, 0xd7, 0x40, 0x7f, 0xb5, 0x86, 0xf6, 0xc1, 0xad, 0x92, 0xa2, 0x8d, 0x72, 0x02,
-	0x8f, 0x56, 0x5e, 0x14, 0xfa, 0xa6, 0xe4, 0x65, 0x8d, 0xf6, 0xe1, 0xed, 0x9a, 0xb2, 0xc3, 0x11,
-	0xb4, 0x96, 0x1e, 0xa6, 0xdd, 0x4d, 0xb9, 0x8b, 0x0a, 0xad, 0x77, 0x9b, 0xa2, 0xac, 0xed, 0x42,
-	0x7b, 0xfd, 0xc9, 0xf7, 0xfc, 0x9f, 0xd3, 0x17, 0x64, 0xda, 0xc1, 0x7f, 0x92, 0x95, 0xad, 0xbe,
-	0x84, 0x66, 0xf5, 0x80, 0x7a, 0x6f, 0x53, 0x6e, 0x49, 0x6b, 0xcf, 0xff, 0x95, 0x2e, 0x4a, 0x1a,
-	0x5f, 0xfd, 0x36, 0xef, 0x48, 0x57, 0xf3, 0x8e, 0x74, 0x3d, 0xef, 0x48, 0x3f, 0xde, 0x74, 0x6a,
-	0x57, 0x37, 0x9d, 0xda, 0xef, 0x37, 0x9d, 0xda, 0xd1, 0xe1, 0xc2, 0xcc, 0x0f, 0xc5, 0x07, 0x81,
-	0xa8, 0xc8, 0x67, 0xde, 0x21, 0x9e, 0x15, 0x38, 0xc5, 0x65, 0xf8, 0xbe, 0xfa, 0x56, 0xe0, 0x97,
-	0x61, 0xbc, 0xc5, 0x3f, 0x03, 0x5e, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x51, 0x66, 0x1b, 0xd5,
-	0x4b, 0x08, 0x00, 0x00,
+func (m *MsgInstallBundleChunk) Reset()         { *m = MsgInstallBundleChunk{} }
+func (m *MsgInstallBundleChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgInstallBundleChunk) ProtoMessage()    {}
+func (*MsgInstallBundleChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Install a JavaScript sources bundle on the chain's SwingSet controller.
-	InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error)
-	// Send inbound messages.
-	DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error)
-	// Perform a low-privilege wallet action.
-	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
-	// Perform a wallet action that spends assets.
-	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
-	// Provision a new endpoint.
-	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
+func (m *MsgInstallBundleChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgInstallBundleChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstallBundleChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgInstallBundleChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstallBundleChunk.Merge(m, src)
+}
+func (m *MsgInstallBundleChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstallBundleChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstallBundleChunk.DiscardUnknown(m)
 }
 
-func (c *msgClient) InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error) {
-	out := new(MsgInstallBundleResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundle", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInstallBundleChunk proto.InternalMessageInfo
+
+func (m *MsgInstallBundleChunk) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error) {
-	out := new(MsgDeliverInboundResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/DeliverInbound", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstallBundleChunk) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error) {
-	out := new(MsgWalletActionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstallBundleChunk) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error) {
-	out := new(MsgWalletSpendActionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletSpendAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstallBundleChunk) GetTotalChunks() uint64 {
+	if m != nil {
+		return m.TotalChunks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
-	out := new(MsgProvisionResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstallBundleChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Install a JavaScript sources bundle on the chain's SwingSet controller.
-	InstallBundle(context.Context, *MsgInstallBundle) (*MsgInstallBundleResponse, error)
-	// Send inbound messages.
-	DeliverInbound(context.Context, *MsgDeliverInbound) (*MsgDeliverInboundResponse, error)
-	// Perform a low-privilege wallet action.
-	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
-	// Perform a wallet action that spends assets.
-	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
-	// Provision a new endpoint.
-	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
+func (m *MsgInstallBundleChunk) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgInstallBundleChunkResponse reports whether the chunk completed the upload,
+// in which case the bundle has been queued for the SwingSet kernel's
+// consideration.
+type MsgInstallBundleChunkResponse struct {
+	Installed bool `protobuf:"varint,1,opt,name=installed,proto3" json:"installed,omitempty"`
 }
 
-func (*UnimplementedMsgServer) InstallBundle(ctx context.Context, req *MsgInstallBundle) (*MsgInstallBundleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstallBundle not implemented")
+func (m *MsgInstallBundleChunkResponse) Reset()         { *m = MsgInstallBundleChunkResponse{} }
+func (m *MsgInstallBundleChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstallBundleChunkResponse) ProtoMessage()    {}
+func (*MsgInstallBundleChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{11}
 }
-func (*UnimplementedMsgServer) DeliverInbound(ctx context.Context, req *MsgDeliverInbound) (*MsgDeliverInboundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeliverInbound not implemented")
+func (m *MsgInstallBundleChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletAction) (*MsgWalletActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletAction not implemented")
+func (m *MsgInstallBundleChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstallBundleChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
+func (m *MsgInstallBundleChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstallBundleChunkResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+func (m *MsgInstallBundleChunkResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgInstallBundleChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstallBundleChunkResponse.DiscardUnknown(m)
 }
 
-func _Msg_InstallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstallBundle)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstallBundle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/InstallBundle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstallBundle(ctx, req.(*MsgInstallBundle))
+var xxx_messageInfo_MsgInstallBundleChunkResponse proto.InternalMessageInfo
+
+func (m *MsgInstallBundleChunkResponse) GetInstalled() bool {
+	if m != nil {
+		return m.Installed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_DeliverInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeliverInbound)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeliverInbound(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/DeliverInbound",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeliverInbound(ctx, req.(*MsgDeliverInbound))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRegisterVatOwner registers the account billed for the computrons used by
+// a vat, replacing any previous registration.  The new owner inherits the
+// cost that the vat has accrued but not paid.  An empty owner removes the
+// registration.  It may only be executed by
+// governance.
+type MsgRegisterVatOwner struct {
+	// The address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The ID of the vat, such as "v42".
+	VatID string `protobuf:"bytes,2,opt,name=vat_id,json=vatId,proto3" json:"vatID" yaml:"vatID"`
+	// The bech32 address of the account to bill.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner" yaml:"owner"`
 }
 
-func _Msg_WalletAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWalletAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WalletAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/WalletAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WalletAction(ctx, req.(*MsgWalletAction))
+func (m *MsgRegisterVatOwner) Reset()         { *m = MsgRegisterVatOwner{} }
+func (m *MsgRegisterVatOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterVatOwner) ProtoMessage()    {}
+func (*MsgRegisterVatOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{12}
+}
+func (m *MsgRegisterVatOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterVatOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterVatOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterVatOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterVatOwner.Merge(m, src)
+}
+func (m *MsgRegisterVatOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterVatOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterVatOwner.DiscardUnknown(m)
 }
 
-func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWalletSpendAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WalletSpendAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/WalletSpendAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WalletSpendAction(ctx, req.(*MsgWalletSpendAction))
+var xxx_messageInfo_MsgRegisterVatOwner proto.InternalMessageInfo
+
+func (m *MsgRegisterVatOwner) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProvision)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Provision(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Msg/Provision",
+func (m *MsgRegisterVatOwner) GetVatID() string {
+	if m != nil {
+		return m.VatID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Provision(ctx, req.(*MsgProvision))
+	return ""
+}
+
+func (m *MsgRegisterVatOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.swingset.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InstallBundle",
-			Handler:    _Msg_InstallBundle_Handler,
-		},
-		{
-			MethodName: "DeliverInbound",
-			Handler:    _Msg_DeliverInbound_Handler,
-		},
-		{
-			MethodName: "WalletAction",
-			Handler:    _Msg_WalletAction_Handler,
-		},
-		{
-			MethodName: "WalletSpendAction",
-			Handler:    _Msg_WalletSpendAction_Handler,
-		},
-		{
-			MethodName: "Provision",
-			Handler:    _Msg_Provision_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/swingset/msgs.proto",
+// MsgRegisterVatOwnerResponse is an empty reply.
+type MsgRegisterVatOwnerResponse struct {
 }
 
-func (m *MsgDeliverInbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRegisterVatOwnerResponse) Reset()         { *m = MsgRegisterVatOwnerResponse{} }
+func (m *MsgRegisterVatOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterVatOwnerResponse) ProtoMessage()    {}
+func (*MsgRegisterVatOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{13}
 }
-
-func (m *MsgDeliverInbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterVatOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgDeliverInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Ack != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Ack))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Nums) > 0 {
-		dAtA2 := make([]byte, len(m.Nums)*10)
-		var j1 int
-		for _, num := range m.Nums {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintMsgs(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgRegisterVatOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterVatOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDeliverInboundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRegisterVatOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterVatOwnerResponse.Merge(m, src)
 }
-
-func (m *MsgDeliverInboundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterVatOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterVatOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterVatOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeliverInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRegisterVatOwnerResponse proto.InternalMessageInfo
+
+// MsgRevokeEgress removes the egress provisioned for an ag-solo peer, along
+// with its mailbox, so that abandoned registrations can be cleaned up.  The
+// peer's account is unaffected.  It may only be executed by governance.
+type MsgRevokeEgress struct {
+	// The address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// The bech32 address of the peer.
+	Peer string `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer" yaml:"peer"`
 }
 
-func (m *MsgWalletAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeEgress) Reset()         { *m = MsgRevokeEgress{} }
+func (m *MsgRevokeEgress) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeEgress) ProtoMessage()    {}
+func (*MsgRevokeEgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{14}
+}
+func (m *MsgRevokeEgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeEgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWalletAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeEgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeEgress.Merge(m, src)
+}
+func (m *MsgRevokeEgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeEgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeEgress.DiscardUnknown(m)
 }
 
-func (m *MsgWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRevokeEgress proto.InternalMessageInfo
+
+func (m *MsgRevokeEgress) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWalletActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeEgress) GetPeer() string {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWalletActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeEgressResponse is an empty reply.
+type MsgRevokeEgressResponse struct {
 }
 
-func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeEgressResponse) Reset()         { *m = MsgRevokeEgressResponse{} }
+func (m *MsgRevokeEgressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeEgressResponse) ProtoMessage()    {}
+func (*MsgRevokeEgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{15}
 }
-
-func (m *MsgWalletSpendAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeEgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeEgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeEgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeEgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeEgressResponse.Merge(m, src)
+}
+func (m *MsgRevokeEgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeEgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeEgressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWalletSpendAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeEgressResponse proto.InternalMessageInfo
+
+// MsgPruneBundles asks SwingSet to remove installed bundles that no vat was
+// created from, and which were installed at least bundle_prune_min_age_blocks
+// ago, reclaiming their storage.  SwingSet keeps any that it still needs, such
+// as the bundles of Zoe installations.  Anyone may submit it.
+type MsgPruneBundles struct {
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// The endoZipBase64Sha512 hashes of the bundles to remove.
+	BundleHashes []string `protobuf:"bytes,2,rep,name=bundle_hashes,json=bundleHashes,proto3" json:"bundleHashes" yaml:"bundleHashes"`
 }
 
-func (m *MsgWalletSpendAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpendAction) > 0 {
-		i -= len(m.SpendAction)
-		copy(dAtA[i:], m.SpendAction)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SpendAction)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgPruneBundles) Reset()         { *m = MsgPruneBundles{} }
+func (m *MsgPruneBundles) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneBundles) ProtoMessage()    {}
+func (*MsgPruneBundles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{16}
+}
+func (m *MsgPruneBundles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneBundles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPruneBundles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneBundles.Merge(m, src)
+}
+func (m *MsgPruneBundles) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneBundles) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneBundles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneBundles proto.InternalMessageInfo
+
+func (m *MsgPruneBundles) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgWalletSpendActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPruneBundles) GetBundleHashes() []string {
+	if m != nil {
+		return m.BundleHashes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWalletSpendActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPruneBundlesResponse is an empty reply.
+type MsgPruneBundlesResponse struct {
 }
 
-func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPruneBundlesResponse) Reset()         { *m = MsgPruneBundlesResponse{} }
+func (m *MsgPruneBundlesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneBundlesResponse) ProtoMessage()    {}
+func (*MsgPruneBundlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{17}
 }
-
-func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPruneBundlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneBundlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneBundlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPruneBundlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneBundlesResponse.Merge(m, src)
+}
+func (m *MsgPruneBundlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneBundlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneBundlesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPruneBundlesResponse proto.InternalMessageInfo
+
+// MsgOraclePush submits a price update from an oracle operator listed in the
+// oracle_operators param, delivered to SwingSet through the oracleQueue
+// rather than as a smart wallet offer, for lower latency at a fixed cost.
+type MsgOraclePush struct {
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter" yaml:"submitter"`
+	// The name of the price feed, such as "ATOM-USD".
+	Feed string `protobuf:"bytes,2,opt,name=feed,proto3" json:"feed" yaml:"feed"`
+	// The price of one unit of the feed's base, in the smallest unit of its
+	// quote, as a positive integer.
+	UnitPrice string `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3" json:"unitPrice" yaml:"unitPrice"`
+	// The round to which the price belongs, or 0 for the feed's next round.
+	RoundID uint64 `protobuf:"varint,4,opt,name=round_id,json=roundId,proto3" json:"roundID" yaml:"roundID"`
 }
 
-func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgOraclePush) Reset()         { *m = MsgOraclePush{} }
+func (m *MsgOraclePush) String() string { return proto.CompactTextString(m) }
+func (*MsgOraclePush) ProtoMessage()    {}
+func (*MsgOraclePush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{18}
+}
+func (m *MsgOraclePush) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOraclePush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOraclePush.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgOraclePush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOraclePush.Merge(m, src)
+}
+func (m *MsgOraclePush) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOraclePush) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOraclePush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOraclePush proto.InternalMessageInfo
+
+func (m *MsgOraclePush) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgOraclePush) GetFeed() string {
+	if m != nil {
+		return m.Feed
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOraclePush) GetUnitPrice() string {
+	if m != nil {
+		return m.UnitPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOraclePush) GetRoundID() uint64 {
+	if m != nil {
+		return m.RoundID
+	}
+	return 0
 }
 
-func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgOraclePushResponse is an empty reply.
+type MsgOraclePushResponse struct {
 }
 
-func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOraclePushResponse) Reset()         { *m = MsgOraclePushResponse{} }
+func (m *MsgOraclePushResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOraclePushResponse) ProtoMessage()    {}
+func (*MsgOraclePushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_788baa062b181a57, []int{19}
+}
+func (m *MsgOraclePushResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOraclePushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOraclePushResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOraclePushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOraclePushResponse.Merge(m, src)
+}
+func (m *MsgOraclePushResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOraclePushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOraclePushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOraclePushResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgDeliverInbound)(nil), "agoric.swingset.MsgDeliverInbound")
+	proto.RegisterType((*MsgDeliverInboundResponse)(nil), "agoric.swingset.MsgDeliverInboundResponse")
+	proto.RegisterType((*MsgWalletAction)(nil), "agoric.swingset.MsgWalletAction")
+	proto.RegisterType((*MsgWalletActionResponse)(nil), "agoric.swingset.MsgWalletActionResponse")
+	proto.RegisterType((*MsgWalletSpendAction)(nil), "agoric.swingset.MsgWalletSpendAction")
+	proto.RegisterType((*MsgWalletSpendActionResponse)(nil), "agoric.swingset.MsgWalletSpendActionResponse")
+	proto.RegisterType((*MsgProvision)(nil), "agoric.swingset.MsgProvision")
+	proto.RegisterType((*MsgProvisionResponse)(nil), "agoric.swingset.MsgProvisionResponse")
+	proto.RegisterType((*MsgInstallBundle)(nil), "agoric.swingset.MsgInstallBundle")
+	proto.RegisterType((*MsgInstallBundleResponse)(nil), "agoric.swingset.MsgInstallBundleResponse")
+	proto.RegisterType((*MsgInstallBundleChunk)(nil), "agoric.swingset.MsgInstallBundleChunk")
+	proto.RegisterType((*MsgInstallBundleChunkResponse)(nil), "agoric.swingset.MsgInstallBundleChunkResponse")
+	proto.RegisterType((*MsgRegisterVatOwner)(nil), "agoric.swingset.MsgRegisterVatOwner")
+	proto.RegisterType((*MsgRegisterVatOwnerResponse)(nil), "agoric.swingset.MsgRegisterVatOwnerResponse")
+	proto.RegisterType((*MsgRevokeEgress)(nil), "agoric.swingset.MsgRevokeEgress")
+	proto.RegisterType((*MsgRevokeEgressResponse)(nil), "agoric.swingset.MsgRevokeEgressResponse")
+	proto.RegisterType((*MsgPruneBundles)(nil), "agoric.swingset.MsgPruneBundles")
+	proto.RegisterType((*MsgPruneBundlesResponse)(nil), "agoric.swingset.MsgPruneBundlesResponse")
+	proto.RegisterType((*MsgOraclePush)(nil), "agoric.swingset.MsgOraclePush")
+	proto.RegisterType((*MsgOraclePushResponse)(nil), "agoric.swingset.MsgOraclePushResponse")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/msgs.proto", fileDescriptor_788baa062b181a57) }
+
+var fileDescriptor_788baa062b181a57 = []byte{
+	// 1327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0x8f, 0x63, 0xe7, 0x87, 0x5f, 0x1c, 0x48, 0x96, 0x40, 0x9c, 0x05, 0x3c, 0x66, 0xbe, 0x5f,
+	0xc0, 0x2d, 0xc5, 0x16, 0xe5, 0x54, 0x50, 0xd5, 0xc6, 0xd0, 0x8a, 0x54, 0x75, 0x49, 0x07, 0x4a,
+	0x25, 0xd4, 0xca, 0x6c, 0x76, 0x87, 0xf5, 0x2a, 0xf6, 0xae, 0xb5, 0xb3, 0x0e, 0x81, 0x5b, 0x0f,
+	0xbd, 0xf7, 0x2f, 0xa8, 0xfa, 0x4f, 0xf4, 0x3f, 0xe0, 0xc0, 0x91, 0xaa, 0x3d, 0x54, 0x3d, 0xac,
+	0xaa, 0x70, 0xa9, 0x7c, 0xf4, 0xb1, 0xa7, 0x6a, 0x66, 0xd6, 0x33, 0x6b, 0xc7, 0x60, 0x4a, 0x25,
+	0x72, 0xf2, 0xbc, 0xcf, 0xfb, 0xcc, 0x9b, 0xf7, 0x63, 0xe6, 0xcd, 0x78, 0xc1, 0xb4, 0xdc, 0x20,
+	0xf4, 0xec, 0x1a, 0x7b, 0xe4, 0xf9, 0x2e, 0xa3, 0x51, 0xad, 0xc3, 0x5c, 0x56, 0xed, 0x86, 0x41,
+	0x14, 0x18, 0xc7, 0xa5, 0xae, 0x3a, 0xd4, 0x99, 0x6b, 0x6e, 0xe0, 0x06, 0x42, 0x57, 0xe3, 0x23,
+	0x49, 0xc3, 0x3f, 0xce, 0xc2, 0x6a, 0x83, 0xb9, 0x37, 0x69, 0xdb, 0xdb, 0xa3, 0xe1, 0x96, 0xbf,
+	0x13, 0xf4, 0x7c, 0xc7, 0xb8, 0x0e, 0x8b, 0x1d, 0xca, 0x98, 0xe5, 0x52, 0x56, 0xcc, 0x94, 0xb3,
+	0x95, 0x7c, 0x1d, 0xf5, 0x63, 0xa4, 0xb0, 0x41, 0x8c, 0x8e, 0x3f, 0xb6, 0x3a, 0xed, 0x6b, 0x78,
+	0x88, 0x60, 0xa2, 0x94, 0xc6, 0x25, 0xc8, 0xf9, 0xbd, 0x0e, 0x2b, 0xce, 0x96, 0xb3, 0x95, 0x5c,
+	0x7d, 0xbd, 0x1f, 0x23, 0x21, 0x0f, 0x62, 0xb4, 0x24, 0x27, 0x71, 0x09, 0x13, 0x01, 0x1a, 0x17,
+	0x21, 0x6b, 0xd9, 0xbb, 0xc5, 0x6c, 0x39, 0x53, 0xc9, 0xd5, 0x4f, 0xf6, 0x63, 0xc4, 0xc5, 0x41,
+	0x8c, 0x40, 0x52, 0x2d, 0x7b, 0x17, 0x13, 0x0e, 0x19, 0x5d, 0xc8, 0xb3, 0xde, 0x4e, 0xc7, 0x8b,
+	0x22, 0x1a, 0x16, 0x73, 0xe5, 0x4c, 0xa5, 0x50, 0x27, 0xfd, 0x18, 0x69, 0x70, 0x10, 0xa3, 0x15,
+	0x39, 0x49, 0x41, 0xf8, 0xef, 0x18, 0x5d, 0x76, 0xbd, 0xa8, 0xd5, 0xdb, 0xa9, 0xda, 0x41, 0xa7,
+	0x66, 0x07, 0xac, 0x13, 0xb0, 0xe4, 0xe7, 0x32, 0x73, 0x76, 0x6b, 0xd1, 0xe3, 0x2e, 0x65, 0xd5,
+	0x4d, 0xdb, 0xde, 0x74, 0x9c, 0x90, 0x32, 0x46, 0xb4, 0xbd, 0x6b, 0xb9, 0xbf, 0x7e, 0x42, 0x33,
+	0xf8, 0x34, 0x6c, 0x1c, 0xca, 0x0f, 0xa1, 0xac, 0x1b, 0xf8, 0x8c, 0xe2, 0xa7, 0x19, 0x38, 0xde,
+	0x60, 0xee, 0xd7, 0x56, 0xbb, 0x4d, 0xa3, 0x4d, 0x3b, 0xf2, 0x02, 0xdf, 0x78, 0x00, 0x73, 0xc1,
+	0x23, 0x9f, 0x86, 0xc5, 0x8c, 0x70, 0xf2, 0xb3, 0x7e, 0x8c, 0x24, 0x30, 0x88, 0x51, 0x41, 0x3a,
+	0x28, 0xc4, 0x37, 0x70, 0x4e, 0xda, 0x31, 0x4e, 0xc1, 0xbc, 0x25, 0xd6, 0x2a, 0xce, 0x96, 0x33,
+	0x95, 0x3c, 0x49, 0x24, 0xe3, 0x0a, 0xe4, 0x99, 0xe7, 0xfa, 0xcd, 0x88, 0xee, 0x47, 0xc5, 0xac,
+	0x28, 0xdb, 0x5a, 0x2a, 0x2b, 0x43, 0x15, 0x26, 0x8b, 0x7c, 0x7c, 0x97, 0xee, 0x47, 0x49, 0x8c,
+	0x1b, 0xb0, 0x3e, 0x16, 0x85, 0x8a, 0xf0, 0xb7, 0x0c, 0xac, 0x29, 0xdd, 0x9d, 0x2e, 0xf5, 0x9d,
+	0xb7, 0x16, 0xe6, 0x39, 0x28, 0x30, 0xbe, 0x60, 0x73, 0x24, 0xd8, 0x25, 0x96, 0x72, 0xe2, 0x8d,
+	0x23, 0x2e, 0xc1, 0x99, 0x49, 0x51, 0xa9, 0xb0, 0xbf, 0xcb, 0x42, 0xa1, 0xc1, 0xdc, 0xed, 0x30,
+	0xd8, 0xf3, 0x18, 0x5f, 0xe9, 0x3a, 0x2c, 0xfa, 0x9e, 0xbd, 0xeb, 0x5b, 0x1d, 0x2a, 0x22, 0x4e,
+	0x4e, 0xc4, 0x10, 0xd3, 0x27, 0x62, 0x88, 0x60, 0xa2, 0x94, 0x46, 0x0b, 0x16, 0x2c, 0x19, 0x9b,
+	0x08, 0xa2, 0x50, 0xff, 0xa2, 0x1f, 0xa3, 0x21, 0x34, 0x88, 0xd1, 0xb1, 0x64, 0xb3, 0x4b, 0xe0,
+	0x0d, 0x32, 0x36, 0xb4, 0x65, 0x10, 0x58, 0xea, 0x06, 0x8f, 0x68, 0xd8, 0x7c, 0xd8, 0xb6, 0x5c,
+	0x96, 0xa4, 0xe4, 0xca, 0x41, 0x8c, 0x60, 0x9b, 0xc3, 0x9f, 0x72, 0xb4, 0x1f, 0x23, 0xe8, 0x2a,
+	0x69, 0x10, 0xa3, 0x55, 0xb9, 0xbc, 0xc6, 0x30, 0x49, 0x11, 0x8e, 0xec, 0xe4, 0x9d, 0x82, 0xb5,
+	0x74, 0x09, 0x54, 0x6d, 0xfe, 0x98, 0x85, 0x95, 0x06, 0x73, 0xb7, 0x7c, 0x16, 0x59, 0xed, 0x76,
+	0xbd, 0xe7, 0x3b, 0x6d, 0x6a, 0x5c, 0x85, 0xf9, 0x1d, 0x31, 0x4a, 0xaa, 0x73, 0xba, 0x1f, 0xa3,
+	0x04, 0x19, 0xc4, 0x68, 0x59, 0xba, 0x27, 0x65, 0x4c, 0x12, 0xc5, 0x68, 0x64, 0xb3, 0x6f, 0x21,
+	0x32, 0xe3, 0x1b, 0x58, 0xb5, 0x83, 0x4e, 0x97, 0xc3, 0xd4, 0x69, 0x26, 0x1e, 0x67, 0xc5, 0xca,
+	0xb5, 0x7e, 0x8c, 0x56, 0xb4, 0xb2, 0x3e, 0xf4, 0x7d, 0x5d, 0x3a, 0x30, 0xae, 0xc1, 0xe4, 0x10,
+	0xd9, 0xd8, 0x84, 0xd5, 0x9e, 0x9f, 0xb2, 0xcf, 0xbc, 0x27, 0x54, 0x54, 0x2c, 0x5b, 0x5f, 0xe3,
+	0xd6, 0xd3, 0xca, 0x3b, 0xde, 0x13, 0x4a, 0x0e, 0x21, 0xd8, 0x84, 0xe2, 0x78, 0x6e, 0x55, 0xe2,
+	0x7f, 0xcd, 0xc2, 0xc9, 0x71, 0xe5, 0x8d, 0x56, 0xcf, 0x1f, 0x6b, 0xce, 0x99, 0xb7, 0x91, 0xc8,
+	0x9b, 0xb0, 0x24, 0xb3, 0xd7, 0x6c, 0x59, 0xac, 0x25, 0x7b, 0x43, 0xfd, 0x7f, 0x7c, 0x6b, 0x4b,
+	0xf8, 0x96, 0xc5, 0x5a, 0x7a, 0x6b, 0x6b, 0x0c, 0x93, 0x14, 0x81, 0x5b, 0xb1, 0x79, 0x00, 0x4d,
+	0xcf, 0x77, 0xe8, 0x7e, 0x72, 0x0b, 0x09, 0x2b, 0x02, 0xde, 0xe2, 0xa8, 0xb6, 0xa2, 0x31, 0x4c,
+	0x52, 0x04, 0xe3, 0x16, 0x14, 0xa2, 0x20, 0xb2, 0xda, 0x4d, 0x81, 0x31, 0x91, 0xf1, 0x5c, 0xfd,
+	0x7c, 0x3f, 0x46, 0x4b, 0x02, 0x17, 0x39, 0xe2, 0x07, 0xcd, 0x90, 0x76, 0x52, 0x20, 0x26, 0x69,
+	0x8a, 0x51, 0x83, 0x39, 0x61, 0xa3, 0x38, 0x27, 0x72, 0xb8, 0xc1, 0x9b, 0xaa, 0x00, 0x74, 0x53,
+	0x15, 0x22, 0x26, 0x12, 0x9e, 0x5c, 0xf1, 0xf9, 0x7f, 0x55, 0xf1, 0x0f, 0xe1, 0xec, 0xc4, 0xa2,
+	0x0e, 0xcb, 0x6e, 0x9c, 0x81, 0xbc, 0x27, 0xb5, 0xd4, 0x11, 0xc5, 0x5d, 0x24, 0x1a, 0xc0, 0xcf,
+	0x32, 0x70, 0xa2, 0xc1, 0x5c, 0x42, 0x5d, 0x8f, 0x45, 0x34, 0xbc, 0x67, 0x45, 0xb7, 0x45, 0xf7,
+	0xfe, 0x08, 0xf2, 0x56, 0x2f, 0x6a, 0x05, 0xa1, 0x17, 0x3d, 0x4e, 0xce, 0xe4, 0x39, 0xbe, 0x25,
+	0x14, 0xa8, 0xb7, 0x84, 0x82, 0x30, 0xd1, 0x6a, 0xe3, 0x03, 0x98, 0xdf, 0xb3, 0xa2, 0xa6, 0xe7,
+	0x24, 0xc5, 0xc5, 0x07, 0x31, 0x9a, 0xbb, 0x67, 0x45, 0x5b, 0x37, 0x79, 0x56, 0xf6, 0xf8, 0x40,
+	0x67, 0x45, 0x88, 0x98, 0x08, 0xd8, 0xe1, 0x69, 0x94, 0x77, 0x53, 0x56, 0xcc, 0xdc, 0x78, 0xe9,
+	0xdd, 0x94, 0x5c, 0x35, 0x49, 0xc3, 0x39, 0x0b, 0xa7, 0x27, 0x44, 0xa2, 0xb6, 0xff, 0xf7, 0xf2,
+	0xb2, 0x27, 0x74, 0x2f, 0xd8, 0xa5, 0x9f, 0xb8, 0xa2, 0xdf, 0xfe, 0xe7, 0x28, 0x2f, 0x41, 0xae,
+	0x4b, 0x93, 0xee, 0x93, 0x97, 0x8f, 0x25, 0x2e, 0xeb, 0xc7, 0x12, 0x97, 0x30, 0x11, 0xe0, 0xc8,
+	0x6d, 0x9d, 0x76, 0x43, 0xb9, 0xf8, 0x8b, 0x74, 0x71, 0x3b, 0xec, 0xf9, 0x54, 0x96, 0x92, 0x1d,
+	0xc1, 0xd9, 0xfc, 0x1c, 0x96, 0x53, 0x67, 0x93, 0xca, 0x97, 0x60, 0xbe, 0x7e, 0xb1, 0x1f, 0xa3,
+	0x82, 0x3e, 0x7c, 0xe2, 0x19, 0x79, 0x62, 0xfc, 0x7c, 0xf2, 0xa7, 0xe4, 0x08, 0x29, 0x09, 0x37,
+	0x1d, 0x92, 0x0a, 0xf7, 0xe7, 0x59, 0x58, 0x6e, 0x30, 0xf7, 0x76, 0x68, 0xd9, 0x6d, 0xba, 0xdd,
+	0x63, 0xad, 0x23, 0x08, 0xf6, 0x12, 0xe4, 0x1e, 0x52, 0xea, 0xa4, 0x0b, 0xc8, 0x65, 0x5d, 0x40,
+	0x2e, 0x61, 0x22, 0x40, 0xe3, 0x63, 0x80, 0x9e, 0xef, 0x45, 0xcd, 0x6e, 0xe8, 0xd9, 0xb4, 0x98,
+	0xd5, 0xfb, 0x85, 0xa3, 0xdb, 0x1c, 0xd4, 0xfe, 0x29, 0x08, 0x13, 0xad, 0x36, 0x6e, 0xc0, 0x62,
+	0xc8, 0x9f, 0xa0, 0xfc, 0x5c, 0xc8, 0x3e, 0x53, 0x39, 0x88, 0xd1, 0x02, 0xe1, 0x98, 0x38, 0x19,
+	0x0b, 0xa1, 0x1c, 0xea, 0x67, 0x45, 0x02, 0x60, 0x92, 0xa8, 0x1c, 0xbc, 0x0e, 0x27, 0x47, 0xd2,
+	0x36, 0x4c, 0xe8, 0xfb, 0x4f, 0x17, 0x20, 0xdb, 0x60, 0xae, 0xf1, 0x2d, 0x2c, 0x8f, 0x5e, 0xaf,
+	0xe7, 0xaa, 0x63, 0x7f, 0x27, 0xaa, 0xe3, 0x3d, 0xc3, 0x7c, 0x67, 0x2a, 0x45, 0x75, 0x94, 0x36,
+	0x18, 0x13, 0x2e, 0x91, 0x0b, 0x53, 0x0d, 0x08, 0x9e, 0x59, 0x7d, 0x3d, 0x9e, 0x5a, 0xed, 0x01,
+	0x1c, 0x1b, 0xfb, 0x7b, 0x83, 0x27, 0x59, 0x18, 0xe5, 0x98, 0xef, 0x4e, 0xe7, 0xa8, 0x15, 0xee,
+	0x43, 0x61, 0xe4, 0x2f, 0x40, 0x79, 0xd2, 0xdc, 0x34, 0xc3, 0xac, 0x4c, 0x63, 0x28, 0xdb, 0x1e,
+	0xac, 0x1e, 0x7e, 0x7c, 0x9f, 0x7f, 0xf9, 0xf4, 0x14, 0xcd, 0xbc, 0xfc, 0x5a, 0x34, 0xb5, 0xd4,
+	0x97, 0x90, 0xd7, 0x0f, 0xde, 0xb3, 0x93, 0xe6, 0x2a, 0xb5, 0x79, 0xfe, 0x95, 0x6a, 0x65, 0xf2,
+	0x21, 0xac, 0x1c, 0xba, 0x19, 0xfe, 0x3f, 0x69, 0xea, 0x38, 0xcb, 0x7c, 0xef, 0x75, 0x58, 0xe9,
+	0x0a, 0x8c, 0xf4, 0xe5, 0xf2, 0xe4, 0xd9, 0x9a, 0x61, 0x56, 0xa6, 0x31, 0xd2, 0xb6, 0x47, 0x1a,
+	0x6a, 0x79, 0x72, 0xe8, 0x9a, 0x61, 0x56, 0xa6, 0x31, 0x94, 0xed, 0xbb, 0x00, 0xa9, 0xee, 0x55,
+	0x9a, 0x34, 0x4f, 0xeb, 0xcd, 0x0b, 0xaf, 0xd6, 0x0f, 0xad, 0xd6, 0xbf, 0x7a, 0x76, 0x50, 0xca,
+	0x3c, 0x3f, 0x28, 0x65, 0xfe, 0x3c, 0x28, 0x65, 0x7e, 0x78, 0x51, 0x9a, 0x79, 0xfe, 0xa2, 0x34,
+	0xf3, 0xfb, 0x8b, 0xd2, 0xcc, 0xfd, 0xeb, 0xa9, 0x3e, 0xb7, 0x29, 0x3f, 0x1e, 0x48, 0x93, 0xa2,
+	0xcf, 0xb9, 0x41, 0xdb, 0xf2, 0xdd, 0x61, 0x03, 0xdc, 0xd7, 0xdf, 0x15, 0x44, 0x03, 0xdc, 0x99,
+	0x17, 0x9f, 0x0c, 0xae, 0xfe, 0x33, 0x00, 0xea, 0x44, 0x15, 0xcc, 0x77, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Install a JavaScript sources bundle on the chain's SwingSet controller.
+	InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error)
+	// Upload one chunk of a compressed bundle too large for a single
+	// transaction, installing the bundle once every chunk has arrived.
+	InstallBundleChunk(ctx context.Context, in *MsgInstallBundleChunk, opts ...grpc.CallOption) (*MsgInstallBundleChunkResponse, error)
+	// Send inbound messages.
+	DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error)
+	// Perform a low-privilege wallet action.
+	WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error)
+	// Perform a wallet action that spends assets.
+	WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error)
+	// Provision a new endpoint.
+	Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error)
+	// Register the account billed for the computrons used by a vat.
+	RegisterVatOwner(ctx context.Context, in *MsgRegisterVatOwner, opts ...grpc.CallOption) (*MsgRegisterVatOwnerResponse, error)
+	// Revoke the egress of an ag-solo peer.
+	RevokeEgress(ctx context.Context, in *MsgRevokeEgress, opts ...grpc.CallOption) (*MsgRevokeEgressResponse, error)
+	// Remove installed bundles that no vat was created from.
+	PruneBundles(ctx context.Context, in *MsgPruneBundles, opts ...grpc.CallOption) (*MsgPruneBundlesResponse, error)
+	// Push a price update from a registered oracle operator.
+	OraclePush(ctx context.Context, in *MsgOraclePush, opts ...grpc.CallOption) (*MsgOraclePushResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) InstallBundle(ctx context.Context, in *MsgInstallBundle, opts ...grpc.CallOption) (*MsgInstallBundleResponse, error) {
+	out := new(MsgInstallBundleResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InstallBundleChunk(ctx context.Context, in *MsgInstallBundleChunk, opts ...grpc.CallOption) (*MsgInstallBundleChunkResponse, error) {
+	out := new(MsgInstallBundleChunkResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/InstallBundleChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UncompressedSize != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CompressedBundle) > 0 {
-		i -= len(m.CompressedBundle)
-		copy(dAtA[i:], m.CompressedBundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) DeliverInbound(ctx context.Context, in *MsgDeliverInbound, opts ...grpc.CallOption) (*MsgDeliverInboundResponse, error) {
+	out := new(MsgDeliverInboundResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/DeliverInbound", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) WalletAction(ctx context.Context, in *MsgWalletAction, opts ...grpc.CallOption) (*MsgWalletActionResponse, error) {
+	out := new(MsgWalletActionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Bundle) > 0 {
-		i -= len(m.Bundle)
-		copy(dAtA[i:], m.Bundle)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Bundle)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) WalletSpendAction(ctx context.Context, in *MsgWalletSpendAction, opts ...grpc.CallOption) (*MsgWalletSpendActionResponse, error) {
+	out := new(MsgWalletSpendActionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/WalletSpendAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInstallBundleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Provision(ctx context.Context, in *MsgProvision, opts ...grpc.CallOption) (*MsgProvisionResponse, error) {
+	out := new(MsgProvisionResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/Provision", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInstallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterVatOwner(ctx context.Context, in *MsgRegisterVatOwner, opts ...grpc.CallOption) (*MsgRegisterVatOwnerResponse, error) {
+	out := new(MsgRegisterVatOwnerResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/RegisterVatOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInstallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RevokeEgress(ctx context.Context, in *MsgRevokeEgress, opts ...grpc.CallOption) (*MsgRevokeEgressResponse, error) {
+	out := new(MsgRevokeEgressResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/RevokeEgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) PruneBundles(ctx context.Context, in *MsgPruneBundles, opts ...grpc.CallOption) (*MsgPruneBundlesResponse, error) {
+	out := new(MsgPruneBundlesResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/PruneBundles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgDeliverInbound) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) OraclePush(ctx context.Context, in *MsgOraclePush, opts ...grpc.CallOption) (*MsgOraclePushResponse, error) {
+	out := new(MsgOraclePushResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Msg/OraclePush", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Install a JavaScript sources bundle on the chain's SwingSet controller.
+	InstallBundle(context.Context, *MsgInstallBundle) (*MsgInstallBundleResponse, error)
+	// Upload one chunk of a compressed bundle too large for a single
+	// transaction, installing the bundle once every chunk has arrived.
+	InstallBundleChunk(context.Context, *MsgInstallBundleChunk) (*MsgInstallBundleChunkResponse, error)
+	// Send inbound messages.
+	DeliverInbound(context.Context, *MsgDeliverInbound) (*MsgDeliverInboundResponse, error)
+	// Perform a low-privilege wallet action.
+	WalletAction(context.Context, *MsgWalletAction) (*MsgWalletActionResponse, error)
+	// Perform a wallet action that spends assets.
+	WalletSpendAction(context.Context, *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error)
+	// Provision a new endpoint.
+	Provision(context.Context, *MsgProvision) (*MsgProvisionResponse, error)
+	// Register the account billed for the computrons used by a vat.
+	RegisterVatOwner(context.Context, *MsgRegisterVatOwner) (*MsgRegisterVatOwnerResponse, error)
+	// Revoke the egress of an ag-solo peer.
+	RevokeEgress(context.Context, *MsgRevokeEgress) (*MsgRevokeEgressResponse, error)
+	// Remove installed bundles that no vat was created from.
+	PruneBundles(context.Context, *MsgPruneBundles) (*MsgPruneBundlesResponse, error)
+	// Push a price update from a registered oracle operator.
+	OraclePush(context.Context, *MsgOraclePush) (*MsgOraclePushResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) InstallBundle(ctx context.Context, req *MsgInstallBundle) (*MsgInstallBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallBundle not implemented")
+}
+func (*UnimplementedMsgServer) InstallBundleChunk(ctx context.Context, req *MsgInstallBundleChunk) (*MsgInstallBundleChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallBundleChunk not implemented")
+}
+func (*UnimplementedMsgServer) DeliverInbound(ctx context.Context, req *MsgDeliverInbound) (*MsgDeliverInboundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverInbound not implemented")
+}
+func (*UnimplementedMsgServer) WalletAction(ctx context.Context, req *MsgWalletAction) (*MsgWalletActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletAction not implemented")
+}
+func (*UnimplementedMsgServer) WalletSpendAction(ctx context.Context, req *MsgWalletSpendAction) (*MsgWalletSpendActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletSpendAction not implemented")
+}
+func (*UnimplementedMsgServer) Provision(ctx context.Context, req *MsgProvision) (*MsgProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+}
+func (*UnimplementedMsgServer) RegisterVatOwner(ctx context.Context, req *MsgRegisterVatOwner) (*MsgRegisterVatOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterVatOwner not implemented")
+}
+func (*UnimplementedMsgServer) RevokeEgress(ctx context.Context, req *MsgRevokeEgress) (*MsgRevokeEgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEgress not implemented")
+}
+func (*UnimplementedMsgServer) PruneBundles(ctx context.Context, req *MsgPruneBundles) (*MsgPruneBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBundles not implemented")
+}
+func (*UnimplementedMsgServer) OraclePush(ctx context.Context, req *MsgOraclePush) (*MsgOraclePushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OraclePush not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_InstallBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstallBundle)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Nums) > 0 {
-		l = 0
-		for _, e := range m.Nums {
-			l += sovMsgs(uint64(e))
-		}
-		n += 1 + sovMsgs(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(MsgServer).InstallBundle(ctx, in)
 	}
-	if m.Ack != 0 {
-		n += 1 + sovMsgs(uint64(m.Ack))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/InstallBundle",
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstallBundle(ctx, req.(*MsgInstallBundle))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeliverInboundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_InstallBundleChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstallBundleChunk)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).InstallBundleChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/InstallBundleChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstallBundleChunk(ctx, req.(*MsgInstallBundleChunk))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletAction) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeliverInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeliverInbound)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeliverInbound(ctx, in)
 	}
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/DeliverInbound",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeliverInbound(ctx, req.(*MsgDeliverInbound))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WalletAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).WalletAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletAction(ctx, req.(*MsgWalletAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletSpendAction) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WalletSpendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWalletSpendAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WalletSpendAction(ctx, in)
 	}
-	l = len(m.SpendAction)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/WalletSpendAction",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WalletSpendAction(ctx, req.(*MsgWalletSpendAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWalletSpendActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProvision)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Provision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/Provision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Provision(ctx, req.(*MsgProvision))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProvision) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterVatOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterVatOwner)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterVatOwner(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/RegisterVatOwner",
 	}
-	if len(m.PowerFlags) > 0 {
-		for _, s := range m.PowerFlags {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterVatOwner(ctx, req.(*MsgRegisterVatOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeEgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeEgress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeEgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/RevokeEgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeEgress(ctx, req.(*MsgRevokeEgress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneBundles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/PruneBundles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneBundles(ctx, req.(*MsgPruneBundles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OraclePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOraclePush)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OraclePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Msg/OraclePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OraclePush(ctx, req.(*MsgOraclePush))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InstallBundle",
+			Handler:    _Msg_InstallBundle_Handler,
+		},
+		{
+			MethodName: "InstallBundleChunk",
+			Handler:    _Msg_InstallBundleChunk_Handler,
+		},
+		{
+			MethodName: "DeliverInbound",
+			Handler:    _Msg_DeliverInbound_Handler,
+		},
+		{
+			MethodName: "WalletAction",
+			Handler:    _Msg_WalletAction_Handler,
+		},
+		{
+			MethodName: "WalletSpendAction",
+			Handler:    _Msg_WalletSpendAction_Handler,
+		},
+		{
+			MethodName: "Provision",
+			Handler:    _Msg_Provision_Handler,
+		},
+		{
+			MethodName: "RegisterVatOwner",
+			Handler:    _Msg_RegisterVatOwner_Handler,
+		},
+		{
+			MethodName: "RevokeEgress",
+			Handler:    _Msg_RevokeEgress_Handler,
+		},
+		{
+			MethodName: "PruneBundles",
+			Handler:    _Msg_PruneBundles_Handler,
+		},
+		{
+			MethodName: "OraclePush",
+			Handler:    _Msg_OraclePush_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/swingset/msgs.proto",
+}
+
+func (m *MsgDeliverInbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverInbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Ack != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Ack))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nums) > 0 {
+		dAtA2 := make([]byte, len(m.Nums)*10)
+		var j1 int
+		for _, num := range m.Nums {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMsgs(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverInboundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverInboundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignText) > 0 {
+		for iNdEx := len(m.SignText) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignText[iNdEx])
+			copy(dAtA[i:], m.SignText[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.SignText[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletSpendAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletSpendAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletSpendAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignText) > 0 {
+		for iNdEx := len(m.SignText) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignText[iNdEx])
+			copy(dAtA[i:], m.SignText[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.SignText[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpendAction) > 0 {
+		i -= len(m.SpendAction)
+		copy(dAtA[i:], m.SpendAction)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SpendAction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWalletSpendActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWalletSpendActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWalletSpendActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CompressedBundle) > 0 {
+		i -= len(m.CompressedBundle)
+		copy(dAtA[i:], m.CompressedBundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CompressedBundle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundle) > 0 {
+		i -= len(m.Bundle)
+		copy(dAtA[i:], m.Bundle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Bundle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundleChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundleChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundleChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UncompressedSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalChunks != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstallBundleChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstallBundleChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstallBundleChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Installed {
+		i--
+		if m.Installed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterVatOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterVatOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterVatOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VatID) > 0 {
+		i -= len(m.VatID)
+		copy(dAtA[i:], m.VatID)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.VatID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterVatOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterVatOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterVatOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeEgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeEgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeEgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeEgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeEgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleHashes) > 0 {
+		for iNdEx := len(m.BundleHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BundleHashes[iNdEx])
+			copy(dAtA[i:], m.BundleHashes[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.BundleHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneBundlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneBundlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneBundlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOraclePush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOraclePush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOraclePush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoundID != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.RoundID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnitPrice) > 0 {
+		i -= len(m.UnitPrice)
+		copy(dAtA[i:], m.UnitPrice)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.UnitPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Feed) > 0 {
+		i -= len(m.Feed)
+		copy(dAtA[i:], m.Feed)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Feed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOraclePushResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOraclePushResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOraclePushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDeliverInbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.Nums) > 0 {
+		l = 0
+		for _, e := range m.Nums {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	if m.Ack != 0 {
+		n += 1 + sovMsgs(uint64(m.Ack))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeliverInboundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.SignText) > 0 {
+		for _, s := range m.SignText {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWalletActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWalletSpendAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SpendAction)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.SignText) > 0 {
+		for _, s := range m.SignText {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWalletSpendActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstallBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CompressedBundle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgInstallBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstallBundleChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.ChunkIndex))
+	}
+	if m.TotalChunks != 0 {
+		n += 1 + sovMsgs(uint64(m.TotalChunks))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+	}
+	return n
+}
+
+func (m *MsgInstallBundleChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Installed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterVatOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.VatID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterVatOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeEgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeEgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.BundleHashes) > 0 {
+		for _, s := range m.BundleHashes {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPruneBundlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOraclePush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Feed)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.UnitPrice)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.RoundID != 0 {
+		n += 1 + sovMsgs(uint64(m.RoundID))
+	}
+	return n
+}
+
+func (m *MsgOraclePushResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverInbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nums = append(m.Nums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nums) == 0 {
+					m.Nums = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nums = append(m.Nums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nums", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			m.Ack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ack |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverInboundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignText", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignText = append(m.SignText, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletSpendAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletSpendAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignText", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignText = append(m.SignText, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWalletSpendActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedBundle == nil {
+				m.CompressedBundle = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgInstallBundle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bundle)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.CompressedBundle)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.UncompressedSize != 0 {
-		n += 1 + sovMsgs(uint64(m.UncompressedSize))
+func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstallBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgInstallBundleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
+func (m *MsgInstallBundleChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,15 +3847,49 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverInbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstallBundleChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstallBundleChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1428,89 +3917,32 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMsgs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nums = append(m.Nums, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMsgs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMsgs
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMsgs
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nums) == 0 {
-					m.Nums = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMsgs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nums = append(m.Nums, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nums", wireType)
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
 			}
-			m.Ack = 0
+			m.TotalChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1520,14 +3952,14 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ack |= uint64(b&0x7F) << shift
+				m.TotalChunks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1554,11 +3986,30 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1580,7 +4031,7 @@ func (m *MsgDeliverInbound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInstallBundleChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1603,12 +4054,32 @@ func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverInboundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstallBundleChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstallBundleChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Installed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Installed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1630,7 +4101,7 @@ func (m *MsgDeliverInboundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterVatOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,17 +4124,17 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterVatOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterVatOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1673,29 +4144,27 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VatID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,7 +4192,39 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.VatID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1746,7 +4247,7 @@ func (m *MsgWalletAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterVatOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,10 +4270,10 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterVatOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterVatOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1796,7 +4297,7 @@ func (m *MsgWalletActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeEgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,17 +4320,17 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletSpendAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeEgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletSpendAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeEgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1839,29 +4340,27 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,7 +4388,7 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendAction = string(dAtA[iNdEx:postIndex])
+			m.Peer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1912,7 +4411,7 @@ func (m *MsgWalletSpendAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeEgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,10 +4434,10 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWalletSpendActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeEgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWalletSpendActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeEgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1962,7 +4461,7 @@ func (m *MsgWalletSpendActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProvision) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,47 +4484,15 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2052,14 +4519,14 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2087,41 +4554,7 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
+			m.BundleHashes = append(m.BundleHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2144,7 +4577,7 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneBundlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,10 +4600,10 @@ func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneBundlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneBundlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2194,7 +4627,7 @@ func (m *MsgProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
+func (m *MsgOraclePush) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2217,17 +4650,17 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstallBundle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOraclePush: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstallBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOraclePush: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2237,29 +4670,31 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bundle = string(dAtA[iNdEx:postIndex])
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2269,31 +4704,29 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
+			m.Feed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2303,31 +4736,29 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompressedBundle = append(m.CompressedBundle[:0], dAtA[iNdEx:postIndex]...)
-			if m.CompressedBundle == nil {
-				m.CompressedBundle = []byte{}
-			}
+			m.UnitPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundID", wireType)
 			}
-			m.UncompressedSize = 0
+			m.RoundID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2337,7 +4768,7 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UncompressedSize |= int64(b&0x7F) << shift
+				m.RoundID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2363,7 +4794,7 @@ func (m *MsgInstallBundle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOraclePushResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,10 +4817,10 @@ func (m *MsgInstallBundleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstallBundleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOraclePushResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstallBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOraclePushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,12 +2,19 @@ package types
 
 import (
 	"bytes"
+	"compress/zlib"
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 var (
@@ -262,3 +269,393 @@ func TestInstallBundle_Compress(t *testing.T) {
 		t.Errorf("wanted Uncompress error for high uncompressed size")
 	}
 }
+
+func TestInstallBundle_UncompressZlib(t *testing.T) {
+	text := "Lorem ipsum dolor sit amet"
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write([]byte(text)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	msg := &MsgInstallBundle{
+		Submitter:        addr,
+		CompressedBundle: buf.Bytes(),
+		UncompressedSize: int64(len(text)),
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = msg.Uncompress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Bundle != text {
+		t.Errorf("want bundle %q, got %q", text, msg.Bundle)
+	}
+	if len(msg.CompressedBundle) != 0 || msg.UncompressedSize != 0 {
+		t.Errorf("want compressed fields cleared, got %+v", msg)
+	}
+}
+
+func TestInstallBundleChunk_ValidateBasic(t *testing.T) {
+	hash := BundleHash([]byte("compressed"))
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgInstallBundleChunk
+		shouldErr bool
+	}{
+		{
+			name: "normal",
+			msg:  NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), 100, addr),
+		},
+		{
+			name:      "no submitter",
+			msg:       NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), 100, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "short hash",
+			msg:       NewMsgInstallBundleChunk(hash[1:], 0, 2, []byte("comp"), 100, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "uppercase hash",
+			msg:       NewMsgInstallBundleChunk(strings.ToUpper(hash), 0, 2, []byte("comp"), 100, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "no chunks",
+			msg:       NewMsgInstallBundleChunk(hash, 0, 0, []byte("comp"), 100, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "too many chunks",
+			msg:       NewMsgInstallBundleChunk(hash, 0, BundleChunksLimit+1, []byte("comp"), 100, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "index out of range",
+			msg:       NewMsgInstallBundleChunk(hash, 2, 2, []byte("comp"), 100, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "empty chunk",
+			msg:       NewMsgInstallBundleChunk(hash, 0, 2, nil, 100, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "no uncompressed size",
+			msg:       NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), 0, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "uncompressed size too large",
+			msg:       NewMsgInstallBundleChunk(hash, 0, 2, []byte("comp"), bundleUncompressedSizeLimit, addr),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+// storageChargeRecorder is a chargeRecorder charging only for storage.
+type storageChargeRecorder struct {
+	chargeRecorder
+}
+
+func (cr *storageChargeRecorder) GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint {
+	return map[string]sdkmath.Uint{
+		BeansPerInboundTx:   sdkmath.ZeroUint(),
+		BeansPerMessage:     sdkmath.ZeroUint(),
+		BeansPerMessageByte: sdkmath.ZeroUint(),
+		BeansPerStorageByte: sdk.NewUint(1),
+	}
+}
+
+func TestInstallBundleChunk_CheckAdmissibility(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	hash := BundleHash([]byte("compressed"))
+
+	keeper := &storageChargeRecorder{}
+	for _, msg := range []*MsgInstallBundleChunk{
+		NewMsgInstallBundleChunk(hash, 1, 3, []byte("comp"), 100, addr),
+		NewMsgInstallBundleChunk(hash, 1, 3, []byte("comp"), 100, addr),
+		NewMsgInstallBundleChunk(hash, 2, 3, []byte("re"), 100, addr),
+		NewMsgInstallBundleChunk(hash, 0, 3, []byte("ssed"), 100, addr),
+	} {
+		if err := msg.CheckAdmissibility(ctx, keeper); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+
+	// Each chunk pays a third of the uncompressed size, rounded up, and its own
+	// length, even when resubmitted.
+	want := "[owner 38 owner 38 owner 36 owner 38]"
+	if got := fmt.Sprint(keeper.charges); got != want {
+		t.Errorf("got charges %s, want %s", got, want)
+	}
+}
+
+func TestRegisterVatOwner(t *testing.T) {
+	authority := addr.String()
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgRegisterVatOwner
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgRegisterVatOwner{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg:  NewMsgRegisterVatOwner(authority, "v42", addr.String()),
+		},
+		{
+			name: "unregister",
+			msg:  NewMsgRegisterVatOwner(authority, "v42", ""),
+		},
+		{
+			name:      "bad vat ID",
+			msg:       NewMsgRegisterVatOwner(authority, "vat42", addr.String()),
+			shouldErr: true,
+		},
+		{
+			name:      "bad owner",
+			msg:       NewMsgRegisterVatOwner(authority, "v42", "agoric1bogus"),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestRevokeEgress(t *testing.T) {
+	authority := addr.String()
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgRevokeEgress
+		shouldErr bool
+	}{
+		{
+			name:      "empty",
+			msg:       &MsgRevokeEgress{},
+			shouldErr: true,
+		},
+		{
+			name: "normal",
+			msg:  NewMsgRevokeEgress(authority, addr.String()),
+		},
+		{
+			name:      "bad peer",
+			msg:       NewMsgRevokeEgress(authority, "agoric1bogus"),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestPruneBundles_ValidateBasic(t *testing.T) {
+	hash := BundleHash([]byte("bundle"))
+	tooMany := make([]string, BundlePruneLimit+1)
+	for i := range tooMany {
+		tooMany[i] = BundleHash([]byte(strconv.Itoa(i)))
+	}
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgPruneBundles
+		shouldErr bool
+	}{
+		{
+			name: "normal",
+			msg:  NewMsgPruneBundles([]string{hash}, addr),
+		},
+		{
+			name:      "no submitter",
+			msg:       NewMsgPruneBundles([]string{hash}, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "no bundles",
+			msg:       NewMsgPruneBundles(nil, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "too many bundles",
+			msg:       NewMsgPruneBundles(tooMany, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "bundle ID",
+			msg:       NewMsgPruneBundles([]string{BundleIDPrefix + hash}, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "duplicate bundle",
+			msg:       NewMsgPruneBundles([]string{hash, hash}, addr),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+func TestOraclePush_ValidateBasic(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		msg       *MsgOraclePush
+		shouldErr bool
+	}{
+		{
+			name: "normal",
+			msg:  NewMsgOraclePush("ATOM-USD", "12010000", 0, addr),
+		},
+		{
+			name: "round",
+			msg:  NewMsgOraclePush("ATOM-USD", "12010000", 42, addr),
+		},
+		{
+			name:      "no submitter",
+			msg:       NewMsgOraclePush("ATOM-USD", "12010000", 0, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "no feed",
+			msg:       NewMsgOraclePush("", "12010000", 0, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "long feed",
+			msg:       NewMsgOraclePush(strings.Repeat("X", OracleFeedLengthLimit+1), "12010000", 0, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "zero price",
+			msg:       NewMsgOraclePush("ATOM-USD", "0", 0, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "decimal price",
+			msg:       NewMsgOraclePush("ATOM-USD", "12.01", 0, addr),
+			shouldErr: true,
+		},
+		{
+			name:      "leading zero",
+			msg:       NewMsgOraclePush("ATOM-USD", "012010000", 0, addr),
+			shouldErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if err != nil && !tt.shouldErr {
+				t.Fatalf("unexpected validation error %s", err)
+			}
+			if err == nil && tt.shouldErr {
+				t.Fatalf("wanted validation error")
+			}
+		})
+	}
+}
+
+// chargeRecorder is a SwingSetKeeper recording the admission charges of a
+// provisioned smart wallet.
+type chargeRecorder struct {
+	SwingSetKeeper
+	charges  []string
+	grantees []sdk.AccAddress
+}
+
+func (cr *chargeRecorder) GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint {
+	return map[string]sdkmath.Uint{
+		BeansPerInboundTx:   sdk.NewUint(10),
+		BeansPerMessage:     sdkmath.ZeroUint(),
+		BeansPerMessageByte: sdkmath.ZeroUint(),
+		BeansPerStorageByte: sdkmath.ZeroUint(),
+	}
+}
+
+func (cr *chargeRecorder) GetSmartWalletState(ctx sdk.Context, addr sdk.AccAddress) SmartWalletState {
+	return SmartWalletStateProvisioned
+}
+
+func (cr *chargeRecorder) ChargeBeans(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, addr sdk.AccAddress, beans sdkmath.Uint) error {
+	cr.charges = append(cr.charges, "owner "+beans.String())
+	return nil
+}
+
+func (cr *chargeRecorder) ChargeGrantedBeans(ctx sdk.Context, beansPerUnit map[string]sdkmath.Uint, granter, grantee sdk.AccAddress, beans sdkmath.Uint, msgs []sdk.Msg) error {
+	cr.charges = append(cr.charges, "granter "+beans.String())
+	cr.grantees = append(cr.grantees, grantee)
+	return nil
+}
+
+func TestWalletActionFeeGrant(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	payer := sdk.AccAddress([]byte("payer"))
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	msg := NewMsgWalletAction(addr, "{}")
+	spendMsg := NewMsgWalletSpendAction(addr, "{}")
+
+	keeper := &chargeRecorder{}
+	for _, cctx := range []sdk.Context{
+		ctx,
+		WithFeeGrant(ctx, granter, payer, []sdk.Msg{msg}),
+		WithFeeGrant(ctx, addr, payer, []sdk.Msg{msg}),
+	} {
+		if err := msg.CheckAdmissibility(cctx, keeper); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		if err := spendMsg.CheckAdmissibility(cctx, keeper); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+
+	want := "[owner 10 owner 10 granter 10 granter 10 owner 10 owner 10]"
+	if got := fmt.Sprint(keeper.charges); got != want {
+		t.Errorf("got charges %s, want %s", got, want)
+	}
+	// The allowance used is that of the fee payer, not of the owner.
+	for _, grantee := range keeper.grantees {
+		if !grantee.Equals(payer) {
+			t.Errorf("got grantee %s, want fee payer %s", grantee, payer)
+		}
+	}
+}
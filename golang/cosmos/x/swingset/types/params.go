@@ -2,12 +2,16 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 )
 
 // Parameter keys
@@ -18,6 +22,24 @@ var (
 	ParamStoreKeyPowerFlagFees      = []byte("power_flag_fees")
 	ParamStoreKeyQueueMax           = []byte("queue_max")
 	ParamStoreKeyVatCleanupBudget   = []byte("vat_cleanup_budget")
+
+	ParamStoreKeyWalletSpendActionRateLimit  = []byte("wallet_spend_action_rate_limit")
+	ParamStoreKeyBridgeMessageHashChain      = []byte("bridge_message_hash_chain")
+	ParamStoreKeyPrioritySenders             = []byte("priority_senders")
+	ParamStoreKeyBlockTimeQuantumSeconds     = []byte("block_time_quantum_seconds")
+	ParamStoreKeyComputronPriceUist          = []byte("computron_price_uist")
+	ParamStoreKeyMinRunPolicyHeadroom        = []byte("min_run_policy_headroom")
+	ParamStoreKeyInboundDedupWindowBlocks    = []byte("inbound_dedup_window_blocks")
+	ParamStoreKeyBundleStorageFeePerByte     = []byte("bundle_storage_fee_per_byte")
+	ParamStoreKeyBundleStorageRefundFraction = []byte("bundle_storage_refund_fraction")
+	ParamStoreKeyBundlePruneMinAgeBlocks     = []byte("bundle_prune_min_age_blocks")
+	ParamStoreKeyBlockEntropyDisabled        = []byte("block_entropy_disabled")
+	ParamStoreKeyOracleOperators             = []byte("oracle_operators")
+	ParamStoreKeyOraclePushFee               = []byte("oracle_push_fee")
+	ParamStoreKeyOraclePushesPerBlock        = []byte("oracle_pushes_per_block")
+	ParamStoreKeyInboundLanes                = []byte("inbound_lanes")
+	ParamStoreKeyInboundLaneBatchSize        = []byte("inbound_lane_batch_size")
+	ParamStoreKeyAutoProvisionMinDeposit     = []byte("auto_provision_min_deposit")
 )
 
 func NewStringBeans(key string, beans sdkmath.Uint) StringBeans {
@@ -55,6 +77,24 @@ func DefaultParams() Params {
 		PowerFlagFees:      DefaultPowerFlagFees,
 		QueueMax:           DefaultQueueMax,
 		VatCleanupBudget:   DefaultVatCleanupBudget,
+
+		WalletSpendActionRateLimit:  DefaultWalletSpendActionRateLimit,
+		BridgeMessageHashChain:      DefaultBridgeMessageHashChain,
+		PrioritySenders:             DefaultPrioritySenders,
+		BlockTimeQuantumSeconds:     DefaultBlockTimeQuantumSeconds,
+		ComputronPriceUist:          DefaultComputronPriceUist,
+		MinRunPolicyHeadroom:        DefaultMinRunPolicyHeadroom,
+		InboundDedupWindowBlocks:    DefaultInboundDedupWindowBlocks,
+		BundleStorageFeePerByte:     DefaultBundleStorageFeePerByte,
+		BundleStorageRefundFraction: DefaultBundleStorageRefundFraction,
+		BundlePruneMinAgeBlocks:     DefaultBundlePruneMinAgeBlocks,
+		BlockEntropyDisabled:        DefaultBlockEntropyDisabled,
+		OracleOperators:             DefaultOracleOperators,
+		OraclePushFee:               DefaultOraclePushFee,
+		OraclePushesPerBlock:        DefaultOraclePushesPerBlock,
+		InboundLanes:                DefaultInboundLanes,
+		InboundLaneBatchSize:        DefaultInboundLaneBatchSize,
+		AutoProvisionMinDeposit:     DefaultAutoProvisionMinDeposit,
 	}
 }
 
@@ -72,6 +112,23 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyPowerFlagFees, &p.PowerFlagFees, validatePowerFlagFees),
 		paramtypes.NewParamSetPair(ParamStoreKeyQueueMax, &p.QueueMax, validateQueueMax),
 		paramtypes.NewParamSetPair(ParamStoreKeyVatCleanupBudget, &p.VatCleanupBudget, validateVatCleanupBudget),
+		paramtypes.NewParamSetPair(ParamStoreKeyWalletSpendActionRateLimit, &p.WalletSpendActionRateLimit, validateRateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyBridgeMessageHashChain, &p.BridgeMessageHashChain, validateBridgeMessageHashChain),
+		paramtypes.NewParamSetPair(ParamStoreKeyPrioritySenders, &p.PrioritySenders, validatePrioritySenders),
+		paramtypes.NewParamSetPair(ParamStoreKeyBlockTimeQuantumSeconds, &p.BlockTimeQuantumSeconds, validateBlockTimeQuantumSeconds),
+		paramtypes.NewParamSetPair(ParamStoreKeyComputronPriceUist, &p.ComputronPriceUist, validateComputronPriceUist),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinRunPolicyHeadroom, &p.MinRunPolicyHeadroom, validateMinRunPolicyHeadroom),
+		paramtypes.NewParamSetPair(ParamStoreKeyInboundDedupWindowBlocks, &p.InboundDedupWindowBlocks, validateInboundDedupWindowBlocks),
+		paramtypes.NewParamSetPair(ParamStoreKeyBundleStorageFeePerByte, &p.BundleStorageFeePerByte, validateBundleStorageFeePerByte),
+		paramtypes.NewParamSetPair(ParamStoreKeyBundleStorageRefundFraction, &p.BundleStorageRefundFraction, validateBundleStorageRefundFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyBundlePruneMinAgeBlocks, &p.BundlePruneMinAgeBlocks, validateBundlePruneMinAgeBlocks),
+		paramtypes.NewParamSetPair(ParamStoreKeyBlockEntropyDisabled, &p.BlockEntropyDisabled, validateBlockEntropyDisabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyOracleOperators, &p.OracleOperators, validateOracleOperators),
+		paramtypes.NewParamSetPair(ParamStoreKeyOraclePushFee, &p.OraclePushFee, validateOraclePushFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyOraclePushesPerBlock, &p.OraclePushesPerBlock, validateOraclePushesPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeyInboundLanes, &p.InboundLanes, validateInboundLanes),
+		paramtypes.NewParamSetPair(ParamStoreKeyInboundLaneBatchSize, &p.InboundLaneBatchSize, validateInboundLaneBatchSize),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoProvisionMinDeposit, &p.AutoProvisionMinDeposit, validateAutoProvisionMinDeposit),
 	}
 }
 
@@ -95,6 +152,57 @@ func (p Params) ValidateBasic() error {
 	if err := validateVatCleanupBudget(p.VatCleanupBudget); err != nil {
 		return err
 	}
+	if err := validateRateLimit(p.WalletSpendActionRateLimit); err != nil {
+		return err
+	}
+	if err := validateBridgeMessageHashChain(p.BridgeMessageHashChain); err != nil {
+		return err
+	}
+	if err := validatePrioritySenders(p.PrioritySenders); err != nil {
+		return err
+	}
+	if err := validateBlockTimeQuantumSeconds(p.BlockTimeQuantumSeconds); err != nil {
+		return err
+	}
+	if err := validateComputronPriceUist(p.ComputronPriceUist); err != nil {
+		return err
+	}
+	if err := validateMinRunPolicyHeadroom(p.MinRunPolicyHeadroom); err != nil {
+		return err
+	}
+	if err := validateInboundDedupWindowBlocks(p.InboundDedupWindowBlocks); err != nil {
+		return err
+	}
+	if err := validateBundleStorageFeePerByte(p.BundleStorageFeePerByte); err != nil {
+		return err
+	}
+	if err := validateBundleStorageRefundFraction(p.BundleStorageRefundFraction); err != nil {
+		return err
+	}
+	if err := validateBundlePruneMinAgeBlocks(p.BundlePruneMinAgeBlocks); err != nil {
+		return err
+	}
+	if err := validateBlockEntropyDisabled(p.BlockEntropyDisabled); err != nil {
+		return err
+	}
+	if err := validateOracleOperators(p.OracleOperators); err != nil {
+		return err
+	}
+	if err := validateOraclePushFee(p.OraclePushFee); err != nil {
+		return err
+	}
+	if err := validateOraclePushesPerBlock(p.OraclePushesPerBlock); err != nil {
+		return err
+	}
+	if err := validateInboundLanes(p.InboundLanes); err != nil {
+		return err
+	}
+	if err := validateInboundLaneBatchSize(p.InboundLaneBatchSize); err != nil {
+		return err
+	}
+	if err := validateAutoProvisionMinDeposit(p.AutoProvisionMinDeposit); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -189,6 +297,310 @@ func validateVatCleanupBudget(i interface{}) error {
 	return nil
 }
 
+// GetRateLimit returns the token bucket capacity and refill interval described
+// by rate limit entries, and reports whether the rate limit is enabled.
+func GetRateLimit(entries []UintMapEntry) (capacity, blocksPerToken uint64, enabled bool) {
+	if len(entries) == 0 {
+		return 0, 0, false
+	}
+	for _, entry := range entries {
+		switch entry.Key {
+		case RateLimitCapacity:
+			capacity = entry.Value.Uint64()
+		case RateLimitBlocksPerToken:
+			blocksPerToken = entry.Value.Uint64()
+		}
+	}
+	return capacity, blocksPerToken, true
+}
+
+func validateRateLimit(i interface{}) error {
+	entries, ok := i.([]UintMapEntry)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	found := map[string]bool{}
+	for _, entry := range entries {
+		switch entry.Key {
+		case RateLimitCapacity, RateLimitBlocksPerToken:
+		default:
+			return fmt.Errorf("unknown rate limit key %q", entry.Key)
+		}
+		if entry.Value.IsNil() || entry.Value.IsZero() {
+			return fmt.Errorf("rate limit %s must be positive", entry.Key)
+		}
+		if !entry.Value.LTE(sdk.NewUint(math.MaxInt64)) {
+			return fmt.Errorf("rate limit %s is too large: %s", entry.Key, entry.Value)
+		}
+		found[entry.Key] = true
+	}
+	if !found[RateLimitCapacity] || !found[RateLimitBlocksPerToken] {
+		return fmt.Errorf("`%s` and `%s` must be present in a non-empty rate limit", RateLimitCapacity, RateLimitBlocksPerToken)
+	}
+	return nil
+}
+
+func validateBridgeMessageHashChain(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePrioritySenders(i interface{}) error {
+	senders, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(senders))
+	for _, sender := range senders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return fmt.Errorf("priority sender %q must be a valid address: %w", sender, err)
+		}
+		if seen[sender] {
+			return fmt.Errorf("duplicate priority sender %q", sender)
+		}
+		seen[sender] = true
+	}
+	return nil
+}
+
+// MaxBlockTimeQuantumSeconds bounds BlockTimeQuantumSeconds, since SwingSet
+// timers cannot fire until the delivered block time reaches their deadline.
+const MaxBlockTimeQuantumSeconds uint64 = 60 * 60
+
+func validateBlockTimeQuantumSeconds(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxBlockTimeQuantumSeconds {
+		return fmt.Errorf("block time quantum seconds %d exceeds the maximum of %d", v, MaxBlockTimeQuantumSeconds)
+	}
+	return nil
+}
+
+func validateComputronPriceUist(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		// Treated as zero.
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("computron price %s must not be negative", v)
+	}
+	return nil
+}
+
+func validateMinRunPolicyHeadroom(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateInboundDedupWindowBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > math.MaxInt64 {
+		return fmt.Errorf("inbound dedup window is too large: %d", v)
+	}
+	return nil
+}
+
+func validateBundleStorageFeePerByte(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("bundle storage fee per byte must be valid: %w", err)
+	}
+	return nil
+}
+
+func validateBundleStorageRefundFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		// Treated as zero.
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("bundle storage refund fraction must be nonnegative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("bundle storage refund fraction must be less than or equal to one: %s", v)
+	}
+	return nil
+}
+
+func validateBundlePruneMinAgeBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > math.MaxInt64 {
+		return fmt.Errorf("bundle prune min age is too large: %d", v)
+	}
+	return nil
+}
+
+func validateBlockEntropyDisabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOracleOperators(i interface{}) error {
+	operators, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(operators))
+	for _, operator := range operators {
+		if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+			return fmt.Errorf("oracle operator %q must be a valid address: %w", operator, err)
+		}
+		if seen[operator] {
+			return fmt.Errorf("duplicate oracle operator %q", operator)
+		}
+		seen[operator] = true
+	}
+	return nil
+}
+
+func validateOraclePushFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("oracle push fee must be valid: %w", err)
+	}
+	return nil
+}
+
+func validateOraclePushesPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// InboundLaneNames are the names of the inbound lanes, in the order in which
+// x/swingset forwards the actions left in lanes no longer configured.
+var InboundLaneNames = []string{InboundLaneGovernance, InboundLaneOracle, InboundLaneWallet, InboundLaneIBC}
+
+func validateInboundLanes(i interface{}) error {
+	lanes, ok := i.([]InboundLane)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		known := false
+		for _, name := range InboundLaneNames {
+			known = known || lane.Name == name
+		}
+		if !known {
+			return fmt.Errorf("unknown inbound lane %q", lane.Name)
+		}
+		if seen[lane.Name] {
+			return fmt.Errorf("duplicate inbound lane %q", lane.Name)
+		}
+		seen[lane.Name] = true
+		if lane.Weight == 0 {
+			return fmt.Errorf("inbound lane %s weight must be positive", lane.Name)
+		}
+		if lane.MaxSize < 0 {
+			return fmt.Errorf("inbound lane %s max size must not be negative: %d", lane.Name, lane.MaxSize)
+		}
+	}
+	return nil
+}
+
+func validateInboundLaneBatchSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > math.MaxInt64 {
+		return fmt.Errorf("inbound lane batch size is too large: %d", v)
+	}
+	return nil
+}
+
+func validateAutoProvisionMinDeposit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("auto provision min deposit must be valid: %w", err)
+	}
+	return nil
+}
+
+// GetInboundLane returns the configuration of the named inbound lane, if any.
+func (p Params) GetInboundLane(name string) (InboundLane, bool) {
+	for _, lane := range p.InboundLanes {
+		if lane.Name == name {
+			return lane, true
+		}
+	}
+	return InboundLane{}, false
+}
+
+// GetComputronPriceUist returns the price per computron in uist at which vat
+// owners are billed, treating an unset price as zero.
+func (p Params) GetComputronPriceUist() sdk.Dec {
+	if p.ComputronPriceUist.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.ComputronPriceUist
+}
+
+// GetBundleStorageRefundFraction returns the refundable fraction of bundle
+// storage fees, treating an unset fraction as zero.
+func (p Params) GetBundleStorageRefundFraction() sdk.Dec {
+	if p.BundleStorageRefundFraction.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.BundleStorageRefundFraction
+}
+
+// BundleStorageFee returns the storage fee of a bundle of size uncompressed
+// bytes, split into the part refundable as a deposit and the rest.  Fractions
+// of a coin are truncated.
+func (p Params) BundleStorageFee(size uint64) (deposit, nonrefundable sdk.Coins) {
+	if p.BundleStorageFeePerByte.IsZero() {
+		return sdk.Coins{}, sdk.Coins{}
+	}
+	fee, _ := p.BundleStorageFeePerByte.MulDec(sdk.NewDecFromInt(sdk.NewIntFromUint64(size))).TruncateDecimal()
+	deposit, _ = sdk.NewDecCoinsFromCoins(fee...).MulDecTruncate(p.GetBundleStorageRefundFraction()).TruncateDecimal()
+	return deposit, fee.Sub(deposit...)
+}
+
+// QuantizeBlockTime returns the Unix time of blockTime, rounded down to a
+// multiple of BlockTimeQuantumSeconds.
+func (p Params) QuantizeBlockTime(blockTime time.Time) int64 {
+	return vm.QuantizeUnixTime(blockTime.Unix(), p.BlockTimeQuantumSeconds)
+}
+
 // UpdateParams appends any missing params, configuring them to their defaults,
 // then returning the updated params or an error. Existing params are not
 // modified, regardless of their value, and they are not removed if they no
@@ -210,11 +622,16 @@ func UpdateParams(params Params) (Params, error) {
 	if err != nil {
 		return params, err
 	}
+	newRl, err := appendMissingDefaults(params.WalletSpendActionRateLimit, DefaultWalletSpendActionRateLimit)
+	if err != nil {
+		return params, err
+	}
 
 	params.BeansPerUnit = newBpu
 	params.PowerFlagFees = newPff
 	params.QueueMax = newQm
 	params.VatCleanupBudget = newVcb
+	params.WalletSpendActionRateLimit = newRl
 	return params, nil
 }
 
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -180,3 +182,196 @@ func TestValidateParams(t *testing.T) {
 		t.Errorf("unexpected ValidateBasic() error with empty VatCleanupBudget: %v", params.VatCleanupBudget)
 	}
 }
+
+func TestValidateRateLimit(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		entries []UintMapEntry
+		wantErr bool
+	}{
+		{name: "empty", entries: []UintMapEntry{}},
+		{name: "nil"},
+		{
+			name: "complete",
+			entries: []UintMapEntry{
+				{RateLimitCapacity, sdk.NewUint(10)},
+				{RateLimitBlocksPerToken, sdk.NewUint(2)},
+			},
+		},
+		{
+			name:    "missing-blocks-per-token",
+			entries: []UintMapEntry{{RateLimitCapacity, sdk.NewUint(10)}},
+			wantErr: true,
+		},
+		{
+			name: "zero-capacity",
+			entries: []UintMapEntry{
+				{RateLimitCapacity, sdk.NewUint(0)},
+				{RateLimitBlocksPerToken, sdk.NewUint(2)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown-key",
+			entries: []UintMapEntry{
+				{RateLimitCapacity, sdk.NewUint(10)},
+				{RateLimitBlocksPerToken, sdk.NewUint(2)},
+				{"grault", sdk.NewUint(1)},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRateLimit(tt.entries)
+			if tt.wantErr && err == nil {
+				t.Errorf("validateRateLimit(%v) failed to reject", tt.entries)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validateRateLimit(%v) error: %v", tt.entries, err)
+			}
+		})
+	}
+
+	capacity, blocksPerToken, enabled := GetRateLimit([]UintMapEntry{
+		{RateLimitBlocksPerToken, sdk.NewUint(2)},
+		{RateLimitCapacity, sdk.NewUint(10)},
+	})
+	if capacity != 10 || blocksPerToken != 2 || !enabled {
+		t.Errorf("got GetRateLimit %d, %d, %t, want 10, 2, true", capacity, blocksPerToken, enabled)
+	}
+	if _, _, enabled := GetRateLimit(nil); enabled {
+		t.Errorf("got GetRateLimit(nil) enabled, want disabled")
+	}
+}
+
+func TestValidatePrioritySenders(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	for _, tt := range []struct {
+		name    string
+		senders []string
+		wantErr bool
+	}{
+		{name: "empty", senders: []string{}},
+		{name: "nil"},
+		{name: "distinct", senders: []string{alice, bob}},
+		{name: "duplicate", senders: []string{alice, bob, alice}, wantErr: true},
+		{name: "invalid", senders: []string{alice, "grault"}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePrioritySenders(tt.senders)
+			if tt.wantErr && err == nil {
+				t.Errorf("validatePrioritySenders(%v) failed to reject", tt.senders)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validatePrioritySenders(%v) error: %v", tt.senders, err)
+			}
+		})
+	}
+}
+
+func TestValidateInboundLanes(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		lanes   []InboundLane
+		wantErr bool
+	}{
+		{name: "empty", lanes: []InboundLane{}},
+		{name: "nil"},
+		{name: "distinct", lanes: []InboundLane{{Name: "oracle", Weight: 3}, {Name: "wallet", Weight: 1, MaxSize: 100}}},
+		{name: "duplicate", lanes: []InboundLane{{Name: "ibc", Weight: 1}, {Name: "ibc", Weight: 2}}, wantErr: true},
+		{name: "unknown", lanes: []InboundLane{{Name: "grault", Weight: 1}}, wantErr: true},
+		{name: "weightless", lanes: []InboundLane{{Name: "governance"}}, wantErr: true},
+		{name: "negative size", lanes: []InboundLane{{Name: "wallet", Weight: 1, MaxSize: -1}}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateInboundLanes(tt.lanes)
+			if tt.wantErr && err == nil {
+				t.Errorf("validateInboundLanes(%v) failed to reject", tt.lanes)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validateInboundLanes(%v) error: %v", tt.lanes, err)
+			}
+		})
+	}
+}
+
+func TestQuantizeBlockTime(t *testing.T) {
+	blockTime := time.Unix(1_700_000_123, 999_000_000)
+	for _, tt := range []struct {
+		quantum uint64
+		want    int64
+	}{
+		{quantum: 0, want: 1_700_000_123},
+		{quantum: 1, want: 1_700_000_123},
+		{quantum: 60, want: 1_700_000_100},
+		{quantum: 3600, want: 1_699_999_200},
+	} {
+		params := Params{BlockTimeQuantumSeconds: tt.quantum}
+		if got := params.QuantizeBlockTime(blockTime); got != tt.want {
+			t.Errorf("QuantizeBlockTime with quantum %d got %d, want %d", tt.quantum, got, tt.want)
+		}
+	}
+
+	// Times before the epoch still round down.
+	params := Params{BlockTimeQuantumSeconds: 60}
+	if got := params.QuantizeBlockTime(time.Unix(-30, 0)); got != -60 {
+		t.Errorf("QuantizeBlockTime(-30) got %d, want -60", got)
+	}
+}
+
+func TestValidateBlockTimeQuantumSeconds(t *testing.T) {
+	for _, tt := range []struct {
+		quantum uint64
+		wantErr bool
+	}{
+		{quantum: 0},
+		{quantum: 60},
+		{quantum: MaxBlockTimeQuantumSeconds},
+		{quantum: MaxBlockTimeQuantumSeconds + 1, wantErr: true},
+		{quantum: math.MaxUint64, wantErr: true},
+	} {
+		err := validateBlockTimeQuantumSeconds(tt.quantum)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateBlockTimeQuantumSeconds(%d) got error %v, want error %t", tt.quantum, err, tt.wantErr)
+		}
+	}
+}
+
+func TestBundleStorageFee(t *testing.T) {
+	params := Params{}
+	if deposit, rest := params.BundleStorageFee(1000); !deposit.IsZero() || !rest.IsZero() {
+		t.Errorf("got fee %s + %s without a price, want none", deposit, rest)
+	}
+
+	params.BundleStorageFeePerByte = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uist", sdk.MustNewDecFromStr("0.15")))
+	if deposit, rest := params.BundleStorageFee(1001); !deposit.IsZero() || !rest.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("uist", 150))) {
+		t.Errorf("got fee %s + %s without a refund fraction, want 150uist nonrefundable", deposit, rest)
+	}
+
+	params.BundleStorageRefundFraction = sdk.MustNewDecFromStr("0.75")
+	deposit, rest := params.BundleStorageFee(1001)
+	if !deposit.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("uist", 112))) || !rest.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("uist", 38))) {
+		t.Errorf("got fee %s + %s, want 112uist deposit + 38uist", deposit, rest)
+	}
+
+	for _, fraction := range []string{"-0.1", "1.01"} {
+		if err := validateBundleStorageRefundFraction(sdk.MustNewDecFromStr(fraction)); err == nil {
+			t.Errorf("validateBundleStorageRefundFraction(%s) failed to reject", fraction)
+		}
+	}
+	if err := validateBundleStorageRefundFraction(sdk.Dec{}); err != nil {
+		t.Errorf("unexpected validateBundleStorageRefundFraction error for unset fraction: %v", err)
+	}
+}
+
+func TestComputronPriceUist(t *testing.T) {
+	if price := (Params{}).GetComputronPriceUist(); !price.IsZero() {
+		t.Errorf("got price %s for an unset price, want zero", price)
+	}
+	if err := validateComputronPriceUist(sdk.MustNewDecFromStr("-0.01")); err == nil {
+		t.Errorf("validateComputronPriceUist(-0.01) failed to reject")
+	}
+	for _, price := range []sdk.Dec{{}, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.01")} {
+		if err := validateComputronPriceUist(price); err != nil {
+			t.Errorf("unexpected validateComputronPriceUist error for %s: %v", price, err)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -71,6 +73,12 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The fee charged to a smart wallet owner for provisioning the wallet
+	// on demand (such as by their first MsgWalletSpendAction), as determined by
+	// the `smartWalletProvision` entry of beans_per_unit and by fee_unit_price.
+	// Explicit provisioning by MsgProvision is instead charged according to
+	// power_flag_fees.
+	SmartWalletProvisionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=smart_wallet_provision_fee,json=smartWalletProvisionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"smart_wallet_provision_fee"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -113,6 +121,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetSmartWalletProvisionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SmartWalletProvisionFee
+	}
+	return nil
+}
+
 // QueryEgressRequest is the request type for the Query/Egress RPC method
 type QueryEgressRequest struct {
 	Peer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
@@ -203,6 +218,104 @@ func (m *QueryEgressResponse) GetEgress() *Egress {
 	return nil
 }
 
+// QueryEgressesRequest is the request type for the Query/Egresses RPC method.
+type QueryEgressesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEgressesRequest) Reset()         { *m = QueryEgressesRequest{} }
+func (m *QueryEgressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEgressesRequest) ProtoMessage()    {}
+func (*QueryEgressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{4}
+}
+func (m *QueryEgressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEgressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEgressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEgressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEgressesRequest.Merge(m, src)
+}
+func (m *QueryEgressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEgressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEgressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEgressesRequest proto.InternalMessageInfo
+
+func (m *QueryEgressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEgressesResponse is the response type for the Query/Egresses RPC method.
+type QueryEgressesResponse struct {
+	Egresses   []Egress            `protobuf:"bytes,1,rep,name=egresses,proto3" json:"egresses" yaml:"egresses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEgressesResponse) Reset()         { *m = QueryEgressesResponse{} }
+func (m *QueryEgressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEgressesResponse) ProtoMessage()    {}
+func (*QueryEgressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{5}
+}
+func (m *QueryEgressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEgressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEgressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEgressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEgressesResponse.Merge(m, src)
+}
+func (m *QueryEgressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEgressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEgressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEgressesResponse proto.InternalMessageInfo
+
+func (m *QueryEgressesResponse) GetEgresses() []Egress {
+	if m != nil {
+		return m.Egresses
+	}
+	return nil
+}
+
+func (m *QueryEgressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryMailboxRequest is the mailbox query.
 type QueryMailboxRequest struct {
 	Peer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
@@ -212,7 +325,7 @@ func (m *QueryMailboxRequest) Reset()         { *m = QueryMailboxRequest{} }
 func (m *QueryMailboxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMailboxRequest) ProtoMessage()    {}
 func (*QueryMailboxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76266f656a1a9971, []int{4}
+	return fileDescriptor_76266f656a1a9971, []int{6}
 }
 func (m *QueryMailboxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,14 +363,19 @@ func (m *QueryMailboxRequest) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAd
 
 // QueryMailboxResponse is the mailbox response.
 type QueryMailboxResponse struct {
+	// The mailbox as stored, in JSON.
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"value"`
+	// The messages in the outbox, in order of message number.
+	Messages []MailboxMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages" yaml:"messages"`
+	// The number of the latest message received from the peer.
+	Ack uint64 `protobuf:"varint,3,opt,name=ack,proto3" json:"ack" yaml:"ack"`
 }
 
 func (m *QueryMailboxResponse) Reset()         { *m = QueryMailboxResponse{} }
 func (m *QueryMailboxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMailboxResponse) ProtoMessage()    {}
 func (*QueryMailboxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76266f656a1a9971, []int{5}
+	return fileDescriptor_76266f656a1a9971, []int{7}
 }
 func (m *QueryMailboxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,481 +411,6474 @@ func (m *QueryMailboxResponse) GetValue() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.swingset.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.swingset.QueryParamsResponse")
-	proto.RegisterType((*QueryEgressRequest)(nil), "agoric.swingset.QueryEgressRequest")
-	proto.RegisterType((*QueryEgressResponse)(nil), "agoric.swingset.QueryEgressResponse")
-	proto.RegisterType((*QueryMailboxRequest)(nil), "agoric.swingset.QueryMailboxRequest")
-	proto.RegisterType((*QueryMailboxResponse)(nil), "agoric.swingset.QueryMailboxResponse")
+func (m *QueryMailboxResponse) GetMessages() []MailboxMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("agoric/swingset/query.proto", fileDescriptor_76266f656a1a9971) }
-
-var fileDescriptor_76266f656a1a9971 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0xcb, 0x6e, 0xc5, 0xec, 0x82, 0x10, 0x07, 0xd6, 0x1d, 0x25, 0x59, 0xe3, 0xcf,
-	0xcb, 0x36, 0xb0, 0xe2, 0x45, 0x4f, 0x33, 0xe0, 0x8f, 0x83, 0x82, 0x0e, 0x78, 0x11, 0x2f, 0x99,
-	0x4e, 0x88, 0xc5, 0xb6, 0xe9, 0x36, 0xa9, 0xee, 0xb0, 0x88, 0xe0, 0x5f, 0x20, 0xf8, 0x4f, 0xed,
-	0x71, 0xc1, 0x8b, 0xa7, 0x22, 0x33, 0x9e, 0xf6, 0x38, 0x47, 0xbd, 0x48, 0x93, 0x54, 0xed, 0x94,
-	0xea, 0x6d, 0x4f, 0xd3, 0xbc, 0xf7, 0xf2, 0xfd, 0xbc, 0x97, 0xf7, 0x1d, 0x70, 0x99, 0x09, 0x99,
-	0x47, 0x21, 0x55, 0xef, 0xa2, 0x54, 0x28, 0xae, 0xe9, 0x41, 0xc1, 0xf3, 0x59, 0x90, 0xe5, 0x52,
-	0x4b, 0x78, 0xc1, 0x26, 0x83, 0x3a, 0x39, 0xe8, 0x0b, 0x29, 0xa4, 0xc9, 0xd1, 0xea, 0xcb, 0x96,
-	0x0d, 0xd0, 0xaa, 0x46, 0xfd, 0xe1, 0xf2, 0x57, 0x84, 0x94, 0x22, 0xe6, 0x94, 0x65, 0x11, 0x65,
-	0x69, 0x2a, 0x35, 0xd3, 0x91, 0x4c, 0x95, 0xcd, 0x92, 0x3e, 0x80, 0xcf, 0x2b, 0xe6, 0x33, 0x96,
-	0xb3, 0x44, 0x8d, 0xf9, 0x41, 0xc1, 0x95, 0x26, 0x4f, 0xc0, 0xc5, 0x46, 0x54, 0x65, 0x32, 0x55,
-	0x1c, 0xde, 0x05, 0x7e, 0x66, 0x22, 0x97, 0xbc, 0x5d, 0xef, 0xf6, 0xe6, 0xfe, 0x76, 0xb0, 0xd2,
-	0x62, 0x60, 0x2f, 0x8c, 0xd6, 0x8f, 0x4b, 0xdc, 0x1b, 0xbb, 0x62, 0x92, 0x3b, 0xc6, 0x03, 0x91,
-	0x73, 0x55, 0x33, 0xe0, 0x2b, 0xb0, 0x9e, 0x71, 0x9e, 0x1b, 0xa9, 0xad, 0xd1, 0xe3, 0xd3, 0x12,
-	0x9b, 0xf3, 0xb2, 0xc4, 0x9b, 0x33, 0x96, 0xc4, 0xf7, 0x48, 0x75, 0x22, 0x3f, 0x4a, 0xbc, 0x27,
-	0x22, 0xfd, 0xba, 0x98, 0x04, 0xa1, 0x4c, 0x68, 0x28, 0x55, 0x22, 0x95, 0xfb, 0xd9, 0x53, 0xd3,
-	0x37, 0x54, 0xcf, 0x32, 0xae, 0x82, 0x61, 0x18, 0x0e, 0xa7, 0x53, 0x23, 0x6f, 0x54, 0xc8, 0x43,
-	0x37, 0x41, 0xcd, 0x74, 0x13, 0x50, 0xe0, 0x73, 0x13, 0xe9, 0x9c, 0xc0, 0x5d, 0x70, 0x65, 0x44,
-	0x39, 0x9d, 0xa7, 0x2c, 0x8a, 0x27, 0xf2, 0xf0, 0x6c, 0x9a, 0x7f, 0x04, 0xfa, 0x4d, 0xe8, 0xef,
-	0xee, 0x37, 0xde, 0xb2, 0xb8, 0xe0, 0x06, 0x7b, 0x7e, 0xb4, 0x73, 0x5a, 0x62, 0x1b, 0x58, 0x96,
-	0x78, 0xcb, 0x72, 0xcd, 0x91, 0x8c, 0x6d, 0x78, 0xff, 0xe7, 0x1a, 0xd8, 0x30, 0x4a, 0x50, 0x03,
-	0xdf, 0xee, 0x06, 0x5e, 0x6b, 0x8d, 0xdc, 0x36, 0xc0, 0xe0, 0xfa, 0xbf, 0x8b, 0x6c, 0x3f, 0x04,
-	0x7f, 0xfc, 0xf2, 0xfd, 0xf3, 0xda, 0x0e, 0xdc, 0xa6, 0xab, 0x1e, 0xb4, 0x9b, 0x87, 0x47, 0xc0,
-	0xb7, 0xef, 0xd9, 0x45, 0x6d, 0x58, 0xa2, 0x8b, 0xda, 0xdc, 0x21, 0xb9, 0x69, 0xa8, 0xbb, 0x10,
-	0xb5, 0xa8, 0x76, 0x67, 0xf4, 0xa8, 0x7a, 0xc4, 0xf7, 0xf0, 0x03, 0x38, 0xe7, 0x1e, 0x10, 0x76,
-	0x08, 0x37, 0x97, 0x3a, 0xb8, 0xf1, 0x9f, 0x2a, 0xc7, 0xbf, 0x65, 0xf8, 0x57, 0x21, 0x6e, 0xf1,
-	0x13, 0x5b, 0xe9, 0x1a, 0x18, 0xbd, 0x38, 0x9e, 0x23, 0xef, 0x64, 0x8e, 0xbc, 0x6f, 0x73, 0xe4,
-	0x7d, 0x5a, 0xa0, 0xde, 0xc9, 0x02, 0xf5, 0xbe, 0x2e, 0x50, 0xef, 0xe5, 0xfd, 0xbf, 0x5c, 0x31,
-	0xb4, 0x22, 0x56, 0xcb, 0xb8, 0x42, 0xc8, 0x98, 0xa5, 0xa2, 0xb6, 0xcb, 0xe1, 0x1f, 0x7d, 0x63,
-	0x97, 0x89, 0x6f, 0xfe, 0xb9, 0x77, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x53, 0xc2, 0x07, 0xc5,
-	0x3d, 0x04, 0x00, 0x00,
+func (m *QueryMailboxResponse) GetAck() uint64 {
+	if m != nil {
+		return m.Ack
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the swingset module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Egress queries a provisioned egress.
-	Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error)
-	// Return the contents of a peer's outbound mailbox.
-	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
+// MailboxMessage is a message in an outbound mailbox.
+type MailboxMessage struct {
+	Num  uint64 `protobuf:"varint,1,opt,name=num,proto3" json:"num" yaml:"num"`
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body" yaml:"body"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *MailboxMessage) Reset()         { *m = MailboxMessage{} }
+func (m *MailboxMessage) String() string { return proto.CompactTextString(m) }
+func (*MailboxMessage) ProtoMessage()    {}
+func (*MailboxMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{8}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *MailboxMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MailboxMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MailboxMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MailboxMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MailboxMessage.Merge(m, src)
+}
+func (m *MailboxMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MailboxMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MailboxMessage.DiscardUnknown(m)
 }
 
-func (c *queryClient) Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error) {
-	out := new(QueryEgressResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Egress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MailboxMessage proto.InternalMessageInfo
+
+func (m *MailboxMessage) GetNum() uint64 {
+	if m != nil {
+		return m.Num
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error) {
-	out := new(QueryMailboxResponse)
-	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Mailbox", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MailboxMessage) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the swingset module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Egress queries a provisioned egress.
-	Egress(context.Context, *QueryEgressRequest) (*QueryEgressResponse, error)
-	// Return the contents of a peer's outbound mailbox.
-	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
+// QueryVatsRequest is the request type for the Query/Vats RPC method.
+type QueryVatsRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVatsRequest) Reset()         { *m = QueryVatsRequest{} }
+func (m *QueryVatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVatsRequest) ProtoMessage()    {}
+func (*QueryVatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{9}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Egress(ctx context.Context, req *QueryEgressRequest) (*QueryEgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+func (m *QueryVatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
+func (m *QueryVatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVatsRequest.Merge(m, src)
+}
+func (m *QueryVatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVatsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryVatsRequest proto.InternalMessageInfo
+
+// VatStatus describes a single vat as recorded in swing-store export data.
+type VatStatus struct {
+	VatID string `protobuf:"bytes,1,opt,name=vat_id,json=vatId,proto3" json:"vatID" yaml:"vatID"`
+	// The vat's static or dynamic name, if any.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
+	// The incarnation number of the vat's current transcript span.
+	Incarnation uint64 `protobuf:"varint,3,opt,name=incarnation,proto3" json:"incarnation" yaml:"incarnation"`
+	// The transcript position of the vat's current heap snapshot, or zero if
+	// the vat has no snapshot.
+	SnapshotPos uint64 `protobuf:"varint,4,opt,name=snapshot_pos,json=snapshotPos,proto3" json:"snapshotPos" yaml:"snapshotPos"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+func (m *VatStatus) Reset()         { *m = VatStatus{} }
+func (m *VatStatus) String() string { return proto.CompactTextString(m) }
+func (*VatStatus) ProtoMessage()    {}
+func (*VatStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{10}
+}
+func (m *VatStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VatStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VatStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Query/Params",
+}
+func (m *VatStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatStatus.Merge(m, src)
+}
+func (m *VatStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VatStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatStatus proto.InternalMessageInfo
+
+func (m *VatStatus) GetVatID() string {
+	if m != nil {
+		return m.VatID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *VatStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEgressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *VatStatus) GetIncarnation() uint64 {
+	if m != nil {
+		return m.Incarnation
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Egress(ctx, in)
+	return 0
+}
+
+func (m *VatStatus) GetSnapshotPos() uint64 {
+	if m != nil {
+		return m.SnapshotPos
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Query/Egress",
+	return 0
+}
+
+// QueryVatsResponse is the response type for the Query/Vats RPC method.
+type QueryVatsResponse struct {
+	Vats []VatStatus `protobuf:"bytes,1,rep,name=vats,proto3" json:"vats" yaml:"vats"`
+	// The number of entries in the kernel run-queue.
+	RunQueueLength uint64 `protobuf:"varint,2,opt,name=run_queue_length,json=runQueueLength,proto3" json:"runQueueLength" yaml:"runQueueLength"`
+	// The number of entries in the kernel acceptance queue.
+	AcceptanceQueueLength uint64 `protobuf:"varint,3,opt,name=acceptance_queue_length,json=acceptanceQueueLength,proto3" json:"acceptanceQueueLength" yaml:"acceptanceQueueLength"`
+	// The number of actions waiting in the inbound (cosmos to swingset) queues.
+	InboundQueueLength uint64 `protobuf:"varint,4,opt,name=inbound_queue_length,json=inboundQueueLength,proto3" json:"inboundQueueLength" yaml:"inboundQueueLength"`
+}
+
+func (m *QueryVatsResponse) Reset()         { *m = QueryVatsResponse{} }
+func (m *QueryVatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVatsResponse) ProtoMessage()    {}
+func (*QueryVatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{11}
+}
+func (m *QueryVatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Egress(ctx, req.(*QueryEgressRequest))
+}
+func (m *QueryVatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVatsResponse.Merge(m, src)
+}
+func (m *QueryVatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVatsResponse proto.InternalMessageInfo
+
+func (m *QueryVatsResponse) GetVats() []VatStatus {
+	if m != nil {
+		return m.Vats
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVatsResponse) GetRunQueueLength() uint64 {
+	if m != nil {
+		return m.RunQueueLength
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mailbox(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.swingset.Query/Mailbox",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mailbox(ctx, req.(*QueryMailboxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.swingset.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Egress",
-			Handler:    _Query_Egress_Handler,
-		},
-		{
-			MethodName: "Mailbox",
-			Handler:    _Query_Mailbox_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/swingset/query.proto",
+func (m *QueryVatsResponse) GetAcceptanceQueueLength() uint64 {
+	if m != nil {
+		return m.AcceptanceQueueLength
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVatsResponse) GetInboundQueueLength() uint64 {
+	if m != nil {
+		return m.InboundQueueLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBundleStatusRequest is the request type for the Query/BundleStatus RPC
+// method.
+type QueryBundleStatusRequest struct {
+	// The endoZipBase64Sha512 hash of the bundle, optionally with the "b1-"
+	// bundle ID prefix.
+	BundleHash string `protobuf:"bytes,1,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBundleStatusRequest) Reset()         { *m = QueryBundleStatusRequest{} }
+func (m *QueryBundleStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleStatusRequest) ProtoMessage()    {}
+func (*QueryBundleStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{12}
+}
+func (m *QueryBundleStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBundleStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleStatusRequest.Merge(m, src)
+}
+func (m *QueryBundleStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBundleStatusRequest proto.InternalMessageInfo
+
+func (m *QueryBundleStatusRequest) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBundleStatusResponse is the response type for the Query/BundleStatus
+// RPC method.
+type QueryBundleStatusResponse struct {
+	Installation BundleInstallation `protobuf:"bytes,1,opt,name=installation,proto3" json:"installation" yaml:"installation"`
+	// The number of vats currently created from the bundle.
+	ReferenceCount uint64 `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"referenceCount" yaml:"referenceCount"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBundleStatusResponse) Reset()         { *m = QueryBundleStatusResponse{} }
+func (m *QueryBundleStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleStatusResponse) ProtoMessage()    {}
+func (*QueryBundleStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{13}
+}
+func (m *QueryBundleStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEgressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBundleStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleStatusResponse.Merge(m, src)
 }
-
-func (m *QueryEgressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBundleStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryBundleStatusResponse proto.InternalMessageInfo
+
+func (m *QueryBundleStatusResponse) GetInstallation() BundleInstallation {
+	if m != nil {
+		return m.Installation
 	}
-	return len(dAtA) - i, nil
+	return BundleInstallation{}
 }
 
-func (m *QueryEgressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBundleStatusResponse) GetReferenceCount() uint64 {
+	if m != nil {
+		return m.ReferenceCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEgressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHealthRequest is the request type for the Query/Health RPC method.
+type QueryHealthRequest struct {
 }
 
-func (m *QueryEgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Egress != nil {
-		{
-			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryHealthRequest) Reset()         { *m = QueryHealthRequest{} }
+func (m *QueryHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthRequest) ProtoMessage()    {}
+func (*QueryHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{14}
+}
+func (m *QueryHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthRequest.Merge(m, src)
+}
+func (m *QueryHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryHealthRequest proto.InternalMessageInfo
+
+// QueryHealthResponse is the response type for the Query/Health RPC method.
+type QueryHealthResponse struct {
+	// Whether the VM is being pinged at all (as configured by
+	// vm-health-check-interval in app.toml).
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled" yaml:"enabled"`
+	// Whether the last ping was answered within the timeout.
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy" yaml:"healthy"`
+	// The Unix time in milliseconds at which a ping was last answered, or zero.
+	LastSuccessUnixMs int64 `protobuf:"varint,3,opt,name=last_success_unix_ms,json=lastSuccessUnixMs,proto3" json:"lastSuccessUnixMs" yaml:"lastSuccessUnixMs"`
+	// The time in milliseconds taken to answer the last answered ping.
+	LatencyMs int64 `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latencyMs" yaml:"latencyMs"`
+	// The number of pings failed since the last answered one.
+	ConsecutiveFailures uint64 `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutiveFailures" yaml:"consecutiveFailures"`
+	// A description of the last failed ping, if any.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"lastError" yaml:"lastError"`
 }
 
-func (m *QueryMailboxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHealthResponse) Reset()         { *m = QueryHealthResponse{} }
+func (m *QueryHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthResponse) ProtoMessage()    {}
+func (*QueryHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{15}
+}
+func (m *QueryHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthResponse.Merge(m, src)
+}
+func (m *QueryHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryHealthResponse proto.InternalMessageInfo
+
+func (m *QueryHealthResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryMailboxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHealthResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryMailboxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHealthResponse) GetLastSuccessUnixMs() int64 {
+	if m != nil {
+		return m.LastSuccessUnixMs
+	}
+	return 0
 }
 
-func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHealthResponse) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHealthResponse) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryHealthResponse) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	var l int
-	_ = l
-	return n
+	return ""
+}
+
+// QueryReplayStatusRequest is the request type for the Query/ReplayStatus RPC
+// method.
+type QueryReplayStatusRequest struct {
+}
+
+func (m *QueryReplayStatusRequest) Reset()         { *m = QueryReplayStatusRequest{} }
+func (m *QueryReplayStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReplayStatusRequest) ProtoMessage()    {}
+func (*QueryReplayStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{16}
+}
+func (m *QueryReplayStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReplayStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReplayStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReplayStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReplayStatusRequest.Merge(m, src)
+}
+func (m *QueryReplayStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReplayStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReplayStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReplayStatusRequest proto.InternalMessageInfo
+
+// QueryReplayStatusResponse is the response type for the Query/ReplayStatus RPC
+// method.
+type QueryReplayStatusResponse struct {
+	// Whether any vat transcript is being replayed.
+	Replaying bool `protobuf:"varint,1,opt,name=replaying,proto3" json:"replaying" yaml:"replaying"`
+	// The vat whose replay progress was last reported, if any.
+	VatID string `protobuf:"bytes,2,opt,name=vat_id,json=vatId,proto3" json:"vatID" yaml:"vatID"`
+	// The number of deliveries of that transcript replayed so far.
+	ReplayedDeliveries uint64 `protobuf:"varint,3,opt,name=replayed_deliveries,json=replayedDeliveries,proto3" json:"replayedDeliveries" yaml:"replayedDeliveries"`
+	// The number of deliveries in that transcript.
+	TotalDeliveries uint64 `protobuf:"varint,4,opt,name=total_deliveries,json=totalDeliveries,proto3" json:"totalDeliveries" yaml:"totalDeliveries"`
+	// The number of transcripts replayed to completion since the node started.
+	VatsReplayed uint64 `protobuf:"varint,5,opt,name=vats_replayed,json=vatsReplayed,proto3" json:"vatsReplayed" yaml:"vatsReplayed"`
+	// The Unix time in milliseconds at which the earliest replay in progress, or
+	// else the last replay, began, or zero.
+	StartedUnixMs int64 `protobuf:"varint,6,opt,name=started_unix_ms,json=startedUnixMs,proto3" json:"startedUnixMs" yaml:"startedUnixMs"`
+	// The Unix time in milliseconds at which replay progress was last reported,
+	// or zero.
+	LastProgressUnixMs int64 `protobuf:"varint,7,opt,name=last_progress_unix_ms,json=lastProgressUnixMs,proto3" json:"lastProgressUnixMs" yaml:"lastProgressUnixMs"`
+	// The replays in progress, by vat ID, of which there may be several at once.
+	Vats []VatReplayStatus `protobuf:"bytes,8,rep,name=vats,proto3" json:"vats" yaml:"vats"`
+}
+
+func (m *QueryReplayStatusResponse) Reset()         { *m = QueryReplayStatusResponse{} }
+func (m *QueryReplayStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReplayStatusResponse) ProtoMessage()    {}
+func (*QueryReplayStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{17}
+}
+func (m *QueryReplayStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReplayStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReplayStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReplayStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReplayStatusResponse.Merge(m, src)
+}
+func (m *QueryReplayStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReplayStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReplayStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReplayStatusResponse proto.InternalMessageInfo
+
+func (m *QueryReplayStatusResponse) GetReplaying() bool {
+	if m != nil {
+		return m.Replaying
+	}
+	return false
+}
+
+func (m *QueryReplayStatusResponse) GetVatID() string {
+	if m != nil {
+		return m.VatID
+	}
+	return ""
+}
+
+func (m *QueryReplayStatusResponse) GetReplayedDeliveries() uint64 {
+	if m != nil {
+		return m.ReplayedDeliveries
+	}
+	return 0
+}
+
+func (m *QueryReplayStatusResponse) GetTotalDeliveries() uint64 {
+	if m != nil {
+		return m.TotalDeliveries
+	}
+	return 0
+}
+
+func (m *QueryReplayStatusResponse) GetVatsReplayed() uint64 {
+	if m != nil {
+		return m.VatsReplayed
+	}
+	return 0
+}
+
+func (m *QueryReplayStatusResponse) GetStartedUnixMs() int64 {
+	if m != nil {
+		return m.StartedUnixMs
+	}
+	return 0
+}
+
+func (m *QueryReplayStatusResponse) GetLastProgressUnixMs() int64 {
+	if m != nil {
+		return m.LastProgressUnixMs
+	}
+	return 0
+}
+
+func (m *QueryReplayStatusResponse) GetVats() []VatReplayStatus {
+	if m != nil {
+		return m.Vats
+	}
+	return nil
+}
+
+// VatReplayStatus is the progress of the transcript replay of one vat.
+type VatReplayStatus struct {
+	// The vat whose transcript is being replayed.
+	VatID string `protobuf:"bytes,1,opt,name=vat_id,json=vatId,proto3" json:"vatID" yaml:"vatID"`
+	// The number of deliveries of that transcript replayed so far.
+	ReplayedDeliveries uint64 `protobuf:"varint,2,opt,name=replayed_deliveries,json=replayedDeliveries,proto3" json:"replayedDeliveries" yaml:"replayedDeliveries"`
+	// The number of deliveries in that transcript.
+	TotalDeliveries uint64 `protobuf:"varint,3,opt,name=total_deliveries,json=totalDeliveries,proto3" json:"totalDeliveries" yaml:"totalDeliveries"`
+	// The Unix time in milliseconds at which the replay began.
+	StartedUnixMs int64 `protobuf:"varint,4,opt,name=started_unix_ms,json=startedUnixMs,proto3" json:"startedUnixMs" yaml:"startedUnixMs"`
+	// The Unix time in milliseconds at which its progress was last reported.
+	LastProgressUnixMs int64 `protobuf:"varint,5,opt,name=last_progress_unix_ms,json=lastProgressUnixMs,proto3" json:"lastProgressUnixMs" yaml:"lastProgressUnixMs"`
+}
+
+func (m *VatReplayStatus) Reset()         { *m = VatReplayStatus{} }
+func (m *VatReplayStatus) String() string { return proto.CompactTextString(m) }
+func (*VatReplayStatus) ProtoMessage()    {}
+func (*VatReplayStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{18}
+}
+func (m *VatReplayStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VatReplayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VatReplayStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VatReplayStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatReplayStatus.Merge(m, src)
+}
+func (m *VatReplayStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VatReplayStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatReplayStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatReplayStatus proto.InternalMessageInfo
+
+func (m *VatReplayStatus) GetVatID() string {
+	if m != nil {
+		return m.VatID
+	}
+	return ""
+}
+
+func (m *VatReplayStatus) GetReplayedDeliveries() uint64 {
+	if m != nil {
+		return m.ReplayedDeliveries
+	}
+	return 0
+}
+
+func (m *VatReplayStatus) GetTotalDeliveries() uint64 {
+	if m != nil {
+		return m.TotalDeliveries
+	}
+	return 0
+}
+
+func (m *VatReplayStatus) GetStartedUnixMs() int64 {
+	if m != nil {
+		return m.StartedUnixMs
+	}
+	return 0
+}
+
+func (m *VatReplayStatus) GetLastProgressUnixMs() int64 {
+	if m != nil {
+		return m.LastProgressUnixMs
+	}
+	return 0
+}
+
+// QueryActionQueueRequest is the request type for the Query/ActionQueue RPC
+// method.
+type QueryActionQueueRequest struct {
+	// The maximum number of entries to return, or zero for the default.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit" yaml:"limit"`
+}
+
+func (m *QueryActionQueueRequest) Reset()         { *m = QueryActionQueueRequest{} }
+func (m *QueryActionQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActionQueueRequest) ProtoMessage()    {}
+func (*QueryActionQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{19}
+}
+func (m *QueryActionQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionQueueRequest.Merge(m, src)
+}
+func (m *QueryActionQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionQueueRequest proto.InternalMessageInfo
+
+func (m *QueryActionQueueRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// ActionQueueEntry describes an action waiting in an inbound queue.
+type ActionQueueEntry struct {
+	// The queue holding the action, "highPriorityQueue" or "actionQueue".
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue" yaml:"queue"`
+	// The inbound number of the action, its index within the queue.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index" yaml:"index"`
+	// The action type, such as "WALLET_SPEND_ACTION".
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type" yaml:"type"`
+	// The account or peer on whose behalf the action was enqueued, if any.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source" yaml:"source"`
+	// The block height at which the action was enqueued.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The hash of the transaction that enqueued the action, or a substitute
+	// naming the module that did.
+	TxHash string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"txHash" yaml:"txHash"`
+}
+
+func (m *ActionQueueEntry) Reset()         { *m = ActionQueueEntry{} }
+func (m *ActionQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*ActionQueueEntry) ProtoMessage()    {}
+func (*ActionQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{20}
+}
+func (m *ActionQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionQueueEntry.Merge(m, src)
+}
+func (m *ActionQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionQueueEntry proto.InternalMessageInfo
+
+func (m *ActionQueueEntry) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *ActionQueueEntry) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ActionQueueEntry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ActionQueueEntry) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ActionQueueEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ActionQueueEntry) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryActionQueueResponse is the response type for the Query/ActionQueue RPC
+// method.
+type QueryActionQueueResponse struct {
+	Entries []ActionQueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	// The number of actions in the high-priority queue.
+	HighPriorityQueueLength uint64 `protobuf:"varint,2,opt,name=high_priority_queue_length,json=highPriorityQueueLength,proto3" json:"highPriorityQueueLength" yaml:"highPriorityQueueLength"`
+	// The number of actions in the action queue.
+	ActionQueueLength uint64 `protobuf:"varint,3,opt,name=action_queue_length,json=actionQueueLength,proto3" json:"actionQueueLength" yaml:"actionQueueLength"`
+	// The number of price updates in the oracle queue.
+	OracleQueueLength uint64 `protobuf:"varint,4,opt,name=oracle_queue_length,json=oracleQueueLength,proto3" json:"oracleQueueLength" yaml:"oracleQueueLength"`
+	// The number of actions waiting in each inbound lane.
+	InboundLaneLengths []UintMapEntry `protobuf:"bytes,5,rep,name=inbound_lane_lengths,json=inboundLaneLengths,proto3" json:"inboundLaneLengths" yaml:"inboundLaneLengths"`
+}
+
+func (m *QueryActionQueueResponse) Reset()         { *m = QueryActionQueueResponse{} }
+func (m *QueryActionQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActionQueueResponse) ProtoMessage()    {}
+func (*QueryActionQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{21}
+}
+func (m *QueryActionQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActionQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActionQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActionQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionQueueResponse.Merge(m, src)
+}
+func (m *QueryActionQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActionQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActionQueueResponse proto.InternalMessageInfo
+
+func (m *QueryActionQueueResponse) GetEntries() []ActionQueueEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryActionQueueResponse) GetHighPriorityQueueLength() uint64 {
+	if m != nil {
+		return m.HighPriorityQueueLength
+	}
+	return 0
+}
+
+func (m *QueryActionQueueResponse) GetActionQueueLength() uint64 {
+	if m != nil {
+		return m.ActionQueueLength
+	}
+	return 0
+}
+
+func (m *QueryActionQueueResponse) GetOracleQueueLength() uint64 {
+	if m != nil {
+		return m.OracleQueueLength
+	}
+	return 0
+}
+
+func (m *QueryActionQueueResponse) GetInboundLaneLengths() []UintMapEntry {
+	if m != nil {
+		return m.InboundLaneLengths
+	}
+	return nil
+}
+
+// QueryPrioritySendersRequest is the request type for the
+// Query/PrioritySenders RPC method.
+type QueryPrioritySendersRequest struct {
+}
+
+func (m *QueryPrioritySendersRequest) Reset()         { *m = QueryPrioritySendersRequest{} }
+func (m *QueryPrioritySendersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrioritySendersRequest) ProtoMessage()    {}
+func (*QueryPrioritySendersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{22}
+}
+func (m *QueryPrioritySendersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrioritySendersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrioritySendersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrioritySendersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrioritySendersRequest.Merge(m, src)
+}
+func (m *QueryPrioritySendersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrioritySendersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrioritySendersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrioritySendersRequest proto.InternalMessageInfo
+
+// QueryPrioritySendersResponse is the response type for the
+// Query/PrioritySenders RPC method.
+type QueryPrioritySendersResponse struct {
+	// The priority_senders param.
+	ParamSenders []string `protobuf:"bytes,1,rep,name=param_senders,json=paramSenders,proto3" json:"paramSenders" yaml:"paramSenders"`
+	// The senders recorded by SwingSet under the highPrioritySenders vstorage
+	// path.
+	StorageSenders []string `protobuf:"bytes,2,rep,name=storage_senders,json=storageSenders,proto3" json:"storageSenders" yaml:"storageSenders"`
+}
+
+func (m *QueryPrioritySendersResponse) Reset()         { *m = QueryPrioritySendersResponse{} }
+func (m *QueryPrioritySendersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrioritySendersResponse) ProtoMessage()    {}
+func (*QueryPrioritySendersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{23}
+}
+func (m *QueryPrioritySendersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrioritySendersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrioritySendersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrioritySendersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrioritySendersResponse.Merge(m, src)
+}
+func (m *QueryPrioritySendersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrioritySendersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrioritySendersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrioritySendersResponse proto.InternalMessageInfo
+
+func (m *QueryPrioritySendersResponse) GetParamSenders() []string {
+	if m != nil {
+		return m.ParamSenders
+	}
+	return nil
+}
+
+func (m *QueryPrioritySendersResponse) GetStorageSenders() []string {
+	if m != nil {
+		return m.StorageSenders
+	}
+	return nil
+}
+
+// QueryTimerRequest is the request type for the Query/Timer RPC method.
+type QueryTimerRequest struct {
+}
+
+func (m *QueryTimerRequest) Reset()         { *m = QueryTimerRequest{} }
+func (m *QueryTimerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimerRequest) ProtoMessage()    {}
+func (*QueryTimerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{24}
+}
+func (m *QueryTimerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimerRequest.Merge(m, src)
+}
+func (m *QueryTimerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimerRequest proto.InternalMessageInfo
+
+// QueryTimerResponse is the response type for the Query/Timer RPC method.
+type QueryTimerResponse struct {
+	Timer TimerStatus `protobuf:"bytes,1,opt,name=timer,proto3" json:"timer" yaml:"timer"`
+}
+
+func (m *QueryTimerResponse) Reset()         { *m = QueryTimerResponse{} }
+func (m *QueryTimerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimerResponse) ProtoMessage()    {}
+func (*QueryTimerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{25}
+}
+func (m *QueryTimerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimerResponse.Merge(m, src)
+}
+func (m *QueryTimerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimerResponse proto.InternalMessageInfo
+
+func (m *QueryTimerResponse) GetTimer() TimerStatus {
+	if m != nil {
+		return m.Timer
+	}
+	return TimerStatus{}
+}
+
+// QueryVatOwnerRequest is the request type for the Query/VatOwner RPC method.
+type QueryVatOwnerRequest struct {
+	VatId string `protobuf:"bytes,1,opt,name=vat_id,json=vatId,proto3" json:"vatID" yaml:"vatID"`
+}
+
+func (m *QueryVatOwnerRequest) Reset()         { *m = QueryVatOwnerRequest{} }
+func (m *QueryVatOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVatOwnerRequest) ProtoMessage()    {}
+func (*QueryVatOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{26}
+}
+func (m *QueryVatOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVatOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVatOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVatOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVatOwnerRequest.Merge(m, src)
+}
+func (m *QueryVatOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVatOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVatOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVatOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryVatOwnerRequest) GetVatId() string {
+	if m != nil {
+		return m.VatId
+	}
+	return ""
+}
+
+// QueryVatOwnerResponse is the response type for the Query/VatOwner RPC method.
+type QueryVatOwnerResponse struct {
+	VatOwner VatOwner `protobuf:"bytes,1,opt,name=vat_owner,json=vatOwner,proto3" json:"vatOwner" yaml:"vatOwner"`
+}
+
+func (m *QueryVatOwnerResponse) Reset()         { *m = QueryVatOwnerResponse{} }
+func (m *QueryVatOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVatOwnerResponse) ProtoMessage()    {}
+func (*QueryVatOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{27}
+}
+func (m *QueryVatOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVatOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVatOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVatOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVatOwnerResponse.Merge(m, src)
+}
+func (m *QueryVatOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVatOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVatOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVatOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryVatOwnerResponse) GetVatOwner() VatOwner {
+	if m != nil {
+		return m.VatOwner
+	}
+	return VatOwner{}
+}
+
+// QueryWalletActionTextRequest is the request type for the
+// Query/WalletActionText RPC method.
+type QueryWalletActionTextRequest struct {
+	// The bech32 address of the wallet owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	// The CapData of the bridge action.
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action" yaml:"action"`
+	// Whether the action is that of a MsgWalletSpendAction.
+	SpendAction bool `protobuf:"varint,3,opt,name=spend_action,json=spendAction,proto3" json:"spendAction" yaml:"spendAction"`
+}
+
+func (m *QueryWalletActionTextRequest) Reset()         { *m = QueryWalletActionTextRequest{} }
+func (m *QueryWalletActionTextRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWalletActionTextRequest) ProtoMessage()    {}
+func (*QueryWalletActionTextRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{28}
+}
+func (m *QueryWalletActionTextRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWalletActionTextRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWalletActionTextRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWalletActionTextRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWalletActionTextRequest.Merge(m, src)
+}
+func (m *QueryWalletActionTextRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWalletActionTextRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWalletActionTextRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWalletActionTextRequest proto.InternalMessageInfo
+
+func (m *QueryWalletActionTextRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryWalletActionTextRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *QueryWalletActionTextRequest) GetSpendAction() bool {
+	if m != nil {
+		return m.SpendAction
+	}
+	return false
+}
+
+// QueryWalletActionTextResponse is the response type for the
+// Query/WalletActionText RPC method.
+type QueryWalletActionTextResponse struct {
+	Lines []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines" yaml:"lines"`
+}
+
+func (m *QueryWalletActionTextResponse) Reset()         { *m = QueryWalletActionTextResponse{} }
+func (m *QueryWalletActionTextResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWalletActionTextResponse) ProtoMessage()    {}
+func (*QueryWalletActionTextResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76266f656a1a9971, []int{29}
+}
+func (m *QueryWalletActionTextResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWalletActionTextResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWalletActionTextResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWalletActionTextResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWalletActionTextResponse.Merge(m, src)
+}
+func (m *QueryWalletActionTextResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWalletActionTextResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWalletActionTextResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWalletActionTextResponse proto.InternalMessageInfo
+
+func (m *QueryWalletActionTextResponse) GetLines() []string {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.swingset.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.swingset.QueryParamsResponse")
+	proto.RegisterType((*QueryEgressRequest)(nil), "agoric.swingset.QueryEgressRequest")
+	proto.RegisterType((*QueryEgressResponse)(nil), "agoric.swingset.QueryEgressResponse")
+	proto.RegisterType((*QueryEgressesRequest)(nil), "agoric.swingset.QueryEgressesRequest")
+	proto.RegisterType((*QueryEgressesResponse)(nil), "agoric.swingset.QueryEgressesResponse")
+	proto.RegisterType((*QueryMailboxRequest)(nil), "agoric.swingset.QueryMailboxRequest")
+	proto.RegisterType((*QueryMailboxResponse)(nil), "agoric.swingset.QueryMailboxResponse")
+	proto.RegisterType((*MailboxMessage)(nil), "agoric.swingset.MailboxMessage")
+	proto.RegisterType((*QueryVatsRequest)(nil), "agoric.swingset.QueryVatsRequest")
+	proto.RegisterType((*VatStatus)(nil), "agoric.swingset.VatStatus")
+	proto.RegisterType((*QueryVatsResponse)(nil), "agoric.swingset.QueryVatsResponse")
+	proto.RegisterType((*QueryBundleStatusRequest)(nil), "agoric.swingset.QueryBundleStatusRequest")
+	proto.RegisterType((*QueryBundleStatusResponse)(nil), "agoric.swingset.QueryBundleStatusResponse")
+	proto.RegisterType((*QueryHealthRequest)(nil), "agoric.swingset.QueryHealthRequest")
+	proto.RegisterType((*QueryHealthResponse)(nil), "agoric.swingset.QueryHealthResponse")
+	proto.RegisterType((*QueryReplayStatusRequest)(nil), "agoric.swingset.QueryReplayStatusRequest")
+	proto.RegisterType((*QueryReplayStatusResponse)(nil), "agoric.swingset.QueryReplayStatusResponse")
+	proto.RegisterType((*VatReplayStatus)(nil), "agoric.swingset.VatReplayStatus")
+	proto.RegisterType((*QueryActionQueueRequest)(nil), "agoric.swingset.QueryActionQueueRequest")
+	proto.RegisterType((*ActionQueueEntry)(nil), "agoric.swingset.ActionQueueEntry")
+	proto.RegisterType((*QueryActionQueueResponse)(nil), "agoric.swingset.QueryActionQueueResponse")
+	proto.RegisterType((*QueryPrioritySendersRequest)(nil), "agoric.swingset.QueryPrioritySendersRequest")
+	proto.RegisterType((*QueryPrioritySendersResponse)(nil), "agoric.swingset.QueryPrioritySendersResponse")
+	proto.RegisterType((*QueryTimerRequest)(nil), "agoric.swingset.QueryTimerRequest")
+	proto.RegisterType((*QueryTimerResponse)(nil), "agoric.swingset.QueryTimerResponse")
+	proto.RegisterType((*QueryVatOwnerRequest)(nil), "agoric.swingset.QueryVatOwnerRequest")
+	proto.RegisterType((*QueryVatOwnerResponse)(nil), "agoric.swingset.QueryVatOwnerResponse")
+	proto.RegisterType((*QueryWalletActionTextRequest)(nil), "agoric.swingset.QueryWalletActionTextRequest")
+	proto.RegisterType((*QueryWalletActionTextResponse)(nil), "agoric.swingset.QueryWalletActionTextResponse")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/query.proto", fileDescriptor_76266f656a1a9971) }
+
+var fileDescriptor_76266f656a1a9971 = []byte{
+	// 2508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xcf, 0x78, 0x6c, 0xc7, 0x2e, 0x3b, 0x6b, 0x6f, 0xd9, 0x5e, 0x8f, 0x27, 0xb1, 0xdb, 0x2e,
+	0x6f, 0xbe, 0x37, 0x9e, 0x4d, 0xb2, 0xab, 0xd5, 0x82, 0x10, 0x64, 0x36, 0xc9, 0x3a, 0x90, 0x08,
+	0xa7, 0xf2, 0xc1, 0x0a, 0xd0, 0xce, 0x96, 0x7b, 0x2a, 0x33, 0xad, 0xf4, 0x74, 0x4f, 0xba, 0x6a,
+	0x1c, 0x9b, 0x10, 0x21, 0x71, 0x58, 0xc1, 0x01, 0x09, 0xc4, 0x09, 0x21, 0xf1, 0x07, 0x70, 0xe5,
+	0xc6, 0x91, 0xcb, 0xee, 0x09, 0x56, 0x20, 0xf1, 0x71, 0x69, 0x50, 0x82, 0x84, 0x34, 0x47, 0x1f,
+	0x39, 0xa1, 0xaa, 0x7a, 0xfd, 0x35, 0xdd, 0x63, 0x1b, 0xb1, 0xcb, 0xc9, 0xae, 0xdf, 0xfb, 0xac,
+	0x57, 0xaf, 0xea, 0xbd, 0x7e, 0x83, 0x4e, 0xb2, 0x96, 0x1f, 0x38, 0x76, 0x4d, 0x3c, 0x75, 0xbc,
+	0x96, 0xe0, 0xb2, 0xf6, 0xa4, 0xc7, 0x83, 0xbd, 0x8d, 0x6e, 0xe0, 0x4b, 0x1f, 0xcf, 0x18, 0xe2,
+	0x46, 0x44, 0xac, 0xce, 0xb7, 0xfc, 0x96, 0xaf, 0x69, 0x35, 0xf5, 0x9f, 0x61, 0xab, 0xae, 0x0c,
+	0xea, 0x88, 0xfe, 0x01, 0xfa, 0x05, 0xdb, 0x17, 0x1d, 0x5f, 0xd4, 0xb6, 0x99, 0xe0, 0x46, 0x7f,
+	0x6d, 0xe7, 0xf2, 0x36, 0x97, 0xec, 0x72, 0xad, 0xcb, 0x5a, 0x8e, 0xc7, 0xa4, 0xe3, 0x7b, 0x91,
+	0xae, 0x34, 0x6f, 0xc4, 0x65, 0xfb, 0x4e, 0x44, 0x3f, 0xd5, 0xf2, 0xfd, 0x96, 0xcb, 0x6b, 0xac,
+	0xeb, 0xd4, 0x98, 0xe7, 0xf9, 0x52, 0x0b, 0x0b, 0x43, 0x25, 0xf3, 0x08, 0xdf, 0x55, 0xfa, 0xb7,
+	0x58, 0xc0, 0x3a, 0x82, 0xf2, 0x27, 0x3d, 0x2e, 0x24, 0xf9, 0x4b, 0x09, 0xcd, 0x65, 0x60, 0xd1,
+	0xf5, 0x3d, 0xc1, 0xf1, 0xdb, 0x68, 0xbc, 0xab, 0x91, 0x4a, 0x69, 0xb5, 0x74, 0x6e, 0xea, 0xca,
+	0xe2, 0xc6, 0xc0, 0x7e, 0x37, 0x8c, 0x40, 0x7d, 0xf4, 0xd3, 0xd0, 0x3a, 0x46, 0x81, 0x19, 0xff,
+	0xa8, 0x84, 0xaa, 0xa2, 0xc3, 0x02, 0xd9, 0x78, 0xca, 0x5c, 0x97, 0xcb, 0x46, 0x37, 0xf0, 0x77,
+	0x1c, 0xe1, 0xf8, 0x5e, 0xe3, 0x11, 0xe7, 0x95, 0x91, 0xd5, 0xf2, 0xb9, 0xa9, 0x2b, 0x4b, 0x1b,
+	0x66, 0x23, 0x1b, 0x6a, 0x23, 0x1b, 0xb0, 0x91, 0x8d, 0xf7, 0x7c, 0xc7, 0xab, 0xbf, 0xa9, 0xb4,
+	0xfd, 0xfa, 0xef, 0xd6, 0xb9, 0x96, 0x23, 0xdb, 0xbd, 0xed, 0x0d, 0xdb, 0xef, 0xd4, 0x60, 0xd7,
+	0xe6, 0xcf, 0x25, 0xd1, 0x7c, 0x5c, 0x93, 0x7b, 0x5d, 0x2e, 0xb4, 0x80, 0xa0, 0x8b, 0xda, 0xdc,
+	0xb7, 0xb4, 0xb5, 0xad, 0xc8, 0xd8, 0x4d, 0xce, 0x49, 0x00, 0xfb, 0xbd, 0xd1, 0x0a, 0xb8, 0x88,
+	0xf6, 0x8b, 0xbf, 0x8b, 0x46, 0xbb, 0x9c, 0x07, 0x7a, 0x57, 0xd3, 0xf5, 0xcd, 0x7e, 0x68, 0xe9,
+	0xf5, 0x7e, 0x68, 0x4d, 0xed, 0xb1, 0x8e, 0xfb, 0x25, 0xa2, 0x56, 0xe4, 0xdf, 0xa1, 0x75, 0xe9,
+	0x08, 0x1e, 0x5c, 0xb3, 0xed, 0x6b, 0xcd, 0xa6, 0x56, 0xaf, 0xb5, 0x90, 0x9b, 0x68, 0x2e, 0x63,
+	0x13, 0x82, 0x59, 0x43, 0xe3, 0x5c, 0x23, 0x43, 0x83, 0x09, 0x02, 0xc0, 0x46, 0x3e, 0x44, 0xf3,
+	0x29, 0x3d, 0x3c, 0xf6, 0xfe, 0x26, 0x42, 0x49, 0x56, 0x80, 0xb2, 0x33, 0x99, 0x68, 0x9a, 0x14,
+	0x8d, 0x62, 0xba, 0xc5, 0x5a, 0x1c, 0x64, 0x69, 0x4a, 0x92, 0xfc, 0xb6, 0x84, 0x16, 0x06, 0x0c,
+	0x80, 0xab, 0x1f, 0xa0, 0x09, 0x0e, 0x58, 0xa5, 0xb4, 0x5a, 0x3e, 0xc0, 0xd9, 0xfa, 0xba, 0x3a,
+	0xab, 0x7e, 0x68, 0xc5, 0x02, 0xfb, 0xa1, 0x35, 0x63, 0x82, 0x18, 0x21, 0x84, 0xc6, 0x44, 0xfc,
+	0x7e, 0xc6, 0xf7, 0x11, 0xed, 0xfb, 0xd9, 0x43, 0x7d, 0x37, 0x6e, 0x65, 0x9c, 0x17, 0x10, 0xe4,
+	0x3b, 0xcc, 0x71, 0xb7, 0xfd, 0xdd, 0xff, 0xcf, 0xc9, 0xfe, 0xb1, 0x84, 0xe6, 0xb3, 0x56, 0xe3,
+	0xb3, 0x1d, 0xdb, 0x61, 0x6e, 0x8f, 0x6b, 0xbb, 0x93, 0xf5, 0xa5, 0x7e, 0x68, 0x19, 0x60, 0x3f,
+	0xb4, 0xa6, 0x8d, 0x61, 0xbd, 0x24, 0xd4, 0xc0, 0xf8, 0x23, 0x34, 0xd1, 0xe1, 0x42, 0xb0, 0x16,
+	0x17, 0x70, 0x1f, 0xac, 0x5c, 0x84, 0xc1, 0xc8, 0x1d, 0xc3, 0x97, 0x44, 0x3a, 0x12, 0x4c, 0x22,
+	0x1d, 0x21, 0x84, 0xc6, 0x44, 0x7c, 0x16, 0x95, 0x99, 0xfd, 0xb8, 0x52, 0x5e, 0x2d, 0x9d, 0x1b,
+	0xad, 0x2f, 0xf4, 0x43, 0x4b, 0x2d, 0xf7, 0x43, 0x0b, 0x19, 0x11, 0x66, 0x3f, 0x26, 0x54, 0x41,
+	0xe4, 0x11, 0x7a, 0x25, 0x6b, 0x49, 0x89, 0x7a, 0xbd, 0x4e, 0xa5, 0x94, 0x88, 0x7a, 0xbd, 0x4e,
+	0x22, 0xea, 0xf5, 0x3a, 0x84, 0x2a, 0x08, 0x5f, 0x44, 0xa3, 0xdb, 0x7e, 0x73, 0x4f, 0x9f, 0xe3,
+	0x64, 0x7d, 0x51, 0x45, 0x5b, 0xad, 0x93, 0x68, 0xab, 0x15, 0xa1, 0x1a, 0x24, 0x18, 0xcd, 0xea,
+	0xd8, 0x3d, 0x64, 0x32, 0x7e, 0x78, 0x3e, 0x1e, 0x41, 0x93, 0x0f, 0x99, 0xbc, 0x27, 0x99, 0xec,
+	0x09, 0xfc, 0x2e, 0x1a, 0xdf, 0x61, 0xb2, 0xe1, 0x34, 0x21, 0x8c, 0xe4, 0x45, 0x68, 0x8d, 0x3d,
+	0x64, 0xf2, 0xd6, 0x75, 0x13, 0x4f, 0x79, 0xeb, 0x7a, 0x3a, 0x9e, 0xf2, 0xd6, 0x75, 0x1d, 0x4f,
+	0x79, 0xab, 0xa9, 0x3c, 0xf1, 0x58, 0x87, 0xa7, 0x3d, 0x51, 0xeb, 0xc4, 0x13, 0xb5, 0x22, 0x54,
+	0x83, 0xf8, 0x7d, 0x34, 0xe5, 0x78, 0x36, 0x0b, 0x20, 0x0b, 0x4d, 0x88, 0x4e, 0xf7, 0x43, 0x2b,
+	0x0d, 0xef, 0x87, 0x16, 0x36, 0xa2, 0x29, 0x90, 0xd0, 0x34, 0x0b, 0xde, 0x44, 0xd3, 0xc2, 0x63,
+	0x5d, 0xd1, 0xf6, 0x65, 0xa3, 0xeb, 0x8b, 0xca, 0x68, 0xa2, 0x29, 0xc2, 0xb7, 0x7c, 0x91, 0x68,
+	0x4a, 0x81, 0x84, 0xa6, 0x59, 0xc8, 0xcf, 0xca, 0xe8, 0xd5, 0x54, 0x74, 0x20, 0xad, 0xbe, 0x81,
+	0x46, 0x77, 0x98, 0x8c, 0xee, 0x60, 0x35, 0x97, 0x21, 0x71, 0xe8, 0xea, 0x27, 0x21, 0x39, 0x34,
+	0x7f, 0xb2, 0x6b, 0xb5, 0x22, 0x54, 0x83, 0xf8, 0x01, 0x9a, 0x0d, 0x7a, 0x5e, 0xe3, 0x49, 0x8f,
+	0xf7, 0x78, 0xc3, 0xe5, 0x5e, 0x4b, 0xb6, 0x75, 0xb8, 0x46, 0xeb, 0x17, 0xfb, 0xa1, 0xf5, 0x4a,
+	0xd0, 0xf3, 0xee, 0x2a, 0xd2, 0x6d, 0x4d, 0xd9, 0x0f, 0xad, 0x05, 0xa3, 0x22, 0x8b, 0x13, 0x3a,
+	0xc0, 0x88, 0x9f, 0xa0, 0x45, 0x66, 0xdb, 0xbc, 0x2b, 0x99, 0x67, 0xf3, 0xac, 0x76, 0x13, 0xd8,
+	0x77, 0xfb, 0xa1, 0xb5, 0x90, 0xb0, 0x64, 0x8d, 0x9c, 0x8a, 0xb2, 0xb1, 0x80, 0x4c, 0x68, 0xb1,
+	0x18, 0xe6, 0x68, 0xde, 0xf1, 0xb6, 0xfd, 0x9e, 0xd7, 0xcc, 0xda, 0x33, 0xe1, 0xbf, 0xda, 0x0f,
+	0x2d, 0x0c, 0xf4, 0xac, 0xb1, 0xa5, 0xe8, 0x3c, 0x07, 0x69, 0x84, 0x16, 0x08, 0x90, 0x8f, 0x50,
+	0x45, 0x1f, 0x49, 0xbd, 0xe7, 0x35, 0x5d, 0x6e, 0x02, 0x1d, 0xbd, 0x33, 0xd7, 0xd1, 0xd4, 0xb6,
+	0x86, 0x1b, 0x6d, 0x26, 0xda, 0x90, 0xaf, 0xeb, 0xfd, 0xd0, 0x42, 0x06, 0xde, 0x64, 0x42, 0x59,
+	0x7c, 0x15, 0xae, 0x41, 0x8c, 0x11, 0x9a, 0x62, 0x20, 0xff, 0x2a, 0xa1, 0xa5, 0x02, 0x13, 0x70,
+	0xfa, 0x12, 0x4d, 0x3b, 0x9e, 0x90, 0xcc, 0x75, 0xd3, 0x2f, 0xfd, 0x7a, 0x2e, 0x0b, 0x8c, 0xf0,
+	0xad, 0x14, 0x6b, 0xfd, 0x22, 0xa4, 0x43, 0x46, 0xc1, 0x7e, 0x68, 0xcd, 0x45, 0x11, 0x48, 0x50,
+	0x42, 0x33, 0x4c, 0xf8, 0x3e, 0x9a, 0x09, 0xf8, 0x23, 0x1e, 0x70, 0x75, 0x9c, 0xb6, 0xdf, 0xf3,
+	0x64, 0x26, 0x4b, 0x22, 0xd2, 0x7b, 0x8a, 0x92, 0xca, 0x92, 0x0c, 0xae, 0xb2, 0x24, 0x0b, 0x44,
+	0x7d, 0xc7, 0x26, 0x67, 0xae, 0x6c, 0x47, 0xd7, 0xff, 0x6f, 0x65, 0x34, 0x97, 0x81, 0x61, 0xe7,
+	0xef, 0xa0, 0xe3, 0xdc, 0x63, 0xdb, 0x2e, 0x37, 0x2f, 0xc1, 0x44, 0x7d, 0xb9, 0x1f, 0x5a, 0x11,
+	0xb4, 0x1f, 0x5a, 0xaf, 0x18, 0xa3, 0x00, 0x10, 0x1a, 0x91, 0x94, 0x60, 0x5b, 0xab, 0x32, 0x6f,
+	0x12, 0x08, 0x02, 0x94, 0x08, 0x02, 0x40, 0x68, 0x44, 0xc2, 0xdb, 0x68, 0xde, 0x65, 0x42, 0x36,
+	0x44, 0xcf, 0xb6, 0xb9, 0x10, 0x8d, 0x9e, 0xe7, 0xec, 0x36, 0x3a, 0x42, 0xa7, 0x70, 0xb9, 0x7e,
+	0xb9, 0x1f, 0x5a, 0xaf, 0x2a, 0xfa, 0x3d, 0x43, 0x7e, 0xe0, 0x39, 0xbb, 0x77, 0xd4, 0x35, 0xab,
+	0x18, 0x7d, 0x39, 0x12, 0xa1, 0x79, 0x76, 0xfc, 0x35, 0x84, 0x5c, 0x26, 0xb9, 0x67, 0xef, 0x29,
+	0xcd, 0xa3, 0x5a, 0xf3, 0x5a, 0x3f, 0xb4, 0x26, 0x01, 0xd5, 0x1a, 0x67, 0x23, 0x8d, 0x00, 0x11,
+	0x9a, 0x90, 0x71, 0x1b, 0xcd, 0xdb, 0x2a, 0x40, 0x76, 0x4f, 0x3a, 0x3b, 0xbc, 0xf1, 0x88, 0x39,
+	0x6e, 0x2f, 0xe0, 0xa2, 0x32, 0xa6, 0x0f, 0xe8, 0xed, 0x7e, 0x68, 0xcd, 0xa5, 0xe8, 0x37, 0x81,
+	0xbc, 0x1f, 0x5a, 0x55, 0xa3, 0xb5, 0x80, 0x48, 0x68, 0x91, 0x88, 0xf1, 0x55, 0xc8, 0x06, 0x0f,
+	0x02, 0x3f, 0xa8, 0x8c, 0xeb, 0xf4, 0x06, 0x5f, 0x85, 0xbc, 0xa1, 0xc0, 0xb4, 0xaf, 0x00, 0x69,
+	0x5f, 0xa3, 0xff, 0xab, 0x70, 0x7b, 0x28, 0xef, 0xba, 0x6c, 0x2f, 0x73, 0x7b, 0xc8, 0x6f, 0xc6,
+	0xd0, 0x52, 0x01, 0x11, 0x4e, 0xff, 0xab, 0x68, 0x32, 0xd0, 0xb8, 0xe3, 0xb5, 0xe0, 0xfc, 0xb5,
+	0xe9, 0x18, 0x4c, 0x4c, 0xc7, 0x10, 0xa1, 0x09, 0x39, 0x55, 0x47, 0x46, 0xfe, 0xdb, 0x3a, 0xd2,
+	0x44, 0x73, 0x46, 0x0f, 0x6f, 0x36, 0x9a, 0xdc, 0x75, 0x76, 0x78, 0xe0, 0x70, 0x51, 0x29, 0x27,
+	0x2f, 0x4b, 0x44, 0xbe, 0x1e, 0x53, 0x93, 0x97, 0x25, 0x4f, 0x23, 0xb4, 0x40, 0x00, 0x7f, 0x80,
+	0x66, 0xa5, 0x2f, 0x99, 0x9b, 0x36, 0x61, 0x1e, 0xaf, 0x4b, 0xfd, 0xd0, 0x9a, 0xd1, 0xb4, 0x8c,
+	0xfe, 0xd7, 0x8c, 0xfe, 0x01, 0x02, 0xa1, 0x83, 0xac, 0xf8, 0x36, 0x3a, 0xa1, 0x1e, 0xfb, 0x46,
+	0x64, 0x14, 0x52, 0xe3, 0xac, 0x7a, 0x0b, 0x76, 0x74, 0x69, 0x31, 0x78, 0xf2, 0x16, 0xa4, 0x51,
+	0x42, 0x33, 0x4c, 0xf8, 0x2e, 0x9a, 0x11, 0x92, 0x05, 0x92, 0x37, 0xe3, 0x0b, 0x31, 0xae, 0xd3,
+	0xf6, 0x7c, 0x3f, 0xb4, 0x4e, 0x00, 0x29, 0xbe, 0x0c, 0xf3, 0x46, 0x61, 0x06, 0x26, 0x34, 0xcb,
+	0x86, 0x1f, 0xa1, 0x05, 0x9d, 0x58, 0xdd, 0xc0, 0xd7, 0x3d, 0x61, 0xac, 0xf8, 0xb8, 0x56, 0xac,
+	0x43, 0xac, 0x18, 0xb6, 0x80, 0x1e, 0x6b, 0x5f, 0x4a, 0x92, 0x2d, 0x4b, 0x23, 0xb4, 0x40, 0x00,
+	0xdf, 0x85, 0xd2, 0x39, 0xa1, 0x4b, 0xe7, 0x6a, 0x51, 0xe9, 0x4c, 0x27, 0xdf, 0x11, 0x0a, 0x28,
+	0xf9, 0xa4, 0x8c, 0x66, 0x06, 0xc4, 0xfe, 0x97, 0x96, 0x65, 0x48, 0xaa, 0x8d, 0x7c, 0xf1, 0xa9,
+	0x56, 0xfe, 0x5c, 0x52, 0xad, 0x20, 0x39, 0x46, 0xbf, 0xa8, 0xe4, 0x18, 0xfb, 0x5c, 0x93, 0x83,
+	0x7c, 0x1d, 0x2d, 0xea, 0xe7, 0xe7, 0x9a, 0xad, 0x4a, 0x9e, 0xae, 0xf9, 0x51, 0x61, 0xaf, 0xa1,
+	0x31, 0xd7, 0xe9, 0x38, 0x12, 0xba, 0x5f, 0xdd, 0xc9, 0x6b, 0x20, 0x39, 0x46, 0xbd, 0x24, 0xd4,
+	0xc0, 0xe4, 0xcf, 0x23, 0x68, 0x36, 0xa5, 0xe7, 0x86, 0x27, 0x83, 0x3d, 0xa5, 0x45, 0x77, 0x26,
+	0xe9, 0xef, 0x01, 0x0d, 0x24, 0x5a, 0xf4, 0x92, 0x50, 0x03, 0x2b, 0x01, 0xc7, 0x6b, 0xf2, 0xdd,
+	0xca, 0x48, 0x22, 0xa0, 0x81, 0x44, 0x40, 0x2f, 0x09, 0x35, 0xb0, 0x6a, 0x78, 0xd5, 0x47, 0x4a,
+	0xa5, 0x9c, 0x34, 0xbc, 0x6a, 0x9d, 0x64, 0xae, 0x5a, 0x11, 0xaa, 0x41, 0x7c, 0x15, 0x8d, 0x0b,
+	0xbf, 0x17, 0xd8, 0x5c, 0x9f, 0xd0, 0x64, 0xfd, 0x64, 0x3f, 0xb4, 0x00, 0xd9, 0x0f, 0xad, 0x13,
+	0x70, 0x34, 0x7a, 0x4d, 0x28, 0x10, 0x54, 0x73, 0xbb, 0xed, 0xfa, 0xf6, 0xe3, 0x46, 0x9b, 0x3b,
+	0xad, 0xb6, 0x84, 0x33, 0xd0, 0xcd, 0xad, 0xc6, 0x37, 0x35, 0x9c, 0x34, 0xb7, 0x29, 0x90, 0xd0,
+	0x34, 0x0b, 0x7e, 0x0b, 0x1d, 0x97, 0xbb, 0xa6, 0x51, 0x1a, 0x4f, 0xec, 0xcb, 0x5d, 0x68, 0x92,
+	0xc0, 0xbe, 0x59, 0x13, 0x0a, 0x04, 0xf2, 0xc9, 0x28, 0x54, 0x90, 0xcc, 0x29, 0x41, 0x8d, 0xf8,
+	0x50, 0x75, 0x08, 0x52, 0x67, 0xb3, 0x69, 0x8e, 0xd7, 0x72, 0x37, 0x7c, 0xf0, 0x50, 0xea, 0x6b,
+	0x70, 0xc5, 0x23, 0xc9, 0x74, 0x23, 0x21, 0x4d, 0x92, 0x47, 0x24, 0xfc, 0x3d, 0x54, 0x6d, 0x3b,
+	0xad, 0x76, 0xa3, 0x1b, 0x38, 0x7e, 0xe0, 0xc8, 0xbd, 0xa2, 0xb6, 0xf9, 0x2b, 0xfd, 0xd0, 0x5a,
+	0x54, 0x5c, 0x5b, 0xc0, 0x94, 0xed, 0x36, 0x57, 0xa0, 0xd7, 0x28, 0x66, 0x20, 0x74, 0x98, 0x28,
+	0x66, 0x68, 0x8e, 0x69, 0xdf, 0x8b, 0xba, 0x69, 0xdd, 0x8a, 0xb0, 0x64, 0x6b, 0xb1, 0xb9, 0x4a,
+	0xd4, 0x49, 0x0f, 0x90, 0x08, 0xcd, 0xb3, 0x2b, 0x13, 0x7e, 0xc0, 0x6c, 0x97, 0x17, 0x35, 0xd0,
+	0xda, 0x84, 0x21, 0x17, 0x9a, 0xc8, 0x91, 0x08, 0xcd, 0xb3, 0xab, 0x21, 0x50, 0xdc, 0xa5, 0xbb,
+	0xcc, 0x8b, 0x6c, 0xa8, 0xbb, 0xac, 0xce, 0x6b, 0x39, 0x77, 0x5e, 0x0f, 0x1c, 0x4f, 0xde, 0x61,
+	0x5d, 0x73, 0x56, 0xef, 0xc0, 0x59, 0x45, 0x7d, 0xf9, 0x6d, 0xe6, 0x81, 0x62, 0x91, 0x6b, 0xe4,
+	0x53, 0xb4, 0xa4, 0x91, 0x4f, 0x83, 0xcb, 0xe8, 0xa4, 0x99, 0x6e, 0x41, 0xb0, 0xef, 0x71, 0xaf,
+	0xc9, 0x83, 0xb8, 0x1b, 0xf9, 0x5d, 0x09, 0x9d, 0x2a, 0xa6, 0x43, 0xb2, 0xdd, 0x46, 0x27, 0xf4,
+	0x64, 0xab, 0x21, 0x0c, 0x41, 0xa7, 0xdc, 0xa4, 0x29, 0xaa, 0x9a, 0x00, 0x02, 0x49, 0x51, 0x4d,
+	0xa3, 0x84, 0x66, 0x98, 0x54, 0x83, 0x2d, 0xa4, 0x1f, 0xb0, 0x16, 0x8f, 0xf5, 0x8d, 0x68, 0x7d,
+	0xba, 0xc1, 0x06, 0x52, 0xa2, 0x71, 0x21, 0x7a, 0x38, 0xd3, 0x38, 0xa1, 0x03, 0x8c, 0x64, 0x0e,
+	0xbe, 0x1f, 0xef, 0x3b, 0x1d, 0x1e, 0x44, 0x3b, 0x6b, 0x21, 0x9c, 0x06, 0x61, 0x3b, 0x77, 0xd1,
+	0x98, 0x54, 0x00, 0x7c, 0x50, 0x9c, 0xca, 0x9d, 0x84, 0x66, 0x87, 0xba, 0xb8, 0x0c, 0x07, 0x61,
+	0x44, 0x92, 0xd7, 0x48, 0x2f, 0x09, 0x35, 0x30, 0xd9, 0x84, 0xb9, 0xc8, 0x43, 0x26, 0xbf, 0xf9,
+	0xd4, 0x8b, 0x1d, 0xc0, 0x6f, 0x0e, 0x94, 0xc7, 0xa5, 0xc3, 0xaa, 0x22, 0x91, 0x68, 0x61, 0x40,
+	0x13, 0x78, 0xfd, 0x1d, 0x34, 0xa9, 0x54, 0xf9, 0x0a, 0x04, 0xcf, 0x97, 0x8a, 0xaa, 0xba, 0x96,
+	0x4a, 0x86, 0x25, 0x3b, 0x80, 0x24, 0xc3, 0x92, 0x08, 0x21, 0x34, 0x26, 0x92, 0xdf, 0x47, 0x29,
+	0x60, 0x46, 0x88, 0xe6, 0xe9, 0xb8, 0xcf, 0x77, 0x65, 0xaa, 0x2c, 0x24, 0x96, 0x61, 0x1f, 0x3e,
+	0xe8, 0x85, 0x7d, 0xf8, 0x46, 0xa9, 0x81, 0xd5, 0x93, 0x6b, 0xae, 0x5d, 0x65, 0x24, 0x79, 0xf2,
+	0x0c, 0x92, 0x3c, 0x79, 0x66, 0x4d, 0x28, 0x10, 0xf4, 0x3c, 0xa1, 0xcb, 0xbd, 0x66, 0x03, 0x44,
+	0xcb, 0xba, 0xf9, 0x35, 0xf3, 0x04, 0x85, 0x5f, 0xb3, 0xb3, 0x93, 0x89, 0x14, 0xa8, 0xe6, 0x09,
+	0xa9, 0xd5, 0x16, 0x5a, 0x1e, 0xb2, 0x9f, 0x64, 0x62, 0xe5, 0x3a, 0x1e, 0x8f, 0x72, 0x19, 0xea,
+	0x9c, 0xc7, 0x45, 0xb2, 0x21, 0xbd, 0xd4, 0x75, 0xce, 0xe3, 0xe2, 0xca, 0x1f, 0x4e, 0xa0, 0x31,
+	0xad, 0x12, 0x4b, 0x34, 0x6e, 0xc6, 0xbe, 0x38, 0xff, 0x2d, 0x9a, 0x1f, 0x2e, 0x57, 0x5f, 0x3f,
+	0x98, 0xc9, 0xf8, 0x43, 0xac, 0x1f, 0xfe, 0xe9, 0x9f, 0x3f, 0x1f, 0x59, 0xc2, 0x8b, 0xb5, 0xc1,
+	0x59, 0x39, 0x0c, 0x95, 0x9f, 0xa1, 0x71, 0x33, 0x72, 0x1c, 0x66, 0x35, 0x33, 0xe2, 0xad, 0xbe,
+	0x7e, 0x30, 0x13, 0x58, 0x3d, 0xa3, 0xad, 0xae, 0xe2, 0x95, 0x9c, 0x55, 0x33, 0xb1, 0xac, 0x3d,
+	0xeb, 0x72, 0x1e, 0x3c, 0xc7, 0xdf, 0x47, 0x13, 0x37, 0xa2, 0x11, 0xe6, 0xe9, 0x83, 0x34, 0xc7,
+	0x53, 0xda, 0xea, 0x99, 0xc3, 0xd8, 0xc0, 0x85, 0x35, 0xed, 0xc2, 0x49, 0xbc, 0x34, 0xc4, 0x05,
+	0x2e, 0xf0, 0x0f, 0xd0, 0x71, 0x98, 0xd0, 0xe1, 0x21, 0xdb, 0xca, 0x4e, 0x41, 0xab, 0xa7, 0x0f,
+	0xe1, 0x02, 0xd3, 0x67, 0xb5, 0xe9, 0x35, 0x6c, 0xe5, 0x4c, 0x77, 0x0c, 0x67, 0xb4, 0x7d, 0x17,
+	0x8d, 0xaa, 0xb9, 0x14, 0x5e, 0x2b, 0xd6, 0x9b, 0x9a, 0xe8, 0x55, 0xc9, 0x41, 0x2c, 0x60, 0x77,
+	0x59, 0xdb, 0x5d, 0xc4, 0x0b, 0x39, 0xbb, 0x7a, 0x50, 0xf5, 0xcb, 0x12, 0x9a, 0x4e, 0x0f, 0x44,
+	0xf0, 0xf9, 0x62, 0x9d, 0x05, 0x73, 0x99, 0xea, 0x85, 0xa3, 0xb0, 0x82, 0x1b, 0x6f, 0x69, 0x37,
+	0x36, 0xf0, 0x1b, 0x39, 0x37, 0x60, 0xb4, 0x23, 0x34, 0x7f, 0xed, 0x59, 0x6a, 0xd2, 0xf3, 0x5c,
+	0x65, 0xbf, 0x99, 0x56, 0x0c, 0xcb, 0xc3, 0xcc, 0x88, 0xa3, 0xfa, 0xfa, 0xc1, 0x4c, 0x87, 0x66,
+	0xbf, 0x19, 0x50, 0xe0, 0x9f, 0x94, 0xd0, 0x74, 0xe6, 0xc3, 0x63, 0x48, 0x4c, 0x0a, 0xbe, 0xb6,
+	0xab, 0x17, 0x8e, 0xc2, 0x7a, 0xe8, 0x85, 0x30, 0xdf, 0x16, 0x10, 0x13, 0xfc, 0xe3, 0x12, 0x9a,
+	0x4a, 0x35, 0x58, 0xf8, 0x5c, 0xb1, 0x8d, 0x7c, 0x83, 0x5d, 0x3d, 0x7f, 0x04, 0x4e, 0x70, 0xe6,
+	0xb4, 0x76, 0xc6, 0xc2, 0xcb, 0x39, 0x67, 0xd2, 0xfd, 0x11, 0xfe, 0x45, 0x09, 0xcd, 0x0c, 0x94,
+	0x6e, 0xfc, 0xc6, 0x90, 0x47, 0xa7, 0xb0, 0x03, 0xa8, 0x5e, 0x3a, 0x22, 0x37, 0xf8, 0x75, 0x5e,
+	0xfb, 0xb5, 0x8e, 0xd7, 0xf2, 0x6f, 0x55, 0xd4, 0x2e, 0x42, 0x65, 0xc7, 0x5d, 0x34, 0xa6, 0xab,
+	0x29, 0x1e, 0x72, 0x2f, 0xd2, 0xe5, 0xba, 0xba, 0x7e, 0x20, 0x0f, 0x18, 0x5f, 0xd1, 0xc6, 0x2b,
+	0xf8, 0xb5, 0x9c, 0x71, 0x5d, 0x8a, 0xf1, 0xc7, 0x25, 0x34, 0x11, 0x95, 0xc1, 0x61, 0x6f, 0xd5,
+	0x40, 0x99, 0xae, 0x9e, 0x39, 0x8c, 0x0d, 0x6c, 0x5f, 0xd4, 0xb6, 0x4f, 0xe3, 0xf5, 0xa2, 0x8b,
+	0x6b, 0x4a, 0x73, 0xed, 0x99, 0x29, 0xf8, 0xcf, 0xf1, 0xaf, 0x4a, 0x68, 0x76, 0xb0, 0xfc, 0xe0,
+	0x21, 0x91, 0x1e, 0x52, 0x76, 0xab, 0x1b, 0x47, 0x65, 0x3f, 0xd4, 0x41, 0xf8, 0x25, 0x12, 0x12,
+	0x47, 0xf2, 0x5d, 0x59, 0x7f, 0xf0, 0xe9, 0x8b, 0x95, 0xd2, 0x67, 0x2f, 0x56, 0x4a, 0xff, 0x78,
+	0xb1, 0x52, 0xfa, 0xe9, 0xcb, 0x95, 0x63, 0x9f, 0xbd, 0x5c, 0x39, 0xf6, 0xd7, 0x97, 0x2b, 0xc7,
+	0xbe, 0xfd, 0xe5, 0xd4, 0x8f, 0x43, 0xd7, 0x8c, 0x22, 0xa3, 0x4f, 0xff, 0x38, 0xd4, 0xf2, 0x5d,
+	0xe6, 0xb5, 0xa2, 0x5f, 0x8d, 0x76, 0x53, 0x07, 0xa0, 0x7e, 0x35, 0xda, 0x1e, 0xd7, 0xbf, 0xb4,
+	0x5e, 0xfd, 0xcf, 0x00, 0x9d, 0x4d, 0xc7, 0xbd, 0x39, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the swingset module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Egress queries a provisioned egress.
+	Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error)
+	// Egresses lists the provisioned egresses, in order of peer address.
+	Egresses(ctx context.Context, in *QueryEgressesRequest, opts ...grpc.CallOption) (*QueryEgressesResponse, error)
+	// Return the contents of a peer's outbound mailbox.
+	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
+	// Vats reports per-vat status and kernel queue depths from swing-store state.
+	Vats(ctx context.Context, in *QueryVatsRequest, opts ...grpc.CallOption) (*QueryVatsResponse, error)
+	// BundleStatus reports the installation progress of a bundle submitted by
+	// MsgInstallBundle or MsgInstallBundleChunk.
+	BundleStatus(ctx context.Context, in *QueryBundleStatusRequest, opts ...grpc.CallOption) (*QueryBundleStatusResponse, error)
+	// Health reports whether this node's VM is answering the periodic pings
+	// sent to it over the bridge. The result is local to the queried node.
+	Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error)
+	// ReplayStatus reports the progress of the kernel's replay of vat
+	// transcripts, which can occupy a restarting node for a long time before it
+	// processes a block. The result is local to the queried node.
+	ReplayStatus(ctx context.Context, in *QueryReplayStatusRequest, opts ...grpc.CallOption) (*QueryReplayStatusResponse, error)
+	// ActionQueue reports the actions waiting in the inbound queues, in the
+	// order SwingSet will process them.
+	ActionQueue(ctx context.Context, in *QueryActionQueueRequest, opts ...grpc.CallOption) (*QueryActionQueueResponse, error)
+	// PrioritySenders lists the addresses whose messages are admitted to the
+	// high-priority queue.
+	PrioritySenders(ctx context.Context, in *QueryPrioritySendersRequest, opts ...grpc.CallOption) (*QueryPrioritySendersResponse, error)
+	// Timer reports the status of the SwingSet timer device as of the end of the
+	// latest block, for debugging timers that never fire.
+	Timer(ctx context.Context, in *QueryTimerRequest, opts ...grpc.CallOption) (*QueryTimerResponse, error)
+	// VatOwner returns the billing record of a vat registered by
+	// MsgRegisterVatOwner.
+	VatOwner(ctx context.Context, in *QueryVatOwnerRequest, opts ...grpc.CallOption) (*QueryVatOwnerResponse, error)
+	// WalletActionText renders a smart wallet action as the lines of text that
+	// a signer, such as a hardware wallet, can display in place of its CapData.
+	WalletActionText(ctx context.Context, in *QueryWalletActionTextRequest, opts ...grpc.CallOption) (*QueryWalletActionTextResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Egress(ctx context.Context, in *QueryEgressRequest, opts ...grpc.CallOption) (*QueryEgressResponse, error) {
+	out := new(QueryEgressResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Egress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Egresses(ctx context.Context, in *QueryEgressesRequest, opts ...grpc.CallOption) (*QueryEgressesResponse, error) {
+	out := new(QueryEgressesResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Egresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error) {
+	out := new(QueryMailboxResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Mailbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vats(ctx context.Context, in *QueryVatsRequest, opts ...grpc.CallOption) (*QueryVatsResponse, error) {
+	out := new(QueryVatsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Vats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BundleStatus(ctx context.Context, in *QueryBundleStatusRequest, opts ...grpc.CallOption) (*QueryBundleStatusResponse, error) {
+	out := new(QueryBundleStatusResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/BundleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error) {
+	out := new(QueryHealthResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReplayStatus(ctx context.Context, in *QueryReplayStatusRequest, opts ...grpc.CallOption) (*QueryReplayStatusResponse, error) {
+	out := new(QueryReplayStatusResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/ReplayStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActionQueue(ctx context.Context, in *QueryActionQueueRequest, opts ...grpc.CallOption) (*QueryActionQueueResponse, error) {
+	out := new(QueryActionQueueResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/ActionQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrioritySenders(ctx context.Context, in *QueryPrioritySendersRequest, opts ...grpc.CallOption) (*QueryPrioritySendersResponse, error) {
+	out := new(QueryPrioritySendersResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/PrioritySenders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Timer(ctx context.Context, in *QueryTimerRequest, opts ...grpc.CallOption) (*QueryTimerResponse, error) {
+	out := new(QueryTimerResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/Timer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VatOwner(ctx context.Context, in *QueryVatOwnerRequest, opts ...grpc.CallOption) (*QueryVatOwnerResponse, error) {
+	out := new(QueryVatOwnerResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/VatOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WalletActionText(ctx context.Context, in *QueryWalletActionTextRequest, opts ...grpc.CallOption) (*QueryWalletActionTextResponse, error) {
+	out := new(QueryWalletActionTextResponse)
+	err := c.cc.Invoke(ctx, "/agoric.swingset.Query/WalletActionText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the swingset module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Egress queries a provisioned egress.
+	Egress(context.Context, *QueryEgressRequest) (*QueryEgressResponse, error)
+	// Egresses lists the provisioned egresses, in order of peer address.
+	Egresses(context.Context, *QueryEgressesRequest) (*QueryEgressesResponse, error)
+	// Return the contents of a peer's outbound mailbox.
+	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
+	// Vats reports per-vat status and kernel queue depths from swing-store state.
+	Vats(context.Context, *QueryVatsRequest) (*QueryVatsResponse, error)
+	// BundleStatus reports the installation progress of a bundle submitted by
+	// MsgInstallBundle or MsgInstallBundleChunk.
+	BundleStatus(context.Context, *QueryBundleStatusRequest) (*QueryBundleStatusResponse, error)
+	// Health reports whether this node's VM is answering the periodic pings
+	// sent to it over the bridge. The result is local to the queried node.
+	Health(context.Context, *QueryHealthRequest) (*QueryHealthResponse, error)
+	// ReplayStatus reports the progress of the kernel's replay of vat
+	// transcripts, which can occupy a restarting node for a long time before it
+	// processes a block. The result is local to the queried node.
+	ReplayStatus(context.Context, *QueryReplayStatusRequest) (*QueryReplayStatusResponse, error)
+	// ActionQueue reports the actions waiting in the inbound queues, in the
+	// order SwingSet will process them.
+	ActionQueue(context.Context, *QueryActionQueueRequest) (*QueryActionQueueResponse, error)
+	// PrioritySenders lists the addresses whose messages are admitted to the
+	// high-priority queue.
+	PrioritySenders(context.Context, *QueryPrioritySendersRequest) (*QueryPrioritySendersResponse, error)
+	// Timer reports the status of the SwingSet timer device as of the end of the
+	// latest block, for debugging timers that never fire.
+	Timer(context.Context, *QueryTimerRequest) (*QueryTimerResponse, error)
+	// VatOwner returns the billing record of a vat registered by
+	// MsgRegisterVatOwner.
+	VatOwner(context.Context, *QueryVatOwnerRequest) (*QueryVatOwnerResponse, error)
+	// WalletActionText renders a smart wallet action as the lines of text that
+	// a signer, such as a hardware wallet, can display in place of its CapData.
+	WalletActionText(context.Context, *QueryWalletActionTextRequest) (*QueryWalletActionTextResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Egress(ctx context.Context, req *QueryEgressRequest) (*QueryEgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+}
+func (*UnimplementedQueryServer) Egresses(ctx context.Context, req *QueryEgressesRequest) (*QueryEgressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Egresses not implemented")
+}
+func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
+}
+func (*UnimplementedQueryServer) Vats(ctx context.Context, req *QueryVatsRequest) (*QueryVatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vats not implemented")
+}
+func (*UnimplementedQueryServer) BundleStatus(ctx context.Context, req *QueryBundleStatusRequest) (*QueryBundleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BundleStatus not implemented")
+}
+func (*UnimplementedQueryServer) Health(ctx context.Context, req *QueryHealthRequest) (*QueryHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (*UnimplementedQueryServer) ReplayStatus(ctx context.Context, req *QueryReplayStatusRequest) (*QueryReplayStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayStatus not implemented")
+}
+func (*UnimplementedQueryServer) ActionQueue(ctx context.Context, req *QueryActionQueueRequest) (*QueryActionQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionQueue not implemented")
+}
+func (*UnimplementedQueryServer) PrioritySenders(ctx context.Context, req *QueryPrioritySendersRequest) (*QueryPrioritySendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrioritySenders not implemented")
+}
+func (*UnimplementedQueryServer) Timer(ctx context.Context, req *QueryTimerRequest) (*QueryTimerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timer not implemented")
+}
+func (*UnimplementedQueryServer) VatOwner(ctx context.Context, req *QueryVatOwnerRequest) (*QueryVatOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VatOwner not implemented")
+}
+func (*UnimplementedQueryServer) WalletActionText(ctx context.Context, req *QueryWalletActionTextRequest) (*QueryWalletActionTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletActionText not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Egress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Egress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Egress(ctx, req.(*QueryEgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Egresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEgressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Egresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Egresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Egresses(ctx, req.(*QueryEgressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Mailbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mailbox(ctx, req.(*QueryMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Vats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vats(ctx, req.(*QueryVatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BundleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BundleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/BundleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BundleStatus(ctx, req.(*QueryBundleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Health(ctx, req.(*QueryHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplayStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReplayStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplayStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/ReplayStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplayStatus(ctx, req.(*QueryReplayStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/ActionQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionQueue(ctx, req.(*QueryActionQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrioritySenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrioritySendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrioritySenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/PrioritySenders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrioritySenders(ctx, req.(*QueryPrioritySendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Timer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Timer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/Timer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Timer(ctx, req.(*QueryTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VatOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVatOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VatOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/VatOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VatOwner(ctx, req.(*QueryVatOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WalletActionText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWalletActionTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WalletActionText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.swingset.Query/WalletActionText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WalletActionText(ctx, req.(*QueryWalletActionTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.swingset.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Egress",
+			Handler:    _Query_Egress_Handler,
+		},
+		{
+			MethodName: "Egresses",
+			Handler:    _Query_Egresses_Handler,
+		},
+		{
+			MethodName: "Mailbox",
+			Handler:    _Query_Mailbox_Handler,
+		},
+		{
+			MethodName: "Vats",
+			Handler:    _Query_Vats_Handler,
+		},
+		{
+			MethodName: "BundleStatus",
+			Handler:    _Query_BundleStatus_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Query_Health_Handler,
+		},
+		{
+			MethodName: "ReplayStatus",
+			Handler:    _Query_ReplayStatus_Handler,
+		},
+		{
+			MethodName: "ActionQueue",
+			Handler:    _Query_ActionQueue_Handler,
+		},
+		{
+			MethodName: "PrioritySenders",
+			Handler:    _Query_PrioritySenders_Handler,
+		},
+		{
+			MethodName: "Timer",
+			Handler:    _Query_Timer_Handler,
+		},
+		{
+			MethodName: "VatOwner",
+			Handler:    _Query_VatOwner_Handler,
+		},
+		{
+			MethodName: "WalletActionText",
+			Handler:    _Query_WalletActionText_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/swingset/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SmartWalletProvisionFee) > 0 {
+		for iNdEx := len(m.SmartWalletProvisionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SmartWalletProvisionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Egress != nil {
+		{
+			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEgressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEgressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEgressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEgressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEgressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEgressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Egresses) > 0 {
+		for iNdEx := len(m.Egresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Egresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ack != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Ack))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MailboxMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MailboxMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MailboxMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Num != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VatStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VatStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VatStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotPos != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotPos))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Incarnation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Incarnation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VatID) > 0 {
+		i -= len(m.VatID)
+		copy(dAtA[i:], m.VatID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VatID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InboundQueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundQueueLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AcceptanceQueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AcceptanceQueueLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RunQueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RunQueueLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Vats) > 0 {
+		for iNdEx := len(m.Vats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Installation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatencyMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatencyMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSuccessUnixMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSuccessUnixMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReplayStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReplayStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReplayStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReplayStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReplayStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReplayStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vats) > 0 {
+		for iNdEx := len(m.Vats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastProgressUnixMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastProgressUnixMs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartedUnixMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartedUnixMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VatsReplayed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VatsReplayed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalDeliveries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDeliveries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReplayedDeliveries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReplayedDeliveries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VatID) > 0 {
+		i -= len(m.VatID)
+		copy(dAtA[i:], m.VatID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VatID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Replaying {
+		i--
+		if m.Replaying {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VatReplayStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VatReplayStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VatReplayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastProgressUnixMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastProgressUnixMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartedUnixMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartedUnixMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalDeliveries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDeliveries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReplayedDeliveries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReplayedDeliveries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VatID) > 0 {
+		i -= len(m.VatID)
+		copy(dAtA[i:], m.VatID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VatID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundLaneLengths) > 0 {
+		for iNdEx := len(m.InboundLaneLengths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundLaneLengths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.OracleQueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleQueueLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActionQueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionQueueLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HighPriorityQueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HighPriorityQueueLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrioritySendersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrioritySendersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrioritySendersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrioritySendersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrioritySendersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrioritySendersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageSenders) > 0 {
+		for iNdEx := len(m.StorageSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageSenders[iNdEx])
+			copy(dAtA[i:], m.StorageSenders[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ParamSenders) > 0 {
+		for iNdEx := len(m.ParamSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ParamSenders[iNdEx])
+			copy(dAtA[i:], m.ParamSenders[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ParamSenders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVatOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVatOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVatOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VatId) > 0 {
+		i -= len(m.VatId)
+		copy(dAtA[i:], m.VatId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VatId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVatOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVatOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVatOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VatOwner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWalletActionTextRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWalletActionTextRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWalletActionTextRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendAction {
+		i--
+		if m.SpendAction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWalletActionTextResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWalletActionTextResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWalletActionTextResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Lines[iNdEx])
+			copy(dAtA[i:], m.Lines[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Lines[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SmartWalletProvisionFee) > 0 {
+		for _, e := range m.SmartWalletProvisionFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEgressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEgressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Egresses) > 0 {
+		for _, e := range m.Egresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Ack != 0 {
+		n += 1 + sovQuery(uint64(m.Ack))
+	}
+	return n
+}
+
+func (m *MailboxMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovQuery(uint64(m.Num))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VatStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VatID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Incarnation != 0 {
+		n += 1 + sovQuery(uint64(m.Incarnation))
+	}
+	if m.SnapshotPos != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotPos))
+	}
+	return n
+}
+
+func (m *QueryVatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vats) > 0 {
+		for _, e := range m.Vats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RunQueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.RunQueueLength))
+	}
+	if m.AcceptanceQueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.AcceptanceQueueLength))
+	}
+	if m.InboundQueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.InboundQueueLength))
+	}
+	return n
+}
+
+func (m *QueryBundleStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBundleStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Installation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ReferenceCount != 0 {
+		n += 1 + sovQuery(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *QueryHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.LastSuccessUnixMs != 0 {
+		n += 1 + sovQuery(uint64(m.LastSuccessUnixMs))
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovQuery(uint64(m.LatencyMs))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovQuery(uint64(m.ConsecutiveFailures))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReplayStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReplayStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Replaying {
+		n += 2
+	}
+	l = len(m.VatID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ReplayedDeliveries != 0 {
+		n += 1 + sovQuery(uint64(m.ReplayedDeliveries))
+	}
+	if m.TotalDeliveries != 0 {
+		n += 1 + sovQuery(uint64(m.TotalDeliveries))
+	}
+	if m.VatsReplayed != 0 {
+		n += 1 + sovQuery(uint64(m.VatsReplayed))
+	}
+	if m.StartedUnixMs != 0 {
+		n += 1 + sovQuery(uint64(m.StartedUnixMs))
+	}
+	if m.LastProgressUnixMs != 0 {
+		n += 1 + sovQuery(uint64(m.LastProgressUnixMs))
+	}
+	if len(m.Vats) > 0 {
+		for _, e := range m.Vats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VatReplayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VatID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ReplayedDeliveries != 0 {
+		n += 1 + sovQuery(uint64(m.ReplayedDeliveries))
+	}
+	if m.TotalDeliveries != 0 {
+		n += 1 + sovQuery(uint64(m.TotalDeliveries))
+	}
+	if m.StartedUnixMs != 0 {
+		n += 1 + sovQuery(uint64(m.StartedUnixMs))
+	}
+	if m.LastProgressUnixMs != 0 {
+		n += 1 + sovQuery(uint64(m.LastProgressUnixMs))
+	}
+	return n
+}
+
+func (m *QueryActionQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ActionQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActionQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.HighPriorityQueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.HighPriorityQueueLength))
+	}
+	if m.ActionQueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.ActionQueueLength))
+	}
+	if m.OracleQueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.OracleQueueLength))
+	}
+	if len(m.InboundLaneLengths) > 0 {
+		for _, e := range m.InboundLaneLengths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPrioritySendersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPrioritySendersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParamSenders) > 0 {
+		for _, s := range m.ParamSenders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.StorageSenders) > 0 {
+		for _, s := range m.StorageSenders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTimerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTimerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Timer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVatOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VatId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVatOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VatOwner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWalletActionTextRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SpendAction {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWalletActionTextResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartWalletProvisionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmartWalletProvisionFee = append(m.SmartWalletProvisionFee, types.Coin{})
+			if err := m.SmartWalletProvisionFee[len(m.SmartWalletProvisionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Peer == nil {
+				m.Peer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEgressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEgressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEgressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEgressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEgressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEgressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Egresses = append(m.Egresses, Egress{})
+			if err := m.Egresses[len(m.Egresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Peer == nil {
+				m.Peer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, MailboxMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			m.Ack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ack |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MailboxMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MailboxMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MailboxMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+			}
+			m.Num = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Num |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VatStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VatStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VatStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VatID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incarnation", wireType)
+			}
+			m.Incarnation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Incarnation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotPos", wireType)
+			}
+			m.SnapshotPos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotPos |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vats = append(m.Vats, VatStatus{})
+			if err := m.Vats[len(m.Vats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunQueueLength", wireType)
+			}
+			m.RunQueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunQueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceQueueLength", wireType)
+			}
+			m.AcceptanceQueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceQueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundQueueLength", wireType)
+			}
+			m.InboundQueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundQueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBundleStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBundleStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBundleStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Installation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Installation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessUnixMs", wireType)
+			}
+			m.LastSuccessUnixMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSuccessUnixMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
+			}
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReplayStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReplayStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReplayStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReplayStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReplayStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReplayStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replaying", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replaying = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VatID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayedDeliveries", wireType)
+			}
+			m.ReplayedDeliveries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplayedDeliveries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeliveries", wireType)
+			}
+			m.TotalDeliveries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDeliveries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatsReplayed", wireType)
+			}
+			m.VatsReplayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VatsReplayed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedUnixMs", wireType)
+			}
+			m.StartedUnixMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedUnixMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProgressUnixMs", wireType)
+			}
+			m.LastProgressUnixMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProgressUnixMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vats = append(m.Vats, VatReplayStatus{})
+			if err := m.Vats[len(m.Vats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VatReplayStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VatReplayStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VatReplayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VatID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayedDeliveries", wireType)
+			}
+			m.ReplayedDeliveries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplayedDeliveries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeliveries", wireType)
+			}
+			m.TotalDeliveries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDeliveries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedUnixMs", wireType)
+			}
+			m.StartedUnixMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedUnixMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProgressUnixMs", wireType)
+			}
+			m.LastProgressUnixMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProgressUnixMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryActionQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, ActionQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighPriorityQueueLength", wireType)
+			}
+			m.HighPriorityQueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighPriorityQueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionQueueLength", wireType)
+			}
+			m.ActionQueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionQueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQueueLength", wireType)
+			}
+			m.OracleQueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleQueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundLaneLengths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundLaneLengths = append(m.InboundLaneLengths, UintMapEntry{})
+			if err := m.InboundLaneLengths[len(m.InboundLaneLengths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryEgressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryPrioritySendersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrioritySendersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrioritySendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEgressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryMailboxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPrioritySendersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrioritySendersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrioritySendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamSenders = append(m.ParamSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageSenders = append(m.StorageSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryMailboxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTimerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -790,10 +6901,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -817,7 +6928,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTimerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,15 +6951,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -875,7 +6986,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -900,7 +7011,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVatOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -923,17 +7034,17 @@ func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEgressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVatOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVatOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VatId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -943,25 +7054,23 @@ func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Peer == nil {
-				m.Peer = []byte{}
-			}
+			m.VatId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -984,7 +7093,7 @@ func (m *QueryEgressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVatOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,15 +7116,15 @@ func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEgressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVatOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVatOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VatOwner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1042,10 +7151,7 @@ func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VatOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1070,7 +7176,7 @@ func (m *QueryEgressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWalletActionTextRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,17 +7199,17 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWalletActionTextRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWalletActionTextRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1113,26 +7219,76 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peer = append(m.Peer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Peer == nil {
-				m.Peer = []byte{}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendAction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpendAction = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1154,7 +7310,7 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWalletActionTextResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,15 +7333,15 @@ func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWalletActionTextResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWalletActionTextResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1213,7 +7369,7 @@ func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Lines = append(m.Lines, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
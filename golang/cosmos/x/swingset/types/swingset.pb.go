@@ -170,6 +170,102 @@ type Params struct {
 	// nodes must all serialize and deserialize the existing order without
 	// permuting it.
 	VatCleanupBudget []UintMapEntry `protobuf:"bytes,6,rep,name=vat_cleanup_budget,json=vatCleanupBudget,proto3" json:"vat_cleanup_budget"`
+	// Per-address token bucket limiting how often a smart wallet may submit
+	// MsgWalletSpendAction.  The `capacity` entry is the largest burst of
+	// actions an address may submit, and the `blocks_per_token` entry is the
+	// number of blocks it takes to earn back one action.  An empty list
+	// disables the rate limit.
+	//
+	// There is no required order to this list of entries, but all the chain
+	// nodes must all serialize and deserialize the existing order without
+	// permuting it.
+	WalletSpendActionRateLimit []UintMapEntry `protobuf:"bytes,7,rep,name=wallet_spend_action_rate_limit,json=walletSpendActionRateLimit,proto3" json:"wallet_spend_action_rate_limit"`
+	// Whether to compute a SHA-256 hash chain over the messages crossing the
+	// bridge between BeginBlock and EndBlock of each block, and store its
+	// digest in module state, so that a divergence between validators' VMs is
+	// detected in the block in which it occurs.
+	BridgeMessageHashChain bool `protobuf:"varint,8,opt,name=bridge_message_hash_chain,json=bridgeMessageHashChain,proto3" json:"bridge_message_hash_chain,omitempty"`
+	// Bech32 addresses whose messages are admitted to the high-priority
+	// queue, in addition to those recorded by SwingSet under the
+	// highPrioritySenders vstorage path.  Must not contain duplicates.
+	PrioritySenders []string `protobuf:"bytes,9,rep,name=priority_senders,json=prioritySenders,proto3" json:"priority_senders,omitempty"`
+	// The granularity, in seconds, of the block times delivered to SwingSet
+	// (and hence its timer device) by the BEGIN_BLOCK and END_BLOCK actions,
+	// which are rounded down to a multiple of it.  Coarser times make timer
+	// wakeups less sensitive to the proposer's choice of block time, at the
+	// expense of precision.  Zero or one delivers full-second precision, and
+	// the quantum may be at most an hour.
+	BlockTimeQuantumSeconds uint64 `protobuf:"varint,10,opt,name=block_time_quantum_seconds,json=blockTimeQuantumSeconds,proto3" json:"block_time_quantum_seconds,omitempty"`
+	// The price per computron, in uist (millionths of an IST), at which the
+	// owners of the vats registered by MsgRegisterVatOwner are billed for the
+	// computrons their vats use.  Zero disables billing.  A vat whose owner
+	// cannot pay what it owes is suspended until the debt is paid.
+	//
+	// cost = computrons * computron_price_uist
+	ComputronPriceUist github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=computron_price_uist,json=computronPriceUist,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"computron_price_uist" yaml:"computron_price_uist"`
+	// The run-policy headroom, in beans, below which the inbound queue is
+	// closed to new actions.  SwingSet reports its headroom (the beans left of
+	// the block compute limit) at the end of each block, and while the latest
+	// report is below this threshold, transactions that would add to the
+	// inbound queue are rejected, except for high-priority ones.  Zero
+	// disables this backpressure.
+	MinRunPolicyHeadroom uint64 `protobuf:"varint,12,opt,name=min_run_policy_headroom,json=minRunPolicyHeadroom,proto3" json:"min_run_policy_headroom,omitempty"`
+	// The number of blocks for which the mailbox messages delivered by
+	// MsgDeliverInbound are remembered, by peer and message number.  A
+	// MsgDeliverInbound carrying only messages remembered for its peer, and no
+	// newer ack, is rejected as a replay before reaching SwingSet.  Zero
+	// disables this deduplication.
+	InboundDedupWindowBlocks uint64 `protobuf:"varint,13,opt,name=inbound_dedup_window_blocks,json=inboundDedupWindowBlocks,proto3" json:"inbound_dedup_window_blocks,omitempty"`
+	// The price per uncompressed byte charged to the submitter of each bundle
+	// installed by MsgInstallBundle or MsgInstallBundleChunk, for the storage
+	// the bundle occupies.  An empty list disables the fee.
+	//
+	// fee = uncompressed_size * bundle_storage_fee_per_byte
+	BundleStorageFeePerByte github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,14,rep,name=bundle_storage_fee_per_byte,json=bundleStorageFeePerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"bundle_storage_fee_per_byte"`
+	// The fraction of a bundle's storage fee held as a deposit, which is
+	// refunded to the submitter when SwingSet rejects or garbage-collects the
+	// bundle.  The rest of the fee goes to the fee collector.  Unset is
+	// treated as zero.
+	BundleStorageRefundFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=bundle_storage_refund_fraction,json=bundleStorageRefundFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bundle_storage_refund_fraction" yaml:"bundle_storage_refund_fraction"`
+	// The number of blocks after its installation before an installed bundle
+	// that no vat was created from may be removed by MsgPruneBundles.  Zero
+	// disables pruning.
+	BundlePruneMinAgeBlocks uint64 `protobuf:"varint,16,opt,name=bundle_prune_min_age_blocks,json=bundlePruneMinAgeBlocks,proto3" json:"bundle_prune_min_age_blocks,omitempty"`
+	// Whether to withhold the per-block entropy that the "entropy" bridge port
+	// offers SwingSet, on chains that forbid such randomness.
+	BlockEntropyDisabled bool `protobuf:"varint,17,opt,name=block_entropy_disabled,json=blockEntropyDisabled,proto3" json:"block_entropy_disabled,omitempty"`
+	// Bech32 addresses of the oracle operators allowed to submit
+	// MsgOraclePush, whose price updates bypass the smart wallet and enter
+	// the dedicated oracleQueue.  Must not contain duplicates.
+	OracleOperators []string `protobuf:"bytes,18,rep,name=oracle_operators,json=oracleOperators,proto3" json:"oracle_operators,omitempty"`
+	// The fixed fee that a transaction consisting only of MsgOraclePush from
+	// oracle operators must pay, in place of the fee implied by the
+	// validator's minimum gas prices.  Empty leaves such transactions to the
+	// usual fee rules.
+	OraclePushFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=oracle_push_fee,json=oraclePushFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"oracle_push_fee"`
+	// The lanes into which inbound actions are sorted by their source, each
+	// with its own size limit, and from which x/swingset forwards them to the
+	// queue they were bound for at the end of each block by weighted
+	// round-robin, so that no busy lane can starve the others.  Actions of a lane not listed here
+	// enter the actionQueue, highPriorityQueue, or oracleQueue directly.
+	// Lane names must be unique and among "governance", "oracle", "wallet",
+	// and "ibc".
+	InboundLanes []InboundLane `protobuf:"bytes,20,rep,name=inbound_lanes,json=inboundLanes,proto3" json:"inbound_lanes"`
+	// The number of actions forwarded from the inbound lanes at the end of
+	// each block, or 0 to forward all of them.  Actions outside the lanes do
+	// not count against it.
+	InboundLaneBatchSize uint64 `protobuf:"varint,21,opt,name=inbound_lane_batch_size,json=inboundLaneBatchSize,proto3" json:"inbound_lane_batch_size,omitempty"`
+	// The minimum by denom of an ICS-20 transfer received by an account with
+	// no smart wallet for the chain to provision one automatically, at the
+	// expense of the provision pool.  A transfer qualifies if its amount is
+	// at least that of the coin of its denom, and the receiver can pay the
+	// smart wallet provisioning fee, as for a wallet action.  Empty disables
+	// automatic provisioning on deposit.
+	AutoProvisionMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,22,rep,name=auto_provision_min_deposit,json=autoProvisionMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"auto_provision_min_deposit"`
+	// The number of MsgOraclePush admitted in each block, beyond which they
+	// fail until the next, so that oracle operators cannot crowd out the
+	// highPriorityQueue that the oracleQueue precedes.  Zero admits none.
+	OraclePushesPerBlock uint64 `protobuf:"varint,23,opt,name=oracle_pushes_per_block,json=oraclePushesPerBlock,proto3" json:"oracle_pushes_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -246,11 +342,120 @@ func (m *Params) GetVatCleanupBudget() []UintMapEntry {
 	return nil
 }
 
+func (m *Params) GetWalletSpendActionRateLimit() []UintMapEntry {
+	if m != nil {
+		return m.WalletSpendActionRateLimit
+	}
+	return nil
+}
+
+func (m *Params) GetBridgeMessageHashChain() bool {
+	if m != nil {
+		return m.BridgeMessageHashChain
+	}
+	return false
+}
+
+func (m *Params) GetPrioritySenders() []string {
+	if m != nil {
+		return m.PrioritySenders
+	}
+	return nil
+}
+
+func (m *Params) GetBlockTimeQuantumSeconds() uint64 {
+	if m != nil {
+		return m.BlockTimeQuantumSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMinRunPolicyHeadroom() uint64 {
+	if m != nil {
+		return m.MinRunPolicyHeadroom
+	}
+	return 0
+}
+
+func (m *Params) GetInboundDedupWindowBlocks() uint64 {
+	if m != nil {
+		return m.InboundDedupWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBundleStorageFeePerByte() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BundleStorageFeePerByte
+	}
+	return nil
+}
+
+func (m *Params) GetBundlePruneMinAgeBlocks() uint64 {
+	if m != nil {
+		return m.BundlePruneMinAgeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBlockEntropyDisabled() bool {
+	if m != nil {
+		return m.BlockEntropyDisabled
+	}
+	return false
+}
+
+func (m *Params) GetOracleOperators() []string {
+	if m != nil {
+		return m.OracleOperators
+	}
+	return nil
+}
+
+func (m *Params) GetOraclePushFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OraclePushFee
+	}
+	return nil
+}
+
+func (m *Params) GetInboundLanes() []InboundLane {
+	if m != nil {
+		return m.InboundLanes
+	}
+	return nil
+}
+
+func (m *Params) GetInboundLaneBatchSize() uint64 {
+	if m != nil {
+		return m.InboundLaneBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetAutoProvisionMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AutoProvisionMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetOraclePushesPerBlock() uint64 {
+	if m != nil {
+		return m.OraclePushesPerBlock
+	}
+	return 0
+}
+
 // The current state of the module.
 type State struct {
 	// The allowed number of items to add to queues, as determined by SwingSet.
 	// Transactions which attempt to enqueue more should be rejected.
 	QueueAllowed []QueueSize `protobuf:"bytes,1,rep,name=queue_allowed,json=queueAllowed,proto3" json:"queue_allowed"`
+	// The block time last delivered to SwingSet, in Unix seconds.  The block
+	// times delivered never go below it, so that raising
+	// block_time_quantum_seconds cannot turn SwingSet's timer back.
+	LastBlockTime int64 `protobuf:"varint,2,opt,name=last_block_time,json=lastBlockTime,proto3" json:"last_block_time,omitempty"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -293,26 +498,45 @@ func (m *State) GetQueueAllowed() []QueueSize {
 	return nil
 }
 
-// Map element of a string key to a Nat bean count.
-type StringBeans struct {
-	// What the beans are for.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The actual bean value.
-	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans"`
+func (m *State) GetLastBlockTime() int64 {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return 0
 }
 
-func (m *StringBeans) Reset()         { *m = StringBeans{} }
-func (m *StringBeans) String() string { return proto.CompactTextString(m) }
-func (*StringBeans) ProtoMessage()    {}
-func (*StringBeans) Descriptor() ([]byte, []int) {
+// The status of the SwingSet timer device as of the END_BLOCK of a block, as
+// reported by SwingSet in its reply.
+type TimerStatus struct {
+	// The block time (after quantization) most recently delivered to the timer
+	// device, in seconds since the Unix epoch.
+	LastTimestamp int64 `protobuf:"varint,1,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"lastTimestamp" yaml:"lastTimestamp"`
+	// The height of that block.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// The number of wakeups scheduled but not yet fired, including the next
+	// wakeup of each scheduled repeater.
+	PendingWakeups uint64 `protobuf:"varint,3,opt,name=pending_wakeups,json=pendingWakeups,proto3" json:"pendingWakeups" yaml:"pendingWakeups"`
+	// The number of repeaters that have not been deleted.
+	Repeaters uint64 `protobuf:"varint,4,opt,name=repeaters,proto3" json:"repeaters" yaml:"repeaters"`
+	// The time of the earliest pending wakeup, or zero if there is none.
+	NextWakeup int64 `protobuf:"varint,5,opt,name=next_wakeup,json=nextWakeup,proto3" json:"nextWakeup" yaml:"nextWakeup"`
+	// Whether SwingSet reported the pending wakeups and repeaters, which older
+	// versions do not.
+	Reported bool `protobuf:"varint,6,opt,name=reported,proto3" json:"reported" yaml:"reported"`
+}
+
+func (m *TimerStatus) Reset()         { *m = TimerStatus{} }
+func (m *TimerStatus) String() string { return proto.CompactTextString(m) }
+func (*TimerStatus) ProtoMessage()    {}
+func (*TimerStatus) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{4}
 }
-func (m *StringBeans) XXX_Unmarshal(b []byte) error {
+func (m *TimerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TimerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StringBeans.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TimerStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -322,43 +546,87 @@ func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StringBeans) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringBeans.Merge(m, src)
+func (m *TimerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerStatus.Merge(m, src)
 }
-func (m *StringBeans) XXX_Size() int {
+func (m *TimerStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *StringBeans) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringBeans.DiscardUnknown(m)
+func (m *TimerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringBeans proto.InternalMessageInfo
+var xxx_messageInfo_TimerStatus proto.InternalMessageInfo
 
-func (m *StringBeans) GetKey() string {
+func (m *TimerStatus) GetLastTimestamp() int64 {
 	if m != nil {
-		return m.Key
+		return m.LastTimestamp
 	}
-	return ""
+	return 0
 }
 
-// Map a provisioning power flag to its corresponding fee.
-type PowerFlagFee struct {
-	PowerFlag string                                   `protobuf:"bytes,1,opt,name=power_flag,json=powerFlag,proto3" json:"power_flag,omitempty"`
-	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+func (m *TimerStatus) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
-func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
-func (*PowerFlagFee) ProtoMessage()    {}
-func (*PowerFlagFee) Descriptor() ([]byte, []int) {
+func (m *TimerStatus) GetPendingWakeups() uint64 {
+	if m != nil {
+		return m.PendingWakeups
+	}
+	return 0
+}
+
+func (m *TimerStatus) GetRepeaters() uint64 {
+	if m != nil {
+		return m.Repeaters
+	}
+	return 0
+}
+
+func (m *TimerStatus) GetNextWakeup() int64 {
+	if m != nil {
+		return m.NextWakeup
+	}
+	return 0
+}
+
+func (m *TimerStatus) GetReported() bool {
+	if m != nil {
+		return m.Reported
+	}
+	return false
+}
+
+// The billing record of a vat whose owner pays for the computrons it uses.
+type VatOwner struct {
+	// The ID of the vat, such as "v42".
+	VatID string `protobuf:"bytes,1,opt,name=vat_id,json=vatId,proto3" json:"vatID" yaml:"vatID"`
+	// The bech32 address of the account billed for the vat.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	// The cost in uist accrued by the vat which has not been charged yet,
+	// because it is too small to charge or the owner could not pay it.
+	Unbilled github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=unbilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unbilled" yaml:"unbilled"`
+	// Whether the vat is suspended because its owner could not pay what it
+	// owes.  SwingSet holds the deliveries to a suspended vat until the debt is
+	// paid or the vat's registration is removed.
+	Suspended bool `protobuf:"varint,4,opt,name=suspended,proto3" json:"suspended" yaml:"suspended"`
+}
+
+func (m *VatOwner) Reset()         { *m = VatOwner{} }
+func (m *VatOwner) String() string { return proto.CompactTextString(m) }
+func (*VatOwner) ProtoMessage()    {}
+func (*VatOwner) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{5}
 }
-func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
+func (m *VatOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VatOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PowerFlagFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VatOwner.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -368,53 +636,62 @@ func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PowerFlagFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PowerFlagFee.Merge(m, src)
+func (m *VatOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatOwner.Merge(m, src)
 }
-func (m *PowerFlagFee) XXX_Size() int {
+func (m *VatOwner) XXX_Size() int {
 	return m.Size()
 }
-func (m *PowerFlagFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_PowerFlagFee.DiscardUnknown(m)
+func (m *VatOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatOwner.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PowerFlagFee proto.InternalMessageInfo
+var xxx_messageInfo_VatOwner proto.InternalMessageInfo
 
-func (m *PowerFlagFee) GetPowerFlag() string {
+func (m *VatOwner) GetVatID() string {
 	if m != nil {
-		return m.PowerFlag
+		return m.VatID
 	}
 	return ""
 }
 
-func (m *PowerFlagFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *VatOwner) GetOwner() string {
 	if m != nil {
-		return m.Fee
+		return m.Owner
 	}
-	return nil
+	return ""
 }
 
-// Map element of a string key to a size.
-// TODO: Replace with UintMapEntry?
-type QueueSize struct {
-	// What the size is for.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The actual size value.
-	Size_ int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+func (m *VatOwner) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
 }
 
-func (m *QueueSize) Reset()         { *m = QueueSize{} }
-func (m *QueueSize) String() string { return proto.CompactTextString(m) }
-func (*QueueSize) ProtoMessage()    {}
-func (*QueueSize) Descriptor() ([]byte, []int) {
+// The rate limit token bucket of a single address.
+type RateLimitBucket struct {
+	// The number of actions the address may currently submit.
+	Tokens uint64 `protobuf:"varint,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// The block height as of which tokens was last replenished.
+	LastRefillHeight int64 `protobuf:"varint,2,opt,name=last_refill_height,json=lastRefillHeight,proto3" json:"last_refill_height,omitempty"`
+	// The block height by which the bucket will have refilled to capacity, and
+	// so may be pruned.
+	FullHeight int64 `protobuf:"varint,3,opt,name=full_height,json=fullHeight,proto3" json:"full_height,omitempty"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{6}
 }
-func (m *QueueSize) XXX_Unmarshal(b []byte) error {
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueSize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -424,52 +701,57 @@ func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueSize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueSize.Merge(m, src)
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
 }
-func (m *QueueSize) XXX_Size() int {
+func (m *RateLimitBucket) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueSize) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueSize.DiscardUnknown(m)
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueSize proto.InternalMessageInfo
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
 
-func (m *QueueSize) GetKey() string {
+func (m *RateLimitBucket) GetTokens() uint64 {
 	if m != nil {
-		return m.Key
+		return m.Tokens
 	}
-	return ""
+	return 0
 }
 
-func (m *QueueSize) GetSize_() int32 {
+func (m *RateLimitBucket) GetLastRefillHeight() int64 {
 	if m != nil {
-		return m.Size_
+		return m.LastRefillHeight
 	}
 	return 0
 }
 
-// Map element of a string key to an unsigned integer.
-// The value uses cosmos-sdk Uint rather than a native Go type to ensure that
-// zeroes survive "omitempty" JSON serialization.
-type UintMapEntry struct {
-	Key   string                                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"value"`
+func (m *RateLimitBucket) GetFullHeight() int64 {
+	if m != nil {
+		return m.FullHeight
+	}
+	return 0
 }
 
-func (m *UintMapEntry) Reset()         { *m = UintMapEntry{} }
-func (m *UintMapEntry) String() string { return proto.CompactTextString(m) }
-func (*UintMapEntry) ProtoMessage()    {}
-func (*UintMapEntry) Descriptor() ([]byte, []int) {
+// The rate limit token bucket of an address, as exported in genesis.
+type RateLimitBucketRecord struct {
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Bucket  RateLimitBucket `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket"`
+}
+
+func (m *RateLimitBucketRecord) Reset()         { *m = RateLimitBucketRecord{} }
+func (m *RateLimitBucketRecord) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucketRecord) ProtoMessage()    {}
+func (*RateLimitBucketRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{7}
 }
-func (m *UintMapEntry) XXX_Unmarshal(b []byte) error {
+func (m *RateLimitBucketRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UintMapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateLimitBucketRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UintMapEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateLimitBucketRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -479,45 +761,52 @@ func (m *UintMapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UintMapEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UintMapEntry.Merge(m, src)
+func (m *RateLimitBucketRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucketRecord.Merge(m, src)
 }
-func (m *UintMapEntry) XXX_Size() int {
+func (m *RateLimitBucketRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *UintMapEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_UintMapEntry.DiscardUnknown(m)
+func (m *RateLimitBucketRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucketRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UintMapEntry proto.InternalMessageInfo
+var xxx_messageInfo_RateLimitBucketRecord proto.InternalMessageInfo
 
-func (m *UintMapEntry) GetKey() string {
+func (m *RateLimitBucketRecord) GetAddress() string {
 	if m != nil {
-		return m.Key
+		return m.Address
 	}
 	return ""
 }
 
-// Egress is the format for a swingset egress.
-type Egress struct {
-	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
-	Peer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
-	// TODO: Remove these power flags as they are deprecated and have no effect.
-	PowerFlags []string `protobuf:"bytes,3,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
+func (m *RateLimitBucketRecord) GetBucket() RateLimitBucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return RateLimitBucket{}
 }
 
-func (m *Egress) Reset()         { *m = Egress{} }
-func (m *Egress) String() string { return proto.CompactTextString(m) }
-func (*Egress) ProtoMessage()    {}
-func (*Egress) Descriptor() ([]byte, []int) {
+// An upgrade step enqueued for SwingSet, as exported in genesis.
+type UpgradeStepRecord struct {
+	// The version-qualified name of the step.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The block height at which the step was enqueued.
+	DoneHeight int64 `protobuf:"varint,2,opt,name=done_height,json=doneHeight,proto3" json:"doneHeight" yaml:"doneHeight"`
+}
+
+func (m *UpgradeStepRecord) Reset()         { *m = UpgradeStepRecord{} }
+func (m *UpgradeStepRecord) String() string { return proto.CompactTextString(m) }
+func (*UpgradeStepRecord) ProtoMessage()    {}
+func (*UpgradeStepRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ff9c341e0de15f8b, []int{8}
 }
-func (m *Egress) XXX_Unmarshal(b []byte) error {
+func (m *UpgradeStepRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpgradeStepRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Egress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpgradeStepRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -527,60 +816,59 @@ func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Egress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Egress.Merge(m, src)
+func (m *UpgradeStepRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeStepRecord.Merge(m, src)
 }
-func (m *Egress) XXX_Size() int {
+func (m *UpgradeStepRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *Egress) XXX_DiscardUnknown() {
-	xxx_messageInfo_Egress.DiscardUnknown(m)
+func (m *UpgradeStepRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeStepRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Egress proto.InternalMessageInfo
+var xxx_messageInfo_UpgradeStepRecord proto.InternalMessageInfo
 
-func (m *Egress) GetNickname() string {
+func (m *UpgradeStepRecord) GetName() string {
 	if m != nil {
-		return m.Nickname
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Egress) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *UpgradeStepRecord) GetDoneHeight() int64 {
 	if m != nil {
-		return m.Peer
+		return m.DoneHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *Egress) GetPowerFlags() []string {
-	if m != nil {
-		return m.PowerFlags
-	}
-	return nil
+// The reassembly state of a bundle being uploaded in chunks by
+// MsgInstallBundleChunk.  The chunk payloads are stored separately.
+type BundleUpload struct {
+	// The number of chunks making up the compressed bundle.
+	TotalChunks uint64 `protobuf:"varint,1,opt,name=total_chunks,json=totalChunks,proto3" json:"total_chunks,omitempty"`
+	// The number of distinct chunks received so far.
+	ReceivedChunks uint64 `protobuf:"varint,2,opt,name=received_chunks,json=receivedChunks,proto3" json:"received_chunks,omitempty"`
+	// The total size in bytes of the chunks received so far.
+	ReceivedSize int64 `protobuf:"varint,3,opt,name=received_size,json=receivedSize,proto3" json:"received_size,omitempty"`
+	// Size in bytes of the uncompressed bundle.
+	UncompressedSize int64 `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
+	// The block height at which the incomplete upload is discarded.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-// SwingStoreArtifact encodes an artifact of a swing-store export.
-// Artifacts may be stored or transmitted in any order. Most handlers do
-// maintain the artifact order from their original source as an effect of how
-// they handle the artifacts.
-type SwingStoreArtifact struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" yaml:"data"`
+func (m *BundleUpload) Reset()         { *m = BundleUpload{} }
+func (m *BundleUpload) String() string { return proto.CompactTextString(m) }
+func (*BundleUpload) ProtoMessage()    {}
+func (*BundleUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{9}
 }
-
-func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
-func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
-func (*SwingStoreArtifact) ProtoMessage()    {}
-func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9c341e0de15f8b, []int{9}
-}
-func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
+func (m *BundleUpload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BundleUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SwingStoreArtifact.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BundleUpload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -590,973 +878,5573 @@ func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SwingStoreArtifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwingStoreArtifact.Merge(m, src)
+func (m *BundleUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleUpload.Merge(m, src)
 }
-func (m *SwingStoreArtifact) XXX_Size() int {
+func (m *BundleUpload) XXX_Size() int {
 	return m.Size()
 }
-func (m *SwingStoreArtifact) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwingStoreArtifact.DiscardUnknown(m)
+func (m *BundleUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleUpload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SwingStoreArtifact proto.InternalMessageInfo
+var xxx_messageInfo_BundleUpload proto.InternalMessageInfo
 
-func (m *SwingStoreArtifact) GetName() string {
+func (m *BundleUpload) GetTotalChunks() uint64 {
 	if m != nil {
-		return m.Name
+		return m.TotalChunks
 	}
-	return ""
+	return 0
 }
 
-func (m *SwingStoreArtifact) GetData() []byte {
+func (m *BundleUpload) GetReceivedChunks() uint64 {
 	if m != nil {
-		return m.Data
+		return m.ReceivedChunks
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
-	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
-	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
-	proto.RegisterType((*State)(nil), "agoric.swingset.State")
-	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
-	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
-	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
-	proto.RegisterType((*UintMapEntry)(nil), "agoric.swingset.UintMapEntry")
-	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
-	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+func (m *BundleUpload) GetReceivedSize() int64 {
+	if m != nil {
+		return m.ReceivedSize
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
-
-var fileDescriptor_ff9c341e0de15f8b = []byte{
-	// 897 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0x8e, 0xc9, 0x07, 0xcd, 0x9b, 0x6c, 0x5b, 0x86, 0x4a, 0x1b, 0x2a, 0x36, 0xae, 0x7c, 0xa1,
-	0xd2, 0x6a, 0x93, 0x2d, 0x08, 0x21, 0x65, 0xc5, 0x21, 0x8e, 0xb2, 0x5a, 0x09, 0x2d, 0xca, 0x3a,
-	0x2a, 0x07, 0x04, 0xb2, 0x26, 0xce, 0xc4, 0x4c, 0xeb, 0x78, 0xbc, 0x9e, 0x49, 0xda, 0xee, 0x1f,
-	0x80, 0x23, 0xe2, 0xc4, 0xb1, 0x67, 0x7e, 0xc9, 0x1e, 0xf7, 0x88, 0x38, 0x98, 0x55, 0x7b, 0x41,
-	0x3d, 0xe6, 0x88, 0x84, 0x84, 0xe6, 0x23, 0xae, 0x45, 0x17, 0x51, 0x21, 0xed, 0xc9, 0xf3, 0x7e,
-	0x3f, 0xef, 0xf3, 0x8c, 0x6d, 0x68, 0xe3, 0x90, 0xa5, 0x34, 0xe8, 0xf2, 0x13, 0x1a, 0x87, 0x9c,
-	0x88, 0xfc, 0xd0, 0x49, 0x52, 0x26, 0x18, 0xda, 0xd2, 0xf1, 0xce, 0xda, 0xbd, 0xbb, 0x13, 0xb2,
-	0x90, 0xa9, 0x58, 0x57, 0x9e, 0x74, 0xda, 0x6e, 0x3b, 0x60, 0x7c, 0xce, 0x78, 0x77, 0x82, 0x39,
-	0xe9, 0x2e, 0x0f, 0x26, 0x44, 0xe0, 0x83, 0x6e, 0xc0, 0x68, 0xac, 0xe3, 0xce, 0xf7, 0x16, 0x6c,
-	0x0f, 0x58, 0x4a, 0x86, 0x4b, 0x1c, 0x8d, 0x52, 0x96, 0x30, 0x8e, 0x23, 0xb4, 0x03, 0x55, 0x41,
-	0x45, 0x44, 0x5a, 0xd6, 0x9e, 0xb5, 0x5f, 0xf7, 0xb4, 0x81, 0xf6, 0xa0, 0x31, 0x25, 0x3c, 0x48,
-	0x69, 0x22, 0x28, 0x8b, 0x5b, 0xef, 0xa8, 0x58, 0xd1, 0x85, 0x3e, 0x85, 0x2a, 0x59, 0xe2, 0x88,
-	0xb7, 0xca, 0x7b, 0xe5, 0xfd, 0xc6, 0xc7, 0x1f, 0x74, 0xfe, 0x81, 0xb1, 0xb3, 0x9e, 0xe4, 0x56,
-	0x5e, 0x66, 0x76, 0xc9, 0xd3, 0xd9, 0xbd, 0xca, 0x0f, 0xe7, 0x76, 0xc9, 0xe1, 0xb0, 0xb1, 0x0e,
-	0xa3, 0x1e, 0x34, 0x8f, 0x38, 0x8b, 0xfd, 0x84, 0xa4, 0x73, 0x2a, 0xb8, 0xc6, 0xe1, 0xde, 0x5d,
-	0x65, 0xf6, 0xfb, 0x67, 0x78, 0x1e, 0xf5, 0x9c, 0x62, 0xd4, 0xf1, 0x1a, 0xd2, 0x1c, 0x69, 0x0b,
-	0xdd, 0x87, 0x77, 0x8f, 0xb8, 0x1f, 0xb0, 0x29, 0xd1, 0x10, 0x5d, 0xb4, 0xca, 0xec, 0xcd, 0x75,
-	0x99, 0x0a, 0x38, 0x5e, 0xed, 0x88, 0x0f, 0xe4, 0xe1, 0x75, 0x19, 0x6a, 0x23, 0x9c, 0xe2, 0x39,
-	0x47, 0x4f, 0x60, 0x73, 0x42, 0x70, 0xcc, 0x65, 0x5b, 0x7f, 0x11, 0x53, 0xd1, 0xb2, 0xd4, 0x16,
-	0x1f, 0xde, 0xd8, 0x62, 0x2c, 0x52, 0x1a, 0x87, 0xae, 0x4c, 0x36, 0x8b, 0x34, 0x55, 0xe5, 0x88,
-	0xa4, 0x87, 0x31, 0x15, 0xe8, 0x39, 0x6c, 0xce, 0x08, 0x51, 0x3d, 0xfc, 0x24, 0xa5, 0x81, 0x04,
-	0xa2, 0xf9, 0xd0, 0x62, 0x74, 0xa4, 0x18, 0x1d, 0x23, 0x46, 0x67, 0xc0, 0x68, 0xec, 0x3e, 0x94,
-	0x6d, 0x7e, 0xf9, 0xdd, 0xde, 0x0f, 0xa9, 0xf8, 0x6e, 0x31, 0xe9, 0x04, 0x6c, 0xde, 0x35, 0xca,
-	0xe9, 0xc7, 0x03, 0x3e, 0x3d, 0xee, 0x8a, 0xb3, 0x84, 0x70, 0x55, 0xc0, 0xbd, 0xe6, 0x8c, 0x10,
-	0x39, 0x6d, 0x24, 0x07, 0xa0, 0x87, 0xb0, 0x33, 0x61, 0x4c, 0x70, 0x91, 0xe2, 0xc4, 0x5f, 0x62,
-	0xe1, 0x07, 0x2c, 0x9e, 0xd1, 0xb0, 0x55, 0x56, 0x22, 0xa1, 0x3c, 0xf6, 0x15, 0x16, 0x03, 0x15,
-	0x41, 0x5f, 0xc0, 0x56, 0xc2, 0x4e, 0x48, 0xea, 0xcf, 0x22, 0x1c, 0xfa, 0x33, 0x42, 0x78, 0xab,
-	0xa2, 0x50, 0xde, 0xbb, 0xb1, 0xef, 0x48, 0xe6, 0x3d, 0x8e, 0x70, 0xf8, 0x98, 0x10, 0xb3, 0xf0,
-	0x9d, 0xa4, 0xe0, 0xe3, 0xe8, 0x73, 0xa8, 0x3f, 0x5f, 0x90, 0x05, 0xf1, 0xe7, 0xf8, 0xb4, 0x55,
-	0x55, 0x6d, 0x76, 0x6f, 0xb4, 0x79, 0x26, 0x33, 0xc6, 0xf4, 0xc5, 0xba, 0xc7, 0x86, 0x2a, 0x79,
-	0x8a, 0x4f, 0xd1, 0x33, 0x40, 0x0a, 0x73, 0x44, 0x70, 0xbc, 0x48, 0xfc, 0xc9, 0x62, 0x1a, 0x12,
-	0xd1, 0xaa, 0xfd, 0x0b, 0x9c, 0x43, 0x1a, 0x8b, 0xa7, 0x38, 0x19, 0xc6, 0x22, 0x3d, 0x33, 0xad,
-	0xb6, 0x97, 0x58, 0x0c, 0x74, 0xb5, 0xab, 0x8a, 0x7b, 0x1b, 0x3f, 0x9f, 0xdb, 0xa5, 0x3f, 0xce,
-	0x6d, 0xcb, 0xf9, 0x12, 0xaa, 0x63, 0x81, 0x05, 0x41, 0x43, 0xb8, 0xa3, 0x41, 0xe2, 0x28, 0x62,
-	0x27, 0x64, 0x6a, 0xf4, 0xfd, 0x6f, 0xa0, 0x4d, 0x55, 0xd6, 0xd7, 0x55, 0x4e, 0x04, 0x8d, 0xc2,
-	0x05, 0x40, 0xdb, 0x50, 0x3e, 0x26, 0x67, 0xe6, 0x4d, 0x91, 0x47, 0x34, 0x84, 0xaa, 0xba, 0x0e,
-	0xe6, 0xfa, 0x75, 0x65, 0x8f, 0xdf, 0x32, 0xfb, 0xa3, 0x5b, 0x48, 0x2b, 0x57, 0xf3, 0x74, 0x75,
-	0xaf, 0xa2, 0xd0, 0xff, 0x64, 0x41, 0xb3, 0xc8, 0x3f, 0xba, 0x07, 0x70, 0xad, 0x9b, 0x19, 0x5b,
-	0xcf, 0xd5, 0x40, 0xdf, 0x42, 0x79, 0x46, 0xde, 0xca, 0x85, 0x93, 0x7d, 0x0d, 0xa8, 0xcf, 0xa0,
-	0x9e, 0x73, 0xf4, 0x06, 0x02, 0x10, 0x54, 0x38, 0x7d, 0xa1, 0x5f, 0xbf, 0xaa, 0xa7, 0xce, 0xa6,
-	0x70, 0x0e, 0xcd, 0xa2, 0x7a, 0x6f, 0x26, 0x6f, 0x89, 0xa3, 0x05, 0xf9, 0xdf, 0xe4, 0xa9, 0x6a,
-	0x33, 0xee, 0x2f, 0x0b, 0x6a, 0xc3, 0x30, 0x25, 0x9c, 0xa3, 0x47, 0xb0, 0x11, 0xd3, 0xe0, 0x38,
-	0xc6, 0x73, 0xf3, 0x55, 0x73, 0xed, 0xab, 0xcc, 0xce, 0x7d, 0xab, 0xcc, 0xde, 0xd2, 0x9f, 0x88,
-	0xb5, 0xc7, 0xf1, 0xf2, 0x20, 0xfa, 0x06, 0x2a, 0x09, 0x21, 0xa9, 0xc2, 0xd4, 0x74, 0x9f, 0x5c,
-	0x65, 0xb6, 0xb2, 0x57, 0x99, 0xdd, 0xd0, 0x45, 0xd2, 0x72, 0xfe, 0xcc, 0xec, 0x07, 0xb7, 0x80,
-	0xd9, 0x0f, 0x82, 0xfe, 0x74, 0x2a, 0x41, 0x79, 0xaa, 0x0b, 0xf2, 0xa0, 0x71, 0xad, 0xa8, 0xfe,
-	0x76, 0xd6, 0xdd, 0x83, 0x8b, 0xcc, 0x86, 0x5c, 0x78, 0x7e, 0x95, 0xd9, 0x90, 0x8b, 0xcc, 0x57,
-	0x99, 0xfd, 0x9e, 0x19, 0x9c, 0xfb, 0x1c, 0xaf, 0x90, 0xa0, 0xf6, 0x2f, 0x39, 0x02, 0xd0, 0x58,
-	0x5e, 0xea, 0xb1, 0x60, 0x29, 0xe9, 0xa7, 0x82, 0xce, 0x70, 0x20, 0xd0, 0x7d, 0xa8, 0x14, 0x68,
-	0xb8, 0x2b, 0xb7, 0x31, 0x14, 0x98, 0x6d, 0xf4, 0xfa, 0xca, 0x29, 0x93, 0xa7, 0x58, 0x60, 0xb3,
-	0xba, 0x4a, 0x96, 0xf6, 0x75, 0xb2, 0xb4, 0x1c, 0x4f, 0x39, 0xf5, 0x54, 0xf7, 0xf0, 0xe5, 0x45,
-	0xdb, 0x7a, 0x75, 0xd1, 0xb6, 0x5e, 0x5f, 0xb4, 0xad, 0x1f, 0x2f, 0xdb, 0xa5, 0x57, 0x97, 0xed,
-	0xd2, 0xaf, 0x97, 0xed, 0xd2, 0xd7, 0x8f, 0x0a, 0xf4, 0xf4, 0xf5, 0xef, 0x4d, 0xbf, 0x7b, 0x8a,
-	0x9e, 0x90, 0x45, 0x38, 0x0e, 0xd7, 0xbc, 0x9d, 0x5e, 0xff, 0xf9, 0x14, 0x6f, 0x93, 0x9a, 0xfa,
-	0x61, 0x7d, 0xf2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x66, 0x1a, 0x0f, 0x19, 0x07, 0x00,
-	0x00,
+func (m *BundleUpload) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *BundleUpload) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// A chunk received by a bundle upload, as exported in genesis.
+type BundleUploadChunk struct {
+	// The index of the chunk within the compressed bundle.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BundleUploadChunk) Reset()         { *m = BundleUploadChunk{} }
+func (m *BundleUploadChunk) String() string { return proto.CompactTextString(m) }
+func (*BundleUploadChunk) ProtoMessage()    {}
+func (*BundleUploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{10}
+}
+func (m *BundleUploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleUploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleUploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *BundleUploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleUploadChunk.Merge(m, src)
+}
+func (m *BundleUploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleUploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleUploadChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleUploadChunk proto.InternalMessageInfo
+
+func (m *BundleUploadChunk) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
-		return false
+	return 0
+}
+
+func (m *BundleUploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	for i := range this.BeansPerUnit {
-		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
-			return false
+	return nil
+}
+
+// A chunked bundle upload in progress, as exported in genesis.
+type BundleUploadRecord struct {
+	// The bech32 address of the account uploading the bundle.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The SHA-512 hash of the compressed bundle.
+	BundleHash string       `protobuf:"bytes,2,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	Upload     BundleUpload `protobuf:"bytes,3,opt,name=upload,proto3" json:"upload"`
+	// The chunks received so far.
+	Chunks []BundleUploadChunk `protobuf:"bytes,4,rep,name=chunks,proto3" json:"chunks"`
+}
+
+func (m *BundleUploadRecord) Reset()         { *m = BundleUploadRecord{} }
+func (m *BundleUploadRecord) String() string { return proto.CompactTextString(m) }
+func (*BundleUploadRecord) ProtoMessage()    {}
+func (*BundleUploadRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{11}
+}
+func (m *BundleUploadRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleUploadRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleUploadRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
-		return false
+}
+func (m *BundleUploadRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleUploadRecord.Merge(m, src)
+}
+func (m *BundleUploadRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleUploadRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleUploadRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleUploadRecord proto.InternalMessageInfo
+
+func (m *BundleUploadRecord) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	for i := range this.FeeUnitPrice {
-		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *BundleUploadRecord) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
 	}
-	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
-		return false
+	return ""
+}
+
+func (m *BundleUploadRecord) GetUpload() BundleUpload {
+	if m != nil {
+		return m.Upload
 	}
-	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
-		return false
+	return BundleUpload{}
+}
+
+func (m *BundleUploadRecord) GetChunks() []BundleUploadChunk {
+	if m != nil {
+		return m.Chunks
 	}
-	for i := range this.PowerFlagFees {
-		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
-			return false
+	return nil
+}
+
+// The installation progress of a bundle, keyed by its endoZipBase64Sha512
+// hash.
+type BundleInstallation struct {
+	// One of "pending", "installed", or "rejected".
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status" yaml:"status"`
+	// The reason SwingSet rejected the bundle, if status is "rejected".
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error" yaml:"error"`
+	// The block height at which status was last updated.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height" yaml:"height"`
+}
+
+func (m *BundleInstallation) Reset()         { *m = BundleInstallation{} }
+func (m *BundleInstallation) String() string { return proto.CompactTextString(m) }
+func (*BundleInstallation) ProtoMessage()    {}
+func (*BundleInstallation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{12}
+}
+func (m *BundleInstallation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleInstallation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleInstallation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.QueueMax) != len(that1.QueueMax) {
-		return false
+}
+func (m *BundleInstallation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleInstallation.Merge(m, src)
+}
+func (m *BundleInstallation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleInstallation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleInstallation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleInstallation proto.InternalMessageInfo
+
+func (m *BundleInstallation) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	for i := range this.QueueMax {
-		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *BundleInstallation) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(this.VatCleanupBudget) != len(that1.VatCleanupBudget) {
-		return false
+	return ""
+}
+
+func (m *BundleInstallation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	for i := range this.VatCleanupBudget {
-		if !this.VatCleanupBudget[i].Equal(&that1.VatCleanupBudget[i]) {
-			return false
+	return 0
+}
+
+// The installation progress of a bundle, as exported in genesis.
+type BundleInstallationRecord struct {
+	// The endoZipBase64Sha512 hash of the bundle.
+	BundleHash   string             `protobuf:"bytes,1,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	Installation BundleInstallation `protobuf:"bytes,2,opt,name=installation,proto3" json:"installation"`
+}
+
+func (m *BundleInstallationRecord) Reset()         { *m = BundleInstallationRecord{} }
+func (m *BundleInstallationRecord) String() string { return proto.CompactTextString(m) }
+func (*BundleInstallationRecord) ProtoMessage()    {}
+func (*BundleInstallationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{13}
+}
+func (m *BundleInstallationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleInstallationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleInstallationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *StringBeans) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *BundleInstallationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleInstallationRecord.Merge(m, src)
+}
+func (m *BundleInstallationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleInstallationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleInstallationRecord.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*StringBeans)
-	if !ok {
-		that2, ok := that.(StringBeans)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_BundleInstallationRecord proto.InternalMessageInfo
+
+func (m *BundleInstallationRecord) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *BundleInstallationRecord) GetInstallation() BundleInstallation {
+	if m != nil {
+		return m.Installation
 	}
-	if this.Key != that1.Key {
-		return false
+	return BundleInstallation{}
+}
+
+// The mailbox messages and ack that a peer delivered within the inbound
+// deduplication window, as exported in genesis.
+type DeliveredInboundRecord struct {
+	// The bech32 address of the peer.
+	Peer string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// The latest ack delivered by the peer.
+	Ack uint64 `protobuf:"varint,2,opt,name=ack,proto3" json:"ack,omitempty"`
+	// The mailbox messages remembered until their expiry.
+	Messages []DeliveredInboundMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *DeliveredInboundRecord) Reset()         { *m = DeliveredInboundRecord{} }
+func (m *DeliveredInboundRecord) String() string { return proto.CompactTextString(m) }
+func (*DeliveredInboundRecord) ProtoMessage()    {}
+func (*DeliveredInboundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{14}
+}
+func (m *DeliveredInboundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveredInboundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveredInboundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Beans.Equal(that1.Beans) {
-		return false
+}
+func (m *DeliveredInboundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveredInboundRecord.Merge(m, src)
+}
+func (m *DeliveredInboundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveredInboundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveredInboundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveredInboundRecord proto.InternalMessageInfo
+
+func (m *DeliveredInboundRecord) GetPeer() string {
+	if m != nil {
+		return m.Peer
 	}
-	return true
+	return ""
 }
-func (this *PowerFlagFee) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DeliveredInboundRecord) GetAck() uint64 {
+	if m != nil {
+		return m.Ack
 	}
+	return 0
+}
 
-	that1, ok := that.(*PowerFlagFee)
-	if !ok {
-		that2, ok := that.(PowerFlagFee)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PowerFlag != that1.PowerFlag {
-		return false
-	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
-	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
-		}
+func (m *DeliveredInboundRecord) GetMessages() []DeliveredInboundMessage {
+	if m != nil {
+		return m.Messages
 	}
-	return true
+	return nil
 }
-func (this *QueueSize) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueueSize)
-	if !ok {
-		that2, ok := that.(QueueSize)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// A mailbox message remembered by the inbound deduplication window.
+type DeliveredInboundMessage struct {
+	// The number of the mailbox message.
+	Num uint64 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	// The block height at which the message is forgotten.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiryHeight" yaml:"expiryHeight"`
+}
+
+func (m *DeliveredInboundMessage) Reset()         { *m = DeliveredInboundMessage{} }
+func (m *DeliveredInboundMessage) String() string { return proto.CompactTextString(m) }
+func (*DeliveredInboundMessage) ProtoMessage()    {}
+func (*DeliveredInboundMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{15}
+}
+func (m *DeliveredInboundMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveredInboundMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveredInboundMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if this.Size_ != that1.Size_ {
-		return false
+}
+func (m *DeliveredInboundMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveredInboundMessage.Merge(m, src)
+}
+func (m *DeliveredInboundMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveredInboundMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveredInboundMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveredInboundMessage proto.InternalMessageInfo
+
+func (m *DeliveredInboundMessage) GetNum() uint64 {
+	if m != nil {
+		return m.Num
 	}
-	return true
+	return 0
 }
-func (this *UintMapEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DeliveredInboundMessage) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*UintMapEntry)
-	if !ok {
-		that2, ok := that.(UintMapEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// The refundable part of the storage fee paid for a bundle, keyed by its
+// endoZipBase64Sha512 hash.
+type BundleStorageDeposit struct {
+	// The bech32 address of the account that paid the fee.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter" yaml:"submitter"`
+	// The coins held by the bundle storage pool, to be refunded to submitter.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit" yaml:"deposit"`
+}
+
+func (m *BundleStorageDeposit) Reset()         { *m = BundleStorageDeposit{} }
+func (m *BundleStorageDeposit) String() string { return proto.CompactTextString(m) }
+func (*BundleStorageDeposit) ProtoMessage()    {}
+func (*BundleStorageDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{16}
+}
+func (m *BundleStorageDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleStorageDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleStorageDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if !this.Value.Equal(that1.Value) {
-		return false
+}
+func (m *BundleStorageDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleStorageDeposit.Merge(m, src)
+}
+func (m *BundleStorageDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleStorageDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleStorageDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleStorageDeposit proto.InternalMessageInfo
+
+func (m *BundleStorageDeposit) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return true
+	return ""
 }
-func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *BundleStorageDeposit) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CoreEvalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A bundle storage deposit, as exported in genesis.
+type BundleStorageDepositRecord struct {
+	// The endoZipBase64Sha512 hash of the bundle.
+	BundleHash string               `protobuf:"bytes,1,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundleHash" yaml:"bundleHash"`
+	Deposit    BundleStorageDeposit `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit"`
 }
 
-func (m *CoreEvalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Evals) > 0 {
-		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *BundleStorageDepositRecord) Reset()         { *m = BundleStorageDepositRecord{} }
+func (m *BundleStorageDepositRecord) String() string { return proto.CompactTextString(m) }
+func (*BundleStorageDepositRecord) ProtoMessage()    {}
+func (*BundleStorageDepositRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{17}
+}
+func (m *BundleStorageDepositRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleStorageDepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleStorageDepositRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BundleStorageDepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleStorageDepositRecord.Merge(m, src)
+}
+func (m *BundleStorageDepositRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleStorageDepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleStorageDepositRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleStorageDepositRecord proto.InternalMessageInfo
+
+func (m *BundleStorageDepositRecord) GetBundleHash() string {
+	if m != nil {
+		return m.BundleHash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CoreEval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BundleStorageDepositRecord) GetDeposit() BundleStorageDeposit {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return BundleStorageDeposit{}
 }
 
-func (m *CoreEval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The record of the automatic provisioning of a smart wallet that a deposit
+// paid for and enqueued, which keeps further deposits from enqueueing it again.
+type AutoProvisionRecord struct {
+	// The bech32 address whose smart wallet is provisioned.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The block height at which the provisioning was enqueued.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JsCode) > 0 {
-		i -= len(m.JsCode)
-		copy(dAtA[i:], m.JsCode)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsCode)))
-		i--
-		dAtA[i] = 0x12
+func (m *AutoProvisionRecord) Reset()         { *m = AutoProvisionRecord{} }
+func (m *AutoProvisionRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoProvisionRecord) ProtoMessage()    {}
+func (*AutoProvisionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{18}
+}
+func (m *AutoProvisionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoProvisionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoProvisionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JsonPermits) > 0 {
-		i -= len(m.JsonPermits)
-		copy(dAtA[i:], m.JsonPermits)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsonPermits)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AutoProvisionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoProvisionRecord.Merge(m, src)
+}
+func (m *AutoProvisionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoProvisionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoProvisionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoProvisionRecord proto.InternalMessageInfo
+
+func (m *AutoProvisionRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AutoProvisionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map element of a string key to a Nat bean count.
+type StringBeans struct {
+	// What the beans are for.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The actual bean value.
+	Beans github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=beans,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"beans"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VatCleanupBudget) > 0 {
-		for iNdEx := len(m.VatCleanupBudget) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VatCleanupBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.QueueMax) > 0 {
-		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PowerFlagFees) > 0 {
-		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BootstrapVatConfig) > 0 {
-		i -= len(m.BootstrapVatConfig)
-		copy(dAtA[i:], m.BootstrapVatConfig)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FeeUnitPrice) > 0 {
-		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.BeansPerUnit) > 0 {
-		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *StringBeans) Reset()         { *m = StringBeans{} }
+func (m *StringBeans) String() string { return proto.CompactTextString(m) }
+func (*StringBeans) ProtoMessage()    {}
+func (*StringBeans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{19}
+}
+func (m *StringBeans) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StringBeans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StringBeans.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StringBeans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringBeans.Merge(m, src)
+}
+func (m *StringBeans) XXX_Size() int {
+	return m.Size()
+}
+func (m *StringBeans) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringBeans.DiscardUnknown(m)
 }
 
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StringBeans proto.InternalMessageInfo
+
+func (m *StringBeans) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map a provisioning power flag to its corresponding fee.
+type PowerFlagFee struct {
+	PowerFlag string                                   `protobuf:"bytes,1,opt,name=power_flag,json=powerFlag,proto3" json:"power_flag,omitempty"`
+	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueAllowed) > 0 {
-		for iNdEx := len(m.QueueAllowed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueueAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PowerFlagFee) Reset()         { *m = PowerFlagFee{} }
+func (m *PowerFlagFee) String() string { return proto.CompactTextString(m) }
+func (*PowerFlagFee) ProtoMessage()    {}
+func (*PowerFlagFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{20}
+}
+func (m *PowerFlagFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerFlagFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerFlagFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PowerFlagFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerFlagFee.Merge(m, src)
+}
+func (m *PowerFlagFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerFlagFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerFlagFee.DiscardUnknown(m)
 }
 
-func (m *StringBeans) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PowerFlagFee proto.InternalMessageInfo
+
+func (m *PowerFlagFee) GetPowerFlag() string {
+	if m != nil {
+		return m.PowerFlag
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StringBeans) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PowerFlagFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
 
-func (m *StringBeans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Beans.Size()
-		i -= size
-		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// Map element of a string key to a size.
+// TODO: Replace with UintMapEntry?
+type QueueSize struct {
+	// What the size is for.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The actual size value.
+	Size_ int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *QueueSize) Reset()         { *m = QueueSize{} }
+func (m *QueueSize) String() string { return proto.CompactTextString(m) }
+func (*QueueSize) ProtoMessage()    {}
+func (*QueueSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{21}
+}
+func (m *QueueSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSwingset(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueueSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSize.Merge(m, src)
+}
+func (m *QueueSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueSize proto.InternalMessageInfo
+
+func (m *QueueSize) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PowerFlagFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueSize) GetSize_() int32 {
+	if m != nil {
+		return m.Size_
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PowerFlagFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InboundLane configures a lane of inbound actions.
+type InboundLane struct {
+	// The name of the lane.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	// The number of the lane's actions forwarded in each round of the
+	// scheduler, at least 1.
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight" yaml:"weight"`
+	// The number of actions that may wait in the lane before further
+	// transactions bound for it are rejected, or 0 for no limit of its own.
+	MaxSize int32 `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"maxSize" yaml:"maxSize"`
 }
 
-func (m *PowerFlagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSwingset(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *InboundLane) Reset()         { *m = InboundLane{} }
+func (m *InboundLane) String() string { return proto.CompactTextString(m) }
+func (*InboundLane) ProtoMessage()    {}
+func (*InboundLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{22}
+}
+func (m *InboundLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PowerFlag) > 0 {
-		i -= len(m.PowerFlag)
-		copy(dAtA[i:], m.PowerFlag)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlag)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueueSize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *InboundLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundLane.Merge(m, src)
 }
-
-func (m *QueueSize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InboundLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundLane.DiscardUnknown(m)
 }
 
-func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_InboundLane proto.InternalMessageInfo
+
+func (m *InboundLane) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *InboundLane) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UintMapEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InboundLane) GetMaxSize() int32 {
+	if m != nil {
+		return m.MaxSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UintMapEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Map element of a string key to an unsigned integer.
+// The value uses cosmos-sdk Uint rather than a native Go type to ensure that
+// zeroes survive "omitempty" JSON serialization.
+type UintMapEntry struct {
+	Key   string                                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"value"`
 }
 
-func (m *UintMapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Value.Size()
-		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *UintMapEntry) Reset()         { *m = UintMapEntry{} }
+func (m *UintMapEntry) String() string { return proto.CompactTextString(m) }
+func (*UintMapEntry) ProtoMessage()    {}
+func (*UintMapEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{23}
+}
+func (m *UintMapEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UintMapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UintMapEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSwingset(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UintMapEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UintMapEntry.Merge(m, src)
+}
+func (m *UintMapEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UintMapEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UintMapEntry.DiscardUnknown(m)
 }
 
-func (m *Egress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UintMapEntry proto.InternalMessageInfo
+
+func (m *UintMapEntry) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Egress is the format for a swingset egress.
+type Egress struct {
+	Nickname string                                        `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname" yaml:"nickname"`
+	Peer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=peer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"peer" yaml:"peer"`
+	// TODO: Remove these power flags as they are deprecated and have no effect.
+	PowerFlags []string `protobuf:"bytes,3,rep,name=power_flags,json=powerFlags,proto3" json:"powerFlags" yaml:"powerFlags"`
+	// The block height at which the peer was first provisioned, or zero if it
+	// was provisioned before the height was recorded.
+	CreatedHeight int64 `protobuf:"varint,4,opt,name=created_height,json=createdHeight,proto3" json:"createdHeight,omitempty" yaml:"createdHeight"`
 }
 
-func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PowerFlags) > 0 {
-		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerFlags[iNdEx])
-			copy(dAtA[i:], m.PowerFlags[iNdEx])
-			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *Egress) Reset()         { *m = Egress{} }
+func (m *Egress) String() string { return proto.CompactTextString(m) }
+func (*Egress) ProtoMessage()    {}
+func (*Egress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{24}
+}
+func (m *Egress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Egress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Egress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Egress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Egress.Merge(m, src)
+}
+func (m *Egress) XXX_Size() int {
+	return m.Size()
+}
+func (m *Egress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Egress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Egress proto.InternalMessageInfo
+
+func (m *Egress) GetNickname() string {
+	if m != nil {
+		return m.Nickname
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Egress) GetPeer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Egress) GetPowerFlags() []string {
+	if m != nil {
+		return m.PowerFlags
+	}
+	return nil
 }
 
-func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+func (m *Egress) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwingset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// SwingStoreArtifact encodes an artifact of a swing-store export.
+// Artifacts may be stored or transmitted in any order. Most handlers do
+// maintain the artifact order from their original source as an effect of how
+// they handle the artifacts.
+type SwingStoreArtifact struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data" yaml:"data"`
 }
-func (m *CoreEvalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	if len(m.Evals) > 0 {
-		for _, e := range m.Evals {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+
+func (m *SwingStoreArtifact) Reset()         { *m = SwingStoreArtifact{} }
+func (m *SwingStoreArtifact) String() string { return proto.CompactTextString(m) }
+func (*SwingStoreArtifact) ProtoMessage()    {}
+func (*SwingStoreArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9c341e0de15f8b, []int{25}
+}
+func (m *SwingStoreArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwingStoreArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwingStoreArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SwingStoreArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwingStoreArtifact.Merge(m, src)
+}
+func (m *SwingStoreArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwingStoreArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwingStoreArtifact.DiscardUnknown(m)
 }
 
-func (m *CoreEval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JsonPermits)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+var xxx_messageInfo_SwingStoreArtifact proto.InternalMessageInfo
+
+func (m *SwingStoreArtifact) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	l = len(m.JsCode)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	return ""
+}
+
+func (m *SwingStoreArtifact) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return n
+	return nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*CoreEvalProposal)(nil), "agoric.swingset.CoreEvalProposal")
+	proto.RegisterType((*CoreEval)(nil), "agoric.swingset.CoreEval")
+	proto.RegisterType((*Params)(nil), "agoric.swingset.Params")
+	proto.RegisterType((*State)(nil), "agoric.swingset.State")
+	proto.RegisterType((*TimerStatus)(nil), "agoric.swingset.TimerStatus")
+	proto.RegisterType((*VatOwner)(nil), "agoric.swingset.VatOwner")
+	proto.RegisterType((*RateLimitBucket)(nil), "agoric.swingset.RateLimitBucket")
+	proto.RegisterType((*RateLimitBucketRecord)(nil), "agoric.swingset.RateLimitBucketRecord")
+	proto.RegisterType((*UpgradeStepRecord)(nil), "agoric.swingset.UpgradeStepRecord")
+	proto.RegisterType((*BundleUpload)(nil), "agoric.swingset.BundleUpload")
+	proto.RegisterType((*BundleUploadChunk)(nil), "agoric.swingset.BundleUploadChunk")
+	proto.RegisterType((*BundleUploadRecord)(nil), "agoric.swingset.BundleUploadRecord")
+	proto.RegisterType((*BundleInstallation)(nil), "agoric.swingset.BundleInstallation")
+	proto.RegisterType((*BundleInstallationRecord)(nil), "agoric.swingset.BundleInstallationRecord")
+	proto.RegisterType((*DeliveredInboundRecord)(nil), "agoric.swingset.DeliveredInboundRecord")
+	proto.RegisterType((*DeliveredInboundMessage)(nil), "agoric.swingset.DeliveredInboundMessage")
+	proto.RegisterType((*BundleStorageDeposit)(nil), "agoric.swingset.BundleStorageDeposit")
+	proto.RegisterType((*BundleStorageDepositRecord)(nil), "agoric.swingset.BundleStorageDepositRecord")
+	proto.RegisterType((*AutoProvisionRecord)(nil), "agoric.swingset.AutoProvisionRecord")
+	proto.RegisterType((*StringBeans)(nil), "agoric.swingset.StringBeans")
+	proto.RegisterType((*PowerFlagFee)(nil), "agoric.swingset.PowerFlagFee")
+	proto.RegisterType((*QueueSize)(nil), "agoric.swingset.QueueSize")
+	proto.RegisterType((*InboundLane)(nil), "agoric.swingset.InboundLane")
+	proto.RegisterType((*UintMapEntry)(nil), "agoric.swingset.UintMapEntry")
+	proto.RegisterType((*Egress)(nil), "agoric.swingset.Egress")
+	proto.RegisterType((*SwingStoreArtifact)(nil), "agoric.swingset.SwingStoreArtifact")
+}
+
+func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
+
+var fileDescriptor_ff9c341e0de15f8b = []byte{
+	// 2512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x24, 0xc5,
+	0xd9, 0xf7, 0xac, 0xed, 0x59, 0xbb, 0x66, 0xfc, 0xb1, 0x85, 0x59, 0x37, 0x5e, 0x70, 0x9b, 0x5e,
+	0x01, 0x46, 0x0b, 0x36, 0xb0, 0x2f, 0x7a, 0xc3, 0x6e, 0x08, 0xf1, 0xd8, 0x5e, 0x96, 0x04, 0x0b,
+	0xd3, 0x66, 0x41, 0x42, 0x89, 0x5a, 0x35, 0xdd, 0x8f, 0x67, 0x0a, 0x77, 0x77, 0xf5, 0x56, 0x55,
+	0xfb, 0x83, 0x4b, 0xa4, 0x5c, 0x82, 0x72, 0x49, 0x94, 0x53, 0x0e, 0x39, 0xa0, 0xdc, 0x92, 0x0b,
+	0x7f, 0x44, 0x2e, 0x1c, 0xc9, 0x2d, 0x89, 0xa2, 0x4e, 0xb4, 0x5c, 0xa2, 0x39, 0xfa, 0x12, 0x29,
+	0xa7, 0xa8, 0x3e, 0x7a, 0xba, 0xc7, 0xde, 0x05, 0x83, 0xc8, 0x69, 0xaa, 0x7e, 0xcf, 0x47, 0x3d,
+	0x55, 0xcf, 0x47, 0x3d, 0x5d, 0x83, 0x96, 0x49, 0x8f, 0x71, 0x1a, 0xae, 0x8b, 0x23, 0x9a, 0xf6,
+	0x04, 0xc8, 0xe1, 0x60, 0x2d, 0xe3, 0x4c, 0x32, 0x3c, 0x67, 0xe8, 0x6b, 0x25, 0xbc, 0xb4, 0xd0,
+	0x63, 0x3d, 0xa6, 0x69, 0xeb, 0x6a, 0x64, 0xd8, 0x96, 0x96, 0x43, 0x26, 0x12, 0x26, 0xd6, 0xbb,
+	0x44, 0xc0, 0xfa, 0xe1, 0xcb, 0x5d, 0x90, 0xe4, 0xe5, 0xf5, 0x90, 0xd1, 0xd4, 0xd0, 0xbd, 0x5f,
+	0x34, 0xd0, 0xfc, 0x26, 0xe3, 0xb0, 0x7d, 0x48, 0xe2, 0x5d, 0xce, 0x32, 0x26, 0x48, 0x8c, 0x17,
+	0xd0, 0xa4, 0xa4, 0x32, 0x06, 0xa7, 0xb1, 0xd2, 0x58, 0x9d, 0xf6, 0xcd, 0x04, 0xaf, 0xa0, 0x56,
+	0x04, 0x22, 0xe4, 0x34, 0x93, 0x94, 0xa5, 0xce, 0x25, 0x4d, 0xab, 0x43, 0xf8, 0x55, 0x34, 0x09,
+	0x87, 0x24, 0x16, 0xce, 0xf8, 0xca, 0xf8, 0x6a, 0xeb, 0x95, 0x27, 0xd6, 0xce, 0xd8, 0xb8, 0x56,
+	0xae, 0xd4, 0x99, 0xf8, 0xbc, 0x70, 0xc7, 0x7c, 0xc3, 0x7d, 0x6b, 0xe2, 0x93, 0x4f, 0xdd, 0x31,
+	0x4f, 0xa0, 0xa9, 0x92, 0x8c, 0x6f, 0xa1, 0xf6, 0x47, 0x82, 0xa5, 0x41, 0x06, 0x3c, 0xa1, 0x52,
+	0x18, 0x3b, 0x3a, 0x8b, 0xa7, 0x85, 0xfb, 0xd8, 0x09, 0x49, 0xe2, 0x5b, 0x5e, 0x9d, 0xea, 0xf9,
+	0x2d, 0x35, 0xdd, 0x35, 0x33, 0x7c, 0x03, 0x5d, 0xfe, 0x48, 0x04, 0x21, 0x8b, 0xc0, 0x98, 0xd8,
+	0xc1, 0xa7, 0x85, 0x3b, 0x5b, 0x8a, 0x69, 0x82, 0xe7, 0x37, 0x3f, 0x12, 0x9b, 0x6a, 0x30, 0x98,
+	0x45, 0xcd, 0x5d, 0xc2, 0x49, 0x22, 0xf0, 0x5d, 0x34, 0xdb, 0x05, 0x92, 0x0a, 0xa5, 0x36, 0xc8,
+	0x53, 0x2a, 0x9d, 0x86, 0xde, 0xc5, 0x93, 0xe7, 0x76, 0xb1, 0x27, 0x39, 0x4d, 0x7b, 0x1d, 0xc5,
+	0x6c, 0x37, 0xd2, 0xd6, 0x92, 0xbb, 0xc0, 0xef, 0xa5, 0x54, 0xe2, 0xfb, 0x68, 0x76, 0x1f, 0x40,
+	0xeb, 0x08, 0x32, 0x4e, 0x43, 0x65, 0x88, 0x39, 0x0f, 0xe3, 0x8c, 0x35, 0xe5, 0x8c, 0x35, 0xeb,
+	0x8c, 0xb5, 0x4d, 0x46, 0xd3, 0xce, 0x4b, 0x4a, 0xcd, 0x1f, 0xff, 0xe1, 0xae, 0xf6, 0xa8, 0xec,
+	0xe7, 0xdd, 0xb5, 0x90, 0x25, 0xeb, 0xd6, 0x73, 0xe6, 0xe7, 0x45, 0x11, 0x1d, 0xac, 0xcb, 0x93,
+	0x0c, 0x84, 0x16, 0x10, 0x7e, 0x7b, 0x1f, 0x40, 0xad, 0xb6, 0xab, 0x16, 0xc0, 0x2f, 0xa1, 0x85,
+	0x2e, 0x63, 0x52, 0x48, 0x4e, 0xb2, 0xe0, 0x90, 0xc8, 0x20, 0x64, 0xe9, 0x3e, 0xed, 0x39, 0xe3,
+	0xda, 0x49, 0x78, 0x48, 0x7b, 0x9f, 0xc8, 0x4d, 0x4d, 0xc1, 0x3f, 0x46, 0x73, 0x19, 0x3b, 0x02,
+	0x1e, 0xec, 0xc7, 0xa4, 0x17, 0xec, 0x03, 0x08, 0x67, 0x42, 0x5b, 0xf9, 0xd4, 0xb9, 0xfd, 0xee,
+	0x2a, 0xbe, 0x3b, 0x31, 0xe9, 0xdd, 0x01, 0xb0, 0x1b, 0x9e, 0xc9, 0x6a, 0x98, 0xc0, 0xaf, 0xa3,
+	0xe9, 0xfb, 0x39, 0xe4, 0x10, 0x24, 0xe4, 0xd8, 0x99, 0xd4, 0x6a, 0x96, 0xce, 0xa9, 0x79, 0x57,
+	0x71, 0xec, 0xd1, 0x8f, 0x4b, 0x1d, 0x53, 0x5a, 0x64, 0x87, 0x1c, 0xe3, 0x77, 0x11, 0xd6, 0x36,
+	0xc7, 0x40, 0xd2, 0x3c, 0x0b, 0xba, 0x79, 0xd4, 0x03, 0xe9, 0x34, 0x1f, 0x61, 0xce, 0x3d, 0x9a,
+	0xca, 0x1d, 0x92, 0x6d, 0xa7, 0x92, 0x9f, 0x58, 0x55, 0xf3, 0x87, 0x44, 0x6e, 0x1a, 0xe9, 0x8e,
+	0x16, 0xc6, 0x3d, 0xb4, 0x7c, 0x44, 0xe2, 0x18, 0x64, 0x20, 0x32, 0x48, 0xa3, 0x80, 0x84, 0x2a,
+	0x42, 0x03, 0x4e, 0x24, 0x04, 0x31, 0x4d, 0xa8, 0x74, 0x2e, 0x5f, 0x5c, 0xfd, 0x92, 0x51, 0xb5,
+	0xa7, 0x34, 0x6d, 0x68, 0x45, 0x3e, 0x91, 0xf0, 0xb6, 0x52, 0x83, 0x5f, 0x43, 0x4f, 0x74, 0x39,
+	0x8d, 0x7a, 0x10, 0x24, 0x20, 0x04, 0xe9, 0x41, 0xd0, 0x27, 0xa2, 0x1f, 0x84, 0x7d, 0x42, 0x53,
+	0x67, 0x6a, 0xa5, 0xb1, 0x3a, 0xe5, 0x5f, 0x35, 0x0c, 0x3b, 0x86, 0x7e, 0x97, 0x88, 0xfe, 0xa6,
+	0xa2, 0xe2, 0xe7, 0xd1, 0x7c, 0xc6, 0x29, 0xe3, 0x54, 0x9e, 0x04, 0x02, 0xd2, 0x08, 0xb8, 0x70,
+	0xa6, 0x57, 0xc6, 0x57, 0xa7, 0xfd, 0xb9, 0x12, 0xdf, 0x33, 0x30, 0xbe, 0x8d, 0x96, 0xba, 0x31,
+	0x0b, 0x0f, 0x02, 0x49, 0x13, 0x08, 0xee, 0xe7, 0x24, 0x95, 0x79, 0x12, 0x08, 0x08, 0x59, 0x1a,
+	0x09, 0x07, 0xad, 0x34, 0x56, 0x27, 0xfc, 0x45, 0xcd, 0xf1, 0x1e, 0x4d, 0xe0, 0x5d, 0x43, 0xdf,
+	0x33, 0x64, 0xfc, 0x33, 0xb4, 0x10, 0xb2, 0x24, 0xcb, 0x25, 0x57, 0x49, 0xa3, 0xe2, 0x25, 0xc8,
+	0xa9, 0x90, 0x4e, 0x4b, 0xa7, 0xc7, 0x8e, 0xda, 0xe2, 0xdf, 0x0a, 0xf7, 0xd9, 0x0b, 0x84, 0xde,
+	0x16, 0x84, 0xa7, 0x85, 0x7b, 0xcd, 0x24, 0xd3, 0xc3, 0x74, 0x7a, 0x3e, 0x1e, 0xc2, 0x3a, 0x32,
+	0xef, 0x51, 0x21, 0xf1, 0xab, 0x68, 0x31, 0xa1, 0x69, 0xc0, 0xf3, 0x34, 0xc8, 0x58, 0x4c, 0xc3,
+	0x93, 0xa0, 0x0f, 0x24, 0xe2, 0x8c, 0x25, 0x4e, 0x5b, 0x9b, 0xbe, 0x90, 0xd0, 0xd4, 0xcf, 0xd3,
+	0x5d, 0x4d, 0xbc, 0x6b, 0x69, 0xf8, 0x75, 0x74, 0x8d, 0xa6, 0x5d, 0x96, 0xa7, 0x51, 0x10, 0x41,
+	0x94, 0x67, 0xc1, 0x11, 0x4d, 0x23, 0x76, 0x14, 0xe8, 0x7d, 0x0a, 0x67, 0x46, 0x8b, 0x3a, 0x96,
+	0x65, 0x4b, 0x71, 0x7c, 0xa0, 0x19, 0x3a, 0x9a, 0x8e, 0x7f, 0xd5, 0x40, 0xd7, 0xba, 0x79, 0x1a,
+	0xc5, 0x10, 0x08, 0xc9, 0xb8, 0x72, 0x8d, 0x4a, 0x4b, 0x95, 0xde, 0xdd, 0x13, 0x09, 0xce, 0xac,
+	0x4d, 0xef, 0x87, 0x25, 0xe5, 0x16, 0x84, 0x3a, 0x2f, 0x6f, 0xda, 0xbc, 0xbc, 0x71, 0xb1, 0xc3,
+	0x31, 0xa9, 0xb9, 0x68, 0x56, 0xdd, 0x33, 0x8b, 0xde, 0x01, 0xd8, 0x05, 0xde, 0x39, 0x91, 0x80,
+	0x7f, 0xd7, 0x40, 0xcb, 0x67, 0x2c, 0xe2, 0xb0, 0xaf, 0xf6, 0xb7, 0xcf, 0x4d, 0x80, 0x3a, 0x73,
+	0xda, 0x27, 0x1f, 0x7c, 0x63, 0x9f, 0x3c, 0x63, 0x7c, 0xf2, 0xd5, 0xda, 0x3d, 0xff, 0xda, 0x88,
+	0x69, 0xbe, 0x26, 0xdf, 0xb1, 0x54, 0xfc, 0xfd, 0xe1, 0x79, 0x65, 0x3c, 0x4f, 0x21, 0x50, 0x3e,
+	0x53, 0x5a, 0xec, 0x79, 0xcf, 0xdb, 0x28, 0xd3, 0x2c, 0xbb, 0x8a, 0x63, 0x87, 0xa6, 0x1b, 0x3d,
+	0xb0, 0xc7, 0xfd, 0x7f, 0xe8, 0xaa, 0x09, 0x51, 0x48, 0x25, 0x67, 0xd9, 0x49, 0x10, 0x51, 0x41,
+	0xba, 0x31, 0x44, 0xce, 0x15, 0x9d, 0x05, 0x0b, 0x9a, 0xba, 0x6d, 0x88, 0x5b, 0x96, 0xa6, 0x72,
+	0x80, 0x71, 0x12, 0xc6, 0x10, 0xb0, 0x0c, 0x38, 0x91, 0x8c, 0x0b, 0x07, 0x9b, 0x1c, 0x30, 0xf8,
+	0x3b, 0x25, 0x8c, 0x05, 0xb2, 0x50, 0x90, 0xe5, 0xa2, 0xaf, 0x7c, 0xe9, 0x3c, 0xf6, 0xdd, 0xd7,
+	0xd5, 0x19, 0xb3, 0xc6, 0x6e, 0x2e, 0xfa, 0x77, 0x00, 0xf0, 0x9b, 0x68, 0xa6, 0x8c, 0xc1, 0x98,
+	0xa4, 0x20, 0x9c, 0x85, 0x47, 0x5c, 0x0a, 0x6f, 0x19, 0xae, 0xb7, 0x49, 0x5a, 0xd6, 0xb7, 0x36,
+	0xad, 0x20, 0xa1, 0x72, 0xa0, 0xae, 0x28, 0xe8, 0x12, 0x19, 0xf6, 0x03, 0x41, 0x3f, 0x06, 0xe7,
+	0x71, 0x93, 0x03, 0x35, 0xf6, 0x8e, 0x22, 0xaa, 0x52, 0x89, 0x3f, 0x69, 0xa0, 0x25, 0x92, 0x4b,
+	0x16, 0x64, 0x9c, 0x1d, 0x52, 0xa1, 0x6a, 0x98, 0x72, 0x4b, 0x04, 0x19, 0x13, 0x54, 0x3a, 0x57,
+	0xbf, 0xfb, 0x03, 0x58, 0x54, 0xcb, 0xed, 0x96, 0xab, 0xed, 0xd0, 0x74, 0xcb, 0xac, 0xa5, 0x76,
+	0x50, 0x3b, 0x7f, 0x30, 0x17, 0xa5, 0x76, 0xaa, 0xb3, 0x68, 0x76, 0x50, 0x1d, 0x1d, 0xa8, 0xcb,
+	0x50, 0x07, 0xc6, 0xad, 0xa9, 0xdf, 0x7e, 0xea, 0x8e, 0xfd, 0xeb, 0x53, 0xb7, 0xe1, 0x1d, 0xa2,
+	0xc9, 0x3d, 0x49, 0x24, 0xe0, 0x6d, 0x34, 0x63, 0xae, 0x0b, 0x12, 0xc7, 0xec, 0x08, 0x22, 0xa7,
+	0x71, 0xc1, 0x2b, 0xa3, 0xad, 0xc5, 0x36, 0x8c, 0x14, 0x7e, 0x16, 0xcd, 0xc5, 0x44, 0xc8, 0xa0,
+	0xaa, 0x8c, 0xfa, 0xc6, 0x1f, 0xf7, 0x67, 0x14, 0xdc, 0x29, 0xab, 0xa1, 0xf7, 0xa7, 0x71, 0xd4,
+	0x52, 0x03, 0xae, 0x56, 0xcf, 0x05, 0xde, 0x45, 0xb3, 0x5a, 0x4e, 0x49, 0x08, 0x49, 0x92, 0x4c,
+	0xf7, 0x17, 0xe3, 0x9d, 0xe7, 0x07, 0x85, 0xab, 0x45, 0xdf, 0x2b, 0x09, 0xa7, 0x85, 0xbb, 0x60,
+	0x12, 0x6b, 0x04, 0xf6, 0xfc, 0x51, 0x36, 0x7c, 0x17, 0xb5, 0x8d, 0x11, 0x7d, 0xa0, 0xbd, 0xbe,
+	0x34, 0x66, 0x74, 0x9e, 0x19, 0x14, 0x6e, 0x4b, 0xe3, 0x77, 0x35, 0x7c, 0x5a, 0xb8, 0xd8, 0xa6,
+	0x69, 0x05, 0x7a, 0x7e, 0x9d, 0x05, 0xbf, 0x87, 0xe6, 0xd4, 0x2d, 0x43, 0xd3, 0x5e, 0x70, 0x44,
+	0x0e, 0x20, 0xcf, 0x84, 0xbe, 0xc3, 0x27, 0x3a, 0x37, 0x06, 0x85, 0x3b, 0x6b, 0x49, 0x1f, 0x18,
+	0xca, 0x69, 0xe1, 0x3e, 0x6e, 0xf4, 0x8d, 0xe2, 0x9e, 0x7f, 0x86, 0x11, 0xbf, 0x81, 0xa6, 0x39,
+	0x64, 0x40, 0xa4, 0xba, 0x62, 0x26, 0xb4, 0xbe, 0xa7, 0x07, 0x85, 0x5b, 0x81, 0xa7, 0x85, 0x3b,
+	0x6f, 0x54, 0x0d, 0x21, 0xcf, 0xaf, 0xc8, 0x78, 0x0b, 0xb5, 0x52, 0x38, 0x96, 0xd6, 0x26, 0x67,
+	0x52, 0xef, 0xef, 0xfa, 0xa0, 0x70, 0x91, 0x82, 0xcd, 0x32, 0xa7, 0x85, 0x7b, 0xc5, 0xe8, 0xa8,
+	0x30, 0xcf, 0xaf, 0x31, 0xe0, 0xdb, 0x68, 0x8a, 0x43, 0xc6, 0xb8, 0x84, 0xc8, 0x69, 0xaa, 0xa2,
+	0xd0, 0x71, 0x07, 0x85, 0x3b, 0xc4, 0x4e, 0x0b, 0x77, 0x6e, 0x68, 0x84, 0x46, 0x3c, 0x7f, 0x48,
+	0xf4, 0x7e, 0x7f, 0x09, 0x4d, 0xbd, 0x4f, 0xe4, 0x3b, 0x47, 0x29, 0x70, 0xfc, 0x1a, 0x6a, 0xaa,
+	0x8e, 0x81, 0x46, 0xb6, 0x35, 0xf4, 0x1e, 0x14, 0xee, 0xe4, 0xfb, 0x44, 0xbe, 0xb5, 0x35, 0x28,
+	0xdc, 0xc9, 0x43, 0x35, 0x38, 0x2d, 0xdc, 0xb6, 0xd1, 0xa6, 0xa7, 0x9e, 0xaf, 0xe1, 0x08, 0xaf,
+	0xa3, 0x49, 0xa6, 0x74, 0xd8, 0xee, 0xf0, 0x09, 0x25, 0xa0, 0x81, 0x4a, 0x40, 0x4f, 0x3d, 0xdf,
+	0xc0, 0x98, 0xa2, 0xa9, 0x3c, 0xed, 0xd2, 0x58, 0x95, 0xb2, 0xf1, 0x6f, 0x73, 0x65, 0xaa, 0x3d,
+	0x96, 0x1a, 0xaa, 0x3d, 0x96, 0x88, 0xe7, 0x0f, 0x89, 0xca, 0x4f, 0x22, 0xd7, 0x0d, 0x0b, 0x44,
+	0xda, 0x4f, 0x53, 0xc6, 0x4f, 0x43, 0xb0, 0xf2, 0xd3, 0x10, 0xf2, 0xfc, 0x8a, 0xec, 0x1d, 0xa3,
+	0xb9, 0x61, 0x6b, 0xd2, 0xc9, 0xc3, 0x03, 0x90, 0xf8, 0x2a, 0x6a, 0x4a, 0x76, 0x00, 0xa9, 0xe9,
+	0xa2, 0x27, 0x7c, 0x3b, 0xc3, 0x2f, 0x20, 0xac, 0xb3, 0x80, 0xc3, 0x3e, 0x8d, 0xe3, 0x91, 0xc8,
+	0xf5, 0xe7, 0x15, 0xc5, 0xd7, 0x04, 0x1b, 0x97, 0x2e, 0x6a, 0xed, 0xe7, 0x15, 0xdb, 0xb8, 0x66,
+	0x43, 0xfb, 0x79, 0xc9, 0xe0, 0xdd, 0x47, 0x8f, 0x9f, 0x59, 0xd9, 0x87, 0x90, 0xf1, 0x08, 0x3b,
+	0xe8, 0x32, 0x89, 0x22, 0x0e, 0xc2, 0xb6, 0xf1, 0x7e, 0x39, 0xc5, 0x3f, 0x40, 0xcd, 0xae, 0xe6,
+	0xd4, 0xab, 0xb6, 0x5e, 0x59, 0x39, 0x97, 0xff, 0x67, 0x34, 0xda, 0x2a, 0x60, 0xa5, 0xbc, 0x04,
+	0x5d, 0xb9, 0x97, 0xf5, 0x38, 0x89, 0x60, 0x4f, 0x42, 0x66, 0x97, 0xc3, 0x68, 0x22, 0x25, 0x49,
+	0xf9, 0xe9, 0xa2, 0xc7, 0x2a, 0x7a, 0x23, 0x96, 0xc2, 0x68, 0x76, 0xea, 0xe8, 0x55, 0xf0, 0x30,
+	0x39, 0x6d, 0xf4, 0x56, 0x98, 0xe7, 0xd7, 0x18, 0xbc, 0x3f, 0x37, 0x50, 0xbb, 0xa3, 0x2f, 0xbf,
+	0x7b, 0x59, 0xcc, 0x48, 0x84, 0x9f, 0x46, 0x6d, 0xc9, 0x24, 0x89, 0x83, 0xb0, 0x9f, 0xa7, 0x07,
+	0xe5, 0xf9, 0xb6, 0x34, 0xb6, 0xa9, 0x21, 0xfc, 0x1c, 0x9a, 0xe3, 0x10, 0x02, 0x3d, 0x84, 0xa8,
+	0xe4, 0xba, 0xa4, 0xb9, 0x66, 0x4b, 0xd8, 0x32, 0x5e, 0x47, 0x33, 0x43, 0x46, 0x7d, 0x29, 0x98,
+	0x13, 0x6e, 0x97, 0xa0, 0xbe, 0x0c, 0x6e, 0xa0, 0x2b, 0x79, 0xaa, 0xfa, 0x2b, 0x75, 0x7c, 0x25,
+	0xe3, 0x84, 0xf1, 0x58, 0x9d, 0xa0, 0x99, 0xaf, 0xa3, 0x19, 0x38, 0xce, 0x28, 0x3f, 0x29, 0xb7,
+	0x3d, 0x69, 0x34, 0x1a, 0xd0, 0xee, 0xe9, 0x75, 0x74, 0xa5, 0xbe, 0x25, 0x6d, 0x8c, 0xfa, 0xfc,
+	0xa3, 0x69, 0x04, 0xc7, 0x76, 0x43, 0x66, 0xa2, 0x0e, 0x36, 0x22, 0x92, 0x68, 0xfb, 0xdb, 0xbe,
+	0x1e, 0x7b, 0xff, 0x6e, 0x20, 0x5c, 0x97, 0xb7, 0x3e, 0x78, 0x52, 0x85, 0x71, 0x37, 0xa1, 0x52,
+	0x02, 0xb7, 0x8e, 0xa8, 0x00, 0xe5, 0x0d, 0xdb, 0x66, 0xa8, 0x4e, 0xd9, 0xa6, 0xa1, 0xf6, 0x86,
+	0x81, 0x55, 0x83, 0x5c, 0x79, 0xa3, 0xc2, 0x3c, 0xbf, 0xc6, 0x80, 0x6f, 0xa3, 0x66, 0xae, 0xd7,
+	0xd4, 0x27, 0xf5, 0xb0, 0x46, 0xbe, 0x6e, 0x58, 0x19, 0x39, 0x46, 0x04, 0xff, 0x10, 0x35, 0xad,
+	0x37, 0xcc, 0x37, 0x8f, 0xf7, 0x95, 0xc2, 0xfa, 0x54, 0x4a, 0x0d, 0x46, 0xce, 0xfb, 0x6c, 0xb8,
+	0xf3, 0xb7, 0x52, 0x21, 0x49, 0x1c, 0x13, 0xdd, 0x42, 0xdd, 0x44, 0x4d, 0xa1, 0x2f, 0x19, 0x5b,
+	0x97, 0xae, 0x0d, 0x0a, 0xd7, 0x22, 0xa7, 0x85, 0x3b, 0x63, 0x53, 0x57, 0xcf, 0x3d, 0xdf, 0x12,
+	0x54, 0x45, 0x02, 0xce, 0xd9, 0x48, 0x45, 0xd2, 0x40, 0x55, 0x91, 0xf4, 0xd4, 0xf3, 0x0d, 0xac,
+	0x56, 0xa9, 0xe7, 0xa1, 0x59, 0xa5, 0x5f, 0x86, 0xb1, 0x5d, 0xa5, 0x6f, 0x43, 0xd8, 0x12, 0x94,
+	0xc5, 0xce, 0x79, 0x8b, 0xad, 0xc7, 0xce, 0xf8, 0xa4, 0xf1, 0xed, 0x7c, 0xb2, 0x83, 0xda, 0xb4,
+	0xa6, 0xdb, 0xa6, 0xf5, 0xf5, 0x47, 0x1c, 0x6e, 0xdd, 0x8c, 0xaa, 0x65, 0xaa, 0x30, 0xef, 0x97,
+	0x0d, 0x74, 0x75, 0x0b, 0x62, 0x7a, 0x08, 0x1c, 0x22, 0xdb, 0x5f, 0x55, 0x59, 0x9e, 0xc1, 0x30,
+	0xb8, 0xf4, 0x18, 0xcf, 0xa3, 0x71, 0x12, 0x1e, 0xd8, 0xfc, 0x52, 0x43, 0xfc, 0x23, 0x34, 0x65,
+	0x3f, 0xca, 0xca, 0x27, 0x89, 0xd5, 0x73, 0xb6, 0x9c, 0x5d, 0xc0, 0x7e, 0xa5, 0x95, 0xdf, 0xa8,
+	0xa5, 0xbc, 0x77, 0x82, 0x16, 0x1f, 0xc1, 0xaa, 0x16, 0x4e, 0xf3, 0xc4, 0x66, 0x8b, 0x1a, 0xe2,
+	0xb7, 0xcf, 0xe6, 0x9e, 0x29, 0x39, 0xcf, 0x0d, 0x0a, 0x77, 0x24, 0xff, 0xaa, 0x07, 0x8d, 0x91,
+	0xac, 0x3c, 0x93, 0xa4, 0x7f, 0x6f, 0xa0, 0x85, 0x4e, 0xbd, 0x6f, 0x2f, 0x3b, 0xb2, 0x37, 0xce,
+	0xe5, 0x59, 0x79, 0x5d, 0x58, 0xb0, 0x7e, 0x5d, 0x58, 0xc8, 0xab, 0xa7, 0xe2, 0xcf, 0x1b, 0xe8,
+	0x72, 0xd9, 0x4a, 0x7e, 0xed, 0x1b, 0x85, 0xbe, 0xf5, 0x06, 0x85, 0x5b, 0x4a, 0x54, 0xcf, 0x2a,
+	0x16, 0xf0, 0xbe, 0x51, 0x9f, 0x59, 0xaa, 0xf1, 0xfe, 0xd0, 0x40, 0x4b, 0x0f, 0xdb, 0xde, 0x77,
+	0x1a, 0x9a, 0xdb, 0xf5, 0x8d, 0xaa, 0xa8, 0x7c, 0xe6, 0x11, 0x51, 0x39, 0x6a, 0x83, 0x0d, 0x83,
+	0xa1, 0xad, 0x6f, 0xa2, 0xc7, 0x36, 0xea, 0xed, 0xf1, 0xd7, 0xde, 0x71, 0x57, 0x87, 0xa9, 0x6a,
+	0x6e, 0x56, 0x3b, 0xf3, 0x62, 0xd4, 0xaa, 0x3d, 0x23, 0xa9, 0x10, 0x3a, 0x80, 0x13, 0x2b, 0xac,
+	0x86, 0x78, 0x1b, 0x4d, 0xea, 0x47, 0x25, 0x5b, 0x14, 0xd6, 0x6d, 0xcb, 0xf1, 0xdc, 0x05, 0xce,
+	0x57, 0xbd, 0x60, 0xf8, 0x46, 0xfa, 0xd6, 0x84, 0xee, 0xbc, 0x7f, 0xd3, 0x40, 0xed, 0xfa, 0x2b,
+	0x0e, 0x7e, 0x0a, 0xa1, 0xea, 0xf5, 0xa7, 0x2c, 0xd1, 0xc3, 0x37, 0x1d, 0xfc, 0x53, 0x34, 0xae,
+	0x3e, 0xaf, 0xfe, 0x07, 0xcf, 0x56, 0x4a, 0xaf, 0x35, 0xea, 0xff, 0xd1, 0xf4, 0xb0, 0xbf, 0x7f,
+	0xc8, 0x01, 0x60, 0x34, 0xa1, 0xef, 0x37, 0xb5, 0xff, 0x49, 0x5f, 0x8f, 0xad, 0xe0, 0x67, 0x0d,
+	0xd4, 0xaa, 0x7d, 0x6e, 0xe1, 0x1b, 0xf5, 0x2b, 0xbf, 0xb3, 0x38, 0x28, 0x5c, 0x3d, 0x3f, 0x2d,
+	0xdc, 0x96, 0xed, 0x47, 0x49, 0x02, 0x9e, 0xed, 0x05, 0x6e, 0xa2, 0xe6, 0x51, 0xe5, 0x90, 0x19,
+	0x53, 0x3b, 0x8f, 0xce, 0xd4, 0xce, 0xa3, 0xb2, 0x76, 0x9a, 0x01, 0xfe, 0x1e, 0x9a, 0x4a, 0xc8,
+	0x71, 0x75, 0x31, 0x4f, 0x76, 0x9e, 0x52, 0x89, 0x90, 0x90, 0x63, 0x65, 0x7c, 0x95, 0x08, 0x16,
+	0xf0, 0xfc, 0x92, 0x64, 0x2d, 0x4e, 0x50, 0xbb, 0xfe, 0xac, 0xf4, 0x70, 0x77, 0x1f, 0x92, 0x38,
+	0x87, 0x6f, 0xed, 0x6e, 0x2d, 0x6d, 0x97, 0xfb, 0xeb, 0x25, 0xd4, 0xdc, 0xee, 0xe9, 0xf8, 0xbb,
+	0x8d, 0xa6, 0x52, 0x1a, 0x1e, 0xd4, 0xce, 0x47, 0xb7, 0xdc, 0x25, 0x56, 0xb5, 0xa3, 0x25, 0xe2,
+	0xf9, 0x43, 0x22, 0xfe, 0x89, 0xad, 0xb2, 0xfa, 0xca, 0xef, 0xdc, 0x55, 0x07, 0xab, 0xe6, 0xd5,
+	0xc1, 0xaa, 0x99, 0xf7, 0x9f, 0xc2, 0x7d, 0xf1, 0x02, 0x66, 0x6e, 0x84, 0xe1, 0x86, 0x49, 0x0a,
+	0x5b, 0xaf, 0x7d, 0xd4, 0xaa, 0x62, 0xd0, 0x14, 0xe8, 0xe9, 0xce, 0xcb, 0x0f, 0x0a, 0x17, 0x0d,
+	0x43, 0x55, 0xa8, 0x34, 0x1f, 0x86, 0xa5, 0xa8, 0xd2, 0xbc, 0xc2, 0x3c, 0xbf, 0xc6, 0x80, 0x3f,
+	0x44, 0xb3, 0x21, 0x57, 0xdf, 0x2c, 0x51, 0x59, 0x79, 0x75, 0x7b, 0xd4, 0xb9, 0x39, 0x28, 0xdc,
+	0x45, 0x4b, 0x31, 0x55, 0xf5, 0x05, 0x96, 0x50, 0x09, 0x49, 0x26, 0x4f, 0xaa, 0x8f, 0xbc, 0x11,
+	0x06, 0xcf, 0x9f, 0x19, 0x99, 0xeb, 0xb3, 0x1d, 0xf3, 0x24, 0xc2, 0x7b, 0xaa, 0x62, 0xa8, 0x3a,
+	0x01, 0x1b, 0x5c, 0xd2, 0x7d, 0x12, 0xca, 0x6f, 0x16, 0x82, 0x37, 0xea, 0x9d, 0x94, 0x61, 0x56,
+	0xf3, 0x8a, 0x59, 0xcd, 0x3c, 0xd3, 0x62, 0x99, 0x55, 0x3b, 0xf7, 0x3e, 0x7f, 0xb0, 0xdc, 0xf8,
+	0xe2, 0xc1, 0x72, 0xe3, 0x9f, 0x0f, 0x96, 0x1b, 0xbf, 0xfe, 0x72, 0x79, 0xec, 0x8b, 0x2f, 0x97,
+	0xc7, 0xfe, 0xf2, 0xe5, 0xf2, 0xd8, 0x87, 0xb7, 0x6b, 0x47, 0xbf, 0x61, 0xfe, 0x32, 0x30, 0x85,
+	0x4d, 0x1f, 0x7d, 0x8f, 0xc5, 0x24, 0xed, 0x95, 0x3e, 0x39, 0xae, 0xfe, 0x4d, 0xd0, 0x3e, 0xe9,
+	0x36, 0xf5, 0x9f, 0x00, 0x37, 0xff, 0x3b, 0x00, 0x8e, 0xdf, 0x0f, 0x9a, 0x6d, 0x18, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.BeansPerUnit) > 0 {
-		for _, e := range m.BeansPerUnit {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.FeeUnitPrice) > 0 {
-		for _, e := range m.FeeUnitPrice {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BeansPerUnit) != len(that1.BeansPerUnit) {
+		return false
+	}
+	for i := range this.BeansPerUnit {
+		if !this.BeansPerUnit[i].Equal(&that1.BeansPerUnit[i]) {
+			return false
 		}
 	}
-	l = len(m.BootstrapVatConfig)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(this.FeeUnitPrice) != len(that1.FeeUnitPrice) {
+		return false
 	}
-	if len(m.PowerFlagFees) > 0 {
-		for _, e := range m.PowerFlagFees {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	for i := range this.FeeUnitPrice {
+		if !this.FeeUnitPrice[i].Equal(&that1.FeeUnitPrice[i]) {
+			return false
 		}
 	}
-	if len(m.QueueMax) > 0 {
-		for _, e := range m.QueueMax {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if this.BootstrapVatConfig != that1.BootstrapVatConfig {
+		return false
+	}
+	if len(this.PowerFlagFees) != len(that1.PowerFlagFees) {
+		return false
+	}
+	for i := range this.PowerFlagFees {
+		if !this.PowerFlagFees[i].Equal(&that1.PowerFlagFees[i]) {
+			return false
 		}
 	}
-	if len(m.VatCleanupBudget) > 0 {
-		for _, e := range m.VatCleanupBudget {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	if len(this.QueueMax) != len(that1.QueueMax) {
+		return false
+	}
+	for i := range this.QueueMax {
+		if !this.QueueMax[i].Equal(&that1.QueueMax[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.VatCleanupBudget) != len(that1.VatCleanupBudget) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.QueueAllowed) > 0 {
-		for _, e := range m.QueueAllowed {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	for i := range this.VatCleanupBudget {
+		if !this.VatCleanupBudget[i].Equal(&that1.VatCleanupBudget[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *StringBeans) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.WalletSpendActionRateLimit) != len(that1.WalletSpendActionRateLimit) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	for i := range this.WalletSpendActionRateLimit {
+		if !this.WalletSpendActionRateLimit[i].Equal(&that1.WalletSpendActionRateLimit[i]) {
+			return false
+		}
 	}
-	l = m.Beans.Size()
-	n += 1 + l + sovSwingset(uint64(l))
-	return n
-}
-
-func (m *PowerFlagFee) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.BridgeMessageHashChain != that1.BridgeMessageHashChain {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.PowerFlag)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(this.PrioritySenders) != len(that1.PrioritySenders) {
+		return false
 	}
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovSwingset(uint64(l))
+	for i := range this.PrioritySenders {
+		if this.PrioritySenders[i] != that1.PrioritySenders[i] {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *QueueSize) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.BlockTimeQuantumSeconds != that1.BlockTimeQuantumSeconds {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if !this.ComputronPriceUist.Equal(that1.ComputronPriceUist) {
+		return false
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovSwingset(uint64(m.Size_))
+	if this.MinRunPolicyHeadroom != that1.MinRunPolicyHeadroom {
+		return false
 	}
-	return n
-}
-
-func (m *UintMapEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.InboundDedupWindowBlocks != that1.InboundDedupWindowBlocks {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(this.BundleStorageFeePerByte) != len(that1.BundleStorageFeePerByte) {
+		return false
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovSwingset(uint64(l))
-	return n
+	for i := range this.BundleStorageFeePerByte {
+		if !this.BundleStorageFeePerByte[i].Equal(&that1.BundleStorageFeePerByte[i]) {
+			return false
+		}
+	}
+	if !this.BundleStorageRefundFraction.Equal(that1.BundleStorageRefundFraction) {
+		return false
+	}
+	if this.BundlePruneMinAgeBlocks != that1.BundlePruneMinAgeBlocks {
+		return false
+	}
+	if this.BlockEntropyDisabled != that1.BlockEntropyDisabled {
+		return false
+	}
+	if len(this.OracleOperators) != len(that1.OracleOperators) {
+		return false
+	}
+	for i := range this.OracleOperators {
+		if this.OracleOperators[i] != that1.OracleOperators[i] {
+			return false
+		}
+	}
+	if len(this.OraclePushFee) != len(that1.OraclePushFee) {
+		return false
+	}
+	for i := range this.OraclePushFee {
+		if !this.OraclePushFee[i].Equal(&that1.OraclePushFee[i]) {
+			return false
+		}
+	}
+	if len(this.InboundLanes) != len(that1.InboundLanes) {
+		return false
+	}
+	for i := range this.InboundLanes {
+		if !this.InboundLanes[i].Equal(&that1.InboundLanes[i]) {
+			return false
+		}
+	}
+	if this.InboundLaneBatchSize != that1.InboundLaneBatchSize {
+		return false
+	}
+	if len(this.AutoProvisionMinDeposit) != len(that1.AutoProvisionMinDeposit) {
+		return false
+	}
+	for i := range this.AutoProvisionMinDeposit {
+		if !this.AutoProvisionMinDeposit[i].Equal(&that1.AutoProvisionMinDeposit[i]) {
+			return false
+		}
+	}
+	if this.OraclePushesPerBlock != that1.OraclePushesPerBlock {
+		return false
+	}
+	return true
 }
+func (this *StringBeans) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Egress) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*StringBeans)
+	if !ok {
+		that2, ok := that.(StringBeans)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !this.Beans.Equal(that1.Beans) {
+		return false
+	}
+	return true
+}
+func (this *PowerFlagFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PowerFlagFee)
+	if !ok {
+		that2, ok := that.(PowerFlagFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PowerFlag != that1.PowerFlag {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QueueSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueSize)
+	if !ok {
+		that2, ok := that.(QueueSize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
 	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	return true
+}
+func (this *InboundLane) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InboundLane)
+	if !ok {
+		that2, ok := that.(InboundLane)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	if this.MaxSize != that1.MaxSize {
+		return false
+	}
+	return true
+}
+func (this *UintMapEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UintMapEntry)
+	if !ok {
+		that2, ok := that.(UintMapEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	return true
+}
+func (m *CoreEvalProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreEvalProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEvalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Evals) > 0 {
+		for iNdEx := len(m.Evals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Peer)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PowerFlags) > 0 {
-		for _, s := range m.PowerFlags {
-			l = len(s)
-			n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoreEval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreEval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreEval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsCode) > 0 {
+		i -= len(m.JsCode)
+		copy(dAtA[i:], m.JsCode)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JsonPermits) > 0 {
+		i -= len(m.JsonPermits)
+		copy(dAtA[i:], m.JsonPermits)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.JsonPermits)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OraclePushesPerBlock != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.OraclePushesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.AutoProvisionMinDeposit) > 0 {
+		for iNdEx := len(m.AutoProvisionMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoProvisionMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.InboundLaneBatchSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.InboundLaneBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.InboundLanes) > 0 {
+		for iNdEx := len(m.InboundLanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundLanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.OraclePushFee) > 0 {
+		for iNdEx := len(m.OraclePushFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePushFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.OracleOperators) > 0 {
+		for iNdEx := len(m.OracleOperators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OracleOperators[iNdEx])
+			copy(dAtA[i:], m.OracleOperators[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.OracleOperators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.BlockEntropyDisabled {
+		i--
+		if m.BlockEntropyDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BundlePruneMinAgeBlocks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BundlePruneMinAgeBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.BundleStorageRefundFraction.Size()
+		i -= size
+		if _, err := m.BundleStorageRefundFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.BundleStorageFeePerByte) > 0 {
+		for iNdEx := len(m.BundleStorageFeePerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleStorageFeePerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.InboundDedupWindowBlocks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.InboundDedupWindowBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinRunPolicyHeadroom != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.MinRunPolicyHeadroom))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.ComputronPriceUist.Size()
+		i -= size
+		if _, err := m.ComputronPriceUist.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.BlockTimeQuantumSeconds != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockTimeQuantumSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PrioritySenders) > 0 {
+		for iNdEx := len(m.PrioritySenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrioritySenders[iNdEx])
+			copy(dAtA[i:], m.PrioritySenders[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PrioritySenders[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.BridgeMessageHashChain {
+		i--
+		if m.BridgeMessageHashChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.WalletSpendActionRateLimit) > 0 {
+		for iNdEx := len(m.WalletSpendActionRateLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletSpendActionRateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VatCleanupBudget) > 0 {
+		for iNdEx := len(m.VatCleanupBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VatCleanupBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.QueueMax) > 0 {
+		for iNdEx := len(m.QueueMax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for iNdEx := len(m.PowerFlagFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerFlagFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BootstrapVatConfig) > 0 {
+		i -= len(m.BootstrapVatConfig)
+		copy(dAtA[i:], m.BootstrapVatConfig)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BootstrapVatConfig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for iNdEx := len(m.FeeUnitPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeUnitPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BeansPerUnit) > 0 {
+		for iNdEx := len(m.BeansPerUnit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeansPerUnit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBlockTime != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.LastBlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueueAllowed) > 0 {
+		for iNdEx := len(m.QueueAllowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reported {
+		i--
+		if m.Reported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextWakeup != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.NextWakeup))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Repeaters != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Repeaters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingWakeups != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.PendingWakeups))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastTimestamp != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VatOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VatOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VatOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Unbilled.Size()
+		i -= size
+		if _, err := m.Unbilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VatID) > 0 {
+		i -= len(m.VatID)
+		copy(dAtA[i:], m.VatID)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.VatID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.FullHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastRefillHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.LastRefillHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tokens != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Tokens))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucketRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucketRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucketRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeStepRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeStepRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeStepRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DoneHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.DoneHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.UncompressedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceivedSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReceivedChunks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ReceivedChunks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalChunks != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleUploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleUploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleUploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleUploadRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleUploadRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleUploadRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Upload.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleInstallation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleInstallation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleInstallation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleInstallationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleInstallationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleInstallationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Installation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveredInboundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveredInboundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveredInboundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Ack != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Ack))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveredInboundMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveredInboundMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveredInboundMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Num != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleStorageDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleStorageDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleStorageDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleStorageDepositRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleStorageDepositRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleStorageDepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoProvisionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoProvisionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoProvisionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringBeans) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringBeans) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StringBeans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Beans.Size()
+		i -= size
+		if _, err := m.Beans.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerFlagFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerFlagFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerFlagFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PowerFlag) > 0 {
+		i -= len(m.PowerFlag)
+		copy(dAtA[i:], m.PowerFlag)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Weight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UintMapEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UintMapEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UintMapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwingset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Egress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSwingset(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PowerFlags) > 0 {
+		for iNdEx := len(m.PowerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerFlags[iNdEx])
+			copy(dAtA[i:], m.PowerFlags[iNdEx])
+			i = encodeVarintSwingset(dAtA, i, uint64(len(m.PowerFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwingStoreArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwingStoreArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwingStoreArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSwingset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwingset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwingset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CoreEvalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Evals) > 0 {
+		for _, e := range m.Evals {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoreEval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPermits)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.JsCode)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BeansPerUnit) > 0 {
+		for _, e := range m.BeansPerUnit {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.FeeUnitPrice) > 0 {
+		for _, e := range m.FeeUnitPrice {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = len(m.BootstrapVatConfig)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.PowerFlagFees) > 0 {
+		for _, e := range m.PowerFlagFees {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.QueueMax) > 0 {
+		for _, e := range m.QueueMax {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.VatCleanupBudget) > 0 {
+		for _, e := range m.VatCleanupBudget {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.WalletSpendActionRateLimit) > 0 {
+		for _, e := range m.WalletSpendActionRateLimit {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.BridgeMessageHashChain {
+		n += 2
+	}
+	if len(m.PrioritySenders) > 0 {
+		for _, s := range m.PrioritySenders {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.BlockTimeQuantumSeconds != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockTimeQuantumSeconds))
+	}
+	l = m.ComputronPriceUist.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if m.MinRunPolicyHeadroom != 0 {
+		n += 1 + sovSwingset(uint64(m.MinRunPolicyHeadroom))
+	}
+	if m.InboundDedupWindowBlocks != 0 {
+		n += 1 + sovSwingset(uint64(m.InboundDedupWindowBlocks))
+	}
+	if len(m.BundleStorageFeePerByte) > 0 {
+		for _, e := range m.BundleStorageFeePerByte {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	l = m.BundleStorageRefundFraction.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if m.BundlePruneMinAgeBlocks != 0 {
+		n += 2 + sovSwingset(uint64(m.BundlePruneMinAgeBlocks))
+	}
+	if m.BlockEntropyDisabled {
+		n += 3
+	}
+	if len(m.OracleOperators) > 0 {
+		for _, s := range m.OracleOperators {
+			l = len(s)
+			n += 2 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.OraclePushFee) > 0 {
+		for _, e := range m.OraclePushFee {
+			l = e.Size()
+			n += 2 + l + sovSwingset(uint64(l))
+		}
+	}
+	if len(m.InboundLanes) > 0 {
+		for _, e := range m.InboundLanes {
+			l = e.Size()
+			n += 2 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.InboundLaneBatchSize != 0 {
+		n += 2 + sovSwingset(uint64(m.InboundLaneBatchSize))
+	}
+	if len(m.AutoProvisionMinDeposit) > 0 {
+		for _, e := range m.AutoProvisionMinDeposit {
+			l = e.Size()
+			n += 2 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.OraclePushesPerBlock != 0 {
+		n += 2 + sovSwingset(uint64(m.OraclePushesPerBlock))
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueueAllowed) > 0 {
+		for _, e := range m.QueueAllowed {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.LastBlockTime != 0 {
+		n += 1 + sovSwingset(uint64(m.LastBlockTime))
+	}
+	return n
+}
+
+func (m *TimerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastTimestamp != 0 {
+		n += 1 + sovSwingset(uint64(m.LastTimestamp))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.BlockHeight))
+	}
+	if m.PendingWakeups != 0 {
+		n += 1 + sovSwingset(uint64(m.PendingWakeups))
+	}
+	if m.Repeaters != 0 {
+		n += 1 + sovSwingset(uint64(m.Repeaters))
+	}
+	if m.NextWakeup != 0 {
+		n += 1 + sovSwingset(uint64(m.NextWakeup))
+	}
+	if m.Reported {
+		n += 2
+	}
+	return n
+}
+
+func (m *VatOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VatID)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Unbilled.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if m.Suspended {
+		n += 2
+	}
+	return n
+}
+
+func (m *RateLimitBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tokens != 0 {
+		n += 1 + sovSwingset(uint64(m.Tokens))
+	}
+	if m.LastRefillHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.LastRefillHeight))
+	}
+	if m.FullHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.FullHeight))
+	}
+	return n
+}
+
+func (m *RateLimitBucketRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Bucket.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *UpgradeStepRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.DoneHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.DoneHeight))
+	}
+	return n
+}
+
+func (m *BundleUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalChunks != 0 {
+		n += 1 + sovSwingset(uint64(m.TotalChunks))
+	}
+	if m.ReceivedChunks != 0 {
+		n += 1 + sovSwingset(uint64(m.ReceivedChunks))
+	}
+	if m.ReceivedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.ReceivedSize))
+	}
+	if m.UncompressedSize != 0 {
+		n += 1 + sovSwingset(uint64(m.UncompressedSize))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *BundleUploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovSwingset(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func (m *BundleUploadRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Upload.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BundleInstallation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSwingset(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BundleInstallationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Installation.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *DeliveredInboundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Ack != 0 {
+		n += 1 + sovSwingset(uint64(m.Ack))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeliveredInboundMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovSwingset(uint64(m.Num))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *BundleStorageDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BundleStorageDepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *AutoProvisionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSwingset(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *StringBeans) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Beans.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *PowerFlagFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PowerFlag)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovSwingset(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *InboundLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovSwingset(uint64(m.Weight))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovSwingset(uint64(m.MaxSize))
+	}
+	return n
+}
+
+func (m *UintMapEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovSwingset(uint64(l))
+	return n
+}
+
+func (m *Egress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	if len(m.PowerFlags) > 0 {
+		for _, s := range m.PowerFlags {
+			l = len(s)
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSwingset(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *SwingStoreArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSwingset(uint64(l))
+	}
+	return n
+}
+
+func sovSwingset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwingset(x uint64) (n int) {
+	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evals = append(m.Evals, CoreEval{})
+			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreEval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
+			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
+			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
+			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueMax = append(m.QueueMax, QueueSize{})
+			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatCleanupBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VatCleanupBudget = append(m.VatCleanupBudget, UintMapEntry{})
+			if err := m.VatCleanupBudget[len(m.VatCleanupBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletSpendActionRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletSpendActionRateLimit = append(m.WalletSpendActionRateLimit, UintMapEntry{})
+			if err := m.WalletSpendActionRateLimit[len(m.WalletSpendActionRateLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeMessageHashChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeMessageHashChain = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritySenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrioritySenders = append(m.PrioritySenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeQuantumSeconds", wireType)
+			}
+			m.BlockTimeQuantumSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeQuantumSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputronPriceUist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComputronPriceUist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRunPolicyHeadroom", wireType)
+			}
+			m.MinRunPolicyHeadroom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRunPolicyHeadroom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDedupWindowBlocks", wireType)
+			}
+			m.InboundDedupWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundDedupWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleStorageFeePerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleStorageFeePerByte = append(m.BundleStorageFeePerByte, types.DecCoin{})
+			if err := m.BundleStorageFeePerByte[len(m.BundleStorageFeePerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleStorageRefundFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BundleStorageRefundFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlePruneMinAgeBlocks", wireType)
+			}
+			m.BundlePruneMinAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundlePruneMinAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEntropyDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockEntropyDisabled = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleOperators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleOperators = append(m.OracleOperators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePushFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePushFee = append(m.OraclePushFee, types.Coin{})
+			if err := m.OraclePushFee[len(m.OraclePushFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundLanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundLanes = append(m.InboundLanes, InboundLane{})
+			if err := m.InboundLanes[len(m.InboundLanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundLaneBatchSize", wireType)
+			}
+			m.InboundLaneBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundLaneBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoProvisionMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoProvisionMinDeposit = append(m.AutoProvisionMinDeposit, types.Coin{})
+			if err := m.AutoProvisionMinDeposit[len(m.AutoProvisionMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePushesPerBlock", wireType)
+			}
+			m.OraclePushesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OraclePushesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
+			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+			}
+			m.LastBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+			}
+			m.LastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWakeups", wireType)
+			}
+			m.PendingWakeups = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingWakeups |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeaters", wireType)
+			}
+			m.Repeaters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repeaters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWakeup", wireType)
+			}
+			m.NextWakeup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWakeup |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reported = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VatOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VatOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VatOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VatID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VatID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			m.Tokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefillHeight", wireType)
+			}
+			m.LastRefillHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRefillHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullHeight", wireType)
+			}
+			m.FullHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FullHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucketRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucketRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucketRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeStepRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeStepRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeStepRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneHeight", wireType)
+			}
+			m.DoneHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoneHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
+			}
+			m.TotalChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedChunks", wireType)
+			}
+			m.ReceivedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedChunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedSize", wireType)
+			}
+			m.UncompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleUploadChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleUploadChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleUploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleUploadRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleUploadRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleUploadRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, BundleUploadChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *SwingStoreArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovSwingset(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSwingset(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSwingset(x uint64) (n int) {
-	return sovSwingset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
+func (m *BundleInstallation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1579,15 +6467,15 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEvalProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: BundleInstallation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEvalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BundleInstallation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1615,11 +6503,11 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1647,13 +6535,13 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evals", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1663,26 +6551,11 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSwingset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Evals = append(m.Evals, CoreEval{})
-			if err := m.Evals[len(m.Evals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -1704,7 +6577,7 @@ func (m *CoreEvalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoreEval) Unmarshal(dAtA []byte) error {
+func (m *BundleInstallationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1727,15 +6600,15 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoreEval: wiretype end group for non-group")
+			return fmt.Errorf("proto: BundleInstallationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoreEval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BundleInstallationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonPermits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1763,13 +6636,13 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsonPermits = string(dAtA[iNdEx:postIndex])
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Installation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1779,23 +6652,24 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsCode = string(dAtA[iNdEx:postIndex])
+			if err := m.Installation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1818,7 +6692,7 @@ func (m *CoreEval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DeliveredInboundRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,17 +6715,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeliveredInboundRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeliveredInboundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeansPerUnit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1861,29 +6735,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeansPerUnit = append(m.BeansPerUnit, StringBeans{})
-			if err := m.BeansPerUnit[len(m.BeansPerUnit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Peer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			m.Ack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ack |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1907,17 +6798,155 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, DeliveredInboundMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveredInboundMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveredInboundMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveredInboundMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+			}
+			m.Num = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Num |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleStorageDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeUnitPrice = append(m.FeeUnitPrice, types.Coin{})
-			if err := m.FeeUnitPrice[len(m.FeeUnitPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleStorageDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleStorageDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapVatConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1945,11 +6974,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BootstrapVatConfig = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerFlagFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1976,16 +7005,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerFlagFees = append(m.PowerFlagFees, PowerFlagFee{})
-			if err := m.PowerFlagFees[len(m.PowerFlagFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleStorageDepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleStorageDepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleStorageDepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -1995,29 +7074,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueMax = append(m.QueueMax, QueueSize{})
-			if err := m.QueueMax[len(m.QueueMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VatCleanupBudget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2044,8 +7121,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VatCleanupBudget = append(m.VatCleanupBudget, UintMapEntry{})
-			if err := m.VatCleanupBudget[len(m.VatCleanupBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2070,7 +7146,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *AutoProvisionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,17 +7169,17 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoProvisionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoProvisionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAllowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwingset
@@ -2113,26 +7189,43 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSwingset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwingset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueAllowed = append(m.QueueAllowed, QueueSize{})
-			if err := m.QueueAllowed[len(m.QueueAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -2487,6 +7580,126 @@ func (m *QueueSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InboundLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwingset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwingset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UintMapEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2730,6 +7943,25 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			}
 			m.PowerFlags = append(m.PowerFlags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +13,32 @@ import (
 
 const EmptyMailboxValue = `"{\"outbox\":[], \"ack\":0}"`
 
+// The values of BundleInstallation.Status.
+const (
+	BundleStatusPending   = "pending"
+	BundleStatusInstalled = "installed"
+	BundleStatusRejected  = "rejected"
+)
+
+// BundleIDPrefix is the prefix of a bundle ID preceding its
+// endoZipBase64Sha512 hash.
+const BundleIDPrefix = "b1-"
+
+// ValidateVatID checks that vatID has the form of a SwingSet vat ID, such as
+// "v42".
+func ValidateVatID(vatID string) error {
+	digits := strings.TrimPrefix(vatID, "v")
+	if len(digits) == len(vatID) || digits == "" {
+		return fmt.Errorf("invalid vat ID %q", vatID)
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("invalid vat ID %q", vatID)
+		}
+	}
+	return nil
+}
+
 // Returns a new Mailbox with an empty mailbox
 func NewMailbox() *vstoragetypes.Data {
 	return &vstoragetypes.Data{
@@ -18,6 +46,55 @@ func NewMailbox() *vstoragetypes.Data {
 	}
 }
 
+// DecodeMailbox returns the outbox messages and ack of a mailbox as stored by
+// SwingSet, which is JSON of the form {"outbox":[[num, body], ...], "ack":num}
+// (possibly itself encoded as a JSON string, as in EmptyMailboxValue), with
+// numbers given either as JSON numbers or decimal strings.
+func DecodeMailbox(value string) ([]MailboxMessage, uint64, error) {
+	var wrapped string
+	if err := json.Unmarshal([]byte(value), &wrapped); err == nil {
+		value = wrapped
+	}
+	var mailbox struct {
+		Outbox [][2]json.RawMessage `json:"outbox"`
+		Ack    json.RawMessage      `json:"ack"`
+	}
+	if err := json.Unmarshal([]byte(value), &mailbox); err != nil {
+		return nil, 0, fmt.Errorf("cannot decode mailbox: %w", err)
+	}
+
+	ack, err := decodeMailboxNum(mailbox.Ack)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid mailbox ack: %w", err)
+	}
+	messages := make([]MailboxMessage, len(mailbox.Outbox))
+	for i, entry := range mailbox.Outbox {
+		num, err := decodeMailboxNum(entry[0])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid mailbox message number: %w", err)
+		}
+		var body string
+		if err := json.Unmarshal(entry[1], &body); err != nil {
+			return nil, 0, fmt.Errorf("invalid body of mailbox message %d: %w", num, err)
+		}
+		messages[i] = MailboxMessage{Num: num, Body: body}
+	}
+	return messages, ack, nil
+}
+
+// decodeMailboxNum decodes a mailbox message number given either as a JSON
+// number or as a decimal string, treating an absent number as zero.
+func decodeMailboxNum(raw json.RawMessage) (uint64, error) {
+	if len(raw) == 0 {
+		return 0, nil
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		raw = json.RawMessage(str)
+	}
+	return strconv.ParseUint(string(raw), 10, 64)
+}
+
 func NewEgress(nickname string, peer sdk.AccAddress, powerFlags []string) *Egress {
 	return &Egress{
 		Nickname:   nickname,
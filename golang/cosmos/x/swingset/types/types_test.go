@@ -0,0 +1,38 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeMailbox(t *testing.T) {
+	for _, tt := range []struct {
+		value    string
+		messages []MailboxMessage
+		ack      uint64
+		wantErr  bool
+	}{
+		{value: EmptyMailboxValue, messages: []MailboxMessage{}},
+		{
+			value:    `{"outbox":[[1,"hello"],["2","world"]],"ack":"7"}`,
+			messages: []MailboxMessage{{Num: 1, Body: "hello"}, {Num: 2, Body: "world"}},
+			ack:      7,
+		},
+		{value: `{"outbox":[[-1,"hello"]],"ack":0}`, wantErr: true},
+		{value: `{"outbox":[[1,2]],"ack":0}`, wantErr: true},
+		{value: `not JSON`, wantErr: true},
+	} {
+		messages, ack, err := DecodeMailbox(tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: want error, got none", tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: want no error, got %v", tt.value, err)
+		} else if !reflect.DeepEqual(messages, tt.messages) || ack != tt.ack {
+			t.Errorf("%s: got %v and ack %d, want %v and ack %d", tt.value, messages, ack, tt.messages, tt.ack)
+		}
+	}
+}
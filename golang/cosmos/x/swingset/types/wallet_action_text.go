@@ -0,0 +1,233 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/capdata"
+)
+
+// textRemotable is a remotable of a decoded bridge action, such as a brand.
+type textRemotable struct {
+	id    interface{}
+	iface string
+}
+
+// String renders the remotable by its alleged name and slot, such as
+// "IST brand (board0257)".
+func (r textRemotable) String() string {
+	name := strings.TrimPrefix(r.iface, "Alleged: ")
+	if name == "" {
+		name = "remotable"
+	}
+	if r.id == nil {
+		return name
+	}
+	return fmt.Sprintf("%s (%v)", name, r.id)
+}
+
+// MarshalJSON renders the remotable as a string.
+func (r textRemotable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// textBigint is a bigint of a decoded bridge action, by its digits.
+type textBigint string
+
+// decodeBridgeAction decodes the CapData of a smart wallet bridge action into
+// a record for rendering.
+func decodeBridgeAction(action string) (map[string]interface{}, error) {
+	decoded, err := capdata.DecodeSerializedCapdata(action, capdata.CapdataValueTransformations{
+		Bigint: func(bigint *capdata.CapdataBigint) interface{} { return textBigint(bigint.Normalized) },
+		Remotable: func(r *capdata.CapdataRemotable) interface{} {
+			remotable := textRemotable{id: r.Id}
+			if r.Iface != nil {
+				remotable.iface = *r.Iface
+			}
+			return remotable
+		},
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrJSONUnmarshal.Wrapf("wallet action must be marshalled CapData: %s", err)
+	}
+	record, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("wallet bridge action is not a record")
+	}
+	return record, nil
+}
+
+// asTextRemotable returns the textRemotable of a decoded remotable.
+func asTextRemotable(value interface{}) (textRemotable, bool) {
+	if r, ok := value.(*capdata.CapdataRemotable); ok {
+		value = r.Representation
+	}
+	remotable, ok := value.(textRemotable)
+	return remotable, ok
+}
+
+// renderTextValue renders a decoded value on one line: an amount as its value
+// in the minimal units of its brand, a remotable by its alleged name, and
+// anything else as JSON.
+func renderTextValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case textBigint:
+		return string(value)
+	case *capdata.CapdataRemotable:
+		return renderTextValue(value.Representation)
+	case textRemotable:
+		return value.String()
+	case map[string]interface{}:
+		if brand, ok := asTextRemotable(value["brand"]); ok && len(value) == 2 {
+			name := strings.TrimSuffix(strings.TrimPrefix(brand.iface, "Alleged: "), " brand")
+			brand.iface = name
+			if digits, ok := value["value"].(textBigint); ok {
+				// The decimal places of a brand are not in the action, so the
+				// value is labelled as being in its smallest units.
+				return string(digits) + " minimal units of " + brand.String()
+			}
+			return renderTextValue(value["value"]) + " of " + brand.String()
+		}
+	}
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(bz)
+}
+
+// renderKeywordRecord appends a line for each keyword of the record at
+// value, in keyword order, with the given label.
+func renderKeywordRecord(lines []string, label string, value interface{}) []string {
+	record, _ := value.(map[string]interface{})
+	keywords := make([]string, 0, len(record))
+	for keyword := range record {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		lines = append(lines, fmt.Sprintf("%s %s: %s", label, keyword, renderTextValue(record[keyword])))
+	}
+	return lines
+}
+
+// RenderWalletAction returns the canonical textual rendering of the smart
+// wallet bridge action of owner, one detail per line, which signers such as
+// hardware wallets can display in place of its CapData.  An executeOffer is
+// rendered by its offer ID, invitation, and the give and want of its
+// proposal, whose amounts are labelled as being in the minimal units of their
+// brands, such as "1000000 minimal units of IST (board0257)" for 1 IST.
+func RenderWalletAction(owner, action string, spend bool) ([]string, error) {
+	bridgeAction, err := decodeBridgeAction(action)
+	if err != nil {
+		return nil, err
+	}
+	method, _ := bridgeAction["method"].(string)
+
+	title := "Smart wallet action"
+	if spend {
+		title = "Smart wallet spend action"
+	}
+	lines := []string{title, "Owner: " + owner, "Method: " + method}
+	if method == BridgeActionExecuteOffer {
+		offer, _ := bridgeAction["offer"].(map[string]interface{})
+		lines = append(lines, "Offer ID: "+renderTextValue(offer["id"]))
+
+		spec, _ := offer["invitationSpec"].(map[string]interface{})
+		lines = append(lines, "Invitation source: "+renderTextValue(spec["source"]))
+		for _, field := range []struct{ key, label string }{
+			{"instance", "Instance"},
+			{"instancePath", "Instance path"},
+			{"publicInvitationMaker", "Invitation maker"},
+			{"invitationMakerName", "Invitation maker"},
+			{"previousOffer", "Previous offer"},
+		} {
+			if value, ok := spec[field.key]; ok {
+				lines = append(lines, field.label+": "+renderTextValue(value))
+			}
+		}
+
+		proposal, _ := offer["proposal"].(map[string]interface{})
+		lines = renderKeywordRecord(lines, "Give", proposal["give"])
+		lines = renderKeywordRecord(lines, "Want", proposal["want"])
+		if exit, ok := proposal["exit"]; ok {
+			lines = append(lines, "Exit: "+renderTextValue(exit))
+		}
+		if offerArgs, ok := offer["offerArgs"]; ok {
+			lines = append(lines, "Offer args: "+renderTextValue(offerArgs))
+		}
+		return lines, nil
+	}
+
+	if method == "tryExitOffer" {
+		return append(lines, "Offer ID: "+renderTextValue(bridgeAction["offerId"])), nil
+	}
+	fields := make([]string, 0, len(bridgeAction))
+	for field := range bridgeAction {
+		if field != "method" {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		lines = append(lines, field+": "+renderTextValue(bridgeAction[field]))
+	}
+	return lines, nil
+}
+
+// SignTextMsg is a message with a textual rendering that signers can display
+// in its sign doc.
+type SignTextMsg interface {
+	sdk.Msg
+	// RenderSignText returns the rendering of the message.
+	RenderSignText() ([]string, error)
+	// GetSignText returns the rendering that the message carries, if any.
+	GetSignText() []string
+}
+
+var (
+	_ SignTextMsg = &MsgWalletAction{}
+	_ SignTextMsg = &MsgWalletSpendAction{}
+)
+
+// RenderSignText returns the textual rendering of the message for display by
+// a signer, per RenderWalletAction.
+func (msg MsgWalletAction) RenderSignText() ([]string, error) {
+	return RenderWalletAction(msg.Owner.String(), msg.Action, false)
+}
+
+// RenderSignText returns the textual rendering of the message for display by
+// a signer, per RenderWalletAction.
+func (msg MsgWalletSpendAction) RenderSignText() ([]string, error) {
+	return RenderWalletAction(msg.Owner.String(), msg.SpendAction, true)
+}
+
+// validateSignText checks that the sign text that msg carries, if any, is its
+// rendering, so that a signer displaying it shows what msg does.
+func validateSignText(msg SignTextMsg) error {
+	signText := msg.GetSignText()
+	if len(signText) == 0 {
+		return nil
+	}
+	lines, err := msg.RenderSignText()
+	if err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sign text given for an action that cannot be rendered: %s", err)
+	}
+	if len(lines) != len(signText) {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "sign text does not match the rendering of the action")
+	}
+	for i, line := range lines {
+		if signText[i] != line {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sign text line %d does not match the rendering of the action: %q", i+1, line)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRenderWalletAction(t *testing.T) {
+	const owner = "agoric1owner"
+	marshal := func(body string, slots ...string) string {
+		bz, err := json.Marshal(map[string]interface{}{"body": body, "slots": append([]string{}, slots...)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(bz)
+	}
+
+	testCases := []struct {
+		name    string
+		action  string
+		spend   bool
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "smallcaps offer",
+			action: marshal(`#{"method":"executeOffer","offer":{"id":"bid-1","invitationSpec":{"source":"agoricContract","instancePath":["auctioneer"],"callPipe":[["makeBidInvitation",["$0.Alleged: ATOM brand"]]]},"proposal":{"want":{"Collateral":{"brand":"$0","value":"+5"}},"give":{"Bid":{"brand":"$1.Alleged: IST brand","value":"+1000000"}}}}}`,
+				"board0123", "board0257"),
+			spend: true,
+			want: []string{
+				"Smart wallet spend action",
+				"Owner: agoric1owner",
+				"Method: executeOffer",
+				"Offer ID: bid-1",
+				"Invitation source: agoricContract",
+				`Instance path: ["auctioneer"]`,
+				"Give Bid: 1000000 minimal units of IST (board0257)",
+				"Want Collateral: 5 minimal units of ATOM (board0123)",
+			},
+		},
+		{
+			name: "legacy offer",
+			action: marshal(`{"method":"executeOffer","offer":{"id":7,"invitationSpec":{"source":"continuing","previousOffer":6,"invitationMakerName":"AdjustBalances"},"proposal":{"give":{"Minted":{"brand":{"@qclass":"slot","iface":"Alleged: IST brand","index":0},"value":{"@qclass":"bigint","digits":"42"}}}}}}`,
+				"board0257"),
+			spend: true,
+			want: []string{
+				"Smart wallet spend action",
+				"Owner: agoric1owner",
+				"Method: executeOffer",
+				"Offer ID: 7",
+				"Invitation source: continuing",
+				"Invitation maker: AdjustBalances",
+				"Previous offer: 6",
+				"Give Minted: 42 minimal units of IST (board0257)",
+			},
+		},
+		{
+			name:   "exit",
+			action: marshal(`#{"method":"tryExitOffer","offerId":"bid-1"}`),
+			want: []string{
+				"Smart wallet action",
+				"Owner: agoric1owner",
+				"Method: tryExitOffer",
+				"Offer ID: bid-1",
+			},
+		},
+		{
+			name:    "not capdata",
+			action:  `{"method":"executeOffer"}`,
+			wantErr: true,
+		},
+		{
+			name:    "not a record",
+			action:  marshal(`#"!executeOffer"`),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := RenderWalletAction(owner, tc.action, tc.spend)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("got %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWalletActionSignText(t *testing.T) {
+	exit := `{"body":"#{\"method\":\"tryExitOffer\",\"offerId\":\"bid-1\"}","slots":[]}`
+	signText, err := RenderWalletAction(addr.String(), exit, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spendSignText, err := RenderWalletAction(addr.String(), exit, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mismatched := append(append([]string{}, signText[:len(signText)-1]...), "Offer ID: bid-2")
+
+	for _, tt := range []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{name: "none", msg: NewMsgWalletAction(addr, exit)},
+		{name: "rendering", msg: &MsgWalletAction{Owner: addr, Action: exit, SignText: signText}},
+		{name: "spend rendering", msg: &MsgWalletSpendAction{Owner: addr, SpendAction: exit, SignText: spendSignText}},
+		{name: "mismatch", msg: &MsgWalletAction{Owner: addr, Action: exit, SignText: mismatched}, wantErr: true},
+		{name: "not for spend", msg: &MsgWalletSpendAction{Owner: addr, SpendAction: exit, SignText: signText}, wantErr: true},
+		{name: "truncated", msg: &MsgWalletAction{Owner: addr, Action: exit, SignText: signText[:1]}, wantErr: true},
+		{name: "unrenderable", msg: &MsgWalletAction{Owner: addr, Action: `{"method":"tryExitOffer"}`, SignText: signText}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The sign text is in the sign doc of legacy amino signers, such as
+	// hardware wallets, and leaves that of a message without one unchanged.
+	var signDoc map[string]interface{}
+	if err := json.Unmarshal(NewMsgWalletAction(addr, exit).GetSignBytes(), &signDoc); err != nil {
+		t.Fatal(err)
+	}
+	if value, ok := signDoc["value"].(map[string]interface{}); !ok || value["sign_text"] != nil {
+		t.Errorf("got sign doc %v, want one without sign_text", signDoc)
+	}
+	msg := MsgWalletAction{Owner: addr, Action: exit, SignText: signText}
+	if err := json.Unmarshal(msg.GetSignBytes(), &signDoc); err != nil {
+		t.Fatal(err)
+	}
+	value, _ := signDoc["value"].(map[string]interface{})
+	if got := fmt.Sprint(value["sign_text"]); got != fmt.Sprint(signText) {
+		t.Errorf("got sign doc text %s, want %s", got, signText)
+	}
+}
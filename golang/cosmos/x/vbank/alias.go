@@ -12,9 +12,11 @@ const (
 )
 
 var (
-	NewKeeper     = keeper.NewKeeper
-	ModuleCdc     = types.ModuleCdc
-	RegisterCodec = types.RegisterCodec
+	NewKeeper                    = keeper.NewKeeper
+	ModuleCdc                    = types.ModuleCdc
+	RegisterCodec                = types.RegisterCodec
+	ModuleAccountPursesInvariant = keeper.ModuleAccountPursesInvariant
+	RewardPoolInvariant          = keeper.RewardPoolInvariant
 )
 
 type (
@@ -21,6 +21,10 @@ func GetQueryCmd() *cobra.Command {
 	vbankQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryState(),
+		GetCmdQueryRewardPool(),
+		GetCmdQueryMintableDenoms(),
+		GetCmdQueryTotalBurned(),
+		GetCmdQueryProvisionPool(),
 	)
 
 	return vbankQueryCmd
@@ -77,3 +81,107 @@ func GetCmdQueryState() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRewardPool implements the query reward-pool command.
+func GetCmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query vbank reward distribution schedule",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPool(cmd.Context(), &types.QueryRewardPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMintableDenoms implements the query mintable-denoms command.
+func GetCmdQueryMintableDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mintable-denoms",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms vbank may mint and burn on behalf of SwingSet",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintableDenoms(cmd.Context(), &types.QueryMintableDenomsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTotalBurned implements the query total-burned command.
+func GetCmdQueryTotalBurned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-burned",
+		Args:  cobra.NoArgs,
+		Short: "Query the amount burned by vbank and not reissued",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalBurned(cmd.Context(), &types.QueryTotalBurnedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProvisionPool implements the query provision-pool command.
+func GetCmdQueryProvisionPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provision-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the balance of the provision pool and the totals of its top-ups",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProvisionPool(cmd.Context(), &types.QueryProvisionPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
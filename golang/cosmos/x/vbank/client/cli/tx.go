@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	vbankTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "vbank transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vbankTxCmd.AddCommand(
+		GetCmdFundProvisionPool(),
+	)
+
+	return vbankTxCmd
+}
+
+// GetCmdFundProvisionPool is the CLI command for sending a FundProvisionPool
+// transaction.
+func GetCmdFundProvisionPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-provision-pool <amount>",
+		Short: "send funds to the provision pool",
+		Long: `Send funds to the provision pool, from which the provisioning of smart
+wallets is subsidized.  The amount must be of denoms reflected into virtual
+purses, per the vbank allowed_denoms param.`,
+		Example: fmt.Sprintf(`$ %[1]s tx vbank fund-provision-pool 1000000uist --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundProvisionPool(cctx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -19,6 +19,14 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, balances := range data.PurseBalances {
+		if _, err := sdk.AccAddressFromBech32(balances.Address); err != nil {
+			return fmt.Errorf("purse balances of %q: %w", balances.Address, err)
+		}
+		if err := balances.Balances.Validate(); err != nil {
+			return fmt.Errorf("purse balances of %s: %w", balances.Address, err)
+		}
+	}
 	return nil
 }
 
@@ -32,6 +40,11 @@ func DefaultGenesisState() *types.GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
 	keeper.SetParams(ctx, data.GetParams())
 	keeper.SetState(ctx, data.GetState())
+	for _, balances := range data.GetPurseBalances() {
+		if err := keeper.SetPurseBalances(ctx, balances); err != nil {
+			panic(err)
+		}
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -39,5 +52,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	var gs types.GenesisState
 	gs.Params = k.GetParams(ctx)
 	gs.State = k.GetState(ctx)
+	gs.PurseBalances = k.GetAllPurseBalances(ctx)
 	return &gs
 }
@@ -3,15 +3,26 @@ package vbank
 import (
 	"fmt"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+
 	sdkioerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewHandler returns a handler for "vbank" type messages.
-func NewHandler(keeper Keeper) sdk.Handler {
+func NewHandler(k Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 		switch msg := msg.(type) {
+		case *types.MsgFundProvisionPool:
+			res, err := msgServer.FundProvisionPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("Unrecognized vbank Msg type: %T", msg)
 			return nil, sdkioerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
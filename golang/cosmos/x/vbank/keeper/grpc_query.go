@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/util"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,3 +26,49 @@ func (k Keeper) State(c context.Context, req *types.QueryStateRequest) (*types.Q
 
 	return &types.QueryStateResponse{State: state}, nil
 }
+
+// RewardPool queries the reward distribution schedule of distribution module
+func (k Keeper) RewardPool(c context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	state := k.GetState(ctx)
+	params := k.GetParams(ctx)
+	epochBlocks, smoothingBlocks := remainingRewardBlocks(params, state, ctx.BlockHeight())
+
+	return &types.QueryRewardPoolResponse{
+		RewardPool:               state.RewardPool,
+		RewardBlockAmount:        state.RewardBlockAmount,
+		RemainingEpochBlocks:     epochBlocks,
+		RemainingSmoothingBlocks: smoothingBlocks,
+	}, nil
+}
+
+// MintableDenoms queries the denoms that the module may mint and burn
+func (k Keeper) MintableDenoms(c context.Context, req *types.QueryMintableDenomsRequest) (*types.QueryMintableDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryMintableDenomsResponse{
+		Denoms:   params.MintableDenoms,
+		AllowAll: util.IndexOf(params.MintableDenoms, types.AllowAllMintableDenomsPattern) != -1,
+	}, nil
+}
+
+// TotalBurned queries the net amount burned by the module
+func (k Keeper) TotalBurned(c context.Context, req *types.QueryTotalBurnedRequest) (*types.QueryTotalBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalBurnedResponse{TotalBurned: k.GetTotalBurned(ctx)}, nil
+}
+
+// ProvisionPool queries the balance and the totals of the top-ups of the
+// provision pool
+func (k Keeper) ProvisionPool(c context.Context, req *types.QueryProvisionPoolRequest) (*types.QueryProvisionPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	state := k.GetState(ctx)
+
+	return &types.QueryProvisionPoolResponse{
+		Balance:     k.GetProvisionPoolBalance(ctx),
+		TotalFunded: state.ProvisionPoolFunded,
+		TopUps:      state.ProvisionPoolTopUps,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+const purseBalanceKeyPrefix = "purseBalance."
+
+// solvencyPoolNames are the module accounts backing virtual purses in
+// SwingSet, whose purse balances must stay covered by the bank.
+var solvencyPoolNames = []string{types.ReservePoolName, types.ProvisionPoolName}
+
+// RegisterInvariants registers the vbank invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-purses", ModuleAccountPursesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reward-pool", RewardPoolInvariant(k))
+}
+
+// AllInvariants runs all the vbank invariants.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountPursesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return RewardPoolInvariant(k)(ctx)
+	}
+}
+
+func isSolvencyPool(addr sdk.AccAddress) bool {
+	for _, name := range solvencyPoolNames {
+		if addr.Equals(authtypes.NewModuleAddress(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) getPurseBalanceStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(purseBalanceKeyPrefix))
+}
+
+func (k Keeper) getPurseBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdkmath.Int {
+	bz := prefix.NewStore(k.getPurseBalanceStore(ctx), address.MustLengthPrefix(addr)).Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setPurseBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) {
+	store := prefix.NewStore(k.getPurseBalanceStore(ctx), address.MustLengthPrefix(addr))
+	if !balance.IsPositive() {
+		store.Delete([]byte(balance.Denom))
+		return
+	}
+	bz, err := balance.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(balance.Denom), bz)
+}
+
+// RecordReportedBalance sets the purse balance of addr to balance, as
+// reported to SwingSet, if addr is the reserve or provision pool. SwingSet
+// believes the latest report, so a lower report lowers the purse balance, and
+// coins leaving the pools behind its back break ModuleAccountPursesInvariant
+// only until it has been told of them.
+func (k Keeper) RecordReportedBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) {
+	if !isSolvencyPool(addr) {
+		return
+	}
+	k.setPurseBalance(ctx, addr, balance)
+}
+
+// RecordPurseDeposit adds coins given by SwingSet with VBANK_GIVE to the purse
+// balances of addr, if addr is the reserve or provision pool.
+func (k Keeper) RecordPurseDeposit(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	if !isSolvencyPool(addr) {
+		return
+	}
+	for _, coin := range coins {
+		k.setPurseBalance(ctx, addr, sdk.NewCoin(coin.Denom, k.getPurseBalance(ctx, addr, coin.Denom).Add(coin.Amount)))
+	}
+}
+
+// RecordPurseWithdrawal subtracts coins grabbed by SwingSet with VBANK_GRAB
+// from the purse balances of addr, if addr is the reserve or provision pool.
+func (k Keeper) RecordPurseWithdrawal(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	if !isSolvencyPool(addr) {
+		return
+	}
+	for _, coin := range coins {
+		remaining := k.getPurseBalance(ctx, addr, coin.Denom).Sub(coin.Amount)
+		if remaining.IsNegative() {
+			remaining = sdk.ZeroInt()
+		}
+		k.setPurseBalance(ctx, addr, sdk.NewCoin(coin.Denom, remaining))
+	}
+}
+
+// GetPurseBalances returns the balances which SwingSet believes the virtual
+// purses of addr hold.
+func (k Keeper) GetPurseBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	iterator := prefix.NewStore(k.getPurseBalanceStore(ctx), address.MustLengthPrefix(addr)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		coins = coins.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return coins
+}
+
+// GetAllPurseBalances returns the purse balances of the reserve and provision
+// pools, for export.
+func (k Keeper) GetAllPurseBalances(ctx sdk.Context) []types.PurseBalances {
+	all := []types.PurseBalances{}
+	for _, name := range solvencyPoolNames {
+		addr := authtypes.NewModuleAddress(name)
+		if balances := k.GetPurseBalances(ctx, addr); !balances.Empty() {
+			all = append(all, types.PurseBalances{Address: addr.String(), Balances: balances})
+		}
+	}
+	return all
+}
+
+// SetPurseBalances replaces the purse balances of a pool, for import.
+func (k Keeper) SetPurseBalances(ctx sdk.Context, balances types.PurseBalances) error {
+	addr, err := sdk.AccAddressFromBech32(balances.Address)
+	if err != nil {
+		return err
+	}
+	if !isSolvencyPool(addr) {
+		return fmt.Errorf("%s is neither the reserve nor the provision pool", balances.Address)
+	}
+	for _, coin := range k.GetPurseBalances(ctx, addr) {
+		k.setPurseBalance(ctx, addr, sdk.NewCoin(coin.Denom, sdk.ZeroInt()))
+	}
+	for _, coin := range balances.Balances {
+		k.setPurseBalance(ctx, addr, coin)
+	}
+	return nil
+}
+
+// ModuleAccountPursesInvariant checks that the reserve and provision module
+// accounts hold at least the balances which SwingSet believes their virtual
+// purses hold, since SwingSet cannot otherwise tell that coins left them
+// behind its back.
+func ModuleAccountPursesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		for _, name := range solvencyPoolNames {
+			addr := authtypes.NewModuleAddress(name)
+			purses := k.GetPurseBalances(ctx, addr)
+			held := k.bankKeeper.GetAllBalances(ctx, addr)
+			if !purses.IsAllLTE(held) {
+				broken = true
+				msg += fmt.Sprintf("\t%s holds %s, less than its virtual purses %s\n", name, held, purses)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "module-account-purses",
+			fmt.Sprintf("module accounts short of their virtual purses:\n%s", msg)), broken
+	}
+}
+
+// RewardPoolInvariant checks that the vbank module account holds at least the
+// reward pool which it has yet to distribute.
+func RewardPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		rewardPool := k.GetState(ctx).RewardPool
+		held := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !rewardPool.IsAllLTE(held)
+		return sdk.FormatInvariant(types.ModuleName, "reward-pool",
+			fmt.Sprintf("\tvbank holds %s for a reward pool of %s\n", held, rewardPool)), broken
+	}
+}
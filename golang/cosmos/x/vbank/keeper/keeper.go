@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -21,6 +26,7 @@ type Keeper struct {
 
 	accountKeeper         types.AccountKeeper
 	bankKeeper            types.BankKeeper
+	distributionKeeper    types.DistributionKeeper
 	rewardDistributorName string
 	PushAction            vm.ActionPusher
 }
@@ -29,6 +35,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
 	rewardDistributorName string,
 	pushAction vm.ActionPusher,
 ) Keeper {
@@ -44,6 +51,7 @@ func NewKeeper(
 		paramSpace:            paramSpace,
 		accountKeeper:         accountKeeper,
 		bankKeeper:            bankKeeper,
+		distributionKeeper:    distributionKeeper,
 		rewardDistributorName: rewardDistributorName,
 		PushAction:            pushAction,
 	}
@@ -57,26 +65,104 @@ func (k Keeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	return k.bankKeeper.GetAllBalances(ctx, addr)
 }
 
+// checkMintable returns an error unless vbank may mint and burn every denom of
+// amt on behalf of SwingSet.
+func (k Keeper) checkMintable(ctx sdk.Context, amt sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range amt {
+		if !params.IsMintableDenom(coin.Denom) {
+			return sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "denom %s is not mintable by %s", coin.Denom, types.ModuleName)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) StoreRewardCoins(ctx sdk.Context, amt sdk.Coins) error {
-	return k.bankKeeper.MintCoins(ctx, types.ModuleName, amt)
+	if err := k.checkMintable(ctx, amt); err != nil {
+		return err
+	}
+	return k.mintReissued(ctx, amt)
 }
 
 func (k Keeper) SendCoinsToRewardDistributor(ctx sdk.Context, amt sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.rewardDistributorName, amt)
 }
 
+// SendCoinsToCommunityPool funds the distribution community pool with amt from
+// the module account.
+func (k Keeper) SendCoinsToCommunityPool(ctx sdk.Context, amt sdk.Coins) error {
+	if k.distributionKeeper == nil {
+		return fmt.Errorf("no distribution keeper for the community pool")
+	}
+	return k.distributionKeeper.FundCommunityPool(ctx, amt, authtypes.NewModuleAddress(types.ModuleName))
+}
+
 func (k Keeper) SendCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amt); err != nil {
+	if err := k.checkMintable(ctx, amt); err != nil {
+		return err
+	}
+	if err := k.mintReissued(ctx, amt); err != nil {
 		return err
 	}
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amt)
 }
 
+// SendCoinsToModule sends amt from the virtual purses of vats to the named
+// module account.
+func (k Keeper) SendCoinsToModule(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if k.GetModuleAccountAddress(ctx, moduleName) == nil {
+		return fmt.Errorf("module account %s not found", moduleName)
+	}
+	if err := k.checkMintable(ctx, amt); err != nil {
+		return err
+	}
+	if err := k.mintReissued(ctx, amt); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, amt)
+}
+
 func (k Keeper) GrabCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkMintable(ctx, amt); err != nil {
+		return err
+	}
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, amt); err != nil {
 		return err
 	}
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt)
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt); err != nil {
+		return err
+	}
+	state := k.GetState(ctx)
+	state.TotalGrabbed = state.TotalGrabbed.Add(amt...)
+	k.SetState(ctx, state)
+	return nil
+}
+
+// mintReissued mints amt into the module account as coins leaving virtual
+// purses, which reissues coins burned when withdrawn into them.
+func (k Keeper) mintReissued(ctx sdk.Context, amt sdk.Coins) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amt); err != nil {
+		return err
+	}
+	state := k.GetState(ctx)
+	state.TotalReissued = state.TotalReissued.Add(amt...)
+	k.SetState(ctx, state)
+	return nil
+}
+
+// GetTotalBurned returns the amount of each denom that the module has burned
+// and not reissued.  Coins withdrawn into virtual purses are burned, but only
+// those never deposited or sent back out of them have really left the supply.
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	state := k.GetState(ctx)
+	burned := sdk.NewCoins()
+	for _, grabbed := range state.TotalGrabbed {
+		reissued := state.TotalReissued.AmountOf(grabbed.Denom)
+		if grabbed.Amount.GT(reissued) {
+			burned = burned.Add(sdk.NewCoin(grabbed.Denom, grabbed.Amount.Sub(reissued)))
+		}
+	}
+	return burned
 }
 
 func (k Keeper) GetModuleAccountAddress(ctx sdk.Context, name string) sdk.AccAddress {
@@ -92,6 +178,16 @@ func (k Keeper) IsAllowedMonitoringAccount(ctx sdk.Context, addr sdk.AccAddress)
 	return params.IsAllowedMonitoringAccount(addr.String())
 }
 
+func (k Keeper) IsAllowedDenom(ctx sdk.Context, denom string) bool {
+	params := k.GetParams(ctx)
+	return params.IsAllowedDenom(denom)
+}
+
+func (k Keeper) IsAllowedSendToModule(ctx sdk.Context, moduleName string) bool {
+	params := k.GetParams(ctx)
+	return params.IsAllowedSendToModule(moduleName)
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
@@ -28,3 +28,46 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3, defaulting allowed_denoms.
+//
+// It and the later migrations each set only the parameter they introduce,
+// since SetParams would validate parameters not yet defaulted by a later
+// migration.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.setDefaultParamIfMissing(ctx, types.ParamStoreKeyAllowedDenoms, types.DefaultParams().AllowedDenoms)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, defaulting reward_smoothing_mode.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.setDefaultParamIfMissing(ctx, types.ParamStoreKeyRewardSmoothingMode, types.DefaultParams().RewardSmoothingMode)
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5, defaulting allowed_send_to_modules.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.setDefaultParamIfMissing(ctx, types.ParamStoreKeyAllowedSendToModules, types.DefaultParams().AllowedSendToModules)
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6, defaulting mintable_denoms.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.setDefaultParamIfMissing(ctx, types.ParamStoreKeyMintableDenoms, types.DefaultParams().MintableDenoms)
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7, defaulting community_pool_fraction.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.setDefaultParamIfMissing(ctx, types.ParamStoreKeyCommunityPoolFraction, types.DefaultParams().CommunityPoolFraction)
+	return nil
+}
+
+// setDefaultParamIfMissing sets the parameter at key to defaultValue, unless
+// it is already present.
+func (m Migrator) setDefaultParamIfMissing(ctx sdk.Context, key []byte, defaultValue interface{}) {
+	if m.keeper.paramSpace.Has(ctx, key) {
+		return
+	}
+	m.keeper.paramSpace.Set(ctx, key, defaultValue)
+}
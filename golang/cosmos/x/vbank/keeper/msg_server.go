@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vbank MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (keeper msgServer) FundProvisionPool(goCtx context.Context, msg *types.MsgFundProvisionPool) (*types.MsgFundProvisionPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := keeper.Keeper.FundProvisionPool(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventProvisionPoolFunded{
+		Sender:   msg.Sender.String(),
+		Amount:   msg.Amount,
+		Sequence: sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundProvisionPoolResponse{}, nil
+}
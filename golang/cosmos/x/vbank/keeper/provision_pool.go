@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// FundProvisionPool sends amt from sender to the provision pool, adding it to
+// the totals of the top-ups, and returns the sequence number of the top-up.
+// Only the totals are kept in state, so that top-ups cannot bloat it.
+func (k Keeper) FundProvisionPool(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) (uint64, error) {
+	params := k.GetParams(ctx)
+	for _, coin := range amt {
+		if !params.IsAllowedDenom(coin.Denom) {
+			return 0, sdkioerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s is not reflected into virtual purses", coin.Denom)
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ProvisionPoolName, amt); err != nil {
+		return 0, err
+	}
+
+	state := k.GetState(ctx)
+	state.ProvisionPoolFunded = state.ProvisionPoolFunded.Add(amt...)
+	state.ProvisionPoolTopUps++
+	k.SetState(ctx, state)
+	return state.ProvisionPoolTopUps, nil
+}
+
+// GetProvisionPoolBalance returns the balance of the provision pool.
+func (k Keeper) GetProvisionPoolBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ProvisionPoolName))
+}
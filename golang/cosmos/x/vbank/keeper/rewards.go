@@ -3,6 +3,7 @@ package keeper
 import (
 	"strings"
 
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -43,6 +44,27 @@ func mulCoins(a sdk.Coins, b sdk.Dec) sdk.Coins {
 	return sdk.NewCoins(coins...)
 }
 
+// remainingRewardBlocks returns, as of the block at height, the number of
+// blocks until the next reward epoch begins, and the number of upcoming blocks
+// that fall within the current epoch's smoothing period.
+func remainingRewardBlocks(params types.Params, state types.State, height int64) (epochBlocks, smoothingBlocks int64) {
+	epochBlocks = state.LastRewardDistributionBlock + params.RewardEpochDurationBlocks - height
+	if epochBlocks < 1 {
+		epochBlocks = 1
+	}
+	switch params.GetSmoothingMode() {
+	case types.RewardSmoothingModeImmediate:
+		return epochBlocks, 0
+	case types.RewardSmoothingModeExponentialDecay:
+		return epochBlocks, epochBlocks
+	}
+	smoothingBlocks = state.LastRewardDistributionBlock + params.GetSmoothingBlocks() - 1 - height
+	if smoothingBlocks < 0 {
+		smoothingBlocks = 0
+	}
+	return epochBlocks, smoothingBlocks
+}
+
 // DistributeRewards drives the rewards state machine.
 func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	// Distribute rewards.
@@ -50,27 +72,51 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	params := k.GetParams(ctx)
 
 	smoothingBlocks := params.GetSmoothingBlocks()
+	smoothingMode := params.GetSmoothingMode()
 	thisBlock := ctx.BlockHeight()
 	cycleIndex := thisBlock - state.LastRewardDistributionBlock
+	newEpoch := cycleIndex >= params.RewardEpochDurationBlocks
 
 	// Check if we're at the end of the last cycle.
-	if cycleIndex >= params.RewardEpochDurationBlocks {
+	if newEpoch {
 		// Get more rewards to distribute.
 		toDistribute := mulCoins(state.RewardPool, params.PerEpochRewardFraction)
 		state.LastRewardDistributionBlock = thisBlock
-		state.RewardBlockAmount = params.RewardRate(toDistribute, smoothingBlocks)
+		if smoothingMode == types.RewardSmoothingModeImmediate {
+			state.RewardBlockAmount = toDistribute
+		} else {
+			state.RewardBlockAmount = params.RewardRate(toDistribute, smoothingBlocks)
+		}
 		k.SetState(ctx, state)
 	}
 
-	if cycleIndex >= smoothingBlocks {
-		// No more distribution to do until the next cycle.
-		return nil
+	switch smoothingMode {
+	case types.RewardSmoothingModeImmediate:
+		if !newEpoch {
+			// Everything was paid at the start of the cycle.
+			return nil
+		}
+	case types.RewardSmoothingModeExponentialDecay:
+		// Pay a constant fraction of whatever remains in the pool, so that
+		// payouts decay geometrically until more rewards arrive.
+		state.RewardBlockAmount = params.RewardRate(state.RewardPool, smoothingBlocks)
+	default:
+		if cycleIndex >= smoothingBlocks {
+			// No more distribution to do until the next cycle.
+			return nil
+		}
 	}
 
-	// We're currently within the smoothing period, send the amount to distribute.
+	// We're currently paying out, send the amount to distribute.
 	xfer := minCoins(state.RewardBlockAmount, state.RewardPool)
-	if !xfer.IsZero() {
-		if err := k.SendCoinsToRewardDistributor(ctx, xfer); err != nil {
+	communityXfer := mulCoins(xfer, params.GetCommunityPoolFraction())
+	if !communityXfer.IsZero() {
+		if err := k.SendCoinsToCommunityPool(ctx, communityXfer); err != nil {
+			return err
+		}
+	}
+	if rewardXfer := xfer.Sub(communityXfer...); !rewardXfer.IsZero() {
+		if err := k.SendCoinsToRewardDistributor(ctx, rewardXfer); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,124 @@
+package vbank
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgBalanceUpdate = "op_weight_msg_balance_update" //nolint:gosec
+
+	DefaultWeightMsgBalanceUpdate = 100
+)
+
+var TypeMsgSend = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+var _ module.AppModuleSimulation = AppModuleSimulation{}
+
+// AppModuleSimulation implements module.AppModuleSimulation for x/vbank,
+// generating randomized transfers whose balance updates x/vbank reports to
+// SwingSet.
+type AppModuleSimulation struct {
+	keeper        Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+}
+
+// NewAppModuleSimulation returns the simulation of x/vbank for the
+// simulation manager.
+func NewAppModuleSimulation(k Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) AppModuleSimulation {
+	return AppModuleSimulation{keeper: k, accountKeeper: ak, bankKeeper: bk}
+}
+
+// GenerateGenesisState creates a randomized GenesisState of x/vbank.
+func (AppModuleSimulation) GenerateGenesisState(simState *module.SimulationState) {
+	genesis := DefaultGenesisState()
+	genesis.Params.RewardEpochDurationBlocks = int64(simState.Rand.Intn(100))
+	genesis.Params.RewardSmoothingBlocks = 1 + int64(simState.Rand.Intn(10))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// ProposalContents returns no governance proposal contents.
+func (AppModuleSimulation) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized param changes for the simulator.
+func (AppModuleSimulation) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyRewardEpochDurationBlocks),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf(`"%d"`, r.Intn(100))
+			},
+		),
+	}
+}
+
+// RegisterStoreDecoder registers no decoders.
+func (AppModuleSimulation) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the x/vbank operations with their weights.
+func (am AppModuleSimulation) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	var weightMsgBalanceUpdate int
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgBalanceUpdate, &weightMsgBalanceUpdate, nil,
+		func(_ *rand.Rand) {
+			weightMsgBalanceUpdate = DefaultWeightMsgBalanceUpdate
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgBalanceUpdate,
+			SimulateBalanceUpdate(am.accountKeeper, am.bankKeeper),
+		),
+	}
+}
+
+// SimulateBalanceUpdate generates a bank MsgSend of some of the spendable
+// coins of a random account to another, changing the balances that x/vbank
+// reports to SwingSet at the end of the block.
+func SimulateBalanceUpdate(ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		if from.Address.Equals(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, "same sender and recipient"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, from.Address)
+		amount := simtypes.RandSubsetCoins(r, spendable)
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, "no spendable coins"), nil, nil
+		}
+		msg := banktypes.NewMsgSend(from.Address, to.Address, amount)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Msg:             msg,
+			MsgType:         TypeMsgSend,
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: amount,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
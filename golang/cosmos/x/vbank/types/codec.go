@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
@@ -17,6 +18,9 @@ var (
 	// The actual codec used for serialization should be provided to x/swingset and
 	// defined at the application level.
 	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+	// ModuleAminoCdc is an amino codec for the legacy JSON signing of Msgs.
+	ModuleAminoCdc = codec.NewAminoCodec(amino)
 )
 
 func init() {
@@ -27,9 +31,13 @@ func init() {
 
 // RegisterCodec registers concrete types on the Amino codec
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgFundProvisionPool{}, ModuleName+"/FundProvisionPool", nil)
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundProvisionPool{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,3 +1,45 @@
 package types
 
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 const RouterKey = ModuleName // this was defined in your key.go file
+
+var _ sdk.Msg = &MsgFundProvisionPool{}
+
+func NewMsgFundProvisionPool(sender sdk.AccAddress, amount sdk.Coins) *MsgFundProvisionPool {
+	return &MsgFundProvisionPool{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgFundProvisionPool) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgFundProvisionPool) Type() string { return "fundProvisionPool" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgFundProvisionPool) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Sender address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgFundProvisionPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgFundProvisionPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -6,10 +6,17 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,19 +30,130 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgFundProvisionPool sends funds from the sender to the provision pool.
+type MsgFundProvisionPool struct {
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender" yaml:"sender"`
+	// amount must be of denoms reflected into virtual purses, per the
+	// allowed_denoms parameter.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgFundProvisionPool) Reset()         { *m = MsgFundProvisionPool{} }
+func (m *MsgFundProvisionPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundProvisionPool) ProtoMessage()    {}
+func (*MsgFundProvisionPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f9d0954f3583404, []int{0}
+}
+func (m *MsgFundProvisionPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundProvisionPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundProvisionPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundProvisionPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundProvisionPool.Merge(m, src)
+}
+func (m *MsgFundProvisionPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundProvisionPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundProvisionPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundProvisionPool proto.InternalMessageInfo
+
+func (m *MsgFundProvisionPool) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *MsgFundProvisionPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundProvisionPoolResponse is an empty reply.
+type MsgFundProvisionPoolResponse struct {
+}
+
+func (m *MsgFundProvisionPoolResponse) Reset()         { *m = MsgFundProvisionPoolResponse{} }
+func (m *MsgFundProvisionPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundProvisionPoolResponse) ProtoMessage()    {}
+func (*MsgFundProvisionPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f9d0954f3583404, []int{1}
+}
+func (m *MsgFundProvisionPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundProvisionPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundProvisionPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundProvisionPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundProvisionPoolResponse.Merge(m, src)
+}
+func (m *MsgFundProvisionPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundProvisionPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundProvisionPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundProvisionPoolResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgFundProvisionPool)(nil), "agoric.vbank.MsgFundProvisionPool")
+	proto.RegisterType((*MsgFundProvisionPoolResponse)(nil), "agoric.vbank.MsgFundProvisionPoolResponse")
+}
+
 func init() { proto.RegisterFile("agoric/vbank/msgs.proto", fileDescriptor_4f9d0954f3583404) }
 
 var fileDescriptor_4f9d0954f3583404 = []byte{
-	// 141 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x4c, 0xcf, 0x2f,
-	0xca, 0x4c, 0xd6, 0x2f, 0x4b, 0x4a, 0xcc, 0xcb, 0xd6, 0xcf, 0x2d, 0x4e, 0x2f, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x48, 0xe8, 0x81, 0x25, 0x8c, 0x58, 0xb9, 0x98, 0x7d, 0x8b,
-	0xd3, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x22, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x11, 0x62, 0x24, 0xc4, 0x00, 0xdd,
-	0xe2, 0x94, 0x6c, 0xfd, 0xf4, 0xfc, 0x9c, 0xc4, 0xbc, 0x74, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc,
-	0x62, 0xfd, 0x0a, 0xa8, 0x6d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xfb, 0x8c, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x56, 0x36, 0x19, 0x8a, 0x00, 0x00, 0x00,
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3d, 0x6f, 0xe2, 0x40,
+	0x10, 0xb5, 0xe1, 0x44, 0xe1, 0xe3, 0x8a, 0xb3, 0x90, 0x8e, 0x43, 0xd1, 0x1a, 0xb9, 0x42, 0x91,
+	0xd8, 0x15, 0xa4, 0x89, 0xe8, 0x20, 0x52, 0x9a, 0x04, 0x09, 0xb9, 0x4c, 0xe7, 0x8f, 0xd5, 0xc6,
+	0x01, 0xef, 0x20, 0x8f, 0x41, 0xa1, 0x49, 0x91, 0x5f, 0x90, 0x9f, 0x90, 0x3a, 0xbf, 0x84, 0x92,
+	0x32, 0x95, 0x13, 0x41, 0x13, 0xa5, 0x4c, 0x99, 0x2a, 0xb2, 0xd7, 0x28, 0x48, 0x41, 0x4a, 0x2a,
+	0x7b, 0xf6, 0xcd, 0xbc, 0x79, 0x6f, 0x66, 0x8c, 0x7f, 0xae, 0x80, 0x38, 0xf4, 0xd9, 0xdc, 0x73,
+	0xe5, 0x98, 0x45, 0x28, 0x90, 0x4e, 0x63, 0x48, 0xc0, 0xac, 0x2a, 0x80, 0xe6, 0x40, 0xa3, 0x26,
+	0x40, 0x40, 0x0e, 0xb0, 0xec, 0x4f, 0xe5, 0x34, 0x88, 0x0f, 0x18, 0x01, 0x32, 0xcf, 0x45, 0xce,
+	0xe6, 0x1d, 0x8f, 0x27, 0x6e, 0x87, 0xf9, 0x10, 0x4a, 0x85, 0xdb, 0xb7, 0x25, 0xa3, 0x36, 0x44,
+	0x71, 0x3a, 0x93, 0xc1, 0x28, 0x86, 0x79, 0x88, 0x21, 0xc8, 0x11, 0xc0, 0xc4, 0x0c, 0x8c, 0x0a,
+	0x72, 0x19, 0xf0, 0xb8, 0xae, 0x37, 0xf5, 0x56, 0x75, 0x70, 0xfe, 0x9a, 0x5a, 0xc5, 0xcb, 0x5b,
+	0x6a, 0xfd, 0x59, 0xb8, 0xd1, 0xa4, 0x67, 0xab, 0xd8, 0x7e, 0x4f, 0xad, 0xb6, 0x08, 0x93, 0xcb,
+	0x99, 0x47, 0x7d, 0x88, 0x58, 0xd1, 0x52, 0x7d, 0xda, 0x18, 0x8c, 0x59, 0xb2, 0x98, 0x72, 0xa4,
+	0x7d, 0xdf, 0xef, 0x07, 0x41, 0xcc, 0x11, 0x9d, 0x82, 0xc9, 0xbc, 0x31, 0x2a, 0x6e, 0x04, 0x33,
+	0x99, 0xd4, 0x4b, 0xcd, 0x72, 0xeb, 0x77, 0xf7, 0x3f, 0x55, 0x55, 0x34, 0xd3, 0x4b, 0x0b, 0xbd,
+	0xf4, 0x04, 0x42, 0x39, 0x38, 0x5b, 0xa6, 0x96, 0x96, 0x89, 0x50, 0x05, 0x9f, 0x22, 0x54, 0x6c,
+	0x3f, 0x3c, 0x59, 0xad, 0x1f, 0x88, 0xc8, 0xb8, 0xd0, 0x29, 0x48, 0x7a, 0xbf, 0x5e, 0xee, 0x2d,
+	0xcd, 0x26, 0xc6, 0xc1, 0xbe, 0x19, 0x38, 0x1c, 0xa7, 0x20, 0x91, 0x77, 0xaf, 0x8c, 0xf2, 0x10,
+	0x85, 0xe9, 0x1b, 0x7f, 0xbf, 0xce, 0xc9, 0xa6, 0xbb, 0x5b, 0xa0, 0xfb, 0x78, 0x1a, 0x87, 0xdf,
+	0xe7, 0x6c, 0x7b, 0x0d, 0x9c, 0xe5, 0x9a, 0xe8, 0xab, 0x35, 0xd1, 0x9f, 0xd7, 0x44, 0xbf, 0xdb,
+	0x10, 0x6d, 0xb5, 0x21, 0xda, 0xe3, 0x86, 0x68, 0x17, 0xc7, 0x3b, 0xee, 0xfa, 0xea, 0x24, 0x14,
+	0x6d, 0xee, 0x4e, 0xc0, 0xc4, 0x95, 0x62, 0x6b, 0xfb, 0xba, 0xb8, 0x96, 0xdc, 0xb3, 0x57, 0xc9,
+	0x77, 0x7d, 0xf4, 0x31, 0x00, 0xd8, 0xfb, 0x00, 0x49, 0x4a, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -50,6 +168,9 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// FundProvisionPool sends funds to the provisioning module account, from
+	// which smart wallet provisioning is subsidized.
+	FundProvisionPool(ctx context.Context, in *MsgFundProvisionPool, opts ...grpc.CallOption) (*MsgFundProvisionPoolResponse, error)
 }
 
 type msgClient struct {
@@ -60,22 +181,426 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) FundProvisionPool(ctx context.Context, in *MsgFundProvisionPool, opts ...grpc.CallOption) (*MsgFundProvisionPoolResponse, error) {
+	out := new(MsgFundProvisionPoolResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Msg/FundProvisionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// FundProvisionPool sends funds to the provisioning module account, from
+	// which smart wallet provisioning is subsidized.
+	FundProvisionPool(context.Context, *MsgFundProvisionPool) (*MsgFundProvisionPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) FundProvisionPool(ctx context.Context, req *MsgFundProvisionPool) (*MsgFundProvisionPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundProvisionPool not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_FundProvisionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundProvisionPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundProvisionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Msg/FundProvisionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundProvisionPool(ctx, req.(*MsgFundProvisionPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "agoric.vbank.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "agoric/vbank/msgs.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FundProvisionPool",
+			Handler:    _Msg_FundProvisionPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vbank/msgs.proto",
+}
+
+func (m *MsgFundProvisionPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundProvisionPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundProvisionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
+
+func (m *MsgFundProvisionPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundProvisionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundProvisionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundProvisionPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundProvisionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundProvisionPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundProvisionPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundProvisionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundProvisionPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundProvisionPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundProvisionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgs = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -12,12 +13,28 @@ import (
 
 const AllowAllMonitoringAccountsPattern = "*"
 
+const AllowAllDenomsPattern = "*"
+
+const AllowAllMintableDenomsPattern = "*"
+
+// Reward smoothing modes
+const (
+	RewardSmoothingModeLinear           = "linear"
+	RewardSmoothingModeExponentialDecay = "exponential_decay"
+	RewardSmoothingModeImmediate        = "immediate"
+)
+
 // Parameter keys
 var (
 	ParamStoreKeyRewardEpochDurationBlocks = []byte("reward_epoch_duration_blocks")
 	ParamStoreKeyRewardSmoothingBlocks     = []byte("reward_smoothing_blocks")
 	ParamStoreKeyPerEpochRewardFraction    = []byte("per_epoch_reward_fraction")
 	ParamStoreKeyAllowedMonitoringAccounts = []byte("allowed_monitoring_accounts")
+	ParamStoreKeyAllowedDenoms             = []byte("allowed_denoms")
+	ParamStoreKeyRewardSmoothingMode       = []byte("reward_smoothing_mode")
+	ParamStoreKeyAllowedSendToModules      = []byte("allowed_send_to_modules")
+	ParamStoreKeyMintableDenoms            = []byte("mintable_denoms")
+	ParamStoreKeyCommunityPoolFraction     = []byte("community_pool_fraction")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -33,6 +50,11 @@ func DefaultParams() Params {
 		RewardSmoothingBlocks:     1,
 		PerEpochRewardFraction:    sdk.OneDec(),
 		AllowedMonitoringAccounts: []string{provisionAddress.String()},
+		AllowedDenoms:             []string{AllowAllDenomsPattern},
+		RewardSmoothingMode:       RewardSmoothingModeLinear,
+		AllowedSendToModules:      []string{authtypes.FeeCollectorName},
+		MintableDenoms:            []string{AllowAllMintableDenomsPattern},
+		CommunityPoolFraction:     sdk.ZeroDec(),
 	}
 }
 
@@ -53,6 +75,24 @@ func (p Params) GetSmoothingBlocks() int64 {
 	return smoothingBlocks
 }
 
+// GetSmoothingMode returns the reward smoothing mode, treating an unset
+// mode as linear.
+func (p Params) GetSmoothingMode() string {
+	if p.RewardSmoothingMode == "" {
+		return RewardSmoothingModeLinear
+	}
+	return p.RewardSmoothingMode
+}
+
+// GetCommunityPoolFraction returns the fraction of rewards sent to the
+// community pool, treating an unset fraction as zero.
+func (p Params) GetCommunityPoolFraction() sdk.Dec {
+	if p.CommunityPoolFraction.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.CommunityPoolFraction
+}
+
 // RewardRate calculates the rate for dispensing the pool of coins over
 // the specified number of blocks. Fractions are rounded up. In other
 // words, it returns the smallest Coins such that pool is exhausted
@@ -87,6 +127,52 @@ func (p Params) IsAllowedMonitoringAccount(addr string) bool {
 	return false
 }
 
+// IsAllowedDenom checks to see if a given denom is reflected into virtual purses.
+// An empty AllowedDenoms reflects every denom, as before it existed.
+func (p Params) IsAllowedDenom(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+	for _, pat := range p.AllowedDenoms {
+		switch pat {
+		case AllowAllDenomsPattern, denom:
+			// Got an AllowAll pattern or an exact match.
+			return true
+		}
+	}
+
+	// No match found.
+	return false
+}
+
+// IsMintableDenom checks to see if vbank may mint and burn a given denom on
+// behalf of SwingSet.
+func (p Params) IsMintableDenom(denom string) bool {
+	for _, pat := range p.MintableDenoms {
+		switch pat {
+		case AllowAllMintableDenomsPattern, denom:
+			// Got an AllowAll pattern or an exact match.
+			return true
+		}
+	}
+
+	// No match found.
+	return false
+}
+
+// IsAllowedSendToModule checks to see if vats may send funds to a given
+// module account.
+func (p Params) IsAllowedSendToModule(moduleName string) bool {
+	for _, name := range p.AllowedSendToModules {
+		if name == moduleName {
+			return true
+		}
+	}
+
+	// No match found.
+	return false
+}
+
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -94,6 +180,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardSmoothingBlocks, &p.RewardSmoothingBlocks, validateRewardSmoothingBlocks),
 		paramtypes.NewParamSetPair(ParamStoreKeyPerEpochRewardFraction, &p.PerEpochRewardFraction, validatePerEpochRewardFraction),
 		paramtypes.NewParamSetPair(ParamStoreKeyAllowedMonitoringAccounts, &p.AllowedMonitoringAccounts, validateAllowedMonitoringAccounts),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardSmoothingMode, &p.RewardSmoothingMode, validateRewardSmoothingMode),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedSendToModules, &p.AllowedSendToModules, validateAllowedSendToModules),
+		paramtypes.NewParamSetPair(ParamStoreKeyMintableDenoms, &p.MintableDenoms, validateMintableDenoms),
+		paramtypes.NewParamSetPair(ParamStoreKeyCommunityPoolFraction, &p.CommunityPoolFraction, validateCommunityPoolFraction),
 	}
 }
 
@@ -111,6 +202,21 @@ func (p Params) ValidateBasic() error {
 	if err := validateAllowedMonitoringAccounts(p.AllowedMonitoringAccounts); err != nil {
 		return err
 	}
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateRewardSmoothingMode(p.RewardSmoothingMode); err != nil {
+		return err
+	}
+	if err := validateAllowedSendToModules(p.AllowedSendToModules); err != nil {
+		return err
+	}
+	if err := validateMintableDenoms(p.MintableDenoms); err != nil {
+		return err
+	}
+	if err := validateCommunityPoolFraction(p.CommunityPoolFraction); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -127,6 +233,20 @@ func validateRewardSmoothingBlocks(i interface{}) error {
 	return nil
 }
 
+func validateRewardSmoothingMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case "", RewardSmoothingModeLinear, RewardSmoothingModeExponentialDecay, RewardSmoothingModeImmediate:
+		return nil
+	}
+
+	return fmt.Errorf("unknown reward smoothing mode: %q", v)
+}
+
 func validateRewardEpochDurationBlocks(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -171,3 +291,79 @@ func validateAllowedMonitoringAccounts(i interface{}) error {
 
 	return nil
 }
+
+func validateAllowedDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for d, denom := range v {
+		if denom == AllowAllDenomsPattern {
+			continue
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("allowed denoms element[%d] is invalid: %w", d, err)
+		}
+	}
+
+	return nil
+}
+
+func validateAllowedSendToModules(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for m, moduleName := range v {
+		if strings.TrimSpace(moduleName) == "" {
+			return fmt.Errorf("allowed send to modules element[%d] cannot be empty", m)
+		}
+		if moduleName == ModuleName {
+			return fmt.Errorf("allowed send to modules element[%d] cannot be %s itself", m, ModuleName)
+		}
+	}
+
+	return nil
+}
+
+func validateMintableDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for d, denom := range v {
+		if denom == AllowAllMintableDenomsPattern {
+			continue
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("mintable denoms element[%d] is invalid: %w", d, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCommunityPoolFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		// Treated as zero.
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("community pool fraction must be nonnegative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("community pool fraction must be less than or equal to one: %s", v)
+	}
+
+	return nil
+}
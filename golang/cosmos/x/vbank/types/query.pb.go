@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -195,39 +197,482 @@ func (m *QueryStateResponse) GetState() State {
 	return State{}
 }
 
+// QueryRewardPoolRequest is the request type for the Query/RewardPool RPC method.
+type QueryRewardPoolRequest struct {
+}
+
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{4}
+}
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+// QueryRewardPoolResponse is the response type for the Query/RewardPool RPC method.
+type QueryRewardPoolResponse struct {
+	// reward_pool is the balance earmarked for distribution to the fee collector.
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool" yaml:"reward_pool"`
+	// reward_block_amount is the amount, if available, sent to the fee collector
+	// on every block of the current smoothing period.
+	RewardBlockAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reward_block_amount,json=rewardBlockAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_block_amount" yaml:"reward_block_amount"`
+	// remaining_epoch_blocks is the number of blocks until the next reward epoch
+	// begins and a new reward_block_amount is computed.  It is always at least
+	// one.
+	RemainingEpochBlocks int64 `protobuf:"varint,3,opt,name=remaining_epoch_blocks,json=remainingEpochBlocks,proto3" json:"remaining_epoch_blocks,omitempty" yaml:"remaining_epoch_blocks"`
+	// remaining_smoothing_blocks is the number of blocks remaining in the current
+	// epoch's smoothing period, during which reward_block_amount is paid out.
+	RemainingSmoothingBlocks int64 `protobuf:"varint,4,opt,name=remaining_smoothing_blocks,json=remainingSmoothingBlocks,proto3" json:"remaining_smoothing_blocks,omitempty" yaml:"remaining_smoothing_blocks"`
+}
+
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{5}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *QueryRewardPoolResponse) GetRewardBlockAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardBlockAmount
+	}
+	return nil
+}
+
+func (m *QueryRewardPoolResponse) GetRemainingEpochBlocks() int64 {
+	if m != nil {
+		return m.RemainingEpochBlocks
+	}
+	return 0
+}
+
+func (m *QueryRewardPoolResponse) GetRemainingSmoothingBlocks() int64 {
+	if m != nil {
+		return m.RemainingSmoothingBlocks
+	}
+	return 0
+}
+
+// QueryMintableDenomsRequest is the request type for the Query/MintableDenoms
+// RPC method.
+type QueryMintableDenomsRequest struct {
+}
+
+func (m *QueryMintableDenomsRequest) Reset()         { *m = QueryMintableDenomsRequest{} }
+func (m *QueryMintableDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintableDenomsRequest) ProtoMessage()    {}
+func (*QueryMintableDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{6}
+}
+func (m *QueryMintableDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintableDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintableDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintableDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintableDenomsRequest.Merge(m, src)
+}
+func (m *QueryMintableDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintableDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintableDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintableDenomsRequest proto.InternalMessageInfo
+
+// QueryMintableDenomsResponse is the response type for the
+// Query/MintableDenoms RPC method.
+type QueryMintableDenomsResponse struct {
+	// denoms is the mintable_denoms parameter.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// allow_all is true if denoms includes `"*"`, permitting any denom.
+	AllowAll bool `protobuf:"varint,2,opt,name=allow_all,json=allowAll,proto3" json:"allow_all,omitempty" yaml:"allow_all"`
+}
+
+func (m *QueryMintableDenomsResponse) Reset()         { *m = QueryMintableDenomsResponse{} }
+func (m *QueryMintableDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintableDenomsResponse) ProtoMessage()    {}
+func (*QueryMintableDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{7}
+}
+func (m *QueryMintableDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintableDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintableDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintableDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintableDenomsResponse.Merge(m, src)
+}
+func (m *QueryMintableDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintableDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintableDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintableDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryMintableDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryMintableDenomsResponse) GetAllowAll() bool {
+	if m != nil {
+		return m.AllowAll
+	}
+	return false
+}
+
+// QueryTotalBurnedRequest is the request type for the Query/TotalBurned RPC
+// method.
+type QueryTotalBurnedRequest struct {
+}
+
+func (m *QueryTotalBurnedRequest) Reset()         { *m = QueryTotalBurnedRequest{} }
+func (m *QueryTotalBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedRequest) ProtoMessage()    {}
+func (*QueryTotalBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{8}
+}
+func (m *QueryTotalBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedRequest.Merge(m, src)
+}
+func (m *QueryTotalBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedRequest proto.InternalMessageInfo
+
+// QueryTotalBurnedResponse is the response type for the Query/TotalBurned RPC
+// method.
+type QueryTotalBurnedResponse struct {
+	// total_burned is the amount of each denom that the module has burned and
+	// not reissued: coins withdrawn into virtual purses and later deposited or
+	// sent back out of them are not counted.
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned" yaml:"total_burned"`
+}
+
+func (m *QueryTotalBurnedResponse) Reset()         { *m = QueryTotalBurnedResponse{} }
+func (m *QueryTotalBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedResponse) ProtoMessage()    {}
+func (*QueryTotalBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{9}
+}
+func (m *QueryTotalBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedResponse.Merge(m, src)
+}
+func (m *QueryTotalBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedResponse proto.InternalMessageInfo
+
+func (m *QueryTotalBurnedResponse) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
+// QueryProvisionPoolRequest is the request type for the Query/ProvisionPool
+// RPC method.
+type QueryProvisionPoolRequest struct {
+}
+
+func (m *QueryProvisionPoolRequest) Reset()         { *m = QueryProvisionPoolRequest{} }
+func (m *QueryProvisionPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvisionPoolRequest) ProtoMessage()    {}
+func (*QueryProvisionPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{10}
+}
+func (m *QueryProvisionPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvisionPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvisionPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvisionPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvisionPoolRequest.Merge(m, src)
+}
+func (m *QueryProvisionPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvisionPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvisionPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvisionPoolRequest proto.InternalMessageInfo
+
+// QueryProvisionPoolResponse is the response type for the Query/ProvisionPool
+// RPC method.
+type QueryProvisionPoolResponse struct {
+	// balance is the current balance of the provision pool module account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance" yaml:"balance"`
+	// total_funded is the cumulative amount of the top-ups.
+	TotalFunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_funded,json=totalFunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_funded" yaml:"total_funded"`
+	// top_ups is the number of top-ups, whose history is in the
+	// EventProvisionPoolFunded events.
+	TopUps uint64 `protobuf:"varint,3,opt,name=top_ups,json=topUps,proto3" json:"top_ups,omitempty" yaml:"top_ups"`
+}
+
+func (m *QueryProvisionPoolResponse) Reset()         { *m = QueryProvisionPoolResponse{} }
+func (m *QueryProvisionPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvisionPoolResponse) ProtoMessage()    {}
+func (*QueryProvisionPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{11}
+}
+func (m *QueryProvisionPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvisionPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvisionPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvisionPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvisionPoolResponse.Merge(m, src)
+}
+func (m *QueryProvisionPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvisionPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvisionPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvisionPoolResponse proto.InternalMessageInfo
+
+func (m *QueryProvisionPoolResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *QueryProvisionPoolResponse) GetTotalFunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFunded
+	}
+	return nil
+}
+
+func (m *QueryProvisionPoolResponse) GetTopUps() uint64 {
+	if m != nil {
+		return m.TopUps
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
 	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
 	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "agoric.vbank.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "agoric.vbank.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryMintableDenomsRequest)(nil), "agoric.vbank.QueryMintableDenomsRequest")
+	proto.RegisterType((*QueryMintableDenomsResponse)(nil), "agoric.vbank.QueryMintableDenomsResponse")
+	proto.RegisterType((*QueryTotalBurnedRequest)(nil), "agoric.vbank.QueryTotalBurnedRequest")
+	proto.RegisterType((*QueryTotalBurnedResponse)(nil), "agoric.vbank.QueryTotalBurnedResponse")
+	proto.RegisterType((*QueryProvisionPoolRequest)(nil), "agoric.vbank.QueryProvisionPoolRequest")
+	proto.RegisterType((*QueryProvisionPoolResponse)(nil), "agoric.vbank.QueryProvisionPoolResponse")
 }
 
 func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
 
 var fileDescriptor_f70e65583c8f2384 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0x4f, 0x5f, 0x3b, 0x18, 0x16, 0x9c, 0x80, 0xaa, 0x50, 0xb9, 0x25, 0x13, 0x0b,
-	0xb1, 0x54, 0x16, 0x56, 0x2a, 0x31, 0xb0, 0x41, 0xd9, 0xd8, 0xdc, 0x62, 0x99, 0xa8, 0xad, 0x6f,
-	0x1a, 0xbb, 0x88, 0xae, 0x3c, 0x01, 0x12, 0x2f, 0xd5, 0xb1, 0x12, 0x0b, 0x0b, 0x08, 0xb5, 0x3c,
-	0x08, 0xaa, 0x6d, 0xa4, 0x5a, 0xfc, 0x59, 0xa2, 0xe8, 0x9e, 0x73, 0x7f, 0xe7, 0x9e, 0x04, 0x37,
-	0xb8, 0x84, 0xaa, 0x18, 0xb0, 0xbb, 0x3e, 0x57, 0x43, 0x36, 0x99, 0x8a, 0x6a, 0x96, 0x97, 0x15,
-	0x18, 0x20, 0xdb, 0x4e, 0xc9, 0xad, 0x92, 0x26, 0x12, 0x24, 0x58, 0x81, 0xad, 0xdf, 0x9c, 0x27,
-	0x6d, 0x4a, 0x00, 0x39, 0x12, 0x8c, 0x97, 0x05, 0xe3, 0x4a, 0x81, 0xe1, 0xa6, 0x00, 0xa5, 0xbd,
-	0x1a, 0xb2, 0xed, 0xd3, 0x29, 0x59, 0x82, 0xc9, 0xe5, 0x3a, 0xea, 0x82, 0x57, 0x7c, 0xac, 0x7b,
-	0x62, 0x32, 0x15, 0xda, 0x64, 0xe7, 0x38, 0x0e, 0xa6, 0xba, 0x04, 0xa5, 0x05, 0xe9, 0xe0, 0x7a,
-	0x69, 0x27, 0x0d, 0xd4, 0x46, 0x87, 0x5b, 0x9d, 0x24, 0xdf, 0xbc, 0x2c, 0x77, 0xee, 0xee, 0xff,
-	0xf9, 0x5b, 0x2b, 0xea, 0x79, 0x67, 0x16, 0xe3, 0x1d, 0x8b, 0xba, 0x32, 0xdc, 0x88, 0x2f, 0xfe,
-	0x99, 0x4f, 0xf5, 0x43, 0x8f, 0x67, 0xb8, 0xa6, 0xd7, 0x03, 0x4f, 0x8f, 0x43, 0xba, 0xf5, 0x7a,
-	0xb8, 0xf3, 0x75, 0x5e, 0x11, 0xae, 0x59, 0x0e, 0x19, 0xe2, 0xba, 0x4b, 0x27, 0xed, 0x70, 0xeb,
-	0x7b, 0xb9, 0xf4, 0xe0, 0x0f, 0x87, 0xbb, 0x24, 0x6b, 0x3e, 0x3c, 0x7f, 0x3c, 0xfd, 0xdb, 0x23,
-	0x09, 0x0b, 0x3e, 0x9c, 0xab, 0x44, 0x24, 0xae, 0xd9, 0x63, 0x48, 0xeb, 0x07, 0xd2, 0x66, 0xcf,
-	0xb4, 0xfd, 0xbb, 0xc1, 0x27, 0xed, 0xdb, 0xa4, 0x5d, 0x12, 0x87, 0x49, 0xb6, 0x5f, 0xb7, 0x37,
-	0x5f, 0x52, 0xb4, 0x58, 0x52, 0xf4, 0xbe, 0xa4, 0xe8, 0x71, 0x45, 0xa3, 0xc5, 0x8a, 0x46, 0x2f,
-	0x2b, 0x1a, 0x5d, 0x9f, 0xc8, 0xc2, 0xdc, 0x4e, 0xfb, 0xf9, 0x00, 0xc6, 0xec, 0xd4, 0x2d, 0xba,
-	0xfd, 0x23, 0x7d, 0x33, 0x64, 0x12, 0x46, 0x5c, 0x49, 0x36, 0x00, 0x3d, 0x06, 0xcd, 0xee, 0x3d,
-	0xd3, 0xcc, 0x4a, 0xa1, 0xfb, 0x75, 0xfb, 0xdf, 0x8f, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x35, 0x34, 0x38, 0x6f, 0x02, 0x00, 0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x18, 0x8d, 0x37, 0xc9, 0xb6, 0x9d, 0x6d, 0x2b, 0x32, 0xbb, 0x04, 0xc7, 0xd9, 0xae, 0x37, 0xa3,
+	0x06, 0xb6, 0x42, 0xd8, 0x4a, 0xb8, 0x20, 0x6e, 0x31, 0xb4, 0x88, 0x03, 0xa8, 0xb8, 0x20, 0x24,
+	0x2e, 0xab, 0xf1, 0xee, 0xe0, 0x58, 0xb1, 0x67, 0x5c, 0xcf, 0x6c, 0x42, 0x04, 0x07, 0x54, 0x09,
+	0x89, 0x03, 0x07, 0x24, 0x4e, 0x5c, 0x39, 0xf2, 0x4b, 0x2a, 0x4e, 0x95, 0xb8, 0x70, 0x5a, 0x50,
+	0xc2, 0x2f, 0xd8, 0x53, 0x8f, 0xc8, 0x33, 0xe3, 0xac, 0x9d, 0x35, 0x2c, 0xc9, 0x25, 0xb1, 0xbe,
+	0xf7, 0xe6, 0x7d, 0xcf, 0xe3, 0xef, 0x7b, 0x09, 0x30, 0x71, 0xc8, 0xb2, 0x68, 0xe4, 0x1e, 0x07,
+	0x98, 0x1e, 0xb9, 0x4f, 0x27, 0x24, 0x3b, 0x75, 0xd2, 0x8c, 0x09, 0x06, 0x6f, 0x2b, 0xc4, 0x91,
+	0x88, 0xd5, 0x09, 0x59, 0xc8, 0x24, 0xe0, 0xe6, 0x4f, 0x8a, 0x63, 0x75, 0x43, 0xc6, 0xc2, 0x98,
+	0xb8, 0x38, 0x8d, 0x5c, 0x4c, 0x29, 0x13, 0x58, 0x44, 0x8c, 0x72, 0x8d, 0xf6, 0x46, 0x8c, 0x27,
+	0x8c, 0xbb, 0x01, 0xe6, 0xc4, 0x3d, 0xde, 0x0b, 0x88, 0xc0, 0x7b, 0xee, 0x88, 0x45, 0x54, 0xe3,
+	0xd5, 0xde, 0xf2, 0xa7, 0x42, 0x50, 0x07, 0xc0, 0x4f, 0x72, 0x2b, 0x8f, 0x71, 0x86, 0x13, 0xee,
+	0x93, 0xa7, 0x13, 0xc2, 0x05, 0xfa, 0x10, 0xb4, 0x2b, 0x55, 0x9e, 0x32, 0xca, 0x09, 0xdc, 0x07,
+	0xcd, 0x54, 0x56, 0x4c, 0xa3, 0x6f, 0x0c, 0x5a, 0xfb, 0x1d, 0xa7, 0xec, 0xdc, 0x51, 0x6c, 0x6f,
+	0xed, 0xf9, 0xd4, 0x5e, 0xf1, 0x35, 0x13, 0xb5, 0xc1, 0x86, 0x94, 0x7a, 0x22, 0xb0, 0x20, 0x85,
+	0xfe, 0x43, 0x00, 0xcb, 0x45, 0x2d, 0xef, 0x82, 0x75, 0x9e, 0x17, 0xb4, 0x7a, 0xbb, 0xaa, 0x2e,
+	0xb9, 0x5a, 0x5c, 0xf1, 0x90, 0x09, 0x36, 0xa5, 0x8c, 0x4f, 0x4e, 0x70, 0x36, 0x7e, 0xcc, 0x58,
+	0x5c, 0x34, 0x78, 0xb9, 0x0a, 0x5e, 0x5b, 0x80, 0x74, 0x9b, 0x67, 0x06, 0x68, 0x65, 0xb2, 0x3c,
+	0x4c, 0x19, 0x8b, 0x4d, 0xa3, 0xbf, 0x3a, 0x68, 0xed, 0x6f, 0x39, 0xea, 0x0e, 0x9d, 0xfc, 0x0e,
+	0x1d, 0x7d, 0x87, 0xce, 0x7b, 0x2c, 0xa2, 0xde, 0xa3, 0xbc, 0xe7, 0x6c, 0x6a, 0xc3, 0x53, 0x9c,
+	0xc4, 0xef, 0xa2, 0xd2, 0x59, 0xf4, 0xeb, 0x9f, 0xf6, 0x20, 0x8c, 0xc4, 0xe1, 0x24, 0x70, 0x46,
+	0x2c, 0x71, 0xf5, 0x67, 0x50, 0xbf, 0xde, 0xe2, 0xe3, 0x23, 0x57, 0x9c, 0xa6, 0x84, 0x4b, 0x19,
+	0xee, 0x83, 0xec, 0xc2, 0x0c, 0xfc, 0xd9, 0x00, 0x6d, 0x2d, 0x14, 0xc4, 0x6c, 0x74, 0x34, 0xc4,
+	0x09, 0x9b, 0x50, 0x61, 0x36, 0x96, 0x99, 0xf9, 0x58, 0x9b, 0xb1, 0x2a, 0x66, 0xca, 0x1a, 0x57,
+	0x33, 0xb5, 0xa1, 0x14, 0xbc, 0x5c, 0xe0, 0x40, 0x9e, 0x87, 0x9f, 0x83, 0xcd, 0x8c, 0x24, 0x38,
+	0xa2, 0x11, 0x0d, 0x87, 0x24, 0x65, 0xa3, 0x43, 0xa5, 0xcf, 0xcd, 0xd5, 0xbe, 0x31, 0x58, 0xf5,
+	0x76, 0x66, 0x53, 0xfb, 0x5e, 0xd1, 0xbe, 0x8e, 0x87, 0xfc, 0xce, 0x05, 0xf0, 0x30, 0xaf, 0x4b,
+	0x75, 0x0e, 0x47, 0xc0, 0x9a, 0x1f, 0xe0, 0x09, 0x63, 0xe2, 0x30, 0x7f, 0xd2, 0xe2, 0x6b, 0x52,
+	0x7c, 0x77, 0x36, 0xb5, 0x77, 0x2e, 0x8b, 0x5f, 0xe6, 0x22, 0xdf, 0xbc, 0x00, 0x9f, 0x14, 0x98,
+	0x6a, 0x82, 0xba, 0xc0, 0x92, 0x5f, 0xfe, 0xa3, 0x88, 0x0a, 0x1c, 0xc4, 0xe4, 0x7d, 0x42, 0xd9,
+	0x7c, 0xb2, 0xbf, 0x06, 0xdb, 0xb5, 0xa8, 0x9e, 0x8d, 0x07, 0xa0, 0x39, 0x96, 0x15, 0x39, 0x15,
+	0xb7, 0xbc, 0x8d, 0xd9, 0xd4, 0xbe, 0xa3, 0xdc, 0xa8, 0x3a, 0xf2, 0x35, 0x01, 0xee, 0x81, 0x5b,
+	0x38, 0x8e, 0xd9, 0xc9, 0x10, 0xc7, 0xb1, 0xd9, 0xe8, 0x1b, 0x83, 0x9b, 0x5e, 0x67, 0x36, 0xb5,
+	0x5f, 0x51, 0xec, 0x0b, 0x08, 0xf9, 0x37, 0xe5, 0xf3, 0x41, 0x1c, 0xa3, 0x2d, 0x3d, 0x94, 0x9f,
+	0x32, 0x81, 0x63, 0x6f, 0x92, 0x51, 0x32, 0x2e, 0x7c, 0xfd, 0x62, 0x00, 0x73, 0x11, 0xd3, 0xae,
+	0xbe, 0x33, 0xc0, 0x6d, 0x91, 0xd7, 0x87, 0x81, 0x04, 0x96, 0x8f, 0xec, 0x07, 0x7a, 0x4a, 0xda,
+	0xca, 0x4d, 0xf9, 0xf0, 0xd5, 0xc6, 0xa3, 0x25, 0xe6, 0x7e, 0xd0, 0x36, 0xd8, 0x52, 0xb1, 0x90,
+	0xb1, 0xe3, 0x88, 0x47, 0x8c, 0x96, 0x57, 0xee, 0xb7, 0x06, 0xb0, 0xea, 0x50, 0xfd, 0x0e, 0x27,
+	0xe0, 0x46, 0x80, 0x63, 0x4c, 0x47, 0x64, 0xb9, 0x7b, 0x4f, 0xbb, 0xbf, 0xab, 0xdc, 0xeb, 0x73,
+	0x57, 0x33, 0x5e, 0x74, 0x2b, 0x5d, 0xde, 0x97, 0x13, 0x3a, 0x26, 0x63, 0xb3, 0x71, 0xad, 0xcb,
+	0x53, 0x87, 0xaf, 0x73, 0x79, 0x8f, 0xe4, 0x49, 0xf8, 0x26, 0xb8, 0x21, 0x58, 0x3a, 0x9c, 0xa4,
+	0x6a, 0x8d, 0xd6, 0x3c, 0x38, 0x7f, 0x43, 0x0d, 0x20, 0xbf, 0x29, 0x58, 0xfa, 0x59, 0xca, 0xf7,
+	0x5f, 0xae, 0x83, 0x75, 0x79, 0x99, 0xf0, 0x08, 0x34, 0x55, 0xae, 0xc2, 0x7e, 0x35, 0x0f, 0x17,
+	0x63, 0xdb, 0xda, 0xf9, 0x0f, 0x86, 0xfa, 0x0c, 0xa8, 0xfb, 0xec, 0xf7, 0xbf, 0x7f, 0x6a, 0x6c,
+	0xc2, 0x8e, 0x5b, 0xf9, 0x93, 0xa0, 0xc2, 0x1a, 0x86, 0x60, 0x5d, 0xc6, 0x2c, 0xb4, 0x6b, 0x94,
+	0xca, 0x09, 0x6e, 0xf5, 0xff, 0x9d, 0xa0, 0x3b, 0x6d, 0xcb, 0x4e, 0xaf, 0xc2, 0x76, 0xb5, 0x93,
+	0x4c, 0x6e, 0xf8, 0x0d, 0x00, 0xf3, 0x64, 0x86, 0xf7, 0x6b, 0xc4, 0x16, 0x32, 0xdd, 0xda, 0x5d,
+	0xc2, 0xd2, 0x7d, 0x77, 0x64, 0xdf, 0x6d, 0xb8, 0x55, 0xed, 0x5b, 0x4a, 0x6d, 0xf8, 0x83, 0x01,
+	0xee, 0x56, 0x03, 0x00, 0x0e, 0x6a, 0xc4, 0x6b, 0x13, 0xc4, 0x7a, 0xf0, 0x3f, 0x98, 0xda, 0xca,
+	0xae, 0xb4, 0x62, 0xc3, 0x7b, 0x55, 0x2b, 0x89, 0x66, 0x0f, 0x75, 0x92, 0x7c, 0x6b, 0x80, 0x56,
+	0x69, 0xed, 0x61, 0xdd, 0x8b, 0x2e, 0x46, 0x86, 0xf5, 0xfa, 0x32, 0x9a, 0x76, 0x81, 0xa4, 0x8b,
+	0x2e, 0xb4, 0xaa, 0x2e, 0xca, 0x99, 0x00, 0xbf, 0x37, 0xc0, 0x9d, 0xca, 0xde, 0xc2, 0x37, 0xea,
+	0x66, 0xa9, 0x66, 0xef, 0xad, 0xc1, 0x72, 0xa2, 0x36, 0x72, 0x5f, 0x1a, 0xe9, 0xc1, 0xee, 0xa5,
+	0xd9, 0x2b, 0xc8, 0xf2, 0xe3, 0x78, 0xfe, 0xf3, 0xb3, 0x9e, 0xf1, 0xe2, 0xac, 0x67, 0xfc, 0x75,
+	0xd6, 0x33, 0x7e, 0x3c, 0xef, 0xad, 0xbc, 0x38, 0xef, 0xad, 0xfc, 0x71, 0xde, 0x5b, 0xf9, 0xe2,
+	0x9d, 0xd2, 0xe2, 0x1d, 0x28, 0x05, 0x25, 0x24, 0x17, 0x2f, 0x64, 0x31, 0xa6, 0x61, 0xb1, 0x91,
+	0x5f, 0x15, 0x6f, 0x99, 0xaf, 0x63, 0xd0, 0x94, 0xff, 0xec, 0xbc, 0xfd, 0xcf, 0x00, 0x8e, 0x5d,
+	0x7b, 0xb8, 0x84, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -246,6 +691,16 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// State queries current state of the vbank module.
 	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// RewardPool queries the reward distribution schedule of the vbank module.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// MintableDenoms queries which denoms the vbank module may mint and burn on
+	// behalf of SwingSet.
+	MintableDenoms(ctx context.Context, in *QueryMintableDenomsRequest, opts ...grpc.CallOption) (*QueryMintableDenomsResponse, error)
+	// TotalBurned queries the net amount burned by the vbank module.
+	TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error)
+	// ProvisionPool queries the balance of the provision pool and the totals of
+	// the top-ups sent to it by MsgFundProvisionPool.
+	ProvisionPool(ctx context.Context, in *QueryProvisionPoolRequest, opts ...grpc.CallOption) (*QueryProvisionPoolResponse, error)
 }
 
 type queryClient struct {
@@ -274,12 +729,58 @@ func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintableDenoms(ctx context.Context, in *QueryMintableDenomsRequest, opts ...grpc.CallOption) (*QueryMintableDenomsResponse, error) {
+	out := new(QueryMintableDenomsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/MintableDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error) {
+	out := new(QueryTotalBurnedResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/TotalBurned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProvisionPool(ctx context.Context, in *QueryProvisionPoolRequest, opts ...grpc.CallOption) (*QueryProvisionPoolResponse, error) {
+	out := new(QueryProvisionPoolResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/ProvisionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the vbank module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// State queries current state of the vbank module.
 	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// RewardPool queries the reward distribution schedule of the vbank module.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// MintableDenoms queries which denoms the vbank module may mint and burn on
+	// behalf of SwingSet.
+	MintableDenoms(context.Context, *QueryMintableDenomsRequest) (*QueryMintableDenomsResponse, error)
+	// TotalBurned queries the net amount burned by the vbank module.
+	TotalBurned(context.Context, *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error)
+	// ProvisionPool queries the balance of the provision pool and the totals of
+	// the top-ups sent to it by MsgFundProvisionPool.
+	ProvisionPool(context.Context, *QueryProvisionPoolRequest) (*QueryProvisionPoolResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -292,6 +793,18 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
 }
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) MintableDenoms(ctx context.Context, req *QueryMintableDenomsRequest) (*QueryMintableDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintableDenoms not implemented")
+}
+func (*UnimplementedQueryServer) TotalBurned(ctx context.Context, req *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurned not implemented")
+}
+func (*UnimplementedQueryServer) ProvisionPool(ctx context.Context, req *QueryProvisionPoolRequest) (*QueryProvisionPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionPool not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -333,20 +846,108 @@ func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.vbank.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "State",
-			Handler:    _Query_State_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintableDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintableDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintableDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/MintableDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintableDenoms(ctx, req.(*QueryMintableDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/TotalBurned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurned(ctx, req.(*QueryTotalBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProvisionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvisionPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProvisionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/ProvisionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProvisionPool(ctx, req.(*QueryProvisionPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vbank.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "MintableDenoms",
+			Handler:    _Query_MintableDenoms_Handler,
+		},
+		{
+			MethodName: "TotalBurned",
+			Handler:    _Query_TotalBurned_Handler,
+		},
+		{
+			MethodName: "ProvisionPool",
+			Handler:    _Query_ProvisionPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "agoric/vbank/query.proto",
 }
 
@@ -454,72 +1055,1104 @@ func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingSmoothingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingSmoothingBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemainingEpochBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingEpochBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintableDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintableDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintableDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintableDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintableDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintableDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowAll {
+		i--
+		if m.AllowAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvisionPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvisionPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvisionPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvisionPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvisionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvisionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopUps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopUps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalFunded) > 0 {
+		for iNdEx := len(m.TotalFunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for _, e := range m.RewardBlockAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RemainingEpochBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingEpochBlocks))
+	}
+	if m.RemainingSmoothingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingSmoothingBlocks))
+	}
+	return n
+}
+
+func (m *QueryMintableDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintableDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AllowAll {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTotalBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProvisionPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProvisionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalFunded) > 0 {
+		for _, e := range m.TotalFunded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TopUps != 0 {
+		n += 1 + sovQuery(uint64(m.TopUps))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
+			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEpochBlocks", wireType)
+			}
+			m.RemainingEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingEpochBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSmoothingBlocks", wireType)
+			}
+			m.RemainingSmoothingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingSmoothingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintableDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintableDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintableDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintableDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintableDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintableDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAll = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBurnedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -542,10 +2175,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBurnedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -569,7 +2202,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBurnedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,15 +2225,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBurnedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -627,7 +2260,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -652,7 +2286,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProvisionPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -675,10 +2309,10 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvisionPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvisionPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -702,7 +2336,7 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProvisionPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -725,15 +2359,15 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProvisionPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProvisionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -760,10 +2394,64 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFunded = append(m.TotalFunded, types.Coin{})
+			if err := m.TotalFunded[len(m.TotalFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUps", wireType)
+			}
+			m.TopUps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopUps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
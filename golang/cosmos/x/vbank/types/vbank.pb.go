@@ -43,6 +43,31 @@ type Params struct {
 	// monitored for sends and receives.  An element of `"*"` will permit any
 	// address.
 	AllowedMonitoringAccounts []string `protobuf:"bytes,4,rep,name=allowed_monitoring_accounts,json=allowedMonitoringAccounts,proto3" json:"allowed_monitoring_accounts,omitempty" yaml:"allowed_monitoring_accounts"`
+	// allowed_denoms is an array of denoms whose balances are reflected into
+	// virtual purses by VBANK_BALANCE_UPDATE.  An element of `"*"` will permit
+	// any denom, as will an empty array.
+	AllowedDenoms []string `protobuf:"bytes,5,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// reward_smoothing_mode selects how an epoch's rewards are paid out:
+	// `"linear"` (the default, also selected by an empty string) pays equal
+	// amounts over reward_smoothing_blocks, `"exponential_decay"` pays
+	// 1/reward_smoothing_blocks of the remaining reward pool every block, and
+	// `"immediate"` pays the whole epoch's rewards in its first block.
+	RewardSmoothingMode string `protobuf:"bytes,6,opt,name=reward_smoothing_mode,json=rewardSmoothingMode,proto3" json:"reward_smoothing_mode,omitempty" yaml:"reward_smoothing_mode"`
+	// allowed_send_to_modules is an array of the names of the module accounts
+	// to which VBANK_SEND_TO_MODULE (and VBANK_SEND_TO_FEE_COLLECTOR, for
+	// `"fee_collector"`) may send funds from vats.  There is no wildcard,
+	// since funds sent to some module accounts (such as staking pools) would
+	// break their invariants.
+	AllowedSendToModules []string `protobuf:"bytes,7,rep,name=allowed_send_to_modules,json=allowedSendToModules,proto3" json:"allowed_send_to_modules,omitempty" yaml:"allowed_send_to_modules"`
+	// mintable_denoms is an array of denoms which vbank may mint and burn on
+	// behalf of SwingSet, as virtual purses deposit to and withdraw from bank
+	// accounts or pay module accounts.  An element of `"*"` will permit any
+	// denom.
+	MintableDenoms []string `protobuf:"bytes,8,rep,name=mintable_denoms,json=mintableDenoms,proto3" json:"mintable_denoms,omitempty" yaml:"mintable_denoms"`
+	// community_pool_fraction is the fraction of the rewards paid out each
+	// block that is instead sent to the distribution community pool, rather
+	// than the fee collector for validator rewards.
+	CommunityPoolFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=community_pool_fraction,json=communityPoolFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_fraction" yaml:"community_pool_fraction"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -98,6 +123,34 @@ func (m *Params) GetAllowedMonitoringAccounts() []string {
 	return nil
 }
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRewardSmoothingMode() string {
+	if m != nil {
+		return m.RewardSmoothingMode
+	}
+	return ""
+}
+
+func (m *Params) GetAllowedSendToModules() []string {
+	if m != nil {
+		return m.AllowedSendToModules
+	}
+	return nil
+}
+
+func (m *Params) GetMintableDenoms() []string {
+	if m != nil {
+		return m.MintableDenoms
+	}
+	return nil
+}
+
 // The current state of the module.
 type State struct {
 	// rewardPool is the current balance of rewards in the module account.
@@ -110,6 +163,18 @@ type State struct {
 	// last_sequence is a sequence number for communicating with the VM.
 	LastSequence                uint64 `protobuf:"varint,3,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty" yaml:"last_sequence"`
 	LastRewardDistributionBlock int64  `protobuf:"varint,4,opt,name=last_reward_distribution_block,json=lastRewardDistributionBlock,proto3" json:"last_reward_distribution_block,omitempty" yaml:"last_reward_distribution_block"`
+	// total_grabbed is the cumulative amount burned by the module when
+	// withdrawn from accounts into virtual purses by VBANK_GRAB.
+	TotalGrabbed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_grabbed,json=totalGrabbed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_grabbed" yaml:"total_grabbed"`
+	// total_reissued is the cumulative amount minted by the module when
+	// deposited or sent out of virtual purses, which reissues coins that were
+	// withdrawn into them.
+	TotalReissued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=total_reissued,json=totalReissued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reissued" yaml:"total_reissued"`
+	// provision_pool_funded is the cumulative amount sent to the provision
+	// pool by MsgFundProvisionPool.
+	ProvisionPoolFunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=provision_pool_funded,json=provisionPoolFunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"provision_pool_funded" yaml:"provision_pool_funded"`
+	// provision_pool_top_ups is the number of MsgFundProvisionPool top-ups.
+	ProvisionPoolTopUps uint64 `protobuf:"varint,7,opt,name=provision_pool_top_ups,json=provisionPoolTopUps,proto3" json:"provision_pool_top_ups,omitempty" yaml:"provision_pool_top_ups"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -173,53 +238,159 @@ func (m *State) GetLastRewardDistributionBlock() int64 {
 	return 0
 }
 
+func (m *State) GetTotalGrabbed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalGrabbed
+	}
+	return nil
+}
+
+func (m *State) GetTotalReissued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalReissued
+	}
+	return nil
+}
+
+func (m *State) GetProvisionPoolFunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProvisionPoolFunded
+	}
+	return nil
+}
+
+func (m *State) GetProvisionPoolTopUps() uint64 {
+	if m != nil {
+		return m.ProvisionPoolTopUps
+	}
+	return 0
+}
+
+// PurseBalances records the balances which SwingSet believes the virtual
+// purses of a module account hold: the highest balances reported to it, less
+// what it has since withdrawn.
+type PurseBalances struct {
+	Address  string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances" yaml:"balances"`
+}
+
+func (m *PurseBalances) Reset()         { *m = PurseBalances{} }
+func (m *PurseBalances) String() string { return proto.CompactTextString(m) }
+func (*PurseBalances) ProtoMessage()    {}
+func (*PurseBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{2}
+}
+func (m *PurseBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurseBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurseBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurseBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurseBalances.Merge(m, src)
+}
+func (m *PurseBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurseBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurseBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurseBalances proto.InternalMessageInfo
+
+func (m *PurseBalances) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PurseBalances) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "agoric.vbank.Params")
 	proto.RegisterType((*State)(nil), "agoric.vbank.State")
+	proto.RegisterType((*PurseBalances)(nil), "agoric.vbank.PurseBalances")
 }
 
 func init() { proto.RegisterFile("agoric/vbank/vbank.proto", fileDescriptor_5e89b3b9e5e671b4) }
 
 var fileDescriptor_5e89b3b9e5e671b4 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xe3, 0x26, 0xbf, 0xea, 0xd7, 0x6b, 0x19, 0x30, 0x05, 0x9c, 0x16, 0xd9, 0xd1, 0x21,
-	0x4a, 0x18, 0xb0, 0x55, 0x58, 0x50, 0x25, 0x86, 0x9a, 0xd2, 0x0d, 0x54, 0xb9, 0x03, 0x52, 0x97,
-	0xe8, 0x7c, 0xb9, 0x3a, 0x56, 0x6d, 0x3f, 0xe1, 0xee, 0xd2, 0xd2, 0x95, 0x57, 0x80, 0x98, 0x60,
-	0xeb, 0xcc, 0x2b, 0xe9, 0xd8, 0x05, 0x09, 0x21, 0x61, 0x50, 0xbb, 0x30, 0xe7, 0x15, 0xa0, 0xfb,
-	0x53, 0xda, 0x20, 0x14, 0x60, 0x49, 0xe2, 0x7c, 0xbe, 0xcf, 0x93, 0xef, 0xf3, 0x27, 0x0f, 0xf2,
-	0x48, 0x06, 0x3c, 0xa7, 0xd1, 0x7e, 0x4a, 0xaa, 0x3d, 0xf3, 0x1a, 0x0e, 0x39, 0x48, 0x70, 0x17,
-	0x0c, 0x09, 0xf5, 0x77, 0x4b, 0x8b, 0x19, 0x64, 0xa0, 0x41, 0xa4, 0x3e, 0x19, 0xcd, 0x92, 0x4f,
-	0x41, 0x94, 0x20, 0xa2, 0x94, 0x08, 0x16, 0xed, 0xaf, 0xa6, 0x4c, 0x92, 0xd5, 0x88, 0x42, 0x5e,
-	0x19, 0x8e, 0x3f, 0x36, 0xd1, 0xec, 0x16, 0xe1, 0xa4, 0x14, 0xee, 0x00, 0xdd, 0xe2, 0xec, 0x80,
-	0xf0, 0x7e, 0x8f, 0x0d, 0x81, 0x0e, 0x7a, 0xfd, 0x11, 0x27, 0x32, 0x87, 0xaa, 0x97, 0x16, 0x40,
-	0xf7, 0x84, 0xe7, 0x74, 0x9c, 0x6e, 0x33, 0xbe, 0x3b, 0xae, 0x83, 0xdb, 0x87, 0xa4, 0x2c, 0xd6,
-	0xf0, 0x34, 0x35, 0x4e, 0xda, 0x06, 0x3f, 0x55, 0x74, 0xc3, 0xc2, 0x58, 0x33, 0xf7, 0xad, 0x83,
-	0xda, 0x43, 0xc6, 0x6d, 0xa4, 0x4d, 0xb3, 0xcb, 0x09, 0x55, 0x1a, 0x6f, 0xa6, 0xe3, 0x74, 0xe7,
-	0xe2, 0x17, 0xc7, 0x75, 0xd0, 0xf8, 0x5c, 0x07, 0x2b, 0x59, 0x2e, 0x07, 0xa3, 0x34, 0xa4, 0x50,
-	0x46, 0xb6, 0x16, 0xf3, 0x76, 0x5f, 0xf4, 0xf7, 0x22, 0x79, 0x38, 0x64, 0x22, 0xdc, 0x60, 0x74,
-	0x5c, 0x07, 0x77, 0x8c, 0xab, 0x7e, 0x2e, 0x28, 0x67, 0x92, 0xfd, 0x3e, 0x3b, 0x4e, 0x6e, 0x0c,
-	0x19, 0xd7, 0xa6, 0x12, 0x4d, 0x36, 0x2d, 0x70, 0x77, 0xd0, 0x4d, 0xab, 0x15, 0x25, 0x80, 0x1c,
-	0xe4, 0x55, 0x76, 0x5e, 0x79, 0x53, 0x57, 0x8e, 0xc7, 0x75, 0xe0, 0x4f, 0x54, 0xfe, 0xab, 0x10,
-	0x27, 0xd7, 0x0d, 0xd9, 0x3e, 0x07, 0xb6, 0xe0, 0x5d, 0xb4, 0x4c, 0x8a, 0x02, 0x0e, 0x58, 0xbf,
-	0x57, 0x42, 0x95, 0x4b, 0xe0, 0x2a, 0x88, 0x50, 0x0a, 0xa3, 0x4a, 0x0a, 0xaf, 0xd5, 0x69, 0x76,
-	0xe7, 0xe2, 0x95, 0x71, 0x1d, 0x60, 0x93, 0x7f, 0x8a, 0x18, 0x27, 0x6d, 0x4b, 0x9f, 0xfd, 0x84,
-	0xeb, 0x96, 0xad, 0xfd, 0xff, 0xee, 0x28, 0x68, 0x7c, 0x3f, 0x0a, 0x1c, 0xfc, 0xa5, 0x89, 0xfe,
-	0xdb, 0x96, 0x44, 0x32, 0xf7, 0xb5, 0x83, 0xe6, 0xad, 0xdf, 0x21, 0x40, 0xe1, 0x39, 0x9d, 0x66,
-	0x77, 0xfe, 0x41, 0x3b, 0x34, 0x5d, 0x0c, 0xd5, 0x62, 0x84, 0x76, 0x31, 0xc2, 0x27, 0x90, 0x57,
-	0xf1, 0xa6, 0xea, 0xfc, 0xb8, 0x0e, 0xdc, 0x89, 0x5a, 0x55, 0x2c, 0xfe, 0xf0, 0x35, 0xe8, 0xfe,
-	0xc5, 0x3c, 0x54, 0x1a, 0x91, 0x20, 0x13, 0xb9, 0x05, 0x50, 0xb8, 0xef, 0x1d, 0x74, 0xcd, 0x26,
-	0xd2, 0xad, 0xea, 0x91, 0x52, 0x39, 0xf6, 0x66, 0xfe, 0x64, 0xe6, 0xb9, 0x35, 0xb3, 0x34, 0x61,
-	0xe6, 0x72, 0x8e, 0x7f, 0x33, 0x75, 0xd5, 0x64, 0xd0, 0x73, 0x59, 0xd7, 0xf1, 0xee, 0x63, 0x74,
-	0xa5, 0x20, 0x42, 0xf6, 0x04, 0x7b, 0x39, 0x62, 0x15, 0x65, 0x7a, 0xdc, 0xad, 0xd8, 0x1b, 0xd7,
-	0xc1, 0xa2, 0xf9, 0xd5, 0x09, 0x8c, 0x93, 0x05, 0xf5, 0xbc, 0x6d, 0x1f, 0xdd, 0x0a, 0xf9, 0x9a,
-	0x5b, 0x6b, 0xfd, 0x5c, 0x48, 0x9e, 0xa7, 0xa3, 0x8b, 0xff, 0x82, 0xd7, 0xd2, 0xeb, 0x73, 0xef,
-	0x62, 0x45, 0xa7, 0xeb, 0x71, 0xb2, 0xac, 0x04, 0x66, 0x3d, 0x37, 0x2e, 0x61, 0x6d, 0x7a, 0xad,
-	0xa5, 0xe6, 0x1b, 0x27, 0xc7, 0xa7, 0xbe, 0x73, 0x72, 0xea, 0x3b, 0xdf, 0x4e, 0x7d, 0xe7, 0xcd,
-	0x99, 0xdf, 0x38, 0x39, 0xf3, 0x1b, 0x9f, 0xce, 0xfc, 0xc6, 0xce, 0xa3, 0x4b, 0xbd, 0x58, 0x37,
-	0xa7, 0xc3, 0xdc, 0x09, 0xdd, 0x8b, 0x0c, 0x0a, 0x52, 0x65, 0xe7, 0x4d, 0x7a, 0x65, 0xaf, 0x8a,
-	0xee, 0x50, 0x3a, 0xab, 0x4f, 0xc2, 0xc3, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0x18, 0x80,
-	0x8f, 0x72, 0x04, 0x00, 0x00,
+	// 947 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xb7, 0xbf, 0x67, 0xdb, 0xae, 0x98, 0x6d, 0x5a, 0xa7, 0xbb, 0xd8, 0x61, 0x10, 0x4b,
+	0x90, 0x20, 0xd1, 0xc2, 0x05, 0x55, 0x42, 0xa2, 0x6e, 0x59, 0xe0, 0x50, 0x14, 0xb9, 0x05, 0xc4,
+	0x5e, 0xac, 0xb1, 0x3d, 0x9b, 0x5a, 0xb5, 0x3d, 0xc6, 0x33, 0xee, 0x52, 0x8e, 0x9c, 0x90, 0xb8,
+	0x20, 0x4e, 0xcb, 0x89, 0x3d, 0xf3, 0x1f, 0xf0, 0x1f, 0xec, 0x71, 0x8f, 0x88, 0x83, 0x41, 0xed,
+	0x85, 0x23, 0xf2, 0x5f, 0x80, 0xe6, 0x87, 0xd3, 0x24, 0xb4, 0x0d, 0xb9, 0x24, 0xf1, 0x7c, 0xdf,
+	0xfb, 0xe6, 0x7b, 0xcf, 0xef, 0x4d, 0x06, 0x98, 0x78, 0x40, 0xf3, 0x28, 0xe8, 0x9d, 0xfa, 0x38,
+	0x3d, 0x51, 0x9f, 0xdd, 0x2c, 0xa7, 0x9c, 0xc2, 0x55, 0x85, 0x74, 0xe5, 0xda, 0xf6, 0xc6, 0x80,
+	0x0e, 0xa8, 0x04, 0x7a, 0xe2, 0x97, 0xe2, 0x6c, 0x5b, 0x01, 0x65, 0x09, 0x65, 0x3d, 0x1f, 0x33,
+	0xd2, 0x3b, 0x7d, 0xe8, 0x13, 0x8e, 0x1f, 0xf6, 0x02, 0x1a, 0xa5, 0x0a, 0x47, 0xcf, 0x96, 0xc0,
+	0x62, 0x1f, 0xe7, 0x38, 0x61, 0xf0, 0x18, 0xdc, 0xcf, 0xc9, 0x53, 0x9c, 0x87, 0x1e, 0xc9, 0x68,
+	0x70, 0xec, 0x85, 0x45, 0x8e, 0x79, 0x44, 0x53, 0xcf, 0x8f, 0x69, 0x70, 0xc2, 0x4c, 0xa3, 0x6d,
+	0x74, 0xe6, 0x9c, 0x37, 0xab, 0xd2, 0x7e, 0xfd, 0x0c, 0x27, 0xf1, 0x0e, 0xba, 0x89, 0x8d, 0xdc,
+	0x96, 0x82, 0x3f, 0x12, 0xe8, 0xbe, 0x06, 0x1d, 0x89, 0xc1, 0x9f, 0x0c, 0xd0, 0xca, 0x48, 0xae,
+	0x23, 0xb5, 0xcc, 0x93, 0x1c, 0x07, 0x82, 0x63, 0xde, 0x6a, 0x1b, 0x9d, 0x15, 0xe7, 0xcb, 0x17,
+	0xa5, 0xdd, 0xf8, 0xa3, 0xb4, 0x1f, 0x0c, 0x22, 0x7e, 0x5c, 0xf8, 0xdd, 0x80, 0x26, 0x3d, 0x9d,
+	0x8b, 0xfa, 0x7a, 0x87, 0x85, 0x27, 0x3d, 0x7e, 0x96, 0x11, 0xd6, 0xdd, 0x27, 0x41, 0x55, 0xda,
+	0x6f, 0x28, 0x57, 0x61, 0xc4, 0x82, 0x9c, 0x70, 0x72, 0xb5, 0x3a, 0x72, 0x37, 0x33, 0x92, 0x4b,
+	0x53, 0xae, 0x44, 0x1e, 0x69, 0x00, 0x3e, 0x06, 0x5b, 0x9a, 0xcb, 0x12, 0x4a, 0xf9, 0x71, 0x94,
+	0x0e, 0xea, 0xcc, 0xe7, 0x64, 0xe6, 0xa8, 0x2a, 0x6d, 0x6b, 0x2c, 0xf3, 0x49, 0x22, 0x72, 0x9b,
+	0x0a, 0x39, 0xac, 0x01, 0x9d, 0xf0, 0x13, 0x70, 0x0f, 0xc7, 0x31, 0x7d, 0x4a, 0x42, 0x2f, 0xa1,
+	0x69, 0xc4, 0x69, 0x2e, 0x82, 0x70, 0x10, 0xd0, 0x22, 0xe5, 0xcc, 0x9c, 0x6f, 0xcf, 0x75, 0x56,
+	0x9c, 0x07, 0x55, 0x69, 0x23, 0xa5, 0x7f, 0x03, 0x19, 0xb9, 0x2d, 0x8d, 0x1e, 0x0c, 0xc1, 0x5d,
+	0x8d, 0xc1, 0x0f, 0xc1, 0x7a, 0x1d, 0x1a, 0x92, 0x94, 0x26, 0xcc, 0x5c, 0x90, 0xd2, 0xad, 0xaa,
+	0xb4, 0x9b, 0xe3, 0xd2, 0x0a, 0x47, 0xee, 0x9a, 0x5e, 0xd8, 0x97, 0xcf, 0xf0, 0x08, 0x34, 0xff,
+	0x93, 0x5c, 0x42, 0x43, 0x62, 0x2e, 0xca, 0xb7, 0xd2, 0xae, 0x4a, 0xfb, 0xfe, 0x35, 0x35, 0x10,
+	0x34, 0xe4, 0xde, 0x9d, 0xa8, 0xc0, 0x01, 0x0d, 0x09, 0xfc, 0x0a, 0x6c, 0xd5, 0xfb, 0x32, 0x92,
+	0x86, 0x1e, 0xa7, 0x82, 0x5d, 0xc4, 0x84, 0x99, 0x4b, 0xd2, 0xe0, 0x48, 0x6d, 0xaf, 0x21, 0x22,
+	0x77, 0x43, 0x23, 0x87, 0x24, 0x0d, 0x8f, 0xe8, 0x81, 0x5a, 0x86, 0x7b, 0xe0, 0x4e, 0x12, 0xa5,
+	0x1c, 0xfb, 0x31, 0xa9, 0x73, 0x5e, 0x96, 0x92, 0xdb, 0x55, 0x69, 0x6f, 0x2a, 0xc9, 0x09, 0x02,
+	0x72, 0xd7, 0xeb, 0x15, 0x9d, 0xf5, 0xf7, 0x06, 0xd8, 0x0a, 0x68, 0x92, 0x14, 0x69, 0xc4, 0xcf,
+	0xbc, 0x8c, 0xd2, 0xf8, 0xb2, 0x1d, 0x57, 0x64, 0xe2, 0xfd, 0x99, 0xdb, 0x51, 0xa7, 0x73, 0x8d,
+	0x2c, 0x72, 0x9b, 0x43, 0xa4, 0x4f, 0x69, 0x5c, 0xb7, 0xe1, 0xce, 0xf2, 0xb3, 0xe7, 0x76, 0xe3,
+	0xef, 0xe7, 0xb6, 0x81, 0xfe, 0x59, 0x02, 0x0b, 0x87, 0x1c, 0x73, 0x02, 0xbf, 0x33, 0xc0, 0x6d,
+	0x5d, 0x6e, 0x21, 0x62, 0x1a, 0xed, 0xb9, 0xce, 0xed, 0x77, 0x5b, 0x5d, 0xb5, 0x73, 0x57, 0xcc,
+	0x76, 0x57, 0xcf, 0x76, 0x77, 0x8f, 0x46, 0xa9, 0xf3, 0x48, 0xb8, 0xad, 0x4a, 0x1b, 0x8e, 0xbd,
+	0x2a, 0x11, 0x8b, 0x7e, 0xfd, 0xd3, 0xee, 0xfc, 0x8f, 0x1c, 0x84, 0x0c, 0x73, 0x81, 0x8a, 0x14,
+	0x06, 0xe1, 0xcf, 0x06, 0xd0, 0xef, 0x56, 0x75, 0xbb, 0x87, 0x13, 0xd1, 0x74, 0xe6, 0xad, 0x69,
+	0x66, 0x3e, 0xd3, 0x66, 0xb6, 0xc7, 0xcc, 0x8c, 0x6a, 0xcc, 0x66, 0xea, 0x15, 0xa5, 0x20, 0x47,
+	0x6b, 0x57, 0xc6, 0xc3, 0x0f, 0xc0, 0x5a, 0x8c, 0x19, 0xf7, 0x18, 0xf9, 0xba, 0x20, 0x69, 0x40,
+	0xe4, 0xc4, 0xce, 0x3b, 0x66, 0x55, 0xda, 0x1b, 0x6a, 0xd7, 0x31, 0x18, 0xb9, 0xab, 0xe2, 0xf9,
+	0x50, 0x3f, 0xc2, 0x14, 0x58, 0x12, 0xd7, 0xd6, 0xc2, 0x88, 0xf1, 0x3c, 0xf2, 0x8b, 0xcb, 0xe3,
+	0xcc, 0x9c, 0x97, 0x27, 0xc0, 0x5b, 0x97, 0xa7, 0xcc, 0xcd, 0x7c, 0xe4, 0xde, 0x13, 0x04, 0x75,
+	0xc2, 0xec, 0x8f, 0xc0, 0xd2, 0xb4, 0x68, 0xb7, 0x35, 0x4e, 0x39, 0x8e, 0xbd, 0x41, 0x8e, 0x7d,
+	0x9f, 0x84, 0xe6, 0xc2, 0xb4, 0x22, 0x7e, 0xa2, 0x8b, 0xa8, 0xd3, 0x19, 0x8b, 0x9e, 0xad, 0x7c,
+	0xab, 0x32, 0xf6, 0x63, 0x15, 0x0a, 0x7f, 0x30, 0xc0, 0xba, 0x12, 0xcb, 0x49, 0xc4, 0x58, 0x41,
+	0x42, 0x73, 0x79, 0x9a, 0x97, 0x4f, 0xb5, 0x97, 0xe6, 0xa8, 0x97, 0x3a, 0x7c, 0x36, 0x33, 0xaa,
+	0x0c, 0xae, 0x8e, 0x85, 0xbf, 0x18, 0xa0, 0x99, 0xe5, 0xf4, 0x34, 0x62, 0xa2, 0x94, 0x6a, 0x60,
+	0x8a, 0x34, 0x24, 0xa1, 0xb9, 0x38, 0xcd, 0x54, 0x5f, 0x9b, 0xd2, 0xa7, 0xd3, 0x95, 0x2a, 0xb3,
+	0x79, 0xbb, 0x3b, 0xd4, 0x90, 0x03, 0x2a, 0x15, 0xe0, 0x17, 0x60, 0x73, 0x42, 0x9a, 0xd3, 0xcc,
+	0x2b, 0x32, 0x71, 0x90, 0x89, 0x96, 0x7b, 0xad, 0x2a, 0xed, 0x57, 0xaf, 0xb4, 0xa0, 0x79, 0x68,
+	0x42, 0xf7, 0x88, 0x66, 0x9f, 0x67, 0x6c, 0x67, 0x5e, 0x8e, 0xfc, 0x6f, 0x06, 0x58, 0xeb, 0x17,
+	0x39, 0x23, 0x0e, 0x8e, 0x71, 0x1a, 0x10, 0x06, 0xdf, 0x06, 0x4b, 0x38, 0x0c, 0x73, 0xc2, 0xd4,
+	0xff, 0xef, 0x8a, 0x03, 0xab, 0xd2, 0x5e, 0x57, 0x1b, 0x68, 0x00, 0xb9, 0x35, 0x05, 0x7e, 0x0b,
+	0x96, 0x7d, 0x1d, 0x39, 0x7d, 0x2e, 0xf7, 0x74, 0xc5, 0xee, 0x28, 0xb5, 0x3a, 0x70, 0xb6, 0x22,
+	0x0d, 0xf7, 0x73, 0xdc, 0x17, 0xe7, 0x96, 0xf1, 0xf2, 0xdc, 0x32, 0xfe, 0x3a, 0xb7, 0x8c, 0x1f,
+	0x2f, 0xac, 0xc6, 0xcb, 0x0b, 0xab, 0xf1, 0xfb, 0x85, 0xd5, 0x78, 0xfc, 0xfe, 0x88, 0xda, 0xae,
+	0xba, 0xcc, 0xa8, 0x9b, 0x8b, 0x54, 0x1b, 0xd0, 0x18, 0xa7, 0x83, 0x7a, 0x9b, 0x6f, 0xf4, 0x3d,
+	0x47, 0xee, 0xe1, 0x2f, 0xca, 0x4b, 0xca, 0x7b, 0xff, 0x0e, 0x00, 0x74, 0x51, 0xf5, 0xff, 0x04,
+	0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -258,6 +429,36 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.AllowedDenoms) != len(that1.AllowedDenoms) {
+		return false
+	}
+	for i := range this.AllowedDenoms {
+		if this.AllowedDenoms[i] != that1.AllowedDenoms[i] {
+			return false
+		}
+	}
+	if this.RewardSmoothingMode != that1.RewardSmoothingMode {
+		return false
+	}
+	if len(this.AllowedSendToModules) != len(that1.AllowedSendToModules) {
+		return false
+	}
+	for i := range this.AllowedSendToModules {
+		if this.AllowedSendToModules[i] != that1.AllowedSendToModules[i] {
+			return false
+		}
+	}
+	if len(this.MintableDenoms) != len(that1.MintableDenoms) {
+		return false
+	}
+	for i := range this.MintableDenoms {
+		if this.MintableDenoms[i] != that1.MintableDenoms[i] {
+			return false
+		}
+	}
+	if !this.CommunityPoolFraction.Equal(that1.CommunityPoolFraction) {
+		return false
+	}
 	return true
 }
 func (this *State) Equal(that interface{}) bool {
@@ -301,6 +502,33 @@ func (this *State) Equal(that interface{}) bool {
 	if this.LastRewardDistributionBlock != that1.LastRewardDistributionBlock {
 		return false
 	}
+	if len(this.TotalGrabbed) != len(that1.TotalGrabbed) {
+		return false
+	}
+	for i := range this.TotalGrabbed {
+		if !this.TotalGrabbed[i].Equal(&that1.TotalGrabbed[i]) {
+			return false
+		}
+	}
+	if len(this.TotalReissued) != len(that1.TotalReissued) {
+		return false
+	}
+	for i := range this.TotalReissued {
+		if !this.TotalReissued[i].Equal(&that1.TotalReissued[i]) {
+			return false
+		}
+	}
+	if len(this.ProvisionPoolFunded) != len(that1.ProvisionPoolFunded) {
+		return false
+	}
+	for i := range this.ProvisionPoolFunded {
+		if !this.ProvisionPoolFunded[i].Equal(&that1.ProvisionPoolFunded[i]) {
+			return false
+		}
+	}
+	if this.ProvisionPoolTopUps != that1.ProvisionPoolTopUps {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -323,6 +551,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.CommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.MintableDenoms) > 0 {
+		for iNdEx := len(m.MintableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MintableDenoms[iNdEx])
+			copy(dAtA[i:], m.MintableDenoms[iNdEx])
+			i = encodeVarintVbank(dAtA, i, uint64(len(m.MintableDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedSendToModules) > 0 {
+		for iNdEx := len(m.AllowedSendToModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSendToModules[iNdEx])
+			copy(dAtA[i:], m.AllowedSendToModules[iNdEx])
+			i = encodeVarintVbank(dAtA, i, uint64(len(m.AllowedSendToModules[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardSmoothingMode) > 0 {
+		i -= len(m.RewardSmoothingMode)
+		copy(dAtA[i:], m.RewardSmoothingMode)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.RewardSmoothingMode)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintVbank(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.AllowedMonitoringAccounts) > 0 {
 		for iNdEx := len(m.AllowedMonitoringAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedMonitoringAccounts[iNdEx])
@@ -375,6 +647,53 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TotalReissued) > 0 {
+		for iNdEx := len(m.TotalReissued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalReissued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ProvisionPoolTopUps != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.ProvisionPoolTopUps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProvisionPoolFunded) > 0 {
+		for iNdEx := len(m.ProvisionPoolFunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvisionPoolFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalGrabbed) > 0 {
+		for iNdEx := len(m.TotalGrabbed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalGrabbed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastRewardDistributionBlock != 0 {
 		i = encodeVarintVbank(dAtA, i, uint64(m.LastRewardDistributionBlock))
 		i--
@@ -416,6 +735,50 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PurseBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurseBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurseBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVbank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVbank(v)
 	base := offset
@@ -447,6 +810,30 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovVbank(uint64(l))
 		}
 	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	l = len(m.RewardSmoothingMode)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if len(m.AllowedSendToModules) > 0 {
+		for _, s := range m.AllowedSendToModules {
+			l = len(s)
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.MintableDenoms) > 0 {
+		for _, s := range m.MintableDenoms {
+			l = len(s)
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	l = m.CommunityPoolFraction.Size()
+	n += 1 + l + sovVbank(uint64(l))
 	return n
 }
 
@@ -474,6 +861,46 @@ func (m *State) Size() (n int) {
 	if m.LastRewardDistributionBlock != 0 {
 		n += 1 + sovVbank(uint64(m.LastRewardDistributionBlock))
 	}
+	if len(m.TotalGrabbed) > 0 {
+		for _, e := range m.TotalGrabbed {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.ProvisionPoolFunded) > 0 {
+		for _, e := range m.ProvisionPoolFunded {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.ProvisionPoolTopUps != 0 {
+		n += 1 + sovVbank(uint64(m.ProvisionPoolTopUps))
+	}
+	if len(m.TotalReissued) > 0 {
+		for _, e := range m.TotalReissued {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PurseBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -616,17 +1043,179 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedMonitoringAccounts = append(m.AllowedMonitoringAccounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipVbank(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthVbank
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSmoothingMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSmoothingMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSendToModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSendToModules = append(m.AllowedSendToModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintableDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintableDenoms = append(m.MintableDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -772,6 +1361,243 @@ func (m *State) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGrabbed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalGrabbed = append(m.TotalGrabbed, types.Coin{})
+			if err := m.TotalGrabbed[len(m.TotalGrabbed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionPoolFunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionPoolFunded = append(m.ProvisionPoolFunded, types.Coin{})
+			if err := m.ProvisionPoolFunded[len(m.ProvisionPoolFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionPoolTopUps", wireType)
+			}
+			m.ProvisionPoolTopUps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisionPoolTopUps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReissued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalReissued = append(m.TotalReissued, types.Coin{})
+			if err := m.TotalReissued[len(m.TotalReissued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurseBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurseBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurseBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVbank(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type portHandler struct {
@@ -99,6 +100,7 @@ func getBalanceUpdate(ctx sdk.Context, keeper Keeper, addressToUpdate map[string
 		for _, coin := range coins {
 			// generate an update even when the current balance is zero
 			balance := keeper.GetBalance(ctx, account, coin.Denom)
+			keeper.RecordReportedBalance(ctx, account, balance)
 			update := VbankSingleBalanceUpdate{
 				Address: address,
 				Denom:   coin.Denom,
@@ -165,6 +167,7 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		if err := keeper.GrabCoins(ctx, addr, coins); err != nil {
 			return "", fmt.Errorf("cannot grab %s coins: %s", coins.Sort().String(), err)
 		}
+		keeper.RecordPurseWithdrawal(ctx, addr, coins)
 		addressToBalances := make(map[string]sdk.Coins, 1)
 		addressToBalances[msg.Sender] = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
 		bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
@@ -193,6 +196,7 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		if err := keeper.SendCoins(ctx, addr, coins); err != nil {
 			return "", fmt.Errorf("cannot give %s coins: %s", coins.Sort().String(), err)
 		}
+		keeper.RecordPurseDeposit(ctx, addr, coins)
 		addressToBalances := make(map[string]sdk.Coins, 1)
 		addressToBalances[msg.Recipient] = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
 		bz, err := marshal(getBalanceUpdate(ctx, keeper, addressToBalances))
@@ -220,6 +224,28 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		// We don't supply the module balance, since the controller shouldn't know.
 		ret = "true"
 
+	case "VBANK_SEND_TO_FEE_COLLECTOR", "VBANK_SEND_TO_MODULE":
+		moduleName := msg.ModuleName
+		if msg.Type == "VBANK_SEND_TO_FEE_COLLECTOR" {
+			moduleName = authtypes.FeeCollectorName
+		}
+		if !keeper.IsAllowedSendToModule(ctx, moduleName) {
+			return "", fmt.Errorf("sending to module account %s is not allowed", moduleName)
+		}
+		if err = sdk.ValidateDenom(msg.Denom); err != nil {
+			return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
+		}
+		value, ok := sdk.NewIntFromString(msg.Amount)
+		if !ok {
+			return "", fmt.Errorf("cannot convert %s to int", msg.Amount)
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, value))
+		if err := keeper.SendCoinsToModule(ctx, moduleName, coins); err != nil {
+			return "", fmt.Errorf("cannot send %s coins to %s: %s", coins.Sort().String(), moduleName, err)
+		}
+		// We don't supply the module balance, since the controller shouldn't know.
+		ret = "true"
+
 	case "VBANK_GET_MODULE_ACCOUNT_ADDRESS":
 		addr := keeper.GetModuleAccountAddress(ctx, msg.ModuleName).String()
 		if len(addr) == 0 {
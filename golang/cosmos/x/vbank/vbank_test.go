@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
@@ -248,6 +249,10 @@ func (b *mockBank) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, re
 
 // makeTestKit creates a minimal Keeper and Context for use in testing.
 func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sdk.Context) {
+	return makeTestKitWithDistribution(account, bank, nil)
+}
+
+func makeTestKitWithDistribution(account types.AccountKeeper, bank types.BankKeeper, distribution types.DistributionKeeper) (Keeper, sdk.Context) {
 	encodingConfig := params.MakeEncodingConfig()
 	cdc := encodingConfig.Marshaler
 	pushAction := func(ctx sdk.Context, action vm.Action) error {
@@ -259,7 +264,7 @@ func makeTestKit(account types.AccountKeeper, bank types.BankKeeper) (Keeper, sd
 	pk := paramskeeper.NewKeeper(cdc, encodingConfig.Amino, paramsStoreKey, paramsTStoreKey)
 
 	subspace := pk.Subspace(types.ModuleName)
-	keeper := NewKeeper(cdc, vbankStoreKey, subspace, account, bank, "feeCollectorName", pushAction)
+	keeper := NewKeeper(cdc, vbankStoreKey, subspace, account, bank, distribution, "feeCollectorName", pushAction)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -506,6 +511,58 @@ func Test_Receive_Grab(t *testing.T) {
 	if !reflect.DeepEqual(bank.calls, wantCalls) {
 		t.Errorf("got calls %v, want {%s}", bank.calls, wantCalls)
 	}
+
+	res, err := keeper.TotalBurned(sdk.WrapSDKContext(ctx), &types.QueryTotalBurnedRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantBurned := sdk.NewCoins(sdk.NewInt64Coin("ubld", 500))
+	if !res.TotalBurned.IsEqual(wantBurned) {
+		t.Errorf("got total burned %v, want %v", res.TotalBurned, wantBurned)
+	}
+}
+
+func Test_TotalBurned_Reissued(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	for _, msg := range []string{
+		`{"type": "VBANK_GRAB", "sender": "` + addr1 + `", "amount": "500", "denom": "ubld"}`,
+		`{"type": "VBANK_GIVE", "recipient": "` + addr1 + `", "amount": "200", "denom": "ubld"}`,
+		`{"type": "VBANK_GIVE", "recipient": "` + addr1 + `", "amount": "100", "denom": "urun"}`,
+		`{"type": "VBANK_GIVE_TO_REWARD_DISTRIBUTOR", "amount": "50", "denom": "ubld"}`,
+	} {
+		if _, err := ch.Receive(ctlCtx, msg); err != nil {
+			t.Fatalf("%s got error = %v", msg, err)
+		}
+	}
+
+	// Only the grabbed coins which were not given back out have left the
+	// supply, and minting a denom never grabbed burns nothing.
+	res, err := keeper.TotalBurned(ctlCtx, &types.QueryTotalBurnedRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	wantBurned := sdk.NewCoins(sdk.NewInt64Coin("ubld", 250))
+	if !res.TotalBurned.IsEqual(wantBurned) {
+		t.Errorf("got total burned %v, want %v", res.TotalBurned, wantBurned)
+	}
+
+	// Giving back more than was grabbed burns nothing.
+	if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_GIVE", "recipient": "`+addr1+`", "amount": "300", "denom": "ubld"}`); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	res, err = keeper.TotalBurned(ctlCtx, &types.QueryTotalBurnedRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.TotalBurned.IsZero() {
+		t.Errorf("got total burned %v after reissuing all, want none", res.TotalBurned)
+	}
 }
 
 func Test_EndBlock_Events(t *testing.T) {
@@ -619,6 +676,84 @@ func Test_EndBlock_Events(t *testing.T) {
 	}
 }
 
+func Test_EndBlock_AllowedDenoms(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000)),
+	}}
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{
+			addr1: &authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{Address: addr1}},
+			addr2: &authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{Address: addr2}},
+		},
+	}
+	keeper, ctx := makeTestKit(acct, bank)
+	// Turn off rewards, and reflect only ubld and urun.
+	keeper.SetParams(ctx, types.Params{
+		PerEpochRewardFraction:    sdk.ZeroDec(),
+		AllowedMonitoringAccounts: []string{"*"},
+		AllowedDenoms:             []string{"ubld", "urun"},
+	})
+	if !keeper.IsAllowedDenom(ctx, "ubld") {
+		t.Errorf("got IsAllowedDenom ubld = false, want true")
+	}
+	if keeper.IsAllowedDenom(ctx, "ushmoo") {
+		t.Errorf("got IsAllowedDenom ushmoo = true, want false")
+	}
+	if !(types.Params{}).IsAllowedDenom("ushmoo") {
+		t.Errorf("got IsAllowedDenom ushmoo = false with no allowed_denoms, want true")
+	}
+	msgsSent := []string{}
+	keeper.PushAction = func(ctx sdk.Context, action vm.Action) error {
+		bz, err := json.Marshal(action)
+		if err != nil {
+			return err
+		}
+		msgsSent = append(msgsSent, string(bz))
+		return nil
+	}
+	am := NewAppModule(keeper)
+
+	events := []abci.Event{
+		{
+			Type: "coin_received",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("receiver"), Value: []byte(addr1)},
+				{Key: []byte("amount"), Value: []byte("500ubld,700ushmoo")},
+			},
+		},
+		{
+			Type: "coin_received",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("receiver"), Value: []byte(addr2)},
+				{Key: []byte("amount"), Value: []byte("1ibc/spam")},
+			},
+		},
+	}
+	em := sdk.NewEventManagerWithHistory(events)
+	ctx = ctx.WithEventManager(em)
+
+	am.EndBlock(ctx, abci.RequestEndBlock{})
+
+	wantCalls := []string{
+		"GetBalance " + addr1 + " ubld",
+	}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got calls %v, want {%s}", bank.calls, wantCalls)
+	}
+
+	if len(msgsSent) != 1 {
+		t.Fatalf("got msgs = %v, want one message", msgsSent)
+	}
+	gotMsg, _, err := decodeBalances([]byte(msgsSent[0]))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	wantMsg := newBalances(account(addr1, coin("ubld", "1000")))
+	if !reflect.DeepEqual(gotMsg, wantMsg) {
+		t.Errorf("got sent message %v, want %v", gotMsg, wantMsg)
+	}
+}
+
 func Test_EndBlock_Rewards(t *testing.T) {
 	bank := &mockBank{
 		balances: map[string]sdk.Coins{
@@ -747,6 +882,146 @@ func Test_EndBlock_Rewards(t *testing.T) {
 	}
 }
 
+func Test_DistributeRewards_SmoothingModes(t *testing.T) {
+	bank := &mockBank{}
+	keeper, ctx := makeTestKit(nil, bank)
+
+	tests := []struct {
+		name      string
+		mode      string
+		height    int64
+		lastBlock int64
+		rate      sdk.Coins
+		wantXfer  string
+		wantRate  sdk.Coins
+	}{
+		{
+			name:      "linear",
+			mode:      types.RewardSmoothingModeLinear,
+			height:    21,
+			lastBlock: 20,
+			rate:      sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+			wantXfer:  "100urun",
+			wantRate:  sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+		},
+		{
+			name:      "linearAfterSmoothing",
+			mode:      "",
+			height:    25,
+			lastBlock: 20,
+			rate:      sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+			wantRate:  sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+		},
+		{
+			name:      "exponentialDecay",
+			mode:      types.RewardSmoothingModeExponentialDecay,
+			height:    25,
+			lastBlock: 20,
+			rate:      sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+			wantXfer:  "250urun",
+			wantRate:  sdk.NewCoins(sdk.NewInt64Coin("urun", 250)),
+		},
+		{
+			name:      "immediateNewEpoch",
+			mode:      types.RewardSmoothingModeImmediate,
+			height:    30,
+			lastBlock: 20,
+			rate:      sdk.NewCoins(),
+			wantXfer:  "1000urun",
+			wantRate:  sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+		},
+		{
+			name:      "immediateMidEpoch",
+			mode:      types.RewardSmoothingModeImmediate,
+			height:    21,
+			lastBlock: 20,
+			rate:      sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+			wantRate:  sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank.calls = []string{}
+			ctx := ctx.WithBlockHeight(tt.height)
+			keeper.SetParams(ctx, types.Params{
+				RewardEpochDurationBlocks: 10,
+				RewardSmoothingBlocks:     4,
+				PerEpochRewardFraction:    sdk.OneDec(),
+				RewardSmoothingMode:       tt.mode,
+			})
+			keeper.SetState(ctx, types.State{
+				RewardPool:                  sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+				RewardBlockAmount:           tt.rate,
+				LastRewardDistributionBlock: tt.lastBlock,
+			})
+
+			if err := keeper.DistributeRewards(ctx); err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+
+			wantCalls := []string{}
+			if tt.wantXfer != "" {
+				wantCalls = append(wantCalls, "SendCoinsFromModuleToModule vbank feeCollectorName "+tt.wantXfer)
+			}
+			if !reflect.DeepEqual(bank.calls, wantCalls) {
+				t.Errorf("got calls %v, want %v", bank.calls, wantCalls)
+			}
+			state := keeper.GetState(ctx)
+			if !state.RewardBlockAmount.IsEqual(tt.wantRate) {
+				t.Errorf("got rate %v, want %v", state.RewardBlockAmount, tt.wantRate)
+			}
+		})
+	}
+}
+
+func Test_Query_RewardPool(t *testing.T) {
+	keeper, ctx := makeTestKit(nil, nil)
+	ctx = ctx.WithBlockHeight(12)
+	keeper.SetParams(ctx, types.Params{
+		RewardEpochDurationBlocks: 10,
+		RewardSmoothingBlocks:     5,
+		PerEpochRewardFraction:    sdk.OneDec(),
+	})
+
+	tests := []struct {
+		name          string
+		lastBlock     int64
+		wantEpoch     int64
+		wantSmoothing int64
+	}{
+		{name: "smoothing", lastBlock: 10, wantEpoch: 8, wantSmoothing: 2},
+		{name: "smoothingDone", lastBlock: 5, wantEpoch: 3, wantSmoothing: 0},
+		{name: "epochDue", lastBlock: 1, wantEpoch: 1, wantSmoothing: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := types.State{
+				RewardPool:                  sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+				RewardBlockAmount:           sdk.NewCoins(sdk.NewInt64Coin("urun", 25)),
+				LastRewardDistributionBlock: tt.lastBlock,
+			}
+			keeper.SetState(ctx, state)
+
+			res, err := keeper.RewardPool(sdk.WrapSDKContext(ctx), &types.QueryRewardPoolRequest{})
+			if err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+			if !res.RewardPool.IsEqual(state.RewardPool) {
+				t.Errorf("got pool %v, want %v", res.RewardPool, state.RewardPool)
+			}
+			if !res.RewardBlockAmount.IsEqual(state.RewardBlockAmount) {
+				t.Errorf("got rate %v, want %v", res.RewardBlockAmount, state.RewardBlockAmount)
+			}
+			if res.RemainingEpochBlocks != tt.wantEpoch {
+				t.Errorf("got remaining epoch blocks %d, want %d", res.RemainingEpochBlocks, tt.wantEpoch)
+			}
+			if res.RemainingSmoothingBlocks != tt.wantSmoothing {
+				t.Errorf("got remaining smoothing blocks %d, want %d", res.RemainingSmoothingBlocks, tt.wantSmoothing)
+			}
+		})
+	}
+}
+
 type mockAuthKeeper struct {
 	accounts map[string]authtypes.AccountI
 	modAddrs map[string]string
@@ -821,3 +1096,425 @@ func Test_Module_Account(t *testing.T) {
 		t.Errorf("got IsAllowedMonitoringAccount missingAddr = false, want true")
 	}
 }
+
+func Test_Receive_SendToModule(t *testing.T) {
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{},
+		modAddrs: map[string]string{},
+	}
+	for _, name := range []string{authtypes.FeeCollectorName, "vbank/reserve"} {
+		addr := authtypes.NewModuleAddress(name).String()
+		acct.accounts[addr] = authtypes.NewEmptyModuleAccount(name)
+		acct.modAddrs[name] = addr
+	}
+	bank := &mockBank{}
+	keeper, ctx := makeTestKit(acct, bank)
+	ch := NewPortHandler(AppModule{keeper: keeper}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	tests := []struct {
+		name      string
+		msg       string
+		allowed   []string
+		wantCalls []string
+		wantErr   string
+	}{
+		{
+			name: "fee collector",
+			msg:  `{"type": "VBANK_SEND_TO_FEE_COLLECTOR", "amount": "123", "denom": "ubld"}`,
+			wantCalls: []string{
+				"MintCoins vbank 123ubld",
+				"SendCoinsFromModuleToModule vbank fee_collector 123ubld",
+			},
+		},
+		{
+			name:    "allowed module",
+			msg:     `{"type": "VBANK_SEND_TO_MODULE", "moduleName": "vbank/reserve", "amount": "45", "denom": "uist"}`,
+			allowed: []string{"vbank/reserve"},
+			wantCalls: []string{
+				"MintCoins vbank 45uist",
+				"SendCoinsFromModuleToModule vbank vbank/reserve 45uist",
+			},
+		},
+		{
+			name:    "module not allowed by default",
+			msg:     `{"type": "VBANK_SEND_TO_MODULE", "moduleName": "vbank/reserve", "amount": "45", "denom": "uist"}`,
+			wantErr: "not allowed",
+		},
+		{
+			name:    "fee collector not allowed",
+			msg:     `{"type": "VBANK_SEND_TO_FEE_COLLECTOR", "amount": "123", "denom": "ubld"}`,
+			allowed: []string{"vbank/reserve"},
+			wantErr: "not allowed",
+		},
+		{
+			name:    "missing module account",
+			msg:     `{"type": "VBANK_SEND_TO_MODULE", "moduleName": "vbank/missing", "amount": "45", "denom": "uist"}`,
+			allowed: []string{"vbank/missing"},
+			wantErr: "not found",
+		},
+		{
+			name:    "invalid amount",
+			msg:     `{"type": "VBANK_SEND_TO_FEE_COLLECTOR", "amount": "lots", "denom": "ubld"}`,
+			wantErr: "cannot convert",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			if tt.allowed != nil {
+				params.AllowedSendToModules = tt.allowed
+			}
+			keeper.SetParams(ctx, params)
+			bank.calls = nil
+
+			ret, err := ch.Receive(ctlCtx, tt.msg)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("got error %v, want one containing %q", err, tt.wantErr)
+				}
+				if len(bank.calls) != 0 {
+					t.Errorf("got calls %v, want none", bank.calls)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+			if ret != "true" {
+				t.Errorf("got %v, want true", ret)
+			}
+			if !reflect.DeepEqual(bank.calls, tt.wantCalls) {
+				t.Errorf("got calls %v, want {%s}", bank.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_Receive_MintableDenoms(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000), sdk.NewInt64Coin("uist", 1000)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.MintableDenoms = []string{"uist"}
+	keeper.SetParams(ctx, params)
+
+	tests := []struct {
+		name    string
+		typ     string
+		denom   string
+		wantErr bool
+	}{
+		{name: "give mintable", typ: "VBANK_GIVE", denom: "uist"},
+		{name: "grab mintable", typ: "VBANK_GRAB", denom: "uist"},
+		{name: "give unmintable", typ: "VBANK_GIVE", denom: "ubld", wantErr: true},
+		{name: "grab unmintable", typ: "VBANK_GRAB", denom: "ubld", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank.calls = nil
+			_, err := ch.Receive(ctlCtx, `{
+				"type": "`+tt.typ+`",
+				"sender": "`+addr1+`",
+				"recipient": "`+addr1+`",
+				"amount": "5",
+				"denom": "`+tt.denom+`"
+				}`)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("got error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "not mintable") {
+				t.Errorf("got error %v, want one containing %q", err, "not mintable")
+			}
+			if len(bank.calls) != 0 {
+				t.Errorf("got calls %v, want none", bank.calls)
+			}
+		})
+	}
+
+	res, err := keeper.MintableDenoms(sdk.WrapSDKContext(ctx), &types.QueryMintableDenomsRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !reflect.DeepEqual(res.Denoms, []string{"uist"}) || res.AllowAll {
+		t.Errorf("got %+v, want only uist", res)
+	}
+
+	keeper.SetParams(ctx, types.DefaultParams())
+	res, err = keeper.MintableDenoms(sdk.WrapSDKContext(ctx), &types.QueryMintableDenomsRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.AllowAll {
+		t.Errorf("got %+v, want all denoms allowed by default", res)
+	}
+}
+
+type mockDistribution struct {
+	bank *mockBank
+}
+
+var _ types.DistributionKeeper = mockDistribution{}
+
+func (d mockDistribution) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	d.bank.record(fmt.Sprintf("FundCommunityPool %s %v", sender, amount))
+	return nil
+}
+
+func Test_EndBlock_CommunityPoolFraction(t *testing.T) {
+	bank := &mockBank{}
+	keeper, ctx := makeTestKitWithDistribution(nil, bank, mockDistribution{bank: bank})
+	am := NewAppModule(keeper)
+	vbankAddr := authtypes.NewModuleAddress(ModuleName).String()
+
+	tests := []struct {
+		name      string
+		fraction  sdk.Dec
+		wantCalls []string
+	}{
+		{
+			name:     "unset",
+			fraction: sdk.Dec{},
+			wantCalls: []string{
+				"SendCoinsFromModuleToModule vbank feeCollectorName 100urun",
+			},
+		},
+		{
+			name:     "split",
+			fraction: sdk.NewDecWithPrec(25, 2),
+			wantCalls: []string{
+				"FundCommunityPool " + vbankAddr + " 25urun",
+				"SendCoinsFromModuleToModule vbank feeCollectorName 75urun",
+			},
+		},
+		{
+			name:     "rounded down",
+			fraction: sdk.NewDecWithPrec(1, 3),
+			wantCalls: []string{
+				"SendCoinsFromModuleToModule vbank feeCollectorName 100urun",
+			},
+		},
+		{
+			name:     "all",
+			fraction: sdk.OneDec(),
+			wantCalls: []string{
+				"FundCommunityPool " + vbankAddr + " 100urun",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank.calls = nil
+			keeper.SetState(ctx, types.State{
+				RewardPool:        sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+				RewardBlockAmount: sdk.NewCoins(sdk.NewInt64Coin("urun", 100)),
+			})
+			keeper.SetParams(ctx, types.Params{
+				RewardEpochDurationBlocks: 3,
+				RewardSmoothingBlocks:     1,
+				PerEpochRewardFraction:    sdk.OneDec(),
+				CommunityPoolFraction:     tt.fraction,
+			})
+
+			am.EndBlock(ctx, abci.RequestEndBlock{})
+
+			if !reflect.DeepEqual(bank.calls, tt.wantCalls) {
+				t.Errorf("got calls %v, want %v", bank.calls, tt.wantCalls)
+			}
+			state := keeper.GetState(ctx)
+			wantPool := sdk.NewCoins(sdk.NewInt64Coin("urun", 900))
+			if !state.RewardPool.IsEqual(wantPool) {
+				t.Errorf("got pool %v, want %v", state.RewardPool, wantPool)
+			}
+		})
+	}
+}
+
+func Test_Invariants(t *testing.T) {
+	reserve := authtypes.NewModuleAddress(types.ReservePoolName)
+	reserveAddr := reserve.String()
+	vbankAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		reserveAddr: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+		addr1:       sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+	receive := func(msg string) {
+		t.Helper()
+		if _, err := ch.Receive(ctlCtx, msg); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	wantPurses := func(want sdk.Coins) {
+		t.Helper()
+		if got := keeper.GetPurseBalances(ctx, reserve); !got.IsEqual(want) {
+			t.Errorf("got reserve purses %v, want %v", got, want)
+		}
+	}
+
+	for _, recipient := range []string{reserveAddr, addr1} {
+		receive(`{
+			"type": "VBANK_GIVE",
+			"recipient": "` + recipient + `",
+			"amount": "1000",
+			"denom": "urun"
+			}`)
+	}
+	wantPurses(sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)))
+	if got := keeper.GetPurseBalances(ctx, sdk.MustAccAddressFromBech32(addr1)); !got.Empty() {
+		t.Errorf("got %s purses %v, want none", addr1, got)
+	}
+	if msg, broken := ModuleAccountPursesInvariant(keeper)(ctx); broken {
+		t.Errorf("unexpectedly broken: %s", msg)
+	}
+
+	// A withdrawal by SwingSet lowers the purse balance.
+	bank.balances[reserveAddr] = sdk.NewCoins(sdk.NewInt64Coin("urun", 600))
+	receive(`{
+		"type": "VBANK_GRAB",
+		"sender": "` + reserveAddr + `",
+		"amount": "400",
+		"denom": "urun"
+		}`)
+	wantPurses(sdk.NewCoins(sdk.NewInt64Coin("urun", 600)))
+	if msg, broken := ModuleAccountPursesInvariant(keeper)(ctx); broken {
+		t.Errorf("unexpectedly broken: %s", msg)
+	}
+
+	// Coins leaving behind the back of SwingSet break the invariant.
+	bank.balances[reserveAddr] = sdk.NewCoins(sdk.NewInt64Coin("urun", 500))
+	bank.balances[addr1] = sdk.NewCoins()
+	if _, broken := ModuleAccountPursesInvariant(keeper)(ctx); !broken {
+		t.Error("reserve short of its purse did not break the invariant")
+	}
+
+	// The purse balances survive a genesis export and import.
+	exported := ExportGenesis(ctx, keeper)
+	if err := ValidateGenesis(exported); err != nil {
+		t.Fatalf("exported genesis is invalid: %v", err)
+	}
+	imported, importedCtx := makeTestKit(nil, bank)
+	InitGenesis(importedCtx, imported, exported)
+	if got := imported.GetPurseBalances(importedCtx, reserve); !got.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("urun", 600))) {
+		t.Errorf("got imported reserve purses %v", got)
+	}
+	if _, broken := ModuleAccountPursesInvariant(imported)(importedCtx); !broken {
+		t.Error("imported reserve short of its purse did not break the invariant")
+	}
+
+	// Once the lower balance has been reported, SwingSet believes it.
+	receive(`{
+		"type": "VBANK_GRAB",
+		"sender": "` + reserveAddr + `",
+		"amount": "1",
+		"denom": "urun"
+		}`)
+	wantPurses(sdk.NewCoins(sdk.NewInt64Coin("urun", 500)))
+	if msg, broken := ModuleAccountPursesInvariant(keeper)(ctx); broken {
+		t.Errorf("unexpectedly broken: %s", msg)
+	}
+
+	keeper.SetState(ctx, types.State{RewardPool: sdk.NewCoins(sdk.NewInt64Coin("urun", 100))})
+	if _, broken := RewardPoolInvariant(keeper)(ctx); !broken {
+		t.Error("unfunded reward pool did not break the invariant")
+	}
+	bank.balances[vbankAddr] = sdk.NewCoins(sdk.NewInt64Coin("urun", 100))
+	if msg, broken := RewardPoolInvariant(keeper)(ctx); broken {
+		t.Errorf("unexpectedly broken: %s", msg)
+	}
+}
+
+func Test_FundProvisionPool(t *testing.T) {
+	provisionAddr := authtypes.NewModuleAddress(types.ProvisionPoolName).String()
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		provisionAddr: sdk.NewCoins(sdk.NewInt64Coin("uist", 1500)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{"ubld", "uist"}
+	keeper.SetParams(ctx, params)
+	handler := NewHandler(keeper)
+	sender, err := sdk.AccAddressFromBech32(addr1)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+
+	_, err = handler(ctx, types.NewMsgFundProvisionPool(sender, sdk.NewCoins(sdk.NewInt64Coin("quatloos", 1))))
+	if err == nil {
+		t.Errorf("got no error for a denom not reflected into virtual purses")
+	}
+	if len(bank.calls) != 0 {
+		t.Errorf("got calls %v for a rejected top-up", bank.calls)
+	}
+
+	for i, amt := range []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("uist", 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin("ubld", 20), sdk.NewInt64Coin("uist", 500)),
+	} {
+		res, err := handler(ctx, types.NewMsgFundProvisionPool(sender, amt))
+		if err != nil {
+			t.Fatalf("top-up %d got error = %v", i, err)
+		}
+		wantCall := fmt.Sprintf("SendCoinsFromAccountToModule %s %s %s", addr1, types.ProvisionPoolName, amt)
+		if got := bank.calls[len(bank.calls)-1]; got != wantCall {
+			t.Errorf("top-up %d got call %q, want %q", i, got, wantCall)
+		}
+		var funded *types.EventProvisionPoolFunded
+		for _, event := range res.Events {
+			if event.Type != "agoric.vbank.EventProvisionPoolFunded" {
+				continue
+			}
+			typed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				t.Fatalf("top-up %d got event error = %v", i, err)
+			}
+			funded = typed.(*types.EventProvisionPoolFunded)
+		}
+		if funded == nil {
+			t.Fatalf("top-up %d got events %v, want EventProvisionPoolFunded", i, res.Events)
+		}
+		if funded.Sequence != uint64(i+1) || funded.Sender != addr1 || !funded.Amount.IsEqual(amt) {
+			t.Errorf("top-up %d got event %v", i, funded)
+		}
+	}
+
+	state := keeper.GetState(ctx)
+	wantFunded := sdk.NewCoins(sdk.NewInt64Coin("ubld", 20), sdk.NewInt64Coin("uist", 1500))
+	if !state.ProvisionPoolFunded.IsEqual(wantFunded) {
+		t.Errorf("got funded %v, want %v", state.ProvisionPoolFunded, wantFunded)
+	}
+	if state.ProvisionPoolTopUps != 2 {
+		t.Errorf("got %d top-ups, want 2", state.ProvisionPoolTopUps)
+	}
+
+	res, err := keeper.ProvisionPool(sdk.WrapSDKContext(ctx), &types.QueryProvisionPoolRequest{})
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if !res.Balance.IsEqual(bank.balances[provisionAddr]) {
+		t.Errorf("got balance %v, want %v", res.Balance, bank.balances[provisionAddr])
+	}
+	if !res.TotalFunded.IsEqual(wantFunded) {
+		t.Errorf("got total funded %v, want %v", res.TotalFunded, wantFunded)
+	}
+	if res.TopUps != 2 {
+		t.Errorf("got %d top-ups, want 2", res.TopUps)
+	}
+
+	gs := ExportGenesis(ctx, keeper)
+	if !gs.State.ProvisionPoolFunded.IsEqual(wantFunded) || gs.State.ProvisionPoolTopUps != 2 {
+		t.Errorf("got exported state %v, want the totals of the top-ups", gs.State)
+	}
+	if err := ValidateGenesis(gs); err != nil {
+		t.Errorf("got exported genesis error = %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package vibc
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+func NewGenesisState() *types.GenesisState {
+	return &types.GenesisState{}
+}
+
+func DefaultGenesisState() *types.GenesisState {
+	return &types.GenesisState{}
+}
+
+func ValidateGenesis(data *types.GenesisState) error {
+	if data == nil {
+		return fmt.Errorf("vibc genesis data cannot be nil")
+	}
+	seen := map[string]bool{}
+	for _, record := range data.InFlightPackets {
+		packet := record.Packet
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid in-flight packet: %w", err)
+		}
+		if record.DeadlineNs == 0 {
+			return fmt.Errorf("in-flight packet %s/%s/%d has no deadline", packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		}
+		key := fmt.Sprintf("%s/%s/%d", packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate in-flight packet %s", key)
+		}
+		seen[key] = true
+	}
+	seenPorts := map[string]bool{}
+	for _, record := range data.PortConfigs {
+		if err := host.PortIdentifierValidator(record.PortId); err != nil {
+			return fmt.Errorf("invalid port config: %w", err)
+		}
+		if seenPorts[record.PortId] {
+			return fmt.Errorf("duplicate port config for %s", record.PortId)
+		}
+		seenPorts[record.PortId] = true
+		config := types.PortConfig{Orders: record.Orders, Versions: record.Versions}
+		if err := config.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid port config for %s: %w", record.PortId, err)
+		}
+	}
+	seenPending := map[string]bool{}
+	for _, packet := range data.PendingAckPackets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid packet awaiting acknowledgement: %w", err)
+		}
+		key := fmt.Sprintf("%s/%s/%d", packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		if seenPending[key] {
+			return fmt.Errorf("duplicate packet awaiting acknowledgement %s", key)
+		}
+		seenPending[key] = true
+	}
+	seenControllers := map[string]bool{}
+	for _, record := range data.IcaControllers {
+		if err := host.ConnectionIdentifierValidator(record.ConnectionId); err != nil {
+			return fmt.Errorf("invalid interchain account controller: %w", err)
+		}
+		if err := host.PortIdentifierValidator(record.ControllerPortId); err != nil {
+			return fmt.Errorf("invalid interchain account controller: %w", err)
+		}
+		if !strings.HasPrefix(record.ControllerPortId, icatypes.ControllerPortPrefix) {
+			return fmt.Errorf("interchain account controller port %s lacks the %s prefix", record.ControllerPortId, icatypes.ControllerPortPrefix)
+		}
+		if record.Target == "" {
+			return fmt.Errorf("interchain account controller %s/%s has no target", record.ConnectionId, record.ControllerPortId)
+		}
+		key := record.ConnectionId + "/" + record.ControllerPortId
+		if seenControllers[key] {
+			return fmt.Errorf("duplicate interchain account controller %s", key)
+		}
+		seenControllers[key] = true
+	}
+	seenQueries := map[string]bool{}
+	for _, query := range data.PendingInterchainQueries {
+		if err := host.PortIdentifierValidator(query.PortId); err != nil {
+			return fmt.Errorf("invalid pending interchain query: %w", err)
+		}
+		if err := host.ChannelIdentifierValidator(query.ChannelId); err != nil {
+			return fmt.Errorf("invalid pending interchain query: %w", err)
+		}
+		key := fmt.Sprintf("%s/%s/%d", query.PortId, query.ChannelId, query.Sequence)
+		if query.Sequence == 0 {
+			return fmt.Errorf("pending interchain query %s has no sequence", key)
+		}
+		if query.Target == "" {
+			return fmt.Errorf("pending interchain query %s has no target", key)
+		}
+		if seenQueries[key] {
+			return fmt.Errorf("duplicate pending interchain query %s", key)
+		}
+		seenQueries[key] = true
+	}
+	return nil
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) {
+	for _, record := range data.InFlightPackets {
+		keeper.SetInFlightPacket(ctx, record)
+	}
+	for _, record := range data.PortConfigs {
+		keeper.SetPortConfig(ctx, record.PortId, types.PortConfig{Orders: record.Orders, Versions: record.Versions})
+	}
+	for _, packet := range data.PendingAckPackets {
+		keeper.SetPendingAckPacket(ctx, packet)
+	}
+	for _, record := range data.IcaControllers {
+		keeper.SetICAController(ctx, record.ConnectionId, record.ControllerPortId, record.Target)
+	}
+	for _, query := range data.PendingInterchainQueries {
+		keeper.SetPendingInterchainQuery(ctx, query)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
+	gs := NewGenesisState()
+	gs.InFlightPackets = keeper.GetInFlightPackets(ctx)
+	gs.PortConfigs = keeper.GetPortConfigs(ctx)
+	gs.PendingAckPackets = keeper.GetPendingAckPackets(ctx)
+	gs.IcaControllers = keeper.GetICAControllers(ctx)
+	gs.PendingInterchainQueries = keeper.GetPendingInterchainQueries(ctx)
+	return gs
+}
@@ -0,0 +1,200 @@
+package vibc
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+func TestDefaultGenesis(t *testing.T) {
+	defaultGenesisState := DefaultGenesisState()
+	if err := ValidateGenesis(defaultGenesisState); err != nil {
+		t.Errorf("DefaultGenesisState did not validate %v: %e", defaultGenesisState, err)
+	}
+}
+
+func makeTestGenesisKeeper(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+	storeKey := storetypes.NewKVStoreKey(StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	keeper := NewKeeper(cdc, nil, nil, nil, nil).WithScope(storeKey, nil, nil)
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	return keeper, ctx
+}
+
+func TestInFlightPacketsGenesis(t *testing.T) {
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket([]byte{1}, sequence, "port-1", "channel-1", "port-98", "channel-22", clienttypes.ZeroHeight(), 2_000_000_000_000_000_000)
+	}
+	data := &types.GenesisState{InFlightPackets: []types.InFlightPacket{
+		{Packet: packet(1), DeadlineNs: 1_700_000_060_000_000_000},
+		{Packet: packet(2), DeadlineNs: 1_700_000_030_000_000_000},
+	}}
+	if err := ValidateGenesis(data); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name    string
+		records []types.InFlightPacket
+	}{
+		{"no deadline", []types.InFlightPacket{{Packet: packet(1)}}},
+		{"no sequence", []types.InFlightPacket{{Packet: packet(0), DeadlineNs: 1}}},
+		{"duplicate", []types.InFlightPacket{{Packet: packet(1), DeadlineNs: 1}, {Packet: packet(1), DeadlineNs: 2}}},
+	} {
+		if err := ValidateGenesis(&types.GenesisState{InFlightPackets: tt.records}); err == nil {
+			t.Errorf("%s: got no error", tt.name)
+		}
+	}
+
+	keeper, ctx := makeTestGenesisKeeper(t)
+	InitGenesis(ctx, keeper, data)
+	got := ExportGenesis(ctx, keeper)
+	// The packets are exported in deadline order.
+	if len(got.InFlightPackets) != 2 || got.InFlightPackets[0].Packet.Sequence != 2 || got.InFlightPackets[1].Packet.Sequence != 1 {
+		t.Errorf("got exported in-flight packets %+v", got.InFlightPackets)
+	}
+}
+
+func TestPortConfigsGenesis(t *testing.T) {
+	configs := []types.PortConfigRecord{
+		{PortId: "icacontroller-1", Orders: []string{"ORDERED"}},
+		{PortId: "port-1", Versions: []string{"ics20-1"}},
+	}
+	if err := ValidateGenesis(&types.GenesisState{PortConfigs: configs}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name    string
+		records []types.PortConfigRecord
+	}{
+		{"invalid port", []types.PortConfigRecord{{PortId: "p", Orders: []string{"ORDERED"}}}},
+		{"invalid order", []types.PortConfigRecord{{PortId: "port-1", Orders: []string{"SORTED"}}}},
+		{"duplicate", []types.PortConfigRecord{configs[1], configs[1]}},
+	} {
+		if err := ValidateGenesis(&types.GenesisState{PortConfigs: tt.records}); err == nil {
+			t.Errorf("%s: got no error", tt.name)
+		}
+	}
+
+	keeper, ctx := makeTestGenesisKeeper(t)
+	InitGenesis(ctx, keeper, &types.GenesisState{PortConfigs: configs})
+	if err := keeper.GetPortConfig(ctx, "icacontroller-1").CheckOrder(channeltypes.UNORDERED); err == nil {
+		t.Error("imported port config does not constrain the channel order")
+	}
+	got := ExportGenesis(ctx, keeper)
+	if len(got.PortConfigs) != 2 || got.PortConfigs[1].PortId != "port-1" || got.PortConfigs[1].Versions[0] != "ics20-1" {
+		t.Errorf("got exported port configs %+v", got.PortConfigs)
+	}
+}
+
+func TestPendingAckPacketsGenesis(t *testing.T) {
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket([]byte{1}, sequence, "port-98", "channel-22", "port-1", "channel-1", clienttypes.ZeroHeight(), 2_000_000_000_000_000_000)
+	}
+	packets := []channeltypes.Packet{packet(1), packet(2)}
+	if err := ValidateGenesis(&types.GenesisState{PendingAckPackets: packets}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name    string
+		packets []channeltypes.Packet
+	}{
+		{"no sequence", []channeltypes.Packet{packet(0)}},
+		{"duplicate", []channeltypes.Packet{packet(1), packet(1)}},
+	} {
+		if err := ValidateGenesis(&types.GenesisState{PendingAckPackets: tt.packets}); err == nil {
+			t.Errorf("%s: got no error", tt.name)
+		}
+	}
+
+	keeper, ctx := makeTestGenesisKeeper(t)
+	InitGenesis(ctx, keeper, &types.GenesisState{PendingAckPackets: packets})
+	// The VM can still acknowledge an imported packet by its sequence.
+	if got, ok := keeper.GetPendingAckPacket(ctx, "port-1", "channel-1", 2); !ok || got.Sequence != 2 {
+		t.Errorf("got pending packet %v (found %t), want sequence 2", got, ok)
+	}
+	if got := ExportGenesis(ctx, keeper); len(got.PendingAckPackets) != 2 {
+		t.Errorf("got exported pending packets %+v", got.PendingAckPackets)
+	}
+}
+
+func TestICAControllersGenesis(t *testing.T) {
+	controllers := []types.ICAControllerRecord{
+		{ConnectionId: "connection-0", ControllerPortId: "icacontroller-agoric1abc", Target: "agoric1target"},
+	}
+	if err := ValidateGenesis(&types.GenesisState{IcaControllers: controllers}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name    string
+		records []types.ICAControllerRecord
+	}{
+		{"invalid connection", []types.ICAControllerRecord{{ConnectionId: "c", ControllerPortId: "icacontroller-1", Target: "t"}}},
+		{"not a controller port", []types.ICAControllerRecord{{ConnectionId: "connection-0", ControllerPortId: "transfer", Target: "t"}}},
+		{"no target", []types.ICAControllerRecord{{ConnectionId: "connection-0", ControllerPortId: "icacontroller-1"}}},
+		{"duplicate", []types.ICAControllerRecord{controllers[0], controllers[0]}},
+	} {
+		if err := ValidateGenesis(&types.GenesisState{IcaControllers: tt.records}); err == nil {
+			t.Errorf("%s: got no error", tt.name)
+		}
+	}
+
+	keeper, ctx := makeTestGenesisKeeper(t)
+	InitGenesis(ctx, keeper, &types.GenesisState{IcaControllers: controllers})
+	if got := keeper.GetICAController(ctx, "connection-0", "icacontroller-agoric1abc"); got != "agoric1target" {
+		t.Errorf("got imported controller %q, want agoric1target", got)
+	}
+	if got := ExportGenesis(ctx, keeper).IcaControllers; len(got) != 1 || got[0] != controllers[0] {
+		t.Errorf("got exported controllers %+v, want %+v", got, controllers)
+	}
+}
+
+func TestPendingInterchainQueriesGenesis(t *testing.T) {
+	query := types.PendingInterchainQuery{PortId: "icqcontroller-1", ChannelId: "channel-3", Sequence: 4, Target: "agoric1target"}
+	if err := ValidateGenesis(&types.GenesisState{PendingInterchainQueries: []types.PendingInterchainQuery{query}}); err != nil {
+		t.Fatal(err)
+	}
+	noSequence, noTarget := query, query
+	noSequence.Sequence = 0
+	noTarget.Target = ""
+	for _, tt := range []struct {
+		name    string
+		queries []types.PendingInterchainQuery
+	}{
+		{"no sequence", []types.PendingInterchainQuery{noSequence}},
+		{"no target", []types.PendingInterchainQuery{noTarget}},
+		{"duplicate", []types.PendingInterchainQuery{query, query}},
+	} {
+		if err := ValidateGenesis(&types.GenesisState{PendingInterchainQueries: tt.queries}); err == nil {
+			t.Errorf("%s: got no error", tt.name)
+		}
+	}
+
+	keeper, ctx := makeTestGenesisKeeper(t)
+	InitGenesis(ctx, keeper, &types.GenesisState{PendingInterchainQueries: []types.PendingInterchainQuery{query}})
+	if got := ExportGenesis(ctx, keeper).PendingInterchainQueries; len(got) != 1 || got[0] != query {
+		t.Errorf("got exported queries %+v, want %+v", got, query)
+	}
+	// The result of the imported query is still reported to its target.
+	packet := channeltypes.NewPacket(nil, 4, "icqcontroller-1", "channel-3", "icqhost", "channel-9", clienttypes.ZeroHeight(), 0)
+	if target, ok := keeper.TakePendingInterchainQuery(ctx, packet); !ok || target != "agoric1target" {
+		t.Errorf("got target %q (found %t), want agoric1target", target, ok)
+	}
+}
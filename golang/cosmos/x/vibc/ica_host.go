@@ -0,0 +1,47 @@
+package vibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/keeper"
+)
+
+var _ porttypes.IBCModule = ICAHostModule{}
+
+// ICAHostModule wraps the interchain accounts host IBCModule so that the
+// packets it executes for accounts controlled by vats (as registered with the
+// "registerICAController" bridge method) are also delivered to them as
+// "icaHostPacket" IBC events.  All other callbacks go to the host unchanged.
+type ICAHostModule struct {
+	porttypes.IBCModule
+	vibcKeeper keeper.Keeper
+}
+
+// NewICAHostModule returns an ICAHostModule wrapping the host's icaHostModule.
+func NewICAHostModule(icaHostModule porttypes.IBCModule, vibcKeeper keeper.Keeper) ICAHostModule {
+	return ICAHostModule{
+		IBCModule:  icaHostModule,
+		vibcKeeper: vibcKeeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.  The packet is executed by
+// the host first, so that the event carries its acknowledgement.
+func (im ICAHostModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil {
+		return ack
+	}
+	if err := im.vibcKeeper.TriggerICAHostPacket(ctx, packet, ack, relayer); err != nil {
+		// The host has already executed the packet, so do not fail it.
+		ctx.Logger().Error("cannot deliver ICA host packet to its controlling vat", "err", err)
+	}
+	return ack
+}
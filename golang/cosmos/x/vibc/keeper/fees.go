@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// WithFeeKeeper returns a new Keeper copied from the receiver, but which sends
+// packets and writes acknowledgements through the given ICS-29 fee keeper, so
+// that vats may incentivize relayers on fee-enabled channels.  The fee
+// middleware must also wrap the vibc IBC module.
+func (k Keeper) WithFeeKeeper(feeKeeper types.FeeKeeper) Keeper {
+	k.feeKeeper = feeKeeper
+	return k
+}
+
+// ics4Wrapper returns the fee keeper if there is one, or else the channel
+// keeper.
+func (k Keeper) ics4Wrapper() porttypes.ICS4Wrapper {
+	if k.feeKeeper != nil {
+		return k.feeKeeper
+	}
+	return k.channelKeeper
+}
+
+// feeVersionMetadata returns the ICS-29 metadata of a channel version, and
+// whether the version is fee metadata at all.  As in the fee middleware, a
+// version that does not parse as fee metadata belongs to the app, but fee
+// metadata must be of the fee version this chain supports.  Without a fee
+// keeper, no version is fee metadata.
+func (k Keeper) feeVersionMetadata(version string) (ibcfeetypes.Metadata, bool, error) {
+	var metadata ibcfeetypes.Metadata
+	if k.feeKeeper == nil {
+		return metadata, false, nil
+	}
+	if err := ibcfeetypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return metadata, false, nil
+	}
+	if metadata.FeeVersion != ibcfeetypes.Version {
+		return metadata, false, sdkioerrors.Wrapf(ibcfeetypes.ErrInvalidVersion, "expected %s, got %s", ibcfeetypes.Version, metadata.FeeVersion)
+	}
+	return metadata, true, nil
+}
+
+// IsFeeEnabled reports whether the channel was opened with ICS-29 fees, so
+// that its packets may carry relayer incentives.
+func (k Keeper) IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool {
+	return k.feeKeeper != nil && k.feeKeeper.IsFeeEnabled(ctx, portID, channelID)
+}
+
+// ReceiveSendIncentivizedPacket sends packet as ReceiveSendPacket does, first
+// escrowing fee from the FeeEscrowAddress of its source port as the incentive
+// of the relayers that deliver the packet and its acknowledgement or timeout.
+// The downcall is not signed by any account, so the fee cannot be refunded to
+// (and so escrowed from) any other address.  Nothing is escrowed or sent
+// unless both succeed.
+func (k Keeper) ReceiveSendIncentivizedPacket(ctx sdk.Context, packet ibcexported.PacketI, fee ibcfeetypes.PacketFee) (uint64, error) {
+	sourcePort := packet.GetSourcePort()
+	sourceChannel := packet.GetSourceChannel()
+	if !k.IsFeeEnabled(ctx, sourcePort, sourceChannel) {
+		return 0, sdkioerrors.Wrapf(ibcfeetypes.ErrFeeNotEnabled, "cannot incentivize packet on %s/%s", sourcePort, sourceChannel)
+	}
+	escrow := types.FeeEscrowAddress(sourcePort).String()
+	if fee.RefundAddress != "" && fee.RefundAddress != escrow {
+		return 0, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "fees of port %s can only be refunded to %s, not %s", sourcePort, escrow, fee.RefundAddress)
+	}
+
+	msg := ibcfeetypes.NewMsgPayPacketFee(fee.Fee, sourcePort, sourceChannel, escrow, fee.Relayers)
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.feeKeeper.PayPacketFee(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+		return 0, err
+	}
+	sequence, err := k.ReceiveSendPacket(cacheCtx, packet)
+	if err != nil {
+		return 0, err
+	}
+	writeCache()
+	return sequence, nil
+}
+
+// refundFeesOnChannelClosure refunds the relayer fees escrowed for the packets
+// of a fee-enabled channel that is being closed, as the fee middleware does
+// in OnChanCloseInit.  A locked fee module cannot refund, and so the channel
+// cannot be closed.
+func (k Keeper) refundFeesOnChannelClosure(ctx sdk.Context, portID, channelID string) error {
+	if !k.IsFeeEnabled(ctx, portID, channelID) {
+		return nil
+	}
+	if k.feeKeeper.IsLocked(ctx) {
+		return ibcfeetypes.ErrFeeModuleLocked
+	}
+	return k.feeKeeper.RefundFeesOnChannelClosure(ctx, portID, channelID)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+const icaControllerStoreKeyPrefix = "icaController."
+
+func icaControllerKey(connectionID, controllerPortID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", connectionID, controllerPortID))
+}
+
+// GetICAController returns the target of the vat that controls the
+// interchain accounts hosted on this chain for the controller port of the
+// counterparty of connectionID, or "" if none does.
+func (k Keeper) GetICAController(ctx sdk.Context, connectionID, controllerPortID string) string {
+	store, ok := k.getPrefixStore(ctx, icaControllerStoreKeyPrefix)
+	if !ok {
+		return ""
+	}
+	return string(store.Get(icaControllerKey(connectionID, controllerPortID)))
+}
+
+// SetICAController records target as the vat controlling the interchain
+// accounts hosted for controllerPortID over connectionID, deleting the record
+// if target is empty.
+func (k Keeper) SetICAController(ctx sdk.Context, connectionID, controllerPortID, target string) {
+	store, ok := k.getPrefixStore(ctx, icaControllerStoreKeyPrefix)
+	if !ok {
+		return
+	}
+	key := icaControllerKey(connectionID, controllerPortID)
+	if target == "" {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte(target))
+}
+
+// GetICAControllers returns every interchain account controller
+// registration, as exported in genesis.
+func (k Keeper) GetICAControllers(ctx sdk.Context) []types.ICAControllerRecord {
+	records := []types.ICAControllerRecord{}
+	store, ok := k.getPrefixStore(ctx, icaControllerStoreKeyPrefix)
+	if !ok {
+		return records
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// Neither identifier may contain a slash.
+		connectionID, controllerPortID, _ := strings.Cut(string(iterator.Key()), "/")
+		records = append(records, types.ICAControllerRecord{
+			ConnectionId:     connectionID,
+			ControllerPortId: controllerPortID,
+			Target:           string(iterator.Value()),
+		})
+	}
+	return records
+}
+
+// ReceiveRegisterICAController registers target, on behalf of a vat, as the
+// recipient of the packets executed by the interchain accounts host for the
+// controller port controllerPortID of the counterparty of connectionID.  A
+// controller port registered for another target cannot be taken over until
+// that target unregisters it.
+func (k Keeper) ReceiveRegisterICAController(ctx sdk.Context, connectionID, controllerPortID, target string) error {
+	if target == "" {
+		return fmt.Errorf("registerICAController requires a target")
+	}
+	if _, ok := k.connectionKeeper.GetConnection(ctx, connectionID); !ok {
+		return sdkioerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection %s", connectionID)
+	}
+	if !strings.HasPrefix(controllerPortID, icatypes.ControllerPortPrefix) {
+		return sdkioerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s prefix, got %s", icatypes.ControllerPortPrefix, controllerPortID)
+	}
+	if existing := k.GetICAController(ctx, connectionID, controllerPortID); existing != "" && existing != target {
+		return fmt.Errorf("controller port %s over connection %s is already registered", controllerPortID, connectionID)
+	}
+	k.SetICAController(ctx, connectionID, controllerPortID, target)
+	return nil
+}
+
+// ReceiveUnregisterICAController removes the registration of target for the
+// controller port controllerPortID of the counterparty of connectionID.
+func (k Keeper) ReceiveUnregisterICAController(ctx sdk.Context, connectionID, controllerPortID, target string) error {
+	if existing := k.GetICAController(ctx, connectionID, controllerPortID); existing == "" || existing != target {
+		return fmt.Errorf("controller port %s over connection %s is not registered for %q", controllerPortID, connectionID, target)
+	}
+	k.SetICAController(ctx, connectionID, controllerPortID, "")
+	return nil
+}
+
+// TriggerICAHostPacket tells the vat controlling the interchain account
+// addressed by a packet, if any, that the host executed it with the given
+// acknowledgement.
+func (k Keeper) TriggerICAHostPacket(
+	ctx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	relayer sdk.AccAddress,
+) error {
+	channel, ok := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !ok || len(channel.ConnectionHops) == 0 {
+		return sdkioerrors.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", packet.GetDestPort(), packet.GetDestChannel())
+	}
+	target := k.GetICAController(ctx, channel.ConnectionHops[0], packet.GetSourcePort())
+	if target == "" {
+		return nil
+	}
+
+	event := types.ICAHostPacketEvent{
+		Target:          target,
+		Packet:          reifyPacket(packet),
+		Acknowledgement: ack.Acknowledgement(),
+		Success:         ack.Success(),
+		Relayer:         relayer,
+	}
+	return k.PushAction(ctx, event)
+}
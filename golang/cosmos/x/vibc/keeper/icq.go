@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+const pendingInterchainQueryStoreKeyPrefix = "pendingInterchainQuery."
+
+// ReceiveSendInterchainQuery sends packet, whose data is an interchain query,
+// and remembers target so that its acknowledgement or timeout is reported to
+// the vat as an interchain query result for target.
+func (k Keeper) ReceiveSendInterchainQuery(ctx sdk.Context, packet ibcexported.PacketI, target string) (uint64, error) {
+	sequence, err := k.ReceiveSendPacket(ctx, packet)
+	if err != nil {
+		return 0, err
+	}
+	k.SetPendingInterchainQuery(ctx, types.PendingInterchainQuery{
+		PortId:    packet.GetSourcePort(),
+		ChannelId: packet.GetSourceChannel(),
+		Sequence:  sequence,
+		Target:    target,
+	})
+	return sequence, nil
+}
+
+// SetPendingInterchainQuery remembers the target of an interchain query
+// awaiting its result.
+func (k Keeper) SetPendingInterchainQuery(ctx sdk.Context, query types.PendingInterchainQuery) {
+	store, ok := k.getPrefixStore(ctx, pendingInterchainQueryStoreKeyPrefix)
+	if !ok {
+		return
+	}
+	store.Set(pendingAckPacketKey(query.PortId, query.ChannelId, query.Sequence), []byte(query.Target))
+}
+
+// GetPendingInterchainQueries returns every interchain query awaiting its
+// result, as exported in genesis.
+func (k Keeper) GetPendingInterchainQueries(ctx sdk.Context) []types.PendingInterchainQuery {
+	queries := []types.PendingInterchainQuery{}
+	store, ok := k.getPrefixStore(ctx, pendingInterchainQueryStoreKeyPrefix)
+	if !ok {
+		return queries
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// Neither identifier may contain a slash.
+		parts := strings.SplitN(string(iterator.Key()), "/", 3)
+		sequence, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		queries = append(queries, types.PendingInterchainQuery{
+			PortId:    parts[0],
+			ChannelId: parts[1],
+			Sequence:  sequence,
+			Target:    string(iterator.Value()),
+		})
+	}
+	return queries
+}
+
+// TakePendingInterchainQuery returns the target of packet if it was sent by
+// ReceiveSendInterchainQuery and is still awaiting its result, forgetting it.
+func (k Keeper) TakePendingInterchainQuery(ctx sdk.Context, packet channeltypes.Packet) (string, bool) {
+	store, ok := k.getPrefixStore(ctx, pendingInterchainQueryStoreKeyPrefix)
+	if !ok {
+		return "", false
+	}
+	key := pendingAckPacketKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	target := store.Get(key)
+	if target == nil {
+		return "", false
+	}
+	store.Delete(key)
+	return string(target), true
+}
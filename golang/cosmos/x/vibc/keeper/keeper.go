@@ -1,15 +1,19 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkioerrors "cosmossdk.io/errors"
 	capability "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
@@ -25,17 +29,27 @@ var (
 	_ types.ReceiverImpl    = Keeper{}
 )
 
+const (
+	portConfigStoreKeyPrefix       = "portConfig."
+	pendingAckPacketStoreKeyPrefix = "pendingAckPacket."
+)
+
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	cdc codec.Codec
 
-	channelKeeper types.ChannelKeeper
-	portKeeper    types.PortKeeper
+	channelKeeper    types.ChannelKeeper
+	portKeeper       types.PortKeeper
+	connectionKeeper types.ConnectionKeeper
+	clientKeeper     types.ClientKeeper
 
 	// Filled out by `WithScope`
 	scopedKeeper types.ScopedKeeper
 	storeKey     storetypes.StoreKey
 	pushAction   vm.ActionPusher
+
+	// Filled out by `WithFeeKeeper`
+	feeKeeper types.FeeKeeper
 }
 
 // NewKeeper creates a new vibc Keeper instance
@@ -43,12 +57,16 @@ func NewKeeper(
 	cdc codec.Codec,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	clientKeeper types.ClientKeeper,
 ) Keeper {
 
 	return Keeper{
-		cdc:           cdc,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
+		cdc:              cdc,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		connectionKeeper: connectionKeeper,
+		clientKeeper:     clientKeeper,
 	}
 }
 
@@ -74,7 +92,7 @@ func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 // GetAppVersion defines a wrapper function for the channel Keeper's function
 // in order to expose it to the vibc IBC handler.
 func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
-	return k.channelKeeper.GetAppVersion(ctx, portID, channelID)
+	return k.ics4Wrapper().GetAppVersion(ctx, portID, channelID)
 }
 
 // GetChannel defines a wrapper function for the channel Keeper's function
@@ -83,10 +101,156 @@ func (k Keeper) GetChannel(ctx sdk.Context, portID, channelID string) (channelty
 	return k.channelKeeper.GetChannel(ctx, portID, channelID)
 }
 
-// ReceiveChanOpenInit wraps the keeper's ChanOpenInit function.
+// getPrefixStore returns the keeper's store under keyPrefix, or false if the
+// keeper has no store of its own (as for the copy used by vtransfer to notify
+// the VM), in which case nothing is recorded.
+func (k Keeper) getPrefixStore(ctx sdk.Context, keyPrefix string) (storetypes.KVStore, bool) {
+	if k.storeKey == nil {
+		return nil, false
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix)), true
+}
+
+// GetPortConfig returns the PortConfig with which a vat bound the port, or an
+// unconstrained PortConfig if it specified none.
+func (k Keeper) GetPortConfig(ctx sdk.Context, portID string) types.PortConfig {
+	var config types.PortConfig
+	store, ok := k.getPrefixStore(ctx, portConfigStoreKeyPrefix)
+	if !ok {
+		return config
+	}
+	bz := store.Get([]byte(portID))
+	if bz == nil {
+		return config
+	}
+	if err := json.Unmarshal(bz, &config); err != nil {
+		panic(err)
+	}
+	return config
+}
+
+// SetPortConfig records the PortConfig of a port, deleting it if it imposes
+// no constraints.
+func (k Keeper) SetPortConfig(ctx sdk.Context, portID string, config types.PortConfig) {
+	store, ok := k.getPrefixStore(ctx, portConfigStoreKeyPrefix)
+	if !ok {
+		return
+	}
+	if len(config.Orders) == 0 && len(config.Versions) == 0 {
+		store.Delete([]byte(portID))
+		return
+	}
+	bz, err := json.Marshal(config)
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(portID), bz)
+}
+
+// GetPortConfigs returns the PortConfig of every port that has one, as
+// exported in genesis.
+func (k Keeper) GetPortConfigs(ctx sdk.Context) []types.PortConfigRecord {
+	records := []types.PortConfigRecord{}
+	store, ok := k.getPrefixStore(ctx, portConfigStoreKeyPrefix)
+	if !ok {
+		return records
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var config types.PortConfig
+		if err := json.Unmarshal(iterator.Value(), &config); err != nil {
+			panic(err)
+		}
+		records = append(records, types.PortConfigRecord{
+			PortId:   string(iterator.Key()),
+			Orders:   config.Orders,
+			Versions: config.Versions,
+		})
+	}
+	return records
+}
+
+// ReceiveQueryConnection returns the proto JSON of the connection end with the
+// given ID, including its client, counterparty, versions, and delay period.
+func (k Keeper) ReceiveQueryConnection(ctx sdk.Context, connectionID string) (string, error) {
+	connection, ok := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !ok {
+		return "", sdkioerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection %s", connectionID)
+	}
+	bz, err := k.cdc.MarshalJSON(&connection)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// ClientStateInfo describes a light client for the VM.
+type ClientStateInfo struct {
+	ClientID string `json:"clientID"`
+	// ChainID is the counterparty chain ID, if the client type has one.
+	ChainID string `json:"chainID,omitempty"`
+	// Status is the client's ibcexported.Status, such as "Active" or "Frozen".
+	Status       string             `json:"status"`
+	LatestHeight clienttypes.Height `json:"latestHeight"`
+	// ClientState is the proto JSON of the client state, including its type URL.
+	ClientState json.RawMessage `json:"clientState"`
+}
+
+// ReceiveQueryClientState returns a JSON ClientStateInfo describing the light
+// client with the given ID.
+func (k Keeper) ReceiveQueryClientState(ctx sdk.Context, clientID string) (string, error) {
+	clientState, ok := k.clientKeeper.GetClientState(ctx, clientID)
+	if !ok {
+		return "", sdkioerrors.Wrapf(clienttypes.ErrClientNotFound, "client %s", clientID)
+	}
+	latestHeight, err := clienttypes.ParseHeight(clientState.GetLatestHeight().String())
+	if err != nil {
+		return "", sdkioerrors.Wrapf(err, "latest height of client %s", clientID)
+	}
+	clientStateJSON, err := k.cdc.MarshalInterfaceJSON(clientState)
+	if err != nil {
+		return "", err
+	}
+	info := ClientStateInfo{
+		ClientID:     clientID,
+		Status:       clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc).String(),
+		LatestHeight: latestHeight,
+		ClientState:  clientStateJSON,
+	}
+	if chainState, ok := clientState.(interface{ GetChainID() string }); ok {
+		info.ChainID = chainState.GetChainID()
+	}
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// ReceiveChanOpenInit wraps the keeper's ChanOpenInit function.  If no version
+// is given, the port's preferred version is proposed.  If the version is the
+// ICS-29 metadata of a fee version, the channel is fee-enabled as the fee
+// middleware would enable a channel opened by a relayer, and the port's
+// preferred version substitutes for a missing app version instead.
 func (k Keeper) ReceiveChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string,
 	portID, rPortID, version string,
 ) error {
+	config := k.GetPortConfig(ctx, portID)
+	if err := config.CheckOrder(order); err != nil {
+		return err
+	}
+	feeMetadata, feeEnabled, err := k.feeVersionMetadata(version)
+	if err != nil {
+		return err
+	}
+	if feeEnabled {
+		feeMetadata.AppVersion = config.VersionOrDefault(feeMetadata.AppVersion)
+		version = string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&feeMetadata))
+	} else {
+		version = config.VersionOrDefault(version)
+	}
+
 	capName := host.PortPath(portID)
 	portCap, ok := k.GetCapability(ctx, capName)
 	if !ok {
@@ -106,6 +270,9 @@ func (k Keeper) ReceiveChanOpenInit(ctx sdk.Context, order channeltypes.Order, c
 	}
 
 	k.channelKeeper.WriteOpenInitChannel(ctx, portID, channelID, order, connectionHops, counterparty, version)
+	if feeEnabled {
+		k.feeKeeper.SetFeeEnabled(ctx, portID, channelID)
+	}
 	return nil
 }
 
@@ -136,7 +303,56 @@ func (k Keeper) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (uint64, error) {
-	return k.channelKeeper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	return k.ics4Wrapper().SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+func pendingAckPacketKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))
+}
+
+// SetPendingAckPacket records a received packet whose acknowledgement the VM
+// will write asynchronously.
+func (k Keeper) SetPendingAckPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	store, ok := k.getPrefixStore(ctx, pendingAckPacketStoreKeyPrefix)
+	if !ok {
+		return
+	}
+	key := pendingAckPacketKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	store.Set(key, k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingAckPacket returns the received packet awaiting acknowledgement
+// with the given destination port, channel, and sequence.
+func (k Keeper) GetPendingAckPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	var packet channeltypes.Packet
+	store, ok := k.getPrefixStore(ctx, pendingAckPacketStoreKeyPrefix)
+	if !ok {
+		return packet, false
+	}
+	bz := store.Get(pendingAckPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// GetPendingAckPackets returns every received packet awaiting
+// acknowledgement, as exported in genesis.
+func (k Keeper) GetPendingAckPackets(ctx sdk.Context) []channeltypes.Packet {
+	packets := []channeltypes.Packet{}
+	store, ok := k.getPrefixStore(ctx, pendingAckPacketStoreKeyPrefix)
+	if !ok {
+		return packets
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+	return packets
 }
 
 // ReceiveWriteAcknowledgement wraps the keeper's WriteAcknowledgment function.
@@ -148,13 +364,27 @@ func (k Keeper) ReceiveWriteAcknowledgement(ctx sdk.Context, packet ibcexported.
 	if !ok {
 		return sdkioerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
 	}
+	if store, ok := k.getPrefixStore(ctx, pendingAckPacketStoreKeyPrefix); ok {
+		store.Delete(pendingAckPacketKey(portID, channelID, packet.GetSequence()))
+	}
 	return k.WriteAcknowledgement(ctx, chanCap, packet, ack)
 }
 
+// ReceiveWritePendingAcknowledgement writes the acknowledgement of a received
+// packet that was recorded by SetPendingAckPacket, so that the VM need not
+// retain the packet itself.
+func (k Keeper) ReceiveWritePendingAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ack ibcexported.Acknowledgement) error {
+	packet, ok := k.GetPendingAckPacket(ctx, portID, channelID, sequence)
+	if !ok {
+		return fmt.Errorf("no packet awaiting acknowledgement at %s/%s/%d", portID, channelID, sequence)
+	}
+	return k.ReceiveWriteAcknowledgement(ctx, packet, ack)
+}
+
 // WriteAcknowledgement defines a wrapper function for the channel Keeper's function
 // in order to expose it to the vibc IBC handler.
 func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capability.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
-	return k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	return k.ics4Wrapper().WriteAcknowledgement(ctx, chanCap, packet, ack)
 }
 
 // ReceiveWriteOpenTryChannel wraps the keeper's WriteOpenTryChannel function.
@@ -173,13 +403,17 @@ func (k Keeper) WriteOpenTryChannel(ctx sdk.Context, portID, channelID string, o
 }
 
 // ReceiveChanCloseInit is a wrapper function for the channel Keeper's function
-// in order to expose it to the vibc IBC handler.
+// in order to expose it to the vibc IBC handler.  The relayer fees escrowed
+// for the packets of a fee-enabled channel are first refunded.
 func (k Keeper) ReceiveChanCloseInit(ctx sdk.Context, portID, channelID string) error {
 	capName := host.ChannelCapabilityPath(portID, channelID)
 	chanCap, ok := k.GetCapability(ctx, capName)
 	if !ok {
 		return sdkioerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
 	}
+	if err := k.refundFeesOnChannelClosure(ctx, portID, channelID); err != nil {
+		return err
+	}
 	err := k.channelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap)
 	if err != nil {
 		return err
@@ -187,16 +421,40 @@ func (k Keeper) ReceiveChanCloseInit(ctx sdk.Context, portID, channelID string)
 	return nil
 }
 
+// ReceiveTargetedChanCloseInit closes a channel of a port bound by vibc, as
+// ReceiveChanCloseInit does, for a vat that opened it.  Since the initiator
+// of a close is not called back by IBC, the vat is then sent a
+// ChannelCloseConfirmEvent for target.
+func (k Keeper) ReceiveTargetedChanCloseInit(ctx sdk.Context, portID, channelID, target string) error {
+	portPath := host.PortPath(portID)
+	if _, ok := k.GetCapability(ctx, portPath); !ok {
+		return sdkioerrors.Wrapf(porttypes.ErrInvalidPort, "could not retrieve port capability at: %s", portPath)
+	}
+	if err := k.ReceiveChanCloseInit(ctx, portID, channelID); err != nil {
+		return err
+	}
+	return k.PushAction(ctx, types.ChannelCloseConfirmEvent{
+		Target:    target,
+		PortID:    portID,
+		ChannelID: channelID,
+	})
+}
+
 // ReceiveBindPort is a wrapper function for the port Keeper's function in order
-// to expose it to the vibc IBC handler.
-func (k Keeper) ReceiveBindPort(ctx sdk.Context, portID string) error {
+// to expose it to the vibc IBC handler.  The config constrains the channels
+// subsequently opened on the port.
+func (k Keeper) ReceiveBindPort(ctx sdk.Context, portID string, config types.PortConfig) error {
 	portPath := host.PortPath(portID)
 	_, ok := k.GetCapability(ctx, portPath)
 	if ok {
 		return fmt.Errorf("port %s is already bound", portID)
 	}
 	cap := k.portKeeper.BindPort(ctx, portID)
-	return k.ClaimCapability(ctx, cap, portPath)
+	if err := k.ClaimCapability(ctx, cap, portPath); err != nil {
+		return err
+	}
+	k.SetPortConfig(ctx, portID, config)
+	return nil
 }
 
 // ReceiveTimeoutExecuted is a wrapper function for the channel Keeper's
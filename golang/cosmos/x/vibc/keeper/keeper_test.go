@@ -0,0 +1,897 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capability "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+var (
+	vibcStoreKey = storetypes.NewKVStoreKey(types.StoreKey)
+	feeStoreKey  = storetypes.NewKVStoreKey(ibcfeetypes.StoreKey)
+)
+
+type mockConnectionKeeper struct {
+	connections map[string]connectiontypes.ConnectionEnd
+}
+
+func (m mockConnectionKeeper) GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool) {
+	connection, ok := m.connections[connectionID]
+	return connection, ok
+}
+
+type mockChannelKeeper struct {
+	types.ChannelKeeper
+	channels  map[string]channeltypes.Channel
+	sequences map[string]uint64
+	closed    map[string]bool
+}
+
+func (m mockChannelKeeper) GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	channel, ok := m.channels[portID+"/"+channelID]
+	return channel, ok
+}
+
+// SendPacket numbers the packets sent on each channel from 1.
+func (m mockChannelKeeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capability.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	m.sequences[sourcePort+"/"+sourceChannel]++
+	return m.sequences[sourcePort+"/"+sourceChannel], nil
+}
+
+// ChanCloseInit records the closed channels in closed.
+func (m mockChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capability.Capability) error {
+	m.closed[portID+"/"+channelID] = true
+	return nil
+}
+
+type mockScopedKeeper struct {
+	types.ScopedKeeper
+}
+
+func (mockScopedKeeper) GetCapability(ctx sdk.Context, name string) (*capability.Capability, bool) {
+	return capability.NewCapability(1), true
+}
+
+// namedCapabilityScopedKeeper is a scoped keeper holding only the capabilities
+// in names.
+type namedCapabilityScopedKeeper struct {
+	types.ScopedKeeper
+	names map[string]bool
+}
+
+func (m namedCapabilityScopedKeeper) GetCapability(ctx sdk.Context, name string) (*capability.Capability, bool) {
+	if !m.names[name] {
+		return nil, false
+	}
+	return capability.NewCapability(1), true
+}
+
+type mockClientKeeper struct {
+	clientStates map[string]ibcexported.ClientState
+}
+
+func (m mockClientKeeper) GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool) {
+	clientState, ok := m.clientStates[clientID]
+	return clientState, ok
+}
+
+func (m mockClientKeeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	return ctx.KVStore(vibcStoreKey)
+}
+
+// bogusHeightClientState is a client state whose latest height is not an IBC
+// client height.
+type bogusHeightClientState struct {
+	ibcexported.ClientState
+}
+
+type bogusHeight struct {
+	ibcexported.Height
+}
+
+func (bogusHeight) String() string { return "bogus" }
+
+func (bogusHeightClientState) GetLatestHeight() ibcexported.Height { return bogusHeight{} }
+
+// makeTestKeeper returns a vibc Keeper with its own store and the given
+// connection and client keepers, whose pushed actions are appended to
+// *actions.
+func makeTestKeeper(t *testing.T, connectionKeeper types.ConnectionKeeper, clientKeeper types.ClientKeeper, actions *[]vm.Action) (Keeper, sdk.Context) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vibcStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(feeStoreKey, storetypes.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	registry := codectypes.NewInterfaceRegistry()
+	clienttypes.RegisterInterfaces(registry)
+	ibctm.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	pushAction := func(ctx sdk.Context, action vm.Action) error {
+		*actions = append(*actions, action)
+		return nil
+	}
+	k := NewKeeper(cdc, nil, nil, connectionKeeper, clientKeeper).
+		WithScope(vibcStoreKey, nil, pushAction)
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 5, Time: time.Unix(1_700_000_000, 0)}, false, log.NewNopLogger())
+	return k, ctx
+}
+
+func TestReceiveQueryConnection(t *testing.T) {
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, "07-tendermint-0",
+		connectiontypes.NewCounterparty("07-tendermint-9", "connection-9", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 30,
+	)
+	var actions []vm.Action
+	k, ctx := makeTestKeeper(t, mockConnectionKeeper{map[string]connectiontypes.ConnectionEnd{"connection-0": connection}}, nil, &actions)
+
+	reply, err := k.ReceiveQueryConnection(ctx, "connection-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		ClientID     string `json:"client_id"`
+		DelayPeriod  string `json:"delay_period"`
+		Counterparty struct {
+			ConnectionID string `json:"connection_id"`
+		} `json:"counterparty"`
+	}
+	if err := json.Unmarshal([]byte(reply), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ClientID != "07-tendermint-0" || got.DelayPeriod != "30" || got.Counterparty.ConnectionID != "connection-9" {
+		t.Errorf("got connection %s", reply)
+	}
+
+	if _, err := k.ReceiveQueryConnection(ctx, "connection-1"); err == nil {
+		t.Errorf("wanted error for unknown connection")
+	}
+}
+
+func TestReceiveQueryClientState(t *testing.T) {
+	tmClient := ibctm.NewClientState(
+		"agoriclocal", ibctm.DefaultTrustLevel, time.Hour, 2*time.Hour, time.Minute,
+		clienttypes.NewHeight(1, 100), commitmenttypes.GetSDKSpecs(), nil, false, false,
+	)
+	clientStates := map[string]ibcexported.ClientState{
+		"07-tendermint-0": tmClient,
+		"07-tendermint-1": bogusHeightClientState{tmClient},
+	}
+	var actions []vm.Action
+	k, ctx := makeTestKeeper(t, nil, mockClientKeeper{clientStates}, &actions)
+
+	reply, err := k.ReceiveQueryClientState(ctx, "07-tendermint-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got ClientStateInfo
+	if err := json.Unmarshal([]byte(reply), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ClientID != "07-tendermint-0" || got.ChainID != "agoriclocal" || got.LatestHeight != clienttypes.NewHeight(1, 100) {
+		t.Errorf("got client state info %s", reply)
+	}
+	// There is no consensus state for the latest height.
+	if got.Status != ibcexported.Expired.String() {
+		t.Errorf("got status %q, want %q", got.Status, ibcexported.Expired)
+	}
+	if !strings.Contains(string(got.ClientState), `"@type":"/ibc.lightclients.tendermint.v1.ClientState"`) {
+		t.Errorf("got client state %s without its type URL", got.ClientState)
+	}
+
+	if _, err := k.ReceiveQueryClientState(ctx, "07-tendermint-1"); err == nil {
+		t.Errorf("wanted error for unparseable latest height")
+	}
+	if _, err := k.ReceiveQueryClientState(ctx, "07-tendermint-2"); err == nil {
+		t.Errorf("wanted error for unknown client")
+	}
+}
+
+func TestICAController(t *testing.T) {
+	connections := map[string]connectiontypes.ConnectionEnd{"connection-0": {}}
+	var actions []vm.Action
+	k, ctx := makeTestKeeper(t, mockConnectionKeeper{connections}, nil, &actions)
+	k.channelKeeper = mockChannelKeeper{channels: map[string]channeltypes.Channel{
+		"icahost/channel-1": {ConnectionHops: []string{"connection-0"}},
+	}}
+	const controllerPort = "icacontroller-agoric1owner"
+
+	for _, tt := range []struct {
+		name, connectionID, controllerPortID, target string
+	}{
+		{"empty target", "connection-0", controllerPort, ""},
+		{"unknown connection", "connection-1", controllerPort, "v1"},
+		{"not a controller port", "connection-0", "transfer", "v1"},
+	} {
+		if err := k.ReceiveRegisterICAController(ctx, tt.connectionID, tt.controllerPortID, tt.target); err == nil {
+			t.Errorf("%s: got no error registering", tt.name)
+		}
+	}
+
+	if err := k.ReceiveRegisterICAController(ctx, "connection-0", controllerPort, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	// Registering again for the same target is idempotent.
+	if err := k.ReceiveRegisterICAController(ctx, "connection-0", controllerPort, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	// Another target can neither take over nor remove the registration.
+	if err := k.ReceiveRegisterICAController(ctx, "connection-0", controllerPort, "v2"); err == nil {
+		t.Error("got no error registering over another target")
+	}
+	if err := k.ReceiveUnregisterICAController(ctx, "connection-0", controllerPort, "v2"); err == nil {
+		t.Error("got no error unregistering another target")
+	}
+	if got := k.GetICAController(ctx, "connection-0", controllerPort); got != "v1" {
+		t.Errorf("got controller %q, want v1", got)
+	}
+
+	data := []byte(`{"type":"TYPE_EXECUTE_TX"}`)
+	packet := channeltypes.NewPacket(data, 3, controllerPort, "channel-7", "icahost", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	ack := channeltypes.NewResultAcknowledgement([]byte("result"))
+	relayer := sdk.AccAddress([]byte("relayer"))
+	if err := k.TriggerICAHostPacket(ctx, packet, ack, relayer); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 {
+		t.Fatalf("got %d actions, want 1", len(actions))
+	}
+	event, ok := actions[0].(types.ICAHostPacketEvent)
+	if !ok {
+		t.Fatalf("got action %T, want ICAHostPacketEvent", actions[0])
+	}
+	if event.Target != "v1" || event.Packet.Sequence != 3 || !event.Success || !event.Relayer.Equals(relayer) {
+		t.Errorf("got event %+v", event)
+	}
+
+	// Packets from other controller ports are not delivered.
+	other := channeltypes.NewPacket(data, 4, "icacontroller-other", "channel-7", "icahost", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	if err := k.TriggerICAHostPacket(ctx, other, ack, relayer); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 {
+		t.Errorf("got %d actions for an unregistered controller port, want 1", len(actions))
+	}
+
+	if err := k.ReceiveUnregisterICAController(ctx, "connection-0", controllerPort, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := k.GetICAController(ctx, "connection-0", controllerPort); got != "" {
+		t.Errorf("got controller %q after unregistering, want none", got)
+	}
+	if err := k.ReceiveRegisterICAController(ctx, "connection-0", controllerPort, "v2"); err != nil {
+		t.Errorf("cannot register another target after unregistering: %v", err)
+	}
+}
+
+func TestInterchainQueryRoundTrip(t *testing.T) {
+	var actions []vm.Action
+	k, ctx := makeTestKeeper(t, nil, nil, &actions)
+	k.channelKeeper = mockChannelKeeper{sequences: map[string]uint64{}}
+	k.scopedKeeper = mockScopedKeeper{}
+	receiver := types.NewReceiver(k)
+	ibcModule := types.NewIBCModule(k)
+
+	sendQuery := func(target string) channeltypes.Packet {
+		t.Helper()
+		reply, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "sendInterchainQuery",
+			"packet": {"source_port": "icqcontroller-1", "source_channel": "channel-3"},
+			"relativeTimeoutNs": "60000000000",
+			"requests": [{"path": "/cosmos.bank.v1beta1.Query/Balance", "data": "AQI="}],
+			"target": "`+target+`"
+		}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var packet channeltypes.Packet
+		if err := json.Unmarshal([]byte(reply), &packet); err != nil {
+			t.Fatal(err)
+		}
+		return packet
+	}
+
+	packet := sendQuery("query-1")
+	if packet.Sequence != 1 {
+		t.Errorf("got sequence %d, want 1", packet.Sequence)
+	}
+	response := types.CosmosResponse{Responses: []abci.ResponseQuery{{Value: []byte{3}}}}
+	bz, err := response.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := json.Marshal(map[string][]byte{"data": bz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ack := channeltypes.NewResultAcknowledgement(result).Acknowledgement()
+	relayer := sdk.AccAddress([]byte("relayer"))
+	if err := ibcModule.OnAcknowledgementPacket(ctx, packet, ack, relayer); err != nil {
+		t.Fatal(err)
+	}
+
+	timedOut := sendQuery("query-2")
+	if err := ibcModule.OnTimeoutPacket(ctx, timedOut, relayer); err != nil {
+		t.Fatal(err)
+	}
+
+	// The query is forgotten once its result is reported.
+	if err := ibcModule.OnAcknowledgementPacket(ctx, packet, ack, relayer); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(actions) != 3 {
+		t.Fatalf("got %d actions, want 3", len(actions))
+	}
+	got, ok := actions[0].(types.InterchainQueryResultEvent)
+	if !ok {
+		t.Fatalf("got action %T, want InterchainQueryResultEvent", actions[0])
+	}
+	if got.Target != "query-1" || got.Error != "" || len(got.Responses) != 1 || got.Responses[0].Value[0] != 3 {
+		t.Errorf("got result %+v", got)
+	}
+	got, ok = actions[1].(types.InterchainQueryResultEvent)
+	if !ok {
+		t.Fatalf("got action %T, want InterchainQueryResultEvent", actions[1])
+	}
+	if got.Target != "query-2" || got.Error != "timeout" {
+		t.Errorf("got timeout result %+v", got)
+	}
+	if _, ok := actions[2].(types.AcknowledgementPacketEvent); !ok {
+		t.Errorf("got action %T for a forgotten query, want AcknowledgementPacketEvent", actions[2])
+	}
+}
+
+func TestRelativePacketTimeouts(t *testing.T) {
+	var actions []vm.Action
+	k, ctx := makeTestKeeper(t, nil, nil, &actions)
+	k.channelKeeper = mockChannelKeeper{sequences: map[string]uint64{}}
+	k.scopedKeeper = mockScopedKeeper{}
+	receiver := types.NewReceiver(k)
+	ibcModule := types.NewIBCModule(k)
+	now := uint64(ctx.BlockTime().UnixNano())
+
+	sendPacket := func(packetTimeoutNs string) (channeltypes.Packet, error) {
+		reply, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "sendPacket",
+			"packet": {"source_port": "port-1", "source_channel": "channel-1", "data": "AQI="},
+			"relativeTimeoutNs": "3600000000000",
+			"packetTimeoutNs": "`+packetTimeoutNs+`"
+		}`)
+		var packet channeltypes.Packet
+		if err == nil {
+			err = json.Unmarshal([]byte(reply), &packet)
+		}
+		return packet, err
+	}
+	startRelativeTimeout := func(sequence uint64, relativeTimeoutNs string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "startRelativeTimeout",
+			"packet": {"source_port": "port-1", "source_channel": "channel-1", "sequence": `+fmt.Sprint(sequence)+`},
+			"relativeTimeoutNs": "`+relativeTimeoutNs+`"
+		}`)
+		return err
+	}
+
+	packet, err := sendPacket("60000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, ok := k.GetInFlightPacket(ctx, "port-1", "channel-1", packet.Sequence)
+	if !ok || record.DeadlineNs != now+60_000_000_000 || record.Packet.TimeoutTimestamp != now+3_600_000_000_000 {
+		t.Fatalf("got in-flight packet %+v, %t", record, ok)
+	}
+
+	// A relative timeout cannot outlast the packet's IBC timeout, and nothing
+	// is sent without it.
+	if _, err := sendPacket("3600000000001"); err == nil {
+		t.Error("got no error for a relative timeout past the packet timeout")
+	}
+	if got := k.GetInFlightPackets(ctx); len(got) != 1 {
+		t.Errorf("got in-flight packets %+v, want one", got)
+	}
+
+	// Only a running timeout can be restarted.
+	if err := startRelativeTimeout(packet.Sequence, "120000000000"); err != nil {
+		t.Fatal(err)
+	}
+	if err := startRelativeTimeout(packet.Sequence+10, "120000000000"); err == nil {
+		t.Error("got no error restarting the timeout of an unknown packet")
+	}
+
+	// The event is sent once the restarted timeout elapses, and not before.
+	if err := k.ExpireRelativeTimeouts(ctx.WithBlockTime(ctx.BlockTime().Add(119 * time.Second))); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 0 {
+		t.Fatalf("got actions %+v before the deadline", actions)
+	}
+	expireCtx := ctx.WithBlockTime(ctx.BlockTime().Add(120 * time.Second))
+	if err := k.ExpireRelativeTimeouts(expireCtx); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 {
+		t.Fatalf("got %d actions, want 1", len(actions))
+	}
+	event, ok := actions[0].(PacketTimeoutEvent)
+	if !ok {
+		t.Fatalf("got action %T, want PacketTimeoutEvent", actions[0])
+	}
+	if event.Packet.Sequence != packet.Sequence || event.DeadlineNs != now+120_000_000_000 {
+		t.Errorf("got event %+v", event)
+	}
+	if _, ok := k.GetInFlightPacket(ctx, "port-1", "channel-1", packet.Sequence); ok {
+		t.Error("got in-flight packet after its timeout elapsed")
+	}
+	if err := startRelativeTimeout(packet.Sequence, "120000000000"); err == nil {
+		t.Error("got no error restarting an elapsed timeout")
+	}
+
+	// An acknowledged packet is forgotten along with its timeout.
+	acked, err := sendPacket("60000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ack := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	if err := ibcModule.OnAcknowledgementPacket(ctx, acked, ack, sdk.AccAddress([]byte("relayer"))); err != nil {
+		t.Fatal(err)
+	}
+	if got := k.GetInFlightPackets(ctx); len(got) != 0 {
+		t.Errorf("got in-flight packets %+v after the ack", got)
+	}
+
+	// A timeout whose event cannot be sent is kept, to be retried.
+	unsent, err := sendPacket("60000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	failing := k.WithScope(vibcStoreKey, mockScopedKeeper{}, func(ctx sdk.Context, action vm.Action) error {
+		return fmt.Errorf("action queue unavailable")
+	})
+	if err := failing.ExpireRelativeTimeouts(expireCtx); err == nil {
+		t.Error("got no error from a failed push")
+	}
+	if _, ok := k.GetInFlightPacket(ctx, "port-1", "channel-1", unsent.Sequence); !ok {
+		t.Error("lost the in-flight packet whose event failed")
+	}
+}
+
+// mockFeeKeeper is an ICS-29 fee keeper whose fees are enabled on the channels
+// in enabled, and which records the fees paid.
+type mockFeeKeeper struct {
+	mockChannelKeeper
+	enabled map[string]bool
+	paid    *[]*ibcfeetypes.MsgPayPacketFee
+}
+
+func (m mockFeeKeeper) IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool {
+	return m.enabled[portID+"/"+channelID]
+}
+
+func (m mockFeeKeeper) SetFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	m.enabled[portID+"/"+channelID] = true
+}
+
+func (m mockFeeKeeper) PayPacketFee(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFee) (*ibcfeetypes.MsgPayPacketFeeResponse, error) {
+	*m.paid = append(*m.paid, msg)
+	return &ibcfeetypes.MsgPayPacketFeeResponse{}, nil
+}
+
+func (m mockFeeKeeper) IsLocked(ctx sdk.Context) bool {
+	return false
+}
+
+func (m mockFeeKeeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+func TestIncentivizedPackets(t *testing.T) {
+	var actions []vm.Action
+	var paid []*ibcfeetypes.MsgPayPacketFee
+	k, ctx := makeTestKeeper(t, nil, nil, &actions)
+	channelKeeper := mockChannelKeeper{sequences: map[string]uint64{}}
+	k.channelKeeper = channelKeeper
+	k.scopedKeeper = mockScopedKeeper{}
+	k = k.WithFeeKeeper(mockFeeKeeper{
+		mockChannelKeeper: channelKeeper,
+		enabled:           map[string]bool{"port-1/channel-1": true},
+		paid:              &paid,
+	})
+	receiver := types.NewReceiver(k)
+	escrow := types.FeeEscrowAddress("port-1").String()
+
+	sendPacket := func(channelID, refundAddress string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "sendPacket",
+			"packet": {"source_port": "port-1", "source_channel": "`+channelID+`", "data": "AQI="},
+			"relativeTimeoutNs": "3600000000000",
+			"fee": {
+				"fee": {"recv_fee": [{"denom": "ubld", "amount": "10"}], "ack_fee": [], "timeout_fee": []},
+				"refund_address": "`+refundAddress+`"
+			}
+		}`)
+		return err
+	}
+
+	// The fee is escrowed from the account of the port, whether or not it is
+	// named as the refund address.
+	for _, refundAddress := range []string{"", escrow} {
+		if err := sendPacket("channel-1", refundAddress); err != nil {
+			t.Fatalf("refund address %q: got error = %v", refundAddress, err)
+		}
+	}
+	if len(paid) != 2 {
+		t.Fatalf("got %d fees paid, want 2", len(paid))
+	}
+	for _, msg := range paid {
+		if msg.Signer != escrow || msg.SourcePortId != "port-1" || msg.SourceChannelId != "channel-1" {
+			t.Errorf("got fee paid %+v, want one from %s", msg, escrow)
+		}
+	}
+
+	// No other account can be made to pay, and no fee can be paid on a channel
+	// without fees, so nothing is sent.
+	stranger := sdk.AccAddress([]byte("stranger")).String()
+	if err := sendPacket("channel-1", stranger); err == nil {
+		t.Error("got no error for a fee refunded to another account")
+	}
+	if err := sendPacket("channel-2", ""); err == nil {
+		t.Error("got no error for a fee on a channel without fees")
+	}
+	if len(paid) != 2 || channelKeeper.sequences["port-1/channel-1"] != 2 || channelKeeper.sequences["port-1/channel-2"] != 0 {
+		t.Errorf("got fees paid %+v and sequences %v after refused fees", paid, channelKeeper.sequences)
+	}
+
+	// The account of the port is announced on its fee-enabled channels only.
+	ibcModule := types.NewIBCModule(k)
+	for channelID, want := range map[string]string{"channel-1": escrow, "channel-2": ""} {
+		actions = nil
+		if err := ibcModule.OnChanOpenConfirm(ctx, "port-1", channelID); err != nil {
+			t.Fatal(err)
+		}
+		event, ok := actions[0].(types.ChannelOpenConfirmEvent)
+		if !ok || event.FeeEscrowAddress != want || event.FeeEnabled != (want != "") {
+			t.Errorf("got event %+v on %s, want fee escrow address %q", actions[0], channelID, want)
+		}
+	}
+}
+
+func TestChannelCloseInit(t *testing.T) {
+	var actions []vm.Action
+	k, ctx := makeTestKeeper(t, nil, nil, &actions)
+	channelKeeper := mockChannelKeeper{closed: map[string]bool{}}
+	k.channelKeeper = channelKeeper
+	k.scopedKeeper = namedCapabilityScopedKeeper{names: map[string]bool{
+		host.PortPath("port-1"):                           true,
+		host.ChannelCapabilityPath("port-1", "channel-1"): true,
+		host.ChannelCapabilityPath("port-2", "channel-1"): true,
+	}}
+	receiver := types.NewReceiver(k)
+
+	closeChannel := func(portID, channelID, target string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "chanCloseInit",
+			"packet": {"source_port": "`+portID+`", "source_channel": "`+channelID+`"},
+			"target": "`+target+`"
+		}`)
+		return err
+	}
+
+	if err := closeChannel("port-1", "channel-1", "close-1"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+
+	// Only the channels of ports bound by vibc, whose capabilities it holds,
+	// can be closed.
+	for _, channel := range [][2]string{{"port-1", "channel-2"}, {"port-2", "channel-1"}} {
+		if err := closeChannel(channel[0], channel[1], "close-2"); err == nil {
+			t.Errorf("got no error closing %s/%s", channel[0], channel[1])
+		}
+	}
+	if want := map[string]bool{"port-1/channel-1": true}; !reflect.DeepEqual(channelKeeper.closed, want) {
+		t.Errorf("got closed channels %v, want %v", channelKeeper.closed, want)
+	}
+
+	// The closing vat is told once its channel is closed.
+	if len(actions) != 1 {
+		t.Fatalf("got actions %+v, want one", actions)
+	}
+	event, ok := actions[0].(types.ChannelCloseConfirmEvent)
+	if !ok || event.Target != "close-1" || event.PortID != "port-1" || event.ChannelID != "channel-1" {
+		t.Errorf("got action %+v, want the channelCloseConfirm of port-1/channel-1 for close-1", actions[0])
+	}
+
+	// The VM is told of channels closed by the counterparty, without a target.
+	if err := types.NewIBCModule(k).OnChanCloseConfirm(ctx, "port-1", "channel-3"); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 {
+		t.Fatalf("got actions %+v, want two", actions)
+	}
+	event, ok = actions[1].(types.ChannelCloseConfirmEvent)
+	if !ok || event.Target != "" || event.PortID != "port-1" || event.ChannelID != "channel-3" {
+		t.Errorf("got action %+v, want the untargeted channelCloseConfirm of port-1/channel-3", actions[1])
+	}
+}
+
+// openingChannelKeeper is a channel keeper that opens channels, recording
+// their versions.
+type openingChannelKeeper struct {
+	mockChannelKeeper
+	versions map[string]string
+}
+
+func (m openingChannelKeeper) ChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string,
+	portCap *capability.Capability, counterparty channeltypes.Counterparty, version string,
+) (string, *capability.Capability, error) {
+	return fmt.Sprintf("channel-%d", len(m.versions)), capability.NewCapability(2), nil
+}
+
+func (m openingChannelKeeper) WriteOpenInitChannel(ctx sdk.Context, portID, channelID string, order channeltypes.Order,
+	connectionHops []string, counterparty channeltypes.Counterparty, version string,
+) {
+	m.versions[portID+"/"+channelID] = version
+}
+
+func (m openingChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	return m.sequences[portID+"/"+channelID] + 1, true
+}
+
+func (m openingChannelKeeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
+	return nil
+}
+
+type claimingScopedKeeper struct {
+	mockScopedKeeper
+}
+
+func (claimingScopedKeeper) ClaimCapability(ctx sdk.Context, cap *capability.Capability, name string) error {
+	return nil
+}
+
+// feeAccountKeeper is an account keeper holding only the accounts in
+// addresses.
+type feeAccountKeeper struct {
+	addresses map[string]bool
+}
+
+func (m feeAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (m feeAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	if !m.addresses[addr.String()] {
+		return nil
+	}
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+// escrowBankKeeper is a bank keeper that records the coins sent to and from
+// modules.
+type escrowBankKeeper struct {
+	sent *[]string
+}
+
+func (m escrowBankKeeper) HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool {
+	return true
+}
+
+func (m escrowBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	*m.sent = append(*m.sent, fmt.Sprintf("%s->%s:%s", senderAddr, recipientModule, amt))
+	return nil
+}
+
+func (m escrowBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	*m.sent = append(*m.sent, fmt.Sprintf("%s->%s:%s", senderModule, recipientAddr, amt))
+	return nil
+}
+
+func (m escrowBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return nil
+}
+
+func (m escrowBankKeeper) BlockedAddr(sdk.AccAddress) bool {
+	return false
+}
+
+func (m escrowBankKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	return nil
+}
+
+func TestFeeEnabledChannelOpenInit(t *testing.T) {
+	var actions []vm.Action
+	var sent []string
+	k, ctx := makeTestKeeper(t, nil, nil, &actions)
+	channelKeeper := openingChannelKeeper{
+		mockChannelKeeper: mockChannelKeeper{sequences: map[string]uint64{}},
+		versions:          map[string]string{},
+	}
+	escrow := types.FeeEscrowAddress("port-1")
+	feeKeeper := ibcfeekeeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), feeStoreKey,
+		channelKeeper, channelKeeper, nil,
+		feeAccountKeeper{addresses: map[string]bool{escrow.String(): true}},
+		escrowBankKeeper{sent: &sent},
+	)
+	k.channelKeeper = channelKeeper
+	k.scopedKeeper = claimingScopedKeeper{}
+	k = k.WithFeeKeeper(feeKeeper)
+	receiver := types.NewReceiver(k)
+
+	openChannel := func(version string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "startChannelOpenInit",
+			"packet": {"source_port": "port-1", "destination_port": "transfer"},
+			"order": "UNORDERED",
+			"hops": ["connection-0"],
+			"version": `+fmt.Sprintf("%q", version)+`
+		}`)
+		return err
+	}
+
+	// A channel proposing the fee version is fee-enabled by the fee keeper.
+	if err := openChannel(`{"fee_version":"ics29-1","app_version":"ics20-1"}`); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if err := openChannel("ics20-1"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if err := openChannel(`{"fee_version":"ics29-9","app_version":"ics20-1"}`); err == nil {
+		t.Errorf("got no error for an unsupported fee version")
+	}
+	wantVersions := map[string]string{
+		"port-1/channel-0": `{"fee_version":"ics29-1","app_version":"ics20-1"}`,
+		"port-1/channel-1": "ics20-1",
+	}
+	if !reflect.DeepEqual(channelKeeper.versions, wantVersions) {
+		t.Errorf("got versions %v, want %v", channelKeeper.versions, wantVersions)
+	}
+	if !feeKeeper.IsFeeEnabled(ctx, "port-1", "channel-0") {
+		t.Errorf("channel-0 is not fee-enabled")
+	}
+	if feeKeeper.IsFeeEnabled(ctx, "port-1", "channel-1") {
+		t.Errorf("channel-1 is fee-enabled")
+	}
+
+	// Its packets can then be incentivized from the account of the port.
+	sendPacket := func(channelID string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "sendPacket",
+			"packet": {"source_port": "port-1", "source_channel": "`+channelID+`", "data": "AQI="},
+			"relativeTimeoutNs": "3600000000000",
+			"fee": {"fee": {"recv_fee": [{"denom": "ubld", "amount": "10"}], "ack_fee": [], "timeout_fee": []}}
+		}`)
+		return err
+	}
+	if err := sendPacket("channel-0"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if err := sendPacket("channel-1"); err == nil {
+		t.Errorf("got no error incentivizing a packet on channel-1")
+	}
+	if want := []string{escrow.String() + "->" + ibcfeetypes.ModuleName + ":10ubld"}; !reflect.DeepEqual(sent, want) {
+		t.Errorf("got escrowed %v, want %v", sent, want)
+	}
+	fees, ok := feeKeeper.GetFeesInEscrow(ctx, channeltypes.NewPacketID("port-1", "channel-0", 1))
+	if !ok || len(fees.PacketFees) != 1 || fees.PacketFees[0].RefundAddress != escrow.String() {
+		t.Errorf("got fees in escrow %+v, want one refunded to %s", fees, escrow)
+	}
+}
+
+func TestFeeEnabledChannelCloseInit(t *testing.T) {
+	var actions []vm.Action
+	var sent []string
+	k, ctx := makeTestKeeper(t, nil, nil, &actions)
+	channelKeeper := openingChannelKeeper{
+		mockChannelKeeper: mockChannelKeeper{sequences: map[string]uint64{}, closed: map[string]bool{}},
+		versions:          map[string]string{},
+	}
+	escrow := types.FeeEscrowAddress("port-1")
+	feeKeeper := ibcfeekeeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), feeStoreKey,
+		channelKeeper, channelKeeper, nil,
+		feeAccountKeeper{addresses: map[string]bool{escrow.String(): true}},
+		escrowBankKeeper{sent: &sent},
+	)
+	k.channelKeeper = channelKeeper
+	k.scopedKeeper = mockScopedKeeper{}
+	k = k.WithFeeKeeper(feeKeeper)
+	feeKeeper.SetFeeEnabled(ctx, "port-1", "channel-0")
+	feeKeeper.SetFeeEnabled(ctx, "port-1", "channel-1")
+	receiver := types.NewReceiver(k)
+
+	sendPacket := func(channelID string) {
+		t.Helper()
+		if _, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "sendPacket",
+			"packet": {"source_port": "port-1", "source_channel": "`+channelID+`", "data": "AQI="},
+			"relativeTimeoutNs": "3600000000000",
+			"fee": {"fee": {"recv_fee": [{"denom": "ubld", "amount": "10"}], "ack_fee": [], "timeout_fee": []}}
+		}`); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	closeChannel := func(channelID string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{
+			"type": "IBC_METHOD",
+			"method": "chanCloseInit",
+			"packet": {"source_port": "port-1", "source_channel": "`+channelID+`"}
+		}`)
+		return err
+	}
+	sendPacket("channel-0")
+	sendPacket("channel-0")
+	sendPacket("channel-1")
+	sent = nil
+
+	// Closing a channel refunds the fees still escrowed for its packets to the
+	// account of the port, and only those.
+	if err := closeChannel("channel-0"); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	refund := ibcfeetypes.ModuleName + "->" + escrow.String() + ":10ubld"
+	if want := []string{refund, refund}; !reflect.DeepEqual(sent, want) {
+		t.Errorf("got refunds %v, want %v", sent, want)
+	}
+	if fees := feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, "port-1", "channel-0"); len(fees) != 0 {
+		t.Errorf("got fees still in escrow %+v on channel-0", fees)
+	}
+	if fees := feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, "port-1", "channel-1"); len(fees) != 1 {
+		t.Errorf("got fees in escrow %+v on channel-1, want one", fees)
+	}
+
+	// A locked fee module cannot refund, so the channel is left open.
+	ctx.KVStore(feeStoreKey).Set(ibcfeetypes.KeyLocked(), []byte{1})
+	if err := closeChannel("channel-1"); err == nil {
+		t.Error("got no error closing a channel while the fee module is locked")
+	}
+	if want := map[string]bool{"port-1/channel-0": true}; !reflect.DeepEqual(channelKeeper.closed, want) {
+		t.Errorf("got closed channels %v, want %v", channelKeeper.closed, want)
+	}
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// The in-flight packets are the outgoing packets of vibc channels whose
+// relative timeouts are running, keyed like the pending acks by their source
+// port, channel, and sequence.  Each is also indexed under
+// packetDeadlineStoreKeyPrefix by its big-endian deadline, so that
+// ExpireRelativeTimeouts can find those due in deadline order.
+//
+// A relative timeout is started on a packet by the downcall that sends it, so
+// it belongs to the sender of the packet rather than to a name given by the
+// VM, which routes the PacketTimeoutEvent by the source channel of the packet
+// to the connection that sent it.
+const (
+	inFlightPacketStoreKeyPrefix = "inFlightPacket."
+	packetDeadlineStoreKeyPrefix = "packetDeadline."
+)
+
+// packetDeadlineKey returns the key of the deadline index entry for the
+// in-flight packet with key packetKey.
+func packetDeadlineKey(deadlineNs uint64, packetKey []byte) []byte {
+	return append(binary.BigEndian.AppendUint64(nil, deadlineNs), packetKey...)
+}
+
+// inFlightPacketKey returns the key of the in-flight packet.
+func inFlightPacketKey(packet channeltypes.Packet) []byte {
+	return pendingAckPacketKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// PacketTimeoutEvent notifies the VM that the relative timeout started on an
+// in-flight packet has elapsed before the packet's acknowledgement or IBC
+// timeout.  The packet remains in flight.
+type PacketTimeoutEvent struct {
+	*vm.ActionHeader `actionType:"IBC_EVENT"`
+	Event            string              `json:"event" default:"packetTimeout"`
+	Packet           channeltypes.Packet `json:"packet"`
+	DeadlineNs       uint64              `json:"deadlineNs,string"`
+}
+
+// GetInFlightPacket returns the record of the in-flight packet with the given
+// source port, channel, and sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	var record types.InFlightPacket
+	store, ok := k.getPrefixStore(ctx, inFlightPacketStoreKeyPrefix)
+	if !ok {
+		return record, false
+	}
+	bz := store.Get(pendingAckPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetInFlightPackets returns the records of all the in-flight packets, in
+// deadline order.
+func (k Keeper) GetInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	return k.getInFlightPacketsBefore(ctx, nil)
+}
+
+// getInFlightPacketsBefore returns the records of the in-flight packets whose
+// deadlines precede the deadline index key end (or all of them if end is
+// nil), in deadline order.
+func (k Keeper) getInFlightPacketsBefore(ctx sdk.Context, end []byte) []types.InFlightPacket {
+	records := []types.InFlightPacket{}
+	deadlines, ok := k.getPrefixStore(ctx, packetDeadlineStoreKeyPrefix)
+	if !ok {
+		return records
+	}
+	store, _ := k.getPrefixStore(ctx, inFlightPacketStoreKeyPrefix)
+	iterator := deadlines.Iterator(nil, end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.InFlightPacket
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetInFlightPacket records an in-flight packet and indexes its deadline,
+// replacing any earlier record of the packet.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, record types.InFlightPacket) {
+	store, ok := k.getPrefixStore(ctx, inFlightPacketStoreKeyPrefix)
+	if !ok {
+		return
+	}
+	k.DeleteInFlightPacket(ctx, record.Packet)
+	key := inFlightPacketKey(record.Packet)
+	store.Set(key, k.cdc.MustMarshal(&record))
+	deadlines, _ := k.getPrefixStore(ctx, packetDeadlineStoreKeyPrefix)
+	deadlines.Set(packetDeadlineKey(record.DeadlineNs, key), key)
+}
+
+// DeleteInFlightPacket forgets packet and its relative timeout, if it is in
+// flight, for when the packet is acknowledged or times out.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	record, ok := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !ok {
+		return
+	}
+	key := inFlightPacketKey(packet)
+	store, _ := k.getPrefixStore(ctx, inFlightPacketStoreKeyPrefix)
+	store.Delete(key)
+	deadlines, _ := k.getPrefixStore(ctx, packetDeadlineStoreKeyPrefix)
+	deadlines.Delete(packetDeadlineKey(record.DeadlineNs, key))
+}
+
+// ReceiveSendTimedPacket sends packet as ReceiveSendPacket does, and starts a
+// timeout of relativeTimeoutNs from the current block time on it, after which
+// the VM is sent a PacketTimeoutEvent unless the packet has been acknowledged
+// or timed out.  Nothing is sent unless the timeout starts.
+func (k Keeper) ReceiveSendTimedPacket(ctx sdk.Context, packet ibcexported.PacketI, relativeTimeoutNs uint64) (uint64, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	sequence, err := k.ReceiveSendPacket(cacheCtx, packet)
+	if err != nil {
+		return 0, err
+	}
+	sent := reifyPacket(packet)
+	sent.Sequence = sequence
+	if err := k.startRelativeTimeout(cacheCtx, sent, relativeTimeoutNs); err != nil {
+		return 0, err
+	}
+	writeCache()
+	return sequence, nil
+}
+
+// ReceiveStartRelativeTimeout restarts, to extend or shorten it, the relative
+// timeout running on the in-flight packet with the given source port, channel,
+// and sequence, so that it elapses relativeTimeoutNs from the current block
+// time.
+func (k Keeper) ReceiveStartRelativeTimeout(ctx sdk.Context, portID, channelID string, sequence uint64, relativeTimeoutNs uint64) error {
+	record, ok := k.GetInFlightPacket(ctx, portID, channelID, sequence)
+	if !ok {
+		return fmt.Errorf("no relative timeout is running on packet %s/%s/%d", portID, channelID, sequence)
+	}
+	return k.startRelativeTimeout(ctx, record.Packet, relativeTimeoutNs)
+}
+
+// startRelativeTimeout records packet as in flight with a deadline
+// relativeTimeoutNs from the current block time, which cannot extend past the
+// IBC timeout timestamp of the packet.
+func (k Keeper) startRelativeTimeout(ctx sdk.Context, packet channeltypes.Packet, relativeTimeoutNs uint64) error {
+	if relativeTimeoutNs == 0 {
+		return fmt.Errorf("relative timeout must be positive")
+	}
+	deadlineNs := uint64(ctx.BlockTime().UnixNano()) + relativeTimeoutNs
+	if timeoutNs := packet.GetTimeoutTimestamp(); timeoutNs != 0 && deadlineNs > timeoutNs {
+		return fmt.Errorf("relative timeout ending at %d exceeds the packet timeout timestamp %d", deadlineNs, timeoutNs)
+	}
+	k.SetInFlightPacket(ctx, types.InFlightPacket{Packet: packet, DeadlineNs: deadlineNs})
+	return nil
+}
+
+// ExpireRelativeTimeouts sends a PacketTimeoutEvent for each in-flight packet
+// whose relative timeout has elapsed by the current block time, in deadline
+// order, and forgets it.  A packet whose event cannot be sent is kept, to be
+// retried in the next block.
+func (k Keeper) ExpireRelativeTimeouts(ctx sdk.Context) error {
+	end := packetDeadlineKey(uint64(ctx.BlockTime().UnixNano())+1, nil)
+	for _, record := range k.getInFlightPacketsBefore(ctx, end) {
+		event := PacketTimeoutEvent{
+			Packet:     record.Packet,
+			DeadlineNs: record.DeadlineNs,
+		}
+		if err := k.PushAction(ctx, event); err != nil {
+			return err
+		}
+		k.DeleteInFlightPacket(ctx, record.Packet)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// BindVstorageAttestationPort binds the port on which remote chains request
+// attestations of vstorage data, unless it is already bound.
+func (k Keeper) BindVstorageAttestationPort(ctx sdk.Context) error {
+	portPath := host.PortPath(types.VstorageAttestationPortID)
+	if _, ok := k.GetCapability(ctx, portPath); ok {
+		return nil
+	}
+	cap := k.portKeeper.BindPort(ctx, types.VstorageAttestationPortID)
+	return k.ClaimCapability(ctx, cap, portPath)
+}
@@ -42,12 +42,29 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 
 // DefaultGenesis returns default genesis state as raw bytes for the deployment
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return nil
+	return cdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// unmarshalGenesis returns the genesis state of bz, or the default for the
+// genesis of an earlier version, which had none.
+func unmarshalGenesis(cdc codec.JSONCodec, bz json.RawMessage) (*types.GenesisState, error) {
+	data := DefaultGenesisState()
+	if len(bz) == 0 || string(bz) == "null" {
+		return data, nil
+	}
+	if err := cdc.UnmarshalJSON(bz, data); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
 
 // Validation check of the Genesis
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	return nil
+	data, err := unmarshalGenesis(cdc, bz)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {
@@ -91,6 +108,10 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// Notify the senders of in-flight packets whose relative timeouts elapsed.
+	if err := am.keeper.ExpireRelativeTimeouts(ctx); err != nil {
+		ctx.Logger().Error("failed to expire relative packet timeouts", "err", err)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -120,14 +141,23 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), tx)
 }
 
-// InitGenesis performs genesis initialization for the ibc-transfer module. It returns
-// no validator updates.
+// InitGenesis performs genesis initialization for the ibc-transfer module,
+// binding the vstorage attestation port and restoring the port configs and
+// in-flight packets. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	genesisState, err := unmarshalGenesis(cdc, data)
+	if err != nil {
+		panic(err)
+	}
+	InitGenesis(ctx, am.keeper, genesisState)
+	if err := am.keeper.BindVstorageAttestationPort(ctx); err != nil {
+		panic(err)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the ibc-transfer
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return nil
+	return cdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
 }
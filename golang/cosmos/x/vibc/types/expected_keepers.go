@@ -1,12 +1,18 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capability "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connection "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channel "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 )
 
 type BankKeeper interface {
@@ -37,9 +43,22 @@ type ChannelKeeper interface {
 	TimeoutExecuted(ctx sdk.Context, channelCap *capability.Capability, packet ibcexported.PacketI) error
 }
 
+// FeeKeeper defines the expected ICS-29 fee keeper, which wraps the ICS4
+// functions of the channel keeper for fee-enabled channels and escrows the
+// relayer incentives of their packets, refunding them when the channels close
+type FeeKeeper interface {
+	porttypes.ICS4Wrapper
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	SetFeeEnabled(ctx sdk.Context, portID, channelID string)
+	PayPacketFee(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFee) (*ibcfeetypes.MsgPayPacketFeeResponse, error)
+	IsLocked(ctx sdk.Context) bool
+	RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID string) error
+}
+
 // ClientKeeper defines the expected IBC client keeper
 type ClientKeeper interface {
-	GetClientConsensusState(ctx sdk.Context, clientID string) (connection ibcexported.ConsensusState, found bool)
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
 }
 
 // ConnectionKeeper defines the expected IBC connection keeper
@@ -52,6 +71,12 @@ type PortKeeper interface {
 	BindPort(ctx sdk.Context, portID string) *capability.Capability
 }
 
+// VstorageKeeper defines the expected vstorage keeper, which reads the data of
+// vstorage paths
+type VstorageKeeper interface {
+	GetEntry(ctx sdk.Context, path string) agoric.KVEntry
+}
+
 // ScopedKeeper defines the expected scoped capability keeper
 type ScopedKeeper interface {
 	ClaimCapability(ctx sdk.Context, cap *capability.Capability, name string) error
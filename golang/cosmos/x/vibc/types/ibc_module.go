@@ -32,6 +32,11 @@ var (
 type IBCModuleImpl interface {
 	ClaimCapability(ctx sdk.Context, channelCap *capability.Capability, path string) error
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetPortConfig(ctx sdk.Context, portID string) PortConfig
+	SetPendingAckPacket(ctx sdk.Context, packet channeltypes.Packet)
+	TakePendingInterchainQuery(ctx sdk.Context, packet channeltypes.Packet) (string, bool)
+	DeleteInFlightPacket(ctx sdk.Context, packet channeltypes.Packet)
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
 	PushAction(ctx sdk.Context, action vm.Action) error
 }
 
@@ -69,6 +74,15 @@ func (im IBCModule) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) (string, error) {
+	config := im.impl.GetPortConfig(ctx, portID)
+	if err := config.CheckOrder(order); err != nil {
+		return "", err
+	}
+	version = config.VersionOrDefault(version)
+	if !config.AcceptsVersion(version) {
+		return "", sdkioerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "port %s does not accept version %q", portID, version)
+	}
+
 	event := ChannelOpenInitEvent{
 		Order:          orderToString(order),
 		ConnectionHops: connectionHops,
@@ -107,7 +121,10 @@ type ChannelOpenTryEvent struct {
 	ChannelID        string                    `json:"channelID"`
 	Counterparty     channeltypes.Counterparty `json:"counterparty"`
 	Version          string                    `json:"version"`
-	AsyncVersions    bool                      `json:"asyncVersions"`
+	// The version proposed by the counterparty, which may differ from Version
+	// if the port's PortConfig does not accept it.
+	CounterpartyVersion string `json:"counterpartyVersion"`
+	AsyncVersions       bool   `json:"asyncVersions"`
 }
 
 func (im IBCModule) OnChanOpenTry(
@@ -120,14 +137,20 @@ func (im IBCModule) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
+	config := im.impl.GetPortConfig(ctx, portID)
+	if err := config.CheckOrder(order); err != nil {
+		return "", err
+	}
+
 	event := ChannelOpenTryEvent{
-		Order:          orderToString(order),
-		ConnectionHops: connectionHops,
-		PortID:         portID,
-		ChannelID:      channelID,
-		Counterparty:   counterparty,
-		Version:        counterpartyVersion,
-		AsyncVersions:  AsyncVersions,
+		Order:               orderToString(order),
+		ConnectionHops:      connectionHops,
+		PortID:              portID,
+		ChannelID:           channelID,
+		Counterparty:        counterparty,
+		Version:             config.NegotiateVersion(counterpartyVersion),
+		CounterpartyVersion: counterpartyVersion,
+		AsyncVersions:       AsyncVersions,
 	}
 
 	err := im.impl.PushAction(ctx, event)
@@ -141,7 +164,8 @@ func (im IBCModule) OnChanOpenTry(
 	}
 
 	if !event.AsyncVersions {
-		// We have to supply a synchronous version, so just echo back the one they sent.
+		// We have to supply a synchronous version, so answer with the one they
+		// sent if the port accepts it, or else with the port's preferred version.
 		return event.Version, nil
 	}
 
@@ -150,6 +174,15 @@ func (im IBCModule) OnChanOpenTry(
 	return "", nil
 }
 
+// feeEscrow returns whether the channel was opened with ICS-29 fees and, if
+// so, the FeeEscrowAddress of its port.
+func (im IBCModule) feeEscrow(ctx sdk.Context, portID, channelID string) (bool, string) {
+	if !im.impl.IsFeeEnabled(ctx, portID, channelID) {
+		return false, ""
+	}
+	return true, FeeEscrowAddress(portID).String()
+}
+
 type ChannelOpenAckEvent struct {
 	*vm.ActionHeader    `actionType:"IBC_EVENT"`
 	Event               string                    `json:"event" default:"channelOpenAck"`
@@ -158,6 +191,12 @@ type ChannelOpenAckEvent struct {
 	CounterpartyVersion string                    `json:"counterpartyVersion"`
 	Counterparty        channeltypes.Counterparty `json:"counterparty"`
 	ConnectionHops      []string                  `json:"connectionHops"`
+	// FeeEnabled is whether the channel was opened with ICS-29 fees, so that
+	// its packets may carry relayer incentives.
+	FeeEnabled bool `json:"feeEnabled"`
+	// FeeEscrowAddress is, if FeeEnabled, the FeeEscrowAddress of the port,
+	// from which those incentives are paid.
+	FeeEscrowAddress string `json:"feeEscrowAddress,omitempty"`
 }
 
 func (im IBCModule) OnChanOpenAck(
@@ -167,17 +206,25 @@ func (im IBCModule) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
+	config := im.impl.GetPortConfig(ctx, portID)
+	if !config.AcceptsVersion(counterpartyVersion) {
+		return sdkioerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "port %s does not accept counterparty version %q", portID, counterpartyVersion)
+	}
+
 	// We don't care if the channel was found.  If it wasn't then GetChannel
 	// returns an empty channel object that we can still use without crashing.
 	channel, _ := im.impl.GetChannel(ctx, portID, channelID)
 
 	channel.Counterparty.ChannelId = counterpartyChannelID
+	feeEnabled, feeEscrowAddress := im.feeEscrow(ctx, portID, channelID)
 	event := ChannelOpenAckEvent{
 		PortID:              portID,
 		ChannelID:           channelID,
 		CounterpartyVersion: counterpartyVersion,
 		Counterparty:        channel.Counterparty,
 		ConnectionHops:      channel.ConnectionHops,
+		FeeEnabled:          feeEnabled,
+		FeeEscrowAddress:    feeEscrowAddress,
 	}
 
 	return im.impl.PushAction(ctx, event)
@@ -189,6 +236,10 @@ type ChannelOpenConfirmEvent struct {
 	Target           string `json:"target,omitempty"`
 	PortID           string `json:"portID"`
 	ChannelID        string `json:"channelID"`
+	// FeeEnabled is whether the channel was opened with ICS-29 fees.
+	FeeEnabled bool `json:"feeEnabled"`
+	// FeeEscrowAddress is, if FeeEnabled, the FeeEscrowAddress of the port.
+	FeeEscrowAddress string `json:"feeEscrowAddress,omitempty"`
 }
 
 func (im IBCModule) OnChanOpenConfirm(
@@ -196,9 +247,12 @@ func (im IBCModule) OnChanOpenConfirm(
 	portID,
 	channelID string,
 ) error {
+	feeEnabled, feeEscrowAddress := im.feeEscrow(ctx, portID, channelID)
 	event := ChannelOpenConfirmEvent{
-		PortID:    portID,
-		ChannelID: channelID,
+		PortID:           portID,
+		ChannelID:        channelID,
+		FeeEnabled:       feeEnabled,
+		FeeEscrowAddress: feeEscrowAddress,
 	}
 
 	return im.impl.PushAction(ctx, event)
@@ -282,9 +336,25 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	// Remember the packet so that the VM can later acknowledge it by sequence
+	// alone, with the writeAcknowledgement downcall.
+	im.impl.SetPendingAckPacket(ctx, packet)
+
 	return nil
 }
 
+// ICAHostPacketEvent reports a packet executed by the interchain accounts host
+// for an account controlled by a vat, with the host's acknowledgement.
+type ICAHostPacketEvent struct {
+	*vm.ActionHeader `actionType:"IBC_EVENT"`
+	Event            string              `json:"event" default:"icaHostPacket"`
+	Target           string              `json:"target,omitempty"`
+	Packet           channeltypes.Packet `json:"packet"`
+	Acknowledgement  []byte              `json:"acknowledgement"`
+	Success          bool                `json:"success"`
+	Relayer          sdk.AccAddress      `json:"relayer"`
+}
+
 type AcknowledgementPacketEvent struct {
 	*vm.ActionHeader `actionType:"IBC_EVENT"`
 	Event            string              `json:"event" default:"acknowledgementPacket"`
@@ -300,6 +370,22 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	im.impl.DeleteInFlightPacket(ctx, packet)
+	if target, ok := im.impl.TakePendingInterchainQuery(ctx, packet); ok {
+		event := InterchainQueryResultEvent{
+			Target:  target,
+			Packet:  packet,
+			Relayer: relayer,
+		}
+		responses, err := DecodeInterchainQueryAck(acknowledgement)
+		if err != nil {
+			event.Error = err.Error()
+		} else {
+			event.Responses = responses
+		}
+		return im.impl.PushAction(ctx, event)
+	}
+
 	event := AcknowledgementPacketEvent{
 		Packet:          packet,
 		Acknowledgement: acknowledgement,
@@ -327,6 +413,17 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	im.impl.DeleteInFlightPacket(ctx, packet)
+	if target, ok := im.impl.TakePendingInterchainQuery(ctx, packet); ok {
+		event := InterchainQueryResultEvent{
+			Target:  target,
+			Packet:  packet,
+			Error:   "timeout",
+			Relayer: relayer,
+		}
+		return im.impl.PushAction(ctx, event)
+	}
+
 	event := TimeoutPacketEvent{
 		Packet:  packet,
 		Relayer: relayer,
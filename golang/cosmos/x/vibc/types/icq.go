@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// InterchainQueryResultEvent reports for the target given to the
+// sendInterchainQuery downcall the responses of the host chain to the query,
+// or the error (including "timeout") that prevented them.
+type InterchainQueryResultEvent struct {
+	*vm.ActionHeader `actionType:"IBC_EVENT"`
+	Event            string               `json:"event" default:"interchainQueryResult"`
+	Target           string               `json:"target,omitempty"`
+	Packet           channeltypes.Packet  `json:"packet"`
+	Responses        []abci.ResponseQuery `json:"responses"`
+	Error            string               `json:"error,omitempty"`
+	Relayer          sdk.AccAddress       `json:"relayer"`
+}
+
+// icqCdc encodes the JSON of interchain query packets, as does async-icq.
+var icqCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// EncodeInterchainQuery returns the packet data of an interchain query for
+// requests.
+func EncodeInterchainQuery(requests []abci.RequestQuery) ([]byte, error) {
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("interchain query has no requests")
+	}
+	query := CosmosQuery{Requests: requests}
+	bz, err := query.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	packetData := InterchainQueryPacketData{Data: bz}
+	packetJSON, err := icqCdc.MarshalJSON(&packetData)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(packetJSON), nil
+}
+
+// DecodeInterchainQueryAck returns the responses carried by the channel
+// acknowledgement of an interchain query.
+func DecodeInterchainQueryAck(acknowledgement []byte) ([]abci.ResponseQuery, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, fmt.Errorf("cannot decode acknowledgement: %w", err)
+	}
+	if !ack.Success() {
+		return nil, fmt.Errorf("interchain query failed: %s", ack.GetError())
+	}
+	var packetAck InterchainQueryPacketAck
+	if err := icqCdc.UnmarshalJSON(ack.GetResult(), &packetAck); err != nil {
+		return nil, fmt.Errorf("cannot decode interchain query result: %w", err)
+	}
+	var response CosmosResponse
+	if err := response.Unmarshal(packetAck.Data); err != nil {
+		return nil, fmt.Errorf("cannot decode interchain query responses: %w", err)
+	}
+	return response.Responses, nil
+}
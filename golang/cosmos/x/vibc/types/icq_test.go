@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestInterchainQuery(t *testing.T) {
+	if _, err := EncodeInterchainQuery(nil); err == nil {
+		t.Errorf("encoded an empty query")
+	}
+	data, err := EncodeInterchainQuery([]abci.RequestQuery{{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: []byte{1, 2}}})
+	if err != nil {
+		t.Fatalf("cannot encode query: %v", err)
+	}
+	var packetData InterchainQueryPacketData
+	if err := icqCdc.UnmarshalJSON(data, &packetData); err != nil {
+		t.Fatalf("cannot decode packet data %s: %v", data, err)
+	}
+	var query CosmosQuery
+	if err := query.Unmarshal(packetData.Data); err != nil || len(query.Requests) != 1 ||
+		query.Requests[0].Path != "/cosmos.bank.v1beta1.Query/Balance" {
+		t.Errorf("got query %v (%v)", query, err)
+	}
+
+	response := CosmosResponse{Responses: []abci.ResponseQuery{{Code: 0, Value: []byte{3}}}}
+	bz, err := response.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := icqCdc.MarshalJSON(&InterchainQueryPacketAck{Data: bz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ack := channeltypes.NewResultAcknowledgement(result).Acknowledgement()
+	responses, err := DecodeInterchainQueryAck(ack)
+	if err != nil {
+		t.Fatalf("cannot decode ack: %v", err)
+	}
+	if len(responses) != 1 || len(responses[0].Value) != 1 || responses[0].Value[0] != 3 {
+		t.Errorf("got responses %v", responses)
+	}
+
+	errAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("no such query")).Acknowledgement()
+	if _, err := DecodeInterchainQueryAck(errAck); err == nil {
+		t.Errorf("decoded an error acknowledgement")
+	}
+}
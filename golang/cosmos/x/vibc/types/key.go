@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// module name
 	ModuleName = "vibc"
@@ -7,3 +12,16 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 )
+
+// FeeEscrowAddress returns the address of the account of portID that pays the
+// ICS-29 relayer incentives of its packets and is refunded whatever of them is
+// not paid out.  It has no key, so it is spent only by the incentivized packets
+// of the port.  It is funded like any other account, by a bank MsgSend or by a
+// vat depositing into its virtual purse from getBankForAddress (a VBANK_GIVE);
+// the first such transfer also creates the account, without which the fee
+// keeper refuses to escrow from it.  Vats learn the address from the
+// feeEscrowAddress of the channelOpenAck or channelOpenConfirm event of a
+// fee-enabled channel.
+func FeeEscrowAddress(portID string) sdk.AccAddress {
+	return sdkaddress.Module(ModuleName, []byte("fees/"+portID))
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// PortConfig constrains the channels which may be opened on a port bound by a
+// vat.  Because IBC requires the channel handshake callbacks to be answered
+// synchronously, the vat declares its preferences when binding the port so
+// that they can be applied before the VM sees the handshake event.
+type PortConfig struct {
+	// Orders are the acceptable channel orders ("ORDERED" or "UNORDERED"). If
+	// empty, any order is acceptable.
+	Orders []string `json:"orders,omitempty"`
+	// Versions are the acceptable channel versions, most preferred first. If
+	// empty, any version is acceptable.
+	Versions []string `json:"versions,omitempty"`
+}
+
+// ValidateBasic checks that the config names only known channel orders and
+// non-empty versions.
+func (pc PortConfig) ValidateBasic() error {
+	for _, order := range pc.Orders {
+		if stringToOrder(order) == channeltypes.NONE {
+			return fmt.Errorf("invalid channel order %q", order)
+		}
+	}
+	for _, version := range pc.Versions {
+		if version == "" {
+			return fmt.Errorf("channel versions cannot be empty")
+		}
+	}
+	return nil
+}
+
+// CheckOrder returns an error if channels of the given order may not be
+// opened on the port.
+func (pc PortConfig) CheckOrder(order channeltypes.Order) error {
+	if len(pc.Orders) == 0 {
+		return nil
+	}
+	for _, allowed := range pc.Orders {
+		if stringToOrder(allowed) == order {
+			return nil
+		}
+	}
+	return sdkioerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "port does not accept %s channels", orderToString(order))
+}
+
+// AcceptsVersion reports whether the port accepts a channel of the given version.
+func (pc PortConfig) AcceptsVersion(version string) bool {
+	if len(pc.Versions) == 0 {
+		return true
+	}
+	for _, allowed := range pc.Versions {
+		if allowed == version {
+			return true
+		}
+	}
+	return false
+}
+
+// NegotiateVersion returns the version to answer a handshake in which the
+// counterparty proposed the given version: the proposal itself if acceptable,
+// otherwise the port's most preferred version.
+func (pc PortConfig) NegotiateVersion(proposed string) string {
+	if pc.AcceptsVersion(proposed) {
+		return proposed
+	}
+	return pc.Versions[0]
+}
+
+// VersionOrDefault returns the version to propose when opening a channel,
+// substituting the port's most preferred version if none was given.
+func (pc PortConfig) VersionOrDefault(version string) string {
+	if version == "" && len(pc.Versions) > 0 {
+		return pc.Versions[0]
+	}
+	return version
+}
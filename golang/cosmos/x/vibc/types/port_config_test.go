@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+func TestPortConfig(t *testing.T) {
+	unconstrained := PortConfig{}
+	config := PortConfig{
+		Orders:   []string{"ORDERED"},
+		Versions: []string{"ics20-2", "ics20-1"},
+	}
+
+	if err := unconstrained.CheckOrder(channeltypes.UNORDERED); err != nil {
+		t.Errorf("unconstrained port rejected UNORDERED: %v", err)
+	}
+	if err := config.CheckOrder(channeltypes.ORDERED); err != nil {
+		t.Errorf("port rejected ORDERED: %v", err)
+	}
+	if err := config.CheckOrder(channeltypes.UNORDERED); err == nil {
+		t.Errorf("port accepted UNORDERED")
+	}
+
+	for _, tt := range []struct {
+		proposed   string
+		negotiated string
+		defaulted  string
+	}{
+		{proposed: "ics20-1", negotiated: "ics20-1", defaulted: "ics20-1"},
+		{proposed: "ics20-3", negotiated: "ics20-2", defaulted: "ics20-3"},
+		{proposed: "", negotiated: "ics20-2", defaulted: "ics20-2"},
+	} {
+		if got := config.NegotiateVersion(tt.proposed); got != tt.negotiated {
+			t.Errorf("NegotiateVersion(%q) = %q, want %q", tt.proposed, got, tt.negotiated)
+		}
+		if got := config.VersionOrDefault(tt.proposed); got != tt.defaulted {
+			t.Errorf("VersionOrDefault(%q) = %q, want %q", tt.proposed, got, tt.defaulted)
+		}
+		if got := unconstrained.NegotiateVersion(tt.proposed); got != tt.proposed {
+			t.Errorf("unconstrained NegotiateVersion(%q) = %q", tt.proposed, got)
+		}
+	}
+
+	if err := (PortConfig{Orders: []string{"NONE"}}).ValidateBasic(); err == nil {
+		t.Errorf("wanted error for invalid order")
+	}
+	if err := (PortConfig{Versions: []string{""}}).ValidateBasic(); err == nil {
+		t.Errorf("wanted error for empty version")
+	}
+	if err := config.ValidateBasic(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 var (
@@ -20,12 +22,22 @@ var (
 
 type ReceiverImpl interface {
 	ReceiveSendPacket(ctx sdk.Context, packet exported.PacketI) (uint64, error)
+	ReceiveSendInterchainQuery(ctx sdk.Context, packet exported.PacketI, target string) (uint64, error)
+	ReceiveSendTimedPacket(ctx sdk.Context, packet exported.PacketI, relativeTimeoutNs uint64) (uint64, error)
+	ReceiveSendIncentivizedPacket(ctx sdk.Context, packet exported.PacketI, fee ibcfeetypes.PacketFee) (uint64, error)
+	ReceiveStartRelativeTimeout(ctx sdk.Context, portID, channelID string, sequence uint64, relativeTimeoutNs uint64) error
 	ReceiveWriteAcknowledgement(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement) error
+	ReceiveWritePendingAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ack exported.Acknowledgement) error
 	ReceiveChanOpenInit(ctx sdk.Context, order channeltypes.Order, hops []string, sourcePort, destinationPort, version string) error
 	ReceiveWriteOpenTryChannel(ctx sdk.Context, packet exported.PacketI, order channeltypes.Order, connectionHops []string, version string) error
 	ReceiveChanCloseInit(ctx sdk.Context, sourcePort, sourceChannel string) error
-	ReceiveBindPort(ctx sdk.Context, sourcePort string) error
+	ReceiveTargetedChanCloseInit(ctx sdk.Context, sourcePort, sourceChannel, target string) error
+	ReceiveBindPort(ctx sdk.Context, sourcePort string, config PortConfig) error
 	ReceiveTimeoutExecuted(ctx sdk.Context, packet exported.PacketI) error
+	ReceiveQueryConnection(ctx sdk.Context, connectionID string) (string, error)
+	ReceiveQueryClientState(ctx sdk.Context, clientID string) (string, error)
+	ReceiveRegisterICAController(ctx sdk.Context, connectionID, controllerPortID, target string) error
+	ReceiveUnregisterICAController(ctx sdk.Context, connectionID, controllerPortID, target string) error
 }
 
 type Receiver struct {
@@ -47,6 +59,29 @@ type portMessage struct { // comes from swingset's IBC handler
 	Hops              []string            `json:"hops"`
 	Version           string              `json:"version"`
 	Ack               []byte              `json:"ack"`
+	// For queryConnection and queryClientState, the object to query.
+	ConnectionID string `json:"connectionID"`
+	ClientID     string `json:"clientID"`
+	// For registerICAController and unregisterICAController, the controller
+	// port whose interchain accounts are controlled by the vat, and the target
+	// notified of their packets.  For chanCloseInit, the target notified when
+	// the channel is closed.
+	ControllerPortID string `json:"controllerPortID"`
+	Target           string `json:"target"`
+	// For sendPacket, the relative timeout to start on the packet once sent,
+	// if any.  For startRelativeTimeout, RelativeTimeoutNs is that of the
+	// restarted timeout instead.
+	PacketTimeoutNs uint64 `json:"packetTimeoutNs,string"`
+	// For sendPacket, the optional ICS-29 relayer incentive of the packet,
+	// escrowed from the FeeEscrowAddress of its source port, which is also its
+	// refund address.
+	Fee *ibcfeetypes.PacketFee `json:"fee"`
+	// For sendInterchainQuery, the ABCI queries to run on the host chain,
+	// whose result is reported for Target.
+	Requests []abci.RequestQuery `json:"requests"`
+	// For bindPort, the PortConfig of the port.
+	Orders   []string `json:"orders"`
+	Versions []string `json:"versions"`
 }
 
 func stringToOrder(order string) channeltypes.Order {
@@ -71,6 +106,22 @@ func orderToString(order channeltypes.Order) string {
 	}
 }
 
+// outboundPacket returns the packet with data to send as specified by msg.
+func (msg portMessage) outboundPacket(ctx sdk.Context, data []byte) channeltypes.Packet {
+	timeoutTimestamp := msg.Packet.TimeoutTimestamp
+	if msg.Packet.TimeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		// Use the relative timeout if no absolute timeout is specifiied.
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeoutNs
+	}
+
+	return channeltypes.NewPacket(
+		data, 0,
+		msg.Packet.SourcePort, msg.Packet.SourceChannel,
+		msg.Packet.DestinationPort, msg.Packet.DestinationChannel,
+		msg.Packet.TimeoutHeight, timeoutTimestamp,
+	)
+}
+
 type RawAcknowledgement struct {
 	data []byte
 }
@@ -115,19 +166,18 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 
 	switch msg.Method {
 	case "sendPacket":
-		timeoutTimestamp := msg.Packet.TimeoutTimestamp
-		if msg.Packet.TimeoutHeight.IsZero() && timeoutTimestamp == 0 {
-			// Use the relative timeout if no absolute timeout is specifiied.
-			timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeoutNs
+		packet := msg.outboundPacket(ctx, msg.Packet.Data)
+		var seq uint64
+		switch {
+		case msg.Fee != nil && msg.PacketTimeoutNs != 0:
+			err = fmt.Errorf("cannot start a relative timeout on an incentivized packet")
+		case msg.Fee != nil:
+			seq, err = impl.ReceiveSendIncentivizedPacket(ctx, packet, *msg.Fee)
+		case msg.PacketTimeoutNs != 0:
+			seq, err = impl.ReceiveSendTimedPacket(ctx, packet, msg.PacketTimeoutNs)
+		default:
+			seq, err = impl.ReceiveSendPacket(ctx, packet)
 		}
-
-		packet := channeltypes.NewPacket(
-			msg.Packet.Data, 0,
-			msg.Packet.SourcePort, msg.Packet.SourceChannel,
-			msg.Packet.DestinationPort, msg.Packet.DestinationChannel,
-			msg.Packet.TimeoutHeight, timeoutTimestamp,
-		)
-		seq, err := impl.ReceiveSendPacket(ctx, packet)
 		if err == nil {
 			packet.Sequence = seq
 			bytes, err := json.Marshal(&packet)
@@ -136,10 +186,33 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 			}
 		}
 
+	case "sendInterchainQuery":
+		var data []byte
+		data, err = EncodeInterchainQuery(msg.Requests)
+		if err != nil {
+			break
+		}
+		packet := msg.outboundPacket(ctx, data)
+		var seq uint64
+		seq, err = impl.ReceiveSendInterchainQuery(ctx, packet, msg.Target)
+		if err == nil {
+			packet.Sequence = seq
+			var bytes []byte
+			bytes, err = json.Marshal(&packet)
+			if err == nil {
+				jsonReply = string(bytes)
+			}
+		}
+
 	case "tryOpenExecuted":
+		order := stringToOrder(msg.Order)
+		if order == channeltypes.NONE {
+			err = fmt.Errorf("invalid channel order %q", msg.Order)
+			break
+		}
 		err = impl.ReceiveWriteOpenTryChannel(
 			ctx, msg.Packet,
-			stringToOrder(msg.Order), msg.Hops, msg.Version,
+			order, msg.Hops, msg.Version,
 		)
 
 	case "receiveExecuted":
@@ -148,9 +221,23 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 		}
 		err = impl.ReceiveWriteAcknowledgement(ctx, msg.Packet, ack)
 
+	case "writeAcknowledgement":
+		ack := RawAcknowledgement{
+			data: msg.Ack,
+		}
+		err = impl.ReceiveWritePendingAcknowledgement(
+			ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel,
+			msg.Packet.Sequence, ack,
+		)
+
 	case "startChannelOpenInit":
+		order := stringToOrder(msg.Order)
+		if order == channeltypes.NONE {
+			err = fmt.Errorf("invalid channel order %q", msg.Order)
+			break
+		}
 		err = impl.ReceiveChanOpenInit(
-			ctx, stringToOrder(msg.Order), msg.Hops,
+			ctx, order, msg.Hops,
 			msg.Packet.SourcePort,
 			msg.Packet.DestinationPort,
 			msg.Version,
@@ -159,12 +246,40 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 	case "startChannelCloseInit":
 		err = impl.ReceiveChanCloseInit(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 
+	case "chanCloseInit":
+		err = impl.ReceiveTargetedChanCloseInit(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Target)
+
 	case "bindPort":
-		err = impl.ReceiveBindPort(ctx, msg.Packet.SourcePort)
+		config := PortConfig{
+			Orders:   msg.Orders,
+			Versions: msg.Versions,
+		}
+		if err = config.ValidateBasic(); err != nil {
+			break
+		}
+		err = impl.ReceiveBindPort(ctx, msg.Packet.SourcePort, config)
+
+	case "startRelativeTimeout":
+		err = impl.ReceiveStartRelativeTimeout(
+			ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel,
+			msg.Packet.Sequence, msg.RelativeTimeoutNs,
+		)
 
 	case "timeoutExecuted":
 		err = impl.ReceiveTimeoutExecuted(ctx, msg.Packet)
 
+	case "queryConnection":
+		jsonReply, err = impl.ReceiveQueryConnection(ctx, msg.ConnectionID)
+
+	case "queryClientState":
+		jsonReply, err = impl.ReceiveQueryClientState(ctx, msg.ClientID)
+
+	case "registerICAController":
+		err = impl.ReceiveRegisterICAController(ctx, msg.ConnectionID, msg.ControllerPortID, msg.Target)
+
+	case "unregisterICAController":
+		err = impl.ReceiveUnregisterICAController(ctx, msg.ConnectionID, msg.ControllerPortID, msg.Target)
+
 	default:
 		err = fmt.Errorf("unrecognized method %s", msg.Method)
 	}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// VstorageAttestationPortID is the port on which remote chains request
+	// attestations of vstorage data.
+	VstorageAttestationPortID = "vstorage"
+
+	// VstorageAttestationVersion is the version of the channels of the
+	// vstorage attestation port.
+	VstorageAttestationVersion = "agoric-vstorage-attestation-1"
+)
+
+// VstorageAttestationPacketData is the packet data of a request for the
+// attestation of the data of a vstorage path.
+type VstorageAttestationPacketData struct {
+	Path string `json:"path"`
+}
+
+// VstorageAttestation is the acknowledgement result of a request for the
+// attestation of the data of a vstorage path: its value as of the receipt of
+// the packet in the block at height. The requesting chain verifies the
+// acknowledgement itself against the app hash, as for any other.
+type VstorageAttestation struct {
+	Path   string `json:"path"`
+	Value  string `json:"value"`
+	Height int64  `json:"height,string"`
+}
+
+// DecodeVstorageAttestationRequest returns the request carried by the packet
+// data of a vstorage attestation request.
+func DecodeVstorageAttestationRequest(data []byte) (VstorageAttestationPacketData, error) {
+	var request VstorageAttestationPacketData
+	if err := json.Unmarshal(data, &request); err != nil {
+		return request, fmt.Errorf("cannot decode vstorage attestation request: %w", err)
+	}
+	return request, nil
+}
+
+// EncodeVstorageAttestation returns the acknowledgement result of an
+// attestation.
+func EncodeVstorageAttestation(attestation VstorageAttestation) ([]byte, error) {
+	bz, err := json.Marshal(attestation)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(bz), nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVstorageAttestation(t *testing.T) {
+	request, err := DecodeVstorageAttestationRequest([]byte(`{"path":"published.feed"}`))
+	if err != nil {
+		t.Fatalf("cannot decode request: %v", err)
+	}
+	if request.Path != "published.feed" {
+		t.Errorf("got request %+v", request)
+	}
+	if _, err := DecodeVstorageAttestationRequest([]byte(`not JSON`)); err == nil {
+		t.Errorf("decoded request that is not JSON")
+	}
+
+	result, err := EncodeVstorageAttestation(VstorageAttestation{
+		Path:   "published.feed",
+		Value:  "price",
+		Height: 12,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"height":"12","path":"published.feed","value":"price"}`
+	if string(result) != expected {
+		t.Errorf("got result %s, want %s", result, expected)
+	}
+	var attestation VstorageAttestation
+	if err := json.Unmarshal(result, &attestation); err != nil || attestation.Height != 12 {
+		t.Errorf("got attestation %+v (%v)", attestation, err)
+	}
+}
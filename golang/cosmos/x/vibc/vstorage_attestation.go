@@ -0,0 +1,203 @@
+package vibc
+
+import (
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capability "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+var _ porttypes.IBCModule = VstorageAttestationModule{}
+
+// VstorageAttestationModule wraps the vIBC IBCModule to answer, without the
+// VM, the channels and packets of the vstorage attestation port, by which
+// remote chains request the data of vstorage paths.  All other callbacks go
+// to the vIBC module unchanged.
+//
+// The data is read from the state in which each packet is received, so that
+// every node acknowledges it alike, and the acknowledgement commitment proves
+// it to the requesting chain.
+type VstorageAttestationModule struct {
+	porttypes.IBCModule
+	vibcKeeper     keeper.Keeper
+	vstorageKeeper types.VstorageKeeper
+}
+
+// NewVstorageAttestationModule returns a VstorageAttestationModule wrapping
+// vibcModule.
+func NewVstorageAttestationModule(vibcModule porttypes.IBCModule, vibcKeeper keeper.Keeper, vstorageKeeper types.VstorageKeeper) VstorageAttestationModule {
+	return VstorageAttestationModule{
+		IBCModule:      vibcModule,
+		vibcKeeper:     vibcKeeper,
+		vstorageKeeper: vstorageKeeper,
+	}
+}
+
+// checkAttestationChannel returns an error unless a channel of the attestation
+// port has the given order and version.
+func checkAttestationChannel(order channeltypes.Order, version string) error {
+	if order != channeltypes.UNORDERED {
+		return sdkioerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "port %s accepts only unordered channels", types.VstorageAttestationPortID)
+	}
+	if version != types.VstorageAttestationVersion {
+		return sdkioerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "port %s accepts only version %q", types.VstorageAttestationPortID, types.VstorageAttestationVersion)
+	}
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im VstorageAttestationModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capability.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if portID != types.VstorageAttestationPortID {
+		return im.IBCModule.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+	}
+	if version == "" {
+		version = types.VstorageAttestationVersion
+	}
+	if err := checkAttestationChannel(order, version); err != nil {
+		return "", err
+	}
+	if err := im.vibcKeeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im VstorageAttestationModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capability.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if portID != types.VstorageAttestationPortID {
+		return im.IBCModule.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+	}
+	if err := checkAttestationChannel(order, counterpartyVersion); err != nil {
+		return "", err
+	}
+	if err := im.vibcKeeper.ClaimCapability(ctx, channelCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", sdkioerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, err.Error())
+	}
+	return counterpartyVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im VstorageAttestationModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if portID != types.VstorageAttestationPortID {
+		return im.IBCModule.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	}
+	return checkAttestationChannel(channeltypes.UNORDERED, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im VstorageAttestationModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	if portID != types.VstorageAttestationPortID {
+		return im.IBCModule.OnChanOpenConfirm(ctx, portID, channelID)
+	}
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.  Attestation channels
+// stay open.
+func (im VstorageAttestationModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	if portID != types.VstorageAttestationPortID {
+		return im.IBCModule.OnChanCloseInit(ctx, portID, channelID)
+	}
+	return fmt.Errorf("channels of port %s cannot be closed", portID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im VstorageAttestationModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	if portID != types.VstorageAttestationPortID {
+		return im.IBCModule.OnChanCloseConfirm(ctx, portID, channelID)
+	}
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface, acknowledging each
+// attestation request with the attestation or the error preventing it.
+func (im VstorageAttestationModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	if packet.GetDestPort() != types.VstorageAttestationPortID {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	result, err := im.attest(ctx, packet.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(result)
+}
+
+// attest returns the acknowledgement result of an attestation request.
+func (im VstorageAttestationModule) attest(ctx sdk.Context, data []byte) ([]byte, error) {
+	request, err := types.DecodeVstorageAttestationRequest(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := vstoragetypes.ValidatePath(request.Path); err != nil {
+		return nil, err
+	}
+	entry := im.vstorageKeeper.GetEntry(ctx, request.Path)
+	return types.EncodeVstorageAttestation(types.VstorageAttestation{
+		Path:   request.Path,
+		Value:  entry.StringValue(),
+		Height: ctx.BlockHeight(),
+	})
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.  The
+// attestation port sends no packets.
+func (im VstorageAttestationModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if packet.GetSourcePort() != types.VstorageAttestationPortID {
+		return im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+	return fmt.Errorf("port %s sends no packets", types.VstorageAttestationPortID)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im VstorageAttestationModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if packet.GetSourcePort() != types.VstorageAttestationPortID {
+		return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	}
+	return fmt.Errorf("port %s sends no packets", types.VstorageAttestationPortID)
+}
@@ -0,0 +1,136 @@
+package vibc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	vstoragekeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	vstoragetypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// recordingModule stands in for the vIBC module, recording the packets it
+// receives.
+type recordingModule struct {
+	porttypes.IBCModule
+	received *[]channeltypes.Packet
+}
+
+func (m recordingModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	*m.received = append(*m.received, packet)
+	return channeltypes.NewResultAcknowledgement([]byte("vibc"))
+}
+
+func makeTestAttestationModule(t *testing.T) (VstorageAttestationModule, vstoragekeeper.Keeper, sdk.Context, *[]channeltypes.Packet) {
+	vstorageStoreKey := storetypes.NewKVStoreKey(vstoragetypes.StoreKey)
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(),
+		paramsStoreKey, paramsTStoreKey, vstoragetypes.ModuleName)
+	vstorageKeeper := vstoragekeeper.NewKeeper(vstorageStoreKey, paramSpace)
+	received := []channeltypes.Packet{}
+	im := NewVstorageAttestationModule(recordingModule{received: &received}, Keeper{}, vstorageKeeper)
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 7}, false, log.NewNopLogger())
+	return im, vstorageKeeper, ctx, &received
+}
+
+func TestVstorageAttestationRecvPacket(t *testing.T) {
+	im, vstorageKeeper, ctx, received := makeTestAttestationModule(t)
+	mkPacket := func(data string, destPort string) channeltypes.Packet {
+		return channeltypes.NewPacket([]byte(data), 1, "remote", "channel-0", destPort, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	// The data written earlier in the block, though not yet committed, is
+	// attested.
+	vstorageKeeper.SetStorage(ctx, agoric.NewKVEntry("published.feed", "price"))
+	for _, tt := range []struct {
+		path  string
+		value string
+	}{
+		{"published.feed", "price"},
+		{"published.none", ""},
+	} {
+		ack := im.OnRecvPacket(ctx, mkPacket(`{"path":"`+tt.path+`"}`, types.VstorageAttestationPortID), nil)
+		if !ack.Success() {
+			t.Fatalf("got failed ack %s for %s", ack.Acknowledgement(), tt.path)
+		}
+		result, ok := ack.(channeltypes.Acknowledgement)
+		if !ok {
+			t.Fatalf("got ack of type %T", ack)
+		}
+		var attestation types.VstorageAttestation
+		if err := json.Unmarshal(result.GetResult(), &attestation); err != nil {
+			t.Fatal(err)
+		}
+		want := types.VstorageAttestation{Path: tt.path, Value: tt.value, Height: 7}
+		if attestation != want {
+			t.Errorf("got attestation %+v, want %+v", attestation, want)
+		}
+	}
+
+	for _, data := range []string{`not JSON`, `{"path":"bad..path"}`} {
+		if ack := im.OnRecvPacket(ctx, mkPacket(data, types.VstorageAttestationPortID), nil); ack.Success() {
+			t.Errorf("got successful ack %s for %s", ack.Acknowledgement(), data)
+		}
+	}
+	if len(*received) != 0 {
+		t.Errorf("attestation packets reached vIBC: %v", *received)
+	}
+
+	// Packets to other ports go to vIBC.
+	if ack := im.OnRecvPacket(ctx, mkPacket(`{"path":"published.feed"}`, "custom"), nil); string(ack.Acknowledgement()) != string(channeltypes.NewResultAcknowledgement([]byte("vibc")).Acknowledgement()) {
+		t.Errorf("got ack %s for another port", ack.Acknowledgement())
+	}
+	if len(*received) != 1 {
+		t.Errorf("got %d packets received by vIBC, want 1", len(*received))
+	}
+}
+
+func TestVstorageAttestationChannels(t *testing.T) {
+	im, _, ctx, _ := makeTestAttestationModule(t)
+	port := types.VstorageAttestationPortID
+	counterparty := channeltypes.NewCounterparty("remote", "channel-0")
+
+	if _, err := im.OnChanOpenTry(ctx, channeltypes.ORDERED, nil, port, "channel-1", nil, counterparty, types.VstorageAttestationVersion); err == nil {
+		t.Errorf("opened an ordered channel")
+	}
+	if _, err := im.OnChanOpenTry(ctx, channeltypes.UNORDERED, nil, port, "channel-1", nil, counterparty, "other-1"); err == nil {
+		t.Errorf("opened a channel of another version")
+	}
+	if err := im.OnChanOpenAck(ctx, port, "channel-1", "channel-0", "other-1"); err == nil {
+		t.Errorf("acknowledged a channel of another version")
+	}
+	if err := im.OnChanCloseInit(ctx, port, "channel-1"); err == nil {
+		t.Errorf("closed an attestation channel")
+	}
+	packet := channeltypes.NewPacket(nil, 1, port, "channel-1", "remote", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	if err := im.OnAcknowledgementPacket(ctx, packet, nil, nil); err == nil {
+		t.Errorf("accepted an acknowledgement of a packet the port never sends")
+	}
+	if err := im.OnTimeoutPacket(ctx, packet, nil); err == nil {
+		t.Errorf("accepted a timeout of a packet the port never sends")
+	}
+}
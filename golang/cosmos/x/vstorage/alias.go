@@ -15,9 +15,12 @@ var (
 	NewQuerier  = keeper.NewQuerier
 	NewStorage  = types.NewData
 	NewChildren = types.NewChildren
+
+	NewStreamCellHistory = keeper.NewStreamCellHistory
 )
 
 type (
-	Keeper = keeper.Keeper
-	Data   = types.Data
+	Keeper            = keeper.Keeper
+	Data              = types.Data
+	StreamCellHistory = keeper.StreamCellHistory
 )
@@ -1,13 +1,30 @@
 package cli
 
 import (
+	"encoding/json"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagPageSize       = "page-size"
+	FlagPattern        = "pattern"
+	FlagMinBlockHeight = "min-block-height"
+	FlagMaxBlockHeight = "max-block-height"
+
+	defaultExportPageSize = 1000
+)
+
 func GetQueryCmd(storeKey string) *cobra.Command {
 	swingsetQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -18,8 +35,12 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 	}
 	swingsetQueryCmd.AddCommand(
 		GetCmdGetData(storeKey),
+		GetCmdGetDataWithProof(storeKey),
+		GetCmdGetDataMulti(storeKey),
 		GetCmdGetChildren(storeKey),
 		GetCmdGetPath(storeKey),
+		GetCmdExport(storeKey),
+		GetCmdHistory(storeKey),
 	)
 
 	return swingsetQueryCmd
@@ -30,7 +51,10 @@ func GetCmdGetData(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "data <path>",
 		Short: "get data for vstorage path",
-		Args:  cobra.ExactArgs(1),
+		Long: `get data for vstorage path.
+With --height, the data is read as of that block height rather than the latest,
+provided the queried node has not pruned that version of its state.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -55,6 +79,76 @@ func GetCmdGetData(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetDataWithProof queries vstorage data with a proof against the app
+// hash
+func GetCmdGetDataWithProof(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-with-proof <path>",
+		Short: "get data for vstorage path with a proof",
+		Long: `get data for vstorage path with an ICS-23 proof against the app hash of the
+state at the reported height, which is committed in the header of the next
+block.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DataWithProof(cmd.Context(), &types.QueryDataWithProofRequest{
+				Path: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetDataMulti queries data for multiple vstorage paths
+func GetCmdGetDataMulti(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-multi [path...]",
+		Short: "get data for multiple vstorage paths",
+		Long: `get data for multiple vstorage paths.
+Paths may be listed explicitly, or selected with --pattern, in which any
+dot-separated segment may be "*" to match every child at that position, e.g.
+--pattern 'published.vaultFactory.managers.*.quotes'.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pattern, err := cmd.Flags().GetString(FlagPattern)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DataMulti(cmd.Context(), &types.QueryDataMultiRequest{
+				Paths:   args,
+				Pattern: pattern,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagPattern, "", "path pattern to match instead of explicit paths")
+	return cmd
+}
+
 // GetCmdGetChildren queries vstorage children
 func GetCmdGetChildren(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -78,8 +172,14 @@ Path segments are dot-separated, so a child "baz" under path "foo.bar" has path
 				path = args[0]
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Children(cmd.Context(), &types.QueryChildrenRequest{
-				Path: path,
+				Path:       path,
+				Pagination: pageReq,
 			})
 			if err != nil {
 				return err
@@ -90,6 +190,7 @@ Path segments are dot-separated, so a child "baz" under path "foo.bar" has path
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "children")
 	return cmd
 }
 
@@ -133,3 +234,126 @@ func GetCmdGetPath(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// exportLine is a JSON line of GetCmdExport output. Unlike DataEntry, it
+// always includes the value, even when empty.
+type exportLine struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// GetCmdExport streams vstorage entries under a path prefix as JSON lines
+func GetCmdExport(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [path-prefix]",
+		Short: "stream vstorage entries at and beneath a path as JSON lines",
+		Long: `stream vstorage entries at and beneath a path as JSON lines.
+When absent, path-prefix defaults to the empty root path, exporting everything.
+Each line is a JSON object {"path": "...", "value": "..."}.
+All pages are read at the same block height, which is the latest height unless
+--height is specified.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			pageSize, err := cmd.Flags().GetUint64(FlagPageSize)
+			if err != nil {
+				return err
+			}
+
+			out := json.NewEncoder(cmd.OutOrStdout())
+			var nextKey []byte
+			for {
+				queryClient := types.NewQueryClient(clientCtx)
+				var header metadata.MD
+				res, err := queryClient.Export(cmd.Context(), &types.QueryExportRequest{
+					Path:       path,
+					Pagination: &query.PageRequest{Key: nextKey, Limit: pageSize},
+				}, grpc.Header(&header))
+				if err != nil {
+					return err
+				}
+
+				// Pin subsequent pages to the height of the first.
+				if clientCtx.Height == 0 {
+					if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+						height, err := strconv.ParseInt(heights[0], 10, 64)
+						if err != nil {
+							return err
+						}
+						clientCtx = clientCtx.WithHeight(height)
+					}
+				}
+
+				for _, entry := range res.Entries {
+					line := exportLine{Path: entry.Path, Value: entry.Value}
+					if err := out.Encode(line); err != nil {
+						return err
+					}
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					return nil
+				}
+				nextKey = res.Pagination.NextKey
+			}
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagPageSize, defaultExportPageSize, "number of entries to request per query")
+	return cmd
+}
+
+// GetCmdHistory queries the past stream cells of a vstorage path
+func GetCmdHistory(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <path>",
+		Short: "get past stream cells of a vstorage path",
+		Long: `get past stream cells of a vstorage path, by block height.
+Only nodes configured with a [vstorage] stream_cell_history_depth retain them,
+and each node retains only the cells of blocks it executed since then.
+At most 1000 cells are returned; to read more, repeat the query with a
+--min-block-height just above that of the last cell.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			minBlockHeight, err := cmd.Flags().GetInt64(FlagMinBlockHeight)
+			if err != nil {
+				return err
+			}
+			maxBlockHeight, err := cmd.Flags().GetInt64(FlagMaxBlockHeight)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.History(cmd.Context(), &types.QueryHistoryRequest{
+				Path:           args[0],
+				MinBlockHeight: minBlockHeight,
+				MaxBlockHeight: maxBlockHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagMinBlockHeight, 0, "lowest block height of cells to return")
+	cmd.Flags().Int64(FlagMaxBlockHeight, 0, "highest block height of cells to return, or 0 for no bound")
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// GetTxCmd returns the vstorage transaction commands, which are only
+// available in devmode builds.
+func GetTxCmd() *cobra.Command {
+	vstorageTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "vstorage transaction subcommands (for development only)",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vstorageTxCmd.AddCommand(
+		GetCmdSetStorage(),
+	)
+
+	return vstorageTxCmd
+}
+
+// GetCmdSetStorage is the CLI command for sending a MsgSetStorage transaction.
+func GetCmdSetStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <path> <value>",
+		Short: "set the data of a vstorage path",
+		Long: `Set the data of a vstorage path directly, without going through SwingSet,
+such as to seed published state for integration tests.  An empty value deletes
+the data.`,
+		Example: fmt.Sprintf(`$ %s tx vstorage set published.foo '{"blockHeight":"1","values":["bar"]}' --from dev`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetStorage(clientCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
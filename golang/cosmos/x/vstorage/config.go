@@ -0,0 +1,90 @@
+package vstorage
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	ConfigPrefix    = "vstorage"
+	FlagMaxQueryGas = ConfigPrefix + ".max_query_gas"
+
+	// StreamCellHistoryDBName is the name of the node-local database in the
+	// data directory that retains past stream cells.
+	StreamCellHistoryDBName = "vstorage_history"
+)
+
+// DefaultMaxQueryGas is the max_query_gas used when none is configured, which
+// allows reading a few megabytes of storage per query.
+const DefaultMaxQueryGas = 10_000_000
+
+// DefaultConfigTemplate defines a default TOML configuration section for
+// vstorage. Values are pulled from a "Vstorage" property, in accord with
+// CustomAppConfig from ../../daemon/cmd/root.go.
+const DefaultConfigTemplate = `
+###############################################################################
+###                         Vstorage Configuration                          ###
+###############################################################################
+
+[vstorage]
+# The gas that a single vstorage gRPC query (e.g., Data or Children) may
+# consume reading storage before it fails with a resource-exhausted error,
+# which protects the node from expensive queries. Zero disables the limit.
+max_query_gas = {{ .Vstorage.MaxQueryGas }}
+
+# The number of past stream cells (the values appended to a path in a block)
+# to retain for each path in a node-local database, for the History query.
+# Such archival history is not part of consensus state, and covers only blocks
+# executed since it was enabled. Zero retains none.
+stream_cell_history_depth = {{ .Vstorage.StreamCellHistoryDepth }}
+`
+
+// VstorageConfig defines node-local configuration for vstorage.
+// "mapstructure" tag data is used to direct reads from app.toml.
+type VstorageConfig struct {
+	// MaxQueryGas is the gas that a single gRPC query may consume, or zero for
+	// no limit.
+	MaxQueryGas uint64 `mapstructure:"max_query_gas"`
+
+	// StreamCellHistoryDepth is the number of past stream cells to retain for
+	// each path, or zero for none.
+	StreamCellHistoryDepth uint64 `mapstructure:"stream_cell_history_depth"`
+}
+
+var DefaultVstorageConfig = VstorageConfig{
+	MaxQueryGas: DefaultMaxQueryGas,
+}
+
+// VstorageConfigFromViper returns the vstorage configuration of
+// resolvedConfig, or nil for an apparently empty configuration.
+func VstorageConfigFromViper(resolvedConfig servertypes.AppOptions) (*VstorageConfig, error) {
+	v, ok := resolvedConfig.(*viper.Viper)
+	if !ok {
+		// Tolerate an apparently empty configuration such as
+		// cosmos/cosmos-sdk/simapp EmptyAppOptions, but otherwise require viper.
+		if resolvedConfig.Get(flags.FlagHome) != nil {
+			return nil, fmt.Errorf("expected an instance of viper!")
+		}
+	}
+	if v == nil {
+		return nil, nil
+	}
+	// Configurations written before the [vstorage] section existed get the
+	// default limit.
+	v.SetDefault(FlagMaxQueryGas, DefaultVstorageConfig.MaxQueryGas)
+	// See CustomAppConfig in ../../daemon/cmd/root.go.
+	type ExtendedConfig struct {
+		serverconfig.Config `mapstructure:",squash"`
+		Vstorage            VstorageConfig `mapstructure:"vstorage"`
+	}
+	extendedConfig := ExtendedConfig{}
+	if err := v.Unmarshal(&extendedConfig); err != nil {
+		return nil, err
+	}
+	return &extendedConfig.Vstorage, nil
+}
@@ -0,0 +1,30 @@
+//go:build devmode
+
+package vstorage
+
+import (
+	"github.com/spf13/cobra"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/client/cli"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// DevMode is true when agd is built with the devmode build tag, which enables
+// MsgSetStorage.
+const DevMode = true
+
+func registerDevModeInterfaces(registry cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+func registerDevModeServices(cfg module.Configurator, k Keeper) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(k))
+}
+
+func devModeTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
@@ -0,0 +1,22 @@
+//go:build !devmode
+
+package vstorage
+
+import (
+	"github.com/spf13/cobra"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// DevMode is true when agd is built with the devmode build tag, which enables
+// MsgSetStorage.
+const DevMode = false
+
+func registerDevModeInterfaces(registry cdctypes.InterfaceRegistry) {}
+
+func registerDevModeServices(cfg module.Configurator, k Keeper) {}
+
+func devModeTxCmd() *cobra.Command {
+	return nil
+}
@@ -1,16 +1,23 @@
 package vstorage
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/jsonpb"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func NewGenesisState() *types.GenesisState {
 	return &types.GenesisState{
-		Data: []*types.DataEntry{},
+		Data:   []*types.DataEntry{},
+		Params: types.DefaultParams(),
 	}
 }
 
@@ -18,21 +25,33 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if data == nil {
 		return nil
 	}
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
 	for _, entry := range data.Data {
-		if err := types.ValidatePath(entry.Path); err != nil {
-			return fmt.Errorf("genesis vstorage.data entry %q has invalid path format: %s", entry.Path, err)
+		if err := validateGenesisEntry(entry); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func validateGenesisEntry(entry *types.DataEntry) error {
+	if err := types.ValidatePath(entry.Path); err != nil {
+		return fmt.Errorf("genesis vstorage.data entry %q has invalid path format: %s", entry.Path, err)
+	}
+	return nil
+}
+
 func DefaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{
-		Data: []*types.DataEntry{},
+		Data:   []*types.DataEntry{},
+		Params: types.DefaultParams(),
 	}
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
 	keeper.ImportStorage(ctx, data.Data)
 	return []abci.ValidatorUpdate{}
 }
@@ -40,5 +59,145 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abc
 func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 	gs := NewGenesisState()
 	gs.Data = keeper.ExportStorage(ctx)
+	gs.Params = keeper.GetParams(ctx)
 	return gs
 }
+
+// genesisEntryJSON is the JSON encoding of a DataEntry within a GenesisState,
+// matching that of the proto JSON codec (which emits empty values).
+type genesisEntryJSON struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// WriteGenesis writes the JSON encoding of the GenesisState that ExportGenesis
+// would return, one entry at a time, so that the export is never held in
+// memory as a GenesisState.
+func WriteGenesis(ctx sdk.Context, keeper Keeper, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(`{"data":[`); err != nil {
+		return err
+	}
+	first := true
+	err := keeper.WalkStorageFromPrefix(ctx, "", func(entry *types.DataEntry) error {
+		bz, err := json.Marshal(genesisEntryJSON{Path: entry.Path, Value: entry.Value})
+		if err != nil {
+			return err
+		}
+		if !first {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = bw.Write(bz)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	params := keeper.GetParams(ctx)
+	paramsJSON, err := codec.ProtoMarshalJSON(&params, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := bw.WriteString(`],"params":`); err != nil {
+		return err
+	}
+	if _, err := bw.Write(paramsJSON); err != nil {
+		return err
+	}
+	if err := bw.WriteByte('}'); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// ReadGenesis decodes the JSON encoding of a GenesisState, calling onParams
+// with its parameters if it has them, and cb with each entry as it is read
+// rather than collecting them.
+func ReadGenesis(r io.Reader, onParams func(params types.Params) error, cb func(entry *types.DataEntry) error) error {
+	dec := json.NewDecoder(r)
+	expectDelim := func(want json.Delim) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if tok != want {
+			return fmt.Errorf("vstorage genesis: expected %q, got %v", want, tok)
+		}
+		return nil
+	}
+
+	if err := expectDelim('{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		if key == "params" {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+			params := types.DefaultParams()
+			if err := (&jsonpb.Unmarshaler{}).Unmarshal(bytes.NewReader(raw), &params); err != nil {
+				return fmt.Errorf("vstorage genesis: invalid params: %w", err)
+			}
+			if err := onParams(params); err != nil {
+				return err
+			}
+			continue
+		}
+		if key != "data" {
+			return fmt.Errorf("vstorage genesis: unknown field %v", tok)
+		}
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if tok == nil {
+			// "data": null
+			continue
+		}
+		if tok != json.Delim('[') {
+			return fmt.Errorf("vstorage genesis: expected data array, got %v", tok)
+		}
+		for dec.More() {
+			var entry genesisEntryJSON
+			if err := dec.Decode(&entry); err != nil {
+				return err
+			}
+			if err := cb(&types.DataEntry{Path: entry.Path, Value: entry.Value}); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim('}')
+}
+
+// ValidateGenesisStream validates the JSON encoding of a GenesisState without
+// collecting its entries.
+func ValidateGenesisStream(r io.Reader) error {
+	return ReadGenesis(r, types.Params.ValidateBasic, validateGenesisEntry)
+}
+
+// ImportGenesis imports the JSON encoding of a GenesisState one entry at a
+// time.
+func ImportGenesis(ctx sdk.Context, keeper Keeper, r io.Reader) error {
+	keeper.SetParams(ctx, types.DefaultParams())
+	onParams := func(params types.Params) error {
+		keeper.SetParams(ctx, params)
+		return nil
+	}
+	return ReadGenesis(r, onParams, func(entry *types.DataEntry) error {
+		keeper.ImportStorageEntry(ctx, entry)
+		return nil
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -21,16 +22,40 @@ type Querier struct {
 
 var _ types.QueryServer = Querier{}
 
+// queryContext returns the sdk.Context of a gRPC query, metering its storage
+// reads against the keeper's query gas limit if it has one.
+func (k Querier) queryContext(c context.Context) sdk.Context {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.maxQueryGas == 0 {
+		return ctx
+	}
+	return ctx.WithGasMeter(sdk.NewGasMeter(k.maxQueryGas))
+}
+
+// recoverQueryOutOfGas must be deferred by queries using queryContext, and
+// converts running out of query gas into a ResourceExhausted error.
+func (k Querier) recoverQueryOutOfGas(err *error) {
+	if r := recover(); r != nil {
+		oog, ok := r.(sdk.ErrorOutOfGas)
+		if !ok {
+			panic(r)
+		}
+		*err = status.Errorf(codes.ResourceExhausted,
+			"query exceeded max_query_gas %d reading %s", k.maxQueryGas, oog.Descriptor)
+	}
+}
+
 // ===================================================================
 // /agoric.vstorage.Query/Data
 // ===================================================================
 
 // /agoric.vstorage.Query/Data returns data for a specified path.
-func (k Querier) Data(c context.Context, req *types.QueryDataRequest) (*types.QueryDataResponse, error) {
+func (k Querier) Data(c context.Context, req *types.QueryDataRequest) (res *types.QueryDataResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
+	defer k.recoverQueryOutOfGas(&err)
 
 	entry := k.GetEntry(ctx, req.Path)
 
@@ -39,6 +64,79 @@ func (k Querier) Data(c context.Context, req *types.QueryDataRequest) (*types.Qu
 	}, nil
 }
 
+// ===================================================================
+// /agoric.vstorage.Query/DataWithProof
+// ===================================================================
+
+// /agoric.vstorage.Query/DataWithProof returns data for a specified path with
+// a proof of the store entry of the path against the app hash. The proof
+// package verifies it.
+func (k Querier) DataWithProof(c context.Context, req *types.QueryDataWithProofRequest) (*types.QueryDataWithProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entry, proof, height, err := k.ProveEntry(ctx, req.Path, ctx.BlockHeight())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &types.QueryDataWithProofResponse{
+		Value:  entry.StringValue(),
+		Proof:  proof,
+		Height: height,
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/DataMulti
+// ===================================================================
+
+// maxDataMultiEntries bounds the work of a single DataMulti request.
+const maxDataMultiEntries = 1000
+
+// /agoric.vstorage.Query/DataMulti returns the data of multiple paths, either
+// listed explicitly or matching a pattern.
+func (k Querier) DataMulti(c context.Context, req *types.QueryDataMultiRequest) (res *types.QueryDataMultiResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if (len(req.Paths) > 0) == (req.Pattern != "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of paths and pattern is required")
+	}
+	ctx := k.queryContext(c)
+	defer k.recoverQueryOutOfGas(&err)
+
+	entries := []*types.DataEntry{}
+	if req.Pattern == "" {
+		if len(req.Paths) > maxDataMultiEntries {
+			return nil, status.Errorf(codes.InvalidArgument, "too many paths; limit is %d", maxDataMultiEntries)
+		}
+		for _, path := range req.Paths {
+			if err := types.ValidatePath(path); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			entry := k.GetEntry(ctx, path)
+			entries = append(entries, &types.DataEntry{Path: path, Value: entry.StringValue()})
+		}
+	} else {
+		matches, err := k.GetEntriesMatching(ctx, req.Pattern, maxDataMultiEntries)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		for _, entry := range matches {
+			entries = append(entries, &types.DataEntry{Path: entry.Key(), Value: entry.StringValue()})
+		}
+	}
+
+	return &types.QueryDataMultiResponse{
+		Entries: entries,
+	}, nil
+}
+
 // ===================================================================
 // /agoric.vstorage.Query/CapData
 // ===================================================================
@@ -166,11 +264,12 @@ func capdataRemotableToObject(r *capdata.CapdataRemotable) interface{} {
 // /agoric.vstorage.Query/CapData returns data for a specified path,
 // interpreted as CapData in a StreamCell (auto-promoting isolated CapData
 // into a single-item StreamCell) and transformed as specified.
-func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (*types.QueryCapDataResponse, error) {
+func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (res *types.QueryCapDataResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
+	defer k.recoverQueryOutOfGas(&err)
 
 	valueTransformations := capdata.CapdataValueTransformations{
 		Bigint: capdataBigintToDigits,
@@ -250,15 +349,136 @@ func (k Querier) CapData(c context.Context, req *types.QueryCapDataRequest) (*ty
 // that exist immediately underneath a specified path, including
 // those corresponding with "empty non-terminals" having children
 // but no data of their own.
-func (k Querier) Children(c context.Context, req *types.QueryChildrenRequest) (*types.QueryChildrenResponse, error) {
+// For backwards compatibility, a request without pagination returns every
+// child in a single response.
+func (k Querier) Children(c context.Context, req *types.QueryChildrenRequest) (res *types.QueryChildrenResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	ctx := sdk.UnwrapSDKContext(c)
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := k.queryContext(c)
+	defer k.recoverQueryOutOfGas(&err)
 
-	children := k.GetChildren(ctx, req.Path)
+	if req.Pagination == nil {
+		children := k.GetChildren(ctx, req.Path)
+		return &types.QueryChildrenResponse{
+			Children: children.Children,
+		}, nil
+	}
+
+	children, pageRes, err := k.GetChildrenPage(ctx, req.Path, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	return &types.QueryChildrenResponse{
-		Children: children.Children,
+		Children:   children.Children,
+		Pagination: pageRes,
 	}, nil
 }
+
+// ===================================================================
+// /agoric.vstorage.Query/Export
+// ===================================================================
+
+// /agoric.vstorage.Query/Export returns the data entries at and beneath a
+// specified path, in pages so that large subtrees can be retrieved
+// incrementally.
+func (k Querier) Export(c context.Context, req *types.QueryExportRequest) (res *types.QueryExportResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := k.queryContext(c)
+	defer k.recoverQueryOutOfGas(&err)
+
+	entries, pageRes, err := k.ExportStoragePage(ctx, req.Path, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExportResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/History
+// ===================================================================
+
+// /agoric.vstorage.Query/History returns the stream cells of a specified path
+// that this node retained from past blocks.
+func (k Querier) History(c context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.MinBlockHeight < 0 || req.MaxBlockHeight < 0 ||
+		(req.MaxBlockHeight != 0 && req.MaxBlockHeight < req.MinBlockHeight) {
+		return nil, status.Error(codes.InvalidArgument, "invalid block height range")
+	}
+	if k.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "this node does not retain stream cell history")
+	}
+
+	cells, err := k.history.Cells(req.Path, req.MinBlockHeight, req.MaxBlockHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryHistoryResponse{Cells: []*types.HistoricalCell{}}
+	for _, cell := range cells {
+		res.Cells = append(res.Cells, &types.HistoricalCell{
+			BlockHeight: strconv.FormatInt(cell.BlockHeight, 10),
+			Value:       cell.Value,
+		})
+	}
+	return res, nil
+}
+
+// ===================================================================
+// /agoric.vstorage.Query/WatchData
+// ===================================================================
+
+// /agoric.vstorage.Query/WatchData streams the data of a specified path as of
+// the end of each block in which it changes, until the client disconnects.
+// Streams are served outside of the SDK query router, so there is no
+// sdk.Context and the current value is not sent.
+func (k Querier) WatchData(req *types.QueryWatchDataRequest, stream types.Query_WatchDataServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePath(req.Path); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	changes, stop, err := k.WatchPath(req.Path)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	defer stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.Aborted, "watcher fell behind; resubscribe and read the current data")
+			}
+			err := stream.Send(&types.QueryWatchDataResponse{
+				BlockHeight: strconv.FormatInt(change.BlockHeight, 10),
+				Value:       change.Value,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
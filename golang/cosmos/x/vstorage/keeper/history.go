@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	db "github.com/tendermint/tm-db"
+)
+
+// maxHistoryCells bounds the cells returned by a single History query.
+const maxHistoryCells = 1000
+
+// HistoricalCell is the stream cell of a path as of the end of a block.
+type HistoricalCell struct {
+	BlockHeight int64
+	Value       string
+}
+
+// StreamCellHistory retains the most recent stream cells of each vstorage
+// path across blocks, which vstorage itself replaces at the first append of
+// each block. The cells are kept in a node-local database rather than in
+// consensus state, so nodes may differ in which cells they retain.
+type StreamCellHistory struct {
+	db    db.DB
+	depth int
+}
+
+// NewStreamCellHistory returns a history in historyDB that retains the depth
+// most recent cells of each path.
+func NewStreamCellHistory(historyDB db.DB, depth uint64) *StreamCellHistory {
+	return &StreamCellHistory{db: historyDB, depth: int(depth)}
+}
+
+// historyKeyPrefix returns the prefix of the keys of the cells of path, which
+// cannot contain the NUL separator.
+func historyKeyPrefix(path string) []byte {
+	return append([]byte(path), 0)
+}
+
+// historyKey returns the key of the cell of path as of blockHeight, which
+// orders cells by block height.
+func historyKey(path string, blockHeight int64) []byte {
+	return binary.BigEndian.AppendUint64(historyKeyPrefix(path), uint64(blockHeight))
+}
+
+// Record retains cell as the stream cell of path as of blockHeight, and prunes
+// all but the most recent cells of path.
+func (h *StreamCellHistory) Record(path string, blockHeight int64, cell string) error {
+	if err := h.db.Set(historyKey(path, blockHeight), []byte(cell)); err != nil {
+		return err
+	}
+
+	prefix := historyKeyPrefix(path)
+	iterator, err := h.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	var pruned [][]byte
+	for kept := 0; iterator.Valid(); iterator.Next() {
+		if kept < h.depth {
+			kept++
+			continue
+		}
+		pruned = append(pruned, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	batch := h.db.NewBatch()
+	defer batch.Close()
+	for _, key := range pruned {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// Cells returns the retained cells of path with block heights from
+// minBlockHeight through maxBlockHeight (or without an upper bound if it is
+// zero), in ascending order of block height and at most maxHistoryCells of
+// them.
+func (h *StreamCellHistory) Cells(path string, minBlockHeight, maxBlockHeight int64) ([]HistoricalCell, error) {
+	prefix := historyKeyPrefix(path)
+	end := sdk.PrefixEndBytes(prefix)
+	if maxBlockHeight != 0 {
+		end = historyKey(path, maxBlockHeight+1)
+	}
+	iterator, err := h.db.Iterator(historyKey(path, minBlockHeight), end)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	cells := []HistoricalCell{}
+	for ; iterator.Valid() && len(cells) < maxHistoryCells; iterator.Next() {
+		height := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+		cells = append(cells, HistoricalCell{
+			BlockHeight: int64(height),
+			Value:       string(iterator.Value()),
+		})
+	}
+	return cells, iterator.Error()
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,9 +12,16 @@ import (
 	"strconv"
 	"strings"
 
+	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 	db "github.com/tendermint/tm-db"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -34,10 +43,16 @@ type ProposedChange struct {
 	ValueFromLastBlock string
 	NewValue           string
 	LegacyEvents       bool
+	// Deleted is true if the path was last written with no value.
+	Deleted bool
+	// Appended is true if the path was last written by appending to its
+	// stream cell.
+	Appended bool
 }
 
 type ChangeManager interface {
 	Track(ctx sdk.Context, k Keeper, entry agoric.KVEntry, isLegacy bool)
+	TrackAppend(ctx sdk.Context, k Keeper, entry agoric.KVEntry)
 	EmitEvents(ctx sdk.Context, k Keeper)
 	Rollback(ctx sdk.Context)
 }
@@ -56,16 +71,36 @@ var MaxSDKInt = sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewIn
 // for the various parts of the state machine
 type Keeper struct {
 	changeManager ChangeManager
+	notifier      *ChangeNotifier
 	storeKey      storetypes.StoreKey
+	paramSpace    paramtypes.Subspace
+	// maxQueryGas limits the gas of each gRPC query, or is zero for no limit.
+	maxQueryGas uint64
+	// history retains past stream cells, or is nil if they are not retained.
+	history *StreamCellHistory
+	// proofQuerier answers the store queries proving vstorage data, or is nil
+	// if proofs are not served.
+	proofQuerier storetypes.Queryable
 }
 
 func (bcm *BatchingChangeManager) Track(ctx sdk.Context, k Keeper, entry agoric.KVEntry, isLegacy bool) {
+	bcm.track(ctx, k, entry, isLegacy, false)
+}
+
+// TrackAppend tracks a change which appends to the stream cell of a path.
+func (bcm *BatchingChangeManager) TrackAppend(ctx sdk.Context, k Keeper, entry agoric.KVEntry) {
+	bcm.track(ctx, k, entry, false, true)
+}
+
+func (bcm *BatchingChangeManager) track(ctx sdk.Context, k Keeper, entry agoric.KVEntry, isLegacy, isAppend bool) {
 	path := entry.Key()
 	// TODO: differentiate between deletion and setting empty string?
 	// Using empty string for deletion for backwards compatibility
 	value := entry.StringValue()
 	if change, ok := bcm.changes[path]; ok {
 		change.NewValue = value
+		change.Deleted = !entry.HasValue()
+		change.Appended = isAppend
 		if isLegacy {
 			change.LegacyEvents = true
 		}
@@ -76,6 +111,8 @@ func (bcm *BatchingChangeManager) Track(ctx sdk.Context, k Keeper, entry agoric.
 		NewValue:           value,
 		ValueFromLastBlock: k.GetEntry(ctx, path).StringValue(),
 		LegacyEvents:       isLegacy,
+		Deleted:            !entry.HasValue(),
+		Appended:           isAppend,
 	}
 }
 
@@ -110,13 +147,48 @@ func NewBatchingChangeManager() *BatchingChangeManager {
 	return &bcm
 }
 
-func NewKeeper(storeKey storetypes.StoreKey) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      storeKey,
+		paramSpace:    paramSpace,
 		changeManager: NewBatchingChangeManager(),
+		notifier:      NewChangeNotifier(),
 	}
 }
 
+// WithMaxQueryGas returns a copy of the keeper whose gRPC queries fail with a
+// ResourceExhausted error upon consuming more than maxQueryGas reading
+// storage. Zero disables the limit.
+func (k Keeper) WithMaxQueryGas(maxQueryGas uint64) Keeper {
+	k.maxQueryGas = maxQueryGas
+	return k
+}
+
+// WithStreamCellHistory returns a copy of the keeper which records each
+// changed stream cell in history as of the end of its block.
+func (k Keeper) WithStreamCellHistory(history *StreamCellHistory) Keeper {
+	k.history = history
+	return k
+}
+
+// WithProofQuerier returns a copy of the keeper whose DataWithProof queries
+// obtain their proofs from querier, normally the app's root multistore.
+func (k Keeper) WithProofQuerier(querier storetypes.Queryable) Keeper {
+	k.proofQuerier = querier
+	return k
+}
+
+// WatchPath returns a channel of the changes to a path as of the end of each
+// block, and a function to stop watching it. See ChangeNotifier.Watch.
+func (k Keeper) WatchPath(path string) (<-chan DataChange, func(), error) {
+	return k.notifier.Watch(path)
+}
+
 // ExportStorage fetches all storage
 func (k Keeper) ExportStorage(ctx sdk.Context) []*types.DataEntry {
 	return k.ExportStorageFromPrefix(ctx, "")
@@ -124,11 +196,26 @@ func (k Keeper) ExportStorage(ctx sdk.Context) []*types.DataEntry {
 
 // ExportStorageFromPrefix fetches storage only under the supplied pathPrefix.
 func (k Keeper) ExportStorageFromPrefix(ctx sdk.Context, pathPrefix string) []*types.DataEntry {
+	exported := []*types.DataEntry{}
+	err := k.WalkStorageFromPrefix(ctx, pathPrefix, func(entry *types.DataEntry) error {
+		exported = append(exported, entry)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return exported
+}
+
+// WalkStorageFromPrefix calls cb with each of the entries that
+// ExportStorageFromPrefix would return, in the same order, without collecting
+// them, and stops at the first error cb returns.
+func (k Keeper) WalkStorageFromPrefix(ctx sdk.Context, pathPrefix string, cb func(entry *types.DataEntry) error) error {
 	store := ctx.KVStore(k.storeKey)
 
 	if len(pathPrefix) > 0 {
 		if err := types.ValidatePath(pathPrefix); err != nil {
-			panic(err)
+			return err
 		}
 		pathPrefix = pathPrefix + types.PathSeparator
 	}
@@ -141,8 +228,6 @@ func (k Keeper) ExportStorageFromPrefix(ctx sdk.Context, pathPrefix string) []*t
 	// recursively list all children under the pathPrefix, and export them.
 
 	iterator := sdk.KVStorePrefixIterator(store, nil)
-
-	exported := []*types.DataEntry{}
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		rawValue := iterator.Value()
@@ -158,23 +243,151 @@ func (k Keeper) ExportStorageFromPrefix(ctx sdk.Context, pathPrefix string) []*t
 		}
 		value, hasPrefix := bytes.CutPrefix(rawValue, types.EncodedDataPrefix)
 		if !hasPrefix {
-			panic(fmt.Errorf("value at path %q starts with unexpected prefix", path))
+			return fmt.Errorf("value at path %q starts with unexpected prefix", path)
 		}
 		path = path[len(pathPrefix):]
-		entry := types.DataEntry{Path: path, Value: string(value)}
-		exported = append(exported, &entry)
+		if err := cb(&types.DataEntry{Path: path, Value: string(value)}); err != nil {
+			return err
+		}
 	}
-	return exported
+	return nil
+}
+
+// ExportStoragePage gets one page of the data entries at or beneath path,
+// identified by their full paths, ordered by depth and then by key. Each
+// depth of the subtree has a common key prefix, so only the subtree is
+// iterated, and not beyond the first depth without keys, since every
+// ancestor of an entry has a key of its own.
+func (k Keeper) ExportStoragePage(ctx sdk.Context, path string, pageReq *query.PageRequest) ([]*types.DataEntry, *query.PageResponse, error) {
+	if err := types.ValidatePath(path); err != nil {
+		return nil, nil, err
+	}
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	store := ctx.KVStore(k.storeKey)
+
+	exported := []*types.DataEntry{}
+	pageRes := &query.PageResponse{}
+	var count uint64
+	err := walkSubtreeKeys(store, path, pageReq.Key, func(key, rawValue []byte) (bool, error) {
+		if len(rawValue) == 0 || bytes.Equal(rawValue, types.EncodedNoDataValue) {
+			return true, nil
+		}
+		count++
+		switch {
+		case count <= pageReq.Offset:
+		case uint64(len(exported)) < limit:
+			value, hasPrefix := bytes.CutPrefix(rawValue, types.EncodedDataPrefix)
+			entryPath := types.EncodedKeyToPath(key)
+			if !hasPrefix {
+				return false, fmt.Errorf("value at path %q starts with unexpected prefix", entryPath)
+			}
+			exported = append(exported, &types.DataEntry{Path: entryPath, Value: string(value)})
+		default:
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = append([]byte{}, key...)
+			}
+			// Keep counting only if the total was requested.
+			return pageReq.CountTotal, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = count
+	}
+	return exported, pageRes, nil
+}
+
+// walkSubtreeKeys calls cb with the raw key and value of path and of each of
+// its descendants, ordered by depth and then by key, starting at the key
+// start (if not empty), until cb returns false.
+func walkSubtreeKeys(store sdk.KVStore, path string, start []byte, cb func(key, rawValue []byte) (bool, error)) error {
+	key := types.PathToEncodedKey(path)
+	depth, _ := encodedKeyDepth(key)
+	startDepth := depth
+	if len(start) > 0 {
+		var err error
+		if startDepth, err = encodedKeyDepth(start); err != nil {
+			return err
+		}
+	}
+	if startDepth <= depth && bytes.Compare(key, start) >= 0 {
+		if rawValue := store.Get(key); rawValue != nil {
+			if ok, err := cb(key, rawValue); !ok || err != nil {
+				return err
+			}
+		}
+	}
+
+	// The descendants at each depth share the children prefix of path, with
+	// its depth replaced.
+	childrenPrefix := types.PathToChildrenPrefix(path)
+	_, childrenPath, _ := bytes.Cut(childrenPrefix, types.EncodedKeySeparator)
+	for d := depth + 1; ; d++ {
+		if d < startDepth {
+			continue
+		}
+		prefix := append([]byte(strconv.Itoa(d)), types.EncodedKeySeparator...)
+		prefix = append(prefix, childrenPath...)
+		iterStart := prefix
+		if d == startDepth && bytes.Compare(start, prefix) > 0 {
+			iterStart = start
+		}
+		iterator := store.Iterator(iterStart, storetypes.PrefixEndBytes(prefix))
+		// The depth of start has keys, even if none follow start.
+		found := d == startDepth && len(start) > 0
+		for ; iterator.Valid(); iterator.Next() {
+			found = true
+			if ok, err := cb(iterator.Key(), iterator.Value()); !ok || err != nil {
+				iterator.Close()
+				return err
+			}
+		}
+		iterator.Close()
+		if !found {
+			return nil
+		}
+	}
+}
+
+// encodedKeyDepth returns the depth of the path of an encoded key.
+func encodedKeyDepth(key []byte) (int, error) {
+	digits, _, ok := bytes.Cut(key, types.EncodedKeySeparator)
+	if !ok {
+		return 0, fmt.Errorf("invalid encoded key %q", key)
+	}
+	depth, err := strconv.Atoi(string(digits))
+	if err != nil {
+		return 0, fmt.Errorf("invalid encoded key %q: %w", key, err)
+	}
+	return depth, nil
 }
 
 func (k Keeper) ImportStorage(ctx sdk.Context, entries []*types.DataEntry) {
 	for _, entry := range entries {
-		// This set does the bookkeeping for us in case the entries aren't a
-		// complete tree.
-		k.SetStorage(ctx, agoric.NewKVEntry(entry.Path, entry.Value))
+		k.ImportStorageEntry(ctx, entry)
 	}
 }
 
+// ImportStorageEntry sets a single entry of an export.
+func (k Keeper) ImportStorageEntry(ctx sdk.Context, entry *types.DataEntry) {
+	// This set does the bookkeeping for us in case the entries aren't a
+	// complete tree. The imported quotas already count the usage of the
+	// imported entries.
+	k.setStorage(ctx, agoric.NewKVEntry(entry.Path, entry.Value), false)
+}
+
 func getEncodedKeysWithPrefixFromIterator(iterator sdk.Iterator, prefix string) [][]byte {
 	keys := make([][]byte, 0)
 	defer iterator.Close()
@@ -215,6 +428,8 @@ func (k Keeper) RemoveEntriesWithPrefix(ctx sdk.Context, pathPrefix string) {
 	keys := getEncodedKeysWithPrefixFromIterator(iterator, descendantPrefix)
 
 	for _, key := range keys {
+		path := types.EncodedKeyToPath(key)
+		k.trackStorageQuota(ctx, path, entrySize(k.GetEntry(ctx, path)), 0)
 		store.Delete(key)
 	}
 
@@ -244,14 +459,83 @@ func (k Keeper) EmitChange(ctx sdk.Context, change *ProposedChange) {
 			[]byte(change.NewValue),
 		),
 	)
+
+	// Emit the typed event, which subscribers can filter by path.
+	var event proto.Message
+	switch {
+	case change.Deleted:
+		event = &types.EventDeletePath{
+			Path:        change.Path,
+			BlockHeight: ctx.BlockHeight(),
+		}
+	case change.Appended:
+		event = &types.EventAppendValue{
+			Path:        change.Path,
+			ValueHash:   hashValue(change.NewValue),
+			BlockHeight: ctx.BlockHeight(),
+		}
+	default:
+		event = &types.EventSetValue{
+			Path:        change.Path,
+			ValueHash:   hashValue(change.NewValue),
+			BlockHeight: ctx.BlockHeight(),
+		}
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+
+	if change.Appended && k.history != nil {
+		// The history is node-local, so failing to record it must not halt
+		// the chain.
+		if err := k.history.Record(change.Path, ctx.BlockHeight(), change.NewValue); err != nil {
+			ctx.Logger().Error("cannot record stream cell history", "path", change.Path, "err", err)
+		}
+	}
+
+	k.notifier.Notify(change.Path, DataChange{
+		BlockHeight: ctx.BlockHeight(),
+		Value:       change.NewValue,
+	})
+}
+
+// hashValue returns the lowercase hex SHA-256 of a storage value, as reported
+// by the typed change events.
+func hashValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
 }
 
 // GetEntry gets generic storage.  The default value is an empty string.
 func (k Keeper) GetEntry(ctx sdk.Context, path string) agoric.KVEntry {
 	//fmt.Printf("GetEntry(%s)\n", path);
 	store := ctx.KVStore(k.storeKey)
-	encodedKey := types.PathToEncodedKey(path)
-	rawValue := store.Get(encodedKey)
+	return decodeEntry(path, store.Get(types.PathToEncodedKey(path)))
+}
+
+// ProveEntry returns the entry of path in the committed state at height (or
+// the latest if zero), with an ICS-23 proof of its store entry (or of its
+// absence) against the app hash of that state, and the height of the state,
+// as queried with "abci_query" of "/store/vstorage/key" and prove=true.
+// Proofs require a proof querier.
+func (k Keeper) ProveEntry(ctx sdk.Context, path string, height int64) (agoric.KVEntry, *crypto.ProofOps, int64, error) {
+	if k.proofQuerier == nil {
+		return agoric.KVEntry{}, nil, 0, sdkioerrors.Wrap(types.ErrProofUnavailable, "this node does not serve proofs")
+	}
+	res := k.proofQuerier.Query(abci.RequestQuery{
+		Path:   "/" + types.StoreKey + "/key",
+		Data:   types.PathToEncodedKey(path),
+		Height: height,
+		Prove:  true,
+	})
+	if res.Code != 0 {
+		return agoric.KVEntry{}, nil, 0, sdkioerrors.Wrap(types.ErrProofUnavailable, res.Log)
+	}
+	return decodeEntry(path, res.Value), res.ProofOps, res.Height, nil
+}
+
+// decodeEntry returns the entry of path given the raw value of its key.
+func decodeEntry(path string, rawValue []byte) agoric.KVEntry {
 	if len(rawValue) == 0 {
 		return agoric.NewKVEntryWithNoValue(path)
 	}
@@ -287,6 +571,74 @@ func (k Keeper) GetChildren(ctx sdk.Context, path string) *types.Children {
 	return &children
 }
 
+// GetChildrenPage gets one page of the vstorage children at a given path, in
+// the same order as GetChildren.
+func (k Keeper) GetChildrenPage(ctx sdk.Context, path string, pageReq *query.PageRequest) (*types.Children, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PathToChildrenPrefix(path))
+
+	var children types.Children
+	children.Children = []string{}
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		// Within the children prefix, each key is just the child path segment.
+		children.Children = append(children.Children, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &children, pageRes, nil
+}
+
+// GetEntriesMatching gets the entries with data at paths matching a pattern
+// in which any segment may be types.PathWildcard, ordered by path segment.
+// It fails as soon as more than limit paths would need to be examined.
+func (k Keeper) GetEntriesMatching(ctx sdk.Context, pattern string, limit int) ([]agoric.KVEntry, error) {
+	if err := types.ValidatePathPattern(pattern); err != nil {
+		return nil, err
+	}
+	tooMany := fmt.Errorf("path pattern %q matches more than %d paths", pattern, limit)
+
+	// Expand the pattern one segment at a time.
+	candidates := []string{""}
+	for _, segment := range strings.Split(pattern, types.PathSeparator) {
+		next := []string{}
+		for _, candidate := range candidates {
+			prefix := candidate
+			if prefix != "" {
+				prefix += types.PathSeparator
+			}
+			if segment != types.PathWildcard {
+				if k.HasEntry(ctx, prefix+segment) {
+					if len(next) == limit {
+						return nil, tooMany
+					}
+					next = append(next, prefix+segment)
+				}
+				continue
+			}
+			iterator := k.getKeyIterator(ctx, candidate)
+			for ; iterator.Valid(); iterator.Next() {
+				if len(next) == limit {
+					iterator.Close()
+					return nil, tooMany
+				}
+				path := types.EncodedKeyToPath(iterator.Key())
+				next = append(next, prefix+path[strings.LastIndex(path, types.PathSeparator)+1:])
+			}
+			iterator.Close()
+		}
+		candidates = next
+	}
+
+	entries := []agoric.KVEntry{}
+	for _, path := range candidates {
+		if entry := k.GetEntry(ctx, path); entry.HasValue() {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
 // HasStorage tells if a given path has data.  Some storage nodes have no data
 // (just an empty string) and exist only to provide linkage to subnodes with
 // data.
@@ -330,27 +682,90 @@ func (k Keeper) LegacySetStorageAndNotify(ctx sdk.Context, entry agoric.KVEntry)
 	k.SetStorage(ctx, entry)
 }
 
+// SetStorageBatch sets and notifies the data values for a list of entries as
+// a single unit. Every path is validated before any is written, and writes are
+// staged in a cache so that running out of gas part way through leaves storage
+// untouched. Change events are coalesced with the rest of the block's changes.
+// Likewise nothing is written if the entries would exceed a quota.
+func (k Keeper) SetStorageBatch(ctx sdk.Context, entries []agoric.KVEntry) error {
+	for _, entry := range entries {
+		if err := types.ValidatePath(entry.Key()); err != nil {
+			return err
+		}
+	}
+	if err := k.CheckStorageQuotas(ctx, entries); err != nil {
+		return err
+	}
+
+	// Nothing was written upon failure, so resynchronize any tracked changes
+	// with the unmodified store.
+	untrack := func() {
+		readCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		for _, entry := range entries {
+			k.changeManager.Track(readCtx, k, k.GetEntry(readCtx, entry.Key()), false)
+		}
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			untrack()
+			panic(r)
+		}
+	}()
+	for _, entry := range entries {
+		k.changeManager.Track(cacheCtx, k, entry, false)
+		k.SetStorage(cacheCtx, entry)
+	}
+	writeCache()
+	return nil
+}
+
 func (k Keeper) AppendStorageValueAndNotify(ctx sdk.Context, path, value string) error {
+	return k.AppendStorageValuesAndNotify(ctx, []agoric.KVEntry{agoric.NewKVEntry(path, value)})
+}
+
+// AppendStorageValuesAndNotify appends the value of each entry to the stream
+// cell at its path in order, writing nothing if the appends would exceed a
+// quota.
+func (k Keeper) AppendStorageValuesAndNotify(ctx sdk.Context, entries []agoric.KVEntry) error {
 	blockHeight := strconv.FormatInt(ctx.BlockHeight(), 10)
 
-	// Preserve correctly-formatted data within the current block,
-	// otherwise initialize a blank cell.
-	currentData := k.GetEntry(ctx, path).StringValue()
-	var cell StreamCell
-	_ = json.Unmarshal([]byte(currentData), &cell)
-	if cell.BlockHeight != blockHeight {
-		cell = StreamCell{BlockHeight: blockHeight, Values: make([]string, 0, 1)}
-	}
+	// The stream cells as of the appends so far.
+	cells := map[string]*StreamCell{}
+	writes := make([]agoric.KVEntry, len(entries))
+	for i, entry := range entries {
+		path := entry.Key()
+		cell, found := cells[path]
+		if !found {
+			// Preserve correctly-formatted data within the current block,
+			// otherwise initialize a blank cell.
+			currentData := k.GetEntry(ctx, path).StringValue()
+			cell = &StreamCell{}
+			_ = json.Unmarshal([]byte(currentData), cell)
+			if cell.BlockHeight != blockHeight {
+				cell = &StreamCell{BlockHeight: blockHeight, Values: make([]string, 0, 1)}
+			}
+			cells[path] = cell
+		}
 
-	// Append the new value.
-	cell.Values = append(cell.Values, value)
+		// Append the new value.
+		cell.Values = append(cell.Values, entry.StringValue())
 
-	// Perform the write.
-	bz, err := json.Marshal(cell)
-	if err != nil {
+		bz, err := json.Marshal(cell)
+		if err != nil {
+			return err
+		}
+		writes[i] = agoric.NewKVEntry(path, string(bz))
+	}
+	if err := k.CheckStorageQuotas(ctx, writes); err != nil {
 		return err
 	}
-	k.SetStorageAndNotify(ctx, agoric.NewKVEntry(path, string(bz)))
+
+	// Perform the writes.
+	for _, entry := range writes {
+		k.changeManager.TrackAppend(ctx, k, entry)
+		k.SetStorage(ctx, entry)
+	}
 	return nil
 }
 
@@ -363,10 +778,20 @@ func componentsToPath(components []string) string {
 // Maintains the invariant: path entries exist if and only if self or some
 // descendant has non-empty storage
 func (k Keeper) SetStorage(ctx sdk.Context, entry agoric.KVEntry) {
+	k.setStorage(ctx, entry, true)
+}
+
+// setStorage is SetStorage, which also updates the usage of the quotas of the
+// subtrees containing the path if trackQuota is true.
+func (k Keeper) setStorage(ctx sdk.Context, entry agoric.KVEntry, trackQuota bool) {
 	store := ctx.KVStore(k.storeKey)
 	path := entry.Key()
 	encodedKey := types.PathToEncodedKey(path)
 
+	if trackQuota {
+		k.trackStorageQuota(ctx, path, entrySize(k.GetEntry(ctx, path)), entrySize(entry))
+	}
+
 	if !entry.HasValue() {
 		if !k.HasChildren(ctx, path) {
 			// We have no children, can delete.
@@ -1,19 +1,26 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/capdata"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/proof"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func ptr[T any](v T) *T {
@@ -298,3 +305,559 @@ func TestCapData(t *testing.T) {
 		}
 	}
 }
+
+func TestChildrenPagination(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+
+	for _, child := range []string{"a", "b", "c", "d", "e"} {
+		keeper.SetStorage(ctx, agoric.NewKVEntry("wallet."+child, "x"))
+	}
+	keeper.SetStorage(ctx, agoric.NewKVEntry("wallet.a.nested", "x"))
+	cctx := sdk.WrapSDKContext(ctx)
+
+	// Without pagination, every child is returned.
+	resp, err := querier.Children(cctx, &types.QueryChildrenRequest{Path: "wallet"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(resp.Children, want) {
+		t.Errorf("got children %q, want %q", resp.Children, want)
+	}
+	if resp.Pagination != nil {
+		t.Errorf("got pagination %v, want nil", resp.Pagination)
+	}
+
+	// Follow next keys through every page.
+	var got []string
+	pageReq := &query.PageRequest{Limit: 2, CountTotal: true}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatalf("too many pages")
+		}
+		resp, err := querier.Children(cctx, &types.QueryChildrenRequest{Path: "wallet", Pagination: pageReq})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Children) > 2 {
+			t.Errorf("got page %q exceeding limit", resp.Children)
+		}
+		if pages == 0 && resp.Pagination.Total != 5 {
+			t.Errorf("got total %d, want 5", resp.Pagination.Total)
+		}
+		got = append(got, resp.Children...)
+		if len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got paginated children %q, want %q", got, want)
+	}
+
+	// Offsets are supported as well.
+	resp, err = querier.Children(cctx, &types.QueryChildrenRequest{
+		Path:       "wallet",
+		Pagination: &query.PageRequest{Offset: 3, Limit: 10},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"d", "e"}; !reflect.DeepEqual(resp.Children, want) {
+		t.Errorf("got offset children %q, want %q", resp.Children, want)
+	}
+
+	_, err = querier.Children(cctx, &types.QueryChildrenRequest{Path: "wallet..a"})
+	if code := grpcStatus.Code(err); code != grpcCodes.InvalidArgument {
+		t.Errorf("got error code %v for invalid path, want %v", code, grpcCodes.InvalidArgument)
+	}
+}
+
+func TestExportPagination(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+
+	for path, value := range map[string]string{
+		"published":                "root",
+		"published.a":              "A",
+		"published.a.deep.er":      "ADE",
+		"published.b":              "",
+		"published.c.x":            "CX",
+		"publishedSibling":         "no",
+		"other.published.mismatch": "no",
+	} {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(path, value))
+	}
+	cctx := sdk.WrapSDKContext(ctx)
+
+	var got []types.DataEntry
+	var nextKey []byte
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatalf("too many pages")
+		}
+		resp, err := querier.Export(cctx, &types.QueryExportRequest{
+			Path:       "published",
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Entries) > 2 {
+			t.Errorf("got page %v exceeding limit", resp.Entries)
+		}
+		for _, entry := range resp.Entries {
+			got = append(got, *entry)
+		}
+		if len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+
+	// Entries are ordered by depth, then path.
+	expected := []types.DataEntry{
+		{Path: "published", Value: "root"},
+		{Path: "published.a", Value: "A"},
+		{Path: "published.b", Value: ""},
+		{Path: "published.c.x", Value: "CX"},
+		{Path: "published.a.deep.er", Value: "ADE"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got export %v, want %v", got, expected)
+	}
+
+	resp, err := querier.Export(cctx, &types.QueryExportRequest{
+		Path:       "",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Pagination.Total != 7 {
+		t.Errorf("got total %d for root export, want 7", resp.Pagination.Total)
+	}
+
+	_, err = querier.Export(cctx, &types.QueryExportRequest{Path: "published."})
+	if code := grpcStatus.Code(err); code != grpcCodes.InvalidArgument {
+		t.Errorf("got error code %v for invalid path, want %v", code, grpcCodes.InvalidArgument)
+	}
+}
+
+func TestExportIteratesOnlySubtree(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+
+	deepPath := "published.d.2.3.4.5.6.7.8.9.10"
+	keeper.SetStorage(ctx, agoric.NewKVEntry(deepPath, "deep"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("published.e", "E"))
+
+	exportGas := func() uint64 {
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		resp, err := querier.Export(sdk.WrapSDKContext(gasCtx), &types.QueryExportRequest{Path: "published"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Depths are ordered numerically, so depth 10 follows depth 2.
+		expected := []*types.DataEntry{
+			{Path: "published.e", Value: "E"},
+			{Path: deepPath, Value: "deep"},
+		}
+		if !reflect.DeepEqual(resp.Entries, expected) {
+			t.Errorf("got export %v, want %v", resp.Entries, expected)
+		}
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	before := exportGas()
+	for i := 0; i < 50; i++ {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(fmt.Sprintf("other.x%d.y", i), "unrelated"))
+	}
+	if after := exportGas(); after != before {
+		t.Errorf("export of published used %d gas after unrelated writes, want %d", after, before)
+	}
+}
+
+func TestGetEntriesMatchingStopsAtLimit(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	matchingGas := func() uint64 {
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := keeper.GetEntriesMatching(gasCtx, "wide.*", 2)
+		if err == nil {
+			t.Fatalf("got no error matching more than the limit")
+		}
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	for i := 0; i < 10; i++ {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(fmt.Sprintf("wide.c%03d", i), "x"))
+	}
+	before := matchingGas()
+	for i := 10; i < 100; i++ {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(fmt.Sprintf("wide.c%03d", i), "x"))
+	}
+	if after := matchingGas(); after != before {
+		t.Errorf("matching used %d gas with more children, want %d", after, before)
+	}
+}
+
+func TestDataMulti(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+
+	for path, value := range map[string]string{
+		"published.vaultFactory.managers.manager0.quotes":     "Q0",
+		"published.vaultFactory.managers.manager0.metrics":    "M0",
+		"published.vaultFactory.managers.manager1.quotes":     "Q1",
+		"published.vaultFactory.managers.manager2.governance": "G2",
+		"published.vaultFactory.managers.manager3.quotes.x":   "QX",
+	} {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(path, value))
+	}
+	cctx := sdk.WrapSDKContext(ctx)
+
+	type testCase struct {
+		label    string
+		request  types.QueryDataMultiRequest
+		expected []types.DataEntry
+		errCode  grpcCodes.Code
+	}
+	testCases := []testCase{
+		{label: "explicit paths",
+			request: types.QueryDataMultiRequest{Paths: []string{
+				"published.vaultFactory.managers.manager1.quotes",
+				"published.missing",
+				"published.vaultFactory.managers.manager0.quotes",
+			}},
+			expected: []types.DataEntry{
+				{Path: "published.vaultFactory.managers.manager1.quotes", Value: "Q1"},
+				{Path: "published.missing", Value: ""},
+				{Path: "published.vaultFactory.managers.manager0.quotes", Value: "Q0"},
+			},
+		},
+		{label: "wildcard",
+			request: types.QueryDataMultiRequest{Pattern: "published.vaultFactory.managers.*.quotes"},
+			expected: []types.DataEntry{
+				{Path: "published.vaultFactory.managers.manager0.quotes", Value: "Q0"},
+				{Path: "published.vaultFactory.managers.manager1.quotes", Value: "Q1"},
+			},
+		},
+		{label: "multiple wildcards",
+			request: types.QueryDataMultiRequest{Pattern: "published.*.managers.manager0.*"},
+			expected: []types.DataEntry{
+				{Path: "published.vaultFactory.managers.manager0.metrics", Value: "M0"},
+				{Path: "published.vaultFactory.managers.manager0.quotes", Value: "Q0"},
+			},
+		},
+		{label: "no matches",
+			request:  types.QueryDataMultiRequest{Pattern: "published.nothing.*"},
+			expected: []types.DataEntry{},
+		},
+		{label: "neither paths nor pattern",
+			request: types.QueryDataMultiRequest{},
+			errCode: grpcCodes.InvalidArgument,
+		},
+		{label: "both paths and pattern",
+			request: types.QueryDataMultiRequest{Paths: []string{"published"}, Pattern: "published.*"},
+			errCode: grpcCodes.InvalidArgument,
+		},
+		{label: "invalid pattern",
+			request: types.QueryDataMultiRequest{Pattern: "published.**"},
+			errCode: grpcCodes.InvalidArgument,
+		},
+		{label: "invalid path",
+			request: types.QueryDataMultiRequest{Paths: []string{"published..x"}},
+			errCode: grpcCodes.InvalidArgument,
+		},
+	}
+	for _, desc := range testCases {
+		resp, err := querier.DataMulti(cctx, &desc.request)
+		if desc.errCode != grpcCodes.OK {
+			if code := grpcStatus.Code(err); code != desc.errCode {
+				t.Errorf("%s: got error %v, want code %v", desc.label, err, desc.errCode)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: got unexpected error %v", desc.label, err)
+			continue
+		}
+		got := []types.DataEntry{}
+		for _, entry := range resp.Entries {
+			got = append(got, *entry)
+		}
+		if !reflect.DeepEqual(got, desc.expected) {
+			t.Errorf("%s: got %v, want %v", desc.label, got, desc.expected)
+		}
+	}
+}
+
+func TestQueryGasLimit(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("small", "x"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("large", strings.Repeat("x", 10_000)))
+	for i := 0; i < 100; i++ {
+		keeper.SetStorage(ctx, agoric.NewKVEntry(fmt.Sprintf("many.child%d", i), "x"))
+	}
+	cctx := sdk.WrapSDKContext(ctx)
+
+	// Without a limit, everything can be read.
+	unlimited := Querier{keeper}
+	if _, err := unlimited.Data(cctx, &types.QueryDataRequest{Path: "large"}); err != nil {
+		t.Errorf("unexpected error reading without a limit: %v", err)
+	}
+
+	querier := Querier{keeper.WithMaxQueryGas(3_000)}
+	if resp, err := querier.Data(cctx, &types.QueryDataRequest{Path: "small"}); err != nil || resp.Value != "x" {
+		t.Errorf("got %v, %v for small data, want value \"x\"", resp, err)
+	}
+	_, err := querier.Data(cctx, &types.QueryDataRequest{Path: "large"})
+	if code := grpcStatus.Code(err); code != grpcCodes.ResourceExhausted {
+		t.Errorf("got error %v for large data, want code %v", err, grpcCodes.ResourceExhausted)
+	}
+	_, err = querier.Children(cctx, &types.QueryChildrenRequest{Path: "many"})
+	if code := grpcStatus.Code(err); code != grpcCodes.ResourceExhausted {
+		t.Errorf("got error %v for many children, want code %v", err, grpcCodes.ResourceExhausted)
+	}
+	resp, err := querier.Children(cctx, &types.QueryChildrenRequest{
+		Path:       "many",
+		Pagination: &query.PageRequest{Limit: 5},
+	})
+	if err != nil || len(resp.Children) != 5 {
+		t.Errorf("got %v, %v for a page of children, want 5 children", resp, err)
+	}
+
+	// The limit applies to each query rather than to the enclosing context.
+	gasBefore := ctx.GasMeter().GasConsumed()
+	if _, err := querier.Data(cctx, &types.QueryDataRequest{Path: "small"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gas := ctx.GasMeter().GasConsumed() - gasBefore; gas != 0 {
+		t.Errorf("got %d gas consumed by the enclosing context, want 0", gas)
+	}
+}
+
+func TestDataWithProof(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+
+	_, err := Querier{keeper}.DataWithProof(sdk.WrapSDKContext(ctx), &types.QueryDataWithProofRequest{Path: "feed"})
+	if code := grpcStatus.Code(err); code != grpcCodes.FailedPrecondition {
+		t.Errorf("got error %v without a proof querier, want code %v", err, grpcCodes.FailedPrecondition)
+	}
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("published.feed", "price"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("published.empty", ""))
+	ms := ctx.MultiStore().(storetypes.CommitMultiStore)
+	commitID := ms.Commit()
+	querier := Querier{keeper.WithProofQuerier(ms.(storetypes.Queryable))}
+
+	for _, path := range []string{"published.feed", "published.empty", "published", "published.none"} {
+		res, err := querier.DataWithProof(sdk.WrapSDKContext(ctx), &types.QueryDataWithProofRequest{Path: path})
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", path, err)
+		}
+		if res.Height != commitID.Version {
+			t.Errorf("%s: got height %d, want %d", path, res.Height, commitID.Version)
+		}
+		if err := proof.VerifyData(commitID.Hash, path, res); err != nil {
+			t.Errorf("%s: got verification error %v for value %q", path, err, res.Value)
+		}
+
+		forged := *res
+		forged.Value = "forged"
+		if err := proof.VerifyData(commitID.Hash, path, &forged); err == nil {
+			t.Errorf("%s: verified forged value", path)
+		}
+	}
+	res, err := querier.DataWithProof(sdk.WrapSDKContext(ctx), &types.QueryDataWithProofRequest{Path: "published.feed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Value != "price" {
+		t.Errorf("got value %q, want %q", res.Value, "price")
+	}
+	if err := proof.VerifyData(commitID.Hash, "published.empty", res); err == nil {
+		t.Errorf("verified the proof of one path for another")
+	}
+}
+
+func TestHistory(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	cctx := sdk.WrapSDKContext(ctx)
+
+	_, err := Querier{keeper}.History(cctx, &types.QueryHistoryRequest{Path: "cell"})
+	if code := grpcStatus.Code(err); code != grpcCodes.FailedPrecondition {
+		t.Errorf("got error %v without history, want code %v", err, grpcCodes.FailedPrecondition)
+	}
+
+	keeper = keeper.WithStreamCellHistory(NewStreamCellHistory(dbm.NewMemDB(), 3))
+	querier := Querier{keeper}
+	for height := int64(1); height <= 5; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		for _, value := range []string{"a", "b"} {
+			if err := keeper.AppendStorageValueAndNotify(ctx, "cell", fmt.Sprint(value, height)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// Values that are set rather than appended are not recorded.
+		keeper.SetStorageAndNotify(ctx, agoric.NewKVEntry("plain", fmt.Sprint(height)))
+		keeper.FlushChangeEvents(ctx)
+	}
+	cell := func(height int64) *types.HistoricalCell {
+		return &types.HistoricalCell{
+			BlockHeight: fmt.Sprint(height),
+			Value:       mustMarshalStreamCell(fmt.Sprint(height), []string{fmt.Sprint("a", height), fmt.Sprint("b", height)}),
+		}
+	}
+
+	type testCase struct {
+		label    string
+		request  types.QueryHistoryRequest
+		expected []*types.HistoricalCell
+		errCode  grpcCodes.Code
+	}
+	for _, tc := range []testCase{
+		{label: "all retained cells",
+			request:  types.QueryHistoryRequest{Path: "cell"},
+			expected: []*types.HistoricalCell{cell(3), cell(4), cell(5)},
+		},
+		{label: "bounded range",
+			request:  types.QueryHistoryRequest{Path: "cell", MinBlockHeight: 4, MaxBlockHeight: 4},
+			expected: []*types.HistoricalCell{cell(4)},
+		},
+		{label: "pruned range",
+			request:  types.QueryHistoryRequest{Path: "cell", MaxBlockHeight: 2},
+			expected: []*types.HistoricalCell{},
+		},
+		{label: "plain data",
+			request:  types.QueryHistoryRequest{Path: "plain"},
+			expected: []*types.HistoricalCell{},
+		},
+		{label: "prefix of another path",
+			request:  types.QueryHistoryRequest{Path: "cel"},
+			expected: []*types.HistoricalCell{},
+		},
+		{label: "inverted range",
+			request: types.QueryHistoryRequest{Path: "cell", MinBlockHeight: 5, MaxBlockHeight: 4},
+			errCode: grpcCodes.InvalidArgument,
+		},
+		{label: "invalid path",
+			request: types.QueryHistoryRequest{Path: "cell..a"},
+			errCode: grpcCodes.InvalidArgument,
+		},
+	} {
+		resp, err := querier.History(cctx, &tc.request)
+		if code := grpcStatus.Code(err); code != tc.errCode {
+			t.Errorf("%s: got error %v, want code %v", tc.label, err, tc.errCode)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(resp.Cells, tc.expected) {
+			t.Errorf("%s: got cells %v, want %v", tc.label, resp.Cells, tc.expected)
+		}
+	}
+}
+
+type testWatchDataStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *types.QueryWatchDataResponse
+}
+
+func (s testWatchDataStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s testWatchDataStream) Send(res *types.QueryWatchDataResponse) error {
+	s.sent <- res
+	return nil
+}
+
+func TestWatchData(t *testing.T) {
+	testKit := makeTestKit()
+	ctx, keeper := testKit.ctx, testKit.vstorageKeeper
+	querier := Querier{keeper}
+
+	watcherCount := func() int {
+		keeper.notifier.mu.Lock()
+		defer keeper.notifier.mu.Unlock()
+		return keeper.notifier.count
+	}
+
+	err := querier.WatchData(&types.QueryWatchDataRequest{Path: "bad..path"}, nil)
+	if grpcStatus.Code(err) != grpcCodes.InvalidArgument {
+		t.Errorf("got error %v for invalid path, want InvalidArgument", err)
+	}
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := testWatchDataStream{ctx: streamCtx, sent: make(chan *types.QueryWatchDataResponse, 10)}
+	done := make(chan error)
+	go func() {
+		done <- querier.WatchData(&types.QueryWatchDataRequest{Path: "watched"}, stream)
+	}()
+	for watcherCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx = ctx.WithBlockHeight(5)
+	if err := keeper.AppendStorageValueAndNotify(ctx, "watched", "first"); err != nil {
+		t.Fatal(err)
+	}
+	keeper.SetStorageAndNotify(ctx, agoric.NewKVEntry("unwatched", "value"))
+	keeper.FlushChangeEvents(ctx)
+
+	// An unchanged value is not reported.
+	ctx = ctx.WithBlockHeight(6)
+	keeper.SetStorageAndNotify(ctx, agoric.NewKVEntry("watched", mustMarshalStreamCell("5", []string{"first"})))
+	keeper.FlushChangeEvents(ctx)
+
+	ctx = ctx.WithBlockHeight(7)
+	keeper.SetStorageAndNotify(ctx, agoric.NewKVEntryWithNoValue("watched"))
+	keeper.FlushChangeEvents(ctx)
+
+	for _, expected := range []types.QueryWatchDataResponse{
+		{BlockHeight: "5", Value: mustMarshalStreamCell("5", []string{"first"})},
+		{BlockHeight: "7", Value: ""},
+	} {
+		if got := <-stream.sent; !reflect.DeepEqual(*got, expected) {
+			t.Errorf("got %#v, want %#v", *got, expected)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("got error %v after cancel, want %v", err, context.Canceled)
+	}
+	if len(stream.sent) != 0 {
+		t.Errorf("got %d unexpected responses", len(stream.sent))
+	}
+	if n := watcherCount(); n != 0 {
+		t.Errorf("got %d watchers after cancel, want 0", n)
+	}
+
+	// A watcher which falls behind is dropped.
+	changes, stop, err := keeper.WatchPath("watched")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+	for i := 0; i <= watchBufferSize; i++ {
+		keeper.notifier.Notify("watched", DataChange{BlockHeight: int64(i)})
+	}
+	received := 0
+	for range changes {
+		received++
+	}
+	if received != watchBufferSize {
+		t.Errorf("got %d changes before the watcher was dropped, want %d", received, watchBufferSize)
+	}
+}
@@ -7,9 +7,13 @@ import (
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gogo/protobuf/proto"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -19,6 +23,8 @@ import (
 
 var (
 	vstorageStoreKey = storetypes.NewKVStoreKey(types.StoreKey)
+	paramsStoreKey   = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey  = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 )
 
 type testKit struct {
@@ -27,11 +33,15 @@ type testKit struct {
 }
 
 func makeTestKit() testKit {
-	keeper := NewKeeper(vstorageStoreKey)
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(),
+		paramsStoreKey, paramsTStoreKey, types.ModuleName)
+	keeper := NewKeeper(vstorageStoreKey, paramSpace)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(vstorageStoreKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
 	err := ms.LoadLatestVersion()
 	if err != nil {
 		panic(err)
@@ -41,6 +51,14 @@ func makeTestKit() testKit {
 	return testKit{ctx, keeper}
 }
 
+func mustTypedEvent(msg proto.Message) sdk.Event {
+	event, err := sdk.TypedEventToEvent(msg)
+	if err != nil {
+		panic(err)
+	}
+	return event
+}
+
 func childrenEqual(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -240,6 +258,7 @@ func TestStorageNotify(t *testing.T) {
 				{Key: []byte("value"), Value: []byte("legacyValue")},
 			},
 		},
+		mustTypedEvent(&types.EventSetValue{Path: "notify.legacy", ValueHash: hashValue("legacyValue")}),
 		{
 			Type: "storage",
 			Attributes: []abci.EventAttribute{
@@ -256,6 +275,7 @@ func TestStorageNotify(t *testing.T) {
 				{Key: []byte("value"), Value: []byte("legacyValue2b")},
 			},
 		},
+		mustTypedEvent(&types.EventSetValue{Path: "notify.legacy2", ValueHash: hashValue("legacyValue2b")}),
 		{
 			Type: "state_change",
 			Attributes: []abci.EventAttribute{
@@ -265,6 +285,7 @@ func TestStorageNotify(t *testing.T) {
 				{Key: []byte("value"), Value: []byte("noLegacyValue")},
 			},
 		},
+		mustTypedEvent(&types.EventSetValue{Path: "notify.noLegacy", ValueHash: hashValue("noLegacyValue")}),
 		{
 			Type: "state_change",
 			Attributes: []abci.EventAttribute{
@@ -274,6 +295,7 @@ func TestStorageNotify(t *testing.T) {
 				{Key: []byte("value"), Value: []byte("noLegacyValue2b")},
 			},
 		},
+		mustTypedEvent(&types.EventSetValue{Path: "notify.noLegacy2", ValueHash: hashValue("noLegacyValue2b")}),
 	}
 
 	keeper.FlushChangeEvents(ctx)
@@ -292,3 +314,242 @@ func TestStorageNotify(t *testing.T) {
 		t.Errorf("got after second flush events %#v, want %#v", got, expectedAfterFlushEvents)
 	}
 }
+
+func TestStorageBatch(t *testing.T) {
+	tk := makeTestKit()
+	ctx, keeper := tk.ctx, tk.vstorageKeeper
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("batch.existing", "old"))
+
+	// An invalid path anywhere in the batch prevents all writes.
+	err := keeper.SetStorageBatch(ctx, []agoric.KVEntry{
+		agoric.NewKVEntry("batch.a", "A"),
+		agoric.NewKVEntry("batch..b", "B"),
+	})
+	if err == nil {
+		t.Errorf("got no error for invalid path")
+	}
+	if keeper.HasStorage(ctx, "batch.a") {
+		t.Errorf("got partial write of batch.a after invalid batch")
+	}
+
+	// Running out of gas part way through prevents all writes and events.
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expected out of gas panic")
+			}
+		}()
+		gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(8000))
+		_ = keeper.SetStorageBatch(gasCtx, []agoric.KVEntry{
+			agoric.NewKVEntry("batch.a", "A"),
+			agoric.NewKVEntry("batch.existing", "new"),
+			agoric.NewKVEntry("batch.c", "C"),
+		})
+	}()
+	if keeper.HasStorage(ctx, "batch.a") {
+		t.Errorf("got partial write of batch.a after out of gas")
+	}
+	if got := keeper.GetEntry(ctx, "batch.existing").StringValue(); got != "old" {
+		t.Errorf("got batch.existing %q after out of gas, want %q", got, "old")
+	}
+	keeper.FlushChangeEvents(ctx)
+	if got := ctx.EventManager().Events(); !reflect.DeepEqual(got, sdk.Events{}) {
+		t.Errorf("got events after out of gas %#v, want none", got)
+	}
+
+	// A valid batch applies every entry, with one event per changed path.
+	err = keeper.SetStorageBatch(ctx, []agoric.KVEntry{
+		agoric.NewKVEntry("batch.a", "A"),
+		agoric.NewKVEntry("batch.existing", "new"),
+		agoric.NewKVEntry("batch.a", "A2"),
+		agoric.NewKVEntryWithNoValue("batch.missing"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := keeper.GetEntry(ctx, "batch.a").StringValue(); got != "A2" {
+		t.Errorf("got batch.a %q, want %q", got, "A2")
+	}
+	if got := keeper.GetEntry(ctx, "batch.existing").StringValue(); got != "new" {
+		t.Errorf("got batch.existing %q, want %q", got, "new")
+	}
+	if got := keeper.GetChildren(ctx, "batch"); !childrenEqual(got.Children, []string{"a", "existing"}) {
+		t.Errorf("got %q children, want [a existing]", got.Children)
+	}
+	keeper.FlushChangeEvents(ctx)
+	expectedEvents := sdk.Events{
+		agoric.NewStateChangeEvent(keeper.GetStoreName(), keeper.PathToEncodedKey("batch.a"), []byte("A2")),
+		mustTypedEvent(&types.EventSetValue{Path: "batch.a", ValueHash: hashValue("A2")}),
+		agoric.NewStateChangeEvent(keeper.GetStoreName(), keeper.PathToEncodedKey("batch.existing"), []byte("new")),
+		mustTypedEvent(&types.EventSetValue{Path: "batch.existing", ValueHash: hashValue("new")}),
+	}
+	if got := ctx.EventManager().Events(); !reflect.DeepEqual(got, expectedEvents) {
+		t.Errorf("got events %#v, want %#v", got, expectedEvents)
+	}
+}
+
+func TestStorageTypedEvents(t *testing.T) {
+	tk := makeTestKit()
+	ctx, keeper := tk.ctx, tk.vstorageKeeper
+	ctx = ctx.WithBlockHeight(7)
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("typed.deleted", "old"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("typed.overwritten", "old"))
+	keeper.SetStorageAndNotify(ctx, agoric.NewKVEntry("typed.set", "value"))
+	if err := keeper.AppendStorageValueAndNotify(ctx, "typed.appended", "first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := keeper.AppendStorageValueAndNotify(ctx, "typed.overwritten", "appended"); err != nil {
+		t.Fatal(err)
+	}
+	keeper.SetStorageAndNotify(ctx, agoric.NewKVEntry("typed.overwritten", "set"))
+	keeper.SetStorageAndNotify(ctx, agoric.NewKVEntryWithNoValue("typed.deleted"))
+
+	keeper.FlushChangeEvents(ctx)
+	var got []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "state_change" {
+			got = append(got, event)
+		}
+	}
+	cell := `{"blockHeight":"7","values":["first"]}`
+	expected := []sdk.Event{
+		mustTypedEvent(&types.EventAppendValue{Path: "typed.appended", ValueHash: hashValue(cell), BlockHeight: 7}),
+		mustTypedEvent(&types.EventDeletePath{Path: "typed.deleted", BlockHeight: 7}),
+		mustTypedEvent(&types.EventSetValue{Path: "typed.overwritten", ValueHash: hashValue("set"), BlockHeight: 7}),
+		mustTypedEvent(&types.EventSetValue{Path: "typed.set", ValueHash: hashValue("value"), BlockHeight: 7}),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got typed events %#v, want %#v", got, expected)
+	}
+}
+
+func TestMsgSetStorage(t *testing.T) {
+	tk := makeTestKit()
+	ctx, keeper := tk.ctx, tk.vstorageKeeper
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	submitter := sdk.AccAddress([]byte("submitter"))
+
+	if _, err := msgServer.SetStorage(goCtx, types.NewMsgSetStorage(submitter, "published.seed", "seeded")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := keeper.GetEntry(ctx, "published.seed").StringValue(); got != "seeded" {
+		t.Errorf("got %q, want %q", got, "seeded")
+	}
+	if got := keeper.GetChildren(ctx, "published").Children; !childrenEqual(got, []string{"seed"}) {
+		t.Errorf("got children %q, want [seed]", got)
+	}
+
+	// An empty value deletes the data, and the placeholder ancestors with it.
+	if _, err := msgServer.SetStorage(goCtx, types.NewMsgSetStorage(submitter, "published.seed", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keeper.HasEntry(ctx, "published.seed") || keeper.HasEntry(ctx, "published") {
+		t.Errorf("got entries after deletion, want none")
+	}
+
+	for _, msg := range []*types.MsgSetStorage{
+		types.NewMsgSetStorage(nil, "published.seed", "seeded"),
+		types.NewMsgSetStorage(submitter, "published..seed", "seeded"),
+	} {
+		if err := msg.ValidateBasic(); err == nil {
+			t.Errorf("got no error validating %+v, want one", msg)
+		}
+	}
+}
+
+func TestPathQuota(t *testing.T) {
+	kit := makeTestKit()
+	ctx, keeper := kit.ctx, kit.vstorageKeeper
+
+	// "a.x" and "a.xy" use 3+2 and 4+1 bytes.
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.x", "12"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.xy", "1"))
+	keeper.SetStorage(ctx, agoric.NewKVEntry("b", "outside"))
+	if _, found := keeper.GetPathQuotaUsage(ctx, "a"); found {
+		t.Fatalf("got usage of a without a quota")
+	}
+	keeper.SetParams(ctx, types.Params{PathQuotas: []types.PathQuota{{Path: "a", Limit: 20}}})
+	usage := func() uint64 {
+		used, found := keeper.GetPathQuotaUsage(ctx, "a")
+		if !found {
+			t.Fatalf("no quota for a")
+		}
+		return used
+	}
+	// The usage of a new quota is measured, and then cached by the next write.
+	if got := usage(); got != 10 {
+		t.Errorf("got initial usage %d, want 10", got)
+	}
+	keeper.SetStorage(ctx, agoric.NewKVEntry("b", "elsewhere"))
+	if got, want := keeper.GetEntry(ctx, types.PathQuotasPath).StringValue(), `{"a":"10"}`; got != want {
+		t.Errorf("got cached usage %s, want %s", got, want)
+	}
+
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.x", "1234"))
+	if got := usage(); got != 12 {
+		t.Errorf("got usage %d after growing a.x, want 12", got)
+	}
+	keeper.SetStorage(ctx, agoric.NewKVEntryWithNoValue("a.xy"))
+	if got := usage(); got != 7 {
+		t.Errorf("got usage %d after deleting a.xy, want 7", got)
+	}
+
+	tooBig := agoric.NewKVEntry("a.y.z", "0123456789")
+	err := keeper.CheckStorageQuotas(ctx, []agoric.KVEntry{tooBig})
+	if !types.ErrQuotaExceeded.Is(err) {
+		t.Errorf("got error %v for exceeding the quota, want ErrQuotaExceeded", err)
+	}
+	// Entries which fit alone may exceed the quota together.
+	err = keeper.CheckStorageQuotas(ctx, []agoric.KVEntry{agoric.NewKVEntry("a.v", "1234"), agoric.NewKVEntry("a.w", "1234")})
+	if !types.ErrQuotaExceeded.Is(err) {
+		t.Errorf("got error %v for entries exceeding the quota together, want ErrQuotaExceeded", err)
+	}
+	// But not if the later entry replaces the earlier.
+	err = keeper.CheckStorageQuotas(ctx, []agoric.KVEntry{agoric.NewKVEntry("a.v", "12345"), agoric.NewKVEntry("a.v", "1")})
+	if err != nil {
+		t.Errorf("got error %v for rewriting an entry within the quota", err)
+	}
+	err = keeper.SetStorageBatch(ctx, []agoric.KVEntry{agoric.NewKVEntry("a.w", "1"), tooBig})
+	if !types.ErrQuotaExceeded.Is(err) {
+		t.Errorf("got batch error %v for exceeding the quota, want ErrQuotaExceeded", err)
+	}
+	if keeper.HasStorage(ctx, "a.w") {
+		t.Errorf("batch exceeding the quota wrote a.w")
+	}
+	err = keeper.AppendStorageValuesAndNotify(ctx, []agoric.KVEntry{agoric.NewKVEntry("a.s", "1"), agoric.NewKVEntry("a.s", "2")})
+	if !types.ErrQuotaExceeded.Is(err) {
+		t.Errorf("got append error %v for exceeding the quota, want ErrQuotaExceeded", err)
+	}
+	if keeper.HasStorage(ctx, "a.s") {
+		t.Errorf("appends exceeding the quota wrote a.s")
+	}
+	if err := keeper.CheckStorageQuotas(ctx, []agoric.KVEntry{agoric.NewKVEntry("b", "0123456789abcdefghij")}); err != nil {
+		t.Errorf("got error %v for a write outside the quota", err)
+	}
+
+	// A lowered quota still permits shrinking the subtree.
+	keeper.SetParams(ctx, types.Params{PathQuotas: []types.PathQuota{{Path: "a", Limit: 1}}})
+	if err := keeper.CheckStorageQuotas(ctx, []agoric.KVEntry{agoric.NewKVEntry("a.x", "12")}); err != nil {
+		t.Errorf("got error %v for shrinking a.x", err)
+	}
+
+	keeper.RemoveEntriesWithPrefix(ctx, "a")
+	if got := usage(); got != 0 {
+		t.Errorf("got usage %d after removing a, want 0", got)
+	}
+
+	// The cached usage of a removed quota is dropped by the next write, so a
+	// restored quota measures the writes made without it.
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetStorage(ctx, agoric.NewKVEntry("a.x", "12"))
+	if keeper.HasStorage(ctx, types.PathQuotasPath) {
+		t.Errorf("cached usage of a removed quota was kept")
+	}
+	keeper.SetParams(ctx, types.Params{PathQuotas: []types.PathQuota{{Path: "a", Limit: 20}}})
+	if got := usage(); got != 5 {
+		t.Errorf("got usage %d of a restored quota, want 5", got)
+	}
+}
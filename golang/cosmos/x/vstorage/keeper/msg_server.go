@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vstorage MsgServer
+// interface for the provided Keeper.  It lets any account write any path, so
+// it is only registered in devmode builds.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) SetStorage(goCtx context.Context, msg *types.MsgSetStorage) (*types.MsgSetStorageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entry := agoric.NewKVEntry(msg.Path, msg.Value)
+	if msg.Value == "" {
+		entry = agoric.NewKVEntryWithNoValue(msg.Path)
+	}
+	k.SetStorageAndNotify(ctx, entry)
+	return &types.MsgSetStorageResponse{}, nil
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// pathQuotaUsage maps the path of each subtree with a quota to the bytes it
+// uses, which are the total length of the paths and values of its entries with
+// data.
+type pathQuotaUsage map[string]uint64
+
+// track updates the usage of the subtrees containing path for the replacement
+// of an entry of oldSize bytes with one of newSize bytes, and returns whether
+// any subtree contains it.
+func (usage pathQuotaUsage) track(quotas []types.PathQuota, path string, oldSize, newSize uint64) bool {
+	tracked := false
+	for _, quota := range quotas {
+		if !types.IsWithinPath(path, quota.Path) {
+			continue
+		}
+		used := usage[quota.Path]
+		if used+newSize < oldSize {
+			used = 0
+		} else {
+			used = used + newSize - oldSize
+		}
+		usage[quota.Path] = used
+		tracked = true
+	}
+	return tracked
+}
+
+// isReservedPath returns whether path is within the quota usage cache, which
+// the bridge cannot write directly.
+func isReservedPath(path string) bool {
+	return types.IsWithinPath(path, types.PathQuotasPath)
+}
+
+// CheckWritablePath returns an error if path is within the quota usage cache,
+// which changes only as vstorage tracks the usage of the subtrees.
+func (k Keeper) CheckWritablePath(path string) error {
+	if isReservedPath(path) {
+		return fmt.Errorf("path %q is reserved for quotas", path)
+	}
+	return nil
+}
+
+// entrySize returns the bytes an entry uses against the quotas of the
+// subtrees containing it.
+func entrySize(entry agoric.KVEntry) uint64 {
+	if !entry.HasValue() {
+		return 0
+	}
+	return uint64(len(entry.Key()) + len(entry.StringValue()))
+}
+
+// GetParams returns the vstorage parameters, which are the defaults until
+// governance sets them.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the vstorage parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetPathQuotaUsage returns the bytes used by the subtree at prefix, if it has
+// a quota.
+func (k Keeper) GetPathQuotaUsage(ctx sdk.Context, prefix string) (uint64, bool) {
+	usage, _ := k.loadPathQuotaUsage(ctx, k.GetParams(ctx).PathQuotas)
+	used, found := usage[prefix]
+	return used, found
+}
+
+// subtreeSize returns the bytes used by the subtree at prefix.
+func (k Keeper) subtreeSize(ctx sdk.Context, prefix string) uint64 {
+	size := entrySize(k.GetEntry(ctx, prefix))
+	err := k.WalkStorageFromPrefix(ctx, prefix, func(entry *types.DataEntry) error {
+		size += uint64(len(prefix) + len(types.PathSeparator) + len(entry.Path) + len(entry.Value))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return size
+}
+
+// loadPathQuotaUsage returns the usage of the subtrees with quotas from the
+// cache, measuring any subtree whose quota is new and dropping any whose quota
+// is gone, and whether the result differs from the cache.
+func (k Keeper) loadPathQuotaUsage(ctx sdk.Context, quotas []types.PathQuota) (pathQuotaUsage, bool) {
+	cached := map[string]string{}
+	if entry := k.GetEntry(ctx, types.PathQuotasPath); entry.HasValue() {
+		if err := json.Unmarshal([]byte(entry.StringValue()), &cached); err != nil {
+			panic(err)
+		}
+	}
+
+	usage := make(pathQuotaUsage, len(quotas))
+	changed := len(cached) != len(quotas)
+	for _, quota := range quotas {
+		encoded, found := cached[quota.Path]
+		if !found {
+			usage[quota.Path] = k.subtreeSize(ctx, quota.Path)
+			changed = true
+			continue
+		}
+		used, err := strconv.ParseUint(encoded, 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		usage[quota.Path] = used
+	}
+	return usage, changed
+}
+
+func (k Keeper) storePathQuotaUsage(ctx sdk.Context, usage pathQuotaUsage) {
+	if len(usage) == 0 {
+		k.setStorage(ctx, agoric.NewKVEntryWithNoValue(types.PathQuotasPath), false)
+		return
+	}
+	encoded := make(map[string]string, len(usage))
+	for prefix, used := range usage {
+		encoded[prefix] = strconv.FormatUint(used, 10)
+	}
+	// Maps are marshalled with sorted keys.
+	bz, err := json.Marshal(encoded)
+	if err != nil {
+		panic(err)
+	}
+	k.setStorage(ctx, agoric.NewKVEntry(types.PathQuotasPath, string(bz)), false)
+}
+
+// CheckStorageQuotas returns an ErrQuotaExceeded error if writing entries in
+// order would make a subtree with a quota use more than its limit, so that a
+// failed write changes nothing. Writes which do not grow a subtree never fail
+// on its account.
+func (k Keeper) CheckStorageQuotas(ctx sdk.Context, entries []agoric.KVEntry) error {
+	quotas := k.GetParams(ctx).PathQuotas
+	if len(quotas) == 0 {
+		return nil
+	}
+	usage, _ := k.loadPathQuotaUsage(ctx, quotas)
+	initial := make(pathQuotaUsage, len(usage))
+	for prefix, used := range usage {
+		initial[prefix] = used
+	}
+
+	// The sizes of the entries as of the writes so far.
+	sizes := map[string]uint64{}
+	for _, entry := range entries {
+		path := entry.Key()
+		if isReservedPath(path) {
+			continue
+		}
+		oldSize, found := sizes[path]
+		if !found {
+			oldSize = entrySize(k.GetEntry(ctx, path))
+		}
+		newSize := entrySize(entry)
+		sizes[path] = newSize
+		usage.track(quotas, path, oldSize, newSize)
+	}
+
+	for _, quota := range quotas {
+		if used := usage[quota.Path]; used > quota.Limit && used > initial[quota.Path] {
+			return sdkioerrors.Wrapf(types.ErrQuotaExceeded,
+				"writing would make %q use %d bytes, beyond its %d byte quota",
+				quota.Path, used, quota.Limit)
+		}
+	}
+	return nil
+}
+
+// trackStorageQuota updates the usage of the subtrees containing path for the
+// replacement of an entry of oldSize bytes with one of newSize bytes.
+func (k Keeper) trackStorageQuota(ctx sdk.Context, path string, oldSize, newSize uint64) {
+	if oldSize == newSize || isReservedPath(path) {
+		return
+	}
+	quotas := k.GetParams(ctx).PathQuotas
+	usage, changed := k.loadPathQuotaUsage(ctx, quotas)
+	if usage.track(quotas, path, oldSize, newSize) || changed {
+		k.storePathQuotaUsage(ctx, usage)
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"sync"
+)
+
+const (
+	// maxWatchers bounds the number of paths watched concurrently across all
+	// subscribers of a node.
+	maxWatchers = 1000
+	// watchBufferSize bounds the changes queued for a watcher which has not
+	// yet received them.
+	watchBufferSize = 64
+)
+
+// DataChange is the value of a path as of the end of a block in which it
+// changed. Value is empty if the path's data was deleted.
+type DataChange struct {
+	BlockHeight int64
+	Value       string
+}
+
+// ChangeNotifier delivers the changes of each block to in-process watchers of
+// individual paths. It is shared by all copies of a Keeper.
+type ChangeNotifier struct {
+	mu       sync.Mutex
+	nextID   uint64
+	count    int
+	watchers map[string]map[uint64]chan DataChange
+}
+
+func NewChangeNotifier() *ChangeNotifier {
+	return &ChangeNotifier{watchers: make(map[string]map[uint64]chan DataChange)}
+}
+
+// Watch returns a channel of the changes to path, and a function to stop
+// watching it. The channel is closed once watching stops, which also happens
+// when the watcher falls watchBufferSize changes behind, so that a slow
+// watcher never stalls the block in which a change is made.
+func (n *ChangeNotifier) Watch(path string) (<-chan DataChange, func(), error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.count >= maxWatchers {
+		return nil, nil, fmt.Errorf("too many watchers; limit is %d", maxWatchers)
+	}
+
+	id := n.nextID
+	n.nextID++
+	ch := make(chan DataChange, watchBufferSize)
+	if n.watchers[path] == nil {
+		n.watchers[path] = make(map[uint64]chan DataChange)
+	}
+	n.watchers[path][id] = ch
+	n.count++
+
+	stop := func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		n.remove(path, id)
+	}
+	return ch, stop, nil
+}
+
+// remove closes and forgets a watcher if it is still present. The caller
+// must hold the lock.
+func (n *ChangeNotifier) remove(path string, id uint64) {
+	ch, ok := n.watchers[path][id]
+	if !ok {
+		return
+	}
+	close(ch)
+	delete(n.watchers[path], id)
+	if len(n.watchers[path]) == 0 {
+		delete(n.watchers, path)
+	}
+	n.count--
+}
+
+// Notify delivers a change of path to its watchers without blocking.
+func (n *ChangeNotifier) Notify(path string, change DataChange) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for id, ch := range n.watchers[path] {
+		select {
+		case ch <- change:
+		default:
+			n.remove(path, id)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package vstorage
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 
@@ -38,6 +39,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registerDevModeInterfaces(registry)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the deployment
@@ -47,13 +49,9 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 
 // Validation check of the Genesis
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	var data types.GenesisState
-	err := cdc.UnmarshalJSON(bz, &data)
-	if err != nil {
-		return err
-	}
-	// Once json successfully marshalled, passes along to genesis.go
-	return ValidateGenesis(&data)
+	// Validate each entry as it is decoded, rather than unmarshalling the
+	// whole tree.
+	return ValidateGenesisStream(bytes.NewReader(bz))
 }
 
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
@@ -67,7 +65,7 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 // Get the root tx command of this module
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return devModeTxCmd()
 }
 
 type AppModule struct {
@@ -106,6 +104,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	querier := keeper.Querier{Keeper: am.keeper}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
+	registerDevModeServices(cfg, am.keeper)
 }
 
 func (AppModule) ConsensusVersion() uint64 { return 1 }
@@ -121,12 +120,16 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState types.GenesisState
-	cdc.MustUnmarshalJSON(data, &genesisState)
-	return InitGenesis(ctx, am.keeper, &genesisState)
+	if err := ImportGenesis(ctx, am.keeper, bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := ExportGenesis(ctx, am.keeper)
-	return cdc.MustMarshalJSON(gs)
+	var buf bytes.Buffer
+	if err := WriteGenesis(ctx, am.keeper, &buf); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
 }
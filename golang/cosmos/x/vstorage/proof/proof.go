@@ -0,0 +1,47 @@
+// Package proof verifies the proofs of vstorage data returned by the
+// /agoric.vstorage.Query/DataWithProof query, so that clients trusting only
+// the app hashes of block headers (such as light clients and bridges) can
+// trust the data of an untrusted node.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// VerifyData returns nil if res proves that the data of path is res.Value in
+// the state whose app hash is appHash. That state is the one at res.Height,
+// and its app hash is committed in the header of block res.Height + 1.
+//
+// An empty value is proven by the absence of the store entry of path, or by an
+// entry without data or with empty data, since the query does not distinguish
+// those.
+func VerifyData(appHash []byte, path string, res *types.QueryDataWithProofResponse) error {
+	if err := types.ValidatePath(path); err != nil {
+		return err
+	}
+	if res == nil || res.Proof == nil {
+		return fmt.Errorf("no proof")
+	}
+	merkleProof, err := commitmenttypes.ConvertProofs(res.Proof)
+	if err != nil {
+		return err
+	}
+	specs := commitmenttypes.GetSDKSpecs()
+	root := commitmenttypes.NewMerkleRoot(appHash)
+	merklePath := commitmenttypes.NewMerklePath(types.StoreKey, string(types.PathToEncodedKey(path)))
+
+	dataValue := bytes.Join([][]byte{types.EncodedDataPrefix, []byte(res.Value)}, []byte{})
+	err = merkleProof.VerifyMembership(specs, root, merklePath, dataValue)
+	if err == nil || res.Value != "" {
+		return err
+	}
+	if merkleProof.VerifyMembership(specs, root, merklePath, types.EncodedNoDataValue) == nil {
+		return nil
+	}
+	return merkleProof.VerifyNonMembership(specs, root, merklePath)
+}
@@ -0,0 +1,139 @@
+package vstorage
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSetStorage = "op_weight_msg_set_storage" //nolint:gosec
+
+	DefaultWeightMsgSetStorage = 100
+)
+
+var TypeMsgSetStorage = sdk.MsgTypeURL(&types.MsgSetStorage{})
+
+var _ module.AppModuleSimulation = AppModuleSimulation{}
+
+// AppModuleSimulation implements module.AppModuleSimulation for x/vstorage,
+// generating randomized storage writes.
+type AppModuleSimulation struct {
+	keeper        Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+}
+
+// NewAppModuleSimulation returns the simulation of x/vstorage for the
+// simulation manager.
+func NewAppModuleSimulation(k Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) AppModuleSimulation {
+	return AppModuleSimulation{keeper: k, accountKeeper: ak, bankKeeper: bk}
+}
+
+// simulationWalletPathPrefix is the prefix of the vstorage paths whose
+// presence makes x/swingset consider a smart wallet provisioned (see
+// GetSmartWalletState in x/swingset/keeper).
+const simulationWalletPathPrefix = "published.wallet."
+
+// GenerateGenesisState creates a randomized GenesisState of x/vstorage, which
+// publishes the smart wallets of some of the simulation accounts (so that
+// their wallet actions are accepted) along with random data.
+func (AppModuleSimulation) GenerateGenesisState(simState *module.SimulationState) {
+	genesis := DefaultGenesisState()
+	for _, acc := range simState.Accounts {
+		if simState.Rand.Intn(2) == 0 {
+			continue
+		}
+		genesis.Data = append(genesis.Data, &types.DataEntry{
+			Path:  simulationWalletPathPrefix + acc.Address.String(),
+			Value: `{"blockHeight":"0","values":[]}`,
+		})
+	}
+	for i := simState.Rand.Intn(20); i > 0; i-- {
+		genesis.Data = append(genesis.Data, &types.DataEntry{
+			Path:  randomSimulationPath(simState.Rand),
+			Value: simtypes.RandStringOfLength(simState.Rand, 1+simState.Rand.Intn(64)),
+		})
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// randomSimulationPath returns a random path under "simulation".
+func randomSimulationPath(r *rand.Rand) string {
+	return fmt.Sprintf("simulation.path%d.leaf%d", r.Intn(10), r.Intn(10))
+}
+
+// ProposalContents returns no governance proposal contents.
+func (AppModuleSimulation) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams returns no param changes, since x/vstorage has no params.
+func (AppModuleSimulation) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers no decoders, since x/vstorage values are
+// plain strings.
+func (AppModuleSimulation) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the x/vstorage operations with their weights.
+// There are none unless MsgSetStorage is enabled by the devmode build tag.
+func (am AppModuleSimulation) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	if !DevMode {
+		return nil
+	}
+	var weightMsgSetStorage int
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgSetStorage, &weightMsgSetStorage, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetStorage = DefaultWeightMsgSetStorage
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSetStorage,
+			SimulateMsgSetStorage(am.accountKeeper, am.bankKeeper),
+		),
+	}
+}
+
+// SimulateMsgSetStorage generates a MsgSetStorage writing a random value to a
+// random path under "simulation".
+func SimulateMsgSetStorage(ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		submitter, _ := simtypes.RandomAcc(r, accs)
+		path := randomSimulationPath(r)
+		value := ""
+		if r.Intn(4) > 0 {
+			value = simtypes.RandStringOfLength(r, 1+r.Intn(64))
+		}
+		msg := types.NewMsgSetStorage(submitter.Address, path, value)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Msg:           msg,
+			MsgType:       TypeMsgSetStorage,
+			Context:       ctx,
+			SimAccount:    submitter,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
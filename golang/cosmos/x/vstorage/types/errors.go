@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+)
+
+// x/vstorage module sentinel errors
+var (
+	ErrQuotaExceeded    = sdkioerrors.Register(ModuleName, 2, "storage quota exceeded")
+	ErrProofUnavailable = sdkioerrors.Register(ModuleName, 3, "proof unavailable")
+)
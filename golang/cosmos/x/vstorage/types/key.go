@@ -7,3 +7,8 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 )
+
+// PathQuotasPath is the path at which vstorage caches the bytes used by each
+// subtree with a quota in Params, as a JSON record such as
+// `{"published.wallet": "1234"}`.
+const PathQuotasPath = "pathQuotas"
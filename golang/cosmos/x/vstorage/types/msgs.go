@@ -1,3 +1,47 @@
 package types
 
+import (
+	sdkioerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 const RouterKey = ModuleName
+
+var _ sdk.Msg = &MsgSetStorage{}
+
+func NewMsgSetStorage(submitter sdk.AccAddress, path, value string) *MsgSetStorage {
+	return &MsgSetStorage{
+		Submitter: submitter,
+		Path:      path,
+		Value:     value,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetStorage) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetStorage) Type() string { return "set_storage" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetStorage) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidAddress, "Submitter address cannot be empty")
+	}
+	if err := ValidatePath(msg.Path); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetStorage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetStorage) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
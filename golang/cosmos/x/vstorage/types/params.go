@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	ParamStoreKeyPathQuotas = []byte("path_quotas")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default parameters, which limit no subtree.
+func DefaultParams() Params {
+	return Params{
+		PathQuotas: []PathQuota{},
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyPathQuotas, &p.PathQuotas, validatePathQuotas),
+	}
+}
+
+// ValidateBasic performs basic validation on vstorage parameters.
+func (p Params) ValidateBasic() error {
+	return validatePathQuotas(p.PathQuotas)
+}
+
+// IsWithinPath returns whether path is prefix or within the subtree at prefix.
+func IsWithinPath(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+PathSeparator)
+}
+
+func validatePathQuotas(i interface{}) error {
+	v, ok := i.([]PathQuota)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, quota := range v {
+		if quota.Path == "" {
+			return fmt.Errorf("cannot limit the root path")
+		}
+		if err := ValidatePath(quota.Path); err != nil {
+			return fmt.Errorf("invalid quota path %q: %w", quota.Path, err)
+		}
+		if IsWithinPath(quota.Path, PathQuotasPath) {
+			return fmt.Errorf("cannot limit the reserved path %q", quota.Path)
+		}
+		if seen[quota.Path] {
+			return fmt.Errorf("duplicate quota for %q", quota.Path)
+		}
+		seen[quota.Path] = true
+		if quota.Limit == 0 {
+			return fmt.Errorf("quota for %q must be positive", quota.Path)
+		}
+	}
+	return nil
+}
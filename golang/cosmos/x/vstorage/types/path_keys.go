@@ -41,6 +41,9 @@ var (
 	EncodedNoDataValue  = []byte{255}
 )
 
+// PathWildcard is a path pattern segment that matches any single path segment.
+const PathWildcard = "*"
+
 // EncodedKeyToPath converts a byte slice key to a string path
 func EncodedKeyToPath(key []byte) string {
 	// Split the key into its path depth and path components.
@@ -72,6 +75,21 @@ func ValidatePath(path string) error {
 	return fmt.Errorf("path %q contains invalid characters", path)
 }
 
+// ValidatePathPattern checks that a path pattern is a path in which any
+// segment may instead be PathWildcard.
+func ValidatePathPattern(pattern string) error {
+	segments := strings.Split(pattern, PathSeparator)
+	for i, segment := range segments {
+		if segment == PathWildcard {
+			segments[i] = "x"
+		}
+	}
+	if err := ValidatePath(strings.Join(segments, PathSeparator)); err != nil {
+		return fmt.Errorf("invalid path pattern %q: %w", pattern, err)
+	}
+	return nil
+}
+
 // PathToEncodedKey converts a path to a byte slice key
 func PathToEncodedKey(path string) []byte {
 	if err := ValidatePath(path); err != nil {
@@ -105,3 +105,32 @@ func Test_Key_Encoding(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidatePathPattern(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		errContains string
+	}{
+		{pattern: "published"},
+		{pattern: "*"},
+		{pattern: "published.vaultFactory.managers.*.quotes"},
+		{pattern: "*.*"},
+		{pattern: "published.**", errContains: "invalid characters"},
+		{pattern: "published.*x", errContains: "invalid characters"},
+		{pattern: "published..*", errContains: "doubled separators"},
+		{pattern: "*.", errContains: "ends with separator"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			err := ValidatePathPattern(tt.pattern)
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("ValidatePathPattern(%q) = %v, want nil", tt.pattern, err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("ValidatePathPattern(%q) = %v, want error containing %q", tt.pattern, err, tt.errContains)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -120,6 +121,218 @@ func (m *QueryDataResponse) GetValue() string {
 	return ""
 }
 
+// QueryDataWithProofRequest is the vstorage path data query with proof.
+type QueryDataWithProofRequest struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
+}
+
+func (m *QueryDataWithProofRequest) Reset()         { *m = QueryDataWithProofRequest{} }
+func (m *QueryDataWithProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataWithProofRequest) ProtoMessage()    {}
+func (*QueryDataWithProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{2}
+}
+func (m *QueryDataWithProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataWithProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataWithProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataWithProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataWithProofRequest.Merge(m, src)
+}
+func (m *QueryDataWithProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataWithProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataWithProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataWithProofRequest proto.InternalMessageInfo
+
+func (m *QueryDataWithProofRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// QueryDataWithProofResponse is the vstorage path data response with proof.
+type QueryDataWithProofResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"value"`
+	// The ICS-23 proof of the value (or of its absence) in the vstorage store,
+	// chained to the app hash of the state at height, which is committed in the
+	// header of the next block.
+	Proof  *crypto.ProofOps `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof" yaml:"proof"`
+	Height int64            `protobuf:"varint,3,opt,name=height,proto3" json:"height" yaml:"height"`
+}
+
+func (m *QueryDataWithProofResponse) Reset()         { *m = QueryDataWithProofResponse{} }
+func (m *QueryDataWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataWithProofResponse) ProtoMessage()    {}
+func (*QueryDataWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{3}
+}
+func (m *QueryDataWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataWithProofResponse.Merge(m, src)
+}
+func (m *QueryDataWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataWithProofResponse proto.InternalMessageInfo
+
+func (m *QueryDataWithProofResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *QueryDataWithProofResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryDataWithProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryDataMultiRequest is the vstorage multiple path data query.
+// Exactly one of paths and pattern must be provided.
+type QueryDataMultiRequest struct {
+	// Paths to read, each reported in order whether or not it has data.
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths" yaml:"paths"`
+	// A path in which any segment may be "*" to match every child at that
+	// position, e.g. "published.vaultFactory.managers.*.quotes". Only matching
+	// paths that have data are reported.
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern" yaml:"pattern"`
+}
+
+func (m *QueryDataMultiRequest) Reset()         { *m = QueryDataMultiRequest{} }
+func (m *QueryDataMultiRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataMultiRequest) ProtoMessage()    {}
+func (*QueryDataMultiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{4}
+}
+func (m *QueryDataMultiRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataMultiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataMultiRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataMultiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataMultiRequest.Merge(m, src)
+}
+func (m *QueryDataMultiRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataMultiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataMultiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataMultiRequest proto.InternalMessageInfo
+
+func (m *QueryDataMultiRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *QueryDataMultiRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+// QueryDataMultiResponse is the vstorage multiple path data response.
+type QueryDataMultiResponse struct {
+	Entries []*DataEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+}
+
+func (m *QueryDataMultiResponse) Reset()         { *m = QueryDataMultiResponse{} }
+func (m *QueryDataMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataMultiResponse) ProtoMessage()    {}
+func (*QueryDataMultiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{5}
+}
+func (m *QueryDataMultiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataMultiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataMultiResponse.Merge(m, src)
+}
+func (m *QueryDataMultiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataMultiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataMultiResponse proto.InternalMessageInfo
+
+func (m *QueryDataMultiResponse) GetEntries() []*DataEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // QueryCapDataRequest contains a path and formatting configuration.
 type QueryCapDataRequest struct {
 	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
@@ -144,7 +357,7 @@ func (m *QueryCapDataRequest) Reset()         { *m = QueryCapDataRequest{} }
 func (m *QueryCapDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCapDataRequest) ProtoMessage()    {}
 func (*QueryCapDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a26d6d1a170e94ae, []int{2}
+	return fileDescriptor_a26d6d1a170e94ae, []int{6}
 }
 func (m *QueryCapDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +425,7 @@ func (m *QueryCapDataResponse) Reset()         { *m = QueryCapDataResponse{} }
 func (m *QueryCapDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCapDataResponse) ProtoMessage()    {}
 func (*QueryCapDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a26d6d1a170e94ae, []int{3}
+	return fileDescriptor_a26d6d1a170e94ae, []int{7}
 }
 func (m *QueryCapDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +478,7 @@ func (m *QueryChildrenRequest) Reset()         { *m = QueryChildrenRequest{} }
 func (m *QueryChildrenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryChildrenRequest) ProtoMessage()    {}
 func (*QueryChildrenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a26d6d1a170e94ae, []int{4}
+	return fileDescriptor_a26d6d1a170e94ae, []int{8}
 }
 func (m *QueryChildrenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +531,7 @@ func (m *QueryChildrenResponse) Reset()         { *m = QueryChildrenResponse{} }
 func (m *QueryChildrenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChildrenResponse) ProtoMessage()    {}
 func (*QueryChildrenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a26d6d1a170e94ae, []int{5}
+	return fileDescriptor_a26d6d1a170e94ae, []int{9}
 }
 func (m *QueryChildrenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,579 +574,2944 @@ func (m *QueryChildrenResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDataRequest)(nil), "agoric.vstorage.QueryDataRequest")
-	proto.RegisterType((*QueryDataResponse)(nil), "agoric.vstorage.QueryDataResponse")
-	proto.RegisterType((*QueryCapDataRequest)(nil), "agoric.vstorage.QueryCapDataRequest")
-	proto.RegisterType((*QueryCapDataResponse)(nil), "agoric.vstorage.QueryCapDataResponse")
-	proto.RegisterType((*QueryChildrenRequest)(nil), "agoric.vstorage.QueryChildrenRequest")
-	proto.RegisterType((*QueryChildrenResponse)(nil), "agoric.vstorage.QueryChildrenResponse")
+// QueryExportRequest is the vstorage path prefix export query.
+type QueryExportRequest struct {
+	Path       string             `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("agoric/vstorage/query.proto", fileDescriptor_a26d6d1a170e94ae) }
-
-var fileDescriptor_a26d6d1a170e94ae = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xa6, 0xfc, 0x50, 0x98, 0x25, 0x01, 0x46, 0xd4, 0x75, 0x21, 0x1d, 0x18, 0xf9, 0x15, 0x8d,
-	0x9d, 0x80, 0x07, 0x13, 0x39, 0xa8, 0x48, 0x90, 0xa3, 0x36, 0xea, 0xc1, 0xcb, 0x66, 0x76, 0x77,
-	0xec, 0x36, 0xb4, 0x9d, 0xd2, 0xce, 0x12, 0x37, 0xc6, 0x98, 0xc8, 0xd1, 0x8b, 0xc6, 0xb3, 0x7f,
-	0x88, 0xff, 0x81, 0x47, 0x12, 0x2f, 0x9e, 0x1a, 0x03, 0x9e, 0x7a, 0xdc, 0xbf, 0xc0, 0x74, 0x66,
-	0xb6, 0x5b, 0x96, 0x55, 0x0c, 0xb7, 0xce, 0xf7, 0xbe, 0xf7, 0xbd, 0xaf, 0xf3, 0xde, 0x3c, 0x30,
-	0x47, 0x1d, 0x1e, 0xb9, 0x75, 0x72, 0x10, 0x0b, 0x1e, 0x51, 0x87, 0x91, 0xfd, 0x16, 0x8b, 0xda,
-	0x56, 0x18, 0x71, 0xc1, 0xe1, 0x94, 0x0a, 0x5a, 0xdd, 0x60, 0x65, 0xd6, 0xe1, 0x0e, 0x97, 0x31,
-	0x92, 0x7d, 0x29, 0x5a, 0xe5, 0x56, 0x9d, 0xc7, 0x3e, 0x8f, 0x49, 0x8d, 0xc6, 0x3a, 0x9f, 0x1c,
-	0xac, 0xd7, 0x98, 0xa0, 0xeb, 0x24, 0xa4, 0x8e, 0x1b, 0x50, 0xe1, 0xf2, 0x40, 0x73, 0xe7, 0x1d,
-	0xce, 0x1d, 0x8f, 0x11, 0x1a, 0xba, 0x84, 0x06, 0x01, 0x17, 0x32, 0x18, 0xab, 0x28, 0x7e, 0x00,
-	0xa6, 0x9f, 0x65, 0xf9, 0xdb, 0x54, 0x50, 0x9b, 0xed, 0xb7, 0x58, 0x2c, 0xe0, 0x6d, 0x30, 0x1a,
-	0x52, 0xd1, 0x2c, 0x1b, 0x0b, 0xc6, 0xda, 0xc4, 0xd6, 0xf5, 0x34, 0x41, 0xf2, 0xdc, 0x49, 0x50,
-	0xa9, 0x4d, 0x7d, 0xef, 0x3e, 0xce, 0x4e, 0xd8, 0x96, 0x20, 0xde, 0x06, 0x33, 0x05, 0x81, 0x38,
-	0xe4, 0x41, 0xcc, 0x20, 0x01, 0x63, 0x07, 0xd4, 0x6b, 0x31, 0x2d, 0x71, 0x23, 0x4d, 0x90, 0x02,
-	0x3a, 0x09, 0x9a, 0x54, 0x1a, 0xf2, 0x88, 0x6d, 0x05, 0xe3, 0x6f, 0xc3, 0xe0, 0x8a, 0x94, 0x79,
-	0x4c, 0xc3, 0x8b, 0x5a, 0x81, 0x0f, 0x01, 0xf0, 0x59, 0xc3, 0xa5, 0x55, 0xd1, 0x0e, 0x59, 0x79,
-	0x58, 0xa6, 0x2c, 0xa6, 0x09, 0x9a, 0x90, 0xe8, 0xf3, 0x76, 0x98, 0x95, 0x9f, 0x56, 0x79, 0x39,
-	0x84, 0xed, 0x5e, 0x18, 0x6e, 0x83, 0x92, 0x2b, 0x98, 0x5f, 0x7d, 0xcd, 0x23, 0x9f, 0x8a, 0xf2,
-	0x88, 0x94, 0xb8, 0x99, 0x26, 0x08, 0x64, 0xf0, 0x8e, 0x44, 0x3b, 0x09, 0x9a, 0x51, 0x1a, 0x3d,
-	0x0c, 0xdb, 0x05, 0x02, 0xf4, 0xc1, 0xb5, 0x88, 0xf9, 0x5c, 0xd0, 0x9a, 0xc7, 0xaa, 0xf2, 0xff,
-	0xba, 0x82, 0x40, 0x0a, 0xde, 0x4b, 0x13, 0x34, 0x9b, 0x33, 0x5e, 0x66, 0x84, 0x5c, 0x7a, 0x4e,
-	0x49, 0x0f, 0x8a, 0x62, 0x7b, 0x60, 0x12, 0xfe, 0x6c, 0x80, 0xd9, 0xd3, 0x77, 0xa7, 0xbb, 0xb0,
-	0x0b, 0x26, 0x6b, 0x1e, 0xaf, 0xef, 0x55, 0x9b, 0xcc, 0x75, 0x9a, 0x42, 0x5f, 0xe2, 0x72, 0x9a,
-	0xa0, 0x92, 0xc4, 0x77, 0x25, 0xdc, 0x49, 0x10, 0x54, 0x45, 0x0b, 0x20, 0xb6, 0x8b, 0x94, 0x5e,
-	0x3f, 0xc1, 0x7f, 0xf6, 0xf3, 0x63, 0xee, 0xa9, 0xe9, 0x7a, 0x8d, 0x88, 0x05, 0x17, 0x6a, 0xe8,
-	0x0e, 0x00, 0xbd, 0x71, 0x96, 0x0d, 0x2d, 0x6d, 0xac, 0x58, 0x6a, 0xf6, 0xad, 0x6c, 0xf6, 0x2d,
-	0xf5, 0x76, 0xf4, 0xec, 0x5b, 0x4f, 0xa9, 0xc3, 0x74, 0x21, 0xbb, 0x90, 0x89, 0xbf, 0x1a, 0xe0,
-	0x6a, 0x9f, 0x1b, 0x7d, 0x45, 0x9b, 0x60, 0xbc, 0xae, 0xb1, 0xb2, 0xb1, 0x30, 0xb2, 0x36, 0xb1,
-	0x85, 0xd2, 0x04, 0xe5, 0x58, 0x27, 0x41, 0x53, 0xca, 0x56, 0x17, 0xc1, 0x76, 0x1e, 0x84, 0x4f,
-	0x06, 0xd8, 0x5b, 0x3d, 0xd7, 0x9e, 0xaa, 0x5c, 0xf4, 0xb7, 0x71, 0x38, 0x02, 0xc6, 0xa4, 0x3f,
-	0x18, 0x83, 0xd1, 0xac, 0x85, 0x70, 0xd1, 0xea, 0x5b, 0x04, 0x56, 0xff, 0x2b, 0xad, 0xe0, 0x7f,
-	0x51, 0x54, 0x11, 0xbc, 0xf4, 0xe1, 0xc7, 0xef, 0x2f, 0xc3, 0x26, 0x9c, 0x27, 0xfd, 0x4b, 0xa7,
-	0x41, 0x05, 0x25, 0x6f, 0xb3, 0x5b, 0x7e, 0x07, 0xdf, 0x83, 0xcb, 0x7a, 0x74, 0xe0, 0xd2, 0x60,
-	0xd1, 0xd3, 0xaf, 0xb2, 0xb2, 0x7c, 0x0e, 0x4b, 0x57, 0x5f, 0x95, 0xd5, 0x17, 0x21, 0x3a, 0x53,
-	0xbd, 0x4e, 0xc3, 0xa2, 0x81, 0x43, 0x03, 0x8c, 0x77, 0x5b, 0x03, 0xff, 0x26, 0x7e, 0x7a, 0x90,
-	0x2a, 0x2b, 0xe7, 0xd1, 0xb4, 0x89, 0x35, 0x69, 0x02, 0xc3, 0x85, 0xb3, 0x26, 0x34, 0x55, 0xbb,
-	0xd8, 0x7a, 0xf1, 0xfd, 0xd8, 0x34, 0x8e, 0x8e, 0x4d, 0xe3, 0xd7, 0xb1, 0x69, 0x7c, 0x3a, 0x31,
-	0x87, 0x8e, 0x4e, 0xcc, 0xa1, 0x9f, 0x27, 0xe6, 0xd0, 0xab, 0x4d, 0xc7, 0x15, 0xcd, 0x56, 0xcd,
-	0xaa, 0x73, 0x9f, 0x3c, 0x52, 0x2a, 0x4a, 0xec, 0x4e, 0xdc, 0xd8, 0x23, 0x0e, 0xf7, 0x68, 0xe0,
-	0x10, 0xbd, 0x92, 0xdf, 0xf4, 0x0a, 0x64, 0x6b, 0x28, 0xae, 0x5d, 0x92, 0x8b, 0xf6, 0xee, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x08, 0x4f, 0xc5, 0xf8, 0x05, 0x00, 0x00,
+func (m *QueryExportRequest) Reset()         { *m = QueryExportRequest{} }
+func (m *QueryExportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExportRequest) ProtoMessage()    {}
+func (*QueryExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{10}
+}
+func (m *QueryExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportRequest.Merge(m, src)
+}
+func (m *QueryExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryExportRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Return the raw string value of an arbitrary vstorage datum.
-	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
-	// Return a formatted representation of a vstorage datum that must be
-	// a valid StreamCell with CapData values, or standalone CapData.
-	CapData(ctx context.Context, in *QueryCapDataRequest, opts ...grpc.CallOption) (*QueryCapDataResponse, error)
-	// Return the children of a given vstorage path.
-	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
+func (m *QueryExportRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryExportRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryExportResponse is the vstorage path prefix export response.
+type QueryExportResponse struct {
+	// Entries with data at or beneath the requested path, identified by their
+	// full paths.
+	Entries    []*DataEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
-	out := new(QueryDataResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/Data", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryExportResponse) Reset()         { *m = QueryExportResponse{} }
+func (m *QueryExportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExportResponse) ProtoMessage()    {}
+func (*QueryExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{11}
+}
+func (m *QueryExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExportResponse.Merge(m, src)
+}
+func (m *QueryExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExportResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CapData(ctx context.Context, in *QueryCapDataRequest, opts ...grpc.CallOption) (*QueryCapDataResponse, error) {
-	out := new(QueryCapDataResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/CapData", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExportResponse proto.InternalMessageInfo
+
+func (m *QueryExportResponse) GetEntries() []*DataEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
-	out := new(QueryChildrenResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/Children", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryExportResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Return the raw string value of an arbitrary vstorage datum.
-	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
-	// Return a formatted representation of a vstorage datum that must be
-	// a valid StreamCell with CapData values, or standalone CapData.
-	CapData(context.Context, *QueryCapDataRequest) (*QueryCapDataResponse, error)
-	// Return the children of a given vstorage path.
-	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
+// QueryWatchDataRequest is the vstorage path data subscription.
+type QueryWatchDataRequest struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryWatchDataRequest) Reset()         { *m = QueryWatchDataRequest{} }
+func (m *QueryWatchDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchDataRequest) ProtoMessage()    {}
+func (*QueryWatchDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{12}
 }
-
-func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
+func (m *QueryWatchDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CapData(ctx context.Context, req *QueryCapDataRequest) (*QueryCapDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CapData not implemented")
+func (m *QueryWatchDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
+func (m *QueryWatchDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchDataRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryWatchDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchDataRequest.DiscardUnknown(m)
 }
 
-func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Data(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vstorage.Query/Data",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
+var xxx_messageInfo_QueryWatchDataRequest proto.InternalMessageInfo
+
+func (m *QueryWatchDataRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CapData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCapDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CapData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vstorage.Query/CapData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CapData(ctx, req.(*QueryCapDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryWatchDataResponse is a changed value of the watched vstorage path, as
+// of the end of a block.
+type QueryWatchDataResponse struct {
+	BlockHeight string `protobuf:"bytes,1,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	// value is empty if the path's data was deleted.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value" yaml:"value"`
 }
 
-func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryChildrenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Children(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vstorage.Query/Children",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+func (m *QueryWatchDataResponse) Reset()         { *m = QueryWatchDataResponse{} }
+func (m *QueryWatchDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchDataResponse) ProtoMessage()    {}
+func (*QueryWatchDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{13}
+}
+func (m *QueryWatchDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.vstorage.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Data",
-			Handler:    _Query_Data_Handler,
-		},
-		{
-			MethodName: "CapData",
-			Handler:    _Query_CapData_Handler,
-		},
-		{
-			MethodName: "Children",
-			Handler:    _Query_Children_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/vstorage/query.proto",
+func (m *QueryWatchDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchDataResponse.Merge(m, src)
+}
+func (m *QueryWatchDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchDataResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWatchDataResponse proto.InternalMessageInfo
+
+func (m *QueryWatchDataResponse) GetBlockHeight() string {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWatchDataResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryHistoryRequest is the vstorage path stream cell history query.
+type QueryHistoryRequest struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
+	// The lowest block height of cells to return.
+	MinBlockHeight int64 `protobuf:"varint,2,opt,name=min_block_height,json=minBlockHeight,proto3" json:"minBlockHeight" yaml:"minBlockHeight"`
+	// The highest block height of cells to return, or zero for no bound.
+	MaxBlockHeight int64 `protobuf:"varint,3,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"maxBlockHeight" yaml:"maxBlockHeight"`
 }
 
-func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHistoryRequest) Reset()         { *m = QueryHistoryRequest{} }
+func (m *QueryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryRequest) ProtoMessage()    {}
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{14}
+}
+func (m *QueryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryRequest.Merge(m, src)
+}
+func (m *QueryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryHistoryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHistoryRequest) GetMinBlockHeight() int64 {
+	if m != nil {
+		return m.MinBlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCapDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHistoryRequest) GetMaxBlockHeight() int64 {
+	if m != nil {
+		return m.MaxBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCapDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HistoricalCell is the stream cell of a path as of the end of a block.
+type HistoricalCell struct {
+	BlockHeight string `protobuf:"bytes,1,opt,name=block_height,json=blockHeight,proto3" json:"blockHeight" yaml:"blockHeight"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value" yaml:"value"`
 }
 
-func (m *QueryCapDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RemotableValueFormat) > 0 {
-		i -= len(m.RemotableValueFormat)
-		copy(dAtA[i:], m.RemotableValueFormat)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RemotableValueFormat)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ItemFormat) > 0 {
-		i -= len(m.ItemFormat)
-		copy(dAtA[i:], m.ItemFormat)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemFormat)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MediaType) > 0 {
-		i -= len(m.MediaType)
-		copy(dAtA[i:], m.MediaType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MediaType)))
-		i--
-		dAtA[i] = 0x12
+func (m *HistoricalCell) Reset()         { *m = HistoricalCell{} }
+func (m *HistoricalCell) String() string { return proto.CompactTextString(m) }
+func (*HistoricalCell) ProtoMessage()    {}
+func (*HistoricalCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{15}
+}
+func (m *HistoricalCell) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalCell.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *HistoricalCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalCell.Merge(m, src)
+}
+func (m *HistoricalCell) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalCell proto.InternalMessageInfo
+
+func (m *HistoricalCell) GetBlockHeight() string {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCapDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HistoricalCell) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCapDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHistoryResponse is the vstorage path stream cell history response.
+type QueryHistoryResponse struct {
+	// Cells in ascending order of block height, of which there are at most
+	// 1000; to read more, repeat the query with a min_block_height just above
+	// that of the last cell.
+	Cells []*HistoricalCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells" yaml:"cells"`
 }
 
-func (m *QueryCapDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.BlockHeight) > 0 {
-		i -= len(m.BlockHeight)
-		copy(dAtA[i:], m.BlockHeight)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHeight)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHistoryResponse) Reset()         { *m = QueryHistoryResponse{} }
+func (m *QueryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryResponse) ProtoMessage()    {}
+func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26d6d1a170e94ae, []int{16}
+}
+func (m *QueryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryResponse.Merge(m, src)
+}
+func (m *QueryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryHistoryResponse) GetCells() []*HistoricalCell {
+	if m != nil {
+		return m.Cells
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryDataRequest)(nil), "agoric.vstorage.QueryDataRequest")
+	proto.RegisterType((*QueryDataResponse)(nil), "agoric.vstorage.QueryDataResponse")
+	proto.RegisterType((*QueryDataWithProofRequest)(nil), "agoric.vstorage.QueryDataWithProofRequest")
+	proto.RegisterType((*QueryDataWithProofResponse)(nil), "agoric.vstorage.QueryDataWithProofResponse")
+	proto.RegisterType((*QueryDataMultiRequest)(nil), "agoric.vstorage.QueryDataMultiRequest")
+	proto.RegisterType((*QueryDataMultiResponse)(nil), "agoric.vstorage.QueryDataMultiResponse")
+	proto.RegisterType((*QueryCapDataRequest)(nil), "agoric.vstorage.QueryCapDataRequest")
+	proto.RegisterType((*QueryCapDataResponse)(nil), "agoric.vstorage.QueryCapDataResponse")
+	proto.RegisterType((*QueryChildrenRequest)(nil), "agoric.vstorage.QueryChildrenRequest")
+	proto.RegisterType((*QueryChildrenResponse)(nil), "agoric.vstorage.QueryChildrenResponse")
+	proto.RegisterType((*QueryExportRequest)(nil), "agoric.vstorage.QueryExportRequest")
+	proto.RegisterType((*QueryExportResponse)(nil), "agoric.vstorage.QueryExportResponse")
+	proto.RegisterType((*QueryWatchDataRequest)(nil), "agoric.vstorage.QueryWatchDataRequest")
+	proto.RegisterType((*QueryWatchDataResponse)(nil), "agoric.vstorage.QueryWatchDataResponse")
+	proto.RegisterType((*QueryHistoryRequest)(nil), "agoric.vstorage.QueryHistoryRequest")
+	proto.RegisterType((*HistoricalCell)(nil), "agoric.vstorage.HistoricalCell")
+	proto.RegisterType((*QueryHistoryResponse)(nil), "agoric.vstorage.QueryHistoryResponse")
 }
 
-func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("agoric/vstorage/query.proto", fileDescriptor_a26d6d1a170e94ae) }
+
+var fileDescriptor_a26d6d1a170e94ae = []byte{
+	// 1173 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xee, 0x26, 0xcd, 0x87, 0xdf, 0xb4, 0x69, 0x3a, 0x4d, 0x43, 0xba, 0x69, 0xbc, 0xce, 0xe4,
+	0x93, 0x46, 0xd9, 0xa5, 0xe9, 0xa1, 0x12, 0x3d, 0x00, 0x6e, 0x5a, 0x72, 0x00, 0x11, 0x16, 0x4a,
+	0x25, 0x0e, 0x44, 0x63, 0x67, 0xba, 0x5e, 0x75, 0xbf, 0xba, 0x3b, 0x09, 0xb1, 0x2a, 0x54, 0x05,
+	0x4e, 0xa8, 0x17, 0x50, 0x4f, 0x1c, 0xf8, 0x15, 0x9c, 0xf8, 0x07, 0x1c, 0x23, 0x71, 0xe1, 0xb4,
+	0x42, 0x09, 0x27, 0x9f, 0x90, 0x7f, 0x01, 0xda, 0x99, 0xd9, 0xb5, 0xd7, 0xb1, 0xeb, 0xc8, 0xa2,
+	0xea, 0x29, 0xd9, 0xe7, 0x7d, 0xe7, 0x79, 0x9e, 0x79, 0x67, 0xde, 0x99, 0x31, 0xcc, 0x11, 0xcb,
+	0x0f, 0xed, 0xaa, 0x71, 0x10, 0x31, 0x3f, 0x24, 0x16, 0x35, 0x9e, 0xed, 0xd3, 0xb0, 0xae, 0x07,
+	0xa1, 0xcf, 0x7c, 0x74, 0x45, 0x04, 0xf5, 0x34, 0xa8, 0x4e, 0x5b, 0xbe, 0xe5, 0xf3, 0x98, 0x91,
+	0xfc, 0x27, 0xd2, 0xd4, 0xf9, 0x4e, 0x0e, 0x8b, 0x7a, 0x34, 0xb2, 0x23, 0x19, 0xbe, 0x55, 0xf5,
+	0x23, 0xd7, 0x8f, 0x8c, 0x0a, 0x89, 0x24, 0xbd, 0x71, 0x70, 0xbb, 0x42, 0x19, 0xb9, 0x6d, 0x04,
+	0xc4, 0xb2, 0x3d, 0xc2, 0x6c, 0xdf, 0x93, 0xb9, 0x37, 0x2d, 0xdf, 0xb7, 0x1c, 0x6a, 0x90, 0xc0,
+	0x36, 0x88, 0xe7, 0xf9, 0x8c, 0x07, 0x53, 0xa6, 0x79, 0x46, 0xbd, 0x3d, 0x1a, 0xba, 0xb6, 0xc7,
+	0x8c, 0x6a, 0x58, 0x0f, 0x98, 0x6f, 0x04, 0xa1, 0xef, 0x3f, 0x11, 0x61, 0xfc, 0x01, 0x4c, 0x7d,
+	0x9e, 0xd0, 0x6f, 0x11, 0x46, 0x4c, 0xfa, 0x6c, 0x9f, 0x46, 0x0c, 0xad, 0xc3, 0xc5, 0x80, 0xb0,
+	0xda, 0xac, 0x52, 0x52, 0xd6, 0x0a, 0xe5, 0x77, 0x1a, 0xb1, 0xc6, 0xbf, 0x9b, 0xb1, 0x36, 0x51,
+	0x27, 0xae, 0xf3, 0x3e, 0x4e, 0xbe, 0xb0, 0xc9, 0x41, 0xbc, 0x05, 0x57, 0xdb, 0x08, 0xa2, 0xc0,
+	0xf7, 0x22, 0x8a, 0x0c, 0x18, 0x39, 0x20, 0xce, 0x3e, 0x95, 0x14, 0x37, 0x1a, 0xb1, 0x26, 0x80,
+	0x66, 0xac, 0x5d, 0x12, 0x1c, 0xfc, 0x13, 0x9b, 0x02, 0xc6, 0xdb, 0x70, 0x23, 0x63, 0x79, 0x6c,
+	0xb3, 0xda, 0x4e, 0x62, 0x71, 0x20, 0x3f, 0xc7, 0x0a, 0xa8, 0xdd, 0xa8, 0x06, 0x74, 0x86, 0x3e,
+	0x81, 0x11, 0x5e, 0xaf, 0xd9, 0xa1, 0x92, 0xb2, 0x36, 0xb1, 0x39, 0xa7, 0xb7, 0xea, 0xa9, 0x8b,
+	0x7a, 0xea, 0x5c, 0xe1, 0xb3, 0x20, 0x12, 0x6c, 0x3c, 0xbb, 0xc5, 0xc6, 0x3f, 0xb1, 0x29, 0x60,
+	0x74, 0x07, 0x46, 0x6b, 0xd4, 0xb6, 0x6a, 0x6c, 0x76, 0xb8, 0xa4, 0xac, 0x0d, 0x97, 0xe7, 0x1a,
+	0xb1, 0x26, 0x91, 0x66, 0xac, 0x5d, 0x16, 0x43, 0xc4, 0x37, 0x36, 0x65, 0x00, 0x1f, 0x29, 0x70,
+	0x3d, 0x9b, 0xd2, 0xa7, 0xfb, 0x0e, 0xb3, 0xd3, 0xca, 0x18, 0x30, 0x92, 0x4c, 0x3a, 0x9a, 0x55,
+	0x4a, 0xc3, 0xe9, 0x6c, 0x38, 0xd0, 0xa6, 0x9f, 0x7c, 0x26, 0xfa, 0xc9, 0x5f, 0x74, 0x17, 0xc6,
+	0x02, 0xc2, 0x18, 0x0d, 0x3d, 0x3e, 0x9f, 0x42, 0x79, 0xbe, 0x11, 0x6b, 0x29, 0xd4, 0x8c, 0xb5,
+	0xc9, 0x6c, 0x50, 0x02, 0x60, 0x33, 0x0d, 0x61, 0x17, 0x66, 0x3a, 0x2d, 0xc8, 0x8a, 0x7e, 0x01,
+	0x63, 0xd4, 0x63, 0xa1, 0x4d, 0x85, 0x8b, 0x89, 0x4d, 0x55, 0xef, 0x68, 0x01, 0x3d, 0x19, 0xf4,
+	0xc0, 0x63, 0x61, 0x5d, 0xc8, 0xc9, 0xf4, 0x96, 0x9c, 0x04, 0xb0, 0x99, 0x86, 0xf0, 0xef, 0x43,
+	0x70, 0x8d, 0xeb, 0xdd, 0x27, 0xc1, 0xa0, 0x5b, 0x13, 0x7d, 0x08, 0xe0, 0xd2, 0x3d, 0x9b, 0xec,
+	0xb2, 0x7a, 0x40, 0xe5, 0x7c, 0x17, 0x1a, 0xb1, 0x56, 0xe0, 0xe8, 0x97, 0xf5, 0x20, 0x59, 0xf4,
+	0x29, 0x31, 0x2e, 0x83, 0xb0, 0xd9, 0x0a, 0xa3, 0x2d, 0x98, 0xb0, 0x19, 0x75, 0x77, 0x9f, 0xf8,
+	0xa1, 0x4b, 0xc4, 0x9a, 0x15, 0xca, 0x8b, 0x8d, 0x58, 0x83, 0x04, 0x7e, 0xc8, 0xd1, 0x66, 0xac,
+	0x5d, 0x15, 0x1c, 0x2d, 0x0c, 0x9b, 0x6d, 0x09, 0xc8, 0x85, 0x99, 0x90, 0xba, 0x3e, 0x23, 0x15,
+	0x87, 0xee, 0xf2, 0x5d, 0x95, 0x12, 0x02, 0x27, 0xbc, 0xdb, 0x88, 0xb5, 0xe9, 0x2c, 0xe3, 0xab,
+	0x24, 0x21, 0xa3, 0x9e, 0x13, 0xd4, 0xdd, 0xa2, 0xd8, 0xec, 0x3a, 0x08, 0xff, 0xac, 0xc0, 0x74,
+	0xbe, 0x76, 0x72, 0xa5, 0xb6, 0xe1, 0x52, 0xc5, 0xf1, 0xab, 0x4f, 0x77, 0xe5, 0x16, 0x14, 0x45,
+	0x5c, 0x6e, 0xc4, 0xda, 0x04, 0xc7, 0xb7, 0xd3, 0x7d, 0x88, 0x84, 0x68, 0x1b, 0x88, 0xcd, 0xf6,
+	0x94, 0x56, 0x17, 0xc1, 0x39, 0xfb, 0xfb, 0x65, 0xe6, 0xa9, 0x66, 0x3b, 0x7b, 0x21, 0xf5, 0x06,
+	0x5a, 0xd0, 0x87, 0x00, 0xad, 0xd3, 0x4f, 0x36, 0xe4, 0x8a, 0x2e, 0x8e, 0x4a, 0x3d, 0x39, 0x2a,
+	0x75, 0x71, 0x12, 0xcb, 0xa3, 0x52, 0xdf, 0x21, 0x16, 0x95, 0x42, 0x66, 0xdb, 0x48, 0xfc, 0x6b,
+	0xda, 0x50, 0x2d, 0x37, 0xb2, 0x44, 0xf7, 0x60, 0xbc, 0x2a, 0x31, 0xd9, 0x53, 0x5a, 0x23, 0xd6,
+	0x32, 0xac, 0x19, 0x6b, 0x57, 0x84, 0xad, 0x14, 0xc1, 0x66, 0x16, 0x44, 0x1f, 0x77, 0xb1, 0xb7,
+	0xda, 0xd7, 0x9e, 0x50, 0xce, 0xf9, 0xfb, 0x51, 0x01, 0xc4, 0xfd, 0x3d, 0x38, 0x0c, 0xfc, 0x90,
+	0xbd, 0xd5, 0x5a, 0xfd, 0xa6, 0xc0, 0xb5, 0x9c, 0x97, 0x37, 0xd8, 0xf6, 0xff, 0x5f, 0x05, 0xb7,
+	0xe4, 0x02, 0x3f, 0x26, 0xac, 0x5a, 0x1b, 0xf8, 0x6e, 0x7b, 0xa5, 0xc0, 0x4c, 0x27, 0xcd, 0x9b,
+	0xeb, 0xa5, 0xa1, 0x73, 0xf6, 0xd2, 0xbf, 0xe9, 0x8a, 0x6c, 0xdb, 0x49, 0xa5, 0xeb, 0x03, 0x6d,
+	0x8f, 0x47, 0x30, 0xe5, 0xda, 0xde, 0x6e, 0x6e, 0x0e, 0x43, 0xfc, 0x4a, 0x5a, 0x6f, 0xc4, 0xda,
+	0xa4, 0x6b, 0x7b, 0xe5, 0xdc, 0x34, 0xae, 0xcb, 0x63, 0x32, 0x87, 0x63, 0xb3, 0x23, 0x91, 0xd3,
+	0x92, 0xc3, 0x3c, 0xed, 0x70, 0x1b, 0x2d, 0x39, 0xec, 0x4e, 0x4b, 0x0e, 0x3b, 0x68, 0xf3, 0xc0,
+	0x4b, 0x05, 0x26, 0xc5, 0x6c, 0xed, 0x2a, 0x71, 0xee, 0x53, 0xc7, 0x79, 0x9b, 0x0b, 0x50, 0x93,
+	0x67, 0x59, 0x56, 0x7f, 0xb9, 0x27, 0x76, 0x60, 0xa4, 0x4a, 0x1d, 0x27, 0x6d, 0x08, 0xed, 0x4c,
+	0x43, 0xe4, 0xa7, 0x20, 0x94, 0xf8, 0x88, 0x96, 0x12, 0xff, 0xc4, 0xa6, 0x80, 0x37, 0x8f, 0xc6,
+	0x61, 0x84, 0x4b, 0xa1, 0x08, 0x2e, 0x26, 0xfb, 0x0f, 0x2d, 0x9c, 0x21, 0xed, 0x7c, 0xbe, 0xa9,
+	0xf8, 0x75, 0x29, 0xc2, 0x2a, 0x5e, 0xfa, 0xfe, 0xcf, 0x7f, 0x5e, 0x0d, 0x15, 0xd1, 0x4d, 0xa3,
+	0xf3, 0x1d, 0xba, 0x47, 0x18, 0x31, 0x9e, 0x27, 0x7b, 0xe4, 0x3b, 0xf4, 0x8b, 0x02, 0x97, 0x73,
+	0xcf, 0x28, 0x74, 0xab, 0x37, 0x77, 0xe7, 0xb3, 0x4d, 0x5d, 0x3f, 0x57, 0xae, 0x34, 0x64, 0x70,
+	0x43, 0xef, 0xa2, 0xd5, 0xae, 0x86, 0x36, 0xbe, 0xb5, 0x59, 0x6d, 0x83, 0x3f, 0xa1, 0x52, 0x6f,
+	0x47, 0x0a, 0x14, 0xb2, 0xc7, 0x08, 0x5a, 0xe9, 0xad, 0xd5, 0xfe, 0x60, 0x52, 0x57, 0xfb, 0xe6,
+	0x49, 0x3f, 0x8b, 0xdc, 0xcf, 0x3c, 0x9a, 0xeb, 0xee, 0xc7, 0xe5, 0xaa, 0x2f, 0x60, 0x4c, 0xde,
+	0xb1, 0x68, 0xa9, 0x3b, 0x71, 0xfe, 0xf9, 0xa2, 0x2e, 0xf7, 0xc9, 0x92, 0xe2, 0xab, 0x5c, 0x7c,
+	0x01, 0x69, 0x67, 0xc4, 0xab, 0x24, 0x68, 0x5f, 0xa0, 0x1f, 0x14, 0x18, 0x4f, 0xef, 0x30, 0xd4,
+	0x8b, 0x3c, 0x7f, 0xe3, 0xaa, 0x2b, 0xfd, 0xd2, 0xa4, 0x89, 0x35, 0x6e, 0x02, 0xa3, 0xd2, 0x59,
+	0x13, 0x32, 0x35, 0x75, 0xf1, 0x1c, 0x46, 0xc5, 0xe5, 0x80, 0x16, 0xbb, 0x73, 0xe7, 0xae, 0x31,
+	0x75, 0xe9, 0xf5, 0x49, 0x52, 0x7e, 0x85, 0xcb, 0x97, 0x50, 0xf1, 0x8c, 0x3c, 0xe5, 0x89, 0xa9,
+	0xf8, 0x0b, 0x18, 0x93, 0x7d, 0xd8, 0x6b, 0x0d, 0xf2, 0xc7, 0xa4, 0xba, 0xdc, 0x27, 0xab, 0xef,
+	0x1a, 0xd4, 0x44, 0x66, 0x6a, 0xe0, 0x1b, 0x28, 0x64, 0xd7, 0x43, 0xaf, 0x7d, 0xd8, 0x79, 0x0d,
+	0xa9, 0xab, 0x7d, 0xf3, 0x84, 0x8d, 0xf7, 0x94, 0xf2, 0xa3, 0x3f, 0x4e, 0x8a, 0xca, 0xf1, 0x49,
+	0x51, 0xf9, 0xfb, 0xa4, 0xa8, 0xfc, 0x74, 0x5a, 0xbc, 0x70, 0x7c, 0x5a, 0xbc, 0xf0, 0xd7, 0x69,
+	0xf1, 0xc2, 0xd7, 0xf7, 0x2c, 0x9b, 0xd5, 0xf6, 0x2b, 0x7a, 0xd5, 0x77, 0x8d, 0x8f, 0x84, 0x49,
+	0xc1, 0xba, 0x11, 0xed, 0x3d, 0x35, 0x2c, 0xdf, 0x21, 0x9e, 0x65, 0xc8, 0x1f, 0x92, 0x87, 0x2d,
+	0xff, 0xc9, 0x6b, 0x38, 0xaa, 0x8c, 0xf2, 0xdf, 0x7f, 0x77, 0xfe, 0x1b, 0x00, 0xe2, 0x39, 0xb2,
+	0x47, 0xcd, 0x0e, 0x00, 0x00,
 }
 
-func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Return the raw string value of an arbitrary vstorage datum.
+	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
+	// Return the raw string value of an arbitrary vstorage datum, with a proof
+	// of it against the app hash committed for the queried block height.
+	DataWithProof(ctx context.Context, in *QueryDataWithProofRequest, opts ...grpc.CallOption) (*QueryDataWithProofResponse, error)
+	// Return the raw string values of several vstorage data at once, selected
+	// either by an explicit list of paths or by a path pattern.
+	DataMulti(ctx context.Context, in *QueryDataMultiRequest, opts ...grpc.CallOption) (*QueryDataMultiResponse, error)
+	// Return a formatted representation of a vstorage datum that must be
+	// a valid StreamCell with CapData values, or standalone CapData.
+	CapData(ctx context.Context, in *QueryCapDataRequest, opts ...grpc.CallOption) (*QueryCapDataResponse, error)
+	// Return the children of a given vstorage path.
+	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
+	// Return the data entries at and beneath a given vstorage path.
+	Export(ctx context.Context, in *QueryExportRequest, opts ...grpc.CallOption) (*QueryExportResponse, error)
+	// Return the stream cells of a vstorage path that this node retained from
+	// past blocks, ordered by block height. Only nodes configured with a
+	// stream cell history depth retain them.
+	History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+	// Stream the raw string value of a vstorage datum as of the end of each
+	// block in which it changes, starting from the first such block after the
+	// subscription. The current value is not sent; use Data to read it.
+	// Only available over gRPC.
+	WatchData(ctx context.Context, in *QueryWatchDataRequest, opts ...grpc.CallOption) (Query_WatchDataClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
+	out := new(QueryDataResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/Data", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DataWithProof(ctx context.Context, in *QueryDataWithProofRequest, opts ...grpc.CallOption) (*QueryDataWithProofResponse, error) {
+	out := new(QueryDataWithProofResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/DataWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Children[iNdEx])
-			copy(dAtA[i:], m.Children[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Children[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) DataMulti(ctx context.Context, in *QueryDataMultiRequest, opts ...grpc.CallOption) (*QueryDataMultiResponse, error) {
+	out := new(QueryDataMultiResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/DataMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) CapData(ctx context.Context, in *QueryCapDataRequest, opts ...grpc.CallOption) (*QueryCapDataResponse, error) {
+	out := new(QueryCapDataResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/CapData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
+	out := new(QueryChildrenResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/Children", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Export(ctx context.Context, in *QueryExportRequest, opts ...grpc.CallOption) (*QueryExportResponse, error) {
+	out := new(QueryExportResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCapDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MediaType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ItemFormat)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RemotableValueFormat)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+	out := new(QueryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vstorage.Query/History", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCapDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) WatchData(ctx context.Context, in *QueryWatchDataRequest, opts ...grpc.CallOption) (Query_WatchDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/agoric.vstorage.Query/WatchData", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.BlockHeight)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	x := &queryWatchDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *QueryChildrenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type Query_WatchDataClient interface {
+	Recv() (*QueryWatchDataResponse, error)
+	grpc.ClientStream
 }
 
-func (m *QueryChildrenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type queryWatchDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWatchDataClient) Recv() (*QueryWatchDataResponse, error) {
+	m := new(QueryWatchDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Return the raw string value of an arbitrary vstorage datum.
+	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
+	// Return the raw string value of an arbitrary vstorage datum, with a proof
+	// of it against the app hash committed for the queried block height.
+	DataWithProof(context.Context, *QueryDataWithProofRequest) (*QueryDataWithProofResponse, error)
+	// Return the raw string values of several vstorage data at once, selected
+	// either by an explicit list of paths or by a path pattern.
+	DataMulti(context.Context, *QueryDataMultiRequest) (*QueryDataMultiResponse, error)
+	// Return a formatted representation of a vstorage datum that must be
+	// a valid StreamCell with CapData values, or standalone CapData.
+	CapData(context.Context, *QueryCapDataRequest) (*QueryCapDataResponse, error)
+	// Return the children of a given vstorage path.
+	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
+	// Return the data entries at and beneath a given vstorage path.
+	Export(context.Context, *QueryExportRequest) (*QueryExportResponse, error)
+	// Return the stream cells of a vstorage path that this node retained from
+	// past blocks, ordered by block height. Only nodes configured with a
+	// stream cell history depth retain them.
+	History(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+	// Stream the raw string value of a vstorage datum as of the end of each
+	// block in which it changes, starting from the first such block after the
+	// subscription. The current value is not sent; use Data to read it.
+	// Only available over gRPC.
+	WatchData(*QueryWatchDataRequest, Query_WatchDataServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
+}
+func (*UnimplementedQueryServer) DataWithProof(ctx context.Context, req *QueryDataWithProofRequest) (*QueryDataWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataWithProof not implemented")
+}
+func (*UnimplementedQueryServer) DataMulti(ctx context.Context, req *QueryDataMultiRequest) (*QueryDataMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataMulti not implemented")
+}
+func (*UnimplementedQueryServer) CapData(ctx context.Context, req *QueryCapDataRequest) (*QueryCapDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapData not implemented")
+}
+func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
+}
+func (*UnimplementedQueryServer) Export(ctx context.Context, req *QueryExportRequest) (*QueryExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedQueryServer) History(ctx context.Context, req *QueryHistoryRequest) (*QueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (*UnimplementedQueryServer) WatchData(req *QueryWatchDataRequest, srv Query_WatchDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchData not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Data(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/Data",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataWithProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/DataWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataWithProof(ctx, req.(*QueryDataWithProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataMultiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/DataMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataMulti(ctx, req.(*QueryDataMultiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CapData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CapData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/CapData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CapData(ctx, req.(*QueryCapDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Children(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/Children",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Export(ctx, req.(*QueryExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vstorage.Query/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).History(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryWatchDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).WatchData(m, &queryWatchDataServer{stream})
+}
+
+type Query_WatchDataServer interface {
+	Send(*QueryWatchDataResponse) error
+	grpc.ServerStream
+}
+
+type queryWatchDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWatchDataServer) Send(m *QueryWatchDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vstorage.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Data",
+			Handler:    _Query_Data_Handler,
+		},
+		{
+			MethodName: "DataWithProof",
+			Handler:    _Query_DataWithProof_Handler,
+		},
+		{
+			MethodName: "DataMulti",
+			Handler:    _Query_DataMulti_Handler,
+		},
+		{
+			MethodName: "CapData",
+			Handler:    _Query_CapData_Handler,
+		},
+		{
+			MethodName: "Children",
+			Handler:    _Query_Children_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _Query_Export_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Query_History_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchData",
+			Handler:       _Query_WatchData_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "agoric/vstorage/query.proto",
+}
+
+func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataWithProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataWithProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataWithProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataMultiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataMultiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataMultiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataMultiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataMultiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemotableValueFormat) > 0 {
+		i -= len(m.RemotableValueFormat)
+		copy(dAtA[i:], m.RemotableValueFormat)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RemotableValueFormat)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ItemFormat) > 0 {
+		i -= len(m.ItemFormat)
+		copy(dAtA[i:], m.ItemFormat)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ItemFormat)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BlockHeight) > 0 {
+		i -= len(m.BlockHeight)
+		copy(dAtA[i:], m.BlockHeight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHeight)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Children[iNdEx])
+			copy(dAtA[i:], m.Children[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Children[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHeight) > 0 {
+		i -= len(m.BlockHeight)
+		copy(dAtA[i:], m.BlockHeight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHeight)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalCell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalCell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalCell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHeight) > 0 {
+		i -= len(m.BlockHeight)
+		copy(dAtA[i:], m.BlockHeight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHeight)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cells) > 0 {
+		for iNdEx := len(m.Cells) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cells[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataWithProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataMultiRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCapDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ItemFormat)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RemotableValueFormat)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHeight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHeight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinBlockHeight))
+	}
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockHeight))
+	}
+	return n
+}
+
+func (m *HistoricalCell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHeight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cells) > 0 {
+		for _, e := range m.Cells {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataWithProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataWithProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataWithProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataWithProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataWithProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataMultiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataMultiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataMultiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataMultiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataMultiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &DataEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotableValueFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemotableValueFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,17 +3534,49 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -976,23 +3586,27 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1015,7 +3629,7 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1038,17 +3652,17 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1058,23 +3672,61 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, &DataEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1097,7 +3749,7 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWatchDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,10 +3772,10 @@ func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCapDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWatchDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCapDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWatchDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1158,41 +3810,59 @@ func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1220,11 +3890,11 @@ func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemFormat = string(dAtA[iNdEx:postIndex])
+			m.BlockHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemotableValueFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1252,7 +3922,7 @@ func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemotableValueFormat = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1275,7 +3945,7 @@ func (m *QueryCapDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCapDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1298,15 +3968,15 @@ func (m *QueryCapDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCapDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCapDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1334,13 +4004,13 @@ func (m *QueryCapDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHeight = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.MinBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1350,24 +4020,30 @@ func (m *QueryCapDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1389,7 +4065,7 @@ func (m *QueryCapDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
+func (m *HistoricalCell) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,15 +4088,15 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoricalCell: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoricalCell: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1448,13 +4124,13 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.BlockHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1464,27 +4140,23 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1507,7 +4179,7 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1530,47 +4202,15 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1597,10 +4237,8 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cells = append(m.Cells, &HistoricalCell{})
+			if err := m.Cells[len(m.Cells)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -114,33 +114,199 @@ func (m *Children) GetChildren() []string {
 	return nil
 }
 
+// The module governance/configuration parameters.
+type Params struct {
+	// The byte quotas of subtrees, each limiting the total length of the paths
+	// and values of the entries with data within the subtree at its path.
+	PathQuotas []PathQuota `protobuf:"bytes,1,rep,name=path_quotas,json=pathQuotas,proto3" json:"path_quotas" yaml:"path_quotas"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f80259d2fe3898c, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPathQuotas() []PathQuota {
+	if m != nil {
+		return m.PathQuotas
+	}
+	return nil
+}
+
+// PathQuota is the byte quota of the subtree at a path.
+type PathQuota struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
+	// The most bytes that the subtree may use, which must be positive.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit" yaml:"limit"`
+}
+
+func (m *PathQuota) Reset()         { *m = PathQuota{} }
+func (m *PathQuota) String() string { return proto.CompactTextString(m) }
+func (*PathQuota) ProtoMessage()    {}
+func (*PathQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f80259d2fe3898c, []int{3}
+}
+func (m *PathQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PathQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PathQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PathQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathQuota.Merge(m, src)
+}
+func (m *PathQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *PathQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathQuota proto.InternalMessageInfo
+
+func (m *PathQuota) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PathQuota) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Data)(nil), "agoric.vstorage.Data")
 	proto.RegisterType((*Children)(nil), "agoric.vstorage.Children")
+	proto.RegisterType((*Params)(nil), "agoric.vstorage.Params")
+	proto.RegisterType((*PathQuota)(nil), "agoric.vstorage.PathQuota")
 }
 
 func init() { proto.RegisterFile("agoric/vstorage/vstorage.proto", fileDescriptor_7f80259d2fe3898c) }
 
 var fileDescriptor_7f80259d2fe3898c = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4c, 0xcf, 0x2f,
-	0xca, 0x4c, 0xd6, 0x2f, 0x2b, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0x85, 0x33, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0x21, 0xf2, 0x7a, 0x30, 0x61, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c,
-	0xb0, 0x9c, 0x3e, 0x88, 0x05, 0x51, 0xa6, 0x64, 0xcb, 0xc5, 0xe2, 0x92, 0x58, 0x92, 0x28, 0xa4,
-	0xcf, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf9,
-	0xea, 0x9e, 0x3c, 0x44, 0xe0, 0xd3, 0x3d, 0x79, 0x9e, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x30,
-	0x57, 0x29, 0x08, 0x22, 0x6c, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0x83, 0x92, 0x2f, 0x17, 0x87, 0x73,
-	0x46, 0x66, 0x4e, 0x4a, 0x51, 0x6a, 0x9e, 0x90, 0x35, 0x17, 0x47, 0x32, 0x94, 0x2d, 0xc1, 0xa8,
-	0xc0, 0xac, 0xc1, 0xe9, 0x24, 0xff, 0xea, 0x9e, 0x3c, 0x5c, 0xec, 0xd3, 0x3d, 0x79, 0x7e, 0x88,
-	0x41, 0x30, 0x11, 0xa5, 0x20, 0xb8, 0x24, 0xc4, 0x38, 0xa7, 0xd0, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x77, 0x84, 0xf8, 0x1c, 0xe2, 0x41, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0xf4, 0xfc, 0x9c, 0xc4,
-	0xbc, 0x74, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0x0a, 0x44, 0xa0, 0x94, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xfd, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x81, 0x6f, 0xc9,
-	0xde, 0x34, 0x01, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x31, 0x6f, 0xe2, 0x30,
+	0x14, 0xc7, 0xe3, 0xbb, 0x1c, 0x02, 0x73, 0x12, 0x52, 0x74, 0xd2, 0x71, 0x0c, 0x31, 0xf2, 0xc4,
+	0xa9, 0x6a, 0x22, 0xb5, 0x1b, 0xa8, 0x43, 0xd3, 0xae, 0x95, 0x68, 0xa4, 0x2e, 0x5d, 0x2a, 0x13,
+	0xd2, 0x24, 0x6a, 0x82, 0xd3, 0xd8, 0xa0, 0xd2, 0x4f, 0xd1, 0xb1, 0x23, 0x1f, 0x87, 0x91, 0xb1,
+	0x93, 0x55, 0xc1, 0x82, 0x32, 0xe6, 0x13, 0x54, 0xb1, 0x21, 0xa0, 0x6e, 0xef, 0xfd, 0xde, 0x7b,
+	0x7f, 0xeb, 0xfd, 0x9f, 0xa1, 0x49, 0x02, 0x9a, 0x45, 0x9e, 0x3d, 0x63, 0x9c, 0x66, 0x24, 0xf0,
+	0xab, 0xc0, 0x4a, 0x33, 0xca, 0xa9, 0xd1, 0x52, 0x75, 0x6b, 0x8f, 0x3b, 0x7f, 0x02, 0x1a, 0x50,
+	0x59, 0xb3, 0xcb, 0x48, 0xb5, 0xe1, 0x0b, 0xa8, 0x5f, 0x13, 0x4e, 0x0c, 0x1b, 0xfe, 0x9a, 0x91,
+	0x78, 0xea, 0xb7, 0x41, 0x17, 0xf4, 0x1a, 0xce, 0xbf, 0x5c, 0x20, 0x05, 0x0a, 0x81, 0x7e, 0xcf,
+	0x49, 0x12, 0xf7, 0xb1, 0x4c, 0xb1, 0xab, 0x70, 0x5f, 0xdf, 0x2e, 0x90, 0x86, 0x6f, 0x60, 0xfd,
+	0x2a, 0x8c, 0xe2, 0x71, 0xe6, 0x4f, 0x8c, 0x01, 0xac, 0x7b, 0xbb, 0xb8, 0x0d, 0xba, 0x3f, 0x7b,
+	0x0d, 0x07, 0xe5, 0x02, 0x55, 0xac, 0x10, 0xa8, 0xa5, 0x84, 0xf6, 0x04, 0xbb, 0x55, 0x71, 0x27,
+	0xf7, 0x0a, 0x6b, 0x43, 0x92, 0x91, 0x84, 0x19, 0x8f, 0xb0, 0x99, 0x12, 0x1e, 0x3e, 0x3c, 0x4f,
+	0x29, 0x27, 0x4c, 0xea, 0x35, 0xcf, 0x3a, 0xd6, 0xb7, 0xa5, 0xac, 0x21, 0xe1, 0xe1, 0x6d, 0xd9,
+	0xe2, 0xfc, 0x5f, 0x0a, 0xa4, 0xe5, 0x02, 0x1d, 0x8f, 0x15, 0x02, 0x19, 0xea, 0xc9, 0x23, 0x88,
+	0x5d, 0x98, 0xee, 0xa7, 0x58, 0xbf, 0xfe, 0xbe, 0x40, 0xda, 0x76, 0x81, 0x00, 0xa6, 0xb0, 0x51,
+	0xa9, 0x19, 0x27, 0x50, 0x2f, 0x9b, 0x76, 0x6e, 0xfc, 0xcd, 0x05, 0x92, 0x79, 0x21, 0x50, 0xf3,
+	0x20, 0x88, 0x5d, 0x09, 0x4b, 0xef, 0xe2, 0x28, 0x89, 0x78, 0xfb, 0x47, 0x17, 0xf4, 0x74, 0xe5,
+	0x9d, 0x04, 0x07, 0xef, 0x64, 0x8a, 0x5d, 0x85, 0xe5, 0xb2, 0xc0, 0xb9, 0x5b, 0xae, 0x4d, 0xb0,
+	0x5a, 0x9b, 0xe0, 0x73, 0x6d, 0x82, 0xb7, 0x8d, 0xa9, 0xad, 0x36, 0xa6, 0xf6, 0xb1, 0x31, 0xb5,
+	0xfb, 0x41, 0x10, 0xf1, 0x70, 0x3a, 0xb2, 0x3c, 0x9a, 0xd8, 0x97, 0xea, 0xcc, 0x6a, 0xf1, 0x53,
+	0x36, 0x7e, 0xb2, 0x03, 0x1a, 0x93, 0x49, 0x60, 0x7b, 0x94, 0x25, 0x94, 0xd9, 0x2f, 0x87, 0x1f,
+	0xc0, 0xe7, 0xa9, 0xcf, 0x46, 0x35, 0x79, 0xd8, 0xf3, 0xaf, 0x01, 0x00, 0xcd, 0x57, 0xbb, 0x8c,
+	0x21, 0x02, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PathQuotas) != len(that1.PathQuotas) {
+		return false
+	}
+	for i := range this.PathQuotas {
+		if !this.PathQuotas[i].Equal(&that1.PathQuotas[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PathQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PathQuota)
+	if !ok {
+		that2, ok := that.(PathQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
 func (m *Data) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -203,6 +369,78 @@ func (m *Children) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PathQuotas) > 0 {
+		for iNdEx := len(m.PathQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVstorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PathQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PathQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PathQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintVstorage(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintVstorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVstorage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVstorage(v)
 	base := offset
@@ -242,6 +480,37 @@ func (m *Children) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PathQuotas) > 0 {
+		for _, e := range m.PathQuotas {
+			l = e.Size()
+			n += 1 + l + sovVstorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PathQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovVstorage(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovVstorage(uint64(m.Limit))
+	}
+	return n
+}
+
 func sovVstorage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -412,6 +681,191 @@ func (m *Children) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVstorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathQuotas = append(m.PathQuotas, PathQuota{})
+			if err := m.PathQuotas[len(m.PathQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVstorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PathQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVstorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVstorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVstorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVstorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVstorage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,21 @@ func unmarshalSinglePathFromArgs(args []json.RawMessage, path *string) error {
 	return json.Unmarshal(args[0], path)
 }
 
+// unmarshalWritableEntries decodes the entries of a write, failing if any is
+// not writable so that nothing is written.
+func unmarshalWritableEntries(keeper Keeper, args []json.RawMessage) ([]agoric.KVEntry, error) {
+	entries := make([]agoric.KVEntry, len(args))
+	for i, arg := range args {
+		if err := json.Unmarshal(arg, &entries[i]); err != nil {
+			return nil, err
+		}
+		if err := keeper.CheckWritablePath(entries[i].Key()); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
 func (sh vstorageHandler) Receive(cctx context.Context, str string) (ret string, err error) {
 	ctx := sdk.UnwrapSDKContext(cctx)
 	keeper := sh.keeper
@@ -69,12 +84,16 @@ func (sh vstorageHandler) Receive(cctx context.Context, str string) (ret string,
 	// Handle generic paths.
 	switch msg.Method {
 	case "set":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
+		var entries []agoric.KVEntry
+		entries, err = unmarshalWritableEntries(keeper, msg.Args)
+		if err != nil {
+			return
+		}
+		err = keeper.CheckStorageQuotas(ctx, entries)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
 			keeper.SetStorageAndNotify(ctx, entry)
 		}
 		return "true", nil
@@ -83,43 +102,63 @@ func (sh vstorageHandler) Receive(cctx context.Context, str string) (ret string,
 		// chain-cosmos-sdk.js consumes legacy events for `mailbox.*` and `egress.*`.
 		// FIXME: Use just "set" and remove this case.
 	case "legacySet":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
+		var entries []agoric.KVEntry
+		entries, err = unmarshalWritableEntries(keeper, msg.Args)
+		if err != nil {
+			return
+		}
+		err = keeper.CheckStorageQuotas(ctx, entries)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
 			//fmt.Printf("giving Keeper.SetStorage(%s) %s\n", entry.Path(), entry.Value())
 			keeper.LegacySetStorageAndNotify(ctx, entry)
 		}
 		return "true", nil
 
 	case "setWithoutNotify":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
+		var entries []agoric.KVEntry
+		entries, err = unmarshalWritableEntries(keeper, msg.Args)
+		if err != nil {
+			return
+		}
+		err = keeper.CheckStorageQuotas(ctx, entries)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
 			keeper.SetStorage(ctx, entry)
 		}
 		return "true", nil
 
+	case "setBatch":
+		var entries []agoric.KVEntry
+		entries, err = unmarshalWritableEntries(keeper, msg.Args)
+		if err != nil {
+			return
+		}
+		err = keeper.SetStorageBatch(ctx, entries)
+		if err != nil {
+			return
+		}
+		return "true", nil
+
 	case "append":
-		for _, arg := range msg.Args {
-			var entry agoric.KVEntry
-			err = json.Unmarshal(arg, &entry)
-			if err != nil {
-				return
-			}
+		var entries []agoric.KVEntry
+		entries, err = unmarshalWritableEntries(keeper, msg.Args)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
 			if !entry.HasValue() {
 				err = fmt.Errorf("no value for append entry with path: %q", entry.Key())
 				return
 			}
-			err = keeper.AppendStorageValueAndNotify(ctx, entry.Key(), entry.StringValue())
-			if err != nil {
-				return
-			}
+		}
+		err = keeper.AppendStorageValuesAndNotify(ctx, entries)
+		if err != nil {
+			return
 		}
 		return "true", nil
 
@@ -1,7 +1,10 @@
 package vstorage
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -10,9 +13,12 @@ import (
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vstorage/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	agorictypes "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,7 +27,9 @@ import (
 )
 
 var (
-	storeKey = storetypes.NewKVStoreKey(types.StoreKey)
+	storeKey        = storetypes.NewKVStoreKey(types.StoreKey)
+	paramsStoreKey  = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 )
 
 func ptr[T any](v T) *T {
@@ -36,10 +44,14 @@ type testKit struct {
 }
 
 func makeTestKit() testKit {
-	keeper := NewKeeper(storeKey)
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), codec.NewLegacyAmino(),
+		paramsStoreKey, paramsTStoreKey, types.ModuleName)
+	keeper := NewKeeper(storeKey, paramSpace)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
 	err := ms.LoadLatestVersion()
 	if err != nil {
 		panic(err)
@@ -166,21 +178,35 @@ func doTestSet(t *testing.T, method string, expectNotify bool) {
 			skipReadBack: map[int]bool{0: true},
 		},
 	}
-	stateChangeEvent := func(path, value string) sdk.Event {
-		return agorictypes.NewStateChangeEvent(
-			keeper.GetStoreName(),
-			keeper.PathToEncodedKey(path),
-			[]byte(value),
-		)
+	changeEvents := func(path, value string) sdk.Events {
+		sum := sha256.Sum256([]byte(value))
+		typedEvent, err := sdk.TypedEventToEvent(&types.EventSetValue{
+			Path:      path,
+			ValueHash: hex.EncodeToString(sum[:]),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sdk.Events{
+			agorictypes.NewStateChangeEvent(
+				keeper.GetStoreName(),
+				keeper.PathToEncodedKey(path),
+				[]byte(value),
+			),
+			typedEvent,
+		}
 	}
 	// Expect events to be alphabetized by key.
-	expectedFlushEvents := sdk.Events{
-		stateChangeEvent("baz.a", "qux"),
-		stateChangeEvent("baz.b", "qux"),
-		stateChangeEvent("final.final.corge", "garply"),
-		stateChangeEvent("foo", "bar"),
-		stateChangeEvent("quux", "new"),
-		stateChangeEvent("qux", "A"),
+	expectedFlushEvents := sdk.Events{}
+	for _, change := range [][2]string{
+		{"baz.a", "qux"},
+		{"baz.b", "qux"},
+		{"final.final.corge", "garply"},
+		{"foo", "bar"},
+		{"quux", "new"},
+		{"qux", "A"},
+	} {
+		expectedFlushEvents = append(expectedFlushEvents, changeEvents(change[0], change[1])...)
 	}
 	for _, desc := range cases {
 		got, err := callReceive(handler, cctx, method, desc.args)
@@ -251,6 +277,37 @@ func TestSetWithoutNotify(t *testing.T) {
 	doTestSet(t, "setWithoutNotify", false)
 }
 
+func TestSetBatch(t *testing.T) {
+	kit := makeTestKit()
+	keeper, handler, ctx, cctx := kit.keeper, kit.handler, kit.ctx, kit.cctx
+
+	_, err := callReceive(handler, cctx, "setBatch", []interface{}{
+		[]string{"batch.a", "A"},
+		[]string{"batch.b.", "B"},
+	})
+	if err == nil {
+		t.Errorf("got no error for invalid path")
+	}
+	if keeper.HasStorage(ctx, "batch.a") {
+		t.Errorf("got partial write of batch.a after invalid batch")
+	}
+
+	got, err := callReceive(handler, cctx, "setBatch", []interface{}{
+		[]string{"batch.a", "A"},
+		[]string{"batch.b", "B"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if got != "true" {
+		t.Errorf("got unexpected response %q; want %q", got, "true")
+	}
+	for path, want := range map[string]string{"batch.a": "A", "batch.b": "B"} {
+		if got := keeper.GetEntry(ctx, path).StringValue(); got != want {
+			t.Errorf("got %s %q, want %q", path, got, want)
+		}
+	}
+}
+
 // TODO: TestAppend
 
 // TODO: TestChildrenAndSize
@@ -295,3 +352,117 @@ func TestEntries(t *testing.T) {
 		}
 	}
 }
+
+func TestGenesisStreaming(t *testing.T) {
+	kit := makeTestKit()
+	keeper, ctx := kit.keeper, kit.ctx
+
+	params := types.Params{PathQuotas: []types.PathQuota{{Path: "gamma", Limit: 100}}}
+	keeper.SetParams(ctx, params)
+	keeper.SetStorage(ctx, agorictypes.NewKVEntry("alpha", "one"))
+	keeper.SetStorage(ctx, agorictypes.NewKVEntry("alpha.beta", ""))
+	keeper.SetStorage(ctx, agorictypes.NewKVEntry("gamma.delta", `{"quoted":"\"json\""}`))
+
+	var buf bytes.Buffer
+	if err := WriteGenesis(ctx, keeper, &buf); err != nil {
+		t.Fatalf("cannot write genesis: %v", err)
+	}
+
+	// The streamed encoding must be readable by the proto JSON codec.
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	var decoded types.GenesisState
+	if err := cdc.UnmarshalJSON(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("cannot unmarshal streamed genesis %s: %v", buf.String(), err)
+	}
+	if want := keeper.ExportStorage(ctx); !reflect.DeepEqual(decoded.Data, want) {
+		t.Errorf("got streamed genesis %v, want %v", decoded.Data, want)
+	}
+	if !decoded.Params.Equal(params) {
+		t.Errorf("got streamed params %v, want %v", decoded.Params, params)
+	}
+
+	// And the codec's encoding must be readable by the stream.
+	var read []*types.DataEntry
+	var readParams types.Params
+	onParams := func(params types.Params) error {
+		readParams = params
+		return nil
+	}
+	err := ReadGenesis(bytes.NewReader(cdc.MustMarshalJSON(&decoded)), onParams, func(entry *types.DataEntry) error {
+		read = append(read, entry)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cannot read genesis: %v", err)
+	}
+	if !reflect.DeepEqual(read, decoded.Data) {
+		t.Errorf("got read genesis %v, want %v", read, decoded.Data)
+	}
+	if !readParams.Equal(params) {
+		t.Errorf("got read params %v, want %v", readParams, params)
+	}
+
+	imported := makeTestKit()
+	if err := ImportGenesis(imported.ctx, imported.keeper, &buf); err != nil {
+		t.Fatalf("cannot import genesis: %v", err)
+	}
+	if got, want := imported.keeper.ExportStorage(imported.ctx), decoded.Data; !reflect.DeepEqual(got, want) {
+		t.Errorf("got imported storage %v, want %v", got, want)
+	}
+	if got := imported.keeper.GetParams(imported.ctx); !got.Equal(params) {
+		t.Errorf("got imported params %v, want %v", got, params)
+	}
+	if used, _ := imported.keeper.GetPathQuotaUsage(imported.ctx, "gamma"); used != 32 {
+		t.Errorf("got imported usage %d, want 32", used)
+	}
+
+	for _, bad := range []string{
+		`{"data":[{"path":"bad..path","value":""}]}`,
+		`{"other":[]}`,
+		`{"data":{}}`,
+		`{"params":{"path_quotas":[{"path":"pathQuotas","limit":"1"}]}}`,
+		`{"params":{"path_quotas":[{"path":"published","limit":"0"}]}}`,
+	} {
+		if err := ValidateGenesisStream(strings.NewReader(bad)); err == nil {
+			t.Errorf("genesis %s passed validation", bad)
+		}
+	}
+	for _, good := range []string{`{}`, `{"data":null}`, `{"data":[]}`, `{"data":[],"params":{"path_quotas":[]}}`} {
+		if err := ValidateGenesisStream(strings.NewReader(good)); err != nil {
+			t.Errorf("genesis %s failed validation: %v", good, err)
+		}
+	}
+}
+
+func TestPathQuotaBridge(t *testing.T) {
+	kit := makeTestKit()
+	keeper, handler, ctx, cctx := kit.keeper, kit.handler, kit.ctx, kit.cctx
+
+	keeper.SetParams(ctx, types.Params{PathQuotas: []types.PathQuota{{Path: "published.wallet", Limit: 30}}})
+	_, err := callReceive(handler, cctx, "set", []interface{}{[]string{"published.wallet.a", "0123456789"}})
+	if err != nil {
+		t.Errorf("set within the quota error: %v", err)
+	}
+	// Each write fails as a whole, even if its first entry fits.
+	for _, method := range []string{"set", "legacySet", "setWithoutNotify", "setBatch", "append"} {
+		_, err = callReceive(handler, cctx, method, []interface{}{
+			[]string{"published.wallet.b", "0"},
+			[]string{"published.wallet.c", "0123456789"},
+		})
+		if err == nil || !strings.Contains(err.Error(), types.ErrQuotaExceeded.Error()) {
+			t.Errorf("%s beyond the quota: got error %v, want %q", method, err, types.ErrQuotaExceeded)
+		}
+		if keeper.HasStorage(ctx, "published.wallet.b") {
+			t.Errorf("%s beyond the quota wrote its first entry", method)
+		}
+	}
+	for _, method := range []string{"set", "legacySet", "setWithoutNotify", "setBatch", "append"} {
+		_, err = callReceive(handler, cctx, method, []interface{}{[]string{types.PathQuotasPath, `{"published.wallet":"0"}`}})
+		if err == nil {
+			t.Errorf("%s unexpectedly changed the quota usage", method)
+		}
+	}
+	if used, _ := keeper.GetPathQuotaUsage(ctx, "published.wallet"); used != 28 {
+		t.Errorf("got usage %d, want 28", used)
+	}
+}
@@ -0,0 +1,54 @@
+package vtransfer
+
+import (
+	"testing"
+
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
+)
+
+// forwarder stands in for packet-forward-middleware.
+type forwarder struct {
+	porttypes.IBCModule
+}
+
+func TestNewIBCMiddlewareWithForwarding(t *testing.T) {
+	var base porttypes.IBCModule = forwarder{}
+	newForwarder := func(ibcModule porttypes.IBCModule) porttypes.IBCModule {
+		return forwarder{IBCModule: ibcModule}
+	}
+
+	for _, mode := range []types.ForwardingMode{types.InterceptBeforeForwarding, types.InterceptAfterForwarding} {
+		k := keeper.NewKeeper(nil, nil, vibc.Keeper{}, capabilitykeeper.ScopedKeeper{}, nil, "", mode)
+		stack := NewIBCMiddlewareWithForwarding(base, k, newForwarder)
+
+		switch mode {
+		case types.InterceptBeforeForwarding:
+			mw, ok := stack.(IBCMiddleware)
+			if !ok {
+				t.Fatalf("expected vtransfer outermost, got %T", stack)
+			}
+			if _, ok := mw.ibcModule.(forwarder); !ok {
+				t.Errorf("expected forwarder inside vtransfer, got %T", mw.ibcModule)
+			}
+			if _, ok := k.GetICS4Wrapper().(keeper.Keeper); ok {
+				t.Errorf("forwarder acknowledgements would not be intercepted")
+			}
+		case types.InterceptAfterForwarding:
+			fw, ok := stack.(forwarder)
+			if !ok {
+				t.Fatalf("expected forwarder outermost, got %T", stack)
+			}
+			if _, ok := fw.IBCModule.(IBCMiddleware); !ok {
+				t.Errorf("expected vtransfer inside forwarder, got %T", fw.IBCModule)
+			}
+			if _, ok := k.GetICS4Wrapper().(keeper.Keeper); !ok {
+				t.Errorf("forwarder acknowledgements should not be intercepted")
+			}
+		}
+	}
+}
@@ -25,6 +25,7 @@ func DefaultGenesisState() *types.GenesisState {
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
 	keeper.SetWatchedAddresses(ctx, data.GetWatchedAddresses())
+	keeper.ReplaceGovernedAddresses(ctx, data.GetGovernedAddresses())
 	return []abci.ValidatorUpdate{}
 }
 
@@ -35,5 +36,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 		panic(err)
 	}
 	gs.WatchedAddresses = addresses
+	gs.GovernedAddresses = k.GetGovernedAddresses(ctx)
 	return &gs
 }
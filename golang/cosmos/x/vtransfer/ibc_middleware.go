@@ -2,6 +2,7 @@ package vtransfer
 
 import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -45,6 +46,20 @@ func NewIBCMiddleware(ibcModule porttypes.IBCModule, vtransferKeeper keeper.Keep
 	}
 }
 
+// NewIBCMiddlewareWithForwarding composes the vtransfer middleware and a
+// packet-forward-middleware created by newForwarder around ibcModule, in the
+// order chosen by the keeper's ForwardingMode.
+func NewIBCMiddlewareWithForwarding(
+	ibcModule porttypes.IBCModule,
+	vtransferKeeper keeper.Keeper,
+	newForwarder func(porttypes.IBCModule) porttypes.IBCModule,
+) porttypes.IBCModule {
+	if vtransferKeeper.GetForwardingMode() == types.InterceptAfterForwarding {
+		return newForwarder(NewIBCMiddleware(ibcModule, vtransferKeeper))
+	}
+	return NewIBCMiddleware(newForwarder(ibcModule), vtransferKeeper)
+}
+
 ///////////////////////////////////
 // The following channel handshake events are all directly forwarded to the
 // wrapped IBCModule.  They are not performed in the context of a packet, and so
@@ -13,6 +13,7 @@ import (
 	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/iancoleman/orderedmap"
@@ -25,11 +26,14 @@ import (
 	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	vibckeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/keeper"
 	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	vtransferkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/keeper"
+	vtransfertypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
@@ -61,6 +65,13 @@ type IntegrationTestSuite struct {
 
 type TestingAppMaker func() (ibctesting.TestingApp, map[string]json.RawMessage)
 
+// appOptions is a servertypes.AppOptions of fixed values.
+type appOptions map[string]interface{}
+
+func (opts appOptions) Get(key string) interface{} {
+	return opts[key]
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	s := new(IntegrationTestSuite)
 	suite.Run(t, s)
@@ -107,7 +118,7 @@ func (s *IntegrationTestSuite) nextChannelOffset(instance int) int {
 	return offset
 }
 
-func SetupAgoricTestingApp(instance int) TestingAppMaker {
+func SetupAgoricTestingApp(instance int, appOpts servertypes.AppOptions) TestingAppMaker {
 	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
 		db := dbm.NewMemDB()
 		encCdc := app.MakeEncodingConfig()
@@ -125,7 +136,7 @@ func SetupAgoricTestingApp(instance int) TestingAppMaker {
 			return jsonReply, nil
 		}
 		appd := app.NewAgoricApp(mockController, vm.NewAgdServer(), log.TestingLogger(), db, nil,
-			true, map[int64]bool{}, app.DefaultNodeHome, simapp.FlagPeriodValue, encCdc, simapp.EmptyAppOptions{}, interBlockCacheOpt())
+			true, map[int64]bool{}, app.DefaultNodeHome, simapp.FlagPeriodValue, encCdc, appOpts, interBlockCacheOpt())
 		genesisState := app.NewDefaultGenesisState()
 
 		t := template.Must(template.New("").Parse(`
@@ -180,13 +191,19 @@ func SetupAgoricTestingApp(instance int) TestingAppMaker {
 // SetupTest initializes an IntegrationTestSuite with three similar chains, a
 // shared coordinator, and a query client that happens to point at chainA.
 func (s *IntegrationTestSuite) SetupTest() {
+	s.setupChains(simapp.EmptyAppOptions{})
+}
+
+// setupChains (re)initializes the suite as SetupTest does, but with chains
+// whose apps are configured by appOpts.
+func (s *IntegrationTestSuite) setupChains(appOpts servertypes.AppOptions) {
 	s.lastChannelOffset = make(map[int]int)
 	s.endpoints = make(map[int]map[int]*ibctesting.Endpoint)
 	s.coordinator = ibctesting.NewCoordinator(s.T(), 0)
 
 	chains := make(map[string]*ibctesting.TestChain)
 	for i := 0; i < 3; i++ {
-		ibctesting.DefaultTestingAppInit = SetupAgoricTestingApp(i)
+		ibctesting.DefaultTestingAppInit = SetupAgoricTestingApp(i, appOpts)
 
 		chainID := ibctesting.GetChainID(i)
 		chain := ibctesting.NewTestChain(s.T(), s.coordinator, chainID)
@@ -812,3 +829,409 @@ func (s *IntegrationTestSuite) TestHops() {
 		}
 	}
 }
+
+// TestForwardingModes relays a transfer from chain A through chain C's
+// packet-forward-middleware to chain B, with the packet addressed on chain C to
+// one of its bridge targets, and verifies that chain C's VM intercepts the
+// forwarded packet's acknowledgement only when configured to intercept before
+// forwarding.
+func (s *IntegrationTestSuite) TestForwardingModes() {
+	for _, mode := range []vtransfertypes.ForwardingMode{
+		vtransfertypes.InterceptBeforeForwarding,
+		vtransfertypes.InterceptAfterForwarding,
+	} {
+		mode := mode
+		s.Run(mode.String(), func() {
+			s.setupChains(appOptions{app.FlagVtransferForwardingMode: mode.String()})
+			s.Require().Equal(mode, s.GetApp(s.chainC).VtransferKeeper.GetForwardingMode())
+
+			pathAC := s.NewTransferPath(0, 2)
+			pathCB := s.NewTransferPath(2, 1)
+
+			_, _, senderAddr := testdata.KeyTestPubAddr()
+			_, _, hopTargetAddr := testdata.KeyTestPubAddr()
+			_, _, receiverAddr := testdata.KeyTestPubAddr()
+			hopTarget := hopTargetAddr.String()
+			s.RegisterBridgeTarget(s.chainC, hopTarget)
+
+			m := struct {
+				Forward packetforwardtypes.ForwardMetadata `json:"forward"`
+			}{}
+			m.Forward.Receiver = receiverAddr.String()
+			m.Forward.Port = pathCB.EndpointA.ChannelConfig.PortID
+			m.Forward.Channel = pathCB.EndpointA.ChannelID
+			memo, err := json.Marshal(m)
+			s.Require().NoError(err)
+
+			transferData := ibctransfertypes.NewFungibleTokenPacketData(
+				"uosmo", "1000000", senderAddr.String(), hopTarget, string(memo),
+			)
+			s.mintToAddress(s.chainA, senderAddr, transferData.Denom, transferData.Amount)
+
+			sendContext := s.chainA.GetContext()
+			err = s.TransferFromEndpoint(sendContext, pathAC.EndpointA, transferData)
+			s.Require().NoError(err)
+			sendPacket, err := ParsePacketFromEvents(sendContext.EventManager().Events())
+			s.Require().NoError(err)
+			s.coordinator.CommitBlock(s.chainA)
+
+			// Chain C forwards the packet to chain B.
+			err = pathAC.EndpointB.UpdateClient()
+			s.Require().NoError(err)
+			recvRes, err := pathAC.EndpointB.RecvPacketWithResult(sendPacket)
+			s.Require().NoError(err)
+			s.coordinator.CommitBlock(s.chainA, s.chainC)
+			s.assertActionQueue(s.chainC, []swingsettypes.InboundQueueRecord{})
+
+			forwardPacket, err := ParsePacketFromEvents(recvRes.GetEvents())
+			s.Require().NoError(err)
+			err = pathCB.EndpointB.UpdateClient()
+			s.Require().NoError(err)
+			forwardRes, err := pathCB.EndpointB.RecvPacketWithResult(forwardPacket)
+			s.Require().NoError(err)
+			s.coordinator.CommitBlock(s.chainB, s.chainC)
+
+			forwardAck, err := ParseAckFromEvents(forwardRes.GetEvents())
+			s.Require().NoError(err)
+			ackedPacket, err := ParsePacketFromFilteredEvents(forwardRes.GetEvents(), channeltypes.EventTypeWriteAck)
+			s.Require().NoError(err)
+
+			// Chain C acknowledges the original packet once chain B has
+			// acknowledged the forwarded one.
+			err = pathCB.EndpointA.UpdateClient()
+			s.Require().NoError(err)
+			ackRes, err := acknowledgePacketWithResult(pathCB.EndpointA, ackedPacket, forwardAck)
+			s.Require().NoError(err)
+			s.coordinator.CommitBlock(s.chainC)
+			_, ackErr := ParseAckFromEvents(ackRes.GetEvents())
+
+			records, err := swingsettesting.GetActionQueueRecords(s.T(), s.chainC.GetContext(), s.GetApp(s.chainC).SwingSetKeeper)
+			s.Require().NoError(err)
+			s.resetActionQueue(s.chainC)
+
+			switch mode {
+			case vtransfertypes.InterceptBeforeForwarding:
+				// The VM has taken over the acknowledgement of the original packet.
+				s.Require().Error(ackErr)
+				s.Require().Len(records, 1)
+				var record struct {
+					Action vibckeeper.WriteAcknowledgementEvent `json:"action"`
+				}
+				err = json.Unmarshal([]byte(records[0]), &record)
+				s.Require().NoError(err)
+				s.Equal("VTRANSFER_IBC_EVENT", record.Action.Type)
+				s.Equal("writeAcknowledgement", record.Action.Event)
+				s.Equal(hopTarget, record.Action.Target)
+				s.Equal(sendPacket.GetData(), record.Action.Packet.GetData())
+				s.Equal(sendPacket.GetSequence(), record.Action.Packet.GetSequence())
+			case vtransfertypes.InterceptAfterForwarding:
+				// The original packet was acknowledged without the VM seeing it.
+				s.Require().NoError(ackErr)
+				s.Require().Empty(records)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestSetInterceptTargets() {
+	agoricApp := s.GetApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	msgServer := vtransferkeeper.NewMsgServerImpl(agoricApp.VtransferKeeper)
+	querier := vtransferkeeper.Querier{Keeper: agoricApp.VtransferKeeper}
+
+	registered := s.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	s.RegisterBridgeTarget(s.chainA, registered)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	targets := []string{
+		s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+		s.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(),
+	}
+
+	_, err := msgServer.SetInterceptTargets(sdk.WrapSDKContext(ctx), vtransfertypes.NewMsgSetInterceptTargets(targets[0], targets))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// Governance targets are canonicalized, and merged with those registered by
+	// the VM.
+	msg := vtransfertypes.NewMsgSetInterceptTargets(authority, []string{targets[0], strings.ToUpper(targets[1]), registered})
+	s.Require().NoError(msg.ValidateBasic())
+	_, err = msgServer.SetInterceptTargets(sdk.WrapSDKContext(ctx), msg)
+	s.Require().NoError(err)
+
+	res, err := querier.InterceptTargets(sdk.WrapSDKContext(ctx), &vtransfertypes.QueryInterceptTargetsRequest{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(append([]string{registered}, targets...), res.Targets)
+
+	// Replacing the governance targets keeps those registered by the VM.
+	_, err = msgServer.SetInterceptTargets(sdk.WrapSDKContext(ctx), vtransfertypes.NewMsgSetInterceptTargets(authority, nil))
+	s.Require().NoError(err)
+
+	res, err = querier.InterceptTargets(sdk.WrapSDKContext(ctx), &vtransfertypes.QueryInterceptTargetsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{registered}, res.Targets)
+}
+
+func (s *IntegrationTestSuite) TestGovernedTargetAck() {
+	path := s.NewTransferPath(0, 1)
+
+	_, _, baseSenderAddr := testdata.KeyTestPubAddr()
+	_, _, baseReceiverAddr := testdata.KeyTestPubAddr()
+	baseReceiver := baseReceiverAddr.String()
+
+	// Governance intercepts the receiver, for which the VM has no app.
+	agoricApp := s.GetApp(s.chainB)
+	msgServer := vtransferkeeper.NewMsgServerImpl(agoricApp.VtransferKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err := msgServer.SetInterceptTargets(
+		sdk.WrapSDKContext(s.chainB.GetContext()),
+		vtransfertypes.NewMsgSetInterceptTargets(authority, []string{baseReceiver}),
+	)
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.chainB)
+	s.resetActionQueue(s.chainB)
+
+	transferData := ibctransfertypes.NewFungibleTokenPacketData(
+		"uosmo",
+		"1000000",
+		baseSenderAddr.String(),
+		baseReceiver,
+		"",
+	)
+	s.mintToAddress(s.chainA, baseSenderAddr, transferData.Denom, transferData.Amount)
+
+	sendContext := s.chainA.GetContext()
+	err = s.TransferFromEndpoint(sendContext, path.EndpointA, transferData)
+	s.Require().NoError(err)
+	sendPacket, err := ParsePacketFromEvents(sendContext.EventManager().Events())
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.chainA)
+
+	err = path.EndpointB.UpdateClient()
+	s.Require().NoError(err)
+	packetRes, err := path.EndpointB.RecvPacketWithResult(sendPacket)
+	s.Require().NoError(err)
+
+	// The transfer app's acknowledgement is written at once, without
+	// troubling the VM.
+	ackData, err := ParseAckFromEvents(packetRes.GetEvents())
+	s.Require().NoError(err)
+	expectedAck := channeltypes.NewResultAcknowledgement([]byte{1})
+	s.Require().Equal(expectedAck.Acknowledgement(), ackData)
+
+	s.coordinator.CommitBlock(s.chainB)
+	s.assertActionQueue(s.chainB, []swingsettypes.InboundQueueRecord{})
+}
+
+func (s *IntegrationTestSuite) TestOversizedMemo() {
+	path := s.NewTransferPath(0, 1)
+
+	_, _, baseSenderAddr := testdata.KeyTestPubAddr()
+	_, _, baseReceiverAddr := testdata.KeyTestPubAddr()
+	baseReceiver := baseReceiverAddr.String()
+
+	s.resetActionQueue(s.chainB)
+	s.RegisterBridgeTarget(s.chainB, baseReceiver)
+
+	transferData := ibctransfertypes.NewFungibleTokenPacketData(
+		"uosmo",
+		"1000000",
+		baseSenderAddr.String(),
+		baseReceiver,
+		strings.Repeat("x", vtransfertypes.DefaultMemoSizeLimit+1),
+	)
+	s.mintToAddress(s.chainA, baseSenderAddr, transferData.Denom, transferData.Amount)
+
+	sendContext := s.chainA.GetContext()
+	err := s.TransferFromEndpoint(sendContext, path.EndpointA, transferData)
+	s.Require().NoError(err)
+	sendPacket, err := ParsePacketFromEvents(sendContext.EventManager().Events())
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.chainA)
+
+	err = path.EndpointB.UpdateClient()
+	s.Require().NoError(err)
+	packetRes, err := path.EndpointB.RecvPacketWithResult(sendPacket)
+	s.Require().NoError(err)
+
+	// The packet was acknowledged synchronously with an error, without
+	// troubling the VM.
+	ackData, err := ParseAckFromEvents(packetRes.GetEvents())
+	s.Require().NoError(err)
+	expectedAck := channeltypes.NewErrorAcknowledgement(vtransfertypes.ErrMemoTooLarge)
+	s.Require().Equal(expectedAck.Acknowledgement(), ackData)
+
+	s.coordinator.CommitBlock(s.chainB)
+	s.assertActionQueue(s.chainB, []swingsettypes.InboundQueueRecord{})
+}
+
+func (s *IntegrationTestSuite) TestProvisionOnDeposit() {
+	path := s.NewTransferPath(0, 1)
+	ibcDenom := ibctransfertypes.ParseDenomTrace(
+		s.prependDenomTrace(path.EndpointB, "uosmo"),
+	).IBCDenom()
+
+	swingsetKeeper := s.GetApp(s.chainB).SwingSetKeeper
+	params := swingsetKeeper.GetParams(s.chainB.GetContext())
+	params.AutoProvisionMinDeposit = sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000000))
+	swingsetKeeper.SetParams(s.chainB.GetContext(), params)
+	s.resetActionQueue(s.chainB)
+
+	_, _, baseSenderAddr := testdata.KeyTestPubAddr()
+	deposit := func(receiver string) {
+		transferData := ibctransfertypes.NewFungibleTokenPacketData(
+			"uosmo",
+			"1000000",
+			baseSenderAddr.String(),
+			receiver,
+			"",
+		)
+		s.mintToAddress(s.chainA, baseSenderAddr, transferData.Denom, transferData.Amount)
+
+		sendContext := s.chainA.GetContext()
+		err := s.TransferFromEndpoint(sendContext, path.EndpointA, transferData)
+		s.Require().NoError(err)
+		sendPacket, err := ParsePacketFromEvents(sendContext.EventManager().Events())
+		s.Require().NoError(err)
+		s.coordinator.CommitBlock(s.chainA)
+
+		err = path.EndpointB.UpdateClient()
+		s.Require().NoError(err)
+		packetRes, err := path.EndpointB.RecvPacketWithResult(sendPacket)
+		s.Require().NoError(err)
+		s.coordinator.CommitBlock(s.chainB)
+
+		// The transfer succeeds whether or not the receiver is provisioned.
+		ackData, err := ParseAckFromEvents(packetRes.GetEvents())
+		s.Require().NoError(err)
+		var ack channeltypes.Acknowledgement
+		err = channeltypes.SubModuleCdc.UnmarshalJSON(ackData, &ack)
+		s.Require().NoError(err)
+		s.Require().True(ack.Success(), "got ack %s", ackData)
+	}
+	provisionActions := func() []string {
+		records, err := swingsettesting.GetActionQueueRecords(s.T(), s.chainB.GetContext(), swingsetKeeper)
+		s.Require().NoError(err)
+		provisioned := []string{}
+		for _, record := range records {
+			var parsed struct {
+				Action struct {
+					Type    string `json:"type"`
+					Address string `json:"address"`
+				} `json:"action"`
+			}
+			err = json.Unmarshal([]byte(record), &parsed)
+			s.Require().NoError(err)
+			if parsed.Action.Type == "PLEASE_PROVISION" {
+				provisioned = append(provisioned, parsed.Action.Address)
+			}
+		}
+		return provisioned
+	}
+
+	// A receiver that cannot pay the provisioning fee is not provisioned.
+	_, _, unfundedAddr := testdata.KeyTestPubAddr()
+	deposit(unfundedAddr.String())
+	s.Require().Empty(provisionActions())
+
+	// One that can is provisioned once, however many deposits it receives.
+	_, _, fundedAddr := testdata.KeyTestPubAddr()
+	s.mintToAddress(s.chainB, fundedAddr, "uist", "1000000")
+	deposit(fundedAddr.String())
+	s.Require().Equal([]string{fundedAddr.String()}, provisionActions())
+	deposit(fundedAddr.String())
+	s.Require().Equal([]string{fundedAddr.String()}, provisionActions())
+	s.resetActionQueue(s.chainB)
+}
+
+func (s *IntegrationTestSuite) TestTypedErrorAck() {
+	path := s.NewTransferPath(0, 1)
+
+	_, _, baseSenderAddr := testdata.KeyTestPubAddr()
+	_, _, baseReceiverAddr := testdata.KeyTestPubAddr()
+	baseReceiver := baseReceiverAddr.String()
+
+	s.RegisterBridgeTarget(s.chainB, baseReceiver)
+
+	transferData := ibctransfertypes.NewFungibleTokenPacketData(
+		"uosmo",
+		"1000000",
+		baseSenderAddr.String(),
+		baseReceiver,
+		"",
+	)
+	s.mintToAddress(s.chainA, baseSenderAddr, transferData.Denom, transferData.Amount)
+
+	sendContext := s.chainA.GetContext()
+	err := s.TransferFromEndpoint(sendContext, path.EndpointA, transferData)
+	s.Require().NoError(err)
+	sendPacket, err := ParsePacketFromEvents(sendContext.EventManager().Events())
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.chainA)
+
+	err = path.EndpointB.UpdateClient()
+	s.Require().NoError(err)
+	_, err = path.EndpointB.RecvPacketWithResult(sendPacket)
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.chainB)
+
+	// The VM refuses the transfer with a typed error acknowledgement, as made
+	// by makeTypedErrorAck of an active tap, in a receiveExecuted downcall
+	// over the bridge.
+	vmAckContext := s.chainB.GetContext()
+	agdServer := s.GetApp(s.chainB).AgdServer
+	restoreContext := agdServer.SetControllerContext(vmAckContext)
+	bz, err := json.Marshal(struct {
+		Type   string              `json:"type"`
+		Method string              `json:"method"`
+		Packet channeltypes.Packet `json:"packet"`
+		Ack    []byte              `json:"ack"`
+	}{
+		Type:   "IBC_METHOD",
+		Method: "receiveExecuted",
+		Packet: sendPacket,
+		Ack:    []byte(`{"error":"too many transfers","code":"rate_limited","retryAfter":60}`),
+	})
+	s.Require().NoError(err)
+	var reply string
+	err = agdServer.ReceiveMessage(
+		&vm.Message{
+			Port: agdServer.GetPort("vtransfer"),
+			Data: string(bz),
+		},
+		&reply,
+	)
+	restoreContext()
+	s.Require().NoError(err)
+
+	events := vmAckContext.EventManager().Events()
+	ackData, err := ParseAckFromEvents(events)
+	s.Require().NoError(err)
+	expectedAck := channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: "vtransfer ack error 5 (rate_limited, transient): too many transfers; retry after 60s",
+		},
+	}
+	s.Require().Equal(expectedAck.Acknowledgement(), ackData)
+
+	var attrs map[string]string
+	for _, event := range events {
+		if event.Type != vtransfertypes.EventTypeAckError {
+			continue
+		}
+		attrs = map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+	}
+	s.Require().Equal(map[string]string{
+		sdk.AttributeKeyModule:                      vtransfertypes.ModuleName,
+		vtransfertypes.AttributeKeyPacketSrcPort:    sendPacket.SourcePort,
+		vtransfertypes.AttributeKeyPacketSrcChannel: sendPacket.SourceChannel,
+		vtransfertypes.AttributeKeyPacketSequence:   fmt.Sprint(sendPacket.Sequence),
+		vtransfertypes.AttributeKeyCode:             "5",
+		vtransfertypes.AttributeKeyCodeName:         "rate_limited",
+		vtransfertypes.AttributeKeyTransient:        "true",
+		vtransfertypes.AttributeKeyReason:           "too many transfers",
+		vtransfertypes.AttributeKeyRetryAfter:       "60",
+	}, attrs)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// InterceptTargets returns the addresses whose ICS-20 transfers are intercepted,
+// whether watched by the VM or governed.
+func (k Querier) InterceptTargets(c context.Context, req *types.QueryInterceptTargetsRequest) (*types.QueryInterceptTargetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addresses, err := k.GetWatchedAddresses(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	seen := make(map[string]bool, len(addresses))
+	targets := make([]string, 0, len(addresses))
+	for _, addr := range append(addresses, k.GetGovernedAddresses(ctx)...) {
+		target := addr.String()
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+	sort.Strings(targets)
+	return &types.QueryInterceptTargetsResponse{Targets: targets}, nil
+}
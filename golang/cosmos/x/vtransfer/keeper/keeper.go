@@ -19,7 +19,9 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc"
 	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	vtransfertypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
@@ -29,6 +31,7 @@ import (
 
 var _ porttypes.ICS4Wrapper = (*Keeper)(nil)
 var _ porttypes.ICS4Wrapper = (*ics4Wrapper)(nil)
+var _ porttypes.ICS4Wrapper = (*forwardingICS4Wrapper)(nil)
 var _ vibctypes.ReceiverImpl = (*Keeper)(nil)
 var _ vm.PortHandler = (*Keeper)(nil)
 
@@ -39,7 +42,12 @@ var _ vm.PortHandler = (*Keeper)(nil)
 const (
 	packetDataStoreKeyPrefix     = "originalData/"
 	watchedAddressStoreKeyPrefix = "watchedAddress/"
-	watchedAddressSentinel       = "y"
+	// The addresses intercepted by governance, kept apart from those registered
+	// by the VM so that neither can remove the other's.  Only the VM can handle
+	// the packets of an address, so those of a governed address are handed to
+	// it only once it also registers the address.
+	governedAddressStoreKeyPrefix = "governedAddress/"
+	watchedAddressSentinel        = "y"
 )
 
 // Keeper handles the interceptions from the vtransfer IBC middleware, passing
@@ -59,6 +67,16 @@ type Keeper struct {
 
 	vibcModule porttypes.IBCModule
 
+	// authority is the address allowed to replace the governed addresses,
+	// normally the governance module account.
+	authority string
+
+	forwardingMode vtransfertypes.ForwardingMode
+
+	memoValidator *vtransfertypes.MemoValidator
+
+	depositProvisioner vtransfertypes.DepositProvisioner
+
 	// This is a pointer so that copies of the Keeper struct share the same mutable debug options.
 	debug *KeeperDebugOptions
 }
@@ -138,6 +156,29 @@ func NewICS4Wrapper(k Keeper, down porttypes.ICS4Wrapper) *ics4Wrapper {
 	return &ics4Wrapper{k: k, ICS4Wrapper: down}
 }
 
+// forwardingICS4Wrapper is the ICS4Wrapper given to packet-forward-middleware
+// when it is wrapped by vtransfer.  PFM acknowledges a forwarded packet
+// asynchronously, once the next hop has been acknowledged, so this wrapper
+// gives the VM the same chance to intercept that acknowledgement as it has for
+// the synchronous ones in InterceptOnRecvPacket.
+type forwardingICS4Wrapper struct {
+	Keeper
+}
+
+func (fw forwardingICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	syncAck, origPacket := fw.InterceptWriteAcknowledgement(ctx, chanCap, packet, ack)
+	if syncAck == nil {
+		// The VM has taken over the ack.
+		return nil
+	}
+	return fw.Keeper.WriteAcknowledgement(ctx, chanCap, origPacket, syncAck)
+}
+
 // NewKeeper creates a new vtransfer Keeper instance
 func NewKeeper(
 	cdc codec.Codec,
@@ -145,6 +186,8 @@ func NewKeeper(
 	prototypeVibcKeeper vibc.Keeper,
 	scopedTransferKeeper capabilitykeeper.ScopedKeeper,
 	pushAction vm.ActionPusher,
+	authority string,
+	forwardingMode vtransfertypes.ForwardingMode,
 ) Keeper {
 	wrappedPushAction := wrapActionPusher(pushAction)
 
@@ -158,6 +201,9 @@ func NewKeeper(
 		key:        key,
 		vibcModule: vibc.NewIBCModule(vibcKeeper),
 		cdc:        cdc,
+		authority:  authority,
+
+		forwardingMode: forwardingMode,
 
 		debug: &KeeperDebugOptions{
 			OverridePacket: nil,
@@ -188,10 +234,35 @@ func wrapActionPusher(pusher vm.ActionPusher) vm.ActionPusher {
 	}
 }
 
+// GetICS4Wrapper returns the ICS4Wrapper to be used by packet-forward-middleware.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	if k.forwardingMode == vtransfertypes.InterceptBeforeForwarding {
+		return forwardingICS4Wrapper{Keeper: k}
+	}
 	return k
 }
 
+// WithMemoValidator returns a copy of the keeper that screens the memos of
+// inbound packets with mv before delivering them to the VM.
+func (k Keeper) WithMemoValidator(mv *vtransfertypes.MemoValidator) Keeper {
+	k.memoValidator = mv
+	return k
+}
+
+// WithDepositProvisioner returns a copy of the keeper that offers each
+// successful inbound transfer to an unwatched account to dp, so that it may
+// provision a smart wallet for the receiver.
+func (k Keeper) WithDepositProvisioner(dp vtransfertypes.DepositProvisioner) Keeper {
+	k.depositProvisioner = dp
+	return k
+}
+
+// GetForwardingMode returns how the vtransfer middleware composes with
+// packet-forward-middleware.
+func (k Keeper) GetForwardingMode() vtransfertypes.ForwardingMode {
+	return k.forwardingMode
+}
+
 func (k Keeper) GetReceiverImpl() vibctypes.ReceiverImpl {
 	return k
 }
@@ -239,11 +310,22 @@ func (k Keeper) PacketStoreFromOrigin(ctx sdk.Context, ourOrigin types.PacketOri
 func (k Keeper) InterceptOnRecvPacket(ctx sdk.Context, ibcModule porttypes.IBCModule, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
 	// Pass every (stripped-receiver) inbound packet to the wrapped IBC module.
 	var strippedPacket channeltypes.Packet
-	_, err := types.ExtractBaseAddressFromPacket(k.cdc, packet, types.RoleReceiver, &strippedPacket)
+	baseReceiver, err := types.ExtractBaseAddressFromPacket(k.cdc, packet, types.RoleReceiver, &strippedPacket)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	// Refuse memos that the VM should never have to process.
+	if k.memoValidator != nil && k.targetIsWatched(ctx, baseReceiver) {
+		var transferData transfertypes.FungibleTokenPacketData
+		if err := k.cdc.UnmarshalJSON(packet.GetData(), &transferData); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		if err := k.memoValidator.Validate(transferData.Memo); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
 	portID := packet.GetDestPort()
 	channelID := packet.GetDestChannel()
 	capName := host.ChannelCapabilityPath(portID, channelID)
@@ -264,11 +346,52 @@ func (k Keeper) InterceptOnRecvPacket(ctx sdk.Context, ibcModule porttypes.IBCMo
 		return nil
 	}
 
+	if ack.Success() && !k.targetIsWatched(ctx, baseReceiver) {
+		// The transfer succeeded regardless, so a failure to provision the
+		// receiver (such as for want of the provisioning fee) doesn't refuse it.
+		if err := k.provisionOnDeposit(ctx, strippedPacket, baseReceiver); err != nil {
+			ctx.Logger().Error("failed to provision smart wallet on deposit", "receiver", baseReceiver, "err", err)
+		}
+	}
+
 	// Give the VM a chance to write (or override) the ack.
 	syncAck, _ := k.InterceptWriteAcknowledgement(ctx, chanCap, packet, ack)
 	return syncAck
 }
 
+// provisionOnDeposit offers the coins that packet transferred to receiver to
+// the deposit provisioner, if any.
+func (k Keeper) provisionOnDeposit(ctx sdk.Context, packet channeltypes.Packet, receiver string) error {
+	if k.depositProvisioner == nil {
+		return nil
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := k.cdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return err
+	}
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return err
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return fmt.Errorf("invalid transfer amount %q", data.Amount)
+	}
+
+	// Find the denom of the coins received, as in the transfer module.
+	var denomPath string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denomPath = data.Denom[len(voucherPrefix):]
+	} else {
+		denomPath = transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	}
+	denom := transfertypes.ParseDenomTrace(denomPath).IBCDenom()
+
+	_, err = k.depositProvisioner.ProvisionOnDeposit(ctx, receiverAddr, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+	return err
+}
+
 // InterceptOnAcknowledgementPacket checks to see if the packet sender is a
 // targeted account, and if so, delegates to the VM.
 func (k Keeper) InterceptOnAcknowledgementPacket(
@@ -292,8 +415,8 @@ func (k Keeper) InterceptOnAcknowledgementPacket(
 
 	modErr := ibcModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 
-	// If the sender is not a watched account, we're done.
-	if !k.targetIsWatched(ctx, baseSender) {
+	// If the sender is not registered by the VM, we're done.
+	if !k.targetIsRegistered(ctx, baseSender) {
 		return modErr
 	}
 
@@ -330,8 +453,8 @@ func (k Keeper) InterceptOnTimeoutPacket(
 	// Pass every stripped-sender timeout to the wrapped IBC module.
 	modErr := ibcModule.OnTimeoutPacket(ctx, packet, relayer)
 
-	// If the sender is not a watched account, we're done.
-	if !k.targetIsWatched(ctx, baseSender) {
+	// If the sender is not registered by the VM, we're done.
+	if !k.targetIsRegistered(ctx, baseSender) {
 		return modErr
 	}
 
@@ -345,8 +468,10 @@ func (k Keeper) InterceptOnTimeoutPacket(
 	return vmErr
 }
 
-// InterceptWriteAcknowledgement checks to see if the packet's receiver is a
-// targeted account, and if so, delegates to the VM.
+// InterceptWriteAcknowledgement checks to see if the packet's receiver is
+// registered by the VM, and if so, delegates to the VM.  The acknowledgement
+// of any other receiver, including a governed address that the VM has not
+// registered, is written as is.
 func (k Keeper) InterceptWriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) (ibcexported.Acknowledgement, ibcexported.PacketI) {
 	// Get the base receiver from the packet, without computing a stripped packet.
 	baseReceiver, err := types.ExtractBaseAddressFromPacket(k.cdc, packet, types.RoleReceiver, nil)
@@ -364,8 +489,9 @@ func (k Keeper) InterceptWriteAcknowledgement(ctx sdk.Context, chanCap *capabili
 		packetStore.Delete(packetKey)
 	}
 
-	if err != nil || !k.targetIsWatched(ctx, baseReceiver) {
-		// We can't parse, or not watching, but that means just to ack directly.
+	if err != nil || !k.targetIsRegistered(ctx, baseReceiver) {
+		// We can't parse, or the VM has no app for the receiver, but that means
+		// just to ack directly.
 		return ack, origPacket
 	}
 
@@ -379,8 +505,49 @@ func (k Keeper) InterceptWriteAcknowledgement(ctx sdk.Context, chanCap *capabili
 	return nil, origPacket
 }
 
-// targetIsWatched checks if a target address has been watched by the VM.
-func (k Keeper) targetIsWatched(ctx sdk.Context, target string) bool {
+// ReceiveWriteAcknowledgement writes the acknowledgement of a packet received
+// by a watched target, as the VM has decided it, replacing a typed error
+// acknowledgement by its ICS-20 one.
+func (k Keeper) ReceiveWriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ReceiverImpl.ReceiveWriteAcknowledgement(ctx, packet, k.typedAcknowledgement(ctx, packet, ack))
+}
+
+// ReceiveWritePendingAcknowledgement is ReceiveWriteAcknowledgement for a
+// packet recorded by the vibc keeper rather than retained by the VM.
+func (k Keeper) ReceiveWritePendingAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ack ibcexported.Acknowledgement) error {
+	if packet, ok := k.vibcKeeper.GetPendingAckPacket(ctx, portID, channelID, sequence); ok {
+		ack = k.typedAcknowledgement(ctx, packet, ack)
+	}
+	return k.ReceiverImpl.ReceiveWritePendingAcknowledgement(ctx, portID, channelID, sequence, ack)
+}
+
+// typedAcknowledgement returns the acknowledgement to write for packet in
+// place of the one from the VM.  A typed error acknowledgement is replaced
+// by its ICS-20 one and recorded in an event for relayers; any other is
+// returned unchanged.
+func (k Keeper) typedAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) ibcexported.Acknowledgement {
+	ackErr, ok := vtransfertypes.ParseAckError(ack.Acknowledgement())
+	if !ok {
+		return ack
+	}
+	ctx.EventManager().EmitEvent(vtransfertypes.NewAckErrorEvent(packet, ackErr))
+	return ackErr.Acknowledgement()
+}
+
+// IsPacketTargeted reports whether the VM is notified of a transfer packet on
+// our side: by its receiver if role is RoleReceiver (as when we receive it),
+// else by its sender (as when it is acknowledged or times out).
+func (k Keeper) IsPacketTargeted(ctx sdk.Context, packet ibcexported.PacketI, role types.AddressRole) bool {
+	target, err := types.ExtractBaseAddressFromData(k.cdc, packet.GetData(), role, nil)
+	if err != nil {
+		return false
+	}
+	return k.targetIsRegistered(ctx, target)
+}
+
+// targetIsRegistered checks if a target address has been watched by the VM,
+// which has registered an app to handle its packets.
+func (k Keeper) targetIsRegistered(ctx sdk.Context, target string) bool {
 	prefixStore := prefix.NewStore(
 		ctx.KVStore(k.key),
 		[]byte(watchedAddressStoreKeyPrefix),
@@ -388,6 +555,21 @@ func (k Keeper) targetIsWatched(ctx sdk.Context, target string) bool {
 	return prefixStore.Has([]byte(target))
 }
 
+// targetIsWatched checks if a target address has been watched by the VM, or is
+// intercepted by governance, so that its inbound transfers are held to the
+// memo validation of intercepted transfers rather than provisioning a wallet.
+func (k Keeper) targetIsWatched(ctx sdk.Context, target string) bool {
+	if k.targetIsRegistered(ctx, target) {
+		return true
+	}
+	addr, err := sdk.AccAddressFromBech32(target)
+	if err != nil {
+		return false
+	}
+	governedStore := prefix.NewStore(ctx.KVStore(k.key), []byte(governedAddressStoreKeyPrefix))
+	return governedStore.Has(addr)
+}
+
 // GetWatchedAdresses returns the watched addresses from the keeper as a slice
 // of account addresses.
 func (k Keeper) GetWatchedAddresses(ctx sdk.Context) ([]sdk.AccAddress, error) {
@@ -417,6 +599,43 @@ func (k Keeper) SetWatchedAddresses(ctx sdk.Context, addresses []sdk.AccAddress)
 	}
 }
 
+// GetGovernedAddresses returns the addresses intercepted by governance.
+func (k Keeper) GetGovernedAddresses(ctx sdk.Context) []sdk.AccAddress {
+	addresses := make([]sdk.AccAddress, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.key), []byte(governedAddressStoreKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(prefixStore, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(append([]byte{}, iterator.Key()...)))
+	}
+	return addresses
+}
+
+// ReplaceGovernedAddresses replaces the addresses intercepted by governance,
+// leaving those watched by the VM in place.
+func (k Keeper) ReplaceGovernedAddresses(ctx sdk.Context, addresses []sdk.AccAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.key), []byte(governedAddressStoreKeyPrefix))
+
+	var oldKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(prefixStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range oldKeys {
+		prefixStore.Delete(key)
+	}
+
+	for _, addr := range addresses {
+		prefixStore.Set(addr, []byte(watchedAddressSentinel))
+	}
+}
+
+// GetAuthority returns the address allowed to replace the governed addresses.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 type registrationAction struct {
 	Type   string `json:"type"` // BRIDGE_TARGET_REGISTER or BRIDGE_TARGET_UNREGISTER
 	Target string `json:"target"`
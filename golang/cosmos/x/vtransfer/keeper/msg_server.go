@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vtransfer MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (keeper msgServer) SetInterceptTargets(goCtx context.Context, msg *types.MsgSetInterceptTargets) (*types.MsgSetInterceptTargetsResponse, error) {
+	if msg.Authority != keeper.GetAuthority() {
+		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", keeper.GetAuthority(), msg.Authority)
+	}
+	addresses := make([]sdk.AccAddress, len(msg.Targets))
+	for i, target := range msg.Targets {
+		addr, err := sdk.AccAddressFromBech32(target)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = addr
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	keeper.ReplaceGovernedAddresses(ctx, addresses)
+	return &types.MsgSetInterceptTargetsResponse{}, nil
+}
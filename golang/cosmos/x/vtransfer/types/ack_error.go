@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// AckErrorCode classifies why the VM refused an intercepted packet, so that
+// counterparty chains and relayers can tell transient failures, which may
+// succeed if the transfer is retried, from permanent ones.
+type AckErrorCode uint32
+
+const (
+	// AckErrorUnknown is the code of a typed error acknowledgement whose code
+	// is missing or unrecognized.  It is permanent.
+	AckErrorUnknown AckErrorCode = iota + 1
+	// AckErrorRejected means that the receiver refused the transfer.
+	AckErrorRejected
+	// AckErrorInvalidPacket means that the packet data, such as its memo, is
+	// not acceptable to the receiver.
+	AckErrorInvalidPacket
+	// AckErrorUnavailable means that the receiver could not handle the
+	// transfer at the time, but may later.
+	AckErrorUnavailable
+	// AckErrorRateLimited means that the receiver refused the transfer for
+	// exceeding a rate limit, and may accept it after the retry-after delay.
+	AckErrorRateLimited
+)
+
+var ackErrorCodeNames = map[AckErrorCode]string{
+	AckErrorUnknown:       "unknown",
+	AckErrorRejected:      "rejected",
+	AckErrorInvalidPacket: "invalid_packet",
+	AckErrorUnavailable:   "unavailable",
+	AckErrorRateLimited:   "rate_limited",
+}
+
+// String returns the name of the code, as used by the VM.
+func (c AckErrorCode) String() string {
+	if name, ok := ackErrorCodeNames[c]; ok {
+		return name
+	}
+	return ackErrorCodeNames[AckErrorUnknown]
+}
+
+// IsTransient reports whether a transfer refused with the code may succeed if
+// it is retried.
+func (c AckErrorCode) IsTransient() bool {
+	return c == AckErrorUnavailable || c == AckErrorRateLimited
+}
+
+// ParseAckErrorCode returns the code of the given name, or AckErrorUnknown if
+// there is none.
+func ParseAckErrorCode(name string) AckErrorCode {
+	for code, codeName := range ackErrorCodeNames {
+		if codeName == name {
+			return code
+		}
+	}
+	return AckErrorUnknown
+}
+
+// AckError is a typed error acknowledgement of the VM, which writes it as the
+// JSON text of
+//
+//	{"error": "<reason>", "code": "<code name>", "retryAfter": <seconds>}
+//
+// where retryAfter is optional.  The middleware replaces it with the ICS-20
+// error acknowledgement of its Acknowledgement, since counterparty transfer
+// modules refuse acknowledgements with unknown fields.
+type AckError struct {
+	Code   AckErrorCode
+	Reason string
+	// RetryAfter is the number of seconds after which a transient failure
+	// may be retried, or 0 if unspecified.
+	RetryAfter uint64
+}
+
+// vmAckError is the JSON encoding of an AckError by the VM.
+type vmAckError struct {
+	Error      string `json:"error"`
+	Code       string `json:"code"`
+	RetryAfter uint64 `json:"retryAfter"`
+}
+
+// ParseAckError returns the AckError of the acknowledgement bytes written by
+// the VM, if they are those of a typed error acknowledgement rather than an
+// untyped one such as {"error": "<reason>"}.
+func ParseAckError(ack []byte) (AckError, bool) {
+	var vmAck vmAckError
+	if err := json.Unmarshal(ack, &vmAck); err != nil || vmAck.Code == "" {
+		return AckError{}, false
+	}
+	return AckError{
+		Code:       ParseAckErrorCode(vmAck.Code),
+		Reason:     vmAck.Error,
+		RetryAfter: vmAck.RetryAfter,
+	}, true
+}
+
+// Error returns the canonical rendering of the AckError, such as
+//
+//	vtransfer ack error 5 (rate_limited, transient): too many transfers; retry after 60s
+func (e AckError) Error() string {
+	kind := "permanent"
+	if e.Code.IsTransient() {
+		kind = "transient"
+	}
+	msg := fmt.Sprintf("%s ack error %d (%s, %s): %s", ModuleName, e.Code, e.Code, kind, e.Reason)
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf("; retry after %ds", e.RetryAfter)
+	}
+	return msg
+}
+
+// Acknowledgement returns the ICS-20 error acknowledgement of the AckError,
+// whose error is its canonical rendering.
+func (e AckError) Acknowledgement() ibcexported.Acknowledgement {
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{Error: e.Error()},
+	}
+}
+
+const (
+	EventTypeAckError = "vtransfer_ack_error"
+
+	AttributeKeyPacketSrcPort    = "packet_src_port"
+	AttributeKeyPacketSrcChannel = "packet_src_channel"
+	AttributeKeyPacketSequence   = "packet_sequence"
+	AttributeKeyCode             = "code"
+	AttributeKeyCodeName         = "code_name"
+	AttributeKeyTransient        = "transient"
+	AttributeKeyReason           = "reason"
+	AttributeKeyRetryAfter       = "retry_after"
+)
+
+// NewAckErrorEvent returns the event recording the typed error
+// acknowledgement of packet, for relayers.
+func NewAckErrorEvent(packet ibcexported.PacketI, ackErr AckError) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeAckError,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPacketSrcPort, packet.GetSourcePort()),
+		sdk.NewAttribute(AttributeKeyPacketSrcChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(AttributeKeyCode, strconv.FormatUint(uint64(ackErr.Code), 10)),
+		sdk.NewAttribute(AttributeKeyCodeName, ackErr.Code.String()),
+		sdk.NewAttribute(AttributeKeyTransient, strconv.FormatBool(ackErr.Code.IsTransient())),
+		sdk.NewAttribute(AttributeKeyReason, ackErr.Reason),
+		sdk.NewAttribute(AttributeKeyRetryAfter, strconv.FormatUint(ackErr.RetryAfter, 10)),
+	)
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestParseAckError(t *testing.T) {
+	cases := []struct {
+		name    string
+		ack     string
+		typed   bool
+		want    AckError
+		wantMsg string
+	}{
+		{
+			name:    "transient",
+			ack:     `{"error":"too many transfers","code":"rate_limited","retryAfter":60}`,
+			typed:   true,
+			want:    AckError{Code: AckErrorRateLimited, Reason: "too many transfers", RetryAfter: 60},
+			wantMsg: "vtransfer ack error 5 (rate_limited, transient): too many transfers; retry after 60s",
+		},
+		{
+			name:    "permanent",
+			ack:     `{"error":"unknown vault","code":"rejected"}`,
+			typed:   true,
+			want:    AckError{Code: AckErrorRejected, Reason: "unknown vault"},
+			wantMsg: "vtransfer ack error 2 (rejected, permanent): unknown vault",
+		},
+		{
+			name:    "unrecognized code",
+			ack:     `{"error":"oops","code":"no_such_code"}`,
+			typed:   true,
+			want:    AckError{Code: AckErrorUnknown, Reason: "oops"},
+			wantMsg: "vtransfer ack error 1 (unknown, permanent): oops",
+		},
+		{
+			name: "untyped error",
+			ack:  `{"error":"oops"}`,
+		},
+		{
+			name: "result",
+			ack:  `{"result":"AQ=="}`,
+		},
+		{
+			name: "not JSON",
+			ack:  `ack`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, typed := ParseAckError([]byte(tc.ack))
+			if typed != tc.typed {
+				t.Fatalf("got typed %v, want %v", typed, tc.typed)
+			}
+			if !typed {
+				return
+			}
+			if got != tc.want {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+			if msg := got.Error(); msg != tc.wantMsg {
+				t.Errorf("got message %q, want %q", msg, tc.wantMsg)
+			}
+			ack := got.Acknowledgement()
+			if ack.Success() {
+				t.Errorf("got successful acknowledgement %s", ack.Acknowledgement())
+			}
+		})
+	}
+}
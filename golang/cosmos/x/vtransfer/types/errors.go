@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+)
+
+// x/vtransfer module sentinel errors
+var (
+	ErrMemoTooLarge = sdkioerrors.Register(ModuleName, 2, "memo too large")
+	ErrInvalidMemo  = sdkioerrors.Register(ModuleName, 3, "invalid memo")
+)
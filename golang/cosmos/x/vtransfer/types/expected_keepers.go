@@ -36,3 +36,9 @@ type ConnectionKeeper interface {
 type PortKeeper interface {
 	BindPort(ctx sdk.Context, portID string) *capability.Capability
 }
+
+// DepositProvisioner provisions the smart wallets of the accounts that
+// receive qualifying deposits, such as the x/swingset keeper.
+type DepositProvisioner interface {
+	ProvisionOnDeposit(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (bool, error)
+}
@@ -0,0 +1,52 @@
+package types
+
+import "fmt"
+
+// ForwardingMode chooses how the vtransfer middleware composes with
+// packet-forward-middleware (PFM).
+type ForwardingMode int
+
+const (
+	// InterceptBeforeForwarding wraps PFM in vtransfer, so that the VM sees
+	// every inbound packet, including those that PFM forwards.  The
+	// acknowledgement that PFM eventually writes for a forwarded packet is
+	// intercepted like any other, with the original packet data (memo
+	// included) restored.
+	InterceptBeforeForwarding ForwardingMode = iota
+	// InterceptAfterForwarding wraps vtransfer in PFM, so that packets which
+	// PFM forwards bypass the VM entirely and only the remainder are
+	// intercepted.
+	InterceptAfterForwarding
+)
+
+// The names of the forwarding modes, as given in the node configuration.
+const (
+	InterceptBeforeForwardingName = "intercept-before-forwarding"
+	InterceptAfterForwardingName  = "intercept-after-forwarding"
+)
+
+// String returns the name of the forwarding mode.
+func (m ForwardingMode) String() string {
+	switch m {
+	case InterceptBeforeForwarding:
+		return InterceptBeforeForwardingName
+	case InterceptAfterForwarding:
+		return InterceptAfterForwardingName
+	default:
+		return fmt.Sprintf("ForwardingMode(%d)", int(m))
+	}
+}
+
+// ParseForwardingMode returns the forwarding mode named by name, which
+// defaults to InterceptBeforeForwarding if empty.
+func ParseForwardingMode(name string) (ForwardingMode, error) {
+	switch name {
+	case "", InterceptBeforeForwardingName:
+		return InterceptBeforeForwarding, nil
+	case InterceptAfterForwardingName:
+		return InterceptAfterForwarding, nil
+	default:
+		return 0, fmt.Errorf("unknown forwarding mode %q; expected %q or %q",
+			name, InterceptBeforeForwardingName, InterceptAfterForwardingName)
+	}
+}
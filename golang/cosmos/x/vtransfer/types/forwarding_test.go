@@ -0,0 +1,34 @@
+package types
+
+import "testing"
+
+func TestParseForwardingMode(t *testing.T) {
+	cases := []struct {
+		name    string
+		want    ForwardingMode
+		wantErr bool
+	}{
+		{"", InterceptBeforeForwarding, false},
+		{InterceptBeforeForwardingName, InterceptBeforeForwarding, false},
+		{InterceptAfterForwardingName, InterceptAfterForwarding, false},
+		{"after", 0, true},
+		{"Intercept-After-Forwarding", 0, true},
+	}
+	for _, tc := range cases {
+		got, err := ParseForwardingMode(tc.name)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseForwardingMode(%q) succeeded with %v, want error", tc.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseForwardingMode(%q) failed: %v", tc.name, err)
+		} else if got != tc.want {
+			t.Errorf("ParseForwardingMode(%q) = %v, want %v", tc.name, got, tc.want)
+		}
+		if tc.name != "" && got.String() != tc.name {
+			t.Errorf("%v.String() = %q, want %q", got, got.String(), tc.name)
+		}
+	}
+}
@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// The list of account addresses that are being watched by the VM.
 	WatchedAddresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,rep,name=watched_addresses,json=watchedAddresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"watched_addresses" yaml:"watched_addresses"`
+	// The account addresses that governance intercepts by
+	// MsgSetInterceptTargets, in addition to those watched by the VM.
+	GovernedAddresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,rep,name=governed_addresses,json=governedAddresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"governed_addresses" yaml:"governed_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +73,13 @@ func (m *GenesisState) GetWatchedAddresses() []github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+func (m *GenesisState) GetGovernedAddresses() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.GovernedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.vtransfer.GenesisState")
 }
@@ -77,23 +87,25 @@ func init() {
 func init() { proto.RegisterFile("agoric/vtransfer/genesis.proto", fileDescriptor_fd0b59a10ad6824e) }
 
 var fileDescriptor_fd0b59a10ad6824e = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
+	// 286 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4c, 0xcf, 0x2f,
 	0xca, 0x4c, 0xd6, 0x2f, 0x2b, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0xd2, 0x32,
-	0x46, 0x2e, 0x1e, 0x77, 0x88, 0xce, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0xa1, 0x7e, 0x46, 0x2e, 0xc1,
+	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0xd2, 0x1e,
+	0x26, 0x2e, 0x1e, 0x77, 0x88, 0xce, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0xa1, 0x7e, 0x46, 0x2e, 0xc1,
 	0xf2, 0xc4, 0x92, 0xe4, 0x8c, 0xd4, 0x94, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xd4,
 	0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x1e, 0xa7, 0xa4, 0x57, 0xf7, 0xe4, 0x31, 0x25, 0x3f, 0xdd,
 	0x93, 0x97, 0xa8, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0x90, 0x52, 0xfa, 0x75, 0x4f, 0x5e, 0x37,
 	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf,
 	0x18, 0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x39, 0x26, 0x27,
-	0x3b, 0x42, 0xf4, 0x04, 0x09, 0x40, 0x0d, 0x71, 0x84, 0x99, 0x61, 0xc5, 0xf2, 0x62, 0x81, 0x3c,
-	0x83, 0x53, 0xd8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xd9, 0x20, 0x59,
-	0xe0, 0x08, 0x09, 0x15, 0x88, 0xe7, 0xc1, 0x16, 0xa4, 0xe7, 0xe7, 0x24, 0xe6, 0xa5, 0xc3, 0x6c,
-	0xae, 0x40, 0x0a, 0x30, 0xb0, 0xd5, 0x49, 0x6c, 0xe0, 0x70, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x52, 0xc9, 0xd5, 0x51, 0x01, 0x00, 0x00,
+	0x3b, 0x42, 0xf4, 0x04, 0x09, 0x40, 0x0d, 0x71, 0x84, 0x99, 0x21, 0x34, 0x99, 0x91, 0x4b, 0x28,
+	0x3d, 0xbf, 0x2c, 0xb5, 0x28, 0x0f, 0xc5, 0x49, 0x4c, 0x60, 0x27, 0xa5, 0xbc, 0xba, 0x27, 0x8f,
+	0x45, 0xf6, 0xd3, 0x3d, 0x79, 0x49, 0x88, 0x9b, 0x30, 0xe5, 0xc8, 0x70, 0x94, 0x20, 0xcc, 0x14,
+	0xb8, 0xab, 0xac, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
+	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
+	0xc6, 0x63, 0x39, 0x86, 0x28, 0x1b, 0x24, 0x1b, 0x1c, 0x21, 0x71, 0x05, 0x89, 0x12, 0xb0, 0x0d,
+	0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x30, 0xab, 0x2b, 0x90, 0xa2, 0x11, 0x6c, 0x77, 0x12, 0x1b,
+	0x38, 0x76, 0x8c, 0x01, 0x03, 0x00, 0xdc, 0xdd, 0x2f, 0xf2, 0xe7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +128,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernedAddresses) > 0 {
+		for iNdEx := len(m.GovernedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GovernedAddresses[iNdEx])
+			copy(dAtA[i:], m.GovernedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GovernedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.WatchedAddresses) > 0 {
 		for iNdEx := len(m.WatchedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WatchedAddresses[iNdEx])
@@ -151,6 +172,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.GovernedAddresses) > 0 {
+		for _, b := range m.GovernedAddresses {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -221,6 +248,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			m.WatchedAddresses = append(m.WatchedAddresses, make([]byte, postIndex-iNdEx))
 			copy(m.WatchedAddresses[len(m.WatchedAddresses)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernedAddresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernedAddresses = append(m.GovernedAddresses, make([]byte, postIndex-iNdEx))
+			copy(m.GovernedAddresses[len(m.GovernedAddresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+
+	sdkioerrors "cosmossdk.io/errors"
+)
+
+// DefaultMemoSizeLimit is the largest ICS-20 memo, in bytes, that will be
+// delivered to the VM.  It matches the memo limit of later ibc-go releases.
+const DefaultMemoSizeLimit = 32768
+
+// MemoFieldValidator checks the value of a single top-level field of a JSON
+// memo.
+type MemoFieldValidator func(value json.RawMessage) error
+
+// MemoValidator screens the memos of ICS-20 packets before they are queued
+// for the VM.  A nil *MemoValidator accepts every memo.
+type MemoValidator struct {
+	// SizeLimit is the maximum memo length in bytes, or 0 for no limit.
+	SizeLimit int
+	// RequireJSON, if true, rejects any non-empty memo which is not a JSON
+	// object.  Otherwise, such memos have no fields for the schemas to check.
+	RequireJSON bool
+
+	schemas map[string]MemoFieldValidator
+}
+
+// NewMemoValidator creates a MemoValidator with the given size limit and no
+// registered schemas.
+func NewMemoValidator(sizeLimit int) *MemoValidator {
+	return &MemoValidator{
+		SizeLimit: sizeLimit,
+		schemas:   map[string]MemoFieldValidator{},
+	}
+}
+
+// RegisterSchema arranges for the named top-level field of a JSON memo to be
+// checked by validate whenever it is present.
+func (mv *MemoValidator) RegisterSchema(field string, validate MemoFieldValidator) {
+	if _, ok := mv.schemas[field]; ok {
+		panic("memo schema already registered for field " + field)
+	}
+	mv.schemas[field] = validate
+}
+
+// Validate returns an ErrMemoTooLarge or ErrInvalidMemo error if the memo is
+// unacceptable.
+func (mv *MemoValidator) Validate(memo string) error {
+	if mv == nil {
+		return nil
+	}
+	if mv.SizeLimit > 0 && len(memo) > mv.SizeLimit {
+		return sdkioerrors.Wrapf(ErrMemoTooLarge, "memo of %d bytes exceeds limit of %d", len(memo), mv.SizeLimit)
+	}
+	if memo == "" || (!mv.RequireJSON && len(mv.schemas) == 0) {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil || fields == nil {
+		if mv.RequireJSON {
+			return sdkioerrors.Wrap(ErrInvalidMemo, "memo is not a JSON object")
+		}
+		return nil
+	}
+
+	// Check the fields in a deterministic order so that the same error is
+	// always reported.
+	names := make([]string, 0, len(mv.schemas))
+	for name := range mv.schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if err := mv.schemas[name](value); err != nil {
+			return sdkioerrors.Wrapf(ErrInvalidMemo, "field %q: %s", name, err)
+		}
+	}
+	return nil
+}
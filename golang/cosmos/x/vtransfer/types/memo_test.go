@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	sdkioerrors "cosmossdk.io/errors"
+)
+
+func TestMemoValidator(t *testing.T) {
+	lenient := NewMemoValidator(64)
+	strict := NewMemoValidator(0)
+	strict.RequireJSON = true
+	strict.RegisterSchema("forward", func(value json.RawMessage) error {
+		var forward struct {
+			Receiver string `json:"receiver"`
+		}
+		if err := json.Unmarshal(value, &forward); err != nil {
+			return err
+		}
+		if forward.Receiver == "" {
+			return errors.New("receiver is required")
+		}
+		return nil
+	})
+
+	cases := []struct {
+		name      string
+		validator *MemoValidator
+		memo      string
+		wantErr   *sdkioerrors.Error
+	}{
+		{"nil accepts anything", nil, strings.Repeat("x", 100000), nil},
+		{"empty", strict, "", nil},
+		{"within limit", lenient, "not JSON", nil},
+		{"too large", lenient, strings.Repeat("x", 65), ErrMemoTooLarge},
+		{"JSON not required", lenient, `{"forward":{}}`, nil},
+		{"JSON required", strict, "not JSON", ErrInvalidMemo},
+		{"JSON array", strict, `[1,2]`, ErrInvalidMemo},
+		{"unregistered field", strict, `{"wasm":{}}`, nil},
+		{"valid field", strict, `{"forward":{"receiver":"agoric1abc"}}`, nil},
+		{"invalid field", strict, `{"forward":{}}`, ErrInvalidMemo},
+		{"malformed field", strict, `{"forward":"abc"}`, ErrInvalidMemo},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.memo)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Validate() unexpected error %v", err)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
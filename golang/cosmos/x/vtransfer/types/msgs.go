@@ -1,9 +1,63 @@
 package types
 
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 const RouterKey = ModuleName // this was defined in your key.go file
 
+var _ sdk.Msg = &MsgSetInterceptTargets{}
+
 type InvokeMemo struct {
 	InvokeOnAcknowledgementPacket string `json:"invokeOnAcknowledgementPacket"`
 	InvokeOnTimeoutPacket         string `json:"invokeOnTimeoutPacket"`
 	InvokeWriteAcknowledgement    string `json:"invokeWriteAcknowledgement"`
 }
+
+func NewMsgSetInterceptTargets(authority string, targets []string) *MsgSetInterceptTargets {
+	return &MsgSetInterceptTargets{
+		Authority: authority,
+		Targets:   targets,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetInterceptTargets) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetInterceptTargets) Type() string { return "setInterceptTargets" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetInterceptTargets) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address: %s", err)
+	}
+	seen := make(map[string]bool, len(msg.Targets))
+	for _, target := range msg.Targets {
+		addr, err := sdk.AccAddressFromBech32(target)
+		if err != nil {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid target address %q: %s", target, err)
+		}
+		if seen[addr.String()] {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Duplicate target address %q", target)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetInterceptTargets) GetSignBytes() []byte {
+	if msg.Targets == nil {
+		msg.Targets = []string{}
+	}
+	return sdk.MustSortJSON(ModuleAminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetInterceptTargets) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
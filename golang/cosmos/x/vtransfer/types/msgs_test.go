@@ -0,0 +1,35 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSetInterceptTargets_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+	target1 := sdk.AccAddress([]byte("target1_____________")).String()
+	target2 := sdk.AccAddress([]byte("target2_____________")).String()
+
+	cases := []struct {
+		name    string
+		msg     *MsgSetInterceptTargets
+		wantErr bool
+	}{
+		{"empty targets", NewMsgSetInterceptTargets(authority, nil), false},
+		{"targets", NewMsgSetInterceptTargets(authority, []string{target1, target2}), false},
+		{"bad authority", NewMsgSetInterceptTargets("", []string{target1}), true},
+		{"bad target", NewMsgSetInterceptTargets(authority, []string{"agoric1bogus"}), true},
+		{"duplicate target", NewMsgSetInterceptTargets(authority, []string{target1, target1}), true},
+		{"duplicate target in another case", NewMsgSetInterceptTargets(authority, []string{target1, strings.ToUpper(target1)}), true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
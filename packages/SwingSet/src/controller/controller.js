@@ -492,6 +492,28 @@ export async function makeSwingsetController(
       assert(reasonCD.slots.length === 0, 'no slots allowed in reason');
       kernel.terminateVatExternally(vatID, reasonCD);
     },
+
+    /**
+     * Suspend a vat: until it is resumed, the messages and promise
+     * notifications bound for it are held in the kernel rather than
+     * delivered. The host must commit the change like any other kernel
+     * state.
+     *
+     * @param {VatID} vatID
+     */
+    suspendVat(vatID) {
+      kernel.suspendVat(vatID);
+    },
+
+    /**
+     * Resume a suspended vat, queueing the deliveries held for it in the
+     * order they arrived. Resuming a vat that is not suspended does nothing.
+     *
+     * @param {VatID} vatID
+     */
+    resumeVat(vatID) {
+      kernel.resumeVat(vatID);
+    },
   });
 
   writeSlogObject({ type: 'kernel-init-finish' });
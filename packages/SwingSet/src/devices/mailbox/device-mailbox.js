@@ -87,5 +87,13 @@ export function buildRootDeviceNode(tools) {
         Fail`error in ackInbound: ${e}`;
       }
     },
+
+    removePeer(peer) {
+      try {
+        endowments.removePeer(`${peer}`);
+      } catch (e) {
+        Fail`error in removePeer: ${e}`;
+      }
+    },
   });
 }
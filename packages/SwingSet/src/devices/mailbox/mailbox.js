@@ -180,10 +180,15 @@ export function buildMailboxStateMap(state = harden(new Map())) {
     getOrCreatePeer(state, `${peer}`).ack = Nat(msgnum);
   }
 
+  function removePeer(peer) {
+    state.delete(`${peer}`);
+  }
+
   return harden({
     add,
     remove,
     setAcknum,
+    removePeer,
   });
 }
 
@@ -209,6 +214,10 @@ export function buildMailbox(state) {
     state.setAcknum(`${peer}`, Nat(msgnum));
   }
 
+  function removePeer(peer) {
+    state.removePeer(`${peer}`);
+  }
+
   // deliverInbound is made available to the host; it is used for inbound
   // messages and acks. The outbound direction uses the mailboxState object.
   // deliverInbound returns true if something changed, and the caller should
@@ -225,7 +234,13 @@ export function buildMailbox(state) {
   // deliverInbound is called to deliver each incoming message.
   return {
     srcPath,
-    endowments: { registerInboundCallback, add, remove, setAcknum },
+    endowments: {
+      registerInboundCallback,
+      add,
+      remove,
+      setAcknum,
+      removePeer,
+    },
     deliverInbound,
   };
 }
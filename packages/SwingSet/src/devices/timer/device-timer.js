@@ -262,6 +262,22 @@ export function buildRootDeviceNode(tools) {
   };
   endowments.registerDevicePollFunction(harden(poll));
 
+  // Older hosts don't ask for stats.
+  const getStats = () => {
+    const schedule = deadlines.cloneSchedule();
+    let pendingWakeups = 0;
+    for (const { handlers } of schedule) {
+      pendingWakeups += handlers.length;
+    }
+    return harden({
+      lastPolled,
+      pendingWakeups,
+      repeaters: repeaters.filter(r => r !== undefined).length,
+      ...(schedule.length > 0 ? { nextWakeup: schedule[0].time } : {}),
+    });
+  };
+  endowments.registerDeviceStatsFunction?.(harden(getStats));
+
   // The Root Device Node. There are two ways to schedule a callback. The
   // first is a straight setWakeup(), which says how soon, and what object to
   // send wake() to. The second is to create a repeater, which makes it
@@ -15,11 +15,16 @@ import { Fail } from '@endo/errors';
 export function buildTimer() {
   const srcPath = new URL('./device-timer.js', import.meta.url).pathname;
   let devicePollFunction;
+  let deviceStatsFunction;
 
   function registerDevicePollFunction(pollFn) {
     devicePollFunction = pollFn;
   }
 
+  function registerDeviceStatsFunction(statsFn) {
+    deviceStatsFunction = statsFn;
+  }
+
   // poll() is made available to the host loop so it can provide the time.
   /** @type {(time: number | bigint) => boolean} */
   function poll(time) {
@@ -30,11 +35,21 @@ export function buildTimer() {
     }
   }
 
+  // getStats() reports the device's pending work to the host loop, or
+  // undefined before the device has been created.
+  /**
+   * @returns {{ lastPolled: bigint, pendingWakeups: number, repeaters: number, nextWakeup?: bigint } | undefined}
+   */
+  function getStats() {
+    return deviceStatsFunction && deviceStatsFunction();
+  }
+
   // srcPath and endowments are provided to buildRootDeviceNode() for use
   // during configuration.
   return {
     srcPath,
-    endowments: { registerDevicePollFunction },
+    endowments: { registerDevicePollFunction, registerDeviceStatsFunction },
     poll,
+    getStats,
   };
 }
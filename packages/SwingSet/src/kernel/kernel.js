@@ -291,6 +291,10 @@ export default function buildKernel(
       const vatKeeper = kernelKeeper.provideVatKeeper(vatID);
       critical = vatKeeper.getOptions().critical;
 
+      // anything held for a suspended vat goes back on the run-queue, to
+      // splat against the dead vat
+      kernelKeeper.resumeVat(vatID);
+
       // remove vatID from the list of live vats, and mark for
       // deletion (which will happen later, in vat-cleanup events)
       kernelKeeper.deleteVatID(vatID);
@@ -1298,7 +1302,12 @@ export default function buildKernel(
         decrementSendEventRefCount(message);
       } else {
         vatID = route.vatID;
-        if (vatID) {
+        if (vatID && kernelKeeper.isVatSuspended(vatID)) {
+          // Message is held until the vat is resumed, and stays the kernel's
+          // responsibility
+          kernelKeeper.addToSuspendedQueue(vatID, message);
+          vatID = undefined;
+        } else if (vatID) {
           decrementSendEventRefCount(message);
           deliverP = processSend(vatID, route.target, message.msg);
         } else {
@@ -1307,10 +1316,15 @@ export default function buildKernel(
           kernelKeeper.addMessageToPromiseQueue(route.target, message.msg);
         }
       }
-      // vatID will be undefined for splat or requeue, else vat of deliverry
+      // vatID will be undefined for splat, requeue, or hold, else vat of
+      // delivery
     } else if (message.type === 'notify') {
-      decrementNotifyEventRefCount(message);
-      deliverP = processNotify(message);
+      if (kernelKeeper.isVatSuspended(message.vatID)) {
+        kernelKeeper.addToSuspendedQueue(message.vatID, message);
+      } else {
+        decrementNotifyEventRefCount(message);
+        deliverP = processNotify(message);
+      }
     } else if (message.type === 'create-vat') {
       // creating a new dynamic vat will immediately do start-vat
       deliverP = processCreateVat(message);
@@ -1415,6 +1429,9 @@ export default function buildKernel(
     if (computrons) {
       assert.typeof(computrons, 'bigint');
       policyInput[1].computrons = BigInt(computrons);
+      if (crankResults.didDelivery) {
+        policyInput[1].vatID = crankResults.didDelivery;
+      }
       if (meterID) {
         const notify = kernelKeeper.deductMeter(meterID, computrons);
         if (notify) {
@@ -2145,6 +2162,19 @@ export default function buildKernel(
     console.log(`scheduled vatID ${vatID} for termination`);
   }
 
+  function suspendVat(vatID) {
+    assert(started, 'must do kernel.start() before suspendVat()');
+    kernelKeeper.vatIsAlive(vatID) || Fail`vat ${vatID} is not alive`;
+    kernelKeeper.suspendVat(vatID);
+    console.log(`suspended vatID ${vatID}`);
+  }
+
+  function resumeVat(vatID) {
+    assert(started, 'must do kernel.start() before resumeVat()');
+    const held = kernelKeeper.resumeVat(vatID);
+    console.log(`resumed vatID ${vatID} with ${held} held deliveries`);
+  }
+
   const kernel = harden({
     // these are meant for the controller
     installBundle,
@@ -2222,6 +2252,8 @@ export default function buildKernel(
     kpResolution,
     addDeviceHook,
     terminateVatExternally,
+    suspendVat,
+    resumeVat,
   });
 
   return kernel;
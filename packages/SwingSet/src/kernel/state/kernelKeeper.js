@@ -121,6 +121,8 @@ export const CURRENT_SCHEMA_VERSION = 3;
 // v$NN.reapDirt = JSON({ deliveries, gcKrefs, computrons }) // missing keys treated as zero
 // (leave room for v$NN.snapshotDirt and options.snapshotDirtThreshold for #6786)
 // v$NN.vatParameters = JSON(capdata) // missing for vats created/upgraded before #8947
+// v$NN.suspendedQueue = JSON([$head, $tail]) // present only while suspended
+// v$NN.suspendedQueue.$NN = JSON(item) // 'send' and 'notify' held for the vat
 //
 // exclude from consensus
 // local.*
@@ -188,6 +190,7 @@ export const CURRENT_SCHEMA_VERSION = 3;
 //   * perform remediation for bug #9039
 // (after v3, does not get its own version)
 //   * `upgradeEvents` recognized, but omitted if empty
+//   * `v$NN.suspendedQueue` recognized, but omitted unless the vat is suspended
 
 /** @type {(s: string) => string[]} s */
 export function commaSplit(s) {
@@ -1290,6 +1293,52 @@ export default function makeKernelKeeper(
     return dequeue('acceptanceQueue');
   }
 
+  // A suspended vat receives no 'send' or 'notify' deliveries. The kernel
+  // moves those it dequeues for the vat onto the vat's suspendedQueue
+  // instead, where they keep their refcounts, and resumeVat() moves them back
+  // onto the run-queue, in order.
+
+  function isVatSuspended(vatID) {
+    insistVatID(vatID);
+    return kvStore.has(`${vatID}.suspendedQueue`);
+  }
+
+  function suspendVat(vatID) {
+    insistVatID(vatID);
+    if (!isVatSuspended(vatID)) {
+      initQueue(`${vatID}.suspendedQueue`);
+    }
+  }
+
+  function addToSuspendedQueue(vatID, msg) {
+    const queue = `${vatID}.suspendedQueue`;
+    const [head, tail] = JSON.parse(getRequired(queue));
+    kvStore.set(`${queue}.${tail}`, JSON.stringify(msg));
+    kvStore.set(queue, JSON.stringify([head, tail + 1]));
+  }
+
+  /**
+   * Resume a suspended vat, moving the events held for it back onto the
+   * run-queue.
+   *
+   * @param {string} vatID
+   * @returns {number} the number of events that were held
+   */
+  function resumeVat(vatID) {
+    if (!isVatSuspended(vatID)) {
+      return 0;
+    }
+    const queue = `${vatID}.suspendedQueue`;
+    const [head, tail] = JSON.parse(getRequired(queue));
+    for (let i = head; i < tail; i += 1) {
+      const itemKey = `${queue}.${i}`;
+      addToRunQueue(JSON.parse(getRequired(itemKey)));
+      kvStore.delete(itemKey);
+    }
+    kvStore.delete(queue);
+    return tail - head;
+  }
+
   function injectQueuedUpgradeEvents() {
     // refcounts: Any krefs in `upgradeEvents` must have a refcount to
     // represent the list's hold on those objects. When
@@ -2009,6 +2058,11 @@ export default function makeKernelKeeper(
     getAcceptanceQueueLength,
     getNextAcceptanceQueueMsg,
 
+    isVatSuspended,
+    suspendVat,
+    addToSuspendedQueue,
+    resumeVat,
+
     injectQueuedUpgradeEvents,
 
     allocateMeter,
@@ -251,8 +251,11 @@ export function makeVatWarehouse({
   panic,
   warehousePolicy,
 }) {
-  const { maxVatsOnline = 50, restartWorkerOnSnapshot = true } =
-    warehousePolicy || {};
+  const {
+    maxVatsOnline = 50,
+    replayWorkers = 1,
+    restartWorkerOnSnapshot = true,
+  } = warehousePolicy || {};
   // Often a large contract evaluation is among the first few deliveries,
   // so let's do a snapshot after just a few deliveries.
   const snapshotInitial = kernelKeeper.getSnapshotInitial();
@@ -414,31 +417,43 @@ export function makeVatWarehouse({
   async function start(logStartup) {
     const recreate = true; // note: PANIC on failure to recreate
     const maxPreload = maxVatsOnline / 2;
-    let numPreloaded = 0;
-
-    // NOTE: OPTIMIZATION OPPORTUNITY: replay vats in parallel
+    /** @type {string[]} */
+    const preload = [];
 
     // instantiate all static vats, in lexicographic order, up to the
     // maxPreload limit
     for await (const [name, vatID] of kernelKeeper.getStaticVats()) {
-      if (numPreloaded >= maxPreload) {
+      if (preload.length >= maxPreload) {
         break;
       }
       logStartup(`provideVatKeeper for vat ${name} as vat ${vatID}`);
-      await ensureVatOnline(vatID, recreate);
-      numPreloaded += 1;
+      preload.push(vatID);
     }
 
     // then instantiate all dynamic vats, in creation order, also
     // subject to maxPreload
     for await (const vatID of kernelKeeper.getDynamicVats()) {
-      if (numPreloaded >= maxPreload) {
+      if (preload.length >= maxPreload) {
         break;
       }
       logStartup(`provideVatKeeper for dynamic vat ${vatID}`);
-      await ensureVatOnline(vatID, recreate);
-      numPreloaded += 1;
+      preload.push(vatID);
     }
+
+    // Replay up to replayWorkers transcripts at a time. Each vat replays
+    // against its own transcript and touches no shared kernel state, so only
+    // the order in which the vats come online (and hence are later paged
+    // out) depends on the number of workers.
+    let next = 0;
+    const replayWorker = async () => {
+      while (next < preload.length) {
+        const vatID = preload[next];
+        next += 1;
+        await ensureVatOnline(vatID, recreate);
+      }
+    };
+    const numWorkers = Math.max(1, Math.min(replayWorkers, preload.length));
+    await Promise.all(Array.from({ length: numWorkers }, replayWorker));
   }
 
   /**
@@ -233,7 +233,7 @@ export {};
  *
  * @typedef { { total: number } & CleanupWork } PolicyInputCleanupCounts
  * @typedef { { cleanups: PolicyInputCleanupCounts, computrons?: bigint } } PolicyInputCleanupDetails
- * @typedef { { computrons?: bigint } } PolicyInputDetails
+ * @typedef { { computrons?: bigint, vatID?: VatID } } PolicyInputDetails
  *
  * @typedef { [tag: 'none', details: PolicyInputDetails ] } PolicyInputNone
  * @typedef { [tag: 'create-vat', details: PolicyInputDetails  ]} PolicyInputCreateVat
@@ -279,6 +279,7 @@ export {};
 /**
  * @typedef {object} VatWarehousePolicy
  * @property { number } [maxVatsOnline]     Limit the number of simultaneous workers
+ * @property { number } [replayWorkers]     Number of vat transcripts to replay concurrently at startup
  * @property { boolean } [restartWorkerOnSnapshot]     Reload worker immediately upon snapshot creation
  */
 
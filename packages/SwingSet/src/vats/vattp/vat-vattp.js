@@ -30,6 +30,7 @@ export function buildRootObject(vatPowers, _vatParams, baggage) {
   const mailboxDeviceBaggageKey = 'mailboxDevice';
   const mailboxHandle = provideKindHandle(baggage, 'mailboxHandle');
   const mailboxMapBaggageKey = 'mailboxes';
+  const revokedRemotesBaggageKey = 'revokedRemotes';
   const networkHostHandle = provideKindHandle(baggage, 'networkHostHandle');
   const networkHostCounterBaggageKey = 'networkHostCounter';
   const networkHostNamesBaggageKey = 'networkHostNames';
@@ -40,6 +41,14 @@ export function buildRootObject(vatPowers, _vatParams, baggage) {
     mailboxDevice = baggage.get(mailboxDeviceBaggageKey);
   }
 
+  // Retain a durable set of the names whose mailboxes have been removed, which
+  // must neither transmit nor receive again.
+  /** @type {SetStore<string>} */
+  const revokedRemotes = provideDurableSetStore(
+    baggage,
+    revokedRemotesBaggageKey,
+  );
+
   // Define the durable Mailbox kind.
   const initMailboxState = name => ({
     name,
@@ -52,6 +61,9 @@ export function buildRootObject(vatPowers, _vatParams, baggage) {
     // The transmitter facet is used to send outbound messages.
     transmitter: {
       transmit: ({ state }, msg) => {
+        if (revokedRemotes.has(state.name)) {
+          return;
+        }
         const num = state.outboundHighestAdded + 1;
         D(mailboxDevice).add(state.name, num, msg);
         state.outboundHighestAdded = num;
@@ -135,7 +147,25 @@ export function buildRootObject(vatPowers, _vatParams, baggage) {
       return harden({ transmitter, setReceiver });
     },
 
+    /**
+     * Remove the mailbox of a remote from the device, and drop any messages
+     * later transmitted to or delivered from it.
+     *
+     * @param {string} name
+     */
+    removeRemote: name => {
+      mailboxes.has(name) || Fail`no remote ${name}`;
+      if (revokedRemotes.has(name)) {
+        return;
+      }
+      revokedRemotes.add(name);
+      D(mailboxDevice).removePeer(name);
+    },
+
     deliverInboundMessages: (name, newMessages) => {
+      if (revokedRemotes.has(name)) {
+        return;
+      }
       // TODO: Stop silently creating mailboxes.
       // https://github.com/Agoric/agoric-sdk/issues/5824
       const { inbound } = provideMailbox(name);
@@ -143,6 +173,9 @@ export function buildRootObject(vatPowers, _vatParams, baggage) {
     },
 
     deliverInboundAck: (name, ack) => {
+      if (revokedRemotes.has(name)) {
+        return;
+      }
       // TODO: Stop silently creating mailboxes.
       // https://github.com/Agoric/agoric-sdk/issues/5824
       const { inbound } = provideMailbox(name);
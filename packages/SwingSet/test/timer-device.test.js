@@ -4,6 +4,7 @@ import { test } from '../tools/prepare-test-env-ava.js';
 // eslint-disable-next-line import/order
 import { Far } from '@endo/far';
 import {
+  buildRootDeviceNode,
   makeTimerMap,
   curryPollFn,
 } from '../src/devices/timer/device-timer.js';
@@ -230,3 +231,32 @@ test('Timer schedule multiple repeaters', t => {
   ];
   t.deepEqual(schedule.cloneSchedule(), [{ time: 17n, handlers: h }]);
 });
+
+test('Timer device stats', t => {
+  let getStats;
+  const root = buildRootDeviceNode({
+    SO: fakeSO,
+    getDeviceState: () => undefined,
+    setDeviceState: () => {},
+    endowments: {
+      registerDevicePollFunction: () => {},
+      registerDeviceStatsFunction: fn => {
+        getStats = fn;
+      },
+    },
+  });
+  t.deepEqual(getStats(), { lastPolled: 0n, pendingWakeups: 0, repeaters: 0 });
+
+  root.setWakeup(7n, makeHandler());
+  root.setWakeup(5n, makeHandler());
+  root.setWakeup(5n, makeHandler());
+  const index = root.makeRepeater(0n, 10n);
+  root.makeRepeater(0n, 20n);
+  root.deleteRepeater(index);
+  t.deepEqual(getStats(), {
+    lastPolled: 0n,
+    pendingWakeups: 3,
+    repeaters: 1,
+    nextWakeup: 5n,
+  });
+});
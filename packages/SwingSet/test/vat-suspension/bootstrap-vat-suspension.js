@@ -0,0 +1,17 @@
+import { Far, E } from '@endo/far';
+
+export function buildRootObject() {
+  let root;
+
+  return Far('root', {
+    bootstrap: async (vats, devices) => {
+      const vatAdmin = await E(vats.vatAdmin).createVatAdminService(
+        devices.vatAdmin,
+      );
+      const bcap = await E(vatAdmin).getNamedBundleCap('sleepy');
+      const res = await E(vatAdmin).createVat(bcap);
+      root = res.root;
+    },
+    ping: async count => E(root).ping(count),
+  });
+}
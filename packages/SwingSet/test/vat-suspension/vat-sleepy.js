@@ -0,0 +1,7 @@
+import { Far } from '@endo/far';
+
+export function buildRootObject() {
+  return Far('sleepy', {
+    ping: count => count,
+  });
+}
@@ -0,0 +1,60 @@
+// eslint-disable-next-line import/order
+import { test } from '../../tools/prepare-test-env-ava.js';
+
+import { initSwingStore } from '@agoric/swing-store';
+import { kser, kunser } from '@agoric/kmarshal';
+import { initializeSwingset, makeSwingsetController } from '../../src/index.js';
+
+const bfile = name => new URL(name, import.meta.url).pathname;
+
+test('suspended vat holds deliveries until resumed', async t => {
+  /** @type {SwingSetConfig} */
+  const config = {
+    bootstrap: 'bootstrap',
+    vats: {
+      bootstrap: { sourceSpec: bfile('./bootstrap-vat-suspension.js') },
+    },
+    bundles: {
+      sleepy: { sourceSpec: bfile('./vat-sleepy.js') },
+    },
+  };
+
+  const kernelStorage = initSwingStore().kernelStorage;
+  await initializeSwingset(config, [], kernelStorage);
+  const c = await makeSwingsetController(kernelStorage);
+  t.teardown(c.shutdown);
+  c.pinVatRoot('bootstrap');
+  await c.run();
+
+  // We casually assume the new vat has the last ID
+  const vatIDs = c.dump().vatTables.map(vt => vt.vatID);
+  const vatID = vatIDs[vatIDs.length - 1];
+
+  {
+    const kpid = c.queueToVatRoot('bootstrap', 'ping', [1]);
+    await c.run();
+    t.is(kunser(c.kpResolution(kpid)), 1);
+  }
+
+  c.suspendVat(vatID);
+  const held = c.queueToVatRoot('bootstrap', 'ping', [2]);
+  await c.run();
+  t.is(c.kpStatus(held), 'unresolved');
+  t.is(c.dump().runQueue.length, 0);
+
+  c.resumeVat(vatID);
+  await c.run();
+  t.is(kunser(c.kpResolution(held)), 2);
+
+  // Terminating a suspended vat splats what it held.
+  c.suspendVat(vatID);
+  const doomed = c.queueToVatRoot('bootstrap', 'ping', [3]);
+  await c.run();
+  t.is(c.kpStatus(doomed), 'unresolved');
+  c.terminateVat(vatID, kser('unpaid'));
+  await c.run();
+  t.is(c.kpStatus(doomed), 'rejected');
+  t.deepEqual(kunser(c.kpResolution(doomed)), Error('vat terminated'));
+
+  t.throws(() => c.suspendVat(vatID), { message: /not alive/ });
+});
@@ -129,3 +129,87 @@ test('only preload maxVatsOnline vats', async t => {
 
   await c2.shutdown();
 });
+
+test('preload vats with parallel replays', async t => {
+  const bpath = new URL('vat-preload-bootstrap.js', import.meta.url).pathname;
+  const tpath = new URL('vat-preload-extra.js', import.meta.url).pathname;
+  /** @type {SwingSetConfig} */
+  const config = {
+    defaultManagerType: 'xs-worker',
+    defaultReapInterval: 'never',
+    bootstrap: 'bootstrap',
+    vats: {
+      bootstrap: {
+        sourceSpec: bpath,
+      },
+    },
+    bundles: { extra: { sourceSpec: tpath } },
+  };
+  const initOpts = {
+    addComms: false,
+    addVattp: false,
+    addTimer: false,
+  };
+
+  const db = sqlite3(':memory:');
+  const snapStore = makeSnapStore(db, () => {}, makeSnapStoreIO());
+  const kernelStorage = { ...initSwingStore().kernelStorage, snapStore };
+  await initializeSwingset(config, [], kernelStorage, initOpts);
+
+  const maxVatsOnline = 10;
+  const c1 = await makeSwingsetController(kernelStorage, null, {
+    warehousePolicy: { maxVatsOnline },
+  });
+  c1.pinVatRoot('bootstrap');
+  await c1.run();
+  c1.queueToVatRoot('bootstrap', 'launchCanary', []);
+  c1.queueToVatRoot('bootstrap', 'launchExtra', []);
+  await c1.run();
+  await c1.shutdown();
+
+  // Restart with each number of replay workers, noting how many vats replay
+  // at once.
+  const restart = async replayWorkers => {
+    const replaying = new Set();
+    let maxReplaying = 0;
+    const slogSender = slogObj => {
+      if (slogObj.type === 'start-replay') {
+        replaying.add(slogObj.vatID);
+        maxReplaying = Math.max(maxReplaying, replaying.size);
+      } else if (slogObj.type === 'finish-replay') {
+        replaying.delete(slogObj.vatID);
+      }
+    };
+    const c = await makeSwingsetController(kernelStorage, null, {
+      warehousePolicy: { maxVatsOnline, replayWorkers },
+      slogSender,
+    });
+    const living = c
+      .getStatus()
+      .activeVats.map(info => info.options.name)
+      .sort();
+    t.is(replaying.size, 0, 'every started replay finished');
+
+    // The preloaded vats still work.
+    c.queueToVatRoot('bootstrap', 'ping', ['extra-1']);
+    await c.run();
+    await c.shutdown();
+    return { living, maxReplaying };
+  };
+
+  const sequential = await restart(1);
+  t.is(sequential.maxReplaying, 1);
+  t.deepEqual(sequential.living, [
+    'bootstrap',
+    'canary',
+    'extra-0',
+    'extra-1',
+    'vatAdmin',
+  ]);
+
+  // The same vats come online, several replaying at once.
+  const parallel = await restart(3);
+  t.true(parallel.maxReplaying > 1, `${parallel.maxReplaying} at once`);
+  t.true(parallel.maxReplaying <= 3, `${parallel.maxReplaying} at once`);
+  t.deepEqual(parallel.living, sequential.living);
+});
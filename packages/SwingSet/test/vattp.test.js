@@ -161,3 +161,56 @@ test.serial('vattp 2', async t => {
     remote1: { outbox: [[2, 'out2']], inboundAck: 3 },
   });
 });
+
+test.serial('vattp removeRemote', async t => {
+  const mailboxStorage = harden(new Map());
+  const s = buildMailboxStateMap(mailboxStorage);
+  const mb = buildMailbox(s);
+  const config = {
+    bootstrap: 'bootstrap',
+    vats: {
+      bootstrap: {
+        sourceSpec: new URL(
+          'files-vattp/bootstrap-test-vattp.js',
+          import.meta.url,
+        ).pathname,
+      },
+    },
+    devices: {
+      mailbox: {
+        sourceSpec: mb.srcPath,
+      },
+    },
+  };
+  const deviceEndowments = {
+    mailbox: { ...mb.endowments },
+  };
+  const kernelStorage = initSwingStore().kernelStorage;
+
+  await initializeSwingset(config, ['2'], kernelStorage);
+  const c = await makeSwingsetController(kernelStorage, deviceEndowments);
+  t.teardown(c.shutdown);
+  c.pinVatRoot('bootstrap');
+  await c.run();
+  t.deepEqual(exportMailboxData(mailboxStorage), {
+    remote1: { outbox: [[1, 'out1']], inboundAck: 0 },
+  });
+
+  // removing the remote drops its mailbox
+  c.queueToVatRoot('vattp', 'removeRemote', ['remote1']);
+  await c.run();
+  t.deepEqual(exportMailboxData(mailboxStorage), {});
+
+  // nothing is transmitted to or received from the removed remote
+  c.queueToVatRoot('bootstrap', 'transmit', ['out2']);
+  t.is(mb.deliverInbound('remote1', [[1, 'msg1']], 1), true);
+  await c.run();
+  t.deepEqual(c.dump().log, []);
+  t.deepEqual(exportMailboxData(mailboxStorage), {});
+
+  // vattp remembers the removal across an upgrade
+  await restartVatTP(c);
+  c.queueToVatRoot('bootstrap', 'transmit', ['out3']);
+  await c.run();
+  t.deepEqual(exportMailboxData(mailboxStorage), {});
+});
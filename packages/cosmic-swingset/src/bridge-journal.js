@@ -0,0 +1,34 @@
+// @ts-check
+
+/**
+ * @typedef {object} BridgeJournalCheckpoint
+ * @property {number} height the block height
+ * @property {number} seq the sequence number of the bridge journal committed
+ *   with the block
+ */
+
+/**
+ * Verify the checkpoint of the bridge journal that the Go side reports at
+ * restart against the sequence number saved by this side when it committed
+ * blocks.  Either side may have committed one more block than the other
+ * before a crash, in which case nothing can be compared, but the same block
+ * must have been committed with the same sequence number, and this side must
+ * not be behind.
+ *
+ * @param {BridgeJournalCheckpoint | undefined} checkpoint from AG_COSMOS_INIT
+ * @param {BridgeJournalCheckpoint | undefined} saved by COMMIT_BLOCK
+ * @returns {string | undefined} a description of the divergence, if any
+ */
+export const checkBridgeJournal = (checkpoint, saved) => {
+  if (!checkpoint || !saved) {
+    return undefined;
+  }
+  if (saved.height < checkpoint.height) {
+    return `bridge journal committed block ${checkpoint.height}, but the VM only committed block ${saved.height}`;
+  }
+  if (saved.height === checkpoint.height && saved.seq !== checkpoint.seq) {
+    return `bridge journal committed block ${checkpoint.height} at sequence number ${checkpoint.seq}, but the VM committed it at ${saved.seq}`;
+  }
+  return undefined;
+};
+harden(checkBridgeJournal);
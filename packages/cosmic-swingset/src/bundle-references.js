@@ -0,0 +1,69 @@
+// @ts-check
+
+import { Fail } from '@endo/errors';
+import {
+  getAllDynamicVats,
+  getAllStaticVats,
+} from '@agoric/swingset-vat/src/kernel/state/kernelKeeper.js';
+import { enumeratePrefixedKeys } from '@agoric/swingset-vat/src/kernel/state/storageHelper.js';
+
+/**
+ * @import {KVStore} from '@agoric/swing-store';
+ */
+
+/**
+ * Find the bundle from which each live vat was created (or last upgraded),
+ * per the `${vatID}.source` records of the kernel.  Vats created from a bundle
+ * object or name rather than a bundle ID are omitted.
+ *
+ * @param {KVStore} kernelKvStore
+ * @returns {Record<string, string>} bundle IDs by vat ID
+ */
+export const getVatBundleIDs = kernelKvStore => {
+  const getRequired = key => {
+    const value = kernelKvStore.get(key);
+    value !== undefined || Fail`missing ${key}`;
+    return value;
+  };
+  const vatIDs = [
+    ...getAllStaticVats(kernelKvStore).map(([_name, vatID]) => vatID),
+    ...getAllDynamicVats(getRequired),
+  ];
+  /** @type {Record<string, string>} */
+  const bundleIDs = {};
+  for (const vatID of vatIDs.sort()) {
+    const source = JSON.parse(kernelKvStore.get(`${vatID}.source`) || '{}');
+    if (typeof source.bundleID === 'string') {
+      bundleIDs[vatID] = source.bundleID;
+    }
+  }
+  return harden(bundleIDs);
+};
+harden(getVatBundleIDs);
+
+/**
+ * Find the bundles that the kernel must keep: those of live vats, those named
+ * by the SwingSet config, and those for which the vatAdmin device has issued a
+ * bundlecap, such as the bundles of Zoe installations.  Device nodes are never
+ * collected, so a bundlecap may be used to create or upgrade a vat at any
+ * time.
+ *
+ * @param {KVStore} kernelKvStore
+ * @returns {Set<string>}
+ */
+export const getReferencedBundleIDs = kernelKvStore => {
+  const referenced = new Set(Object.values(getVatBundleIDs(kernelKvStore)));
+  for (const key of enumeratePrefixedKeys(kernelKvStore, 'namedBundleID.')) {
+    referenced.add(/** @type {string} */ (kernelKvStore.get(key)));
+  }
+  const vatAdminID = kernelKvStore.get('device.name.vatAdmin');
+  if (vatAdminID) {
+    // Per returnCapForBundleID in device-vat-admin.js.
+    const prefix = `${vatAdminID}.vs.id.`;
+    for (const key of enumeratePrefixedKeys(kernelKvStore, prefix)) {
+      referenced.add(key.slice(prefix.length));
+    }
+  }
+  return referenced;
+};
+harden(getReferencedBundleIDs);
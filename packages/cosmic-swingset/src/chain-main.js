@@ -49,6 +49,12 @@ import {
 import stringify from './helpers/json-stable-stringify.js';
 import { launch } from './launch-chain.js';
 import { makeProcessValue } from './helpers/process-value.js';
+import { makeSlogFilter } from './slog-filter.js';
+import {
+  VM_TRANSPORT_GRPC,
+  makeGrpcAgcc,
+  readVmTransport,
+} from './grpc-agcc.js';
 import {
   spawnSwingStoreExport,
   validateExporterOptions,
@@ -85,31 +91,59 @@ const toNumber = specimen => {
  *
  * @typedef {object} CosmosSwingsetConfig
  * @property {string} [slogfile]
+ * @property {number} [slogfileMaxSize]
+ * @property {number} [slogfileMaxBackups]
+ * @property {boolean} [slogfileCompress]
+ * @property {string} [slogsocket]
  * @property {number} [maxVatsOnline]
+ * @property {number} [replayWorkers]
  * @property {'debug' | 'operational'} [vatSnapshotRetention]
  * @property {'archival' | 'operational'} [vatTranscriptRetention]
  * @property {string} [vatSnapshotArchiveDir]
  * @property {string} [vatTranscriptArchiveDir]
+ * @property {string} [vatConfigOverride]
+ * @property {string[]} [slogExcludeTypes]
  */
 const SwingsetConfigShape = M.splitRecord(
   // All known properties are optional, but unknown properties are not allowed.
   {},
   {
     slogfile: M.string(),
+    slogfileMaxSize: M.number(),
+    slogfileMaxBackups: M.number(),
+    slogfileCompress: M.boolean(),
+    slogsocket: M.string(),
     maxVatsOnline: M.number(),
+    replayWorkers: M.number(),
     vatSnapshotRetention: M.or('debug', 'operational'),
     vatTranscriptRetention: M.or('archival', 'operational'),
     vatSnapshotArchiveDir: M.string(),
     vatTranscriptArchiveDir: M.string(),
+    vatConfigOverride: M.string(),
+    slogExcludeTypes: M.arrayOf(M.string()),
   },
   {},
 );
 const validateSwingsetConfig = swingsetConfig => {
   mustMatch(swingsetConfig, SwingsetConfigShape);
-  const { maxVatsOnline } = swingsetConfig;
+  const {
+    maxVatsOnline,
+    replayWorkers,
+    slogfileMaxSize,
+    slogfileMaxBackups,
+  } = swingsetConfig;
   maxVatsOnline === undefined ||
     (isNat(maxVatsOnline) && maxVatsOnline > 0) ||
     Fail`maxVatsOnline must be a positive integer`;
+  replayWorkers === undefined ||
+    (isNat(replayWorkers) && replayWorkers > 0) ||
+    Fail`replayWorkers must be a positive integer`;
+  slogfileMaxSize === undefined ||
+    isNat(slogfileMaxSize) ||
+    Fail`slogfileMaxSize must be a non-negative integer`;
+  slogfileMaxBackups === undefined ||
+    isNat(slogfileMaxBackups) ||
+    Fail`slogfileMaxBackups must be a non-negative integer`;
 };
 
 /**
@@ -177,7 +211,7 @@ const makePrefixedBridgeStorage = (
 export default async function main(
   progname,
   args,
-  { env, homedir, path = nativePath, agcc },
+  { env, homedir, path = nativePath, agcc: nativeAgcc },
 ) {
   const portNums = {};
 
@@ -194,8 +228,19 @@ export default async function main(
   const stateDBDir = `${cosmosHome}/data/agoric`;
   fs.mkdirSync(stateDBDir, { recursive: true });
 
+  // With the "grpc" vm_transport, agd runs as its own process, and we reach it
+  // over gRPC rather than running it in this one.
+  const agcc =
+    readVmTransport(cosmosHome) === VM_TRANSPORT_GRPC
+      ? makeGrpcAgcc(cosmosHome)
+      : nativeAgcc;
+
   // console.log('Have AG_COSMOS', agcc);
 
+  // The types of slog entries to drop, as configured by AG_COSMOS_INIT and
+  // changed by SET_LOG_CONFIG.
+  const slogFilter = makeSlogFilter();
+
   const portHandlers = {};
   let lastPort = 0;
   function registerPortHandler(portHandler) {
@@ -310,6 +355,10 @@ export default async function main(
     validateSwingsetConfig(swingsetConfig);
     const {
       slogfile,
+      slogfileMaxSize,
+      slogfileMaxBackups,
+      slogfileCompress,
+      slogsocket,
       vatSnapshotRetention,
       vatTranscriptRetention,
       vatSnapshotArchiveDir,
@@ -325,6 +374,16 @@ export default async function main(
     // As a kludge, back-propagate selected configuration into environment variables.
     // eslint-disable-next-line dot-notation
     if (slogfile) env['SLOGFILE'] = slogfile;
+    // eslint-disable-next-line dot-notation
+    if (slogfileMaxSize) env['SLOGFILE_MAX_SIZE'] = String(slogfileMaxSize);
+    if (slogfileMaxBackups) {
+      // eslint-disable-next-line dot-notation
+      env['SLOGFILE_MAX_BACKUPS'] = String(slogfileMaxBackups);
+    }
+    // eslint-disable-next-line dot-notation
+    if (slogfileCompress) env['SLOGFILE_COMPRESS'] = 'true';
+    // eslint-disable-next-line dot-notation
+    if (slogsocket) env['SLOGSOCKET'] = slogsocket;
 
     const sendToChainStorage = msg => chainSend(portNums.storage, msg);
     // this object is used to store the mailbox state.
@@ -358,6 +417,7 @@ export default async function main(
     const highPriorityQueueStorage = makeQueueStorage(
       STORAGE_PATH.HIGH_PRIORITY_QUEUE,
     );
+    const oracleQueueStorage = makeQueueStorage(STORAGE_PATH.ORACLE_QUEUE);
     /**
      * Callback invoked during SwingSet execution when new "export data" is
      * generated by swingStore to be saved in the host's verified DB. In our
@@ -394,6 +454,69 @@ export default async function main(
         }),
       );
     };
+    /** @param {import('./launch-chain.js').KernelStatsReport} stats */
+    const reportKernelStats = stats => {
+      chainSend(
+        portNums.swingset,
+        stringify({
+          method: 'reportKernelStats',
+          args: [stats],
+        }),
+      );
+    };
+    /**
+     * Report the progress of vat transcript replays as observed in the slog,
+     * so that a restarting node is not mistaken for a stuck one. The reports
+     * are outside consensus, so they bypass the saved chain sends, and stop
+     * once the kernel is launched lest they enter a block's bridge messages:
+     * the replays of vats brought back online later, while running blocks, are
+     * not reported.  Nor are any replays without a slog sender to observe.
+     */
+    const replayProgressInterval = 1000;
+    let reportingReplays = true;
+    /** @type {Map<string, { progress: Record<string, any>, time: number }>} */
+    const replays = new Map();
+    const sendReplayProgress = progress =>
+      agcc.send(
+        portNums.swingset,
+        stringify({
+          method: 'reportReplayProgress',
+          args: [progress],
+        }),
+      );
+    /** @param {Record<string, any>} slogObj */
+    const observeReplay = slogObj => {
+      if (!reportingReplays) return;
+      switch (slogObj.type) {
+        case 'start-replay': {
+          const { vatID, deliveries: total } = slogObj;
+          const progress = { vatID, replayed: 0, total, done: false };
+          replays.set(vatID, { progress, time: Date.now() });
+          sendReplayProgress(progress);
+          break;
+        }
+        case 'deliver': {
+          const replay = slogObj.replay && replays.get(slogObj.vatID);
+          if (!replay) break;
+          replay.progress.replayed += 1;
+          const now = Date.now();
+          if (now - replay.time >= replayProgressInterval) {
+            replay.time = now;
+            sendReplayProgress(replay.progress);
+          }
+          break;
+        }
+        case 'finish-replay': {
+          const replay = replays.get(slogObj.vatID);
+          if (!replay) break;
+          replay.progress.done = true;
+          sendReplayProgress(replay.progress);
+          replays.delete(slogObj.vatID);
+          break;
+        }
+        default:
+      }
+    };
     function doOutboundBridge(dstID, msg) {
       const portNum = portNums[dstID];
       if (portNum === undefined) {
@@ -444,6 +567,7 @@ export default async function main(
     const getVatConfig = async () => {
       const href = await importMetaResolve(
         env.CHAIN_BOOTSTRAP_VAT_CONFIG ||
+          swingsetConfig.vatConfigOverride ||
           argv.bootMsg.params.bootstrap_vat_config,
         import.meta.url,
       );
@@ -460,11 +584,32 @@ export default async function main(
       serviceName: TELEMETRY_SERVICE_NAME,
     });
 
-    const slogSender = await makeSlogSender({
-      stateDir: stateDBDir,
-      env,
-      serviceName: TELEMETRY_SERVICE_NAME,
-    });
+    slogFilter.setExcludeTypes(swingsetConfig.slogExcludeTypes || []);
+    const configuredSlogSender = slogFilter.wrapSlogSender(
+      await makeSlogSender({
+        stateDir: stateDBDir,
+        env,
+        serviceName: TELEMETRY_SERVICE_NAME,
+      }),
+    );
+    // Transcript replays are observed through the slog sender, if any, so
+    // that a node without one keeps the kernel's fast path of making no slog
+    // entries at all.  The observation is unwrapped once the kernel is
+    // launched.
+    let sendSlog = configuredSlogSender;
+    if (configuredSlogSender) {
+      sendSlog = (slogObj, ...rest) => {
+        observeReplay(slogObj);
+        configuredSlogSender(slogObj, ...rest);
+      };
+    }
+    const slogSender =
+      configuredSlogSender &&
+      Object.assign((slogObj, ...rest) => sendSlog?.(slogObj, ...rest), {
+        forceFlush: configuredSlogSender.forceFlush,
+        shutdown: configuredSlogSender.shutdown,
+        usesJsonObject: configuredSlogSender.usesJsonObject,
+      });
 
     const swingStoreTraceFile = processValue.getPath({
       envName: 'SWING_STORE_TRACE',
@@ -546,6 +691,7 @@ export default async function main(
     const s = await launch({
       actionQueueStorage,
       highPriorityQueueStorage,
+      oracleQueueStorage,
       kernelStateDBDir: stateDBDir,
       makeInstallationPublisher,
       mailboxStorage,
@@ -559,6 +705,7 @@ export default async function main(
       metricsProvider,
       slogSender,
       swingStoreExportCallback,
+      reportKernelStats,
       swingStoreTraceFile,
       keepSnapshots,
       keepTranscripts,
@@ -568,6 +715,9 @@ export default async function main(
       swingsetConfig,
     });
 
+    reportingReplays = false;
+    sendSlog = configuredSlogSender;
+
     const { blockingSend, shutdown } = s;
     ({ writeSlogObject, savedChainSends } = s);
 
@@ -801,6 +951,19 @@ export default async function main(
         return resultP;
       }
 
+      // Pings are outside of consensus, and are answered without touching
+      // kernel state (or waiting for initialization), as a sign of life.
+      case ActionType.HEALTH_CHECK: {
+        return true;
+      }
+
+      // Logging changes are outside of consensus, and touch no kernel state.
+      case ActionType.SET_LOG_CONFIG: {
+        const { slogExcludeTypes = [] } = action;
+        slogFilter.setExcludeTypes(slogExcludeTypes);
+        return true;
+      }
+
       default: {
         if (!blockingSend) throw Fail`Swingset not initialized`;
 
@@ -44,6 +44,9 @@ export function computronCounter(
   assert.typeof(xsnapComputron, 'bigint');
 
   let totalBeans = 0n;
+  let totalComputrons = 0n;
+  /** @type {Map<string, bigint>} */
+  const vatComputrons = new Map();
   const shouldRun = () => ignoreBlockLimit || totalBeans < blockComputeLimit;
 
   const remainingCleanups = { default: Infinity, ...vatCleanupBudget };
@@ -88,12 +91,21 @@ export function computronCounter(
         // TODO: xsnapComputron should not be assumed here.
         // Instead, SwingSet should describe the computron model it uses.
         totalBeans += details.computrons * xsnapComputron;
+        totalComputrons += details.computrons;
+        if (details.vatID) {
+          const { vatID } = details;
+          vatComputrons.set(
+            vatID,
+            (vatComputrons.get(vatID) || 0n) + details.computrons,
+          );
+        }
       }
       return shouldRun();
     },
     crankFailed() {
       const failedComputrons = 1000000n; // who knows, 1M is as good as anything
       totalBeans += failedComputrons * xsnapComputron;
+      totalComputrons += failedComputrons;
       return shouldRun();
     },
     emptyCrank() {
@@ -106,6 +118,8 @@ export function computronCounter(
     remainingBeans: () =>
       ignoreBlockLimit ? undefined : blockComputeLimit - totalBeans,
     totalBeans: () => totalBeans,
+    totalComputrons: () => totalComputrons,
+    vatComputrons: () => harden(Object.fromEntries(vatComputrons)),
     startCleanup,
   });
   return policy;
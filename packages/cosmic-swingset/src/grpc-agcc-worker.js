@@ -0,0 +1,96 @@
+// @ts-check
+
+// NOTE: Runs outside SES, in the worker thread started by makeGrpcAgcc.
+
+import fs from 'node:fs';
+import { fileURLToPath } from 'node:url';
+import { workerData } from 'node:worker_threads';
+import grpc from '@grpc/grpc-js';
+import protoLoader from '@grpc/proto-loader';
+import { resolve as importMetaResolve } from 'import-meta-resolve';
+
+/**
+ * @type {{
+ *   vmSocket: string,
+ *   agdSocket: string,
+ *   syncState: Int32Array,
+ *   syncPort: import('node:worker_threads').MessagePort,
+ *   inboundPort: import('node:worker_threads').MessagePort,
+ * }}
+ */
+const { vmSocket, agdSocket, syncState, syncPort, inboundPort } = workerData;
+
+const protoPath = fileURLToPath(
+  importMetaResolve(
+    '@agoric/cosmos/proto/agoric/vm/transport.proto',
+    import.meta.url,
+  ),
+);
+const packageDefinition = protoLoader.loadSync(protoPath, { defaults: true });
+/** @type {any} */
+const { agoric } = grpc.loadPackageDefinition(packageDefinition);
+const { VM, Agd } = agoric.vm;
+
+// Bundles and swing-store export data can far exceed gRPC's default 4 MiB
+// message limit, so the transport accepts messages of any size, as agd does.
+const channelOptions = {
+  'grpc.max_receive_message_length': -1,
+  'grpc.max_send_message_length': -1,
+};
+
+// Carry each send from the main thread to agd, posting the reply before
+// waking the main thread.
+const agd = new Agd(
+  `unix:${agdSocket}`,
+  grpc.credentials.createInsecure(),
+  channelOptions,
+);
+syncPort.on('message', ({ port, data }) => {
+  agd.ReceiveMessage({ port, needsReply: true, data }, (err, res) => {
+    syncPort.postMessage(
+      err ? { error: err.details || err.message } : { reply: res.data },
+    );
+    Atomics.store(syncState, 0, 1);
+    Atomics.notify(syncState, 0);
+  });
+});
+
+// Carry each message from agd to the main thread, and its reply back.
+const pendingReplies = new Map();
+let lastID = 0;
+inboundPort.on('message', ({ id, reply, error }) => {
+  const callback = pendingReplies.get(id);
+  pendingReplies.delete(id);
+  if (error !== undefined) {
+    callback({ code: grpc.status.UNKNOWN, details: error });
+  } else {
+    callback(null, { data: reply });
+  }
+});
+
+const server = new grpc.Server(channelOptions);
+server.addService(VM.service, {
+  ReceiveMessage: ({ request }, callback) => {
+    const { port, needsReply, data } = request;
+    if (!needsReply) {
+      inboundPort.postMessage({ id: 0, port, data });
+      callback(null, { data: '' });
+      return;
+    }
+    lastID += 1;
+    pendingReplies.set(lastID, callback);
+    inboundPort.postMessage({ id: lastID, port, data });
+  },
+});
+
+// Replace any socket left by a previous run.
+fs.rmSync(vmSocket, { force: true });
+server.bindAsync(
+  `unix:${vmSocket}`,
+  grpc.ServerCredentials.createInsecure(),
+  err => {
+    if (err) {
+      throw err;
+    }
+  },
+);
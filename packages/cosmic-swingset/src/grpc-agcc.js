@@ -0,0 +1,127 @@
+// @ts-check
+
+import fs from 'node:fs';
+import process from 'node:process';
+import {
+  MessageChannel,
+  Worker,
+  receiveMessageOnPort,
+} from 'node:worker_threads';
+import toml from '@iarna/toml';
+
+import { Fail } from '@endo/errors';
+
+// These must be kept in sync with VmTransportGrpc, VmSocketFile, and
+// AgdSocketFile in ../../../golang/cosmos/x/swingset/config.go.
+export const VM_TRANSPORT_GRPC = 'grpc';
+const VM_SOCKET_FILE = 'agvm.sock';
+const AGD_SOCKET_FILE = 'agd.sock';
+
+/**
+ * Read the `[swingset] vm_transport` setting of the application home.
+ *
+ * @param {string} cosmosHome
+ * @returns {string} the setting, or 'bridge' if there is none
+ */
+export const readVmTransport = cosmosHome => {
+  let appConfig;
+  try {
+    appConfig = toml.parse(
+      fs.readFileSync(`${cosmosHome}/config/app.toml`, 'utf-8'),
+    );
+  } catch (e) {
+    if (/** @type {NodeJS.ErrnoException} */ (e).code === 'ENOENT') {
+      return 'bridge';
+    }
+    throw e;
+  }
+  const swingset = /** @type {Record<string, unknown> | undefined} */ (
+    appConfig.swingset
+  );
+  return `${swingset?.vm_transport || 'bridge'}`;
+};
+
+/**
+ * Make a stand-in for the native `@agoric/cosmos` binding for a SwingSet
+ * worker that runs as a process separate from agd, with the "grpc"
+ * vm_transport. Rather than running agd in this process,
+ * `runAgCosmosDaemon` serves the agoric.vm.VM gRPC service to receive the
+ * messages from agd, and `send` calls the agoric.vm.Agd service of agd, both
+ * on Unix domain sockets in the data directory of cosmosHome.
+ *
+ * The gRPC client and server run in a worker thread, so that `send` can block
+ * until agd replies, as the native binding does.
+ *
+ * @param {string} cosmosHome
+ */
+export const makeGrpcAgcc = cosmosHome => {
+  const vmSocket = `${cosmosHome}/data/${VM_SOCKET_FILE}`;
+  const agdSocket = `${cosmosHome}/data/${AGD_SOCKET_FILE}`;
+
+  // syncState[0] is set by the worker thread when it has posted the reply to
+  // a send on syncChannel.
+  const syncState = new Int32Array(
+    new SharedArrayBuffer(Int32Array.BYTES_PER_ELEMENT),
+  );
+  const syncChannel = new MessageChannel();
+  const inboundChannel = new MessageChannel();
+  /** @type {Worker | undefined} */
+  let worker;
+
+  /**
+   * @param {number} _nodePort agd addresses the VM at the port it registers
+   *   first, which is nodePort
+   * @param {(port: number, str: string, replier: { resolve: (res: string) => void, reject: (rej: unknown) => void }) => void} fromGo
+   * @param {string[]} _args
+   */
+  const runAgCosmosDaemon = (_nodePort, fromGo, _args) => {
+    !worker || Fail`gRPC transport already running`;
+    worker = new Worker(new URL('./grpc-agcc-worker.js', import.meta.url), {
+      workerData: {
+        vmSocket,
+        agdSocket,
+        syncState,
+        syncPort: syncChannel.port2,
+        inboundPort: inboundChannel.port2,
+      },
+      transferList: [syncChannel.port2, inboundChannel.port2],
+    });
+    worker.on('error', e => {
+      console.error('gRPC transport failed', e);
+      process.exit(1);
+    });
+
+    inboundChannel.port1.on('message', ({ id, port, data }) => {
+      // An id of zero marks a message whose reply agd does not await.
+      const reply = result => id && inboundChannel.port1.postMessage(result);
+      fromGo(port, data, {
+        resolve: res => reply({ id, reply: res }),
+        reject: rej => reply({ id, error: `${rej}` }),
+      });
+    });
+  };
+
+  /**
+   * Send a message to a port of agd, blocking until it replies.
+   *
+   * @param {number} port
+   * @param {string} data
+   * @returns {string} the reply, or a JSON-encoded `{ error }` record if agd
+   *   failed, as the native binding returns
+   */
+  const send = (port, data) => {
+    worker || Fail`gRPC transport not running`;
+    Atomics.store(syncState, 0, 0);
+    syncChannel.port1.postMessage({ port, data });
+    Atomics.wait(syncState, 0, 0);
+    const received = receiveMessageOnPort(syncChannel.port1);
+    received || Fail`gRPC transport did not reply`;
+    const { reply, error } = received.message;
+    if (error !== undefined) {
+      return JSON.stringify({ error });
+    }
+    return reply;
+  };
+
+  return harden({ runAgCosmosDaemon, send });
+};
@@ -141,9 +141,20 @@ function createHistogram(metricMeter, name) {
 }
 
 /**
- * @param {{ metricMeter: OTelMeter, attributes?: MetricAttributes }} options
+ * @param {object} options
+ * @param {OTelMeter} options.metricMeter
+ * @param {MetricAttributes} [options.attributes]
+ * @param {(vatID: string, meterUsage: Record<string, unknown>) => void} [options.onDeliveryMeterUsage]
+ *   called with the meter usage reported by each vat delivery
+ * @param {(vatID: string) => void} [options.onTerminateVat]
+ *   called with the vatID of each vat the kernel terminates
  */
-export function makeSlogCallbacks({ metricMeter, attributes = {} }) {
+export function makeSlogCallbacks({
+  metricMeter,
+  attributes = {},
+  onDeliveryMeterUsage,
+  onTerminateVat,
+}) {
   // Legacy because legacyMaps are not passable
   const groupToRecorder = makeLegacyMap('metricGroup');
 
@@ -233,6 +244,9 @@ export function makeSlogCallbacks({ metricMeter, attributes = {} }) {
         (deltaMS, [[_status, _problem, meterUsage]]) => {
           const group = getVatGroup(vatID);
           getGroupedRecorder('swingset_vat_delivery', group).record(deltaMS);
+          if (meterUsage && onDeliveryMeterUsage) {
+            onDeliveryMeterUsage(vatID, meterUsage);
+          }
           const { meterType, ...measurements } = meterUsage || {};
           for (const [key, value] of Object.entries(measurements)) {
             if (typeof value === 'object') continue;
@@ -247,6 +261,12 @@ export function makeSlogCallbacks({ metricMeter, attributes = {} }) {
         },
       );
     },
+    terminateVat(_method, [vatID], ret) {
+      if (onTerminateVat) {
+        onTerminateVat(vatID);
+      }
+      return ret;
+    },
   };
 
   return harden(slogCallbacks);
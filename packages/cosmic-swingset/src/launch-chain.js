@@ -47,6 +47,11 @@ import { makeQueue, makeQueueStorageMock } from './helpers/make-queue.js';
 import { exportStorage } from './export-storage.js';
 import { parseLocatedJson } from './helpers/json.js';
 import { computronCounter } from './computron-counter.js';
+import {
+  getReferencedBundleIDs,
+  getVatBundleIDs,
+} from './bundle-references.js';
+import { checkBridgeJournal } from './bridge-journal.js';
 
 /** @import { BlockInfo } from '@agoric/internal/src/chain-utils.js' */
 /** @import { Mailbox, RunPolicy, SwingSetConfig } from '@agoric/swingset-vat' */
@@ -289,15 +294,29 @@ export async function buildSwingset(
  *   shouldRun(): boolean;
  *   remainingBeans(): bigint | undefined;
  *   totalBeans(): bigint;
+ *   totalComputrons(): bigint;
+ *   vatComputrons(): Record<string, bigint>;
  *   startCleanup(): boolean;
  * }} ChainRunPolicy
  */
 
+/**
+ * The kernel stats reported to cosmos at the end of each block, for export as
+ * swingset_* metrics by `golang/cosmos/x/swingset/metrics.go`.
+ *
+ * @typedef {object} KernelStatsReport
+ * @property {number} crankCount total number of cranks to date
+ * @property {number} blockComputrons computrons consumed during the block
+ * @property {Record<string, number>} vatHeapSizes per-vat xsnap heap size
+ *   (`currentHeapCount`) as of each vat's most recent delivery
+ */
+
 /**
  * @template [T=unknown]
  * @typedef {object} LaunchOptions
  * @property {import('./helpers/make-queue.js').QueueStorage} actionQueueStorage
  * @property {import('./helpers/make-queue.js').QueueStorage} highPriorityQueueStorage
+ * @property {import('./helpers/make-queue.js').QueueStorage} oracleQueueStorage
  * @property {string} [kernelStateDBDir]
  * @property {import('@agoric/swing-store').SwingStore} [swingStore]
  * @property {BufferedKVStore<Mailbox>} mailboxStorage
@@ -319,6 +338,8 @@ export async function buildSwingset(
  * @property {import('@agoric/telemetry').SlogSender} [slogSender]
  * @property {string} [swingStoreTraceFile]
  * @property {(...args: unknown[]) => void} [swingStoreExportCallback]
+ * @property {(stats: KernelStatsReport) => void} [reportKernelStats]
+ *   called at the end of each block with a summary of kernel activity
  * @property {boolean} [keepSnapshots]
  * @property {boolean} [keepTranscripts]
  * @property {ReturnType<typeof import('@agoric/swing-store').makeArchiveSnapshot>} [archiveSnapshot]
@@ -336,6 +357,7 @@ export async function buildSwingset(
 export async function launch({
   actionQueueStorage,
   highPriorityQueueStorage,
+  oracleQueueStorage,
   kernelStateDBDir,
   swingStore,
   mailboxStorage,
@@ -352,6 +374,7 @@ export async function launch({
   slogSender,
   swingStoreTraceFile,
   swingStoreExportCallback,
+  reportKernelStats,
   keepSnapshots,
   keepTranscripts,
   archiveSnapshot,
@@ -419,6 +442,13 @@ export async function launch({
   const actionQueue = makeQueue(actionQueueStorage);
   /** @type {InboundQueue} */
   const highPriorityQueue = makeQueue(highPriorityQueueStorage);
+  /**
+   * Price updates pushed by oracle operators, processed ahead of the
+   * highPriorityQueue to keep their latency low.
+   *
+   * @type {InboundQueue}
+   */
+  const oracleQueue = makeQueue(oracleQueueStorage);
   /**
    * In memory queue holding actions that must be consumed entirely
    * during the block. If it's not drained, we open the gates to
@@ -445,13 +475,24 @@ export async function launch({
     }
     processedInboundActionCounter.add(1, { actionType });
   };
+  /** @type {Map<string, number>} */
+  const vatHeapSizes = new Map();
   const slogCallbacks = makeSlogCallbacks({
     metricMeter,
+    onDeliveryMeterUsage: (vatID, { currentHeapCount }) => {
+      if (typeof currentHeapCount === 'number') {
+        vatHeapSizes.set(vatID, currentHeapCount);
+      }
+    },
+    onTerminateVat: vatID => {
+      vatHeapSizes.delete(vatID);
+    },
   });
 
   console.debug(`buildSwingset`);
   const warehousePolicy = {
     maxVatsOnline: swingsetConfig.maxVatsOnline,
+    replayWorkers: swingsetConfig.replayWorkers,
   };
   const {
     coreProposals: bootstrapCoreProposals,
@@ -485,7 +526,7 @@ export async function launch({
 
   const initialQueueLengths = /** @type {Record<InboundQueueName, number>} */ ({
     [InboundQueueName.Forced]: runThisBlock.size(),
-    [InboundQueueName.Priority]: highPriorityQueue.size(),
+    [InboundQueueName.Priority]: oracleQueue.size() + highPriorityQueue.size(),
     [InboundQueueName.Inbound]: actionQueue.size(),
   });
   const { crankScheduler, inboundQueueMetrics } = exportKernelStats({
@@ -554,16 +595,72 @@ export async function launch({
     await runSwingset(CrankerPhase.Forced);
   }
 
+  /**
+   * Report the work pending in the timer device, which the END_BLOCK reply
+   * carries to the chain for its x/swingset Query/Timer.
+   */
+  function getTimerStatus() {
+    const stats = timer.getStats();
+    if (!stats) return undefined;
+    const { pendingWakeups, repeaters, nextWakeup } = stats;
+    return harden({
+      pendingWakeups,
+      repeaters,
+      ...(nextWakeup === undefined ? {} : { nextWakeup: Number(nextWakeup) }),
+    });
+  }
+
+  /**
+   * Report the computrons used by each vat in the latest block, which the
+   * END_BLOCK reply carries to the chain for its swingset.vats.<vatID>.meter
+   * storage.
+   *
+   * @returns {Record<string, string>}
+   */
+  function getVatComputrons() {
+    return harden(JSON.parse(kvStore.get(getHostKey('vatComputrons')) || '{}'));
+  }
+
+  /**
+   * Report the beans left of the block compute limit when the run policy of
+   * the latest block stopped, which the END_BLOCK reply carries to the chain
+   * so that it can hold back new inbound actions while the kernel is
+   * saturated.
+   *
+   * @returns {string | undefined} undefined if the block ran without a limit
+   */
+  function getPolicyHeadroom() {
+    return kvStore.get(getHostKey('policyHeadroom'));
+  }
+
+  /**
+   * Report the bundle of each live vat for the END_BLOCK reply, from which the
+   * chain updates its bundle reference counts.  The report is of the kernel's
+   * state rather than of its changes during the block, so that it does not
+   * depend on anything a state-synced node lacks.
+   *
+   * @returns {Record<string, string>}
+   */
+  function getBundleReferences() {
+    return getVatBundleIDs(kernelStorage.kvStore);
+  }
+
   async function saveChainState() {
     // Save the mailbox state.
     await mailboxStorage.commit();
   }
 
-  async function saveOutsideState(blockHeight) {
+  async function saveOutsideState(blockHeight, bridgeJournalSeq) {
     allowExportCallback = false;
     const chainSends = await clearChainSends();
     kvStore.set(getHostKey('height'), `${blockHeight}`);
     kvStore.set(getHostKey('chainSends'), JSON.stringify(chainSends));
+    if (bridgeJournalSeq !== undefined) {
+      kvStore.set(
+        getHostKey('bridgeJournal'),
+        JSON.stringify({ height: blockHeight, seq: bridgeJournalSeq }),
+      );
+    }
 
     await commit();
   }
@@ -576,6 +673,63 @@ export async function launch({
     controller.injectQueuedUpgradeEvents();
   }
 
+  /**
+   * Delete the bundles that the chain found unused, keeping any that a vat
+   * was created from since, or that the SwingSet config names.
+   *
+   * @param {string[]} bundleIDs
+   * @param {string} inboundNum
+   */
+  async function pruneBundles(bundleIDs, inboundNum) {
+    const { bundleStore } = kernelStorage;
+    const referenced = getReferencedBundleIDs(kernelStorage.kvStore);
+    const pruned = [];
+    const kept = [];
+    for (const bundleID of bundleIDs) {
+      if (referenced.has(bundleID) || !bundleStore.hasBundle(bundleID)) {
+        kept.push(bundleID);
+        continue;
+      }
+      bundleStore.deleteBundle(bundleID);
+      pruned.push(bundleID);
+    }
+    controller.writeSlogObject({
+      type: 'cosmic-swingset-prune-bundles',
+      inboundNum,
+      pruned,
+      kept,
+    });
+  }
+
+  /**
+   * Suspend or resume a vat whose owner's ability to pay for its computrons
+   * has changed. A vat that is no longer alive is ignored.
+   *
+   * @param {string} vatID
+   * @param {boolean} suspended
+   * @param {string} inboundNum
+   */
+  async function setVatSuspended(vatID, suspended, inboundNum) {
+    let error = null;
+    try {
+      if (suspended) {
+        controller.suspendVat(vatID);
+      } else {
+        controller.resumeVat(vatID);
+      }
+    } catch (e) {
+      blockManagerConsole.warn(`cannot suspend/resume ${vatID}:`, e);
+      error = `${e}`;
+    }
+    controller.writeSlogObject({
+      type: 'cosmic-swingset-vat-suspension',
+      inboundNum,
+      vatID,
+      suspended,
+      error,
+    });
+  }
+
   async function deliverInbound(sender, messages, ack, inboundNum) {
     Array.isArray(messages) || Fail`inbound given non-Array: ${messages}`;
     controller.writeSlogObject({
@@ -709,7 +863,8 @@ export async function launch({
         break;
       }
 
-      case ActionType.PLEASE_PROVISION: {
+      case ActionType.PLEASE_PROVISION:
+      case ActionType.REVOKE_EGRESS: {
         p = doBridgeInbound(BRIDGE_ID.PROVISION, action, inboundNum);
         break;
       }
@@ -724,6 +879,26 @@ export async function launch({
         break;
       }
 
+      case ActionType.PRUNE_BUNDLES: {
+        p = pruneBundles(action.bundleIDs, inboundNum);
+        break;
+      }
+
+      case ActionType.SUSPEND_VAT: {
+        p = setVatSuspended(action.vatID, true, inboundNum);
+        break;
+      }
+
+      case ActionType.RESUME_VAT: {
+        p = setVatSuspended(action.vatID, false, inboundNum);
+        break;
+      }
+
+      case ActionType.ORACLE_PUSH: {
+        p = doBridgeInbound(BRIDGE_ID.ORACLE, action, inboundNum);
+        break;
+      }
+
       case ActionType.CORE_EVAL: {
         p = doBridgeInbound(BRIDGE_ID.CORE, action, inboundNum);
         break;
@@ -792,7 +967,14 @@ export async function launch({
       return;
     }
 
-    // Then, process as much as we can from the priorityQueue.
+    // Then, process as much as we can from the oracleQueue, followed by the
+    // priorityQueue.
+    keepGoing = await processActions(
+      oracleQueue,
+      runSwingset,
+      CrankerPhase.Priority,
+    );
+    if (!keepGoing) return;
     keepGoing = await processActions(
       highPriorityQueue,
       runSwingset,
@@ -835,7 +1017,8 @@ export async function launch({
     // added up for delivery to swingset) into our inboundQueue metrics
     const newLengths = /** @type {Record<InboundQueueName, number>} */ ({
       [InboundQueueName.Forced]: runThisBlock.size(),
-      [InboundQueueName.Priority]: highPriorityQueue.size(),
+      [InboundQueueName.Priority]:
+        oracleQueue.size() + highPriorityQueue.size(),
       [InboundQueueName.Inbound]: actionQueue.size(),
     });
     inboundQueueMetrics.updateLengths(newLengths);
@@ -850,6 +1033,31 @@ export async function launch({
     const runSwingset = makeRunSwingset(blockHeight, runPolicy);
     await processBlockActions(runSwingset, blockHeight, blockTime);
 
+    // Remember the computrons used by each vat in this block for the END_BLOCK
+    // reply, including when the block is reevaluated after a restart.
+    const vatComputrons = Object.entries(runPolicy.vatComputrons()).map(
+      ([vatID, computrons]) => [vatID, `${computrons}`],
+    );
+    kvStore.set(
+      getHostKey('vatComputrons'),
+      JSON.stringify(Object.fromEntries(vatComputrons)),
+    );
+    const remainingBeans = runPolicy.remainingBeans();
+    if (remainingBeans === undefined) {
+      kvStore.delete(getHostKey('policyHeadroom'));
+    } else {
+      const headroom = remainingBeans > 0n ? remainingBeans : 0n;
+      kvStore.set(getHostKey('policyHeadroom'), `${headroom}`);
+    }
+
+    if (reportKernelStats) {
+      reportKernelStats({
+        crankCount: Number(kernelStorage.kvStore.get('crankNumber') || 0),
+        blockComputrons: Number(runPolicy.totalComputrons()),
+        vatHeapSizes: Object.fromEntries(vatHeapSizes),
+      });
+    }
+
     if (END_BLOCK_SPIN_MS) {
       // Introduce a busy-wait to artificially put load on the chain.
       const startTime = Date.now();
@@ -1034,9 +1242,25 @@ export async function launch({
     switch (action.type) {
       case ActionType.AG_COSMOS_INIT: {
         allowExportCallback = true; // cleared by saveOutsideState in COMMIT_BLOCK
-        const { blockHeight, isBootstrap, upgradeDetails } = action;
+        const { blockHeight, isBootstrap, upgradeDetails, bridgeJournal } =
+          action;
         // TODO: parseParams(action.params), for validation?
 
+        const savedBridgeJournal = kvStore.get(getHostKey('bridgeJournal'));
+        const bridgeJournalDivergence = checkBridgeJournal(
+          bridgeJournal,
+          savedBridgeJournal && JSON.parse(savedBridgeJournal),
+        );
+        if (bridgeJournalDivergence) {
+          blockManagerConsole.error(bridgeJournalDivergence);
+          controller.writeSlogObject({
+            type: 'cosmic-swingset-bridge-journal-divergence',
+            blockHeight,
+            bridgeJournal,
+            savedBridgeJournal: JSON.parse(savedBridgeJournal),
+          });
+        }
+
         if (!blockNeedsExecution(blockHeight)) {
           return true;
         }
@@ -1084,7 +1308,7 @@ export async function launch({
       }
 
       case ActionType.COMMIT_BLOCK: {
-        const { blockHeight, blockTime } = action;
+        const { blockHeight, blockTime, bridgeJournalSeq } = action;
         verboseBlocks &&
           blockManagerConsole.info('block', blockHeight, 'commit');
         if (blockHeight !== savedHeight) {
@@ -1104,7 +1328,7 @@ export async function launch({
 
         // Save the kernel's computed state just before the chain commits.
         const start = Date.now();
-        await saveOutsideState(savedHeight);
+        await saveOutsideState(savedHeight, bridgeJournalSeq);
         saveTime = Date.now() - start;
 
         blockParams = undefined;
@@ -1137,7 +1361,7 @@ export async function launch({
 
       case ActionType.BEGIN_BLOCK: {
         allowExportCallback = true; // cleared by saveOutsideState in COMMIT_BLOCK
-        const { blockHeight, blockTime, params } = action;
+        const { blockHeight, blockTime, params, traceContext } = action;
         blockParams = parseParams(params);
         verboseBlocks &&
           blockManagerConsole.info('block', blockHeight, 'begin');
@@ -1159,6 +1383,8 @@ export async function launch({
           blockHeight,
           blockTime,
           inboundQueueStats: inboundQueueMetrics.getStats(),
+          // The W3C trace context of the Cosmos span of BEGIN_BLOCK, if traced.
+          traceContext,
         });
 
         return undefined;
@@ -1229,7 +1455,12 @@ export async function launch({
 
         endBlockFinish = Date.now();
 
-        return undefined;
+        return harden({
+          timer: getTimerStatus(),
+          vatComputrons: getVatComputrons(),
+          policyHeadroom: getPolicyHeadroom(),
+          vatBundleIDs: getBundleReferences(),
+        });
       }
 
       default: {
@@ -16,7 +16,11 @@ import { makeWithQueue } from '@agoric/internal/src/queue.js';
 import { makeBatchedDeliver } from '@agoric/internal/src/batched-deliver.js';
 import stringify from './helpers/json-stable-stringify.js';
 import { launch } from './launch-chain.js';
-import { DEFAULT_SIM_SWINGSET_PARAMS, QueueInbound } from './sim-params.js';
+import {
+  DEFAULT_SIM_SWINGSET_PARAMS,
+  QueueInbound,
+  withMaxComputronsPerBlock,
+} from './sim-params.js';
 import { parseQueueSizes } from './params.js';
 import { makeKVStoreFromMap } from './helpers/bufferedStorage.js';
 import { makeQueue, makeQueueStorageMock } from './helpers/make-queue.js';
@@ -70,6 +74,15 @@ export async function connectToFakeChain(basedir, GCI, delay, inbound) {
 
   const mailboxStorage = await makeMailboxStorageFromFile(mailboxFile);
 
+  // The simulated chain has no other nodes to agree with, so its compute
+  // budget may be set locally.
+  const simParams = env.SIM_CHAIN_MAX_COMPUTRONS_PER_BLOCK
+    ? withMaxComputronsPerBlock(
+        DEFAULT_SIM_SWINGSET_PARAMS,
+        BigInt(env.SIM_CHAIN_MAX_COMPUTRONS_PER_BLOCK),
+      )
+    : DEFAULT_SIM_SWINGSET_PARAMS;
+
   const argv = {
     giveMeAllTheAgoricPowers: true,
     hardcodedClientAddresses: [bootAddress],
@@ -78,7 +91,7 @@ export async function connectToFakeChain(basedir, GCI, delay, inbound) {
         { denom: 'ubld', amount: `${50_000n * 10n ** 6n}` },
         { denom: 'uist', amount: `${1_000_000n * 10n ** 6n}` },
       ],
-      params: DEFAULT_SIM_SWINGSET_PARAMS,
+      params: simParams,
     },
   };
 
@@ -113,12 +126,14 @@ export async function connectToFakeChain(basedir, GCI, delay, inbound) {
 
   const actionQueueStorage = makeQueueStorageMock().storage;
   const highPriorityQueueStorage = makeQueueStorageMock().storage;
+  const oracleQueueStorage = makeQueueStorageMock().storage;
   const actionQueue = makeQueue(actionQueueStorage);
 
   const s = await launch({
     bridgeOutbound: /** @type {any} */ (undefined),
     actionQueueStorage,
     highPriorityQueueStorage,
+    oracleQueueStorage,
     kernelStateDBDir: stateDBdir,
     mailboxStorage,
     clearChainSends,
@@ -151,7 +166,7 @@ export async function connectToFakeChain(basedir, GCI, delay, inbound) {
       const blockTime = scaleBlockTime(Date.now());
       blockHeight += 1;
 
-      const params = DEFAULT_SIM_SWINGSET_PARAMS;
+      const params = simParams;
       const beginAction = {
         type: 'BEGIN_BLOCK',
         blockHeight,
@@ -229,7 +244,7 @@ export async function connectToFakeChain(basedir, GCI, delay, inbound) {
       type: 'AG_COSMOS_INIT',
       blockTime: scaleBlockTime(Date.now()),
       isBootstrap: true,
-      params: DEFAULT_SIM_SWINGSET_PARAMS,
+      params: simParams,
     });
     blockHeight = initialHeight;
   };
@@ -61,6 +61,38 @@ export const defaultBeansPerUnit = [
   makeStringBeans(BeansPerXsnapComputron, defaultBeansPerXsnapComputron),
 ];
 
+// The smallest per-block compute budget, in computrons, that a simulated chain
+// may be given.  Lower budgets risk starving the kernel of the work needed to
+// keep up with inbound actions.
+export const minMaxComputronsPerBlock = 1_000_000n;
+
+/**
+ * Return simulated chain params whose block compute limit is
+ * `maxComputronsPerBlock` computrons.  A real chain takes its limit from the
+ * `blockComputeLimit` entry of its governed params, so that every node does
+ * the same work in each block.
+ *
+ * @param {import('@agoric/cosmic-proto/swingset/swingset.js').ParamsSDKType} params
+ * @param {bigint} maxComputronsPerBlock
+ */
+export const withMaxComputronsPerBlock = (params, maxComputronsPerBlock) => {
+  maxComputronsPerBlock >= minMaxComputronsPerBlock ||
+    Fail`maxComputronsPerBlock must be at least ${minMaxComputronsPerBlock}`;
+  const computron = params.beans_per_unit.find(
+    ({ key }) => key === BeansPerXsnapComputron,
+  );
+  computron || Fail`params lack ${BeansPerXsnapComputron} beans`;
+  const limit = maxComputronsPerBlock * BigInt(computron.beans);
+  return harden({
+    ...params,
+    beans_per_unit: params.beans_per_unit.map(entry =>
+      entry.key === BeansPerBlockComputeLimit
+        ? makeStringBeans(BeansPerBlockComputeLimit, limit)
+        : entry,
+    ),
+  });
+};
+
 const defaultBootstrapVatConfig =
   '@agoric/vm-config/decentral-demo-config.json';
 
@@ -0,0 +1,45 @@
+// @ts-check
+
+/** @import {SlogSender} from '@agoric/telemetry' */
+
+/**
+ * Make a filter of slog entries by type, whose excluded types can be changed
+ * at any time, as by SET_LOG_CONFIG.
+ *
+ * @param {string[]} [excludeTypes] the types of the slog entries to drop
+ */
+export const makeSlogFilter = (excludeTypes = []) => {
+  let excluded = new Set(excludeTypes);
+
+  /**
+   * Replace the types of the slog entries to drop.
+   *
+   * @param {string[]} types
+   */
+  const setExcludeTypes = types => {
+    excluded = new Set(types);
+  };
+
+  /**
+   * Wrap slogSender to drop the excluded entries.  An absent slogSender stays
+   * absent, so that the kernel can skip making slog entries at all.
+   *
+   * @param {SlogSender | undefined} slogSender
+   * @returns {SlogSender | undefined}
+   */
+  const wrapSlogSender = slogSender =>
+    slogSender &&
+    Object.assign(
+      (slogObj, ...rest) => {
+        if (excluded.has(slogObj.type)) return;
+        slogSender(slogObj, ...rest);
+      },
+      {
+        forceFlush: slogSender.forceFlush,
+        shutdown: slogSender.shutdown,
+        usesJsonObject: slogSender.usesJsonObject,
+      },
+    );
+
+  return harden({ setExcludeTypes, wrapSlogSender });
+};
@@ -34,6 +34,13 @@ export type PleaseProvisionAction = ActionContext<'PLEASE_PROVISION'> & {
   autoProvision: boolean;
 };
 
+/**
+ * @see revokeEgressAction in keeper.go
+ */
+export type RevokeEgressAction = ActionContext<'REVOKE_EGRESS'> & {
+  peer: string;
+};
+
 /**
  * @see VbankBalanceUpdate in vbank.go
  */
@@ -45,4 +52,5 @@ export type VbankBalanceUpdateAction = ActionContext<'VBANK_BALANCE_UPDATE'> & {
 export type BridgeMessage =
   | CoreEvalAction
   | PleaseProvisionAction
+  | RevokeEgressAction
   | VbankBalanceUpdateAction;
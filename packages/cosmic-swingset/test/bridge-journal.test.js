@@ -0,0 +1,24 @@
+// @ts-check
+import test from 'ava';
+import { checkBridgeJournal } from '../src/bridge-journal.js';
+
+test('checkBridgeJournal', t => {
+  const checkpoint = { height: 10, seq: 42 };
+  t.is(checkBridgeJournal(undefined, undefined), undefined);
+  t.is(checkBridgeJournal(checkpoint, undefined), undefined, 'no saved seq');
+  t.is(checkBridgeJournal(undefined, checkpoint), undefined, 'no journal');
+  t.is(checkBridgeJournal(checkpoint, { height: 10, seq: 42 }), undefined);
+  t.is(
+    checkBridgeJournal(checkpoint, { height: 11, seq: 50 }),
+    undefined,
+    'the VM committed one more block before the crash',
+  );
+  t.regex(
+    checkBridgeJournal(checkpoint, { height: 10, seq: 41 }) || '',
+    /block 10 at sequence number 42, but the VM committed it at 41/,
+  );
+  t.regex(
+    checkBridgeJournal(checkpoint, { height: 9, seq: 40 }) || '',
+    /committed block 10, but the VM only committed block 9/,
+  );
+});
@@ -0,0 +1,63 @@
+// @ts-check
+import test from 'ava';
+import {
+  getReferencedBundleIDs,
+  getVatBundleIDs,
+} from '../src/bundle-references.js';
+
+/** @param {string} letter */
+const bundleID = letter => `b1-${letter.repeat(128)}`;
+
+/**
+ * @param {Record<string, string>} entries
+ * @returns {any} a minimal kernel KVStore
+ */
+const makeKvStore = entries => {
+  const map = new Map(Object.entries(entries));
+  return {
+    get: key => map.get(key),
+    has: key => map.has(key),
+    getNextKey: previousKey =>
+      [...map.keys()].sort().find(key => key > previousKey),
+  };
+};
+
+const makeKernelKvStore = () =>
+  makeKvStore({
+    'vat.name.bootstrap': 'v1',
+    'vat.name.zoe': 'v2',
+    'vat.dynamicIDs': JSON.stringify(['v3', 'v4']),
+    'v1.source': JSON.stringify({ bundleName: 'bootstrap' }),
+    'v2.source': JSON.stringify({ bundleID: bundleID('a') }),
+    'v3.source': JSON.stringify({ bundleID: bundleID('b') }),
+    'v4.source': JSON.stringify({ bundleID: bundleID('a') }),
+    // A terminated vat leaves no ID among the live vats.
+    'v5.source': JSON.stringify({ bundleID: bundleID('c') }),
+    'namedBundleID.bootstrap': bundleID('d'),
+    'device.name.vatAdmin': 'd7',
+    [`d7.vs.id.${bundleID('e')}`]: 'd+1',
+    'd7.vs.slot.d+1': bundleID('e'),
+    [`d8.vs.id.${bundleID('f')}`]: 'd+1',
+  });
+
+test('getVatBundleIDs reports the live vats', t => {
+  t.deepEqual(getVatBundleIDs(makeKernelKvStore()), {
+    v2: bundleID('a'),
+    v3: bundleID('b'),
+    v4: bundleID('a'),
+  });
+  t.deepEqual(
+    getVatBundleIDs(makeKvStore({ 'vat.dynamicIDs': '[]' })),
+    {},
+    'no vats',
+  );
+});
+
+test('getReferencedBundleIDs keeps bundlecap bundles', t => {
+  const referenced = getReferencedBundleIDs(makeKernelKvStore());
+  t.deepEqual(
+    [...referenced].sort(),
+    [bundleID('a'), bundleID('b'), bundleID('d'), bundleID('e')],
+    'live vats, named bundles, and bundlecaps of the vatAdmin device',
+  );
+});
@@ -0,0 +1,21 @@
+// @ts-check
+import test from 'ava';
+import fs from 'node:fs';
+import tmp from 'tmp';
+import { readVmTransport } from '../src/grpc-agcc.js';
+
+test('readVmTransport', t => {
+  const { name: home, removeCallback } = tmp.dirSync({ unsafeCleanup: true });
+  t.teardown(removeCallback);
+  t.is(readVmTransport(home), 'bridge', 'no app.toml');
+
+  fs.mkdirSync(`${home}/config`);
+  const writeAppConfig = content =>
+    fs.writeFileSync(`${home}/config/app.toml`, content);
+  writeAppConfig('[api]\nenable = false\n');
+  t.is(readVmTransport(home), 'bridge', 'no [swingset] section');
+  writeAppConfig('[swingset]\nvm_transport = ""\n');
+  t.is(readVmTransport(home), 'bridge');
+  writeAppConfig('[swingset]\nvm_transport = "grpc"\n');
+  t.is(readVmTransport(home), 'grpc');
+});
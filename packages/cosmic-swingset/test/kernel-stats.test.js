@@ -0,0 +1,57 @@
+// @ts-check
+import test from 'ava';
+import { makeSlogger } from '@agoric/swingset-vat/src/kernel/slogger.js';
+import { makeSlogCallbacks } from '../src/kernel-stats.js';
+
+const makeFakeMeter = () =>
+  /** @type {any} */ ({
+    createHistogram: () => ({ record: () => {} }),
+  });
+
+test('slog callbacks forget the heap sizes of terminated vats', t => {
+  /** @type {Map<string, number>} */
+  const vatHeapSizes = new Map();
+  const slogCallbacks = makeSlogCallbacks({
+    metricMeter: makeFakeMeter(),
+    onDeliveryMeterUsage: (vatID, { currentHeapCount }) => {
+      if (typeof currentHeapCount === 'number') {
+        vatHeapSizes.set(vatID, currentHeapCount);
+      }
+    },
+    onTerminateVat: vatID => {
+      vatHeapSizes.delete(vatID);
+    },
+  });
+  /** @type {any[]} */
+  const written = [];
+  const slogger = makeSlogger(slogCallbacks, obj => written.push(obj));
+
+  let crankNum = 0;
+  /**
+   * @param {string} vatID
+   * @param {number} currentHeapCount
+   */
+  const deliver = (vatID, currentHeapCount) => {
+    crankNum += 1;
+    const finish = slogger.delivery(vatID, crankNum, crankNum, {}, {});
+    finish(['ok', null, { meterType: 'xs-meter-34', currentHeapCount }]);
+  };
+
+  deliver('v1', 100);
+  deliver('v2', 200);
+  deliver('v1', 150);
+  t.deepEqual(Object.fromEntries(vatHeapSizes), { v1: 150, v2: 200 });
+
+  slogger.terminateVat('v2', true, 'bad vat');
+  t.deepEqual(Object.fromEntries(vatHeapSizes), { v1: 150 });
+  t.like(written.at(-1), {
+    type: 'terminate',
+    vatID: 'v2',
+    shouldReject: true,
+    info: 'bad vat',
+  });
+
+  // A later delivery to a live vat is still tracked.
+  deliver('v1', 175);
+  t.deepEqual(Object.fromEntries(vatHeapSizes), { v1: 175 });
+});
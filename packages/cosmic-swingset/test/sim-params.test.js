@@ -0,0 +1,42 @@
+// @ts-check
+import test from 'ava';
+import {
+  BeansPerBlockComputeLimit,
+  DEFAULT_SIM_SWINGSET_PARAMS,
+  defaultBeansPerXsnapComputron,
+  minMaxComputronsPerBlock,
+  withMaxComputronsPerBlock,
+} from '../src/sim-params.js';
+
+const getBeans = (params, key) =>
+  params.beans_per_unit.find(entry => entry.key === key)?.beans;
+
+test('withMaxComputronsPerBlock sets the block compute limit', t => {
+  const params = withMaxComputronsPerBlock(
+    DEFAULT_SIM_SWINGSET_PARAMS,
+    65_000_000n,
+  );
+  t.is(
+    getBeans(params, BeansPerBlockComputeLimit),
+    `${65_000_000n * defaultBeansPerXsnapComputron}`,
+  );
+  t.is(
+    params.beans_per_unit.length,
+    DEFAULT_SIM_SWINGSET_PARAMS.beans_per_unit.length,
+  );
+  t.not(
+    getBeans(DEFAULT_SIM_SWINGSET_PARAMS, BeansPerBlockComputeLimit),
+    getBeans(params, BeansPerBlockComputeLimit),
+  );
+});
+
+test('withMaxComputronsPerBlock rejects a budget below the minimum', t => {
+  t.throws(
+    () =>
+      withMaxComputronsPerBlock(
+        DEFAULT_SIM_SWINGSET_PARAMS,
+        minMaxComputronsPerBlock - 1n,
+      ),
+    { message: /must be at least/ },
+  );
+});
@@ -0,0 +1,40 @@
+// @ts-check
+import test from 'ava';
+import { makeSlogFilter } from '../src/slog-filter.js';
+
+test('makeSlogFilter', t => {
+  const filter = makeSlogFilter(['syscall']);
+  t.is(filter.wrapSlogSender(undefined), undefined, 'no slog sender');
+
+  /** @type {string[]} */
+  const sent = [];
+  const forceFlush = async () => {};
+  const slogSender = Object.assign(
+    (/** @type {any} */ slogObj) => {
+      sent.push(slogObj.type);
+    },
+    { forceFlush },
+  );
+  const filtered = filter.wrapSlogSender(slogSender);
+  if (!filtered) throw Error('no filtered slog sender');
+  t.is(filtered.forceFlush, forceFlush);
+
+  const slogTypes = () => {
+    for (const type of ['deliver', 'syscall', 'syscall-result']) {
+      filtered({ type, time: 0 });
+    }
+  };
+  slogTypes();
+  t.deepEqual(sent, ['deliver', 'syscall-result']);
+
+  // As by SET_LOG_CONFIG.
+  sent.length = 0;
+  filter.setExcludeTypes(['syscall-result']);
+  slogTypes();
+  t.deepEqual(sent, ['deliver', 'syscall']);
+
+  sent.length = 0;
+  filter.setExcludeTypes([]);
+  slogTypes();
+  t.deepEqual(sent, ['deliver', 'syscall', 'syscall-result']);
+});
@@ -222,6 +222,7 @@ export const makeCosmicSwingsetTestKit = async (
 
   const actionQueueStorage = makeQueueStorageMock().storage;
   const highPriorityQueueStorage = makeQueueStorageMock().storage;
+  const oracleQueueStorage = makeQueueStorageMock().storage;
   const { kvStore: mailboxKVStore, ...mailboxBufferMethods } =
     makeBufferedStorage(
       /** @type {KVStore<Mailbox>} */ (makeKVStoreFromMap(new Map())),
@@ -242,6 +243,7 @@ export const makeCosmicSwingsetTestKit = async (
     swingStore,
     actionQueueStorage,
     highPriorityQueueStorage,
+    oracleQueueStorage,
     mailboxStorage,
     clearChainSends,
     replayChainSends,
@@ -329,6 +331,8 @@ export const makeCosmicSwingsetTestKit = async (
   const actionQueue = makeQueue(actionQueueStorage);
   /** @type {InboundQueue} */
   const highPriorityQueue = makeQueue(highPriorityQueueStorage);
+  /** @type {InboundQueue} */
+  const oracleQueue = makeQueue(oracleQueueStorage);
   /**
    * @param {{ type: QueuedActionType } & Record<string, unknown>} action
    * @param {InboundQueue} [queue]
@@ -379,6 +383,7 @@ export const makeCosmicSwingsetTestKit = async (
     // SwingSet-oriented references.
     actionQueue,
     highPriorityQueue,
+    oracleQueue,
     mailboxStorage,
     shutdown,
     swingStore,
@@ -3,6 +3,7 @@
  *   https://github.com/smartcontractkit/chainlink/blob/master/contracts/src/v0.6/FluxAggregator.sol
  *   (version?)
  */
+import { Fail } from '@endo/errors';
 import { AmountMath } from '@agoric/ertp';
 import { assertAllDefined, makeTracer } from '@agoric/internal';
 import { makeNotifierFromSubscriber, observeNotifier } from '@agoric/notifier';
@@ -277,6 +278,19 @@ export const prepareFluxAggregatorKit = async (
 
           return zcf.makeInvitation(offerHandler, INVITATION_MAKERS_DESC);
         },
+        /**
+         * Push a price on behalf of an oracle, for the MsgOraclePush updates
+         * that the chain authenticates as from the oracle's address and
+         * delivers without the oracle's smart wallet.
+         *
+         * @param {string} oracleId
+         * @param {import('./roundsManager.js').PriceRound} result
+         */
+        async pushPriceForOracle(oracleId, result) {
+          const { oracles } = this.state;
+          oracles.has(oracleId) || Fail`No oracle ${oracleId}`;
+          await oracles.get(oracleId).oracle.pushPrice(result);
+        },
         /** @param {string} oracleId */
         async removeOracle(oracleId) {
           const { oracles } = this.state;
@@ -107,6 +107,7 @@ export const createPriceFeed = async (
       econCharterKit,
       highPrioritySendersManager,
       namesByAddressAdmin,
+      oraclePushRegistrar,
       priceAuthority,
       priceAuthorityAdmin,
       startGovernedUpgradable,
@@ -231,6 +232,20 @@ export const createPriceFeed = async (
   produceInstance[AGORIC_INSTANCE_NAME].reset();
   produceInstance[AGORIC_INSTANCE_NAME].resolve(faKit.instance);
 
+  // Route the chain's MsgOraclePush updates for this feed to its oracles,
+  // replacing those of any feed it replaces.  Don't block on a chain that does
+  // not route them yet.
+  void E.when(oraclePushRegistrar, registrar =>
+    registrar
+      ? E(registrar).registerFeed(
+          `${IN_BRAND_NAME}-${OUT_BRAND_NAME}`,
+          faKit.creatorFacet,
+        )
+      : undefined,
+  ).catch(err =>
+    console.error(`🚨 failed to route oracle pushes to ${label}`, err),
+  );
+
   E(E.get(econCharterKit).creatorFacet).addInstance(
     faKit.instance,
     faKit.governorCreatorFacet,
@@ -281,6 +296,7 @@ export const getManifestForPriceFeed = async (
         econCharterKit: t,
         highPrioritySendersManager: t,
         namesByAddressAdmin: t,
+        oraclePushRegistrar: t,
         priceAuthority: t,
         priceAuthorityAdmin: t,
         startGovernedUpgradable: t,
@@ -21,6 +21,8 @@ export const SwingsetMessageType = /** @type {const} */ ({
   COMMIT_BLOCK: 'COMMIT_BLOCK',
   AFTER_COMMIT_BLOCK: 'AFTER_COMMIT_BLOCK',
   SWING_STORE_EXPORT: 'SWING_STORE_EXPORT', // used to synchronize data export
+  HEALTH_CHECK: 'HEALTH_CHECK', // outside of consensus, to ping the VM
+  SET_LOG_CONFIG: 'SET_LOG_CONFIG', // outside of consensus, to change logging
 });
 harden(SwingsetMessageType);
 
@@ -32,14 +34,18 @@ export const {
   COMMIT_BLOCK,
   AFTER_COMMIT_BLOCK,
   SWING_STORE_EXPORT,
+  HEALTH_CHECK,
+  SET_LOG_CONFIG,
 } = SwingsetMessageType;
 
 /**
- * Types of "action" messages consumed by the swingset VM from actionQueue or
- * highPriorityQueue during END_BLOCK. See:
+ * Types of "action" messages consumed by the swingset VM from actionQueue,
+ * highPriorityQueue, or oracleQueue during END_BLOCK. See:
  *
+ * - ../../../golang/cosmos/x/swingset/keeper/bundle_refs.go
  * - ../../../golang/cosmos/x/swingset/keeper/msg_server.go
  * - ../../../golang/cosmos/x/swingset/keeper/proposal.go
+ * - ../../../golang/cosmos/x/swingset/keeper/vat_billing.go
  * - ../../../golang/cosmos/x/vbank/vbank.go
  * - ../../../golang/cosmos/x/vibc/handler.go
  * - ../../../golang/cosmos/x/vibc/keeper/triggers.go
@@ -53,11 +59,16 @@ export const QueuedActionType = /** @type {const} */ ({
   IBC_EVENT: 'IBC_EVENT',
   INSTALL_BUNDLE: 'INSTALL_BUNDLE',
   PLEASE_PROVISION: 'PLEASE_PROVISION',
+  REVOKE_EGRESS: 'REVOKE_EGRESS',
   VBANK_BALANCE_UPDATE: 'VBANK_BALANCE_UPDATE',
   WALLET_ACTION: 'WALLET_ACTION',
   WALLET_SPEND_ACTION: 'WALLET_SPEND_ACTION',
   VTRANSFER_IBC_EVENT: 'VTRANSFER_IBC_EVENT',
   KERNEL_UPGRADE_EVENTS: 'KERNEL_UPGRADE_EVENTS',
+  PRUNE_BUNDLES: 'PRUNE_BUNDLES',
+  ORACLE_PUSH: 'ORACLE_PUSH',
+  SUSPEND_VAT: 'SUSPEND_VAT',
+  RESUME_VAT: 'RESUME_VAT',
 });
 harden(QueuedActionType);
 
@@ -68,9 +79,14 @@ export const {
   IBC_EVENT,
   INSTALL_BUNDLE,
   PLEASE_PROVISION,
+  REVOKE_EGRESS,
   VBANK_BALANCE_UPDATE,
   WALLET_ACTION,
   WALLET_SPEND_ACTION,
   VTRANSFER_IBC_EVENT,
   KERNEL_UPGRADE_EVENTS,
+  PRUNE_BUNDLES,
+  ORACLE_PUSH,
+  SUSPEND_VAT,
+  RESUME_VAT,
 } = QueuedActionType;
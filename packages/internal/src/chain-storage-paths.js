@@ -7,6 +7,8 @@
  */
 export const ACTION_QUEUE = 'actionQueue';
 export const HIGH_PRIORITY_QUEUE = 'highPriorityQueue';
+export const ORACLE_QUEUE = 'oracleQueue';
+export const INBOUND_LANES = 'inboundLanes';
 export const HIGH_PRIORITY_SENDERS = 'highPrioritySenders';
 export const BEANSOWING = 'beansOwing';
 export const EGRESS = 'egress';
@@ -14,3 +16,4 @@ export const MAILBOX = 'mailbox';
 export const BUNDLES = 'bundles';
 export const CUSTOM = 'published';
 export const SWING_STORE = 'swingStore';
+export const SWINGSET = 'swingset';
@@ -22,6 +22,8 @@ export const BridgeId = /** @type {const} */ ({
   BANK: 'bank',
   CORE: 'core',
   DIBC: 'dibc',
+  ENTROPY: 'entropy',
+  ORACLE: 'oracle',
   STORAGE: 'storage',
   PROVISION: 'provision',
   PROVISION_SMART_WALLET: 'provisionWallet',
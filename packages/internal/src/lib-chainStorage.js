@@ -127,7 +127,10 @@ harden(assertPathSegment);
  *   | 'size'} StorageGetByPathMessageMethod
  *
  *
- * @typedef {'set' | 'setWithoutNotify' | 'append'} StorageUpdateEntriesMessageMethod
+ * @typedef {'set'
+ *   | 'setWithoutNotify'
+ *   | 'setBatch'
+ *   | 'append'} StorageUpdateEntriesMessageMethod
  *
  *
  * @typedef {StorageGetByPathMessageMethod
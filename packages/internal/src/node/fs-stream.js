@@ -1,4 +1,3 @@
-import { createWriteStream } from 'node:fs';
 import process from 'node:process';
 import { open } from 'node:fs/promises';
 
@@ -40,10 +39,6 @@ export const fsStreamReady = stream =>
     stream.on('error', onError);
   });
 
-const noPath = /** @type {import('fs').PathLike} */ (
-  /** @type {unknown} */ (undefined)
-);
-
 /** @typedef {NonNullable<Awaited<ReturnType<typeof makeFsStreamWriter>>>} FsStreamWriter */
 /** @param {string | undefined | null} filePath */
 export const makeFsStreamWriter = async filePath => {
@@ -53,9 +48,10 @@ export const makeFsStreamWriter = async filePath => {
 
   const handle = await (filePath !== '-' ? open(filePath, 'a') : undefined);
 
-  const stream = handle
-    ? createWriteStream(noPath, { fd: handle.fd })
-    : process.stdout;
+  // The stream owns the handle, closing it with the stream, so that a writer
+  // which has been closed does not leave the handle to be closed again on
+  // garbage collection.
+  const stream = handle ? handle.createWriteStream() : process.stdout;
   await fsStreamReady(stream);
 
   let flushed = Promise.resolve();
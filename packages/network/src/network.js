@@ -114,18 +114,23 @@ const prepareHalfConnection = (zone, { watch, allVows, finalizer }) => {
           const { addrs, r } = this.state;
           return addrs[r];
         },
-        /** @param {Bytes} packetBytes */
-        async send(packetBytes) {
+        /**
+         * @param {Bytes} packetBytes
+         * @param {Record<string, any>} [opts]
+         */
+        async send(packetBytes, opts) {
           const { closed, handlers, r, conns } = this.state;
           if (closed) {
             throw Error(closed);
           }
 
+          // Pass along any options, such as the timeouts of an IBC packet.
           const innerVow = watch(
             E(handlers[r]).onReceive(
               conns.get(r),
               toBytes(packetBytes),
               handlers[r],
+              ...(opts ? [opts] : []),
             ),
             this.facets.openConnectionAckWatcher,
           );
@@ -306,8 +306,8 @@ test.serial(
       "startChannelOpenInit fires one add'l time during automatic reactivate",
     );
     t.falsy(
-      bridgeDowncalls1.find(x => x.method === 'startChannelCloseInit'),
-      'should not send startChannelCloseInit downcall to bridge',
+      bridgeDowncalls1.find(x => x.method === 'chanCloseInit'),
+      'should not send chanCloseInit downcall to bridge',
     );
   },
 );
@@ -340,8 +340,8 @@ test.serial(
     const { bridgeDowncalls: bridgeDowncalls0 } = await inspectDibcBridge();
     t.is(
       bridgeDowncalls0?.[2]?.method,
-      'startChannelCloseInit',
-      'bridge received startChannelCloseInit downcall',
+      'chanCloseInit',
+      'bridge received chanCloseInit downcall',
     );
 
     // reactivate the account
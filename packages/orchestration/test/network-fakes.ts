@@ -56,7 +56,10 @@ const addParamsIfJsonVersion = (
   }
 };
 
-type ImplementedIBCEvents = 'channelOpenAck' | 'acknowledgementPacket';
+type ImplementedIBCEvents =
+  | 'channelOpenAck'
+  | 'acknowledgementPacket'
+  | 'channelCloseConfirm';
 
 export const ibcBridgeMocks: {
   [T in ImplementedIBCEvents]: T extends 'channelOpenAck'
@@ -73,7 +76,9 @@ export const ibcBridgeMocks: {
           obj: IBCMethod<'sendPacket'>,
           opts: { sequence: bigint; acknowledgement: string },
         ) => IBCEvent<'acknowledgementPacket'>
-      : never;
+      : T extends 'channelCloseConfirm'
+        ? (obj: IBCMethod<'chanCloseInit'>) => IBCEvent<'channelCloseConfirm'>
+        : never;
 } = {
   channelOpenAck: (
     obj: IBCMethod<'startChannelOpenInit'>,
@@ -130,6 +135,20 @@ export const ibcBridgeMocks: {
       type: 'IBC_EVENT',
     };
   },
+
+  channelCloseConfirm: (
+    obj: IBCMethod<'chanCloseInit'>,
+  ): IBCEvent<'channelCloseConfirm'> => {
+    return {
+      blockHeight: 290,
+      blockTime: 1712180325,
+      event: 'channelCloseConfirm',
+      portID: obj.packet.source_port,
+      channelID: obj.packet.source_channel,
+      target: obj.target,
+      type: 'IBC_EVENT',
+    };
+  },
 };
 
 type BridgeEvents = Array<
@@ -141,7 +160,7 @@ type BridgeEvents = Array<
 
 type BridgeDowncalls = Array<
   | IBCMethod<'startChannelOpenInit'>
-  | IBCMethod<'startChannelCloseInit'>
+  | IBCMethod<'chanCloseInit'>
   | IBCMethod<'bindPort'>
   | IBCMethod<'sendPacket'>
 >;
@@ -250,6 +269,12 @@ export const makeFakeIBCBridge = (
             remoteChannelMap[obj.hops[0]] = connectionChannelCount + 1;
             return undefined;
           }
+          case 'chanCloseInit': {
+            const closeEvent = ibcBridgeMocks.channelCloseConfirm(obj);
+            bridgeHandler?.fromBridge(closeEvent);
+            bridgeEvents = bridgeEvents.concat(closeEvent);
+            return undefined;
+          }
           case 'sendPacket': {
             const mockAckMapHasData = obj.packet.data in mockAckMap;
             if (!mockAckMapHasData) {
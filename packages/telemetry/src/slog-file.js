@@ -1,22 +1,125 @@
+import { createReadStream, createWriteStream } from 'node:fs';
+import { rename, stat, unlink } from 'node:fs/promises';
+import { pipeline } from 'node:stream/promises';
+import { createGzip } from 'node:zlib';
 import { makeFsStreamWriter } from '@agoric/internal/src/node/fs-stream.js';
 import { serializeSlogObj } from './serialize-slog-obj.js';
 
+const MEGABYTE = 1024 * 1024;
+
+/**
+ * Shift `${filePath}.1`, `${filePath}.2`, ... up by one (discarding any beyond
+ * `maxBackups`), then move `filePath` itself into the `.1` position.
+ *
+ * @param {string} filePath
+ * @param {number} maxBackups - zero retains all backups
+ * @param {boolean} compress
+ */
+const rotateSlogFile = async (filePath, maxBackups, compress) => {
+  const ext = compress ? '.gz' : '';
+  const backupPath = n => `${filePath}.${n}${ext}`;
+  const exists = p =>
+    stat(p).then(
+      () => true,
+      () => false,
+    );
+
+  let last = 1;
+  while (await exists(backupPath(last))) {
+    last += 1;
+  }
+  for (let n = last - 1; n >= 1; n -= 1) {
+    if (maxBackups && n >= maxBackups) {
+      await unlink(backupPath(n));
+    } else {
+      await rename(backupPath(n), backupPath(n + 1));
+    }
+  }
+
+  if (!compress) {
+    await rename(filePath, backupPath(1));
+    return;
+  }
+  const tmpPath = `${filePath}.rotating`;
+  await rename(filePath, tmpPath);
+  await pipeline(
+    createReadStream(tmpPath),
+    createGzip(),
+    createWriteStream(backupPath(1)),
+  );
+  await unlink(tmpPath);
+};
+
 /** @param {import('./index.js').MakeSlogSenderOptions} opts */
-export const makeSlogSender = async ({ env: { SLOGFILE } = {} } = {}) => {
-  const stream = await makeFsStreamWriter(SLOGFILE);
+export const makeSlogSender = async ({
+  env: {
+    SLOGFILE,
+    SLOGFILE_MAX_SIZE,
+    SLOGFILE_MAX_BACKUPS,
+    SLOGFILE_COMPRESS,
+  } = {},
+} = {}) => {
+  let stream = await makeFsStreamWriter(SLOGFILE);
 
   if (!stream) {
     return undefined;
   }
 
+  const maxBytes = Number(SLOGFILE_MAX_SIZE || 0) * MEGABYTE;
+  if (!maxBytes || SLOGFILE === '-') {
+    const slogSender = (slogObj, jsonObj = serializeSlogObj(slogObj)) => {
+      // eslint-disable-next-line prefer-template
+      stream.write(jsonObj + '\n').catch(() => {});
+    };
+
+    return Object.assign(slogSender, {
+      forceFlush: async () => stream.flush(),
+      shutdown: async () => stream.close(),
+      usesJsonObject: true,
+    });
+  }
+
+  const filePath = /** @type {string} */ (SLOGFILE);
+  const maxBackups = Number(SLOGFILE_MAX_BACKUPS || 0);
+  const compress = ['1', 'true'].includes(SLOGFILE_COMPRESS || '');
+  let bytesWritten = await stat(filePath).then(
+    s => s.size,
+    () => 0,
+  );
+
+  // Rotation must not interleave with writes, so every operation on the
+  // stream is serialized through this promise chain.
+  let queue = Promise.resolve();
+  const enqueue = fn => {
+    const result = queue.then(fn);
+    queue = result.catch(() => {});
+    return result;
+  };
+
+  const rotate = async () => {
+    const oldStream = stream;
+    await oldStream.close();
+    await rotateSlogFile(filePath, maxBackups, compress);
+    stream = await makeFsStreamWriter(filePath);
+    bytesWritten = 0;
+  };
+
   const slogSender = (slogObj, jsonObj = serializeSlogObj(slogObj)) => {
     // eslint-disable-next-line prefer-template
-    stream.write(jsonObj + '\n').catch(() => {});
+    const line = jsonObj + '\n';
+    const size = Buffer.byteLength(line);
+    enqueue(async () => {
+      if (bytesWritten > 0 && bytesWritten + size > maxBytes) {
+        await rotate();
+      }
+      bytesWritten += size;
+      stream.write(line).catch(() => {});
+    }).catch(() => {});
   };
 
   return Object.assign(slogSender, {
-    forceFlush: async () => stream.flush(),
-    shutdown: async () => stream.close(),
+    forceFlush: async () => enqueue(() => stream.flush()),
+    shutdown: async () => enqueue(() => stream.close()),
     usesJsonObject: true,
   });
 };
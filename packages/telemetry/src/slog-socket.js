@@ -0,0 +1,76 @@
+import net from 'node:net';
+import { serializeSlogObj } from './serialize-slog-obj.js';
+
+const RECONNECT_INTERVAL_MS = 1000;
+
+/**
+ * Stream slog entries as newline-delimited JSON to a Unix domain socket.
+ * Entries are dropped while no consumer is listening, so a missing or slow
+ * monitoring agent cannot stall the kernel.
+ *
+ * @param {import('./index.js').MakeSlogSenderOptions} opts
+ */
+export const makeSlogSender = async ({ env: { SLOGSOCKET } = {} } = {}) => {
+  if (!SLOGSOCKET) {
+    return undefined;
+  }
+
+  /** @type {net.Socket | undefined} */
+  let socket;
+  let connected = false;
+  let lastAttempt = 0;
+  let shutDown = false;
+
+  const connect = () => {
+    lastAttempt = Date.now();
+    const s = net.createConnection(SLOGSOCKET);
+    socket = s;
+    s.on('connect', () => {
+      connected = true;
+    });
+    s.on('error', () => {});
+    s.on('close', () => {
+      if (socket === s) {
+        connected = false;
+        socket = undefined;
+      }
+    });
+    s.unref();
+  };
+  connect();
+
+  const slogSender = (slogObj, jsonObj = serializeSlogObj(slogObj)) => {
+    if (shutDown) {
+      return;
+    }
+    if (!socket && Date.now() - lastAttempt >= RECONNECT_INTERVAL_MS) {
+      connect();
+    }
+    if (!connected || !socket || socket.writableNeedDrain) {
+      return;
+    }
+    // eslint-disable-next-line prefer-template
+    socket.write(jsonObj + '\n');
+  };
+
+  const forceFlush = async () => {
+    const s = socket;
+    if (!connected || !s || !s.writableNeedDrain) {
+      return;
+    }
+    await new Promise(resolve => {
+      s.once('drain', resolve);
+      s.once('close', resolve);
+    });
+  };
+
+  return Object.assign(slogSender, {
+    forceFlush,
+    shutdown: async () => {
+      await forceFlush();
+      shutDown = true;
+      socket?.end();
+    },
+    usesJsonObject: true,
+  });
+};
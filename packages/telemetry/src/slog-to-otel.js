@@ -21,6 +21,27 @@ const { assign } = Object;
 
 const sink = harden(() => {});
 
+/**
+ * Return a non-recording span standing for the remote parent described by a
+ * W3C trace context, as propagated by agd over the bridge.
+ *
+ * @param {Record<string, string> | undefined} traceContext
+ * @returns {Span | undefined}
+ */
+const spanFromTraceContext = traceContext => {
+  const match = /^00-([0-9a-f]{32})-([0-9a-f]{16})-([0-9a-f]{2})$/.exec(
+    traceContext?.traceparent ?? '',
+  );
+  if (!match) return undefined;
+  const [, traceId, spanId, traceFlags] = match;
+  return otel.trace.wrapSpanContext({
+    traceId,
+    spanId,
+    traceFlags: parseInt(traceFlags, 16),
+    isRemote: true,
+  });
+};
+
 const replacer = (_key, value) => {
   if (typeof value === 'bigint') {
     // Use Protobuf JSON convention: replace bigint with string.
@@ -410,6 +431,7 @@ export const makeSlogToOtelKit = (tracer, overrideAttrs = {}) => {
        * @param {object} [options]
        * @param {string} [options.kind]
        * @param {string} [options.name]
+       * @param {Span} [options.parent] defaults to the top of the stack
        * @param {Record<string, any>} [options.attributes]
        * @param {SpanLink[]} [options.links]
        */
@@ -418,6 +440,7 @@ export const makeSlogToOtelKit = (tracer, overrideAttrs = {}) => {
         {
           kind = undefined,
           name = undefined,
+          parent = undefined,
           attributes = currentAttrs,
           ...opts
         } = {},
@@ -426,7 +449,7 @@ export const makeSlogToOtelKit = (tracer, overrideAttrs = {}) => {
         kind ??= keyArray[0];
         name ??= kind;
         const spanKey = makeSpanKey(keyArray);
-        const span = sp.create(name, sp.top(), attributes, opts);
+        const span = sp.create(name, parent ?? sp.top(), attributes, opts);
         const record = { span, name, kind, key: spanKey };
         keyToSpan.init(spanKey, record);
         spanStack.push(record);
@@ -936,8 +959,11 @@ export const makeSlogToOtelKit = (tracer, overrideAttrs = {}) => {
         }
         currentBlockHeight = slogAttrs.blockHeight;
 
-        // TODO: Move the encompassing `block` root span to cosmos
-        spans.push(['block', slogAttrs.blockHeight]);
+        // The encompassing `block` span descends from agd's, if it traced the
+        // block.
+        spans.push(['block', slogAttrs.blockHeight], {
+          parent: spanFromTraceContext(slogAttrs.traceContext),
+        });
         spans.top()?.addEvent(`begin-block-action`, cleanAttrs(slogAttrs), now);
         break;
       }
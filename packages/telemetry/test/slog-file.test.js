@@ -0,0 +1,70 @@
+import fs from 'node:fs';
+import { gunzipSync } from 'node:zlib';
+import tmp from 'tmp';
+import { test } from './prepare-test-env-ava.js';
+
+import { makeSlogSender } from '../src/slog-file.js';
+
+const MEGABYTE = 1024 * 1024;
+
+const readLines = contents =>
+  contents
+    .split('\n')
+    .filter(line => line)
+    .map(line => JSON.parse(line));
+
+test('slog file rotation keeps compressed backups', async t => {
+  const { name: tmpDir, removeCallback } = tmp.dirSync({
+    unsafeCleanup: true,
+  });
+  t.teardown(removeCallback);
+  const slogFile = `${tmpDir}/chain.slog`;
+  const maxSize = 0.001;
+  const maxBackups = 3;
+
+  const slogSender = await makeSlogSender({
+    env: {
+      SLOGFILE: slogFile,
+      SLOGFILE_MAX_SIZE: `${maxSize}`,
+      SLOGFILE_MAX_BACKUPS: `${maxBackups}`,
+      SLOGFILE_COMPRESS: 'true',
+    },
+  });
+  t.truthy(slogSender);
+
+  // Write well past the maximum size, rotating many more times than the
+  // number of retained backups.
+  const last = 100;
+  for (let i = 0; i < last; i += 1) {
+    slogSender({ type: 'iteration', iteration: i, padding: 'x'.repeat(100) });
+  }
+  await slogSender.forceFlush();
+  await slogSender.shutdown();
+
+  const backups = fs
+    .readdirSync(tmpDir)
+    .filter(name => name !== 'chain.slog')
+    .sort();
+  t.deepEqual(backups, [
+    'chain.slog.1.gz',
+    'chain.slog.2.gz',
+    'chain.slog.3.gz',
+  ]);
+
+  // Each backup is gzipped and no larger than the maximum size, and together
+  // with the live file they hold the latest entries in order.
+  const entries = [];
+  for (let n = maxBackups; n >= 1; n -= 1) {
+    const compressed = fs.readFileSync(`${slogFile}.${n}.gz`);
+    t.deepEqual([...compressed.subarray(0, 2)], [0x1f, 0x8b]);
+    const contents = gunzipSync(compressed);
+    t.true(contents.length <= maxSize * MEGABYTE, `backup ${n} too large`);
+    entries.push(...readLines(contents.toString('utf-8')));
+  }
+  entries.push(...readLines(fs.readFileSync(slogFile, 'utf-8')));
+  t.is(entries.at(-1).iteration, last - 1);
+  t.deepEqual(
+    entries.map(({ iteration }) => iteration),
+    entries.map((_, i) => last - entries.length + i),
+  );
+});
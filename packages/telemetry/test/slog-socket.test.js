@@ -0,0 +1,103 @@
+import net from 'node:net';
+import tmp from 'tmp';
+import { test } from './prepare-test-env-ava.js';
+
+import { makeSlogSender } from '../src/slog-socket.js';
+
+const delay = ms => new Promise(resolve => setTimeout(resolve, ms));
+
+/**
+ * Listen on a Unix domain socket, collecting the parsed lines of each
+ * connection separately.
+ *
+ * @param {string} path
+ */
+const makeSlogServer = async path => {
+  /** @type {{ lines: any[], socket: net.Socket, ended: Promise<void> }[]} */
+  const connections = [];
+  const server = net.createServer(socket => {
+    const lines = [];
+    let buffered = '';
+    socket.setEncoding('utf-8');
+    socket.on('data', chunk => {
+      buffered += chunk;
+      const parts = buffered.split('\n');
+      buffered = parts.pop() || '';
+      lines.push(...parts.map(line => JSON.parse(line)));
+    });
+    const ended = new Promise(resolve => socket.on('close', resolve));
+    connections.push({ lines, socket, ended });
+  });
+  await new Promise(resolve => server.listen(path, resolve));
+  return { server, connections };
+};
+
+test('slog socket streams entries and reconnects after a failure', async t => {
+  const { name: tmpDir, removeCallback } = tmp.dirSync({
+    unsafeCleanup: true,
+  });
+  t.teardown(removeCallback);
+  const socketPath = `${tmpDir}/slog.sock`;
+  const { server, connections } = await makeSlogServer(socketPath);
+  t.teardown(() => server.close());
+
+  const slogSender = await makeSlogSender({
+    env: { SLOGSOCKET: socketPath },
+  });
+  t.truthy(slogSender);
+  if (!slogSender) return;
+
+  // Entries are dropped until the connection is up, so send a probe until
+  // one arrives on the given connection.
+  const probe = async index => {
+    const type = `probe-${index}`;
+    const arrived = () =>
+      connections[index]?.lines.some(line => line.type === type);
+    while (!arrived()) {
+      slogSender({ type });
+      await delay(10);
+    }
+  };
+  const entryTypes = index =>
+    connections[index].lines
+      .map(({ type }) => type)
+      .filter(type => !type.startsWith('probe-'));
+
+  await probe(0);
+  slogSender({ type: 'first', n: 1 });
+  slogSender({ type: 'first', n: 2 });
+  await slogSender.forceFlush();
+  await delay(50);
+  t.deepEqual(entryTypes(0), ['first', 'first']);
+  t.deepEqual(
+    connections[0].lines.filter(({ type }) => type === 'first'),
+    [
+      { type: 'first', n: 1 },
+      { type: 'first', n: 2 },
+    ],
+  );
+
+  // Drop the connection from the consumer's side.  What is sent while it is
+  // down is lost, and the sender reconnects on a later entry.
+  connections[0].socket.destroy();
+  await connections[0].ended;
+  slogSender({ type: 'lost' });
+  await probe(1);
+  t.is(connections.length, 2);
+
+  slogSender({ type: 'second', n: 3 });
+  await slogSender.shutdown();
+  await connections[1].ended;
+  t.deepEqual(entryTypes(1), ['second']);
+  t.deepEqual(connections[1].lines.at(-1), { type: 'second', n: 3 });
+
+  // Nothing more is sent once shut down.
+  slogSender({ type: 'after-shutdown' });
+  await delay(50);
+  t.is(connections.length, 2);
+  t.false(
+    connections.some(({ lines }) =>
+      lines.some(({ type }) => ['lost', 'after-shutdown'].includes(type)),
+    ),
+  );
+});
@@ -0,0 +1,51 @@
+// @ts-check
+import { Fail } from '@endo/errors';
+import { E } from '@endo/far';
+import { M } from '@endo/patterns';
+
+/**
+ * @typedef {object} BlockEntropyRecord
+ * @property {bigint} blockHeight the block whose entropy this is
+ * @property {string} entropy the hex encoding of 32 bytes
+ */
+
+export const BlockEntropyI = M.interface('BlockEntropy', {
+  getBlockEntropy: M.call().returns(M.promise()),
+});
+
+/**
+ * Prepare the random beacon offered to contracts that need tie-break
+ * randomness.  The entropy of each block is derived by the chain from the
+ * block's hash and that of the previous block.  Since the proposer of a block
+ * can influence it, it is not suitable for lotteries or anything else of which
+ * the proposer could profit by choosing the outcome.
+ *
+ * @param {import('@agoric/base-zone').Zone} zone
+ */
+export const prepareBlockEntropy = zone =>
+  zone.exoClass(
+    'BlockEntropy',
+    BlockEntropyI,
+    /**
+     * @param {ERef<import('./types.js').ScopedBridgeManager<'entropy'>>} entropyBridgeManager
+     */
+    entropyBridgeManager => ({ entropyBridgeManager }),
+    {
+      /**
+       * Get the entropy of the block being executed, which fails if the chain
+       * does not offer it.
+       *
+       * @returns {Promise<BlockEntropyRecord>}
+       */
+      async getBlockEntropy() {
+        const { entropyBridgeManager } = this.state;
+        const { blockHeight, entropy } = await E(
+          entropyBridgeManager,
+        ).toBridge({ method: 'getBlockEntropy' });
+        (typeof blockHeight === 'string' && /^[0-9a-f]{64}$/.test(entropy)) ||
+          Fail`invalid block entropy ${entropy} for block ${blockHeight}`;
+        return harden({ blockHeight: BigInt(blockHeight), entropy });
+      },
+    },
+  );
+/** @typedef {ReturnType<ReturnType<typeof prepareBlockEntropy>>} BlockEntropy */
@@ -182,6 +182,12 @@ export const bridgeProvisioner = async ({
                 )
                 .then(_ => {});
             }
+            case 'REVOKE_EGRESS': {
+              const { peer } = obj;
+              return E(provisioning)
+                .revokeProvision(peer)
+                .catch(e => console.error(`Error revoking ${peer}:`, e));
+            }
             default: {
               throw Fail`Unrecognized request ${obj.type}`;
             }
@@ -378,6 +384,56 @@ export const makeChainStorage = async ({
   chainStorageP.resolve(rootNodeP);
 };
 
+/**
+ * Offer the entropy of each block, for contracts that need tie-break
+ * randomness.
+ *
+ * @param {BootstrapSpace} powers
+ */
+export const produceBlockEntropy = async ({
+  consume: { loadCriticalVat, bridgeManager: bridgeManagerP },
+  produce: { blockEntropy: blockEntropyP },
+}) => {
+  const bridgeManager = await bridgeManagerP;
+  if (!bridgeManager) {
+    console.warn('Cannot provide blockEntropy without an actual chain.');
+    blockEntropyP.resolve(undefined);
+    return;
+  }
+
+  const entropyBridgeManager = makeScopedBridge(
+    bridgeManager,
+    BRIDGE_ID.ENTROPY,
+  );
+  const vat = E(loadCriticalVat)('bridge');
+  blockEntropyP.resolve(E(vat).makeBlockEntropy(entropyBridgeManager));
+};
+harden(produceBlockEntropy);
+
+/**
+ * Route the MsgOraclePush price updates of the chain's oracle operators to the
+ * price feeds registered with the oraclePushRegistrar.
+ *
+ * @param {BootstrapSpace} powers
+ */
+export const produceOraclePushRegistrar = async ({
+  consume: { loadCriticalVat, bridgeManager: bridgeManagerP },
+  produce: { oraclePushRegistrar: registrarP },
+}) => {
+  const bridgeManager = await bridgeManagerP;
+  if (!bridgeManager) {
+    console.warn('Cannot route oracle pushes without an actual chain.');
+    registrarP.resolve(undefined);
+    return;
+  }
+
+  const vat = E(loadCriticalVat)('bridge');
+  const { bridgeHandler, registrar } = await E(vat).makeOraclePushKit();
+  await makeScopedBridge(bridgeManager, BRIDGE_ID.ORACLE, bridgeHandler);
+  registrarP.resolve(registrar);
+};
+harden(produceOraclePushRegistrar);
+
 /**
  * @param {BootstrapSpace} powers
  */
@@ -531,6 +587,18 @@ export const SHARED_CHAIN_BOOTSTRAP_MANIFEST = {
       storageBridgeManager: 'bridge',
     },
   },
+  [produceBlockEntropy.name]: {
+    consume: { loadCriticalVat: true, bridgeManager: true },
+    produce: {
+      blockEntropy: 'bridge',
+    },
+  },
+  [produceOraclePushRegistrar.name]: {
+    consume: { loadCriticalVat: true, bridgeManager: true },
+    produce: {
+      oraclePushRegistrar: 'bridge',
+    },
+  },
   [produceHighPrioritySendersManager.name]: {
     consume: { loadCriticalVat: true, storageBridgeManager: true },
     produce: {
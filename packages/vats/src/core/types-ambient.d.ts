@@ -338,6 +338,7 @@ type ChainBootstrapSpaceT = {
   agoricNamesAdmin: import('@agoric/vats').NameAdmin;
   bankManager: import('@agoric/vats/src/vat-bank.js').BankManager;
   bldIssuerKit: RemoteIssuerKit;
+  blockEntropy: import('../block-entropy.js').BlockEntropy | undefined;
   board: import('@agoric/vats').Board;
   bridgeManager: import('../types.js').BridgeManager | undefined;
   chainStorage: StorageNode | null;
@@ -373,6 +374,9 @@ type ChainBootstrapSpaceT = {
   namesByAddressAdmin: import('../types.js').NamesByAddressAdmin;
   networkVat: NetworkVat;
   orchestration?: import('@agoric/orchestration').CosmosInterchainService;
+  oraclePushRegistrar:
+    | import('../oracle-push.js').OraclePushKit['registrar']
+    | undefined;
   pegasusConnections: import('@agoric/vats').NameHubKit;
   pegasusConnectionsAdmin: import('@agoric/vats').NameAdmin;
   powerStore: MapStore;
@@ -29,7 +29,7 @@ const DEFAULT_PACKET_TIMEOUT_NS = 60n * 60n * 1_000_000_000n;
 
 /**
  * @import {Endpoint, Connection, ConnectionHandler, InboundAttempt, Bytes, ProtocolHandler, ProtocolImpl} from '@agoric/network';
- * @import {BridgeHandler, ScopedBridgeManager, ConnectingInfo, IBCChannelID, IBCChannelOrdering, IBCEvent, IBCPacket, IBCPortID, IBCDowncallPacket, IBCDowncallMethod, IBCDowncall, IBCBridgeEvent} from './types.js';
+ * @import {BridgeHandler, ScopedBridgeManager, ConnectingInfo, IBCChannelID, IBCChannelOrdering, IBCConnectionID, IBCEvent, IBCPacket, IBCPortID, IBCDowncallPacket, IBCDowncallMethod, IBCDowncall, IBCBridgeEvent, ICAHostHandler, ICQRequest, ICQResponse} from './types.js';
  * @import {Zone} from '@agoric/base-zone';
  * @import {PromiseVow, Remote, VowKit, VowResolver, VowTools} from '@agoric/vow';
  */
@@ -119,12 +119,19 @@ export const prepareIBCConnectionHandler = zone => {
 
         channelKeyToConnP.init(channelKey, conn);
       },
-      /** @type {Required<ConnectionHandler>['onReceive']} */
+      /**
+       * Send a packet with the options `relativeTimeoutNs`, the IBC timeout
+       * relative to the current block time, and `packetTimeoutNs`, a relative
+       * timeout after which the acknowledgement is rejected even though the
+       * packet remains in flight.
+       *
+       * @type {Required<ConnectionHandler>['onReceive']}
+       */
       async onReceive(
         _conn,
         packetBytes,
         _handler,
-        { relativeTimeoutNs } = {},
+        { relativeTimeoutNs, packetTimeoutNs } = {},
       ) {
         const { portID, channelID, rPortID, rChannelID } = this.state;
         const { protocolUtils } = this.state;
@@ -137,7 +144,11 @@ export const prepareIBCConnectionHandler = zone => {
           destination_channel: rChannelID,
           data: byteSourceToBase64(packetBytes),
         };
-        return protocolUtils.ibcSendPacket(packet, relativeTimeoutNs);
+        return protocolUtils.ibcSendPacket(
+          packet,
+          relativeTimeoutNs,
+          packetTimeoutNs,
+        );
       },
       /** @type {Required<ConnectionHandler>['onClose']} */
       onClose(_conn, _reason) {
@@ -158,12 +169,11 @@ export const prepareIBCConnectionHandler = zone => {
         }
         channelKeyToSeqAck.delete(channelKey);
 
-        // This Connection object is initiating the close event
+        // This Connection object is initiating the close event, so wait for
+        // the channel to be closed.
         if (channelKeyToConnP.has(channelKey)) {
           channelKeyToConnP.delete(channelKey);
-          return protocolUtils.downcall('startChannelCloseInit', {
-            packet,
-          });
+          return protocolUtils.chanCloseInit(packet);
         }
         return Promise.resolve();
       },
@@ -193,6 +203,8 @@ export const prepareIBCProtocol = (zone, powers) => {
    *   {
    *     protocolHandler: ProtocolHandler;
    *     bridgeHandler: BridgeHandler;
+   *     icaHost: ICAHostRegistrar;
+   *     icq: ICQSender;
    *   }
    * >} Map
    *   from IBC device to existing handler
@@ -201,6 +213,117 @@ export const prepareIBCProtocol = (zone, powers) => {
 
   const detached = zone.detached();
 
+  /** @type {MapStore<string, Remote<ICAHostHandler>>} */
+  const targetToICAHostHandler = zone.mapStore('targetToICAHostHandler');
+
+  /** @type {MapStore<string, VowKit<ICQResponse[]>>} */
+  const targetToQueryKit = zone.mapStore('targetToQueryKit');
+
+  /** @type {MapStore<string, VowKit<void>>} */
+  const targetToCloseKit = zone.mapStore('targetToCloseKit');
+
+  /**
+   * Registers the handlers of the packets that the interchain accounts host
+   * executes for the accounts of a counterparty controller port.
+   */
+  const makeICAHostRegistrar = zone.exoClass(
+    'ICAHostRegistrar',
+    undefined,
+    /** @param {IBCDevice} ibcdev */
+    ibcdev => ({ ibcdev }),
+    {
+      /**
+       * Send to handler the packets that the interchain accounts host executes
+       * for the accounts of the counterparty controller port controllerPortID
+       * over connectionID, until it is unregistered with the same handler.
+       *
+       * @param {IBCConnectionID} connectionID
+       * @param {IBCPortID} controllerPortID
+       * @param {Remote<ICAHostHandler>} handler
+       */
+      async register(connectionID, controllerPortID, handler) {
+        const target = `${connectionID}/${controllerPortID}`;
+        !targetToICAHostHandler.has(target) ||
+          Fail`${target} is already registered`;
+        targetToICAHostHandler.init(target, handler);
+        await null;
+        try {
+          await E(this.state.ibcdev).downcall('registerICAController', {
+            connectionID,
+            controllerPortID,
+            target,
+          });
+        } catch (e) {
+          targetToICAHostHandler.delete(target);
+          throw e;
+        }
+      },
+      /**
+       * @param {IBCConnectionID} connectionID
+       * @param {IBCPortID} controllerPortID
+       * @param {Remote<ICAHostHandler>} handler the handler it was registered
+       *   with
+       */
+      async unregister(connectionID, controllerPortID, handler) {
+        const target = `${connectionID}/${controllerPortID}`;
+        (targetToICAHostHandler.has(target) &&
+          targetToICAHostHandler.get(target) === handler) ||
+          Fail`${target} is not registered with this handler`;
+        targetToICAHostHandler.delete(target);
+        await E(this.state.ibcdev).downcall('unregisterICAController', {
+          connectionID,
+          controllerPortID,
+          target,
+        });
+      },
+    },
+  );
+  /** @typedef {ReturnType<typeof makeICAHostRegistrar>} ICAHostRegistrar */
+
+  /** Sends interchain queries on channels to ICQ hosts. */
+  const makeICQSender = zone.exoClass(
+    'ICQSender',
+    undefined,
+    /** @param {IBCDevice} ibcdev */
+    ibcdev => ({ ibcdev, lastQueryID: 0n }),
+    {
+      /**
+       * Send an interchain query of requests on a channel opened on a vibc
+       * port to an ICQ host.
+       *
+       * @param {Pick<IBCPacket, 'source_port' | 'source_channel'>} packet
+       * @param {ICQRequest[]} requests
+       * @param {bigint} [relativeTimeoutNs]
+       * @returns {PromiseVow<ICQResponse[]>} the host chain's responses
+       */
+      async sendQuery(
+        packet,
+        requests,
+        relativeTimeoutNs = DEFAULT_PACKET_TIMEOUT_NS,
+      ) {
+        this.state.lastQueryID += 1n;
+        const target = `query-${this.state.lastQueryID}`;
+        /** @type {VowKit<ICQResponse[]>} */
+        const kit = makeVowKit();
+        targetToQueryKit.init(target, kit);
+        await null;
+        try {
+          await E(this.state.ibcdev).downcall('sendInterchainQuery', {
+            packet,
+            relativeTimeoutNs,
+            requests,
+            target,
+          });
+        } catch (e) {
+          targetToQueryKit.delete(target);
+          throw e;
+        }
+        return kit.vow;
+      },
+    },
+  );
+  /** @typedef {ReturnType<typeof makeICQSender>} ICQSender */
+
   /**
    * Create a handler for the IBC protocol, both from the network and from the
    * bridge.
@@ -592,16 +715,47 @@ export const prepareIBCProtocol = (zone, powers) => {
               break;
             }
 
+            // The relative timeout of the packet elapsed before it was
+            // acknowledged, so the sender stops waiting for its ack.
+            case 'packetTimeout': {
+              const { packet, deadlineNs } =
+                /** @type {IBCEvent<'packetTimeout'>} */ (obj);
+              const {
+                sequence,
+                source_channel: channelID,
+                source_port: portID,
+              } = packet;
+              if (sequence === undefined)
+                throw TypeError('packetTimeout without sequence');
+
+              const ackKit = util.findAckKit(channelID, portID, sequence);
+              ackKit.resolver.reject(
+                Error(`Packet ${sequence} timed out at ${deadlineNs}`),
+              );
+              break;
+            }
+
             // We ignore the close init tx message, since any decision to
             // close should be left to the VM...
             case 'channelCloseInit': {
               break;
             }
 
-            // ... or received from the other side.
+            // ... or received from the other side, unless it confirms our
+            // own chanCloseInit.
             case 'channelCloseConfirm': {
-              const { portID, channelID } =
+              const { portID, channelID, target } =
                 /** @type {IBCEvent<'channelCloseConfirm'>} */ (obj);
+              if (target !== undefined) {
+                if (!targetToCloseKit.has(target)) {
+                  console.warn('Unexpected channelCloseConfirm for', target);
+                  break;
+                }
+                const { resolver } = targetToCloseKit.get(target);
+                targetToCloseKit.delete(target);
+                resolver.resolve();
+                break;
+              }
               const channelKey = `${channelID}:${portID}`;
               if (channelKeyToConnP.has(channelKey)) {
                 const conn = channelKeyToConnP.get(channelKey);
@@ -611,6 +765,44 @@ export const prepareIBCProtocol = (zone, powers) => {
               break;
             }
 
+            case 'icaHostPacket': {
+              const { target, packet, acknowledgement, success, relayer } =
+                /** @type {IBCEvent<'icaHostPacket'>} */ (obj);
+              if (!targetToICAHostHandler.has(target)) {
+                // Its handler unregistered after the packet was executed.
+                console.warn('Unexpected icaHostPacket for', target, packet);
+                break;
+              }
+              const handler = targetToICAHostHandler.get(target);
+              E(handler)
+                .onHostPacket(
+                  harden({ packet, acknowledgement, success, relayer }),
+                )
+                .catch(e =>
+                  console.warn('icaHostPacket for', target, 'failed:', e),
+                );
+              break;
+            }
+
+            case 'interchainQueryResult': {
+              const { target, packet, responses, error } =
+                /** @type {IBCEvent<'interchainQueryResult'>} */ (obj);
+              if (!targetToQueryKit.has(target)) {
+                console.warn('Unexpected interchainQueryResult for', target);
+                break;
+              }
+              const { resolver } = targetToQueryKit.get(target);
+              targetToQueryKit.delete(target);
+              if (error) {
+                resolver.reject(
+                  Error(`Interchain query ${packet.sequence} failed: ${error}`),
+                );
+              } else {
+                resolver.resolve(harden(responses || []));
+              }
+              break;
+            }
+
             case 'sendPacket': {
               const { packet, relativeTimeoutNs } =
                 /** @type {IBCEvent<'sendPacket'>} */ (obj);
@@ -641,17 +833,21 @@ export const prepareIBCProtocol = (zone, powers) => {
          *
          * @param {IBCPacket} packet
          * @param {bigint} [relativeTimeoutNs]
+         * @param {bigint} [packetTimeoutNs] the relative timeout to start on
+         *   the packet, after which the returned vow is rejected
          * @returns {PromiseVow<Bytes>} Acknowledgement data
          */
         async ibcSendPacket(
           packet,
           relativeTimeoutNs = DEFAULT_PACKET_TIMEOUT_NS,
+          packetTimeoutNs,
         ) {
           const { util } = this.facets;
           // Make a kernel call to do the send.
           const fullPacket = await util.downcall('sendPacket', {
             packet,
             relativeTimeoutNs,
+            ...(packetTimeoutNs ? { packetTimeoutNs } : {}),
           });
 
           // Extract the actual sequence number from the return.
@@ -666,6 +862,28 @@ export const prepareIBCProtocol = (zone, powers) => {
           return vow;
         },
 
+        /**
+         * Close a channel opened on a vibc port.
+         *
+         * @param {Pick<IBCPacket, 'source_port' | 'source_channel'>} packet
+         * @returns {PromiseVow<void>} fulfilled once the channel is closed
+         */
+        async chanCloseInit(packet) {
+          const { util } = this.facets;
+          const target = `close/${packet.source_port}/${packet.source_channel}`;
+          /** @type {VowKit<void>} */
+          const kit = makeVowKit();
+          targetToCloseKit.init(target, kit);
+          await null;
+          try {
+            await util.downcall('chanCloseInit', { packet, target });
+          } catch (e) {
+            targetToCloseKit.delete(target);
+            throw e;
+          }
+          return kit.vow;
+        },
+
         /**
          * @param {IBCChannelID} channelID
          * @param {IBCPortID} portID
@@ -807,13 +1025,26 @@ export const prepareIBCProtocol = (zone, powers) => {
   /** @param {IBCDevice} ibcdev */
   const makeIBCProtocolHandlerKit = ibcdev => {
     const { protocolHandler, bridgeHandler } = makeIBCProtocolKit(ibcdev);
-    return harden({ protocolHandler, bridgeHandler });
+    const icaHost = makeICAHostRegistrar(ibcdev);
+    const icq = makeICQSender(ibcdev);
+    return harden({ protocolHandler, bridgeHandler, icaHost, icq });
   };
 
   /** @param {IBCDevice} ibcdev */
   const provideIBCProtocolHandlerKit = ibcdev => {
     if (ibcdevToKit.has(ibcdev)) {
-      return ibcdevToKit.get(ibcdev);
+      const kit = ibcdevToKit.get(ibcdev);
+      if (kit.icaHost && kit.icq) {
+        return kit;
+      }
+      // The kit was made by an earlier version of this vat.
+      const upgradedKit = harden({
+        ...kit,
+        icaHost: makeICAHostRegistrar(ibcdev),
+        icq: makeICQSender(ibcdev),
+      });
+      ibcdevToKit.set(ibcdev, upgradedKit);
+      return upgradedKit;
     }
     const kit = makeIBCProtocolHandlerKit(ibcdev);
     ibcdevToKit.init(ibcdev, kit);
@@ -0,0 +1,85 @@
+// @ts-check
+import { Fail } from '@endo/errors';
+import { E } from '@endo/far';
+import { M } from '@endo/patterns';
+import { BridgeHandlerI } from './bridge.js';
+
+/**
+ * @typedef {object} OraclePushTarget
+ * @property {(
+ *   oracleId: string,
+ *   result: { unitPrice: bigint; roundId?: bigint },
+ * ) => Promise<void>} pushPriceForOracle
+ */
+
+/**
+ * @typedef {object} OraclePushAction
+ * @property {'ORACLE_PUSH'} type
+ * @property {string} owner the oracle operator, authenticated by the chain
+ * @property {string} feed
+ * @property {string} unitPrice
+ * @property {number} roundID 0 for the feed's next round
+ */
+
+export const OraclePushRegistrarI = M.interface('OraclePushRegistrar', {
+  registerFeed: M.call(M.string(), M.remotable('OraclePushTarget')).returns(),
+  unregisterFeed: M.call(M.string()).returns(),
+});
+
+/**
+ * Prepare the handler of the MsgOraclePush price updates that the chain
+ * delivers over the "oracle" bridge, and the registrar of the price feeds to
+ * which it routes them by feed name.  The chain admits only the pushes of its
+ * registered oracle operators, and the feed accepts only those of its own
+ * oracles.
+ *
+ * @param {import('@agoric/base-zone').Zone} zone
+ */
+export const prepareOraclePushKit = zone =>
+  zone.exoClassKit(
+    'OraclePushKit',
+    { bridgeHandler: BridgeHandlerI, registrar: OraclePushRegistrarI },
+    () => ({
+      /** @type {MapStore<string, OraclePushTarget>} */
+      feeds: zone.detached().mapStore('feeds'),
+    }),
+    {
+      bridgeHandler: {
+        /** @param {OraclePushAction} obj */
+        async fromBridge(obj) {
+          const { feeds } = this.state;
+          const { type, owner, feed, unitPrice, roundID } = obj;
+          type === 'ORACLE_PUSH' ||
+            Fail`Invalid inbound event type ${type}; expected ORACLE_PUSH`;
+          feeds.has(feed) || Fail`No price feed ${feed} for oracle ${owner}`;
+          const result = roundID
+            ? { unitPrice: BigInt(unitPrice), roundId: BigInt(roundID) }
+            : { unitPrice: BigInt(unitPrice) };
+          await E(feeds.get(feed)).pushPriceForOracle(owner, harden(result));
+        },
+      },
+      registrar: {
+        /**
+         * Route the pushes for feed to target, replacing any earlier target,
+         * such as that of a feed being replaced.
+         *
+         * @param {string} feed
+         * @param {OraclePushTarget} target
+         */
+        registerFeed(feed, target) {
+          const { feeds } = this.state;
+          if (feeds.has(feed)) {
+            feeds.set(feed, target);
+          } else {
+            feeds.init(feed, target);
+          }
+        },
+        /** @param {string} feed */
+        unregisterFeed(feed) {
+          const { feeds } = this.state;
+          feeds.delete(feed);
+        },
+      },
+    },
+  );
+/** @typedef {ReturnType<ReturnType<typeof prepareOraclePushKit>>} OraclePushKit */
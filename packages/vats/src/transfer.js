@@ -17,6 +17,39 @@ import { TargetAppI, AppTransformerI } from './bridge-target.js';
  */
 const ReactionGuard = M.call(M.any()).optional(M.any()).returns(M.any());
 
+/**
+ * The codes of a typed error acknowledgement, per `AckErrorCode` in
+ * golang/cosmos/x/vtransfer/types/ack_error.go. Those of `unavailable` and
+ * `rate_limited` are transient, so the transfer may succeed if retried.
+ *
+ * @typedef {'unknown'
+ *   | 'rejected'
+ *   | 'invalid_packet'
+ *   | 'unavailable'
+ *   | 'rate_limited'} AckErrorCode
+ */
+
+/**
+ * Make the raw acknowledgement with which an active tap can refuse a transfer,
+ * which `x/vtransfer` replaces by an ICS-20 error acknowledgement of the
+ * code, reason, and retry delay so that relayers can tell transient from
+ * permanent failures. The transfer interceptor also refuses with one of code
+ * `rejected` the transfers whose tap fails.
+ *
+ * @param {AckErrorCode} code
+ * @param {string} reason
+ * @param {number} [retryAfter] seconds after which a transient failure may be
+ *   retried
+ * @returns {string}
+ */
+export const makeTypedErrorAck = (code, reason, retryAfter) =>
+  JSON.stringify({
+    error: reason,
+    code,
+    ...(retryAfter ? { retryAfter } : {}),
+  });
+harden(makeTypedErrorAck);
+
 /**
  * @param {import('@agoric/base-zone').Zone} zone
  * @param {import('@agoric/vow').VowTools} vowTools
@@ -58,7 +91,8 @@ const prepareTransferInterceptor = (zone, vowTools) => {
             Fail`Invalid upcall argument type ${obj.type}; expected ${b(VTRANSFER_IBC_EVENT)}`;
 
           // First, call our target contract listener.
-          // A VTransfer active interceptor can return a write acknowledgement
+          // A VTransfer active interceptor can return a write acknowledgement,
+          // such as a typed error acknowledgement from `makeTypedErrorAck`.
           /** @type {import('@agoric/vow').Vow<unknown>} */
           let retP = watch(E(tap).receiveUpcall(obj));
 
@@ -112,7 +146,7 @@ const prepareTransferInterceptor = (zone, vowTools) => {
       nackSender: {
         onRejected(error, { response }) {
           console.error(`Error sending ack:`, error);
-          const rawAck = JSON.stringify({ error: error.message });
+          const rawAck = makeTypedErrorAck('rejected', error.message);
           const nack = { ...response, ack: byteSourceToBase64(rawAck) };
           return E(this.state.targetHost).sendDowncall(nack);
         },
@@ -162,6 +162,17 @@ export type ConnectingInfo = {
   counterpartyVersion: string;
   version: string;
   asyncVersions?: boolean;
+  /**
+   * for channelOpenAck and channelOpenConfirm, whether the channel was opened
+   * with ICS-29 fees, so that `sendPacket` may incentivize relayers
+   */
+  feeEnabled?: boolean;
+  /**
+   * if `feeEnabled`, the account of the port from which relayer incentives are
+   * paid, to be funded by sending it coins, such as by depositing into its
+   * purse from `getBankForAddress`
+   */
+  feeEscrowAddress?: string;
 };
 
 /** see [ibc_module.go](../../../golang/cosmos/x/vibc/types/ibc_module.go) */
@@ -173,8 +184,11 @@ export type IBCBridgeEvent =
   | 'receivePacket'
   | 'acknowledgementPacket'
   | 'timeoutPacket'
+  | 'packetTimeout'
   | 'channelCloseInit'
   | 'channelCloseConfirm'
+  | 'icaHostPacket'
+  | 'interchainQueryResult'
   | 'sendPacket';
 
 type IBCPacketEvents = {
@@ -193,11 +207,74 @@ type IBCPacketEvents = {
   timeoutPacket: {
     packet: IBCPacket;
   };
+  /**
+   * the relative timeout started by the `packetTimeoutNs` of `sendPacket`
+   * elapsed before the packet was acknowledged or timed out, which it remains
+   * free to do
+   */
+  packetTimeout: {
+    packet: IBCPacket;
+    deadlineNs: string;
+  };
   channelCloseInit: { channelID: IBCChannelID; portID: IBCPortID };
-  channelCloseConfirm: { channelID: IBCChannelID; portID: IBCPortID };
+  channelCloseConfirm: {
+    channelID: IBCChannelID;
+    portID: IBCPortID;
+    /** the target of the `chanCloseInit` that closed the channel, if any */
+    target?: string;
+  };
+  /**
+   * the interchain accounts host executed a packet for an account whose
+   * controller port is registered with `registerICAController`
+   */
+  icaHostPacket: {
+    target: string;
+  } & ICAHostPacket;
+  /**
+   * the result of a query sent with `sendInterchainQuery`, or the error
+   * (including "timeout") that prevented it
+   */
+  interchainQueryResult: {
+    target: string;
+    packet: IBCPacket;
+    responses: ICQResponse[] | null;
+    error?: string;
+    relayer: string; // chain address
+  };
   sendPacket: { relativeTimeoutNs: bigint; packet: IBCPacket };
 };
 
+/** an ABCI query to run on the host chain of an interchain query */
+export type ICQRequest = {
+  path: string;
+  /** base64 of the protobuf request */
+  data: string;
+  height?: number;
+};
+
+/** the ABCI response to an ICQRequest */
+export type ICQResponse = {
+  code?: number;
+  log?: string;
+  key?: string;
+  /** base64 of the protobuf response */
+  value?: string;
+  height?: number;
+};
+
+/** a packet executed by the interchain accounts host, with its result */
+export type ICAHostPacket = {
+  packet: IBCPacket;
+  acknowledgement: Bytes;
+  success: boolean;
+  relayer: string; // chain address
+};
+
+/** the recipient of the packets registered with `registerICAController` */
+export type ICAHostHandler = {
+  onHostPacket: (hostPacket: ICAHostPacket) => Promise<void>;
+};
+
 export type IBCEvent<E extends IBCBridgeEvent> = {
   type: 'IBC_EVENT';
   blockHeight: number;
@@ -210,29 +287,88 @@ export type IBCEvent<E extends IBCBridgeEvent> = {
 /** see [receiver.go](../../../golang/cosmos/x/vibc/types/receiver.go) */
 export type IBCDowncallMethod =
   | 'sendPacket'
+  | 'sendInterchainQuery'
   | 'tryOpenExecuted'
   | 'receiveExecuted'
+  | 'writeAcknowledgement'
   | 'startChannelOpenInit'
   | 'startChannelCloseInit'
+  | 'chanCloseInit'
   | 'bindPort'
+  | 'startRelativeTimeout'
   | 'timeoutExecuted'
+  | 'queryConnection'
+  | 'queryClientState'
+  | 'registerICAController'
+  | 'unregisterICAController'
   | 'initOpenExecuted';
 
 type IBCMethodEvents = {
   sendPacket: SendPacketDownCall;
+  /**
+   * send the requests as an interchain query on a channel to an ICQ host, whose
+   * result is sent to the target as `interchainQueryResult`
+   */
+  sendInterchainQuery: {
+    packet: Pick<IBCPacket, 'source_port' | 'source_channel'>;
+    relativeTimeoutNs: bigint;
+    requests: ICQRequest[];
+    target: string;
+  };
   tryOpenExecuted: ChannelOpenAckDowncall;
   receiveExecuted: {
     packet: IBCPacket;
     ack: Bytes;
   };
+  /** acknowledge a received packet recorded by the vibc keeper */
+  writeAcknowledgement: {
+    packet: Pick<
+      IBCPacket,
+      'destination_port' | 'destination_channel' | 'sequence'
+    >;
+    ack: Bytes;
+  };
   startChannelOpenInit: ChannelOpenInitDowncall;
+  /** close a channel, as when the holder of its `Connection` closes it */
   startChannelCloseInit: {
     packet: Pick<IBCPacket, 'source_port' | 'source_channel'>;
   };
-  bindPort: { packet: { source_port: IBCPortID } };
+  /**
+   * close a channel opened on a vibc port, then send the target
+   * `channelCloseConfirm`
+   */
+  chanCloseInit: {
+    packet: Pick<IBCPacket, 'source_port' | 'source_channel'>;
+    target: string;
+  };
+  bindPort: {
+    packet: { source_port: IBCPortID };
+    /** acceptable channel orders; any if omitted */
+    orders?: IBCChannelOrdering[];
+    /** acceptable channel versions, most preferred first; any if omitted */
+    versions?: string[];
+  };
+  /**
+   * restart the timeout started by the `packetTimeoutNs` of `sendPacket`, if
+   * it is still running, ending no later than the IBC timeout of the packet
+   */
+  startRelativeTimeout: {
+    packet: Pick<IBCPacket, 'source_port' | 'source_channel' | 'sequence'>;
+    relativeTimeoutNs: bigint;
+  };
   timeoutExecuted: {
     packet: IBCPacket;
   };
+  /** replies with the proto JSON of the connection end */
+  queryConnection: { connectionID: IBCConnectionID };
+  /** replies with the client's chainID, status, latestHeight and clientState */
+  queryClientState: { clientID: string };
+  /**
+   * send the `icaHostPacket` events of the accounts of a counterparty
+   * controller port to the target, which alone may unregister it
+   */
+  registerICAController: ICAControllerDowncall;
+  unregisterICAController: ICAControllerDowncall;
   // XXX why isn't this in receiver.go?
   initOpenExecuted: ChannelOpenAckDowncall;
 };
@@ -257,10 +393,20 @@ type ChannelOpenDowncallBase = {
   version: string;
 };
 
+/**
+ * To open a channel with ICS-29 fees, `version` is the JSON fee metadata
+ * `{"fee_version":"ics29-1","app_version":...}`.
+ */
 type ChannelOpenInitDowncall = ChannelOpenDowncallBase & {
   packet: Pick<IBCPacket, 'destination_port' | 'source_port'>;
 };
 
+type ICAControllerDowncall = {
+  connectionID: IBCConnectionID;
+  controllerPortID: IBCPortID;
+  target: string;
+};
+
 type ChannelOpenAckDowncall = ChannelOpenDowncallBase & {
   packet: Pick<
     IBCPacket,
@@ -271,9 +417,31 @@ type ChannelOpenAckDowncall = ChannelOpenDowncallBase & {
   >;
 };
 
+/** an ICS-29 relayer incentive, in the smallest units of each denom */
+export type IBCPacketFee = {
+  fee: {
+    recv_fee: { denom: string; amount: string }[];
+    ack_fee: { denom: string; amount: string }[];
+    timeout_fee: { denom: string; amount: string }[];
+  };
+  /**
+   * the address that pays the fee and is refunded whatever is not paid out,
+   * which can only be the `feeEscrowAddress` of the source port, and may be
+   * omitted
+   */
+  refund_address?: string;
+};
+
 type SendPacketDownCall = {
   packet: IBCPacket;
   relativeTimeoutNs: bigint;
+  /**
+   * a timeout to start on the packet once sent, after which a `packetTimeout`
+   * event is sent unless it has been acknowledged or timed out
+   */
+  packetTimeoutNs?: bigint;
+  /** the relayer incentive to escrow, on a fee-enabled channel */
+  fee?: IBCPacketFee;
 };
 
 /**
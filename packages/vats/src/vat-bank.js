@@ -182,6 +182,45 @@ const prepareRewardPurseController = zone =>
     },
   );
 
+/** @param {import('@agoric/zone').Zone} zone */
+const prepareModulePurseController = zone =>
+  zone.exoClass(
+    'ModulePurseController',
+    VirtualPurseControllerI,
+    /**
+     * @param {BridgeChannel} bankChannel
+     * @param {string} moduleName
+     * @param {string} denom
+     * @param {Brand} brand
+     */
+    (bankChannel, moduleName, denom, brand) => ({
+      bankChannel,
+      moduleName,
+      denom,
+      brand,
+    }),
+    {
+      getBalances(b) {
+        // Never resolve!
+        assert.equal(b, this.state.brand);
+        return makeNotifierKit().notifier;
+      },
+      async pullAmount(_amount) {
+        throw Error(`Cannot pull from module account ${this.state.moduleName}`);
+      },
+      async pushAmount(amount) {
+        const { brand, bankChannel, moduleName, denom } = this.state;
+        const value = AmountMath.getValue(brand, amount);
+        await bankChannel.toBridge({
+          type: 'VBANK_SEND_TO_MODULE',
+          moduleName,
+          denom,
+          amount: `${value}`,
+        });
+      },
+    },
+  );
+
 /** @param {import('@agoric/zone').Zone} zone */
 const prepareBankChannelHandler = zone =>
   zone.exoClass(
@@ -559,6 +598,11 @@ const BankManagerI = M.interface('BankManager', {
     M.string(),
     AssetIssuerKitShape,
   ).returns(M.remotable('DepositFacet')),
+  getModuleAccountDepositFacet: M.callWhen(
+    M.string(),
+    M.string(),
+    AssetIssuerKitShape,
+  ).returns(M.remotable('DepositFacet')),
 });
 
 /**
@@ -568,6 +612,7 @@ const BankManagerI = M.interface('BankManager', {
  * @param {ReturnType<prepareBank>} makers.makeBank
  * @param {ReturnType<prepareDurablePublishKit>} makers.makePublishKit
  * @param {ReturnType<prepareRewardPurseController>} makers.makeRewardPurseController
+ * @param {ReturnType<prepareModulePurseController>} makers.makeModulePurseController
  * @param {ReturnType<prepareVirtualPurse>} makers.makeVirtualPurse
  */
 const prepareBankManager = (
@@ -577,6 +622,7 @@ const prepareBankManager = (
     makeBank,
     makePublishKit,
     makeRewardPurseController,
+    makeModulePurseController,
     makeVirtualPurse,
   },
 ) => {
@@ -665,6 +711,37 @@ const prepareBankManager = (
         return E(vp).getDepositFacet();
       },
 
+      /**
+       * Get a deposit facet which sends its deposits directly to the named
+       * module account, which the chain must allow in the vbank
+       * `allowed_send_to_modules` parameter.
+       *
+       * @param {string} moduleName
+       * @param {string} denom
+       * @param {AssetIssuerKit} kit
+       * @returns {ERef<
+       *   import('@endo/far').EOnly<
+       *     import('@agoric/ertp/src/types.js').DepositFacet
+       *   >
+       * >}
+       */
+      getModuleAccountDepositFacet(moduleName, denom, kit) {
+        const { bankChannel } = this.state;
+        if (!bankChannel) {
+          throw Error(`Bank doesn't implement module accounts`);
+        }
+
+        const moduleVpc = makeModulePurseController(
+          bankChannel,
+          moduleName,
+          denom,
+          kit.brand,
+        );
+
+        const vp = makeVirtualPurse(moduleVpc, kit);
+        return E(vp).getDepositFacet();
+      },
+
       /**
        * Get the address of named module account.
        *
@@ -828,6 +905,7 @@ const prepareFromBaggage = baggage => {
     makeVirtualPurse,
   });
   const makeRewardPurseController = prepareRewardPurseController(rootZone);
+  const makeModulePurseController = prepareModulePurseController(rootZone);
   const makeBankChannelHandler = prepareBankChannelHandler(rootZone);
 
   /** @type {import('@agoric/internal/src/callback.js').MakeAttenuator<BridgeChannel>} */
@@ -844,6 +922,7 @@ const prepareFromBaggage = baggage => {
     makeBank,
     makePublishKit,
     makeRewardPurseController,
+    makeModulePurseController,
     makeVirtualPurse,
   });
 
@@ -855,6 +934,7 @@ const prepareFromBaggage = baggage => {
     makeBankManager,
     makeBridgeChannelAttenuator,
     makeRewardPurseController,
+    makeModulePurseController,
     makePublishKit,
     makeVirtualPurse,
   };
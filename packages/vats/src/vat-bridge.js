@@ -4,6 +4,8 @@ import { makeDurableZone } from '@agoric/zone/durable.js';
 import * as cb from '@agoric/internal/src/callback.js';
 import { prepareChainStorageNode } from '@agoric/internal/src/lib-chainStorage.js';
 import { prepareBridgeManager } from './bridge.js';
+import { prepareBlockEntropy } from './block-entropy.js';
+import { prepareOraclePushKit } from './oracle-push.js';
 
 export function buildRootObject(vatPowers, _args, baggage) {
   const { D } = vatPowers;
@@ -18,6 +20,8 @@ export function buildRootObject(vatPowers, _args, baggage) {
   const makeChainStorageNode = prepareChainStorageNode(
     zone.subZone('ChainStorageNode'),
   );
+  const makeBlockEntropy = prepareBlockEntropy(zone.subZone('BlockEntropy'));
+  const makeOraclePushKit = prepareOraclePushKit(zone.subZone('OraclePush'));
 
   /**
    * @param {ERef<import('./types.js').ScopedBridgeManager<'storage'>>} storageBridgeManagerP
@@ -44,7 +48,9 @@ export function buildRootObject(vatPowers, _args, baggage) {
   // We colocate these functions in this vat so that we don't pay extra cranks
   // to shuffle messages between a chainStorage node and the bridgeManager.
   return Far('root', {
+    makeBlockEntropy,
     makeBridgedChainStorageRoot,
+    makeOraclePushKit,
     provideManagerForBridge,
   });
 }
@@ -164,9 +164,20 @@ export function buildRootObject(_vatPowers, _vatParameters, baggage) {
     return { ingressIndex: INDEX };
   }
 
+  /**
+   * Stop exchanging mailbox messages with an address whose egress has been
+   * revoked.
+   *
+   * @param {string} address
+   */
+  async function revokeProvision(address) {
+    await E(vattp).removeRemote(address);
+  }
+
   return Far('root', {
     register,
     pleaseProvision,
+    revokeProvision,
     getNamesByAddressKit: () =>
       harden({ namesByAddress: nameHubKit.nameHub, namesByAddressAdmin }),
   });
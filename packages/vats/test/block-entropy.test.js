@@ -0,0 +1,51 @@
+import { test } from '@agoric/swingset-vat/tools/prepare-test-env-ava.js';
+
+import { makeHeapZone } from '@agoric/zone';
+import { E, Far } from '@endo/far';
+import { prepareBlockEntropy } from '../src/block-entropy.js';
+
+/**
+ * @param {(obj: any) => unknown} reply
+ * @returns {any} a fake entropy bridge manager
+ */
+const makeFakeEntropyBridge = reply =>
+  Far('entropyBridgeManager', {
+    toBridge: async obj => reply(obj),
+  });
+
+test('getBlockEntropy', async t => {
+  const makeBlockEntropy = prepareBlockEntropy(makeHeapZone());
+  const entropy = 'ab'.repeat(32);
+  /** @type {any[]} */
+  const sent = [];
+  const blockEntropy = makeBlockEntropy(
+    makeFakeEntropyBridge(obj => {
+      sent.push(obj);
+      return { blockHeight: '12', entropy };
+    }),
+  );
+  t.deepEqual(await E(blockEntropy).getBlockEntropy(), {
+    blockHeight: 12n,
+    entropy,
+  });
+  t.deepEqual(sent, [{ method: 'getBlockEntropy' }]);
+});
+
+test('getBlockEntropy rejects what the chain does not offer', async t => {
+  const makeBlockEntropy = prepareBlockEntropy(makeHeapZone());
+  const disabled = makeBlockEntropy(
+    makeFakeEntropyBridge(() => {
+      throw Error('block entropy is not available');
+    }),
+  );
+  await t.throwsAsync(E(disabled).getBlockEntropy(), {
+    message: 'block entropy is not available',
+  });
+
+  const malformed = makeBlockEntropy(
+    makeFakeEntropyBridge(() => ({ blockHeight: '12', entropy: 'xyz' })),
+  );
+  await t.throwsAsync(E(malformed).getBlockEntropy(), {
+    message: /invalid block entropy/,
+  });
+});
@@ -9,6 +9,7 @@ import {
 import { prepareVowTools } from '@agoric/vow/vat.js';
 import { makeDurableZone } from '@agoric/zone/durable.js';
 import { E } from '@endo/far';
+import { eventLoopIteration } from '@agoric/internal/src/testing-utils.js';
 
 import { prepareNetworkPowers } from '@agoric/network';
 import { buildRootObject as ibcBuildRootObject } from '../src/vat-ibc.js';
@@ -223,15 +224,17 @@ test('network - ibc', async t => {
     const evclose = await events.next();
     t.assert(!evclose.done);
     t.deepEqual(evclose.value, [
-      'startChannelCloseInit',
+      'chanCloseInit',
       {
         packet: {
           source_channel: 'channel-1',
           source_port: 'port-1',
         },
+        target: 'close/port-1/channel-1',
       },
     ]);
 
+    // The fake bridge confirms the close for the target, completing it.
     await when(closeV);
   };
 
@@ -302,3 +305,244 @@ test('network - ibc', async t => {
   t.assert(evend.done);
   t.deepEqual(evend.value, []);
 });
+
+test('network - ibc ICA host packets', async t => {
+  const ibcVat = E(ibcBuildRootObject)(null, null, provideBaggage('ibc-ica'));
+  const zone = makeDurableZone(provideBaggage('network - ibc ICA host'));
+
+  /** @type {[string, any][]} */
+  const downcalls = [];
+  const ibcBridge = makeFakeIbcBridge(zone, obj => {
+    const { method, type: _, ...params } = obj;
+    downcalls.push([method, params]);
+  });
+  const callbacks = await E(ibcVat).makeCallbacks(ibcBridge);
+  const { bridgeHandler, icaHost } = await E(ibcVat).createHandlers(callbacks);
+  await E(ibcBridge).initHandler(bridgeHandler);
+
+  const received = [];
+  const makeHandler = name =>
+    zone.exo(`ICA host handler ${name}`, undefined, {
+      async onHostPacket(hostPacket) {
+        received.push([name, hostPacket]);
+      },
+    });
+  const handler = makeHandler('first');
+  const otherHandler = makeHandler('other');
+
+  const controllerPortID = 'icacontroller-agoric1owner';
+  await E(icaHost).register('connection-0', controllerPortID, handler);
+  const target = `connection-0/${controllerPortID}`;
+  t.deepEqual(downcalls, [
+    [
+      'registerICAController',
+      { connectionID: 'connection-0', controllerPortID, target },
+    ],
+  ]);
+
+  // Another handler can neither take over nor remove the registration.
+  await t.throwsAsync(
+    E(icaHost).register('connection-0', controllerPortID, otherHandler),
+    { message: /already registered/ },
+  );
+  await t.throwsAsync(
+    E(icaHost).unregister('connection-0', controllerPortID, otherHandler),
+    { message: /not registered with this handler/ },
+  );
+  t.is(downcalls.length, 1);
+
+  const hostPacket = {
+    packet: {
+      data: 'eyJ0eXBlIjoxfQ==',
+      source_port: controllerPortID,
+      source_channel: 'channel-7',
+      destination_port: 'icahost',
+      destination_channel: 'channel-1',
+      sequence: '3',
+    },
+    acknowledgement: 'eyJyZXN1bHQiOiIifQ==',
+    success: true,
+    relayer: 'agoric1relayer',
+  };
+  await E(ibcBridge).fromBridge({
+    type: 'IBC_EVENT',
+    event: 'icaHostPacket',
+    target,
+    ...hostPacket,
+  });
+  await eventLoopIteration();
+  t.deepEqual(received, [['first', hostPacket]]);
+
+  await E(icaHost).unregister('connection-0', controllerPortID, handler);
+  t.deepEqual(downcalls[1], [
+    'unregisterICAController',
+    { connectionID: 'connection-0', controllerPortID, target },
+  ]);
+
+  // Events that arrive after unregistering are dropped.
+  await E(ibcBridge).fromBridge({
+    type: 'IBC_EVENT',
+    event: 'icaHostPacket',
+    target,
+    ...hostPacket,
+  });
+  await eventLoopIteration();
+  t.is(received.length, 1);
+
+  // The controller port is free to be registered again.
+  await E(icaHost).register('connection-0', controllerPortID, otherHandler);
+  t.is(downcalls.length, 3);
+});
+
+test('network - ibc interchain queries', async t => {
+  const ibcVat = E(ibcBuildRootObject)(null, null, provideBaggage('ibc-icq'));
+  const zone = makeDurableZone(provideBaggage('network - ibc ICQ'));
+  const { when } = prepareVowTools(zone);
+
+  /** @type {[string, any][]} */
+  const downcalls = [];
+  const ibcBridge = makeFakeIbcBridge(zone, obj => {
+    const { method, type: _, ...params } = obj;
+    downcalls.push([method, params]);
+  });
+  const callbacks = await E(ibcVat).makeCallbacks(ibcBridge);
+  const { bridgeHandler, icq } = await E(ibcVat).createHandlers(callbacks);
+  await E(ibcBridge).initHandler(bridgeHandler);
+
+  const packet = { source_port: 'icqcontroller-1', source_channel: 'channel-3' };
+  const requests = [
+    { path: '/cosmos.bank.v1beta1.Query/Balance', data: 'AQI=' },
+  ];
+  const resultV = E(icq).sendQuery(packet, requests);
+  const timedOutV = E(icq).sendQuery(packet, requests, 1_000_000_000n);
+  // Let both downcalls be made.
+  await eventLoopIteration();
+  t.deepEqual(downcalls, [
+    [
+      'sendInterchainQuery',
+      {
+        packet,
+        relativeTimeoutNs: 3_600_000_000_000n,
+        requests,
+        target: 'query-1',
+      },
+    ],
+    [
+      'sendInterchainQuery',
+      {
+        packet,
+        relativeTimeoutNs: 1_000_000_000n,
+        requests,
+        target: 'query-2',
+      },
+    ],
+  ]);
+
+  const responses = [{ value: 'Aw==', height: 12 }];
+  await E(ibcBridge).fromBridge({
+    type: 'IBC_EVENT',
+    event: 'interchainQueryResult',
+    target: 'query-1',
+    packet: { ...packet, sequence: '1' },
+    responses,
+    relayer: 'agoric1relayer',
+  });
+  t.deepEqual(await when(resultV), responses);
+
+  await E(ibcBridge).fromBridge({
+    type: 'IBC_EVENT',
+    event: 'interchainQueryResult',
+    target: 'query-2',
+    packet: { ...packet, sequence: '2' },
+    responses: null,
+    error: 'timeout',
+    relayer: 'agoric1relayer',
+  });
+  await t.throwsAsync(when(timedOutV), {
+    message: 'Interchain query 2 failed: timeout',
+  });
+
+  // A result for a query that is no longer awaited is ignored.
+  await E(ibcBridge).fromBridge({
+    type: 'IBC_EVENT',
+    event: 'interchainQueryResult',
+    target: 'query-1',
+    packet: { ...packet, sequence: '1' },
+    responses,
+    relayer: 'agoric1relayer',
+  });
+  t.pass();
+});
+
+test('network - ibc relative packet timeouts', async t => {
+  const networkVat = E(networkBuildRootObject)(
+    null,
+    null,
+    provideBaggage('network-timeouts'),
+  );
+  const ibcVat = E(ibcBuildRootObject)(
+    null,
+    null,
+    provideBaggage('ibc-timeouts'),
+  );
+  const zone = makeDurableZone(provideBaggage('network - ibc timeouts'));
+  const { when } = prepareVowTools(zone);
+
+  /** @type {[string, any][]} */
+  const downcalls = [];
+  const ibcBridge = makeFakeIbcBridge(zone, obj => {
+    const { method, type: _, ...params } = obj;
+    downcalls.push([method, params]);
+  });
+  await registerNetworkProtocols(
+    { network: networkVat, ibc: ibcVat, provisioning: undefined },
+    ibcBridge,
+  );
+  const portAllocator = await E(networkVat).getPortAllocator();
+  const p = await when(E(portAllocator).allocateCustomIBCPort());
+
+  const cV = when(
+    E(p).connect('/ibc-hop/connection-11/ibc-port/port-98/unordered/bar'),
+  );
+  await eventLoopIteration();
+  await E(ibcBridge).fromBridge({
+    event: 'channelOpenAck',
+    portID: 'port-1',
+    channelID: 'channel-1',
+    counterparty: { port_id: 'port-98', channel_id: 'channel-22' },
+    counterpartyVersion: 'bar',
+    connectionHops: ['connection-11'],
+  });
+  const c = await cV;
+
+  const packet = {
+    data: 'dGltZWQtbWVzc2FnZQ==',
+    destination_channel: 'channel-22',
+    destination_port: 'port-98',
+    source_channel: 'channel-1',
+    source_port: 'port-1',
+  };
+  const ackV = when(
+    E(c).send('timed-message', { packetTimeoutNs: 60_000_000_000n }),
+  );
+  await eventLoopIteration();
+  t.deepEqual(downcalls.at(-1), [
+    'sendPacket',
+    {
+      packet,
+      relativeTimeoutNs: 3_600_000_000_000n,
+      packetTimeoutNs: 60_000_000_000n,
+    },
+  ]);
+
+  // The sender stops waiting once the relative timeout elapses.
+  await E(ibcBridge).fromBridge({
+    type: 'IBC_EVENT',
+    event: 'packetTimeout',
+    packet: { ...packet, sequence: '39' },
+    deadlineNs: '1700000060000000000',
+  });
+  await t.throwsAsync(ackV, {
+    message: 'Packet 39 timed out at 1700000060000000000',
+  });
+});
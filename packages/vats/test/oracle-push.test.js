@@ -0,0 +1,84 @@
+import { test } from '@agoric/swingset-vat/tools/prepare-test-env-ava.js';
+
+import { makeHeapZone } from '@agoric/zone';
+import { E, Far } from '@endo/far';
+import { prepareOraclePushKit } from '../src/oracle-push.js';
+
+/** @param {any[]} pushed */
+const makeFakeFeed = pushed =>
+  Far('OraclePushTarget', {
+    pushPriceForOracle: async (oracleId, result) => {
+      pushed.push([oracleId, result]);
+    },
+  });
+
+test('fromBridge routes pushes to the registered feed', async t => {
+  const { bridgeHandler, registrar } = prepareOraclePushKit(makeHeapZone())();
+  /** @type {any[]} */
+  const pushed = [];
+  registrar.registerFeed('ATOM-USD', makeFakeFeed(pushed));
+
+  await E(bridgeHandler).fromBridge({
+    type: 'ORACLE_PUSH',
+    owner: 'agoric1oracle',
+    feed: 'ATOM-USD',
+    unitPrice: '12010000',
+    roundID: 0,
+  });
+  await E(bridgeHandler).fromBridge({
+    type: 'ORACLE_PUSH',
+    owner: 'agoric1oracle',
+    feed: 'ATOM-USD',
+    unitPrice: '12020000',
+    roundID: 7,
+  });
+  t.deepEqual(pushed, [
+    ['agoric1oracle', { unitPrice: 12010000n }],
+    ['agoric1oracle', { unitPrice: 12020000n, roundId: 7n }],
+  ]);
+
+  // A replacement feed takes over the pushes.
+  /** @type {any[]} */
+  const replaced = [];
+  registrar.registerFeed('ATOM-USD', makeFakeFeed(replaced));
+  await E(bridgeHandler).fromBridge({
+    type: 'ORACLE_PUSH',
+    owner: 'agoric1oracle',
+    feed: 'ATOM-USD',
+    unitPrice: '12030000',
+    roundID: 0,
+  });
+  t.is(pushed.length, 2);
+  t.deepEqual(replaced, [['agoric1oracle', { unitPrice: 12030000n }]]);
+});
+
+test('fromBridge rejects unknown feeds and events', async t => {
+  const { bridgeHandler, registrar } = prepareOraclePushKit(makeHeapZone())();
+  registrar.registerFeed('ATOM-USD', makeFakeFeed([]));
+  await t.throwsAsync(
+    E(bridgeHandler).fromBridge({
+      type: 'ORACLE_PUSH',
+      owner: 'agoric1oracle',
+      feed: 'OSMO-USD',
+      unitPrice: '1',
+      roundID: 0,
+    }),
+    { message: /No price feed "OSMO-USD"/ },
+  );
+  await t.throwsAsync(
+    E(bridgeHandler).fromBridge({ type: 'OTHER', feed: 'ATOM-USD' }),
+    { message: /Invalid inbound event type/ },
+  );
+
+  registrar.unregisterFeed('ATOM-USD');
+  await t.throwsAsync(
+    E(bridgeHandler).fromBridge({
+      type: 'ORACLE_PUSH',
+      owner: 'agoric1oracle',
+      feed: 'ATOM-USD',
+      unitPrice: '1',
+      roundID: 0,
+    }),
+    { message: /No price feed "ATOM-USD"/ },
+  );
+});
@@ -0,0 +1,107 @@
+import { test } from '@agoric/swingset-vat/tools/prepare-test-env-ava.js';
+
+import { VTRANSFER_IBC_EVENT } from '@agoric/internal/src/action-types.js';
+import { byteSourceToBase64 } from '@agoric/network';
+import { prepareVowTools } from '@agoric/vow/vat.js';
+import { makeHeapZone } from '@agoric/zone';
+import { E, Far } from '@endo/far';
+import { prepareBridgeTargetModule } from '../src/bridge-target.js';
+import { makeTypedErrorAck, prepareTransferTools } from '../src/transfer.js';
+import { makeFakeTransferBridge } from '../tools/fake-bridge.js';
+
+const target = 'agoric1receiver';
+const packet = harden({
+  source_port: 'transfer',
+  source_channel: 'channel-0',
+  destination_port: 'transfer',
+  destination_channel: 'channel-1',
+  sequence: 1,
+  data: byteSourceToBase64('{}'),
+});
+const acknowledgement = byteSourceToBase64('{"result":"AQ=="}');
+
+/**
+ * Make the transfer middleware over a fake transfer bridge, which records the
+ * downcalls of the taps registered with it.
+ */
+const makeTransferMiddleware = async () => {
+  const zone = makeHeapZone();
+  /** @type {any[]} */
+  const downcalls = [];
+  const transferBridge = makeFakeTransferBridge(zone.subZone('bridge'), obj =>
+    downcalls.push(obj),
+  );
+  const { makeBridgeTargetKit } = prepareBridgeTargetModule(
+    zone.subZone('targets'),
+  );
+  const { makeTransferMiddlewareKit } = prepareTransferTools(
+    zone,
+    prepareVowTools(zone.subZone('vows')),
+  );
+  const { finisher, interceptorFactory, transferMiddleware } =
+    makeTransferMiddlewareKit();
+  const bridgeTargetKit = makeBridgeTargetKit(
+    transferBridge,
+    VTRANSFER_IBC_EVENT,
+    interceptorFactory,
+  );
+  finisher.useRegistry(bridgeTargetKit.targetRegistry);
+  await E(transferBridge).initHandler(bridgeTargetKit.bridgeHandler);
+
+  const writeAcknowledgement = () =>
+    E(transferBridge).fromBridge({
+      type: VTRANSFER_IBC_EVENT,
+      event: 'writeAcknowledgement',
+      blockHeight: 1,
+      blockTime: 1,
+      acknowledgement,
+      packet,
+      relayer: 'agoric1relayer',
+      target,
+    });
+  return { downcalls, transferMiddleware, writeAcknowledgement };
+};
+
+test('active tap refuses a transfer with a typed error ack', async t => {
+  const { downcalls, transferMiddleware, writeAcknowledgement } =
+    await makeTransferMiddleware();
+  const rawAck = makeTypedErrorAck('rate_limited', 'too many transfers', 60);
+  t.deepEqual(JSON.parse(rawAck), {
+    error: 'too many transfers',
+    code: 'rate_limited',
+    retryAfter: 60,
+  });
+  const tap = Far('RateLimiter', { receiveUpcall: async _obj => rawAck });
+  await E(transferMiddleware).registerActiveTap(target, tap);
+
+  await writeAcknowledgement();
+  t.deepEqual(downcalls.at(-1), {
+    type: 'IBC_METHOD',
+    method: 'receiveExecuted',
+    packet,
+    ack: byteSourceToBase64(rawAck),
+  });
+});
+
+test('failing active tap refuses a transfer as rejected', async t => {
+  const { downcalls, transferMiddleware, writeAcknowledgement } =
+    await makeTransferMiddleware();
+  const tap = Far('Refuser', {
+    receiveUpcall: async _obj => {
+      throw Error('no such account');
+    },
+  });
+  await E(transferMiddleware).registerActiveTap(target, tap);
+
+  await writeAcknowledgement();
+  t.deepEqual(downcalls.at(-1), {
+    type: 'IBC_METHOD',
+    method: 'receiveExecuted',
+    packet,
+    ack: byteSourceToBase64(makeTypedErrorAck('rejected', 'no such account')),
+  });
+  t.deepEqual(JSON.parse(makeTypedErrorAck('rejected', 'no such account')), {
+    error: 'no such account',
+    code: 'rejected',
+  });
+});
@@ -63,6 +63,11 @@ export const makeFakeBankBridge = (
           return moduleDescriptor.address;
         }
 
+        case 'VBANK_SEND_TO_FEE_COLLECTOR':
+        case 'VBANK_SEND_TO_MODULE': {
+          return true;
+        }
+
         // Observed message:
         // address: 'agoric1megzytg65cyrgzs6fvzxgrcqvwwl7ugpt62346',
         // denom: 'ibc/toyatom',
@@ -141,14 +146,16 @@ export const makeFakeIbcBridge = (zone, onToBridge) => {
       if (method === 'sendPacket') {
         const { packet } = params;
         return { ...packet, sequence: '39' };
-      } else if (method === 'startChannelCloseInit') {
-        const { packet } = params;
+      } else if (method === 'chanCloseInit') {
+        const { packet, target } = params;
         if (hndlr)
           E(hndlr)
             .fromBridge({
               type: 'IBC_EVENT',
               event: 'channelCloseConfirm',
-              packet,
+              portID: packet.source_port,
+              channelID: packet.source_channel,
+              target,
             })
             .catch(e => console.error(e));
       }
@@ -370,6 +377,11 @@ export const makeFakeTransferBridge = (zone, onToBridge = () => {}) => {
           registered.delete(params.target);
           return undefined;
         }
+        case 'IBC_METHOD': {
+          params.method === 'receiveExecuted' ||
+            Fail`unknown method ${params.method}`;
+          return undefined;
+        }
         default:
           Fail`unknown type ${type}`;
       }